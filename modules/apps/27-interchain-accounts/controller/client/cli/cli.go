@@ -18,6 +18,8 @@ func GetQueryCmd() *cobra.Command {
 	queryCmd.AddCommand(
 		GetCmdQueryInterchainAccount(),
 		GetCmdParams(),
+		GetCmdClosedActiveChannels(),
+		GetCmdScheduledTxs(),
 	)
 
 	return queryCmd
@@ -36,6 +38,8 @@ func NewTxCmd() *cobra.Command {
 	cmd.AddCommand(
 		newRegisterInterchainAccountCmd(),
 		newSendTxCmd(),
+		newScheduleTxCmd(),
+		newCancelScheduledTxCmd(),
 	)
 
 	return cmd
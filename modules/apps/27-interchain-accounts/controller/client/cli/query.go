@@ -26,10 +26,16 @@ func GetCmdQueryInterchainAccount() *cobra.Command {
 				return err
 			}
 
+			accountIndex, err := cmd.Flags().GetUint64(flagAccountIndex)
+			if err != nil {
+				return err
+			}
+
 			queryClient := types.NewQueryClient(clientCtx)
 			req := &types.QueryInterchainAccountRequest{
 				Owner:        args[0],
 				ConnectionId: args[1],
+				AccountIndex: accountIndex,
 			}
 
 			res, err := queryClient.InterchainAccount(cmd.Context(), req)
@@ -41,6 +47,7 @@ func GetCmdQueryInterchainAccount() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().Uint64(flagAccountIndex, 0, "Account index of the interchain account")
 	flags.AddQueryFlagsToCmd(cmd)
 
 	return cmd
@@ -74,3 +81,82 @@ func GetCmdParams() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdClosedActiveChannels returns the command handler for querying interchain accounts whose active channel is closed.
+func GetCmdClosedActiveChannels() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "closed-active-channels",
+		Short:   "Query the interchain accounts whose active channel is closed",
+		Long:    "Query the controller submodule for all interchain accounts whose active channel is in the CLOSED state",
+		Args:    cobra.NoArgs,
+		Example: fmt.Sprintf("%s query interchain-accounts controller closed-active-channels", version.AppName),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryClosedActiveChannelsRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.ClosedActiveChannels(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "closed active channels")
+
+	return cmd
+}
+
+// GetCmdScheduledTxs returns the command handler for the Query/ScheduledTxs rpc method.
+func GetCmdScheduledTxs() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "scheduled-txs [owner]",
+		Short:   "Query the pending scheduled interchain account txs of an owner",
+		Long:    "Query the controller submodule for all pending scheduled interchain account txs of the provided owner",
+		Args:    cobra.ExactArgs(1),
+		Example: fmt.Sprintf("%s query interchain-accounts controller scheduled-txs cosmos1layxcsmyye0dc0har9sdfzwckaz8sjwlfsj8zs", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryScheduledTxsRequest{
+				Owner:      args[0],
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.ScheduledTxs(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "scheduled txs")
+
+	return cmd
+}
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"strconv"
 	"strings"
 	"time"
 
@@ -13,6 +14,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/cosmos/ibc-go/v9/modules/apps/27-interchain-accounts/controller/types"
 	icatypes "github.com/cosmos/ibc-go/v9/modules/apps/27-interchain-accounts/types"
@@ -27,6 +29,13 @@ const (
 	flagOrdering               = "ordering"
 	flagPacketTimeoutTimestamp = "packet-timeout-timestamp"
 	flagAbsoluteTimeouts       = "absolute-timeouts"
+	// The account index of the interchain account of the owner
+	flagAccountIndex = "account-index"
+	// The scheduling of a scheduled interchain account tx
+	flagExecutionTime = "execution-time"
+	flagInterval      = "interval"
+	flagExecutions    = "executions"
+	flagExecutionFee  = "execution-fee"
 )
 
 // defaultRelativePacketTimeoutTimestamp is the default packet timeout timestamp (in nanoseconds)
@@ -44,7 +53,7 @@ connection id from the source chain. Connection identifier should be for the sou
 and the interchain account will be created on the counterparty chain. Callers are expected to 
 provide the appropriate application version string via {version} flag and the desired ordering
 via the {ordering} flag. Generates a new port identifier using the provided owner string, binds to the port identifier and claims 
-the associated capability.`),
+the associated capability. Multiple interchain accounts can be registered on the same connection by providing a distinct {account-index}.`),
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
@@ -64,7 +73,13 @@ the associated capability.`),
 				return err
 			}
 
+			accountIndex, err := cmd.Flags().GetUint64(flagAccountIndex)
+			if err != nil {
+				return err
+			}
+
 			msg := types.NewMsgRegisterInterchainAccount(connectionID, owner, version, order)
+			msg.AccountIndex = accountIndex
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
@@ -72,6 +87,7 @@ the associated capability.`),
 
 	cmd.Flags().String(flagVersion, "", "Controller chain channel version")
 	cmd.Flags().String(flagOrdering, channeltypes.UNORDERED.String(), fmt.Sprintf("Channel ordering, can be one of: %s", strings.Join(connectiontypes.SupportedOrderings, ", ")))
+	cmd.Flags().Uint64(flagAccountIndex, 0, "Account index of the interchain account, allows registering multiple interchain accounts on the same connection")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -97,19 +113,9 @@ If no timeout value is set then a default relative timeout value of 10 minutes i
 			connectionID := args[0]
 			owner := clientCtx.GetFromAddress().String()
 
-			// attempt to unmarshal ica msg data argument
-			var icaMsgData icatypes.InterchainAccountPacketData
-			msgContentOrFileName := args[1]
-			if err := cdc.UnmarshalJSON([]byte(msgContentOrFileName), &icaMsgData); err != nil {
-				// check for file path if JSON input is not provided
-				contents, err := os.ReadFile(msgContentOrFileName)
-				if err != nil {
-					return fmt.Errorf("neither JSON input nor path to .json file for packet data with messages were provided: %w", err)
-				}
-
-				if err := cdc.UnmarshalJSON(contents, &icaMsgData); err != nil {
-					return fmt.Errorf("error unmarshalling packet data with messages file: %w", err)
-				}
+			icaMsgData, err := parsePacketData(cdc, args[1])
+			if err != nil {
+				return err
 			}
 
 			timeoutTimestamp, err := cmd.Flags().GetUint64(flagPacketTimeoutTimestamp)
@@ -137,7 +143,13 @@ If no timeout value is set then a default relative timeout value of 10 minutes i
 				timeoutTimestamp = uint64(now) + timeoutTimestamp
 			}
 
+			accountIndex, err := cmd.Flags().GetUint64(flagAccountIndex)
+			if err != nil {
+				return err
+			}
+
 			msg := types.NewMsgSendTx(owner, connectionID, timeoutTimestamp, icaMsgData)
+			msg.AccountIndex = accountIndex
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
@@ -145,11 +157,141 @@ If no timeout value is set then a default relative timeout value of 10 minutes i
 
 	cmd.Flags().Uint64(flagPacketTimeoutTimestamp, defaultRelativePacketTimeoutTimestamp, "Packet timeout timestamp in nanoseconds from now. Default is 10 minutes.")
 	cmd.Flags().Bool(flagAbsoluteTimeouts, false, "Timeout flags are used as absolute timeouts.")
+	cmd.Flags().Uint64(flagAccountIndex, 0, "Account index of the interchain account the tx is sent from")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func newScheduleTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "schedule-tx [connection-id] [path/to/packet_msg.json]",
+		Short: "Schedule an interchain account tx on the provided connection.",
+		Long: strings.TrimSpace(`Schedules pre-built packet data containing messages to be sent to the host chain at a future block time. 
+Packet data is provided as json, file or string. The block time of the first execution is provided as an RFC3339 timestamp using the flag {execution-time}. 
+A recurring tx is scheduled by providing the number of executions using the flag {executions} and the time between executions using the flag {interval}. 
+The execution fee provided using the flag {execution-fee} is paid on each execution and is escrowed upfront for all executions.
+The packet timeout timestamp of each execution is calculated relatively to the block time of the execution using the flag {packet-timeout-timestamp}.`),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			cdc := codec.NewProtoCodec(clientCtx.InterfaceRegistry)
+
+			connectionID := args[0]
+			owner := clientCtx.GetFromAddress().String()
+
+			icaMsgData, err := parsePacketData(cdc, args[1])
+			if err != nil {
+				return err
+			}
+
+			timeoutTimestamp, err := cmd.Flags().GetUint64(flagPacketTimeoutTimestamp)
+			if err != nil {
+				return err
+			}
+
+			executionTimeString, err := cmd.Flags().GetString(flagExecutionTime)
+			if err != nil {
+				return err
+			}
+
+			executionTime, err := time.Parse(time.RFC3339, executionTimeString)
+			if err != nil {
+				return fmt.Errorf("invalid execution time %s: %w", executionTimeString, err)
+			}
+
+			interval, err := cmd.Flags().GetDuration(flagInterval)
+			if err != nil {
+				return err
+			}
+
+			executions, err := cmd.Flags().GetUint64(flagExecutions)
+			if err != nil {
+				return err
+			}
+
+			executionFeeString, err := cmd.Flags().GetString(flagExecutionFee)
+			if err != nil {
+				return err
+			}
+
+			executionFee, err := sdk.ParseCoinsNormalized(executionFeeString)
+			if err != nil {
+				return err
+			}
+
+			accountIndex, err := cmd.Flags().GetUint64(flagAccountIndex)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgScheduleTx(owner, connectionID, timeoutTimestamp, icaMsgData, executionTime, interval, executions, executionFee)
+			msg.AccountIndex = accountIndex
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Uint64(flagPacketTimeoutTimestamp, defaultRelativePacketTimeoutTimestamp, "Packet timeout timestamp in nanoseconds from the block time of each execution. Default is 10 minutes.")
+	cmd.Flags().String(flagExecutionTime, "", "Block time of the first execution as an RFC3339 timestamp")
+	cmd.Flags().Duration(flagInterval, 0, "Time between the executions of a recurring tx")
+	cmd.Flags().Uint64(flagExecutions, 1, "Number of executions of the tx")
+	cmd.Flags().String(flagExecutionFee, "", "Fee paid on each execution of the tx")
+	cmd.Flags().Uint64(flagAccountIndex, 0, "Account index of the interchain account the tx is sent from")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func newCancelScheduledTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-scheduled-tx [scheduled-tx-id]",
+		Short: "Cancel a scheduled interchain account tx.",
+		Long:  "Cancels a scheduled interchain account tx of the sender and refunds the execution fees escrowed for its remaining executions.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			scheduledTxID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid scheduled tx id %s: %w", args[0], err)
+			}
+
+			msg := types.NewMsgCancelScheduledTx(clientCtx.GetFromAddress().String(), scheduledTxID)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
 }
 
+// parsePacketData unmarshals the interchain account packet data from the provided JSON string or file path.
+func parsePacketData(cdc codec.Codec, msgContentOrFileName string) (icatypes.InterchainAccountPacketData, error) {
+	var icaMsgData icatypes.InterchainAccountPacketData
+	if err := cdc.UnmarshalJSON([]byte(msgContentOrFileName), &icaMsgData); err != nil {
+		// check for file path if JSON input is not provided
+		contents, err := os.ReadFile(msgContentOrFileName)
+		if err != nil {
+			return icatypes.InterchainAccountPacketData{}, fmt.Errorf("neither JSON input nor path to .json file for packet data with messages were provided: %w", err)
+		}
+
+		if err := cdc.UnmarshalJSON(contents, &icaMsgData); err != nil {
+			return icatypes.InterchainAccountPacketData{}, fmt.Errorf("error unmarshalling packet data with messages file: %w", err)
+		}
+	}
+
+	return icaMsgData, nil
+}
+
 // parseOrdering gets the channel ordering from the flags.
 func parseOrdering(cmd *cobra.Command) (channeltypes.Order, error) {
 	orderString, err := cmd.Flags().GetString(flagOrdering)
@@ -6,6 +6,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/cosmos/ibc-go/v9/modules/apps/27-interchain-accounts/controller/types"
 	icatypes "github.com/cosmos/ibc-go/v9/modules/apps/27-interchain-accounts/types"
 	channeltypes "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
 	"github.com/cosmos/ibc-go/v9/modules/core/exported"
@@ -32,3 +33,71 @@ func EmitAcknowledgementEvent(ctx context.Context, packet channeltypes.Packet, a
 		),
 	)
 }
+
+// emitReopenActiveChannelEvent emits an event signalling that a new channel handshake has been initiated
+// to replace the closed active channel of an interchain account.
+func emitReopenActiveChannelEvent(ctx context.Context, connectionID, portID, closedChannelID, channelID string) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx) // TODO: https://github.com/cosmos/ibc-go/issues/5917
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			icatypes.EventTypeReopenActiveChannel,
+			sdk.NewAttribute(sdk.AttributeKeyModule, icatypes.ModuleName),
+			sdk.NewAttribute(icatypes.AttributeKeyControllerConnectionID, connectionID),
+			sdk.NewAttribute(icatypes.AttributeKeyControllerPortID, portID),
+			sdk.NewAttribute(icatypes.AttributeKeyClosedChannelID, closedChannelID),
+			sdk.NewAttribute(icatypes.AttributeKeyControllerChannelID, channelID),
+		),
+	)
+}
+
+// emitScheduleTxEvent emits an event signalling that a new interchain account transaction has been scheduled.
+func emitScheduleTxEvent(ctx context.Context, scheduledTx types.ScheduledTx) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx) // TODO: https://github.com/cosmos/ibc-go/issues/5917
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			icatypes.EventTypeScheduleTx,
+			sdk.NewAttribute(sdk.AttributeKeyModule, icatypes.ModuleName),
+			sdk.NewAttribute(icatypes.AttributeKeyScheduledTxID, strconv.FormatUint(scheduledTx.Id, 10)),
+			sdk.NewAttribute(icatypes.AttributeKeyOwner, scheduledTx.Owner),
+			sdk.NewAttribute(icatypes.AttributeKeyControllerConnectionID, scheduledTx.ConnectionId),
+		),
+	)
+}
+
+// emitCancelScheduledTxEvent emits an event signalling that a scheduled interchain account transaction has been cancelled.
+func emitCancelScheduledTxEvent(ctx context.Context, scheduledTx types.ScheduledTx) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx) // TODO: https://github.com/cosmos/ibc-go/issues/5917
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			icatypes.EventTypeCancelScheduledTx,
+			sdk.NewAttribute(sdk.AttributeKeyModule, icatypes.ModuleName),
+			sdk.NewAttribute(icatypes.AttributeKeyScheduledTxID, strconv.FormatUint(scheduledTx.Id, 10)),
+			sdk.NewAttribute(icatypes.AttributeKeyOwner, scheduledTx.Owner),
+		),
+	)
+}
+
+// emitExecuteScheduledTxEvent emits an event signalling the execution of a scheduled interchain account transaction
+// and including the sequence of the sent packet or the execution error details.
+func emitExecuteScheduledTxEvent(ctx context.Context, scheduledTx types.ScheduledTx, sequence uint64, err error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx) // TODO: https://github.com/cosmos/ibc-go/issues/5917
+	attributes := []sdk.Attribute{
+		sdk.NewAttribute(sdk.AttributeKeyModule, icatypes.ModuleName),
+		sdk.NewAttribute(icatypes.AttributeKeyScheduledTxID, strconv.FormatUint(scheduledTx.Id, 10)),
+		sdk.NewAttribute(icatypes.AttributeKeyOwner, scheduledTx.Owner),
+		sdk.NewAttribute(icatypes.AttributeKeyControllerConnectionID, scheduledTx.ConnectionId),
+	}
+
+	if err != nil {
+		attributes = append(attributes, sdk.NewAttribute(icatypes.AttributeKeyExecutionError, err.Error()))
+	} else {
+		attributes = append(attributes, sdk.NewAttribute(icatypes.AttributeKeySequence, strconv.FormatUint(sequence, 10)))
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			icatypes.EventTypeExecuteScheduledTx,
+			attributes...,
+		),
+	)
+}
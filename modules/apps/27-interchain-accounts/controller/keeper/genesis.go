@@ -39,15 +39,26 @@ func InitGenesis(ctx context.Context, keeper Keeper, state genesistypes.Controll
 		keeper.SetInterchainAccountAddress(ctx, acc.ConnectionId, acc.PortId, acc.AccountAddress)
 	}
 
+	for _, scheduledTx := range state.ScheduledTxs {
+		keeper.SetScheduledTx(ctx, scheduledTx)
+	}
+
+	keeper.SetNextScheduledTxID(ctx, state.NextScheduledTxId)
+
 	keeper.SetParams(ctx, state.Params)
 }
 
 // ExportGenesis returns the interchain accounts controller exported genesis
 func ExportGenesis(ctx context.Context, keeper Keeper) genesistypes.ControllerGenesisState {
-	return genesistypes.NewControllerGenesisState(
+	genesisState := genesistypes.NewControllerGenesisState(
 		keeper.GetAllActiveChannels(ctx),
 		keeper.GetAllInterchainAccounts(ctx),
 		keeper.GetAllPorts(ctx),
 		keeper.GetParams(ctx),
 	)
+
+	genesisState.ScheduledTxs = keeper.GetAllScheduledTxs(ctx)
+	genesisState.NextScheduledTxId = keeper.GetNextScheduledTxID(ctx)
+
+	return genesisState
 }
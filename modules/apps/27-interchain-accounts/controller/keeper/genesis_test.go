@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	"time"
+
 	"github.com/cosmos/ibc-go/v9/modules/apps/27-interchain-accounts/controller/keeper"
 	"github.com/cosmos/ibc-go/v9/modules/apps/27-interchain-accounts/controller/types"
 	genesistypes "github.com/cosmos/ibc-go/v9/modules/apps/27-interchain-accounts/genesis/types"
@@ -54,6 +56,22 @@ func (suite *KeeperTestSuite) TestInitGenesis() {
 			},
 		},
 		Ports: ports,
+		ScheduledTxs: []types.ScheduledTx{
+			{
+				Id:           1,
+				Owner:        TestOwnerAddress,
+				ConnectionId: ibctesting.FirstConnectionID,
+				PacketData: icatypes.InterchainAccountPacketData{
+					Type: icatypes.EXECUTE_TX,
+					Data: []byte("data"),
+				},
+				RelativeTimeout:     uint64(time.Minute.Nanoseconds()),
+				NextExecutionTime:   time.Unix(1700000000, 0).UTC(),
+				RemainingExecutions: 1,
+			},
+		},
+		NextScheduledTxId: 2,
+		Params:            types.NewParams(false),
 	}
 	for _, tc := range testCases {
 		tc := tc
@@ -79,6 +97,11 @@ func (suite *KeeperTestSuite) TestInitGenesis() {
 			suite.Require().True(found)
 			suite.Require().Equal(interchainAccAddr.String(), accountAdrr)
 
+			scheduledTx, found := suite.chainA.GetSimApp().ICAControllerKeeper.GetScheduledTx(suite.chainA.GetContext(), 1)
+			suite.Require().True(found)
+			suite.Require().Equal(genesisState.ScheduledTxs[0], scheduledTx)
+			suite.Require().Equal(uint64(2), suite.chainA.GetSimApp().ICAControllerKeeper.GetNextScheduledTxID(suite.chainA.GetContext()))
+
 			expParams := types.NewParams(false)
 			params := suite.chainA.GetSimApp().ICAControllerKeeper.GetParams(suite.chainA.GetContext())
 			suite.Require().Equal(expParams, params)
@@ -2,14 +2,20 @@ package keeper
 
 import (
 	"context"
+	"strings"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"cosmossdk.io/store/prefix"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkquery "github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/cosmos/ibc-go/v9/modules/apps/27-interchain-accounts/controller/types"
 	icatypes "github.com/cosmos/ibc-go/v9/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
 )
 
 var _ types.QueryServer = (*Keeper)(nil)
@@ -22,7 +28,7 @@ func (k Keeper) InterchainAccount(goCtx context.Context, req *types.QueryInterch
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	portID, err := icatypes.NewControllerPortID(req.Owner)
+	portID, err := icatypes.NewControllerPortIDWithIndex(req.Owner, req.AccountIndex)
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "failed to generate portID from owner address: %s", err)
 	}
@@ -46,3 +52,81 @@ func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.Q
 		Params: &params,
 	}, nil
 }
+
+// ClosedActiveChannels implements the Query/ClosedActiveChannels gRPC method
+func (k Keeper) ClosedActiveChannels(goCtx context.Context, req *types.QueryClosedActiveChannelsRequest) (*types.QueryClosedActiveChannelsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), []byte(icatypes.ActiveChannelKeyPrefix+"/"))
+
+	var channels []types.ClosedActiveChannel
+	pageRes, err := sdkquery.FilteredPaginate(store, req.Pagination, func(key, value []byte, accumulate bool) (bool, error) {
+		keySplit := strings.Split(string(key), "/")
+		if len(keySplit) != 2 {
+			return false, nil
+		}
+
+		portID, connectionID, channelID := keySplit[0], keySplit[1], string(value)
+
+		channel, found := k.channelKeeper.GetChannel(ctx, portID, channelID)
+		if !found || channel.State != channeltypes.CLOSED {
+			return false, nil
+		}
+
+		if accumulate {
+			address, _ := k.GetInterchainAccountAddress(ctx, connectionID, portID)
+			channels = append(channels, types.ClosedActiveChannel{
+				ConnectionId: connectionID,
+				PortId:       portID,
+				ChannelId:    channelID,
+				Address:      address,
+			})
+		}
+
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryClosedActiveChannelsResponse{
+		Channels:   channels,
+		Pagination: pageRes,
+	}, nil
+}
+
+// ScheduledTxs implements the Query/ScheduledTxs gRPC method
+func (k Keeper) ScheduledTxs(goCtx context.Context, req *types.QueryScheduledTxsRequest) (*types.QueryScheduledTxsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if strings.TrimSpace(req.Owner) == "" {
+		return nil, status.Error(codes.InvalidArgument, "owner address cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.KeyScheduledTxOwnerPrefix(req.Owner))
+
+	var scheduledTxs []types.ScheduledTx
+	pageRes, err := sdkquery.Paginate(store, req.Pagination, func(key, _ []byte) error {
+		scheduledTx, found := k.GetScheduledTx(ctx, sdk.BigEndianToUint64(key))
+		if !found {
+			return status.Errorf(codes.NotFound, "scheduled transaction with id %d", sdk.BigEndianToUint64(key))
+		}
+
+		scheduledTxs = append(scheduledTxs, scheduledTx)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryScheduledTxsResponse{
+		ScheduledTxs: scheduledTxs,
+		Pagination:   pageRes,
+	}, nil
+}
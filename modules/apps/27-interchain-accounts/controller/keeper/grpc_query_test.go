@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"github.com/cosmos/ibc-go/v9/modules/apps/27-interchain-accounts/controller/keeper"
 	"github.com/cosmos/ibc-go/v9/modules/apps/27-interchain-accounts/controller/types"
 	channeltypes "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
 	ibctesting "github.com/cosmos/ibc-go/v9/testing"
@@ -40,6 +41,13 @@ func (suite *KeeperTestSuite) TestQueryInterchainAccount() {
 			},
 			"failed to retrieve account address",
 		},
+		{
+			"account index not registered, account address not found",
+			func() {
+				req.AccountIndex = 1
+			},
+			"failed to retrieve account address",
+		},
 	}
 
 	for _, ordering := range []channeltypes.Order{channeltypes.UNORDERED, channeltypes.ORDERED} {
@@ -84,3 +92,66 @@ func (suite *KeeperTestSuite) TestQueryParams() {
 	res, _ := suite.chainA.GetSimApp().ICAControllerKeeper.Params(ctx, &types.QueryParamsRequest{})
 	suite.Require().Equal(&expParams, res.Params)
 }
+
+func (suite *KeeperTestSuite) TestQueryClosedActiveChannels() {
+	suite.SetupTest()
+
+	path := NewICAPath(suite.chainA, suite.chainB, channeltypes.ORDERED)
+	path.SetupConnections()
+
+	err := SetupICAPath(path, ibctesting.TestAccAddress)
+	suite.Require().NoError(err)
+
+	res, err := suite.chainA.GetSimApp().ICAControllerKeeper.ClosedActiveChannels(suite.chainA.GetContext(), &types.QueryClosedActiveChannelsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Channels)
+
+	path.EndpointA.UpdateChannel(func(channel *channeltypes.Channel) { channel.State = channeltypes.CLOSED })
+
+	res, err = suite.chainA.GetSimApp().ICAControllerKeeper.ClosedActiveChannels(suite.chainA.GetContext(), &types.QueryClosedActiveChannelsRequest{})
+	suite.Require().NoError(err)
+
+	expAddress, exists := suite.chainA.GetSimApp().ICAControllerKeeper.GetInterchainAccountAddress(suite.chainA.GetContext(), path.EndpointA.ConnectionID, path.EndpointA.ChannelConfig.PortID)
+	suite.Require().True(exists)
+
+	expChannels := []types.ClosedActiveChannel{
+		{
+			ConnectionId: path.EndpointA.ConnectionID,
+			PortId:       path.EndpointA.ChannelConfig.PortID,
+			ChannelId:    path.EndpointA.ChannelID,
+			Address:      expAddress,
+		},
+	}
+	suite.Require().Equal(expChannels, res.Channels)
+
+	_, err = suite.chainA.GetSimApp().ICAControllerKeeper.ClosedActiveChannels(suite.chainA.GetContext(), nil)
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestQueryScheduledTxs() {
+	suite.SetupTest()
+
+	_, msg := suite.setupScheduledTx(channeltypes.ORDERED)
+
+	msgServer := keeper.NewMsgServerImpl(&suite.chainA.GetSimApp().ICAControllerKeeper)
+	for i := 0; i < 2; i++ {
+		_, err := msgServer.ScheduleTx(suite.chainA.GetContext(), msg)
+		suite.Require().NoError(err)
+	}
+
+	res, err := suite.chainA.GetSimApp().ICAControllerKeeper.ScheduledTxs(suite.chainA.GetContext(), &types.QueryScheduledTxsRequest{Owner: msg.Owner})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.ScheduledTxs, 2)
+	suite.Require().Equal(uint64(0), res.ScheduledTxs[0].Id)
+	suite.Require().Equal(uint64(1), res.ScheduledTxs[1].Id)
+
+	res, err = suite.chainA.GetSimApp().ICAControllerKeeper.ScheduledTxs(suite.chainA.GetContext(), &types.QueryScheduledTxsRequest{Owner: TestOwnerAddress})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.ScheduledTxs)
+
+	_, err = suite.chainA.GetSimApp().ICAControllerKeeper.ScheduledTxs(suite.chainA.GetContext(), &types.QueryScheduledTxsRequest{})
+	suite.Require().Error(err)
+
+	_, err = suite.chainA.GetSimApp().ICAControllerKeeper.ScheduledTxs(suite.chainA.GetContext(), nil)
+	suite.Require().Error(err)
+}
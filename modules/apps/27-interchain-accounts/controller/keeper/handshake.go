@@ -55,7 +55,7 @@ func (k Keeper) OnChanOpenInit(
 		}
 	}
 
-	if err := icatypes.ValidateControllerMetadata(ctx, k.channelKeeper, connectionHops, metadata); err != nil {
+	if err := icatypes.ValidateControllerMetadata(ctx, k.channelKeeper, k.encodingRegistry, connectionHops, metadata); err != nil {
 		return "", err
 	}
 
@@ -84,7 +84,7 @@ func (k Keeper) OnChanOpenInit(
 		}
 	}
 
-	return string(icatypes.ModuleCdc.MustMarshalJSON(&metadata)), nil
+	return string(metadata.GetBytes()), nil
 }
 
 // OnChanOpenAck sets the active channel for the interchain account/owner pair
@@ -116,7 +116,7 @@ func (k Keeper) OnChanOpenAck(
 		return errorsmod.Wrapf(channeltypes.ErrChannelNotFound, "failed to retrieve channel %s on port %s", channelID, portID)
 	}
 
-	if err := icatypes.ValidateControllerMetadata(ctx, k.channelKeeper, channel.ConnectionHops, metadata); err != nil {
+	if err := icatypes.ValidateControllerMetadata(ctx, k.channelKeeper, k.encodingRegistry, channel.ConnectionHops, metadata); err != nil {
 		return err
 	}
 
@@ -147,6 +147,7 @@ func (Keeper) OnChanCloseConfirm(
 // The following may be changed:
 // - tx type (must be supported)
 // - encoding (must be supported)
+// - ack format (must be supported)
 // - order
 //
 // The following may not be changed:
@@ -181,7 +182,7 @@ func (k Keeper) OnChanUpgradeInit(ctx context.Context, portID, channelID string,
 
 	// ValidateControllerMetadata will ensure the ICS27 protocol version has not changed and that the
 	// tx type and encoding are supported
-	if err := icatypes.ValidateControllerMetadata(ctx, k.channelKeeper, proposedConnectionHops, proposedMetadata); err != nil {
+	if err := icatypes.ValidateControllerMetadata(ctx, k.channelKeeper, k.encodingRegistry, proposedConnectionHops, proposedMetadata); err != nil {
 		return "", errorsmod.Wrap(err, "invalid upgrade metadata")
 	}
 
@@ -210,6 +211,7 @@ func (k Keeper) OnChanUpgradeInit(ctx context.Context, portID, channelID string,
 // The following may be changed:
 // - tx type (must be supported)
 // - encoding (must be supported)
+// - ack format (must be supported)
 //
 // The following may not be changed:
 // - controller connectionID
@@ -239,7 +241,7 @@ func (k Keeper) OnChanUpgradeAck(ctx context.Context, portID, channelID, counter
 	// ValidateControllerMetadata will ensure the ICS27 protocol version has not changed and that the
 	// tx type and encoding are supported. Note, we pass in the current channel connection hops. The upgrade init
 	// step will verify that the proposed connection hops will not change.
-	if err := icatypes.ValidateControllerMetadata(ctx, k.channelKeeper, channel.ConnectionHops, proposedMetadata); err != nil {
+	if err := icatypes.ValidateControllerMetadata(ctx, k.channelKeeper, k.encodingRegistry, channel.ConnectionHops, proposedMetadata); err != nil {
 		return errorsmod.Wrap(err, "invalid upgrade metadata")
 	}
 
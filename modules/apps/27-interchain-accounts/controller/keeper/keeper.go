@@ -35,11 +35,15 @@ type Keeper struct {
 	ics4Wrapper    porttypes.ICS4Wrapper
 	channelKeeper  icatypes.ChannelKeeper
 	portKeeper     icatypes.PortKeeper
+	bankKeeper     types.BankKeeper
 
 	scopedKeeper exported.ScopedKeeper
 
 	msgRouter icatypes.MessageRouter
 
+	// encodingRegistry contains the encodings supported for the packet data of interchain accounts
+	encodingRegistry *icatypes.TxEncodingRegistry
+
 	// the address capable of executing a MsgUpdateParams message. Typically, this
 	// should be the x/gov module account.
 	authority string
@@ -49,22 +53,24 @@ type Keeper struct {
 func NewKeeper(
 	cdc codec.Codec, storeService corestore.KVStoreService, legacySubspace icatypes.ParamSubspace,
 	ics4Wrapper porttypes.ICS4Wrapper, channelKeeper icatypes.ChannelKeeper, portKeeper icatypes.PortKeeper,
-	scopedKeeper exported.ScopedKeeper, msgRouter icatypes.MessageRouter, authority string,
+	bankKeeper types.BankKeeper, scopedKeeper exported.ScopedKeeper, msgRouter icatypes.MessageRouter, authority string,
 ) Keeper {
 	if strings.TrimSpace(authority) == "" {
 		panic(errors.New("authority must be non-empty"))
 	}
 
 	return Keeper{
-		storeService:   storeService,
-		cdc:            cdc,
-		legacySubspace: legacySubspace,
-		ics4Wrapper:    ics4Wrapper,
-		channelKeeper:  channelKeeper,
-		portKeeper:     portKeeper,
-		scopedKeeper:   scopedKeeper,
-		msgRouter:      msgRouter,
-		authority:      authority,
+		storeService:     storeService,
+		cdc:              cdc,
+		legacySubspace:   legacySubspace,
+		ics4Wrapper:      ics4Wrapper,
+		channelKeeper:    channelKeeper,
+		portKeeper:       portKeeper,
+		bankKeeper:       bankKeeper,
+		scopedKeeper:     scopedKeeper,
+		msgRouter:        msgRouter,
+		encodingRegistry: icatypes.NewTxEncodingRegistry(),
+		authority:        authority,
 	}
 }
 
@@ -75,6 +81,13 @@ func (k *Keeper) WithICS4Wrapper(wrapper porttypes.ICS4Wrapper) {
 	k.ics4Wrapper = wrapper
 }
 
+// RegisterTxEncoding registers an additional encoding format which may be negotiated in the ICS27 Metadata
+// of interchain account channels. The protobuf and proto3 JSON encodings are registered by default.
+// This function must be called right after the keeper is created, before any channel handshake is executed.
+func (k *Keeper) RegisterTxEncoding(format string, encoding icatypes.TxEncoding) {
+	k.encodingRegistry.Register(format, encoding)
+}
+
 // GetICS4Wrapper returns the ICS4Wrapper.
 func (k Keeper) GetICS4Wrapper() porttypes.ICS4Wrapper {
 	return k.ics4Wrapper
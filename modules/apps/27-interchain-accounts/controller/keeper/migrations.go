@@ -30,3 +30,40 @@ func (m Migrator) MigrateParams(ctx sdk.Context) error {
 	}
 	return nil
 }
+
+// MigrateScheduledTxParams sets the scheduled transaction limits of the controller submodule's parameters to their
+// default values, as they are unset in the parameters stored before they were introduced.
+func (m Migrator) MigrateScheduledTxParams(ctx sdk.Context) error {
+	if m.keeper != nil {
+		params := m.keeper.GetParams(ctx)
+		defaultParams := controllertypes.DefaultParams()
+
+		if params.MaxExecutionsPerBlock == 0 {
+			params.MaxExecutionsPerBlock = defaultParams.MaxExecutionsPerBlock
+		}
+
+		if params.MaxScheduledTxsPerOwner == 0 {
+			params.MaxScheduledTxsPerOwner = defaultParams.MaxScheduledTxsPerOwner
+		}
+
+		if params.ScheduledTxGasLimit == 0 {
+			params.ScheduledTxGasLimit = defaultParams.ScheduledTxGasLimit
+		}
+
+		if params.MinExecutionFee.IsZero() {
+			params.MinExecutionFee = defaultParams.MinExecutionFee
+		}
+
+		if params.MinInterval == 0 {
+			params.MinInterval = defaultParams.MinInterval
+		}
+
+		if err := params.Validate(); err != nil {
+			return err
+		}
+
+		m.keeper.SetParams(ctx, params)
+		m.keeper.Logger(ctx).Info("successfully migrated ica/controller submodule scheduled transaction params")
+	}
+	return nil
+}
@@ -34,6 +34,7 @@ func (suite *KeeperTestSuite) TestMigratorMigrateParams() {
 					suite.chainA.GetSimApp().IBCKeeper.ChannelKeeper,
 					suite.chainA.GetSimApp().IBCKeeper.ChannelKeeper,
 					suite.chainA.GetSimApp().IBCKeeper.PortKeeper,
+					suite.chainA.GetSimApp().BankKeeper,
 					suite.chainA.GetSimApp().ScopedICAControllerKeeper,
 					suite.chainA.GetSimApp().MsgServiceRouter(),
 					suite.chainA.GetSimApp().ICAControllerKeeper.GetAuthority(),
@@ -60,3 +61,20 @@ func (suite *KeeperTestSuite) TestMigratorMigrateParams() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestMigratorMigrateScheduledTxParams() {
+	suite.SetupTest()
+
+	// params stored before the scheduled transaction params were introduced
+	suite.chainA.GetSimApp().ICAControllerKeeper.SetParams(suite.chainA.GetContext(), icacontrollertypes.Params{ControllerEnabled: false, ReopenClosedChannels: true})
+
+	migrator := icacontrollerkeeper.NewMigrator(&suite.chainA.GetSimApp().ICAControllerKeeper)
+	err := migrator.MigrateScheduledTxParams(suite.chainA.GetContext())
+	suite.Require().NoError(err)
+
+	expParams := icacontrollertypes.NewParams(false)
+	expParams.ReopenClosedChannels = true
+
+	params := suite.chainA.GetSimApp().ICAControllerKeeper.GetParams(suite.chainA.GetContext())
+	suite.Require().Equal(expParams, params)
+}
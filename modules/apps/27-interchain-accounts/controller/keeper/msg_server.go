@@ -29,7 +29,7 @@ func NewMsgServerImpl(keeper *Keeper) types.MsgServer {
 func (s msgServer) RegisterInterchainAccount(goCtx context.Context, msg *types.MsgRegisterInterchainAccount) (*types.MsgRegisterInterchainAccountResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	portID, err := icatypes.NewControllerPortID(msg.Owner)
+	portID, err := icatypes.NewControllerPortIDWithIndex(msg.Owner, msg.AccountIndex)
 	if err != nil {
 		return nil, err
 	}
@@ -64,7 +64,7 @@ func (s msgServer) RegisterInterchainAccount(goCtx context.Context, msg *types.M
 func (s msgServer) SendTx(goCtx context.Context, msg *types.MsgSendTx) (*types.MsgSendTxResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	portID, err := icatypes.NewControllerPortID(msg.Owner)
+	portID, err := icatypes.NewControllerPortIDWithIndex(msg.Owner, msg.AccountIndex)
 	if err != nil {
 		return nil, err
 	}
@@ -80,6 +80,32 @@ func (s msgServer) SendTx(goCtx context.Context, msg *types.MsgSendTx) (*types.M
 	return &types.MsgSendTxResponse{Sequence: seq}, nil
 }
 
+// ScheduleTx defines a rpc handler for MsgScheduleTx
+func (s msgServer) ScheduleTx(goCtx context.Context, msg *types.MsgScheduleTx) (*types.MsgScheduleTxResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	id, err := s.scheduleTx(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	s.Logger(ctx).Info("successfully scheduled interchain account transaction", "id", id, "owner", msg.Owner)
+
+	return &types.MsgScheduleTxResponse{ScheduledTxId: id}, nil
+}
+
+// CancelScheduledTx defines a rpc handler for MsgCancelScheduledTx
+func (s msgServer) CancelScheduledTx(goCtx context.Context, msg *types.MsgCancelScheduledTx) (*types.MsgCancelScheduledTxResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	refund, err := s.cancelScheduledTx(ctx, msg.Owner, msg.ScheduledTxId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCancelScheduledTxResponse{Refund: refund}, nil
+}
+
 // UpdateParams defines an rpc handler method for MsgUpdateParams. Updates the ica/controller submodule's parameters.
 func (k Keeper) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
 	if k.GetAuthority() != msg.Signer {
@@ -23,6 +23,7 @@ func (suite *KeeperTestSuite) TestRegisterInterchainAccount_MsgServer() {
 		msg               *types.MsgRegisterInterchainAccount
 		expectedOrderding channeltypes.Order
 		expectedChannelID = "channel-0"
+		expectedPortID    string
 	)
 
 	testCases := []struct {
@@ -43,10 +44,19 @@ func (suite *KeeperTestSuite) TestRegisterInterchainAccount_MsgServer() {
 			},
 			nil,
 		},
+		{
+			"success: account index is appended to the port ID",
+			func() {
+				msg.AccountIndex = 1
+				expectedPortID = icatypes.ControllerPortPrefix + ibctesting.TestAccAddress + ".1"
+			},
+			nil,
+		},
 		{
 			"success: non-empty owner address is valid",
 			func() {
 				msg.Owner = "<invalid-owner>"
+				expectedPortID = icatypes.ControllerPortPrefix + msg.Owner
 			},
 			nil,
 		},
@@ -81,6 +91,7 @@ func (suite *KeeperTestSuite) TestRegisterInterchainAccount_MsgServer() {
 
 			suite.Run(tc.name, func() {
 				expectedOrderding = ordering
+				expectedPortID = icatypes.ControllerPortPrefix + ibctesting.TestAccAddress
 
 				suite.SetupTest()
 
@@ -99,6 +110,7 @@ func (suite *KeeperTestSuite) TestRegisterInterchainAccount_MsgServer() {
 					suite.Require().NoError(err)
 					suite.Require().NotNil(res)
 					suite.Require().Equal(expectedChannelID, res.ChannelId)
+					suite.Require().Equal(expectedPortID, res.PortId)
 
 					events := ctx.EventManager().Events()
 					suite.Require().Len(events, 2)
@@ -118,6 +130,74 @@ func (suite *KeeperTestSuite) TestRegisterInterchainAccount_MsgServer() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestRegisterMultipleInterchainAccounts_MsgServer() {
+	suite.SetupTest()
+
+	path := NewICAPath(suite.chainA, suite.chainB, channeltypes.ORDERED)
+	path.SetupConnections()
+
+	msgServer := keeper.NewMsgServerImpl(&suite.chainA.GetSimApp().ICAControllerKeeper)
+
+	var addresses []string
+	for _, accountIndex := range []uint64{0, 1, 2} {
+		msg := types.NewMsgRegisterInterchainAccount(path.EndpointA.ConnectionID, TestOwnerAddress, TestVersion, channeltypes.ORDERED)
+		msg.AccountIndex = accountIndex
+
+		res, err := msgServer.RegisterInterchainAccount(suite.chainA.GetContext(), msg)
+		suite.Require().NoError(err)
+
+		expPortID, err := icatypes.NewControllerPortIDWithIndex(TestOwnerAddress, accountIndex)
+		suite.Require().NoError(err)
+		suite.Require().Equal(expPortID, res.PortId)
+
+		// complete the channel handshake for the registered interchain account
+		suite.chainA.NextBlock()
+		path.EndpointA.ChannelConfig.PortID = res.PortId
+		path.EndpointA.ChannelID = res.ChannelId
+		path.EndpointB.ChannelID = ""
+		path.EndpointA.ChannelConfig.Version = TestVersion
+		path.EndpointB.ChannelConfig.Version = TestVersion
+		suite.Require().NoError(path.EndpointB.ChanOpenTry())
+		suite.Require().NoError(path.EndpointA.ChanOpenAck())
+		suite.Require().NoError(path.EndpointB.ChanOpenConfirm())
+
+		queryRes, err := suite.chainA.GetSimApp().ICAControllerKeeper.InterchainAccount(suite.chainA.GetContext(), &types.QueryInterchainAccountRequest{
+			Owner:        TestOwnerAddress,
+			ConnectionId: path.EndpointA.ConnectionID,
+			AccountIndex: accountIndex,
+		})
+		suite.Require().NoError(err)
+		suite.Require().NotContains(addresses, queryRes.Address)
+		addresses = append(addresses, queryRes.Address)
+	}
+
+	// send a tx from the interchain account with account index 1
+	icaMsg := &banktypes.MsgSend{
+		FromAddress: addresses[1],
+		ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+		Amount:      sdk.NewCoins(ibctesting.TestCoin),
+	}
+
+	data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []proto.Message{icaMsg}, icatypes.EncodingProtobuf)
+	suite.Require().NoError(err)
+
+	msg := types.NewMsgSendTx(TestOwnerAddress, path.EndpointA.ConnectionID, uint64(time.Minute.Nanoseconds()), icatypes.InterchainAccountPacketData{
+		Type: icatypes.EXECUTE_TX,
+		Data: data,
+	})
+	msg.AccountIndex = 1
+
+	res, err := msgServer.SendTx(suite.chainA.GetContext(), msg)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), res.Sequence)
+
+	// each interchain account of the owner is exported in genesis
+	genesisState := keeper.ExportGenesis(suite.chainA.GetContext(), suite.chainA.GetSimApp().ICAControllerKeeper)
+	suite.Require().Len(genesisState.ActiveChannels, 3)
+	suite.Require().Len(genesisState.InterchainAccounts, 3)
+	suite.Require().Len(genesisState.Ports, 3)
+}
+
 func (suite *KeeperTestSuite) TestSubmitTx() {
 	var (
 		path *ibctesting.Path
@@ -54,8 +54,51 @@ func (k Keeper) sendTx(ctx sdk.Context, connectionID, portID string, icaPacketDa
 	return sequence, nil
 }
 
-// OnTimeoutPacket removes the active channel associated with the provided packet, the underlying channel end is closed
-// due to the semantics of ORDERED channels
-func (Keeper) OnTimeoutPacket(ctx context.Context, packet channeltypes.Packet) error {
+// OnTimeoutPacket is called when a packet sent by an interchain account times out. The underlying channel end is closed
+// due to the semantics of ORDERED channels. If the reopen closed channels param is enabled and the closed channel is the
+// active channel of the interchain account, a new channel handshake is initiated with the metadata of the closed channel.
+func (k Keeper) OnTimeoutPacket(ctx context.Context, packet channeltypes.Packet) error {
+	if !k.GetParams(ctx).ReopenClosedChannels {
+		return nil
+	}
+
+	connectionID, err := k.GetConnectionID(ctx, packet.SourcePort, packet.SourceChannel)
+	if err != nil {
+		return err
+	}
+
+	activeChannelID, found := k.GetActiveChannelID(ctx, connectionID, packet.SourcePort)
+	if !found || activeChannelID != packet.SourceChannel || !k.IsActiveChannelClosed(ctx, connectionID, packet.SourcePort) {
+		return nil
+	}
+
+	k.reopenActiveChannel(ctx, connectionID, packet.SourcePort, activeChannelID)
+
 	return nil
 }
+
+// reopenActiveChannel initiates a new channel handshake for the interchain account with the version and ordering of
+// its closed active channel. Failing to initiate the handshake does not fail the packet timeout, the owner may still
+// register the interchain account again.
+func (k Keeper) reopenActiveChannel(ctx context.Context, connectionID, portID, closedChannelID string) {
+	channel, found := k.channelKeeper.GetChannel(ctx, portID, closedChannelID)
+	if !found {
+		return
+	}
+
+	// the state changes of a failed handshake initiation are discarded
+	sdkCtx := sdk.UnwrapSDKContext(ctx) // TODO: https://github.com/cosmos/ibc-go/issues/5917
+	cacheCtx, writeFn := sdkCtx.CacheContext()
+
+	channelID, err := k.registerInterchainAccount(cacheCtx, connectionID, portID, channel.Version, channel.Ordering)
+	if err != nil {
+		k.Logger(ctx).Error("failed to reopen closed active channel", "port-id", portID, "connection-id", connectionID, "channel-id", closedChannelID, "error", err)
+		return
+	}
+
+	writeFn()
+
+	k.Logger(ctx).Info("initiated reopening of closed active channel", "port-id", portID, "connection-id", connectionID, "channel-id", channelID)
+
+	emitReopenActiveChannelEvent(ctx, connectionID, portID, closedChannelID, channelID)
+}
@@ -221,3 +221,100 @@ func (suite *KeeperTestSuite) TestOnTimeoutPacket() {
 		}
 	}
 }
+
+func (suite *KeeperTestSuite) TestOnTimeoutPacketReopenClosedChannel() {
+	var (
+		path   *ibctesting.Path
+		packet channeltypes.Packet
+	)
+
+	testCases := []struct {
+		msg       string
+		malleate  func()
+		expReopen bool
+	}{
+		{
+			"success: closed active channel is reopened",
+			func() {},
+			true,
+		},
+		{
+			"reopen closed channels param is disabled",
+			func() {
+				suite.chainA.GetSimApp().ICAControllerKeeper.SetParams(suite.chainA.GetContext(), types.DefaultParams())
+			},
+			false,
+		},
+		{
+			"active channel is not closed",
+			func() {
+				path.EndpointA.UpdateChannel(func(channel *channeltypes.Channel) { channel.State = channeltypes.OPEN })
+			},
+			false,
+		},
+		{
+			"packet source channel is not the active channel",
+			func() {
+				suite.chainA.GetSimApp().ICAControllerKeeper.SetActiveChannelID(suite.chainA.GetContext(), path.EndpointA.ConnectionID, path.EndpointA.ChannelConfig.PortID, "channel-100")
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			path = NewICAPath(suite.chainA, suite.chainB, channeltypes.ORDERED)
+			path.SetupConnections()
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			params := types.DefaultParams()
+			params.ReopenClosedChannels = true
+			suite.chainA.GetSimApp().ICAControllerKeeper.SetParams(suite.chainA.GetContext(), params)
+
+			// the channel end is closed by core IBC before the timeout callback is invoked
+			path.EndpointA.UpdateChannel(func(channel *channeltypes.Channel) { channel.State = channeltypes.CLOSED })
+
+			packet = channeltypes.NewPacket(
+				[]byte{},
+				1,
+				path.EndpointA.ChannelConfig.PortID,
+				path.EndpointA.ChannelID,
+				path.EndpointB.ChannelConfig.PortID,
+				path.EndpointB.ChannelID,
+				clienttypes.NewHeight(0, 100),
+				0,
+			)
+
+			tc.malleate() // malleate mutates test data
+
+			ctx := suite.chainA.GetContext()
+			nextChannelID := channeltypes.FormatChannelIdentifier(suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetNextChannelSequence(ctx))
+
+			err = suite.chainA.GetSimApp().ICAControllerKeeper.OnTimeoutPacket(ctx, packet)
+			suite.Require().NoError(err)
+
+			channel, found := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetChannel(ctx, path.EndpointA.ChannelConfig.PortID, nextChannelID)
+			suite.Require().Equal(tc.expReopen, found)
+
+			var reopenEventFound bool
+			for _, event := range ctx.EventManager().Events() {
+				if event.Type == icatypes.EventTypeReopenActiveChannel {
+					reopenEventFound = true
+				}
+			}
+			suite.Require().Equal(tc.expReopen, reopenEventFound)
+
+			if tc.expReopen {
+				suite.Require().Equal(channeltypes.INIT, channel.State)
+				suite.Require().Equal(channeltypes.ORDERED, channel.Ordering)
+				suite.Require().Equal(path.EndpointA.GetChannel().Version, channel.Version)
+			}
+		})
+	}
+}
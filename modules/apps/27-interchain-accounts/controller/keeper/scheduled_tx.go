@@ -0,0 +1,357 @@
+package keeper
+
+import (
+	"context"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/cosmos/ibc-go/v9/modules/apps/27-interchain-accounts/controller/types"
+	icatypes "github.com/cosmos/ibc-go/v9/modules/apps/27-interchain-accounts/types"
+	ibcerrors "github.com/cosmos/ibc-go/v9/modules/core/errors"
+)
+
+// GetScheduledTx retrieves the scheduled transaction with the provided identifier from the store
+func (k Keeper) GetScheduledTx(ctx context.Context, id uint64) (types.ScheduledTx, bool) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.KeyScheduledTx(id))
+	if err != nil {
+		panic(err)
+	}
+	if len(bz) == 0 {
+		return types.ScheduledTx{}, false
+	}
+
+	var scheduledTx types.ScheduledTx
+	k.cdc.MustUnmarshal(bz, &scheduledTx)
+
+	return scheduledTx, true
+}
+
+// SetScheduledTx stores the provided scheduled transaction and indexes it by owner and next execution time
+func (k Keeper) SetScheduledTx(ctx context.Context, scheduledTx types.ScheduledTx) {
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Set(types.KeyScheduledTx(scheduledTx.Id), k.cdc.MustMarshal(&scheduledTx)); err != nil {
+		panic(err)
+	}
+
+	if err := store.Set(types.KeyScheduledTxOwner(scheduledTx.Owner, scheduledTx.Id), []byte{1}); err != nil {
+		panic(err)
+	}
+
+	if err := store.Set(types.KeyScheduledTxQueue(scheduledTx.NextExecutionTime, scheduledTx.Id), []byte{1}); err != nil {
+		panic(err)
+	}
+}
+
+// deleteScheduledTx removes the provided scheduled transaction and its indexes from the store
+func (k Keeper) deleteScheduledTx(ctx context.Context, scheduledTx types.ScheduledTx) {
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Delete(types.KeyScheduledTx(scheduledTx.Id)); err != nil {
+		panic(err)
+	}
+
+	if err := store.Delete(types.KeyScheduledTxOwner(scheduledTx.Owner, scheduledTx.Id)); err != nil {
+		panic(err)
+	}
+
+	k.dequeueScheduledTx(ctx, scheduledTx)
+}
+
+// dequeueScheduledTx removes the provided scheduled transaction from the execution queue
+func (k Keeper) dequeueScheduledTx(ctx context.Context, scheduledTx types.ScheduledTx) {
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Delete(types.KeyScheduledTxQueue(scheduledTx.NextExecutionTime, scheduledTx.Id)); err != nil {
+		panic(err)
+	}
+}
+
+// GetAllScheduledTxs returns all scheduled transactions ordered by identifier
+func (k Keeper) GetAllScheduledTxs(ctx context.Context) []types.ScheduledTx {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte(types.ScheduledTxKeyPrefix+"/"))
+	defer sdk.LogDeferred(k.Logger(ctx), func() error { return iterator.Close() })
+
+	var scheduledTxs []types.ScheduledTx
+	for ; iterator.Valid(); iterator.Next() {
+		var scheduledTx types.ScheduledTx
+		k.cdc.MustUnmarshal(iterator.Value(), &scheduledTx)
+
+		scheduledTxs = append(scheduledTxs, scheduledTx)
+	}
+
+	return scheduledTxs
+}
+
+// getScheduledTxCount returns the number of pending scheduled transactions of the provided owner
+func (k Keeper) getScheduledTxCount(ctx context.Context, owner string) uint64 {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iterator := storetypes.KVStorePrefixIterator(store, types.KeyScheduledTxOwnerPrefix(owner))
+	defer sdk.LogDeferred(k.Logger(ctx), func() error { return iterator.Close() })
+
+	var count uint64
+	for ; iterator.Valid(); iterator.Next() {
+		count++
+	}
+
+	return count
+}
+
+// GetNextScheduledTxID returns the identifier assigned to the next scheduled transaction
+func (k Keeper) GetNextScheduledTxID(ctx context.Context) uint64 {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get([]byte(types.NextScheduledTxIDKey))
+	if err != nil {
+		panic(err)
+	}
+	if len(bz) == 0 {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetNextScheduledTxID stores the identifier assigned to the next scheduled transaction
+func (k Keeper) SetNextScheduledTxID(ctx context.Context, id uint64) {
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Set([]byte(types.NextScheduledTxIDKey), sdk.Uint64ToBigEndian(id)); err != nil {
+		panic(err)
+	}
+}
+
+// scheduleTx stores a new scheduled transaction for the interchain account of the owner and escrows the execution
+// fee of all its executions. The identifier of the scheduled transaction is returned.
+func (k Keeper) scheduleTx(ctx sdk.Context, msg *types.MsgScheduleTx) (uint64, error) {
+	params := k.GetParams(ctx)
+	if !params.ControllerEnabled {
+		return 0, types.ErrControllerSubModuleDisabled
+	}
+
+	if !msg.ExecutionFee.IsAllGTE(params.MinExecutionFee) {
+		return 0, errorsmod.Wrapf(ibcerrors.ErrInsufficientFunds, "execution fee %s is less than the minimum execution fee %s", msg.ExecutionFee, params.MinExecutionFee)
+	}
+
+	if msg.Executions > 1 && msg.Interval < params.MinInterval {
+		return 0, errorsmod.Wrapf(types.ErrInvalidScheduledTx, "interval %s is less than the minimum interval %s", msg.Interval, params.MinInterval)
+	}
+
+	if count := k.getScheduledTxCount(ctx, msg.Owner); count >= params.MaxScheduledTxsPerOwner {
+		return 0, errorsmod.Wrapf(types.ErrInvalidScheduledTx, "owner %s has reached the maximum of %d pending scheduled transactions", msg.Owner, params.MaxScheduledTxsPerOwner)
+	}
+
+	portID, err := icatypes.NewControllerPortIDWithIndex(msg.Owner, msg.AccountIndex)
+	if err != nil {
+		return 0, err
+	}
+
+	if !k.IsActiveChannel(ctx, msg.ConnectionId, portID) {
+		return 0, errorsmod.Wrapf(icatypes.ErrActiveChannelNotFound, "failed to retrieve active channel on connection %s for port %s", msg.ConnectionId, portID)
+	}
+
+	if msg.ExecutionTime.Before(ctx.BlockTime()) {
+		return 0, errorsmod.Wrapf(types.ErrInvalidScheduledTx, "execution time %s is before the current block time %s", msg.ExecutionTime, ctx.BlockTime())
+	}
+
+	scheduledTx := types.ScheduledTx{
+		Id:                  k.GetNextScheduledTxID(ctx),
+		Owner:               msg.Owner,
+		ConnectionId:        msg.ConnectionId,
+		AccountIndex:        msg.AccountIndex,
+		PacketData:          msg.PacketData,
+		RelativeTimeout:     msg.RelativeTimeout,
+		NextExecutionTime:   msg.ExecutionTime,
+		Interval:            msg.Interval,
+		RemainingExecutions: msg.Executions,
+		ExecutionFee:        msg.ExecutionFee,
+	}
+
+	if escrowedFee := scheduledTx.EscrowedFee(); !escrowedFee.IsZero() {
+		owner, err := sdk.AccAddressFromBech32(msg.Owner)
+		if err != nil {
+			return 0, errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "failed to parse owner address %s: %s", msg.Owner, err)
+		}
+
+		if err := k.bankKeeper.SendCoins(ctx, owner, types.GetScheduledTxEscrowAddress(), escrowedFee); err != nil {
+			return 0, errorsmod.Wrap(err, "failed to escrow execution fees of scheduled transaction")
+		}
+	}
+
+	k.SetScheduledTx(ctx, scheduledTx)
+	k.SetNextScheduledTxID(ctx, scheduledTx.Id+1)
+
+	emitScheduleTxEvent(ctx, scheduledTx)
+
+	return scheduledTx.Id, nil
+}
+
+// cancelScheduledTx removes the scheduled transaction with the provided identifier and refunds the execution fees
+// escrowed for its remaining executions to the owner. The refunded fees are returned.
+func (k Keeper) cancelScheduledTx(ctx sdk.Context, owner string, id uint64) (sdk.Coins, error) {
+	scheduledTx, found := k.GetScheduledTx(ctx, id)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrScheduledTxNotFound, "scheduled transaction with id %d", id)
+	}
+
+	if scheduledTx.Owner != owner {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected owner %s, got %s", scheduledTx.Owner, owner)
+	}
+
+	refund, err := k.refundScheduledTx(ctx, scheduledTx)
+	if err != nil {
+		return nil, err
+	}
+
+	k.deleteScheduledTx(ctx, scheduledTx)
+
+	emitCancelScheduledTxEvent(ctx, scheduledTx)
+
+	return refund, nil
+}
+
+// refundScheduledTx returns the execution fees escrowed for the remaining executions of the scheduled transaction
+// to its owner.
+func (k Keeper) refundScheduledTx(ctx context.Context, scheduledTx types.ScheduledTx) (sdk.Coins, error) {
+	refund := scheduledTx.EscrowedFee()
+	if refund.IsZero() {
+		return sdk.NewCoins(), nil
+	}
+
+	owner, err := sdk.AccAddressFromBech32(scheduledTx.Owner)
+	if err != nil {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "failed to parse owner address %s: %s", scheduledTx.Owner, err)
+	}
+
+	if err := k.bankKeeper.SendCoins(ctx, types.GetScheduledTxEscrowAddress(), owner, refund); err != nil {
+		return nil, errorsmod.Wrap(err, "failed to refund execution fees of scheduled transaction")
+	}
+
+	return refund, nil
+}
+
+// EndBlocker executes the scheduled transactions whose next execution time is at or before the current block time,
+// in order of execution time, up to the maximum number of executions per block. The remaining due scheduled
+// transactions stay queued for execution in the following blocks.
+func (k Keeper) EndBlocker(ctx context.Context) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx) // TODO: https://github.com/cosmos/ibc-go/issues/5917
+	params := k.GetParams(sdkCtx)
+
+	for _, scheduledTx := range k.getDueScheduledTxs(sdkCtx, sdkCtx.BlockTime(), params.MaxExecutionsPerBlock) {
+		k.executeScheduledTx(sdkCtx, scheduledTx, params.ScheduledTxGasLimit)
+	}
+}
+
+// getDueScheduledTxs returns up to limit scheduled transactions queued for execution at or before the provided time.
+func (k Keeper) getDueScheduledTxs(ctx context.Context, blockTime time.Time, limit uint64) []types.ScheduledTx {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	queuePrefix := types.KeyScheduledTxQueuePrefix()
+	end := storetypes.PrefixEndBytes(append(queuePrefix, sdk.FormatTimeBytes(blockTime)...))
+
+	iterator := store.Iterator(queuePrefix, end)
+	defer sdk.LogDeferred(k.Logger(ctx), func() error { return iterator.Close() })
+
+	var scheduledTxs []types.ScheduledTx
+	for ; iterator.Valid() && uint64(len(scheduledTxs)) < limit; iterator.Next() {
+		key := iterator.Key()
+		id := sdk.BigEndianToUint64(key[len(key)-8:])
+
+		scheduledTx, found := k.GetScheduledTx(ctx, id)
+		if !found {
+			continue
+		}
+
+		scheduledTxs = append(scheduledTxs, scheduledTx)
+	}
+
+	return scheduledTxs
+}
+
+// executeScheduledTx sends the packet data of the scheduled transaction and pays its execution fee to the fee collector.
+// The execution fails once it consumes more gas than the provided gas limit. A failed execution cancels the scheduled
+// transaction and refunds the fees escrowed for its remaining executions. A recurring transaction is queued again for
+// its next execution, until no executions remain.
+func (k Keeper) executeScheduledTx(ctx sdk.Context, scheduledTx types.ScheduledTx, gasLimit uint64) {
+	k.dequeueScheduledTx(ctx, scheduledTx)
+
+	// the state changes of a failed execution are discarded
+	cacheCtx, writeFn := ctx.CacheContext()
+
+	sequence, err := k.sendScheduledTxWithGasLimit(cacheCtx, scheduledTx, gasLimit)
+	if err != nil {
+		k.Logger(ctx).Error("failed to execute scheduled transaction", "id", scheduledTx.Id, "owner", scheduledTx.Owner, "error", err)
+
+		if _, err := k.refundScheduledTx(ctx, scheduledTx); err != nil {
+			k.Logger(ctx).Error("failed to refund scheduled transaction", "id", scheduledTx.Id, "owner", scheduledTx.Owner, "error", err)
+		}
+
+		k.deleteScheduledTx(ctx, scheduledTx)
+		emitExecuteScheduledTxEvent(ctx, scheduledTx, 0, err)
+
+		return
+	}
+
+	writeFn()
+
+	emitExecuteScheduledTxEvent(ctx, scheduledTx, sequence, nil)
+
+	scheduledTx.RemainingExecutions--
+	if scheduledTx.RemainingExecutions == 0 {
+		k.deleteScheduledTx(ctx, scheduledTx)
+		return
+	}
+
+	// executions missed while the chain was halted are skipped rather than executed in quick succession
+	nextExecutionTime := scheduledTx.NextExecutionTime.Add(scheduledTx.Interval)
+	if !nextExecutionTime.After(ctx.BlockTime()) {
+		nextExecutionTime = ctx.BlockTime().Add(scheduledTx.Interval)
+	}
+
+	scheduledTx.NextExecutionTime = nextExecutionTime
+	k.SetScheduledTx(ctx, scheduledTx)
+}
+
+// sendScheduledTxWithGasLimit sends the scheduled transaction with a gas meter limited to the provided gas limit.
+// Running out of gas is returned as an error.
+func (k Keeper) sendScheduledTxWithGasLimit(ctx sdk.Context, scheduledTx types.ScheduledTx, gasLimit uint64) (sequence uint64, err error) {
+	gasMeter := storetypes.NewGasMeter(gasLimit)
+
+	defer func() {
+		if r := recover(); r != nil {
+			if _, ok := r.(storetypes.ErrorOutOfGas); !ok {
+				panic(r)
+			}
+
+			sequence, err = 0, errorsmod.Wrapf(ibcerrors.ErrOutOfGas, "scheduled transaction exceeded gas limit %d", gasLimit)
+		}
+	}()
+
+	return k.sendScheduledTx(ctx.WithGasMeter(gasMeter), scheduledTx)
+}
+
+// sendScheduledTx sends the packet data of the scheduled transaction using the interchain account of its owner and
+// pays the execution fee to the fee collector. The sequence of the sent packet is returned.
+func (k Keeper) sendScheduledTx(ctx sdk.Context, scheduledTx types.ScheduledTx) (uint64, error) {
+	portID, err := icatypes.NewControllerPortIDWithIndex(scheduledTx.Owner, scheduledTx.AccountIndex)
+	if err != nil {
+		return 0, err
+	}
+
+	// the absolute timeout value is calculated using the controller chain block time + the relative timeout value
+	absoluteTimeout := uint64(ctx.BlockTime().UnixNano()) + scheduledTx.RelativeTimeout
+	sequence, err := k.sendTx(ctx, scheduledTx.ConnectionId, portID, scheduledTx.PacketData, absoluteTimeout)
+	if err != nil {
+		return 0, err
+	}
+
+	if !scheduledTx.ExecutionFee.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, types.GetScheduledTxEscrowAddress(), authtypes.FeeCollectorName, scheduledTx.ExecutionFee); err != nil {
+			return 0, errorsmod.Wrap(err, "failed to pay execution fee of scheduled transaction")
+		}
+	}
+
+	return sequence, nil
+}
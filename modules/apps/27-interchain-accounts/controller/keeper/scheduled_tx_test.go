@@ -0,0 +1,415 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/cosmos/ibc-go/v9/modules/apps/27-interchain-accounts/controller/keeper"
+	"github.com/cosmos/ibc-go/v9/modules/apps/27-interchain-accounts/controller/types"
+	icatypes "github.com/cosmos/ibc-go/v9/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
+	ibcerrors "github.com/cosmos/ibc-go/v9/modules/core/errors"
+	ibctesting "github.com/cosmos/ibc-go/v9/testing"
+)
+
+var executionFee = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(types.DefaultMinExecutionFeeAmount)))
+
+// setupScheduledTx registers an interchain account for the sender account of chainA and returns a MsgScheduleTx
+// sending a bank transfer from the interchain account at the current block time.
+func (suite *KeeperTestSuite) setupScheduledTx(ordering channeltypes.Order) (*ibctesting.Path, *types.MsgScheduleTx) {
+	owner := suite.chainA.SenderAccount.GetAddress().String()
+
+	path := NewICAPath(suite.chainA, suite.chainB, ordering)
+	path.SetupConnections()
+
+	err := SetupICAPath(path, owner)
+	suite.Require().NoError(err)
+
+	interchainAccountAddr, found := suite.chainA.GetSimApp().ICAControllerKeeper.GetInterchainAccountAddress(suite.chainA.GetContext(), path.EndpointA.ConnectionID, path.EndpointA.ChannelConfig.PortID)
+	suite.Require().True(found)
+
+	icaMsg := &banktypes.MsgSend{
+		FromAddress: interchainAccountAddr,
+		ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+		Amount:      sdk.NewCoins(ibctesting.TestCoin),
+	}
+
+	data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []proto.Message{icaMsg}, icatypes.EncodingProtobuf)
+	suite.Require().NoError(err)
+
+	packetData := icatypes.InterchainAccountPacketData{
+		Type: icatypes.EXECUTE_TX,
+		Data: data,
+	}
+
+	executionTime := suite.chainA.GetContext().BlockTime()
+	msg := types.NewMsgScheduleTx(owner, path.EndpointA.ConnectionID, uint64(time.Minute.Nanoseconds()), packetData, executionTime, 0, 1, executionFee)
+
+	return path, msg
+}
+
+func (suite *KeeperTestSuite) TestScheduleTx_MsgServer() {
+	var msg *types.MsgScheduleTx
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success", func() {}, nil,
+		},
+		{
+			"success: recurring executions", func() {
+				msg.Interval = time.Hour
+				msg.Executions = 3
+			},
+			nil,
+		},
+		{
+			"success: recurring executions at the minimum interval", func() {
+				msg.Interval = types.DefaultMinInterval
+				msg.Executions = 3
+			},
+			nil,
+		},
+		{
+			"failure: controller submodule disabled", func() {
+				suite.chainA.GetSimApp().ICAControllerKeeper.SetParams(suite.chainA.GetContext(), types.NewParams(false))
+			},
+			types.ErrControllerSubModuleDisabled,
+		},
+		{
+			"failure: active channel does not exist for connection ID", func() {
+				msg.ConnectionId = "connection-100"
+			},
+			icatypes.ErrActiveChannelNotFound,
+		},
+		{
+			"failure: active channel does not exist for account index", func() {
+				msg.AccountIndex = 1
+			},
+			icatypes.ErrActiveChannelNotFound,
+		},
+		{
+			"failure: execution time is before the block time", func() {
+				msg.ExecutionTime = msg.ExecutionTime.Add(-time.Second)
+			},
+			types.ErrInvalidScheduledTx,
+		},
+		{
+			"failure: execution fee is less than the minimum execution fee", func() {
+				params := suite.chainA.GetSimApp().ICAControllerKeeper.GetParams(suite.chainA.GetContext())
+				params.MinExecutionFee = executionFee.Add(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.OneInt()))
+				suite.chainA.GetSimApp().ICAControllerKeeper.SetParams(suite.chainA.GetContext(), params)
+			},
+			ibcerrors.ErrInsufficientFunds,
+		},
+		{
+			"failure: no execution fee", func() {
+				msg.ExecutionFee = nil
+			},
+			ibcerrors.ErrInsufficientFunds,
+		},
+		{
+			"failure: interval is less than the minimum interval", func() {
+				msg.Interval = types.DefaultMinInterval - time.Second
+				msg.Executions = 3
+			},
+			types.ErrInvalidScheduledTx,
+		},
+		{
+			"failure: owner has reached the maximum number of pending scheduled transactions", func() {
+				params := suite.chainA.GetSimApp().ICAControllerKeeper.GetParams(suite.chainA.GetContext())
+				params.MaxScheduledTxsPerOwner = 1
+				suite.chainA.GetSimApp().ICAControllerKeeper.SetParams(suite.chainA.GetContext(), params)
+
+				msgServer := keeper.NewMsgServerImpl(&suite.chainA.GetSimApp().ICAControllerKeeper)
+				_, err := msgServer.ScheduleTx(suite.chainA.GetContext(), msg)
+				suite.Require().NoError(err)
+			},
+			types.ErrInvalidScheduledTx,
+		},
+		{
+			"failure: insufficient funds to escrow execution fees", func() {
+				balance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), sdk.DefaultBondDenom)
+				msg.ExecutionFee = sdk.NewCoins(balance.AddAmount(sdkmath.OneInt()))
+			},
+			sdkerrors.ErrInsufficientFunds,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			_, msg = suite.setupScheduledTx(channeltypes.ORDERED)
+
+			tc.malleate() // malleate mutates test data
+
+			ctx := suite.chainA.GetContext()
+			escrowAddr := types.GetScheduledTxEscrowAddress()
+			balanceBefore := suite.chainA.GetSimApp().BankKeeper.GetAllBalances(ctx, escrowAddr)
+
+			msgServer := keeper.NewMsgServerImpl(&suite.chainA.GetSimApp().ICAControllerKeeper)
+			res, err := msgServer.ScheduleTx(ctx, msg)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+
+				scheduledTx, found := suite.chainA.GetSimApp().ICAControllerKeeper.GetScheduledTx(ctx, res.ScheduledTxId)
+				suite.Require().True(found)
+				suite.Require().Equal(msg.Owner, scheduledTx.Owner)
+				suite.Require().Equal(msg.Executions, scheduledTx.RemainingExecutions)
+				suite.Require().Equal(res.ScheduledTxId+1, suite.chainA.GetSimApp().ICAControllerKeeper.GetNextScheduledTxID(ctx))
+
+				balanceAfter := suite.chainA.GetSimApp().BankKeeper.GetAllBalances(ctx, escrowAddr)
+				suite.Require().Equal(scheduledTx.EscrowedFee(), balanceAfter.Sub(balanceBefore...))
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().Nil(res)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestCancelScheduledTx_MsgServer() {
+	var msg *types.MsgCancelScheduledTx
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success", func() {}, nil,
+		},
+		{
+			"failure: scheduled transaction not found", func() {
+				msg.ScheduledTxId = 100
+			},
+			types.ErrScheduledTxNotFound,
+		},
+		{
+			"failure: sender is not the owner", func() {
+				msg.Owner = suite.chainB.SenderAccount.GetAddress().String()
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			_, scheduleMsg := suite.setupScheduledTx(channeltypes.ORDERED)
+			scheduleMsg.Interval = time.Hour
+			scheduleMsg.Executions = 3
+
+			msgServer := keeper.NewMsgServerImpl(&suite.chainA.GetSimApp().ICAControllerKeeper)
+			scheduleRes, err := msgServer.ScheduleTx(suite.chainA.GetContext(), scheduleMsg)
+			suite.Require().NoError(err)
+
+			msg = types.NewMsgCancelScheduledTx(scheduleMsg.Owner, scheduleRes.ScheduledTxId)
+
+			tc.malleate() // malleate mutates test data
+
+			ctx := suite.chainA.GetContext()
+			balanceBefore := suite.chainA.GetSimApp().BankKeeper.GetAllBalances(ctx, suite.chainA.SenderAccount.GetAddress())
+
+			res, err := msgServer.CancelScheduledTx(ctx, msg)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+
+				expRefund := executionFee.MulInt(sdkmath.NewInt(3))
+				suite.Require().Equal(expRefund, res.Refund)
+
+				balanceAfter := suite.chainA.GetSimApp().BankKeeper.GetAllBalances(ctx, suite.chainA.SenderAccount.GetAddress())
+				suite.Require().Equal(expRefund, balanceAfter.Sub(balanceBefore...))
+
+				_, found := suite.chainA.GetSimApp().ICAControllerKeeper.GetScheduledTx(ctx, scheduleRes.ScheduledTxId)
+				suite.Require().False(found)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().Nil(res)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestEndBlockerScheduledTx() {
+	suite.SetupTest()
+
+	path, msg := suite.setupScheduledTx(channeltypes.ORDERED)
+	msg.ExecutionTime = msg.ExecutionTime.Add(time.Minute)
+	msg.Interval = time.Hour
+	msg.Executions = 2
+
+	controllerKeeper := suite.chainA.GetSimApp().ICAControllerKeeper
+	bankKeeper := suite.chainA.GetSimApp().BankKeeper
+	feeCollectorAddr := suite.chainA.GetSimApp().AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+
+	msgServer := keeper.NewMsgServerImpl(&controllerKeeper)
+	res, err := msgServer.ScheduleTx(suite.chainA.GetContext(), msg)
+	suite.Require().NoError(err)
+
+	nextSequence := func(ctx sdk.Context) uint64 {
+		seq, found := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetNextSequenceSend(ctx, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+		suite.Require().True(found)
+		return seq
+	}
+
+	// the scheduled transaction is not executed before its execution time
+	ctx := suite.chainA.GetContext()
+	sequence := nextSequence(ctx)
+	controllerKeeper.EndBlocker(ctx)
+	suite.Require().Equal(sequence, nextSequence(ctx))
+
+	// the first execution sends the packet and pays the execution fee
+	ctx = suite.chainA.GetContext().WithBlockTime(msg.ExecutionTime)
+	feeCollectorBalance := bankKeeper.GetAllBalances(ctx, feeCollectorAddr)
+	controllerKeeper.EndBlocker(ctx)
+	suite.Require().Equal(sequence+1, nextSequence(ctx))
+	suite.Require().Equal(executionFee, bankKeeper.GetAllBalances(ctx, feeCollectorAddr).Sub(feeCollectorBalance...))
+
+	scheduledTx, found := controllerKeeper.GetScheduledTx(ctx, res.ScheduledTxId)
+	suite.Require().True(found)
+	suite.Require().Equal(uint64(1), scheduledTx.RemainingExecutions)
+	suite.Require().Equal(msg.ExecutionTime.Add(time.Hour), scheduledTx.NextExecutionTime)
+	suite.Require().Equal(executionFee, bankKeeper.GetAllBalances(ctx, types.GetScheduledTxEscrowAddress()))
+
+	// the last execution removes the scheduled transaction
+	ctx = suite.chainA.GetContext().WithBlockTime(scheduledTx.NextExecutionTime)
+	controllerKeeper.EndBlocker(ctx)
+	suite.Require().Equal(sequence+2, nextSequence(ctx))
+	suite.Require().True(bankKeeper.GetAllBalances(ctx, types.GetScheduledTxEscrowAddress()).IsZero())
+
+	_, found = controllerKeeper.GetScheduledTx(ctx, res.ScheduledTxId)
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestEndBlockerScheduledTxFailure() {
+	suite.SetupTest()
+
+	path, msg := suite.setupScheduledTx(channeltypes.ORDERED)
+	msg.ExecutionTime = msg.ExecutionTime.Add(time.Hour)
+	msg.Interval = time.Hour
+	msg.Executions = 2
+
+	controllerKeeper := suite.chainA.GetSimApp().ICAControllerKeeper
+	bankKeeper := suite.chainA.GetSimApp().BankKeeper
+
+	msgServer := keeper.NewMsgServerImpl(&controllerKeeper)
+	res, err := msgServer.ScheduleTx(suite.chainA.GetContext(), msg)
+	suite.Require().NoError(err)
+
+	// close the active channel to fail the execution
+	path.EndpointA.UpdateChannel(func(channel *channeltypes.Channel) { channel.State = channeltypes.CLOSED })
+
+	ctx := suite.chainA.GetContext().WithBlockTime(msg.ExecutionTime)
+	ownerBalance := bankKeeper.GetAllBalances(ctx, suite.chainA.SenderAccount.GetAddress())
+	controllerKeeper.EndBlocker(ctx)
+
+	_, found := controllerKeeper.GetScheduledTx(ctx, res.ScheduledTxId)
+	suite.Require().False(found)
+
+	// the execution fees of all remaining executions are refunded
+	expRefund := executionFee.MulInt(sdkmath.NewInt(2))
+	suite.Require().Equal(expRefund, bankKeeper.GetAllBalances(ctx, suite.chainA.SenderAccount.GetAddress()).Sub(ownerBalance...))
+	suite.Require().True(bankKeeper.GetAllBalances(ctx, types.GetScheduledTxEscrowAddress()).IsZero())
+}
+
+func (suite *KeeperTestSuite) TestEndBlockerScheduledTxMaxExecutionsPerBlock() {
+	suite.SetupTest()
+
+	path, msg := suite.setupScheduledTx(channeltypes.ORDERED)
+	msg.ExecutionTime = msg.ExecutionTime.Add(time.Minute)
+
+	controllerKeeper := suite.chainA.GetSimApp().ICAControllerKeeper
+
+	params := controllerKeeper.GetParams(suite.chainA.GetContext())
+	params.MaxExecutionsPerBlock = 1
+	controllerKeeper.SetParams(suite.chainA.GetContext(), params)
+
+	msgServer := keeper.NewMsgServerImpl(&controllerKeeper)
+	firstRes, err := msgServer.ScheduleTx(suite.chainA.GetContext(), msg)
+	suite.Require().NoError(err)
+
+	secondRes, err := msgServer.ScheduleTx(suite.chainA.GetContext(), msg)
+	suite.Require().NoError(err)
+
+	nextSequence := func(ctx sdk.Context) uint64 {
+		seq, found := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetNextSequenceSend(ctx, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+		suite.Require().True(found)
+		return seq
+	}
+
+	// only the first due scheduled transaction is executed
+	ctx := suite.chainA.GetContext().WithBlockTime(msg.ExecutionTime)
+	sequence := nextSequence(ctx)
+	controllerKeeper.EndBlocker(ctx)
+	suite.Require().Equal(sequence+1, nextSequence(ctx))
+
+	_, found := controllerKeeper.GetScheduledTx(ctx, firstRes.ScheduledTxId)
+	suite.Require().False(found)
+
+	_, found = controllerKeeper.GetScheduledTx(ctx, secondRes.ScheduledTxId)
+	suite.Require().True(found)
+
+	// the second scheduled transaction remains queued and is executed in the following block
+	ctx = ctx.WithBlockTime(msg.ExecutionTime.Add(time.Second))
+	controllerKeeper.EndBlocker(ctx)
+	suite.Require().Equal(sequence+2, nextSequence(ctx))
+
+	_, found = controllerKeeper.GetScheduledTx(ctx, secondRes.ScheduledTxId)
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestEndBlockerScheduledTxOutOfGas() {
+	suite.SetupTest()
+
+	path, msg := suite.setupScheduledTx(channeltypes.ORDERED)
+	msg.ExecutionTime = msg.ExecutionTime.Add(time.Minute)
+
+	controllerKeeper := suite.chainA.GetSimApp().ICAControllerKeeper
+	bankKeeper := suite.chainA.GetSimApp().BankKeeper
+
+	params := controllerKeeper.GetParams(suite.chainA.GetContext())
+	params.ScheduledTxGasLimit = 1000
+	controllerKeeper.SetParams(suite.chainA.GetContext(), params)
+
+	msgServer := keeper.NewMsgServerImpl(&controllerKeeper)
+	res, err := msgServer.ScheduleTx(suite.chainA.GetContext(), msg)
+	suite.Require().NoError(err)
+
+	ctx := suite.chainA.GetContext().WithBlockTime(msg.ExecutionTime)
+	sequence, found := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetNextSequenceSend(ctx, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+	suite.Require().True(found)
+
+	ownerBalance := bankKeeper.GetAllBalances(ctx, suite.chainA.SenderAccount.GetAddress())
+	controllerKeeper.EndBlocker(ctx)
+
+	// the execution runs out of gas, no packet is sent and the execution fee is refunded
+	nextSequence, found := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetNextSequenceSend(ctx, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+	suite.Require().True(found)
+	suite.Require().Equal(sequence, nextSequence)
+
+	_, found = controllerKeeper.GetScheduledTx(ctx, res.ScheduledTxId)
+	suite.Require().False(found)
+	suite.Require().Equal(executionFee, bankKeeper.GetAllBalances(ctx, suite.chainA.SenderAccount.GetAddress()).Sub(ownerBalance...))
+}
@@ -0,0 +1,856 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: ibc/applications/interchain_accounts/controller/v1/authz.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// SendTxAllocation defines the interchain account transactions a grantee is allowed to send from an interchain
+// account on a connection
+type SendTxAllocation struct {
+	// the connection on which the interchain account transactions may be sent
+	ConnectionId string `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	// type URLs of the messages allowed in the interchain account transactions;
+	// a list only with "*" permits any message type
+	AllowedMsgTypeUrls []string `protobuf:"bytes,2,rep,name=allowed_msg_type_urls,json=allowedMsgTypeUrls,proto3" json:"allowed_msg_type_urls,omitempty"`
+	// maximum number of interchain account transactions that may be sent per period
+	MaxTxsPerPeriod uint64 `protobuf:"varint,3,opt,name=max_txs_per_period,json=maxTxsPerPeriod,proto3" json:"max_txs_per_period,omitempty"`
+	// duration of a period
+	Period time.Duration `protobuf:"bytes,4,opt,name=period,proto3,stdduration" json:"period"`
+	// number of interchain account transactions sent in the current period
+	PeriodTxs uint64 `protobuf:"varint,5,opt,name=period_txs,json=periodTxs,proto3" json:"period_txs,omitempty"`
+	// block time at which the current period ends
+	PeriodReset time.Time `protobuf:"bytes,6,opt,name=period_reset,json=periodReset,proto3,stdtime" json:"period_reset"`
+	// encoding of the interchain account transactions, which must be the encoding negotiated in the version
+	// metadata of the channel of the interchain account on the connection
+	Encoding string `protobuf:"bytes,7,opt,name=encoding,proto3" json:"encoding,omitempty"`
+	// account index of the interchain account of the granter on the connection
+	AccountIndex uint64 `protobuf:"varint,8,opt,name=account_index,json=accountIndex,proto3" json:"account_index,omitempty"`
+}
+
+func (m *SendTxAllocation) Reset()         { *m = SendTxAllocation{} }
+func (m *SendTxAllocation) String() string { return proto.CompactTextString(m) }
+func (*SendTxAllocation) ProtoMessage()    {}
+func (*SendTxAllocation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f921fc62dd679fac, []int{0}
+}
+func (m *SendTxAllocation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SendTxAllocation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SendTxAllocation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SendTxAllocation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SendTxAllocation.Merge(m, src)
+}
+func (m *SendTxAllocation) XXX_Size() int {
+	return m.Size()
+}
+func (m *SendTxAllocation) XXX_DiscardUnknown() {
+	xxx_messageInfo_SendTxAllocation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SendTxAllocation proto.InternalMessageInfo
+
+func (m *SendTxAllocation) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
+}
+
+func (m *SendTxAllocation) GetAllowedMsgTypeUrls() []string {
+	if m != nil {
+		return m.AllowedMsgTypeUrls
+	}
+	return nil
+}
+
+func (m *SendTxAllocation) GetMaxTxsPerPeriod() uint64 {
+	if m != nil {
+		return m.MaxTxsPerPeriod
+	}
+	return 0
+}
+
+func (m *SendTxAllocation) GetPeriod() time.Duration {
+	if m != nil {
+		return m.Period
+	}
+	return 0
+}
+
+func (m *SendTxAllocation) GetPeriodTxs() uint64 {
+	if m != nil {
+		return m.PeriodTxs
+	}
+	return 0
+}
+
+func (m *SendTxAllocation) GetPeriodReset() time.Time {
+	if m != nil {
+		return m.PeriodReset
+	}
+	return time.Time{}
+}
+
+func (m *SendTxAllocation) GetEncoding() string {
+	if m != nil {
+		return m.Encoding
+	}
+	return ""
+}
+
+func (m *SendTxAllocation) GetAccountIndex() uint64 {
+	if m != nil {
+		return m.AccountIndex
+	}
+	return 0
+}
+
+// SendTxAuthorization allows the grantee to send interchain account transactions of the granter
+// from specific interchain accounts on specific connections
+type SendTxAuthorization struct {
+	// connection allocations
+	Allocations []SendTxAllocation `protobuf:"bytes,1,rep,name=allocations,proto3" json:"allocations"`
+}
+
+func (m *SendTxAuthorization) Reset()         { *m = SendTxAuthorization{} }
+func (m *SendTxAuthorization) String() string { return proto.CompactTextString(m) }
+func (*SendTxAuthorization) ProtoMessage()    {}
+func (*SendTxAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f921fc62dd679fac, []int{1}
+}
+func (m *SendTxAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SendTxAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SendTxAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SendTxAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SendTxAuthorization.Merge(m, src)
+}
+func (m *SendTxAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *SendTxAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_SendTxAuthorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SendTxAuthorization proto.InternalMessageInfo
+
+func (m *SendTxAuthorization) GetAllocations() []SendTxAllocation {
+	if m != nil {
+		return m.Allocations
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*SendTxAllocation)(nil), "ibc.applications.interchain_accounts.controller.v1.SendTxAllocation")
+	proto.RegisterType((*SendTxAuthorization)(nil), "ibc.applications.interchain_accounts.controller.v1.SendTxAuthorization")
+}
+
+func init() {
+	proto.RegisterFile("ibc/applications/interchain_accounts/controller/v1/authz.proto", fileDescriptor_f921fc62dd679fac)
+}
+
+var fileDescriptor_f921fc62dd679fac = []byte{
+	// 537 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0xcf, 0x8a, 0x13, 0x31,
+	0x18, 0xef, 0xec, 0xae, 0xb5, 0x4d, 0x2b, 0xca, 0xa8, 0x30, 0x5b, 0x70, 0x5a, 0x2a, 0x48, 0x41,
+	0x9a, 0xd0, 0x7a, 0x10, 0x15, 0x04, 0xcb, 0x82, 0xec, 0x41, 0x28, 0x63, 0xbd, 0x78, 0x19, 0x66,
+	0x32, 0x71, 0x1a, 0xc9, 0x4c, 0x86, 0x24, 0x53, 0x67, 0xf7, 0x29, 0xf6, 0xe8, 0x3b, 0x78, 0xf5,
+	0x21, 0x16, 0x4f, 0x7b, 0xf4, 0xa4, 0xd2, 0x3e, 0x87, 0x20, 0x99, 0x64, 0xb7, 0xeb, 0xba, 0x17,
+	0x0f, 0x81, 0x7c, 0xbf, 0x2f, 0xbf, 0xef, 0xf7, 0xfd, 0x0b, 0x78, 0x49, 0x63, 0x8c, 0xa2, 0xa2,
+	0x60, 0x14, 0x47, 0x8a, 0xf2, 0x5c, 0x22, 0x9a, 0x2b, 0x22, 0xf0, 0x32, 0xa2, 0x79, 0x18, 0x61,
+	0xcc, 0xcb, 0x5c, 0x49, 0x84, 0x79, 0xae, 0x04, 0x67, 0x8c, 0x08, 0xb4, 0x9a, 0xa0, 0xa8, 0x54,
+	0xcb, 0x63, 0x58, 0x08, 0xae, 0xb8, 0x3b, 0xa5, 0x31, 0x86, 0x97, 0xf9, 0xf0, 0x1a, 0x3e, 0xdc,
+	0xf2, 0xe1, 0x6a, 0xd2, 0xdb, 0xc7, 0x5c, 0x66, 0x5c, 0x86, 0x75, 0x04, 0x64, 0x0c, 0x13, 0xae,
+	0x77, 0x2f, 0xe5, 0x29, 0x37, 0xb8, 0xbe, 0x59, 0xd4, 0x4f, 0x39, 0x4f, 0x19, 0x41, 0xb5, 0x15,
+	0x97, 0x1f, 0x50, 0x52, 0x8a, 0x5a, 0xcd, 0xfa, 0xfb, 0x57, 0xfd, 0x8a, 0x66, 0x44, 0xaa, 0x28,
+	0x2b, 0xcc, 0x83, 0xe1, 0xef, 0x1d, 0x70, 0xe7, 0x2d, 0xc9, 0x93, 0x45, 0xf5, 0x8a, 0x31, 0x6e,
+	0x32, 0x75, 0x1f, 0x82, 0x5b, 0x98, 0xe7, 0x39, 0xc1, 0xda, 0x0a, 0x69, 0xe2, 0x39, 0x03, 0x67,
+	0xd4, 0x0e, 0xba, 0x5b, 0xf0, 0x30, 0x71, 0x27, 0xe0, 0x7e, 0xc4, 0x18, 0xff, 0x44, 0x92, 0x30,
+	0x93, 0x69, 0xa8, 0x8e, 0x0a, 0x12, 0x96, 0x82, 0x49, 0x6f, 0x67, 0xb0, 0x3b, 0x6a, 0x07, 0xae,
+	0x75, 0xbe, 0x91, 0xe9, 0xe2, 0xa8, 0x20, 0xef, 0x04, 0x93, 0xee, 0x63, 0xe0, 0x66, 0x51, 0x15,
+	0xaa, 0x4a, 0x86, 0x05, 0x11, 0xfa, 0x50, 0x9e, 0x78, 0xbb, 0x03, 0x67, 0xb4, 0x17, 0xdc, 0xce,
+	0xa2, 0x6a, 0x51, 0xc9, 0x39, 0x11, 0xf3, 0x1a, 0x76, 0x5f, 0x80, 0xa6, 0x7d, 0xb0, 0x37, 0x70,
+	0x46, 0x9d, 0xe9, 0x3e, 0x34, 0xb5, 0xc0, 0xf3, 0x5a, 0xe0, 0x81, 0xad, 0x75, 0xd6, 0x3a, 0xfd,
+	0xd1, 0x6f, 0x7c, 0xfe, 0xd9, 0x77, 0x02, 0x4b, 0x71, 0x1f, 0x00, 0x60, 0x6e, 0x5a, 0xcc, 0xbb,
+	0x51, 0x2b, 0xb4, 0x0d, 0xb2, 0xa8, 0xa4, 0xfb, 0x1a, 0x74, 0xad, 0x5b, 0x10, 0x49, 0x94, 0xd7,
+	0xac, 0x15, 0x7a, 0xff, 0x28, 0x2c, 0xce, 0xbb, 0x65, 0x24, 0x4e, 0xb4, 0x44, 0xc7, 0x30, 0x03,
+	0x4d, 0x74, 0x7b, 0xa0, 0x45, 0x72, 0xcc, 0x13, 0x9a, 0xa7, 0xde, 0xcd, 0xba, 0x49, 0x17, 0xb6,
+	0xee, 0xa2, 0x1d, 0x73, 0x48, 0xf3, 0x84, 0x54, 0x5e, 0xab, 0x4e, 0xa3, 0x6b, 0xc1, 0x43, 0x8d,
+	0x0d, 0xbf, 0x38, 0xe0, 0xae, 0xed, 0x7f, 0xa9, 0x96, 0x5c, 0xd0, 0x63, 0x33, 0x02, 0x06, 0x3a,
+	0xd1, 0xc5, 0x40, 0xa4, 0xe7, 0x0c, 0x76, 0x47, 0x9d, 0xe9, 0x01, 0xfc, 0xff, 0x9d, 0x82, 0x57,
+	0xa7, 0x3b, 0xdb, 0xd3, 0xa5, 0x04, 0x97, 0xc3, 0x3f, 0x7f, 0xf4, 0xed, 0xeb, 0x78, 0x68, 0xd7,
+	0xcd, 0xec, 0xf0, 0x6a, 0x12, 0x13, 0x15, 0x4d, 0xe0, 0x5f, 0x59, 0xcd, 0x3e, 0x9e, 0xae, 0x7d,
+	0xe7, 0x6c, 0xed, 0x3b, 0xbf, 0xd6, 0xbe, 0x73, 0xb2, 0xf1, 0x1b, 0x67, 0x1b, 0xbf, 0xf1, 0x7d,
+	0xe3, 0x37, 0xde, 0xcf, 0x53, 0xaa, 0x96, 0x65, 0x0c, 0x31, 0xcf, 0xec, 0xde, 0x22, 0x1a, 0xe3,
+	0x71, 0xca, 0xd1, 0xea, 0x19, 0xca, 0x78, 0x52, 0x32, 0x22, 0xf5, 0x6f, 0x92, 0x68, 0xfa, 0x74,
+	0xbc, 0x4d, 0x7a, 0x7c, 0xdd, 0x47, 0xd2, 0xab, 0x24, 0xe3, 0x66, 0x3d, 0x85, 0x27, 0x7f, 0x06,
+	0x00, 0x2a, 0xa4, 0x10, 0x4b, 0x88, 0x03, 0x00, 0x00,
+}
+
+func (m *SendTxAllocation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SendTxAllocation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SendTxAllocation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AccountIndex != 0 {
+		i = encodeVarintAuthz(dAtA, i, uint64(m.AccountIndex))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Encoding) > 0 {
+		i -= len(m.Encoding)
+		copy(dAtA[i:], m.Encoding)
+		i = encodeVarintAuthz(dAtA, i, uint64(len(m.Encoding)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.PeriodReset, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PeriodReset):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintAuthz(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x32
+	if m.PeriodTxs != 0 {
+		i = encodeVarintAuthz(dAtA, i, uint64(m.PeriodTxs))
+		i--
+		dAtA[i] = 0x28
+	}
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Period, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Period):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintAuthz(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x22
+	if m.MaxTxsPerPeriod != 0 {
+		i = encodeVarintAuthz(dAtA, i, uint64(m.MaxTxsPerPeriod))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.AllowedMsgTypeUrls) > 0 {
+		for iNdEx := len(m.AllowedMsgTypeUrls) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedMsgTypeUrls[iNdEx])
+			copy(dAtA[i:], m.AllowedMsgTypeUrls[iNdEx])
+			i = encodeVarintAuthz(dAtA, i, uint64(len(m.AllowedMsgTypeUrls[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintAuthz(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SendTxAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SendTxAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SendTxAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Allocations) > 0 {
+		for iNdEx := len(m.Allocations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Allocations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAuthz(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAuthz(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SendTxAllocation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	if len(m.AllowedMsgTypeUrls) > 0 {
+		for _, s := range m.AllowedMsgTypeUrls {
+			l = len(s)
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	if m.MaxTxsPerPeriod != 0 {
+		n += 1 + sovAuthz(uint64(m.MaxTxsPerPeriod))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Period)
+	n += 1 + l + sovAuthz(uint64(l))
+	if m.PeriodTxs != 0 {
+		n += 1 + sovAuthz(uint64(m.PeriodTxs))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PeriodReset)
+	n += 1 + l + sovAuthz(uint64(l))
+	l = len(m.Encoding)
+	if l > 0 {
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	if m.AccountIndex != 0 {
+		n += 1 + sovAuthz(uint64(m.AccountIndex))
+	}
+	return n
+}
+
+func (m *SendTxAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Allocations) > 0 {
+		for _, e := range m.Allocations {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovAuthz(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAuthz(x uint64) (n int) {
+	return sovAuthz(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SendTxAllocation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SendTxAllocation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SendTxAllocation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedMsgTypeUrls", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedMsgTypeUrls = append(m.AllowedMsgTypeUrls, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTxsPerPeriod", wireType)
+			}
+			m.MaxTxsPerPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTxsPerPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Period, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodTxs", wireType)
+			}
+			m.PeriodTxs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PeriodTxs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodReset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.PeriodReset, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Encoding", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Encoding = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountIndex", wireType)
+			}
+			m.AccountIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AccountIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SendTxAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SendTxAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SendTxAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allocations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Allocations = append(m.Allocations, SendTxAllocation{})
+			if err := m.Allocations[len(m.Allocations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAuthz(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAuthz
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAuthz
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAuthz
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAuthz        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAuthz          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAuthz = fmt.Errorf("proto: unexpected end of group")
+)
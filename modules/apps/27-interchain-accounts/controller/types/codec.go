@@ -4,6 +4,7 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 )
 
 // RegisterInterfaces registers the interchain accounts controller message types using the provided InterfaceRegistry
@@ -13,6 +14,14 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgRegisterInterchainAccount{},
 		&MsgSendTx{},
 		&MsgUpdateParams{},
+		&MsgScheduleTx{},
+		&MsgCancelScheduledTx{},
 	)
+
+	registry.RegisterImplementations(
+		(*authz.Authorization)(nil),
+		&SendTxAuthorization{},
+	)
+
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
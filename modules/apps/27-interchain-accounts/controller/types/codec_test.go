@@ -34,6 +34,11 @@ func TestCodecTypeRegistration(t *testing.T) {
 			sdk.MsgTypeURL(&types.MsgUpdateParams{}),
 			nil,
 		},
+		{
+			"success: SendTxAuthorization",
+			"/ibc.applications.interchain_accounts.controller.v1.SendTxAuthorization",
+			nil,
+		},
 		{
 			"type not registered on codec",
 			"ibc.invalid.MsgTypeURL",
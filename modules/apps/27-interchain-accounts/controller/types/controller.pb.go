@@ -5,16 +5,25 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	types1 "github.com/cosmos/ibc-go/v9/modules/apps/27-interchain-accounts/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -27,6 +36,20 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type Params struct {
 	// controller_enabled enables or disables the controller submodule.
 	ControllerEnabled bool `protobuf:"varint,1,opt,name=controller_enabled,json=controllerEnabled,proto3" json:"controller_enabled,omitempty"`
+	// reopen_closed_channels enables initiating a new channel handshake for the interchain account when
+	// the timeout of a packet closes its active ORDERED channel.
+	ReopenClosedChannels bool `protobuf:"varint,2,opt,name=reopen_closed_channels,json=reopenClosedChannels,proto3" json:"reopen_closed_channels,omitempty"`
+	// min_execution_fee is the minimum fee which must be charged on each execution of a scheduled transaction.
+	MinExecutionFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=min_execution_fee,json=minExecutionFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"min_execution_fee"`
+	// max_executions_per_block is the maximum number of scheduled transactions executed at the end of a block. Due
+	// scheduled transactions exceeding it remain queued for execution in the following blocks.
+	MaxExecutionsPerBlock uint64 `protobuf:"varint,4,opt,name=max_executions_per_block,json=maxExecutionsPerBlock,proto3" json:"max_executions_per_block,omitempty"`
+	// max_scheduled_txs_per_owner is the maximum number of pending scheduled transactions of a single owner.
+	MaxScheduledTxsPerOwner uint64 `protobuf:"varint,5,opt,name=max_scheduled_txs_per_owner,json=maxScheduledTxsPerOwner,proto3" json:"max_scheduled_txs_per_owner,omitempty"`
+	// scheduled_tx_gas_limit is the maximum gas consumed by a single execution of a scheduled transaction.
+	ScheduledTxGasLimit uint64 `protobuf:"varint,6,opt,name=scheduled_tx_gas_limit,json=scheduledTxGasLimit,proto3" json:"scheduled_tx_gas_limit,omitempty"`
+	// min_interval is the minimum interval between the executions of a recurring scheduled transaction.
+	MinInterval time.Duration `protobuf:"bytes,7,opt,name=min_interval,json=minInterval,proto3,stdduration" json:"min_interval"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -69,8 +92,179 @@ func (m *Params) GetControllerEnabled() bool {
 	return false
 }
 
+func (m *Params) GetReopenClosedChannels() bool {
+	if m != nil {
+		return m.ReopenClosedChannels
+	}
+	return false
+}
+
+func (m *Params) GetMinExecutionFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.MinExecutionFee
+	}
+	return nil
+}
+
+func (m *Params) GetMaxExecutionsPerBlock() uint64 {
+	if m != nil {
+		return m.MaxExecutionsPerBlock
+	}
+	return 0
+}
+
+func (m *Params) GetMaxScheduledTxsPerOwner() uint64 {
+	if m != nil {
+		return m.MaxScheduledTxsPerOwner
+	}
+	return 0
+}
+
+func (m *Params) GetScheduledTxGasLimit() uint64 {
+	if m != nil {
+		return m.ScheduledTxGasLimit
+	}
+	return 0
+}
+
+func (m *Params) GetMinInterval() time.Duration {
+	if m != nil {
+		return m.MinInterval
+	}
+	return 0
+}
+
+// ScheduledTx defines an interchain account transaction which is sent by the controller submodule at a future
+// block time, once or on a recurring interval.
+type ScheduledTx struct {
+	// unique identifier of the scheduled transaction
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// owner of the interchain account the transaction is sent from
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// connection identifier of the interchain account
+	ConnectionId string `protobuf:"bytes,3,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	// account index of the interchain account of the owner
+	AccountIndex uint64 `protobuf:"varint,4,opt,name=account_index,json=accountIndex,proto3" json:"account_index,omitempty"`
+	// packet data sent on each execution
+	PacketData types1.InterchainAccountPacketData `protobuf:"bytes,5,opt,name=packet_data,json=packetData,proto3" json:"packet_data"`
+	// relative timeout added to the block time of each execution to compute the packet timeout timestamp
+	RelativeTimeout uint64 `protobuf:"varint,6,opt,name=relative_timeout,json=relativeTimeout,proto3" json:"relative_timeout,omitempty"`
+	// block time at or after which the next execution takes place
+	NextExecutionTime time.Time `protobuf:"bytes,7,opt,name=next_execution_time,json=nextExecutionTime,proto3,stdtime" json:"next_execution_time"`
+	// interval between recurring executions, zero for a single execution
+	Interval time.Duration `protobuf:"bytes,8,opt,name=interval,proto3,stdduration" json:"interval"`
+	// number of remaining executions
+	RemainingExecutions uint64 `protobuf:"varint,9,opt,name=remaining_executions,json=remainingExecutions,proto3" json:"remaining_executions,omitempty"`
+	// fee charged on each execution, escrowed for all remaining executions
+	ExecutionFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,10,rep,name=execution_fee,json=executionFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"execution_fee"`
+}
+
+func (m *ScheduledTx) Reset()         { *m = ScheduledTx{} }
+func (m *ScheduledTx) String() string { return proto.CompactTextString(m) }
+func (*ScheduledTx) ProtoMessage()    {}
+func (*ScheduledTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_177fd0fec5eb3400, []int{1}
+}
+func (m *ScheduledTx) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduledTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduledTx.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScheduledTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduledTx.Merge(m, src)
+}
+func (m *ScheduledTx) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduledTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduledTx.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduledTx proto.InternalMessageInfo
+
+func (m *ScheduledTx) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *ScheduledTx) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *ScheduledTx) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
+}
+
+func (m *ScheduledTx) GetAccountIndex() uint64 {
+	if m != nil {
+		return m.AccountIndex
+	}
+	return 0
+}
+
+func (m *ScheduledTx) GetPacketData() types1.InterchainAccountPacketData {
+	if m != nil {
+		return m.PacketData
+	}
+	return types1.InterchainAccountPacketData{}
+}
+
+func (m *ScheduledTx) GetRelativeTimeout() uint64 {
+	if m != nil {
+		return m.RelativeTimeout
+	}
+	return 0
+}
+
+func (m *ScheduledTx) GetNextExecutionTime() time.Time {
+	if m != nil {
+		return m.NextExecutionTime
+	}
+	return time.Time{}
+}
+
+func (m *ScheduledTx) GetInterval() time.Duration {
+	if m != nil {
+		return m.Interval
+	}
+	return 0
+}
+
+func (m *ScheduledTx) GetRemainingExecutions() uint64 {
+	if m != nil {
+		return m.RemainingExecutions
+	}
+	return 0
+}
+
+func (m *ScheduledTx) GetExecutionFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.ExecutionFee
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "ibc.applications.interchain_accounts.controller.v1.Params")
+	proto.RegisterType((*ScheduledTx)(nil), "ibc.applications.interchain_accounts.controller.v1.ScheduledTx")
 }
 
 func init() {
@@ -78,21 +272,53 @@ func init() {
 }
 
 var fileDescriptor_177fd0fec5eb3400 = []byte{
-	// 221 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x8f, 0xb1, 0x4e, 0xc3, 0x30,
-	0x10, 0x86, 0xe3, 0xa5, 0x42, 0xd9, 0xc8, 0xc4, 0x64, 0x21, 0x26, 0x96, 0xf8, 0xd4, 0x32, 0x54,
-	0xac, 0x20, 0xf6, 0x8a, 0x91, 0xa5, 0xb2, 0xaf, 0x56, 0x7b, 0xc8, 0xf1, 0x45, 0x3e, 0x27, 0x12,
-	0x6f, 0xc1, 0x63, 0x31, 0x76, 0x64, 0x44, 0xc9, 0x8b, 0x20, 0xe8, 0x90, 0x0c, 0x19, 0x4f, 0x9f,
-	0xee, 0xd3, 0xff, 0x95, 0xcf, 0xe4, 0x10, 0x6c, 0xdb, 0x06, 0x42, 0x9b, 0x89, 0xa3, 0x00, 0xc5,
-	0xec, 0x13, 0x9e, 0x2c, 0xc5, 0xbd, 0x45, 0xe4, 0x2e, 0x66, 0x01, 0xe4, 0x98, 0x13, 0x87, 0xe0,
-	0x13, 0xf4, 0xeb, 0xd9, 0x65, 0xda, 0xc4, 0x99, 0xab, 0x0d, 0x39, 0x34, 0x73, 0x89, 0x59, 0x90,
-	0x98, 0xd9, 0x5b, 0xbf, 0xbe, 0xdb, 0x96, 0xab, 0x9d, 0x4d, 0xb6, 0x91, 0xaa, 0x2e, 0xab, 0x09,
-	0xed, 0x7d, 0xb4, 0x2e, 0xf8, 0xc3, 0x8d, 0xba, 0x55, 0xf7, 0x57, 0xaf, 0xd7, 0x13, 0x79, 0xb9,
-	0x80, 0xa7, 0xf7, 0xaf, 0x41, 0xab, 0xf3, 0xa0, 0xd5, 0xcf, 0xa0, 0xd5, 0xe7, 0xa8, 0x8b, 0xf3,
-	0xa8, 0x8b, 0xef, 0x51, 0x17, 0x6f, 0xbb, 0x23, 0xe5, 0x53, 0xe7, 0x0c, 0x72, 0x03, 0xc8, 0xd2,
-	0xb0, 0x00, 0x39, 0xac, 0x8f, 0x0c, 0xfd, 0x23, 0x34, 0x7c, 0xe8, 0x82, 0x97, 0xbf, 0x56, 0x81,
-	0xcd, 0xb6, 0x9e, 0x16, 0xd6, 0x4b, 0x99, 0xf9, 0xa3, 0xf5, 0xe2, 0x56, 0xff, 0x7d, 0x0f, 0xbf,
-	0x01, 0x00, 0x00, 0xff, 0xff, 0x0d, 0xbb, 0xb2, 0xf3, 0x26, 0x01, 0x00, 0x00,
+	// 736 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x41, 0x8f, 0xdb, 0x44,
+	0x14, 0x8e, 0x37, 0xe9, 0x92, 0x4e, 0x52, 0xca, 0x4e, 0x43, 0x31, 0x8b, 0x94, 0x44, 0xe5, 0x12,
+	0x0e, 0xb1, 0x49, 0x5a, 0xa9, 0x42, 0x42, 0x42, 0x24, 0xdb, 0xa2, 0x48, 0x48, 0x44, 0x21, 0x27,
+	0x2e, 0xd6, 0x78, 0xfc, 0xea, 0x0c, 0xb1, 0x67, 0x2c, 0xcf, 0xc4, 0x35, 0xff, 0xa2, 0x47, 0x7e,
+	0x03, 0xbf, 0xa4, 0xc7, 0x72, 0x43, 0x1c, 0x28, 0xda, 0xfd, 0x23, 0x68, 0x66, 0xec, 0xc4, 0xd0,
+	0x1e, 0xf6, 0xc0, 0xc9, 0x9e, 0xf7, 0xcd, 0xf7, 0x66, 0xde, 0xf7, 0xbe, 0x37, 0x68, 0xc9, 0x42,
+	0xea, 0x93, 0x2c, 0x4b, 0x18, 0x25, 0x8a, 0x09, 0x2e, 0x7d, 0xc6, 0x15, 0xe4, 0x74, 0x47, 0x18,
+	0x0f, 0x08, 0xa5, 0xe2, 0xc0, 0x95, 0xf4, 0xa9, 0xe0, 0x2a, 0x17, 0x49, 0x02, 0xb9, 0x5f, 0xcc,
+	0x1a, 0x2b, 0x2f, 0xcb, 0x85, 0x12, 0x78, 0xce, 0x42, 0xea, 0x35, 0x93, 0x78, 0xef, 0x49, 0xe2,
+	0x35, 0x68, 0xc5, 0xec, 0x72, 0x10, 0x8b, 0x58, 0x18, 0xba, 0xaf, 0xff, 0x6c, 0xa6, 0xcb, 0x61,
+	0x2c, 0x44, 0x9c, 0x80, 0x6f, 0x56, 0xe1, 0xe1, 0x85, 0x1f, 0x1d, 0x72, 0x93, 0xb2, 0xc2, 0x47,
+	0xff, 0xc5, 0x15, 0x4b, 0x41, 0x2a, 0x92, 0x66, 0x75, 0x02, 0x2a, 0x64, 0x2a, 0xa4, 0x1f, 0x12,
+	0x09, 0x7e, 0x31, 0x0b, 0x41, 0x11, 0x7d, 0x61, 0x56, 0x27, 0x78, 0x72, 0xab, 0x7a, 0x8b, 0x99,
+	0x9f, 0x11, 0xba, 0x07, 0x65, 0x59, 0x8f, 0xfe, 0x6c, 0xa3, 0xf3, 0x35, 0xc9, 0x49, 0x2a, 0xf1,
+	0x14, 0xe1, 0x53, 0x21, 0x01, 0x70, 0x12, 0x26, 0x10, 0xb9, 0xce, 0xd8, 0x99, 0x74, 0x37, 0x17,
+	0x27, 0xe4, 0x99, 0x05, 0xf0, 0x13, 0xf4, 0x30, 0x07, 0x91, 0x01, 0x0f, 0x68, 0x22, 0x24, 0x44,
+	0x01, 0xdd, 0x11, 0xce, 0x21, 0x91, 0xee, 0x99, 0xa1, 0x0c, 0x2c, 0xba, 0x34, 0xe0, 0xb2, 0xc2,
+	0xf0, 0x4b, 0x74, 0x91, 0x32, 0x1e, 0x40, 0x09, 0xf4, 0xa0, 0x6f, 0x19, 0xbc, 0x00, 0x70, 0xdb,
+	0xe3, 0xf6, 0xa4, 0x37, 0xff, 0xd4, 0xb3, 0x15, 0x7a, 0xba, 0x42, 0xaf, 0xaa, 0xd0, 0x5b, 0x0a,
+	0xc6, 0x17, 0x5f, 0xbe, 0xfe, 0x6b, 0xd4, 0xfa, 0xed, 0xed, 0x68, 0x12, 0x33, 0xb5, 0x3b, 0x84,
+	0x1e, 0x15, 0xa9, 0x5f, 0xc9, 0x61, 0x3f, 0x53, 0x19, 0xed, 0x7d, 0xf5, 0x4b, 0x06, 0xd2, 0x10,
+	0xe4, 0xe6, 0x7e, 0xca, 0xf8, 0xb3, 0xfa, 0x90, 0xe7, 0x00, 0xf8, 0x29, 0x72, 0x53, 0x52, 0x9e,
+	0x0e, 0x96, 0x41, 0x06, 0x79, 0x10, 0x26, 0x82, 0xee, 0xdd, 0xce, 0xd8, 0x99, 0x74, 0x36, 0x1f,
+	0xa7, 0xa4, 0x3c, 0x52, 0xe4, 0x1a, 0xf2, 0x85, 0x06, 0xf1, 0xd7, 0xe8, 0x33, 0x4d, 0x94, 0x74,
+	0x07, 0xd1, 0x21, 0x81, 0x28, 0x50, 0xa5, 0xe5, 0x8a, 0x97, 0x1c, 0x72, 0xf7, 0x8e, 0xe1, 0x7e,
+	0x92, 0x92, 0xf2, 0xc7, 0x7a, 0xc7, 0xb6, 0xd4, 0xec, 0x1f, 0x34, 0x8c, 0x1f, 0xa3, 0x87, 0x4d,
+	0x66, 0x10, 0x13, 0x19, 0x24, 0x2c, 0x65, 0xca, 0x3d, 0x37, 0xc4, 0x07, 0xf2, 0xc4, 0xfa, 0x8e,
+	0xc8, 0xef, 0x35, 0x84, 0x9f, 0xa3, 0xbe, 0x16, 0xc9, 0xf4, 0xaf, 0x20, 0x89, 0xfb, 0xc1, 0xd8,
+	0x31, 0xfa, 0x58, 0x8b, 0x78, 0xb5, 0x45, 0xbc, 0xab, 0xca, 0x42, 0x8b, 0xae, 0xd6, 0xe7, 0xd7,
+	0xb7, 0x23, 0x67, 0xd3, 0x4b, 0x19, 0x5f, 0x55, 0xbc, 0x47, 0xbf, 0x77, 0x50, 0xaf, 0x71, 0x2b,
+	0xfc, 0x21, 0x3a, 0x63, 0xb6, 0xa3, 0x9d, 0xcd, 0x19, 0x8b, 0xf0, 0x00, 0xdd, 0xb1, 0x45, 0xe8,
+	0x8e, 0xdd, 0xdd, 0xd8, 0x05, 0xfe, 0x1c, 0xdd, 0xa3, 0x82, 0x73, 0xa0, 0xa6, 0x3f, 0x2c, 0x72,
+	0xdb, 0x06, 0xed, 0x9f, 0x82, 0xab, 0x48, 0x6f, 0xaa, 0x3c, 0x15, 0x30, 0x1e, 0x41, 0x59, 0x69,
+	0xd8, 0xaf, 0x82, 0x2b, 0x1d, 0xc3, 0x7b, 0xd4, 0xb3, 0x66, 0x0b, 0x22, 0xa2, 0x88, 0x91, 0xaa,
+	0x37, 0xbf, 0xf2, 0x6e, 0x35, 0x53, 0xc5, 0xcc, 0x5b, 0x1d, 0xc3, 0xdf, 0xda, 0xe8, 0xda, 0x24,
+	0xbb, 0x22, 0x8a, 0x2c, 0x3a, 0xba, 0xe2, 0x0d, 0xca, 0x8e, 0x11, 0xfc, 0x05, 0xfa, 0x28, 0x87,
+	0x84, 0x28, 0x56, 0x40, 0xa0, 0x67, 0x47, 0x1c, 0x6a, 0x8d, 0xef, 0xd7, 0xf1, 0xad, 0x0d, 0xe3,
+	0x2d, 0x7a, 0xc0, 0xa1, 0x54, 0x0d, 0x17, 0x6a, 0x42, 0x25, 0xf3, 0xe5, 0x3b, 0x32, 0x6f, 0xeb,
+	0x49, 0xb4, 0x3a, 0xbf, 0xd2, 0x3a, 0x5f, 0xe8, 0x04, 0x47, 0xb7, 0xe8, 0x1d, 0xf8, 0x1b, 0xd4,
+	0x3d, 0x76, 0xac, 0x7b, 0xfb, 0x8e, 0x1d, 0x49, 0x78, 0x86, 0x06, 0x39, 0xa4, 0x84, 0x71, 0xc6,
+	0xe3, 0x86, 0x51, 0xdd, 0xbb, 0xd6, 0x29, 0x47, 0xec, 0x64, 0x52, 0x9c, 0xa1, 0x7b, 0xff, 0x1e,
+	0x25, 0xf4, 0xff, 0x8f, 0x52, 0x1f, 0x1a, 0x73, 0xb4, 0xf8, 0xf9, 0xf5, 0xf5, 0xd0, 0x79, 0x73,
+	0x3d, 0x74, 0xfe, 0xbe, 0x1e, 0x3a, 0xaf, 0x6e, 0x86, 0xad, 0x37, 0x37, 0xc3, 0xd6, 0x1f, 0x37,
+	0xc3, 0xd6, 0x4f, 0xeb, 0x77, 0x33, 0xb2, 0x90, 0x4e, 0x63, 0xe1, 0x17, 0x5f, 0xf9, 0xa9, 0xd0,
+	0x26, 0x94, 0xfa, 0x81, 0x92, 0xfe, 0xfc, 0xe9, 0xf4, 0xd4, 0xf2, 0xe9, 0xfb, 0xde, 0x62, 0x73,
+	0x7e, 0x78, 0x6e, 0x74, 0x7b, 0xfc, 0xcf, 0x00, 0x5b, 0xc5, 0x75, 0x45, 0xcb, 0x05, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -115,6 +341,53 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MinInterval, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MinInterval):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintController(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x3a
+	if m.ScheduledTxGasLimit != 0 {
+		i = encodeVarintController(dAtA, i, uint64(m.ScheduledTxGasLimit))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MaxScheduledTxsPerOwner != 0 {
+		i = encodeVarintController(dAtA, i, uint64(m.MaxScheduledTxsPerOwner))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MaxExecutionsPerBlock != 0 {
+		i = encodeVarintController(dAtA, i, uint64(m.MaxExecutionsPerBlock))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.MinExecutionFee) > 0 {
+		for iNdEx := len(m.MinExecutionFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinExecutionFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintController(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.ReopenClosedChannels {
+		i--
+		if m.ReopenClosedChannels {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
 	if m.ControllerEnabled {
 		i--
 		if m.ControllerEnabled {
@@ -128,6 +401,103 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ScheduledTx) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduledTx) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduledTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ExecutionFee) > 0 {
+		for iNdEx := len(m.ExecutionFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExecutionFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintController(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.RemainingExecutions != 0 {
+		i = encodeVarintController(dAtA, i, uint64(m.RemainingExecutions))
+		i--
+		dAtA[i] = 0x48
+	}
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Interval, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Interval):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintController(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x42
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.NextExecutionTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.NextExecutionTime):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintController(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x3a
+	if m.RelativeTimeout != 0 {
+		i = encodeVarintController(dAtA, i, uint64(m.RelativeTimeout))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size, err := m.PacketData.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintController(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.AccountIndex != 0 {
+		i = encodeVarintController(dAtA, i, uint64(m.AccountIndex))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintController(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintController(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintController(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintController(dAtA []byte, offset int, v uint64) int {
 	offset -= sovController(v)
 	base := offset
@@ -148,6 +518,67 @@ func (m *Params) Size() (n int) {
 	if m.ControllerEnabled {
 		n += 2
 	}
+	if m.ReopenClosedChannels {
+		n += 2
+	}
+	if len(m.MinExecutionFee) > 0 {
+		for _, e := range m.MinExecutionFee {
+			l = e.Size()
+			n += 1 + l + sovController(uint64(l))
+		}
+	}
+	if m.MaxExecutionsPerBlock != 0 {
+		n += 1 + sovController(uint64(m.MaxExecutionsPerBlock))
+	}
+	if m.MaxScheduledTxsPerOwner != 0 {
+		n += 1 + sovController(uint64(m.MaxScheduledTxsPerOwner))
+	}
+	if m.ScheduledTxGasLimit != 0 {
+		n += 1 + sovController(uint64(m.ScheduledTxGasLimit))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MinInterval)
+	n += 1 + l + sovController(uint64(l))
+	return n
+}
+
+func (m *ScheduledTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovController(uint64(m.Id))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovController(uint64(l))
+	}
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovController(uint64(l))
+	}
+	if m.AccountIndex != 0 {
+		n += 1 + sovController(uint64(m.AccountIndex))
+	}
+	l = m.PacketData.Size()
+	n += 1 + l + sovController(uint64(l))
+	if m.RelativeTimeout != 0 {
+		n += 1 + sovController(uint64(m.RelativeTimeout))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.NextExecutionTime)
+	n += 1 + l + sovController(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Interval)
+	n += 1 + l + sovController(uint64(l))
+	if m.RemainingExecutions != 0 {
+		n += 1 + sovController(uint64(m.RemainingExecutions))
+	}
+	if len(m.ExecutionFee) > 0 {
+		for _, e := range m.ExecutionFee {
+			l = e.Size()
+			n += 1 + l + sovController(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -206,6 +637,473 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.ControllerEnabled = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReopenClosedChannels", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ReopenClosedChannels = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinExecutionFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthController
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthController
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinExecutionFee = append(m.MinExecutionFee, types.Coin{})
+			if err := m.MinExecutionFee[len(m.MinExecutionFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxExecutionsPerBlock", wireType)
+			}
+			m.MaxExecutionsPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxExecutionsPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxScheduledTxsPerOwner", wireType)
+			}
+			m.MaxScheduledTxsPerOwner = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxScheduledTxsPerOwner |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledTxGasLimit", wireType)
+			}
+			m.ScheduledTxGasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScheduledTxGasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinInterval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthController
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthController
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.MinInterval, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipController(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthController
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScheduledTx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowController
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduledTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduledTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthController
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthController
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthController
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthController
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountIndex", wireType)
+			}
+			m.AccountIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AccountIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketData", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthController
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthController
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PacketData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelativeTimeout", wireType)
+			}
+			m.RelativeTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RelativeTimeout |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextExecutionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthController
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthController
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.NextExecutionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthController
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthController
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Interval, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingExecutions", wireType)
+			}
+			m.RemainingExecutions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RemainingExecutions |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthController
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthController
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExecutionFee = append(m.ExecutionFee, types.Coin{})
+			if err := m.ExecutionFee[len(m.ExecutionFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipController(dAtA[iNdEx:])
@@ -7,4 +7,7 @@ import (
 // ICA Controller sentinel errors
 var (
 	ErrControllerSubModuleDisabled = errorsmod.Register(SubModuleName, 2, "controller submodule is disabled")
+	ErrScheduledTxNotFound         = errorsmod.Register(SubModuleName, 3, "scheduled transaction not found")
+	ErrInvalidScheduledTx          = errorsmod.Register(SubModuleName, 4, "invalid scheduled transaction")
+	ErrInvalidAuthorization        = errorsmod.Register(SubModuleName, 5, "invalid send tx authorization")
 )
@@ -1,5 +1,12 @@
 package types
 
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
 const (
 	// SubModuleName defines the interchain accounts controller module name
 	SubModuleName = "icacontroller"
@@ -9,4 +16,52 @@ const (
 
 	// ParamsKey is the store key for the interchain accounts controller parameters
 	ParamsKey = "params"
+
+	// ScheduledTxKeyPrefix defines the key prefix used to store scheduled transactions
+	ScheduledTxKeyPrefix = "scheduledTx"
+
+	// ScheduledTxOwnerKeyPrefix defines the key prefix used to index scheduled transactions by owner
+	ScheduledTxOwnerKeyPrefix = "scheduledTxOwner"
+
+	// ScheduledTxQueueKeyPrefix defines the key prefix used to index scheduled transactions by next execution time
+	ScheduledTxQueueKeyPrefix = "scheduledTxQueue"
+
+	// NextScheduledTxIDKey defines the key used to store the identifier of the next scheduled transaction
+	NextScheduledTxIDKey = "nextScheduledTxID"
+
+	// scheduledTxEscrowKey is the key used when generating the escrow address of scheduled transaction fees
+	scheduledTxEscrowKey = "scheduled-tx-escrow"
 )
+
+// KeyScheduledTx creates and returns a new key used for storing the scheduled transaction with the provided identifier
+func KeyScheduledTx(id uint64) []byte {
+	return append([]byte(ScheduledTxKeyPrefix+"/"), sdk.Uint64ToBigEndian(id)...)
+}
+
+// KeyScheduledTxOwnerPrefix creates and returns the key prefix of the scheduled transactions of the provided owner
+func KeyScheduledTxOwnerPrefix(owner string) []byte {
+	return []byte(ScheduledTxOwnerKeyPrefix + "/" + owner + "/")
+}
+
+// KeyScheduledTxOwner creates and returns a new key used for indexing the scheduled transaction with the provided
+// identifier by its owner
+func KeyScheduledTxOwner(owner string, id uint64) []byte {
+	return append(KeyScheduledTxOwnerPrefix(owner), sdk.Uint64ToBigEndian(id)...)
+}
+
+// KeyScheduledTxQueuePrefix returns the key prefix of the scheduled transaction queue
+func KeyScheduledTxQueuePrefix() []byte {
+	return []byte(ScheduledTxQueueKeyPrefix + "/")
+}
+
+// KeyScheduledTxQueue creates and returns a new key used for indexing the scheduled transaction with the provided
+// identifier by its next execution time
+func KeyScheduledTxQueue(executionTime time.Time, id uint64) []byte {
+	key := append(KeyScheduledTxQueuePrefix(), sdk.FormatTimeBytes(executionTime)...)
+	return append(key, sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetScheduledTxEscrowAddress returns the address escrowing the execution fees of scheduled transactions
+func GetScheduledTxEscrowAddress() sdk.AccAddress {
+	return address.Module(SubModuleName, []byte(scheduledTxEscrowKey))
+}
@@ -3,6 +3,7 @@ package types
 import (
 	"slices"
 	"strings"
+	"time"
 
 	errorsmod "cosmossdk.io/errors"
 
@@ -20,10 +21,14 @@ var (
 	_ sdk.Msg = (*MsgRegisterInterchainAccount)(nil)
 	_ sdk.Msg = (*MsgSendTx)(nil)
 	_ sdk.Msg = (*MsgUpdateParams)(nil)
+	_ sdk.Msg = (*MsgScheduleTx)(nil)
+	_ sdk.Msg = (*MsgCancelScheduledTx)(nil)
 
 	_ sdk.HasValidateBasic = (*MsgRegisterInterchainAccount)(nil)
 	_ sdk.HasValidateBasic = (*MsgSendTx)(nil)
 	_ sdk.HasValidateBasic = (*MsgUpdateParams)(nil)
+	_ sdk.HasValidateBasic = (*MsgScheduleTx)(nil)
+	_ sdk.HasValidateBasic = (*MsgCancelScheduledTx)(nil)
 )
 
 // NewMsgRegisterInterchainAccount creates a new instance of MsgRegisterInterchainAccount
@@ -107,5 +112,73 @@ func (msg MsgUpdateParams) ValidateBasic() error {
 		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
 	}
 
+	return msg.Params.Validate()
+}
+
+// NewMsgScheduleTx creates a new instance of MsgScheduleTx
+func NewMsgScheduleTx(owner, connectionID string, relativeTimeoutTimestamp uint64, packetData icatypes.InterchainAccountPacketData, executionTime time.Time, interval time.Duration, executions uint64, executionFee sdk.Coins) *MsgScheduleTx {
+	return &MsgScheduleTx{
+		Owner:           owner,
+		ConnectionId:    connectionID,
+		PacketData:      packetData,
+		RelativeTimeout: relativeTimeoutTimestamp,
+		ExecutionTime:   executionTime,
+		Interval:        interval,
+		Executions:      executions,
+		ExecutionFee:    executionFee,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgScheduleTx) ValidateBasic() error {
+	if err := host.ConnectionIdentifierValidator(msg.ConnectionId); err != nil {
+		return errorsmod.Wrap(err, "invalid connection ID")
+	}
+
+	if strings.TrimSpace(msg.Owner) == "" {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidAddress, "owner address cannot be empty")
+	}
+
+	if len(msg.Owner) > MaximumOwnerLength {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "owner address must not exceed %d bytes", MaximumOwnerLength)
+	}
+
+	if err := msg.PacketData.ValidateBasic(); err != nil {
+		return errorsmod.Wrap(err, "invalid interchain account packet data")
+	}
+
+	if msg.RelativeTimeout == 0 {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidRequest, "relative timeout cannot be zero")
+	}
+
+	if msg.ExecutionTime.IsZero() {
+		return errorsmod.Wrap(ErrInvalidScheduledTx, "execution time cannot be zero")
+	}
+
+	if err := validateExecutions(msg.Executions, msg.Interval.Nanoseconds()); err != nil {
+		return err
+	}
+
+	if err := msg.ExecutionFee.Validate(); err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidCoins, "invalid execution fee: %s", err)
+	}
+
+	return nil
+}
+
+// NewMsgCancelScheduledTx creates a new instance of MsgCancelScheduledTx
+func NewMsgCancelScheduledTx(owner string, scheduledTxID uint64) *MsgCancelScheduledTx {
+	return &MsgCancelScheduledTx{
+		Owner:         owner,
+		ScheduledTxId: scheduledTxID,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgCancelScheduledTx) ValidateBasic() error {
+	if strings.TrimSpace(msg.Owner) == "" {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidAddress, "owner address cannot be empty")
+	}
+
 	return nil
 }
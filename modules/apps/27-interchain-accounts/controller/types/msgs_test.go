@@ -3,10 +3,13 @@ package types_test
 import (
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/cosmos/gogoproto/proto"
 	"github.com/stretchr/testify/require"
 
+	sdkmath "cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
@@ -234,6 +237,177 @@ func TestMsgSendTxGetSigners(t *testing.T) {
 	require.Equal(t, expSigner.Bytes(), signers[0])
 }
 
+func TestMsgScheduleTxValidateBasic(t *testing.T) {
+	var msg *types.MsgScheduleTx
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"success: recurring executions",
+			func() {
+				msg.Interval = time.Hour
+				msg.Executions = 10
+			},
+			nil,
+		},
+		{
+			"success: no execution fee",
+			func() {
+				msg.ExecutionFee = nil
+			},
+			nil,
+		},
+		{
+			"connection id is invalid",
+			func() {
+				msg.ConnectionId = ""
+			},
+			host.ErrInvalidID,
+		},
+		{
+			"owner address is empty",
+			func() {
+				msg.Owner = ""
+			},
+			ibcerrors.ErrInvalidAddress,
+		},
+		{
+			"owner address is too long",
+			func() {
+				msg.Owner = ibctesting.GenerateString(types.MaximumOwnerLength + 1)
+			},
+			ibcerrors.ErrInvalidAddress,
+		},
+		{
+			"messages array is empty",
+			func() {
+				msg.PacketData = icatypes.InterchainAccountPacketData{}
+			},
+			icatypes.ErrInvalidOutgoingData,
+		},
+		{
+			"relative timeout is not set",
+			func() {
+				msg.RelativeTimeout = 0
+			},
+			ibcerrors.ErrInvalidRequest,
+		},
+		{
+			"execution time is not set",
+			func() {
+				msg.ExecutionTime = time.Time{}
+			},
+			types.ErrInvalidScheduledTx,
+		},
+		{
+			"executions is zero",
+			func() {
+				msg.Executions = 0
+			},
+			types.ErrInvalidScheduledTx,
+		},
+		{
+			"interval is negative",
+			func() {
+				msg.Interval = -time.Hour
+			},
+			types.ErrInvalidScheduledTx,
+		},
+		{
+			"interval is zero for recurring executions",
+			func() {
+				msg.Executions = 2
+			},
+			types.ErrInvalidScheduledTx,
+		},
+		{
+			"execution fee is invalid",
+			func() {
+				msg.ExecutionFee = sdk.Coins{sdk.Coin{Denom: "invalid denom", Amount: sdkmath.NewInt(100)}}
+			},
+			ibcerrors.ErrInvalidCoins,
+		},
+	}
+
+	for i, tc := range testCases {
+		i, tc := i, tc
+
+		msgBankSend := &banktypes.MsgSend{
+			FromAddress: ibctesting.TestAccAddress,
+			ToAddress:   ibctesting.TestAccAddress,
+			Amount:      ibctesting.TestCoins,
+		}
+
+		encodingConfig := moduletestutil.MakeTestEncodingConfig(ica.AppModuleBasic{})
+
+		data, err := icatypes.SerializeCosmosTx(encodingConfig.Codec, []proto.Message{msgBankSend}, icatypes.EncodingProtobuf)
+		require.NoError(t, err)
+
+		packetData := icatypes.InterchainAccountPacketData{
+			Type: icatypes.EXECUTE_TX,
+			Data: data,
+		}
+
+		msg = types.NewMsgScheduleTx(
+			ibctesting.TestAccAddress,
+			ibctesting.FirstConnectionID,
+			100000,
+			packetData,
+			time.Unix(1700000000, 0).UTC(),
+			0,
+			1,
+			ibctesting.TestCoins,
+		)
+
+		tc.malleate()
+
+		err = msg.ValidateBasic()
+		if tc.expErr == nil {
+			require.NoError(t, err, "valid test case %d failed: %s", i, tc.name)
+		} else {
+			require.ErrorIs(t, err, tc.expErr, "invalid test case %d passed: %s", i, tc.name)
+		}
+	}
+}
+
+func TestMsgCancelScheduledTxValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name   string
+		msg    *types.MsgCancelScheduledTx
+		expErr error
+	}{
+		{
+			"success",
+			types.NewMsgCancelScheduledTx(ibctesting.TestAccAddress, 1),
+			nil,
+		},
+		{
+			"owner address is empty",
+			types.NewMsgCancelScheduledTx("", 1),
+			ibcerrors.ErrInvalidAddress,
+		},
+	}
+
+	for i, tc := range testCases {
+		i, tc := i, tc
+
+		err := tc.msg.ValidateBasic()
+		if tc.expErr == nil {
+			require.NoError(t, err, "valid test case %d failed: %s", i, tc.name)
+		} else {
+			require.ErrorIs(t, err, tc.expErr, "invalid test case %d passed: %s", i, tc.name)
+		}
+	}
+}
+
 // TestMsgUpdateParamsValidateBasic tests ValidateBasic for MsgUpdateParams
 func TestMsgUpdateParamsValidateBasic(t *testing.T) {
 	testCases := []struct {
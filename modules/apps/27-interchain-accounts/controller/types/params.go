@@ -1,14 +1,41 @@
 package types
 
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	ibcerrors "github.com/cosmos/ibc-go/v9/modules/core/errors"
+)
+
 const (
 	// DefaultControllerEnabled is the default value for the controller param (set to true)
 	DefaultControllerEnabled = true
+	// DefaultMaxExecutionsPerBlock is the default maximum number of scheduled transactions executed in a block
+	DefaultMaxExecutionsPerBlock = 100
+	// DefaultMaxScheduledTxsPerOwner is the default maximum number of pending scheduled transactions of an owner
+	DefaultMaxScheduledTxsPerOwner = 10
+	// DefaultScheduledTxGasLimit is the default maximum gas consumed by a single execution of a scheduled transaction
+	DefaultScheduledTxGasLimit = 1_000_000
+	// DefaultMinExecutionFeeAmount is the default minimum fee amount, in the default bond denomination, charged on each
+	// execution of a scheduled transaction
+	DefaultMinExecutionFeeAmount = 1000
+	// DefaultMinInterval is the default minimum interval between the executions of a recurring scheduled transaction
+	DefaultMinInterval = time.Minute
 )
 
 // NewParams creates a new parameter configuration for the controller submodule
+// using the default scheduled transaction limits and fees
 func NewParams(enableController bool) Params {
 	return Params{
-		ControllerEnabled: enableController,
+		ControllerEnabled:       enableController,
+		MaxExecutionsPerBlock:   DefaultMaxExecutionsPerBlock,
+		MaxScheduledTxsPerOwner: DefaultMaxScheduledTxsPerOwner,
+		ScheduledTxGasLimit:     DefaultScheduledTxGasLimit,
+		MinExecutionFee:         sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, DefaultMinExecutionFeeAmount)),
+		MinInterval:             DefaultMinInterval,
 	}
 }
 
@@ -16,3 +43,32 @@ func NewParams(enableController bool) Params {
 func DefaultParams() Params {
 	return NewParams(DefaultControllerEnabled)
 }
+
+// Validate validates all controller submodule parameters
+func (p Params) Validate() error {
+	if err := p.MinExecutionFee.Validate(); err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidCoins, "invalid minimum execution fee: %s", err)
+	}
+
+	if p.MinExecutionFee.IsZero() {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidCoins, "minimum execution fee cannot be empty")
+	}
+
+	if p.MaxExecutionsPerBlock == 0 {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidRequest, "maximum executions per block cannot be zero")
+	}
+
+	if p.MaxScheduledTxsPerOwner == 0 {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidRequest, "maximum scheduled transactions per owner cannot be zero")
+	}
+
+	if p.ScheduledTxGasLimit == 0 {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidRequest, "scheduled transaction gas limit cannot be zero")
+	}
+
+	if p.MinInterval <= 0 {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidRequest, "minimum interval must be positive")
+	}
+
+	return nil
+}
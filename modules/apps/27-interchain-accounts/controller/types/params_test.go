@@ -0,0 +1,44 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v9/modules/apps/27-interchain-accounts/controller/types"
+)
+
+func TestValidateParams(t *testing.T) {
+	require.NoError(t, types.DefaultParams().Validate())
+	require.NoError(t, types.NewParams(false).Validate())
+
+	params := types.DefaultParams()
+	params.MinExecutionFee = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100)))
+	require.NoError(t, params.Validate())
+
+	params.MinExecutionFee = sdk.Coins{sdk.Coin{Denom: sdk.DefaultBondDenom, Amount: sdkmath.NewInt(-1)}}
+	require.Error(t, params.Validate())
+
+	params.MinExecutionFee = sdk.NewCoins()
+	require.Error(t, params.Validate())
+
+	params = types.DefaultParams()
+	params.MaxExecutionsPerBlock = 0
+	require.Error(t, params.Validate())
+
+	params = types.DefaultParams()
+	params.MaxScheduledTxsPerOwner = 0
+	require.Error(t, params.Validate())
+
+	params = types.DefaultParams()
+	params.ScheduledTxGasLimit = 0
+	require.Error(t, params.Validate())
+
+	params = types.DefaultParams()
+	params.MinInterval = 0
+	require.Error(t, params.Validate())
+}
@@ -6,6 +6,8 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
@@ -32,6 +34,8 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type QueryInterchainAccountRequest struct {
 	Owner        string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
 	ConnectionId string `protobuf:"bytes,2,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	// account index of the interchain account of the owner.
+	AccountIndex uint64 `protobuf:"varint,3,opt,name=account_index,json=accountIndex,proto3" json:"account_index,omitempty"`
 }
 
 func (m *QueryInterchainAccountRequest) Reset()         { *m = QueryInterchainAccountRequest{} }
@@ -81,6 +85,13 @@ func (m *QueryInterchainAccountRequest) GetConnectionId() string {
 	return ""
 }
 
+func (m *QueryInterchainAccountRequest) GetAccountIndex() uint64 {
+	if m != nil {
+		return m.AccountIndex
+	}
+	return 0
+}
+
 // QueryInterchainAccountResponse the response type for the Query/InterchainAccount RPC method.
 type QueryInterchainAccountResponse struct {
 	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
@@ -209,11 +220,296 @@ func (m *QueryParamsResponse) GetParams() *Params {
 	return nil
 }
 
+// QueryClosedActiveChannelsRequest is the request type for the Query/ClosedActiveChannels RPC method.
+type QueryClosedActiveChannelsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryClosedActiveChannelsRequest) Reset()         { *m = QueryClosedActiveChannelsRequest{} }
+func (m *QueryClosedActiveChannelsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryClosedActiveChannelsRequest) ProtoMessage()    {}
+func (*QueryClosedActiveChannelsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df0d8b259d72854e, []int{4}
+}
+func (m *QueryClosedActiveChannelsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryClosedActiveChannelsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClosedActiveChannelsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryClosedActiveChannelsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClosedActiveChannelsRequest.Merge(m, src)
+}
+func (m *QueryClosedActiveChannelsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClosedActiveChannelsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClosedActiveChannelsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryClosedActiveChannelsRequest proto.InternalMessageInfo
+
+func (m *QueryClosedActiveChannelsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryClosedActiveChannelsResponse is the response type for the Query/ClosedActiveChannels RPC method.
+type QueryClosedActiveChannelsResponse struct {
+	// channels defines the interchain accounts whose active channel is closed.
+	Channels []ClosedActiveChannel `protobuf:"bytes,1,rep,name=channels,proto3" json:"channels"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryClosedActiveChannelsResponse) Reset()         { *m = QueryClosedActiveChannelsResponse{} }
+func (m *QueryClosedActiveChannelsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryClosedActiveChannelsResponse) ProtoMessage()    {}
+func (*QueryClosedActiveChannelsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df0d8b259d72854e, []int{5}
+}
+func (m *QueryClosedActiveChannelsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryClosedActiveChannelsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClosedActiveChannelsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryClosedActiveChannelsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClosedActiveChannelsResponse.Merge(m, src)
+}
+func (m *QueryClosedActiveChannelsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClosedActiveChannelsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClosedActiveChannelsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryClosedActiveChannelsResponse proto.InternalMessageInfo
+
+func (m *QueryClosedActiveChannelsResponse) GetChannels() []ClosedActiveChannel {
+	if m != nil {
+		return m.Channels
+	}
+	return nil
+}
+
+func (m *QueryClosedActiveChannelsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// ClosedActiveChannel defines a closed active channel of an interchain account.
+type ClosedActiveChannel struct {
+	ConnectionId string `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	PortId       string `protobuf:"bytes,2,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	ChannelId    string `protobuf:"bytes,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// address is the address of the interchain account on the host chain.
+	Address string `protobuf:"bytes,4,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *ClosedActiveChannel) Reset()         { *m = ClosedActiveChannel{} }
+func (m *ClosedActiveChannel) String() string { return proto.CompactTextString(m) }
+func (*ClosedActiveChannel) ProtoMessage()    {}
+func (*ClosedActiveChannel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df0d8b259d72854e, []int{6}
+}
+func (m *ClosedActiveChannel) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClosedActiveChannel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClosedActiveChannel.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClosedActiveChannel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClosedActiveChannel.Merge(m, src)
+}
+func (m *ClosedActiveChannel) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClosedActiveChannel) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClosedActiveChannel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClosedActiveChannel proto.InternalMessageInfo
+
+func (m *ClosedActiveChannel) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
+}
+
+func (m *ClosedActiveChannel) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *ClosedActiveChannel) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *ClosedActiveChannel) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// QueryScheduledTxsRequest is the request type for the Query/ScheduledTxs RPC method.
+type QueryScheduledTxsRequest struct {
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryScheduledTxsRequest) Reset()         { *m = QueryScheduledTxsRequest{} }
+func (m *QueryScheduledTxsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryScheduledTxsRequest) ProtoMessage()    {}
+func (*QueryScheduledTxsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df0d8b259d72854e, []int{7}
+}
+func (m *QueryScheduledTxsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryScheduledTxsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryScheduledTxsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryScheduledTxsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryScheduledTxsRequest.Merge(m, src)
+}
+func (m *QueryScheduledTxsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryScheduledTxsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryScheduledTxsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryScheduledTxsRequest proto.InternalMessageInfo
+
+func (m *QueryScheduledTxsRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *QueryScheduledTxsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryScheduledTxsResponse is the response type for the Query/ScheduledTxs RPC method.
+type QueryScheduledTxsResponse struct {
+	// scheduled_txs defines the pending scheduled transactions of the owner.
+	ScheduledTxs []ScheduledTx `protobuf:"bytes,1,rep,name=scheduled_txs,json=scheduledTxs,proto3" json:"scheduled_txs"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryScheduledTxsResponse) Reset()         { *m = QueryScheduledTxsResponse{} }
+func (m *QueryScheduledTxsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryScheduledTxsResponse) ProtoMessage()    {}
+func (*QueryScheduledTxsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df0d8b259d72854e, []int{8}
+}
+func (m *QueryScheduledTxsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryScheduledTxsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryScheduledTxsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryScheduledTxsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryScheduledTxsResponse.Merge(m, src)
+}
+func (m *QueryScheduledTxsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryScheduledTxsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryScheduledTxsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryScheduledTxsResponse proto.InternalMessageInfo
+
+func (m *QueryScheduledTxsResponse) GetScheduledTxs() []ScheduledTx {
+	if m != nil {
+		return m.ScheduledTxs
+	}
+	return nil
+}
+
+func (m *QueryScheduledTxsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryInterchainAccountRequest)(nil), "ibc.applications.interchain_accounts.controller.v1.QueryInterchainAccountRequest")
 	proto.RegisterType((*QueryInterchainAccountResponse)(nil), "ibc.applications.interchain_accounts.controller.v1.QueryInterchainAccountResponse")
 	proto.RegisterType((*QueryParamsRequest)(nil), "ibc.applications.interchain_accounts.controller.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "ibc.applications.interchain_accounts.controller.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryClosedActiveChannelsRequest)(nil), "ibc.applications.interchain_accounts.controller.v1.QueryClosedActiveChannelsRequest")
+	proto.RegisterType((*QueryClosedActiveChannelsResponse)(nil), "ibc.applications.interchain_accounts.controller.v1.QueryClosedActiveChannelsResponse")
+	proto.RegisterType((*ClosedActiveChannel)(nil), "ibc.applications.interchain_accounts.controller.v1.ClosedActiveChannel")
+	proto.RegisterType((*QueryScheduledTxsRequest)(nil), "ibc.applications.interchain_accounts.controller.v1.QueryScheduledTxsRequest")
+	proto.RegisterType((*QueryScheduledTxsResponse)(nil), "ibc.applications.interchain_accounts.controller.v1.QueryScheduledTxsResponse")
 }
 
 func init() {
@@ -221,35 +517,55 @@ func init() {
 }
 
 var fileDescriptor_df0d8b259d72854e = []byte{
-	// 439 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0x41, 0x8b, 0xd4, 0x30,
-	0x14, 0xc7, 0xa7, 0x23, 0x3b, 0x62, 0xd4, 0x83, 0x71, 0x0f, 0x43, 0xd1, 0x22, 0xf5, 0xe2, 0x65,
-	0x12, 0xb6, 0x0a, 0xe2, 0x1c, 0x04, 0x15, 0x94, 0xbd, 0xed, 0xf6, 0x20, 0xb2, 0x07, 0x97, 0x34,
-	0x0d, 0xdd, 0x48, 0x9b, 0xd7, 0x4d, 0xd2, 0x91, 0x65, 0xd9, 0x8b, 0x9f, 0x40, 0xf0, 0xe6, 0x27,
-	0xf2, 0xb8, 0x20, 0x82, 0x47, 0x99, 0xf1, 0x63, 0x78, 0x90, 0x49, 0xa3, 0x9d, 0xc1, 0x51, 0x9c,
-	0xd1, 0x53, 0xc9, 0x0b, 0xef, 0xf7, 0x7f, 0xef, 0xff, 0x4f, 0xd1, 0x43, 0x99, 0x71, 0xca, 0xea,
-	0xba, 0x94, 0x9c, 0x59, 0x09, 0xca, 0x50, 0xa9, 0xac, 0xd0, 0xfc, 0x88, 0x49, 0x75, 0xc8, 0x38,
-	0x87, 0x46, 0x59, 0x43, 0x39, 0x28, 0xab, 0xa1, 0x2c, 0x85, 0xa6, 0x93, 0x1d, 0x7a, 0xdc, 0x08,
-	0x7d, 0x42, 0x6a, 0x0d, 0x16, 0x70, 0x22, 0x33, 0x4e, 0x16, 0xfb, 0xc9, 0x8a, 0x7e, 0xd2, 0xf5,
-	0x93, 0xc9, 0x4e, 0xf8, 0x64, 0x03, 0xcd, 0x05, 0x82, 0x13, 0x0e, 0x6f, 0x14, 0x00, 0x45, 0x29,
-	0x28, 0xab, 0x25, 0x65, 0x4a, 0x81, 0xf5, 0xf2, 0xee, 0x36, 0x3e, 0x40, 0x37, 0xf7, 0xe7, 0x53,
-	0xee, 0xfe, 0x04, 0x3f, 0x6a, 0xb9, 0xa9, 0x38, 0x6e, 0x84, 0xb1, 0x78, 0x1b, 0x6d, 0xc1, 0x6b,
-	0x25, 0xf4, 0x30, 0xb8, 0x15, 0xdc, 0xb9, 0x94, 0xb6, 0x07, 0x7c, 0x1b, 0x5d, 0xe5, 0xa0, 0x94,
-	0xe0, 0x73, 0xd6, 0xa1, 0xcc, 0x87, 0x7d, 0x77, 0x7b, 0xa5, 0x2b, 0xee, 0xe6, 0xf1, 0x18, 0x45,
-	0xbf, 0x63, 0x9b, 0x1a, 0x94, 0x11, 0x78, 0x88, 0x2e, 0xb2, 0x3c, 0xd7, 0xc2, 0x18, 0x8f, 0xff,
-	0x71, 0x8c, 0xb7, 0x11, 0x76, 0xbd, 0x7b, 0x4c, 0xb3, 0xca, 0xf8, 0x61, 0x62, 0x89, 0xae, 0x2f,
-	0x55, 0x3d, 0x26, 0x45, 0x83, 0xda, 0x55, 0x1c, 0xe5, 0x72, 0x32, 0x26, 0xeb, 0x9b, 0x4d, 0x3c,
-	0xd3, 0x93, 0x92, 0x6f, 0x17, 0xd0, 0x96, 0xd3, 0xc2, 0xef, 0xfb, 0xe8, 0xda, 0x2f, 0x2b, 0xe0,
-	0xfd, 0x4d, 0x34, 0xfe, 0x68, 0x75, 0x98, 0xfe, 0x4f, 0x64, 0x6b, 0x4d, 0xfc, 0xf2, 0xcd, 0xc7,
-	0xaf, 0xef, 0xfa, 0x2f, 0xf0, 0x73, 0xea, 0xdf, 0xd2, 0xdf, 0xbc, 0x21, 0x97, 0xb1, 0xa1, 0xa7,
-	0xee, 0x7b, 0x46, 0xbb, 0x50, 0x0d, 0x3d, 0x5d, 0x8a, 0xfd, 0x0c, 0x7f, 0x0a, 0xd0, 0xa0, 0x75,
-	0x0e, 0x3f, 0xdd, 0x78, 0xfc, 0xa5, 0x90, 0xc3, 0x67, 0xff, 0xcc, 0xf1, 0xbb, 0x8f, 0xdd, 0xee,
-	0xf7, 0x70, 0xb2, 0xce, 0xee, 0x6d, 0xfc, 0x8f, 0x5f, 0x7d, 0x98, 0x46, 0xc1, 0xf9, 0x34, 0x0a,
-	0xbe, 0x4c, 0xa3, 0xe0, 0xed, 0x2c, 0xea, 0x9d, 0xcf, 0xa2, 0xde, 0xe7, 0x59, 0xd4, 0x3b, 0xd8,
-	0x2b, 0xa4, 0x3d, 0x6a, 0x32, 0xc2, 0xa1, 0xa2, 0x1c, 0x4c, 0x05, 0x66, 0x8e, 0x1f, 0x15, 0x40,
-	0x27, 0x0f, 0x68, 0x05, 0x79, 0x53, 0x0a, 0xd3, 0x8a, 0x25, 0xf7, 0x47, 0x9d, 0xde, 0x68, 0x95,
-	0x9e, 0x3d, 0xa9, 0x85, 0xc9, 0x06, 0xee, 0x57, 0xbc, 0xfb, 0x3d, 0x00, 0x00, 0xff, 0xff, 0xde,
-	0xb6, 0xd3, 0x83, 0x63, 0x04, 0x00, 0x00,
+	// 766 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x51, 0x6b, 0x13, 0x4b,
+	0x14, 0xce, 0xa6, 0x6d, 0xda, 0x4e, 0xd3, 0x87, 0x3b, 0x0d, 0xdc, 0xdc, 0x70, 0x9b, 0x9b, 0x1b,
+	0x41, 0x8b, 0xd0, 0x1d, 0x12, 0x05, 0xb1, 0x0f, 0x4a, 0x5b, 0x69, 0x4d, 0x51, 0x48, 0x63, 0x15,
+	0xf1, 0xc1, 0x30, 0x99, 0x1d, 0x36, 0x53, 0x92, 0x99, 0xed, 0xce, 0x26, 0xa6, 0x94, 0x22, 0xf8,
+	0x2c, 0x2a, 0xf8, 0xe6, 0x2f, 0xea, 0x63, 0x41, 0x44, 0x9f, 0x44, 0x5a, 0xff, 0x86, 0x20, 0x3b,
+	0x3b, 0x69, 0xb2, 0x74, 0x8d, 0x76, 0x9b, 0xa7, 0x64, 0xcf, 0xec, 0xf9, 0xce, 0xf7, 0x9d, 0x73,
+	0xe6, 0x63, 0xc1, 0x1d, 0xd6, 0x20, 0x08, 0x3b, 0x4e, 0x8b, 0x11, 0xec, 0x31, 0xc1, 0x25, 0x62,
+	0xdc, 0xa3, 0x2e, 0x69, 0x62, 0xc6, 0xeb, 0x98, 0x10, 0xd1, 0xe1, 0x9e, 0x44, 0x44, 0x70, 0xcf,
+	0x15, 0xad, 0x16, 0x75, 0x51, 0xb7, 0x84, 0xf6, 0x3a, 0xd4, 0xdd, 0x37, 0x1d, 0x57, 0x78, 0x02,
+	0x96, 0x59, 0x83, 0x98, 0xc3, 0xf9, 0x66, 0x44, 0xbe, 0x39, 0xc8, 0x37, 0xbb, 0xa5, 0xdc, 0x7a,
+	0x8c, 0x9a, 0x43, 0x08, 0xaa, 0x70, 0xee, 0x5f, 0x5b, 0x08, 0xbb, 0x45, 0x11, 0x76, 0x18, 0xc2,
+	0x9c, 0x0b, 0x4f, 0x97, 0x0f, 0x4e, 0x33, 0xb6, 0xb0, 0x85, 0xfa, 0x8b, 0xfc, 0x7f, 0x3a, 0x7a,
+	0x9d, 0x08, 0xd9, 0x16, 0x12, 0x35, 0xb0, 0xa4, 0x81, 0x0a, 0xd4, 0x2d, 0x35, 0xa8, 0x87, 0x4b,
+	0xc8, 0xc1, 0x36, 0xe3, 0x0a, 0x22, 0x78, 0xb7, 0xf8, 0x12, 0x2c, 0x6e, 0xfb, 0x6f, 0x54, 0xce,
+	0xa8, 0xad, 0x06, 0xcc, 0x6a, 0x74, 0xaf, 0x43, 0xa5, 0x07, 0x33, 0x60, 0x4a, 0xbc, 0xe0, 0xd4,
+	0xcd, 0x1a, 0x05, 0x63, 0x69, 0xb6, 0x16, 0x3c, 0xc0, 0x2b, 0x60, 0x9e, 0x08, 0xce, 0x29, 0xf1,
+	0xa1, 0xea, 0xcc, 0xca, 0x26, 0xd5, 0x69, 0x7a, 0x10, 0xac, 0x58, 0xfe, 0x4b, 0x5a, 0x66, 0x9d,
+	0x71, 0x8b, 0xf6, 0xb2, 0x13, 0x05, 0x63, 0x69, 0xb2, 0x96, 0xd6, 0xc1, 0x8a, 0x1f, 0x2b, 0xae,
+	0x80, 0xfc, 0xaf, 0x08, 0x48, 0x47, 0x70, 0x49, 0x61, 0x16, 0x4c, 0x63, 0xcb, 0x72, 0xa9, 0x94,
+	0x9a, 0x43, 0xff, 0xb1, 0x98, 0x01, 0x50, 0xe5, 0x56, 0xb1, 0x8b, 0xdb, 0x52, 0x33, 0x2e, 0x32,
+	0xb0, 0x10, 0x8a, 0x6a, 0x98, 0x1a, 0x48, 0x39, 0x2a, 0xa2, 0x50, 0xe6, 0xca, 0x2b, 0xe6, 0xc5,
+	0x67, 0x6a, 0x6a, 0x4c, 0x8d, 0x54, 0xdc, 0x05, 0x05, 0x55, 0x6a, 0xbd, 0x25, 0x24, 0xb5, 0x56,
+	0x89, 0xc7, 0xba, 0x74, 0xbd, 0x89, 0x39, 0xa7, 0xad, 0x3e, 0x1d, 0xb8, 0x01, 0xc0, 0xa0, 0xeb,
+	0xba, 0xf6, 0x55, 0x33, 0x18, 0x91, 0xe9, 0x8f, 0xc8, 0x0c, 0x16, 0x4d, 0x8f, 0xc8, 0xac, 0x62,
+	0x9b, 0xea, 0xdc, 0xda, 0x50, 0x66, 0xf1, 0xb3, 0x01, 0xfe, 0x1f, 0x51, 0x4c, 0xab, 0x64, 0x60,
+	0x86, 0xe8, 0x58, 0xd6, 0x28, 0x4c, 0x2c, 0xcd, 0x95, 0x37, 0xe3, 0xe8, 0x8c, 0xa8, 0xb1, 0x36,
+	0x79, 0xf4, 0xf5, 0xbf, 0x44, 0xed, 0x0c, 0x1e, 0x6e, 0x86, 0x84, 0x25, 0x95, 0xb0, 0x6b, 0xbf,
+	0x15, 0x16, 0xf0, 0x0c, 0x29, 0x7b, 0x6d, 0x80, 0x85, 0x88, 0x82, 0xe7, 0x97, 0xcc, 0x88, 0x58,
+	0xb2, 0xbf, 0xc1, 0xb4, 0x23, 0x5c, 0x6f, 0xb0, 0x83, 0x29, 0xff, 0xb1, 0x62, 0xc1, 0x45, 0x00,
+	0x34, 0x55, 0xff, 0x6c, 0x42, 0x9d, 0xcd, 0xea, 0x48, 0xc5, 0x1a, 0xde, 0xaa, 0xc9, 0xf0, 0x56,
+	0xf5, 0x40, 0x56, 0xf5, 0xf9, 0x11, 0x69, 0x52, 0xab, 0xd3, 0xa2, 0xd6, 0x4e, 0x4f, 0x8e, 0xbe,
+	0x0d, 0x1b, 0x11, 0x9d, 0x88, 0x33, 0xe2, 0x63, 0x03, 0xfc, 0x13, 0x51, 0x5a, 0x8f, 0x76, 0x17,
+	0xcc, 0xcb, 0x7e, 0xbc, 0xee, 0xf5, 0xfa, 0xf3, 0xbd, 0x1b, 0x67, 0xbe, 0x43, 0x05, 0xf4, 0x5c,
+	0xd3, 0x72, 0xa8, 0xe6, 0xd8, 0x66, 0x5b, 0x7e, 0x33, 0x03, 0xa6, 0x94, 0x24, 0xf8, 0x21, 0x09,
+	0xfe, 0x3a, 0x77, 0xc9, 0xe1, 0x76, 0x1c, 0xf6, 0x23, 0x1d, 0x2b, 0x57, 0x1b, 0x27, 0x64, 0x20,
+	0xa9, 0xf8, 0xfc, 0xd5, 0xc7, 0xef, 0xef, 0x93, 0x4f, 0xe1, 0x13, 0xa4, 0x4d, 0xfd, 0x4f, 0xcc,
+	0x5c, 0x2d, 0x87, 0x44, 0x07, 0xea, 0xf7, 0x10, 0x0d, 0xd6, 0x56, 0xa2, 0x83, 0xd0, 0x62, 0x1f,
+	0xc2, 0x4f, 0x06, 0x48, 0x05, 0xde, 0x02, 0x37, 0x62, 0xd3, 0x0f, 0xd9, 0x60, 0x6e, 0xf3, 0xd2,
+	0x38, 0x5a, 0xfb, 0x8a, 0xd2, 0x7e, 0x13, 0x96, 0x2f, 0xa2, 0x3d, 0x30, 0x48, 0xf8, 0x36, 0x09,
+	0x32, 0x51, 0x7e, 0x05, 0x77, 0x62, 0xb3, 0x1b, 0xe1, 0xb5, 0xb9, 0xc7, 0x63, 0x46, 0xd5, 0x1d,
+	0xd8, 0x52, 0x1d, 0xb8, 0x07, 0xd7, 0x2e, 0xd2, 0x01, 0xa2, 0x10, 0xeb, 0x58, 0x41, 0xd6, 0xcf,
+	0x5c, 0xf3, 0x87, 0x01, 0xd2, 0xc3, 0xd7, 0x1b, 0x3e, 0x88, 0xcd, 0x39, 0xc2, 0xa0, 0x72, 0x0f,
+	0xc7, 0x84, 0xa6, 0x95, 0x57, 0x95, 0xf2, 0x2d, 0x78, 0xff, 0x12, 0x7b, 0x1f, 0x32, 0xad, 0xb5,
+	0xdd, 0xa3, 0x93, 0xbc, 0x71, 0x7c, 0x92, 0x37, 0xbe, 0x9d, 0xe4, 0x8d, 0x77, 0xa7, 0xf9, 0xc4,
+	0xf1, 0x69, 0x3e, 0xf1, 0xe5, 0x34, 0x9f, 0x78, 0x56, 0xb5, 0x99, 0xd7, 0xec, 0x34, 0x4c, 0x22,
+	0xda, 0x48, 0x7f, 0xc1, 0xb0, 0x06, 0x59, 0xb6, 0x05, 0xea, 0xde, 0x46, 0x6d, 0xe1, 0x43, 0xc8,
+	0x80, 0x42, 0xf9, 0xd6, 0xf2, 0x80, 0xc5, 0x72, 0x14, 0x0b, 0x6f, 0xdf, 0xa1, 0xb2, 0x91, 0x52,
+	0xdf, 0x38, 0x37, 0x7e, 0x0e, 0x00, 0x37, 0xe3, 0x71, 0xaf, 0xfe, 0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -268,6 +584,10 @@ type QueryClient interface {
 	InterchainAccount(ctx context.Context, in *QueryInterchainAccountRequest, opts ...grpc.CallOption) (*QueryInterchainAccountResponse, error)
 	// Params queries all parameters of the ICA controller submodule.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// ClosedActiveChannels returns the interchain accounts whose active channel is closed.
+	ClosedActiveChannels(ctx context.Context, in *QueryClosedActiveChannelsRequest, opts ...grpc.CallOption) (*QueryClosedActiveChannelsResponse, error)
+	// ScheduledTxs returns the pending scheduled transactions of an owner.
+	ScheduledTxs(ctx context.Context, in *QueryScheduledTxsRequest, opts ...grpc.CallOption) (*QueryScheduledTxsResponse, error)
 }
 
 type queryClient struct {
@@ -296,12 +616,34 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) ClosedActiveChannels(ctx context.Context, in *QueryClosedActiveChannelsRequest, opts ...grpc.CallOption) (*QueryClosedActiveChannelsResponse, error) {
+	out := new(QueryClosedActiveChannelsResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.interchain_accounts.controller.v1.Query/ClosedActiveChannels", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ScheduledTxs(ctx context.Context, in *QueryScheduledTxsRequest, opts ...grpc.CallOption) (*QueryScheduledTxsResponse, error) {
+	out := new(QueryScheduledTxsResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.interchain_accounts.controller.v1.Query/ScheduledTxs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// InterchainAccount returns the interchain account address for a given owner address on a given connection
 	InterchainAccount(context.Context, *QueryInterchainAccountRequest) (*QueryInterchainAccountResponse, error)
 	// Params queries all parameters of the ICA controller submodule.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// ClosedActiveChannels returns the interchain accounts whose active channel is closed.
+	ClosedActiveChannels(context.Context, *QueryClosedActiveChannelsRequest) (*QueryClosedActiveChannelsResponse, error)
+	// ScheduledTxs returns the pending scheduled transactions of an owner.
+	ScheduledTxs(context.Context, *QueryScheduledTxsRequest) (*QueryScheduledTxsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -314,6 +656,12 @@ func (*UnimplementedQueryServer) InterchainAccount(ctx context.Context, req *Que
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (*UnimplementedQueryServer) ClosedActiveChannels(ctx context.Context, req *QueryClosedActiveChannelsRequest) (*QueryClosedActiveChannelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClosedActiveChannels not implemented")
+}
+func (*UnimplementedQueryServer) ScheduledTxs(ctx context.Context, req *QueryScheduledTxsRequest) (*QueryScheduledTxsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduledTxs not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -355,6 +703,43 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ClosedActiveChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClosedActiveChannelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ClosedActiveChannels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.interchain_accounts.controller.v1.Query/ClosedActiveChannels",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClosedActiveChannels(ctx, req.(*QueryClosedActiveChannelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ScheduledTxs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryScheduledTxsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ScheduledTxs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.interchain_accounts.controller.v1.Query/ScheduledTxs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ScheduledTxs(ctx, req.(*QueryScheduledTxsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ibc.applications.interchain_accounts.controller.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -367,6 +752,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "ClosedActiveChannels",
+			Handler:    _Query_ClosedActiveChannels_Handler,
+		},
+		{
+			MethodName: "ScheduledTxs",
+			Handler:    _Query_ScheduledTxs_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ibc/applications/interchain_accounts/controller/v1/query.proto",
@@ -392,6 +785,11 @@ func (m *QueryInterchainAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 	_ = i
 	var l int
 	_ = l
+	if m.AccountIndex != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AccountIndex))
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.ConnectionId) > 0 {
 		i -= len(m.ConnectionId)
 		copy(dAtA[i:], m.ConnectionId)
@@ -497,44 +895,273 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryClosedActiveChannelsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryInterchainAccountRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.ConnectionId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+
+func (m *QueryClosedActiveChannelsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryInterchainAccountResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryClosedActiveChannelsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClosedActiveChannelsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClosedActiveChannelsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClosedActiveChannelsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Channels) > 0 {
+		for iNdEx := len(m.Channels) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Channels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClosedActiveChannel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClosedActiveChannel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClosedActiveChannel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryScheduledTxsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryScheduledTxsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryScheduledTxsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryScheduledTxsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryScheduledTxsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryScheduledTxsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ScheduledTxs) > 0 {
+		for iNdEx := len(m.ScheduledTxs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScheduledTxs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryInterchainAccountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.AccountIndex != 0 {
+		n += 1 + sovQuery(uint64(m.AccountIndex))
+	}
+	return n
+}
+
+func (m *QueryInterchainAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -542,31 +1169,561 @@ func (m *QueryParamsRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
-	var l int
-	_ = l
-	return n
-}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Params != nil {
+		l = m.Params.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryClosedActiveChannelsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryClosedActiveChannelsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Channels) > 0 {
+		for _, e := range m.Channels {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ClosedActiveChannel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryScheduledTxsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryScheduledTxsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ScheduledTxs) > 0 {
+		for _, e := range m.ScheduledTxs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryInterchainAccountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInterchainAccountRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInterchainAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountIndex", wireType)
+			}
+			m.AccountIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AccountIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInterchainAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInterchainAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInterchainAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Params == nil {
+				m.Params = &Params{}
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryClosedActiveChannelsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClosedActiveChannelsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClosedActiveChannelsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Params != nil {
-		l = m.Params.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryInterchainAccountRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryClosedActiveChannelsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -589,17 +1746,17 @@ func (m *QueryInterchainAccountRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryInterchainAccountRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryClosedActiveChannelsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryInterchainAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryClosedActiveChannelsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Channels", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -609,29 +1766,31 @@ func (m *QueryInterchainAccountRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.Channels = append(m.Channels, ClosedActiveChannel{})
+			if err := m.Channels[len(m.Channels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -641,23 +1800,27 @@ func (m *QueryInterchainAccountRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -680,7 +1843,7 @@ func (m *QueryInterchainAccountRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryInterchainAccountResponse) Unmarshal(dAtA []byte) error {
+func (m *ClosedActiveChannel) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -703,13 +1866,109 @@ func (m *QueryInterchainAccountResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryInterchainAccountResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClosedActiveChannel: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryInterchainAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClosedActiveChannel: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
@@ -762,7 +2021,7 @@ func (m *QueryInterchainAccountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryScheduledTxsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -785,12 +2044,80 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryScheduledTxsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryScheduledTxsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -812,7 +2139,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryScheduledTxsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -835,15 +2162,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryScheduledTxsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryScheduledTxsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledTxs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -870,10 +2197,44 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Params == nil {
-				m.Params = &Params{}
+			m.ScheduledTxs = append(m.ScheduledTxs, ScheduledTx{})
+			if err := m.ScheduledTxs[len(m.ScheduledTxs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
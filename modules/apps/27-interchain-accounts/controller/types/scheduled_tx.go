@@ -0,0 +1,73 @@
+package types
+
+import (
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	host "github.com/cosmos/ibc-go/v9/modules/core/24-host"
+	ibcerrors "github.com/cosmos/ibc-go/v9/modules/core/errors"
+)
+
+// Validate performs basic validation of the scheduled transaction.
+func (stx ScheduledTx) Validate() error {
+	if strings.TrimSpace(stx.Owner) == "" {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidAddress, "owner address cannot be empty")
+	}
+
+	if err := host.ConnectionIdentifierValidator(stx.ConnectionId); err != nil {
+		return errorsmod.Wrap(err, "invalid connection ID")
+	}
+
+	if err := stx.PacketData.ValidateBasic(); err != nil {
+		return errorsmod.Wrap(err, "invalid interchain account packet data")
+	}
+
+	if stx.RelativeTimeout == 0 {
+		return errorsmod.Wrap(ErrInvalidScheduledTx, "relative timeout cannot be zero")
+	}
+
+	if stx.NextExecutionTime.IsZero() {
+		return errorsmod.Wrap(ErrInvalidScheduledTx, "next execution time cannot be zero")
+	}
+
+	if err := validateExecutions(stx.RemainingExecutions, stx.Interval.Nanoseconds()); err != nil {
+		return err
+	}
+
+	if err := stx.ExecutionFee.Validate(); err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidCoins, "invalid execution fee: %s", err)
+	}
+
+	return nil
+}
+
+// EscrowedFee returns the execution fee escrowed for all remaining executions of the scheduled transaction.
+func (stx ScheduledTx) EscrowedFee() sdk.Coins {
+	return multiplyCoins(stx.ExecutionFee, stx.RemainingExecutions)
+}
+
+// validateExecutions ensures a recurring transaction has a non-zero interval and a single execution otherwise.
+func validateExecutions(executions uint64, interval int64) error {
+	if executions == 0 {
+		return errorsmod.Wrap(ErrInvalidScheduledTx, "number of executions must be at least one")
+	}
+
+	if interval < 0 {
+		return errorsmod.Wrap(ErrInvalidScheduledTx, "interval cannot be negative")
+	}
+
+	if interval == 0 && executions > 1 {
+		return errorsmod.Wrap(ErrInvalidScheduledTx, "interval must be positive for recurring executions")
+	}
+
+	return nil
+}
+
+// multiplyCoins returns the provided coins multiplied by n.
+func multiplyCoins(coins sdk.Coins, n uint64) sdk.Coins {
+	return coins.MulInt(sdkmath.NewIntFromUint64(n))
+}
@@ -0,0 +1,223 @@
+package types
+
+import (
+	"bytes"
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/cosmos/gogoproto/jsonpb"
+	"github.com/cosmos/gogoproto/proto"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+
+	icatypes "github.com/cosmos/ibc-go/v9/modules/apps/27-interchain-accounts/types"
+	host "github.com/cosmos/ibc-go/v9/modules/core/24-host"
+	ibcerrors "github.com/cosmos/ibc-go/v9/modules/core/errors"
+)
+
+var _ authz.Authorization = (*SendTxAuthorization)(nil)
+
+const (
+	// AllowAllMsgTypeURLs is the wildcard allowing any message type in the interchain account transactions
+	AllowAllMsgTypeURLs = "*"
+
+	allocationNotFound = -1
+)
+
+// NewSendTxAuthorization creates a new SendTxAuthorization object.
+func NewSendTxAuthorization(allocations ...SendTxAllocation) *SendTxAuthorization {
+	return &SendTxAuthorization{
+		Allocations: allocations,
+	}
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (SendTxAuthorization) MsgTypeURL() string {
+	return sdk.MsgTypeURL(&MsgSendTx{})
+}
+
+// Accept implements Authorization.Accept.
+func (a SendTxAuthorization) Accept(goCtx context.Context, msg proto.Message) (authz.AcceptResponse, error) {
+	msgSendTx, ok := msg.(*MsgSendTx)
+	if !ok {
+		return authz.AcceptResponse{}, errorsmod.Wrap(ibcerrors.ErrInvalidType, "type mismatch")
+	}
+
+	index := getAllocationIndex(*msgSendTx, a.Allocations)
+	if index == allocationNotFound {
+		return authz.AcceptResponse{}, errorsmod.Wrapf(ibcerrors.ErrNotFound, "requested allocation for connection %s and account index %d does not exist", msgSendTx.ConnectionId, msgSendTx.AccountIndex)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := validateMsgTypeURLs(ctx, msgSendTx.PacketData, a.Allocations[index]); err != nil {
+		return authz.AcceptResponse{}, err
+	}
+
+	// start a new period once the current period has ended
+	allocation := &a.Allocations[index]
+	if !ctx.BlockTime().Before(allocation.PeriodReset) {
+		allocation.PeriodTxs = 0
+		allocation.PeriodReset = ctx.BlockTime().Add(allocation.Period)
+	}
+
+	if allocation.PeriodTxs >= allocation.MaxTxsPerPeriod {
+		return authz.AcceptResponse{}, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "maximum number of %d interchain account transactions per period reached until %s", allocation.MaxTxsPerPeriod, allocation.PeriodReset)
+	}
+
+	allocation.PeriodTxs++
+
+	return authz.AcceptResponse{Accept: true, Delete: false, Updated: &SendTxAuthorization{
+		Allocations: a.Allocations,
+	}}, nil
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a SendTxAuthorization) ValidateBasic() error {
+	if len(a.Allocations) == 0 {
+		return errorsmod.Wrap(ErrInvalidAuthorization, "allocations cannot be empty")
+	}
+
+	type allocationKey struct {
+		connectionID string
+		accountIndex uint64
+	}
+
+	foundAllocations := make(map[allocationKey]bool, 0)
+
+	for _, allocation := range a.Allocations {
+		key := allocationKey{connectionID: allocation.ConnectionId, accountIndex: allocation.AccountIndex}
+		if foundAllocations[key] {
+			return errorsmod.Wrapf(ErrInvalidAuthorization, "duplicate allocation for connection ID %s and account index %d", allocation.ConnectionId, allocation.AccountIndex)
+		}
+
+		foundAllocations[key] = true
+
+		if err := host.ConnectionIdentifierValidator(allocation.ConnectionId); err != nil {
+			return errorsmod.Wrap(err, "invalid connection ID")
+		}
+
+		if allocation.Encoding != icatypes.EncodingProtobuf && allocation.Encoding != icatypes.EncodingProto3JSON {
+			return errorsmod.Wrapf(ErrInvalidAuthorization, "unsupported encoding format %s", allocation.Encoding)
+		}
+
+		if len(allocation.AllowedMsgTypeUrls) == 0 {
+			return errorsmod.Wrap(ErrInvalidAuthorization, "allowed message type URLs cannot be empty")
+		}
+
+		found := make(map[string]bool, 0)
+		for _, typeURL := range allocation.AllowedMsgTypeUrls {
+			if strings.TrimSpace(typeURL) == "" {
+				return errorsmod.Wrap(ErrInvalidAuthorization, "allowed message type URL cannot be empty")
+			}
+
+			if found[typeURL] {
+				return errorsmod.Wrapf(ErrInvalidAuthorization, "duplicate entry in allowed message type URLs %s", typeURL)
+			}
+			found[typeURL] = true
+		}
+
+		if allocation.MaxTxsPerPeriod == 0 {
+			return errorsmod.Wrap(ErrInvalidAuthorization, "maximum number of transactions per period cannot be zero")
+		}
+
+		if allocation.Period <= 0 {
+			return errorsmod.Wrap(ErrInvalidAuthorization, "period must be positive")
+		}
+
+		if allocation.PeriodTxs > allocation.MaxTxsPerPeriod {
+			return errorsmod.Wrap(ErrInvalidAuthorization, "number of transactions in the current period cannot exceed the maximum number of transactions per period")
+		}
+	}
+
+	return nil
+}
+
+// validateMsgTypeURLs returns a nil error if the packet data is a transaction, decoded with the encoding of the
+// allocation, containing only allowed message types. The type URLs of the messages are compared without unpacking
+// the messages, as their types may only be registered on the host chain.
+// gasCostPerIteration gas is consumed for each message.
+func validateMsgTypeURLs(ctx sdk.Context, packetData icatypes.InterchainAccountPacketData, allocation SendTxAllocation) error {
+	if packetData.Type != icatypes.EXECUTE_TX {
+		return errorsmod.Wrapf(ErrInvalidAuthorization, "packet data type must be %s, got %s", icatypes.EXECUTE_TX, packetData.Type)
+	}
+
+	typeURLs, err := getMsgTypeURLs(packetData.Data, allocation.Encoding)
+	if err != nil {
+		return errorsmod.Wrap(err, "failed to deserialize packet data")
+	}
+
+	if len(allocation.AllowedMsgTypeUrls) == 1 && allocation.AllowedMsgTypeUrls[0] == AllowAllMsgTypeURLs {
+		return nil
+	}
+
+	gasCostPerIteration := ctx.KVGasConfig().IterNextCostFlat
+
+	for _, typeURL := range typeURLs {
+		ctx.GasMeter().ConsumeGas(gasCostPerIteration, "send tx authorization")
+
+		if !slices.Contains(allocation.AllowedMsgTypeUrls, typeURL) {
+			return errorsmod.Wrapf(ErrInvalidAuthorization, "not allowed message type: %s", typeURL)
+		}
+	}
+
+	return nil
+}
+
+// getMsgTypeURLs returns the type URLs of the messages of the CosmosTx decoded with the provided encoding, without
+// unpacking them. Proto3 JSON is decoded with the same unmarshaler as the host chain, ignoring the message fields.
+func getMsgTypeURLs(data []byte, encoding string) ([]string, error) {
+	var cosmosTx icatypes.CosmosTx
+
+	switch encoding {
+	case icatypes.EncodingProtobuf:
+		if err := proto.Unmarshal(data, &cosmosTx); err != nil {
+			return nil, errorsmod.Wrapf(ibcerrors.ErrInvalidType, "cannot unmarshal CosmosTx with protobuf: %v", err)
+		}
+	case icatypes.EncodingProto3JSON:
+		unmarshaler := jsonpb.Unmarshaler{AnyResolver: anyMessageResolver{}, AllowUnknownFields: true}
+		if err := unmarshaler.Unmarshal(bytes.NewReader(data), &cosmosTx); err != nil {
+			return nil, errorsmod.Wrapf(ibcerrors.ErrInvalidType, "cannot unmarshal CosmosTx with proto3 json: %v", err)
+		}
+	default:
+		return nil, errorsmod.Wrapf(icatypes.ErrInvalidCodec, "unsupported encoding format %s", encoding)
+	}
+
+	typeURLs := make([]string, len(cosmosTx.Messages))
+	for i, msg := range cosmosTx.Messages {
+		typeURLs[i] = msg.TypeUrl
+	}
+
+	return typeURLs, nil
+}
+
+// anyMessageResolver resolves every type URL to an anyMessage.
+type anyMessageResolver struct{}
+
+// Resolve implements jsonpb.AnyResolver.
+func (anyMessageResolver) Resolve(string) (proto.Message, error) {
+	return &anyMessage{}, nil
+}
+
+// anyMessage is a message without fields into which any proto3 JSON encoded message can be decoded when its
+// unknown fields are allowed.
+type anyMessage struct{}
+
+func (*anyMessage) Reset()         {}
+func (*anyMessage) String() string { return "" }
+func (*anyMessage) ProtoMessage()  {}
+
+// getAllocationIndex ranges through a set of allocations, and returns the index of the allocation matching the
+// connection and account index of the message if found. If not, returns -1.
+func getAllocationIndex(msg MsgSendTx, allocations []SendTxAllocation) int {
+	for index, allocation := range allocations {
+		if allocation.ConnectionId == msg.ConnectionId && allocation.AccountIndex == msg.AccountIndex {
+			return index
+		}
+	}
+	return allocationNotFound
+}
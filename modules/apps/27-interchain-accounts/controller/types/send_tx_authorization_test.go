@@ -0,0 +1,422 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/cosmos/ibc-go/v9/modules/apps/27-interchain-accounts/controller/types"
+	icatypes "github.com/cosmos/ibc-go/v9/modules/apps/27-interchain-accounts/types"
+	host "github.com/cosmos/ibc-go/v9/modules/core/24-host"
+	ibcerrors "github.com/cosmos/ibc-go/v9/modules/core/errors"
+	ibctesting "github.com/cosmos/ibc-go/v9/testing"
+)
+
+func TestSendTxAuthorizationAccept(t *testing.T) {
+	var (
+		msgSendTx    *types.MsgSendTx
+		sendTxAuthz  types.SendTxAuthorization
+		blockTime    time.Time
+		serializeMsg func(encoding string, msgs ...proto.Message) []byte
+	)
+
+	msgBankSend := &banktypes.MsgSend{FromAddress: ibctesting.TestAccAddress, ToAddress: ibctesting.TestAccAddress, Amount: ibctesting.TestCoins}
+	msgDelegate := &stakingtypes.MsgDelegate{DelegatorAddress: ibctesting.TestAccAddress, ValidatorAddress: ibctesting.TestAccAddress, Amount: ibctesting.TestCoin}
+
+	testCases := []struct {
+		name         string
+		malleate     func()
+		assertResult func(res authz.AcceptResponse, err error)
+	}{
+		{
+			"success",
+			func() {},
+			func(res authz.AcceptResponse, err error) {
+				require.NoError(t, err)
+				require.True(t, res.Accept)
+				require.False(t, res.Delete)
+
+				updatedAuthz, ok := res.Updated.(*types.SendTxAuthorization)
+				require.True(t, ok)
+				require.Equal(t, uint64(1), updatedAuthz.Allocations[0].PeriodTxs)
+				require.Equal(t, blockTime.Add(time.Hour), updatedAuthz.Allocations[0].PeriodReset)
+			},
+		},
+		{
+			"success: proto3 json encoded packet data",
+			func() {
+				sendTxAuthz.Allocations[0].Encoding = icatypes.EncodingProto3JSON
+				msgSendTx.PacketData.Data = serializeMsg(icatypes.EncodingProto3JSON, msgBankSend)
+			},
+			func(res authz.AcceptResponse, err error) {
+				require.NoError(t, err)
+				require.True(t, res.Accept)
+			},
+		},
+		{
+			"success: allocation of the account index is used",
+			func() {
+				allocation := sendTxAuthz.Allocations[0]
+				allocation.AccountIndex = 1
+				allocation.AllowedMsgTypeUrls = []string{sdk.MsgTypeURL(msgDelegate)}
+				sendTxAuthz.Allocations = append(sendTxAuthz.Allocations, allocation)
+
+				msgSendTx.AccountIndex = 1
+				msgSendTx.PacketData.Data = serializeMsg(icatypes.EncodingProtobuf, msgDelegate)
+			},
+			func(res authz.AcceptResponse, err error) {
+				require.NoError(t, err)
+				require.True(t, res.Accept)
+
+				updatedAuthz, ok := res.Updated.(*types.SendTxAuthorization)
+				require.True(t, ok)
+				require.Equal(t, uint64(0), updatedAuthz.Allocations[0].PeriodTxs)
+				require.Equal(t, uint64(1), updatedAuthz.Allocations[1].PeriodTxs)
+			},
+		},
+		{
+			"success: any message type allowed",
+			func() {
+				sendTxAuthz.Allocations[0].AllowedMsgTypeUrls = []string{types.AllowAllMsgTypeURLs}
+				msgSendTx.PacketData.Data = serializeMsg(icatypes.EncodingProtobuf, msgBankSend, msgDelegate)
+			},
+			func(res authz.AcceptResponse, err error) {
+				require.NoError(t, err)
+				require.True(t, res.Accept)
+			},
+		},
+		{
+			"success: message type only registered on the host chain",
+			func() {
+				sendTxAuthz.Allocations[0].AllowedMsgTypeUrls = []string{"/host.module.v1.MsgHostOnly"}
+
+				cosmosTx := &icatypes.CosmosTx{Messages: []*codectypes.Any{{TypeUrl: "/host.module.v1.MsgHostOnly", Value: []byte{0x0a, 0x01, 0x61}}}}
+				bz, err := proto.Marshal(cosmosTx)
+				require.NoError(t, err)
+				msgSendTx.PacketData.Data = bz
+			},
+			func(res authz.AcceptResponse, err error) {
+				require.NoError(t, err)
+				require.True(t, res.Accept)
+			},
+		},
+		{
+			"success: proto3 json encoded message type only registered on the host chain with any message type allowed",
+			func() {
+				sendTxAuthz.Allocations[0].Encoding = icatypes.EncodingProto3JSON
+				sendTxAuthz.Allocations[0].AllowedMsgTypeUrls = []string{types.AllowAllMsgTypeURLs}
+				msgSendTx.PacketData.Data = []byte(`{"messages":[{"@type":"/host.module.v1.MsgHostOnly","signer":"cosmos1"}]}`)
+			},
+			func(res authz.AcceptResponse, err error) {
+				require.NoError(t, err)
+				require.True(t, res.Accept)
+			},
+		},
+		{
+			"success: transaction counted in the current period",
+			func() {
+				sendTxAuthz.Allocations[0].PeriodTxs = 1
+				sendTxAuthz.Allocations[0].PeriodReset = blockTime.Add(time.Minute)
+			},
+			func(res authz.AcceptResponse, err error) {
+				require.NoError(t, err)
+				require.True(t, res.Accept)
+
+				updatedAuthz, ok := res.Updated.(*types.SendTxAuthorization)
+				require.True(t, ok)
+				require.Equal(t, uint64(2), updatedAuthz.Allocations[0].PeriodTxs)
+				require.Equal(t, blockTime.Add(time.Minute), updatedAuthz.Allocations[0].PeriodReset)
+			},
+		},
+		{
+			"success: new period started",
+			func() {
+				sendTxAuthz.Allocations[0].PeriodTxs = 2
+				sendTxAuthz.Allocations[0].PeriodReset = blockTime
+			},
+			func(res authz.AcceptResponse, err error) {
+				require.NoError(t, err)
+				require.True(t, res.Accept)
+
+				updatedAuthz, ok := res.Updated.(*types.SendTxAuthorization)
+				require.True(t, ok)
+				require.Equal(t, uint64(1), updatedAuthz.Allocations[0].PeriodTxs)
+				require.Equal(t, blockTime.Add(time.Hour), updatedAuthz.Allocations[0].PeriodReset)
+			},
+		},
+		{
+			"failure: maximum number of transactions reached in the current period",
+			func() {
+				sendTxAuthz.Allocations[0].PeriodTxs = 2
+				sendTxAuthz.Allocations[0].PeriodReset = blockTime.Add(time.Minute)
+			},
+			func(res authz.AcceptResponse, err error) {
+				require.ErrorIs(t, err, ibcerrors.ErrUnauthorized)
+				require.False(t, res.Accept)
+			},
+		},
+		{
+			"failure: connection allocation does not exist",
+			func() {
+				msgSendTx.ConnectionId = "connection-100"
+			},
+			func(res authz.AcceptResponse, err error) {
+				require.ErrorIs(t, err, ibcerrors.ErrNotFound)
+				require.False(t, res.Accept)
+			},
+		},
+		{
+			"failure: allocation of the account index does not exist",
+			func() {
+				msgSendTx.AccountIndex = 1
+			},
+			func(res authz.AcceptResponse, err error) {
+				require.ErrorIs(t, err, ibcerrors.ErrNotFound)
+				require.False(t, res.Accept)
+			},
+		},
+		{
+			"failure: message type not allowed",
+			func() {
+				msgSendTx.PacketData.Data = serializeMsg(icatypes.EncodingProtobuf, msgBankSend, msgDelegate)
+			},
+			func(res authz.AcceptResponse, err error) {
+				require.ErrorIs(t, err, types.ErrInvalidAuthorization)
+				require.False(t, res.Accept)
+			},
+		},
+		{
+			"failure: proto3 json message type not allowed with a case insensitive duplicate type URL",
+			func() {
+				sendTxAuthz.Allocations[0].Encoding = icatypes.EncodingProto3JSON
+				msgSendTx.PacketData.Data = []byte(`{"messages":[{"@type":"/cosmos.staking.v1beta1.MsgDelegate","@TYPE":"/cosmos.bank.v1beta1.MsgSend"}]}`)
+			},
+			func(res authz.AcceptResponse, err error) {
+				require.ErrorIs(t, err, types.ErrInvalidAuthorization)
+				require.False(t, res.Accept)
+			},
+		},
+		{
+			"failure: packet data encoding differs from the allocation encoding",
+			func() {
+				msgSendTx.PacketData.Data = serializeMsg(icatypes.EncodingProto3JSON, msgBankSend)
+			},
+			func(res authz.AcceptResponse, err error) {
+				require.ErrorIs(t, err, ibcerrors.ErrInvalidType)
+				require.False(t, res.Accept)
+			},
+		},
+		{
+			"failure: packet data type is not execute tx",
+			func() {
+				msgSendTx.PacketData.Type = icatypes.QUERY
+			},
+			func(res authz.AcceptResponse, err error) {
+				require.ErrorIs(t, err, types.ErrInvalidAuthorization)
+				require.False(t, res.Accept)
+			},
+		},
+		{
+			"failure: packet data cannot be deserialized",
+			func() {
+				msgSendTx.PacketData.Data = []byte("invalid packet data")
+			},
+			func(res authz.AcceptResponse, err error) {
+				require.ErrorIs(t, err, ibcerrors.ErrInvalidType)
+				require.False(t, res.Accept)
+			},
+		},
+		{
+			"failure: type mismatch",
+			func() {},
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			chain := ibctesting.NewCoordinator(t, 1).GetChain(ibctesting.GetChainID(1))
+			ctx := chain.GetContext()
+			blockTime = ctx.BlockTime()
+
+			serializeMsg = func(encoding string, msgs ...proto.Message) []byte {
+				bz, err := icatypes.SerializeCosmosTx(chain.Codec, msgs, encoding)
+				require.NoError(t, err)
+				return bz
+			}
+
+			sendTxAuthz = types.SendTxAuthorization{
+				Allocations: []types.SendTxAllocation{
+					{
+						ConnectionId:       ibctesting.FirstConnectionID,
+						AllowedMsgTypeUrls: []string{sdk.MsgTypeURL(msgBankSend)},
+						MaxTxsPerPeriod:    2,
+						Period:             time.Hour,
+						Encoding:           icatypes.EncodingProtobuf,
+					},
+				},
+			}
+
+			packetData := icatypes.InterchainAccountPacketData{
+				Type: icatypes.EXECUTE_TX,
+				Data: serializeMsg(icatypes.EncodingProtobuf, msgBankSend),
+			}
+
+			msgSendTx = types.NewMsgSendTx(ibctesting.TestAccAddress, ibctesting.FirstConnectionID, 100000, packetData)
+
+			tc.malleate()
+
+			if tc.assertResult == nil {
+				res, err := sendTxAuthz.Accept(ctx, &types.MsgRegisterInterchainAccount{})
+				require.ErrorIs(t, err, ibcerrors.ErrInvalidType)
+				require.False(t, res.Accept)
+				return
+			}
+
+			res, err := sendTxAuthz.Accept(ctx, msgSendTx)
+			tc.assertResult(res, err)
+		})
+	}
+}
+
+func TestSendTxAuthorizationMsgTypeURL(t *testing.T) {
+	var sendTxAuthz types.SendTxAuthorization
+	require.Equal(t, sdk.MsgTypeURL(&types.MsgSendTx{}), sendTxAuthz.MsgTypeURL(), "invalid type url for send tx authorization")
+}
+
+func TestSendTxAuthorizationValidateBasic(t *testing.T) {
+	var sendTxAuthz types.SendTxAuthorization
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"success: multiple allocations",
+			func() {
+				allocation := sendTxAuthz.Allocations[0]
+				allocation.ConnectionId = "connection-1"
+				sendTxAuthz.Allocations = append(sendTxAuthz.Allocations, allocation)
+			},
+			nil,
+		},
+		{
+			"success: multiple allocations on the same connection with different account indices",
+			func() {
+				allocation := sendTxAuthz.Allocations[0]
+				allocation.AccountIndex = 1
+				sendTxAuthz.Allocations = append(sendTxAuthz.Allocations, allocation)
+			},
+			nil,
+		},
+		{
+			"empty allocations",
+			func() {
+				sendTxAuthz.Allocations = nil
+			},
+			types.ErrInvalidAuthorization,
+		},
+		{
+			"duplicate connection ID and account index",
+			func() {
+				sendTxAuthz.Allocations = append(sendTxAuthz.Allocations, sendTxAuthz.Allocations[0])
+			},
+			types.ErrInvalidAuthorization,
+		},
+		{
+			"invalid connection ID",
+			func() {
+				sendTxAuthz.Allocations[0].ConnectionId = ""
+			},
+			host.ErrInvalidID,
+		},
+		{
+			"unsupported encoding",
+			func() {
+				sendTxAuthz.Allocations[0].Encoding = "invalid-encoding"
+			},
+			types.ErrInvalidAuthorization,
+		},
+		{
+			"empty allowed message type URLs",
+			func() {
+				sendTxAuthz.Allocations[0].AllowedMsgTypeUrls = nil
+			},
+			types.ErrInvalidAuthorization,
+		},
+		{
+			"duplicate allowed message type URL",
+			func() {
+				sendTxAuthz.Allocations[0].AllowedMsgTypeUrls = []string{"/cosmos.bank.v1beta1.MsgSend", "/cosmos.bank.v1beta1.MsgSend"}
+			},
+			types.ErrInvalidAuthorization,
+		},
+		{
+			"empty allowed message type URL",
+			func() {
+				sendTxAuthz.Allocations[0].AllowedMsgTypeUrls = []string{" "}
+			},
+			types.ErrInvalidAuthorization,
+		},
+		{
+			"maximum number of transactions per period is zero",
+			func() {
+				sendTxAuthz.Allocations[0].MaxTxsPerPeriod = 0
+			},
+			types.ErrInvalidAuthorization,
+		},
+		{
+			"period is zero",
+			func() {
+				sendTxAuthz.Allocations[0].Period = 0
+			},
+			types.ErrInvalidAuthorization,
+		},
+		{
+			"number of transactions in the period exceeds the maximum",
+			func() {
+				sendTxAuthz.Allocations[0].PeriodTxs = 3
+			},
+			types.ErrInvalidAuthorization,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			sendTxAuthz = *types.NewSendTxAuthorization(types.SendTxAllocation{
+				ConnectionId:       ibctesting.FirstConnectionID,
+				AllowedMsgTypeUrls: []string{"/cosmos.bank.v1beta1.MsgSend"},
+				MaxTxsPerPeriod:    2,
+				Period:             time.Hour,
+				Encoding:           icatypes.EncodingProtobuf,
+			})
+
+			tc.malleate()
+
+			err := sendTxAuthz.ValidateBasic()
+			if tc.expErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expErr)
+			}
+		})
+	}
+}
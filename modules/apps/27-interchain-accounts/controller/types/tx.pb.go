@@ -6,24 +6,31 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types2 "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	types1 "github.com/cosmos/ibc-go/v9/modules/apps/27-interchain-accounts/types"
 	types "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -37,6 +44,9 @@ type MsgRegisterInterchainAccount struct {
 	ConnectionId string      `protobuf:"bytes,2,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
 	Version      string      `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
 	Ordering     types.Order `protobuf:"varint,4,opt,name=ordering,proto3,enum=ibc.core.channel.v1.Order" json:"ordering,omitempty"`
+	// account index allows the owner to register multiple interchain accounts on the same connection.
+	// Account index 0 refers to the interchain account of the owner without an account index.
+	AccountIndex uint64 `protobuf:"varint,5,opt,name=account_index,json=accountIndex,proto3" json:"account_index,omitempty"`
 }
 
 func (m *MsgRegisterInterchainAccount) Reset()         { *m = MsgRegisterInterchainAccount{} }
@@ -119,6 +129,8 @@ type MsgSendTx struct {
 	// Relative timeout timestamp provided will be added to the current block time during transaction execution.
 	// The timeout timestamp must be non-zero.
 	RelativeTimeout uint64 `protobuf:"varint,4,opt,name=relative_timeout,json=relativeTimeout,proto3" json:"relative_timeout,omitempty"`
+	// account index of the interchain account of the owner the transaction is sent from.
+	AccountIndex uint64 `protobuf:"varint,5,opt,name=account_index,json=accountIndex,proto3" json:"account_index,omitempty"`
 }
 
 func (m *MsgSendTx) Reset()         { *m = MsgSendTx{} }
@@ -272,6 +284,175 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgScheduleTx defines the payload for Msg/ScheduleTx
+type MsgScheduleTx struct {
+	Owner        string                             `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	ConnectionId string                             `protobuf:"bytes,2,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	PacketData   types1.InterchainAccountPacketData `protobuf:"bytes,3,opt,name=packet_data,json=packetData,proto3" json:"packet_data"`
+	// Relative timeout timestamp provided will be added to the block time of each execution.
+	// The timeout timestamp must be non-zero.
+	RelativeTimeout uint64 `protobuf:"varint,4,opt,name=relative_timeout,json=relativeTimeout,proto3" json:"relative_timeout,omitempty"`
+	// account index of the interchain account of the owner the transaction is sent from.
+	AccountIndex uint64 `protobuf:"varint,5,opt,name=account_index,json=accountIndex,proto3" json:"account_index,omitempty"`
+	// block time at or after which the first execution takes place.
+	ExecutionTime time.Time `protobuf:"bytes,6,opt,name=execution_time,json=executionTime,proto3,stdtime" json:"execution_time"`
+	// interval between recurring executions. Must be zero if the transaction is executed once.
+	Interval time.Duration `protobuf:"bytes,7,opt,name=interval,proto3,stdduration" json:"interval"`
+	// number of executions, must be at least one.
+	Executions uint64 `protobuf:"varint,8,opt,name=executions,proto3" json:"executions,omitempty"`
+	// fee charged on each execution. The fee of all executions is escrowed when the transaction is scheduled.
+	ExecutionFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,9,rep,name=execution_fee,json=executionFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"execution_fee"`
+}
+
+func (m *MsgScheduleTx) Reset()         { *m = MsgScheduleTx{} }
+func (m *MsgScheduleTx) String() string { return proto.CompactTextString(m) }
+func (*MsgScheduleTx) ProtoMessage()    {}
+func (*MsgScheduleTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7def041328c84a30, []int{6}
+}
+func (m *MsgScheduleTx) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgScheduleTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgScheduleTx.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgScheduleTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgScheduleTx.Merge(m, src)
+}
+func (m *MsgScheduleTx) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgScheduleTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgScheduleTx.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgScheduleTx proto.InternalMessageInfo
+
+// MsgScheduleTxResponse defines the response for MsgScheduleTx
+type MsgScheduleTxResponse struct {
+	ScheduledTxId uint64 `protobuf:"varint,1,opt,name=scheduled_tx_id,json=scheduledTxId,proto3" json:"scheduled_tx_id,omitempty"`
+}
+
+func (m *MsgScheduleTxResponse) Reset()         { *m = MsgScheduleTxResponse{} }
+func (m *MsgScheduleTxResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgScheduleTxResponse) ProtoMessage()    {}
+func (*MsgScheduleTxResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7def041328c84a30, []int{7}
+}
+func (m *MsgScheduleTxResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgScheduleTxResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgScheduleTxResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgScheduleTxResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgScheduleTxResponse.Merge(m, src)
+}
+func (m *MsgScheduleTxResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgScheduleTxResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgScheduleTxResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgScheduleTxResponse proto.InternalMessageInfo
+
+// MsgCancelScheduledTx defines the payload for Msg/CancelScheduledTx
+type MsgCancelScheduledTx struct {
+	Owner         string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	ScheduledTxId uint64 `protobuf:"varint,2,opt,name=scheduled_tx_id,json=scheduledTxId,proto3" json:"scheduled_tx_id,omitempty"`
+}
+
+func (m *MsgCancelScheduledTx) Reset()         { *m = MsgCancelScheduledTx{} }
+func (m *MsgCancelScheduledTx) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelScheduledTx) ProtoMessage()    {}
+func (*MsgCancelScheduledTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7def041328c84a30, []int{8}
+}
+func (m *MsgCancelScheduledTx) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelScheduledTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelScheduledTx.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelScheduledTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelScheduledTx.Merge(m, src)
+}
+func (m *MsgCancelScheduledTx) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelScheduledTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelScheduledTx.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelScheduledTx proto.InternalMessageInfo
+
+// MsgCancelScheduledTxResponse defines the response for MsgCancelScheduledTx
+type MsgCancelScheduledTxResponse struct {
+	// refund defines the escrowed execution fees refunded to the owner.
+	Refund github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=refund,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"refund"`
+}
+
+func (m *MsgCancelScheduledTxResponse) Reset()         { *m = MsgCancelScheduledTxResponse{} }
+func (m *MsgCancelScheduledTxResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelScheduledTxResponse) ProtoMessage()    {}
+func (*MsgCancelScheduledTxResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7def041328c84a30, []int{9}
+}
+func (m *MsgCancelScheduledTxResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelScheduledTxResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelScheduledTxResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelScheduledTxResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelScheduledTxResponse.Merge(m, src)
+}
+func (m *MsgCancelScheduledTxResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelScheduledTxResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelScheduledTxResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelScheduledTxResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgRegisterInterchainAccount)(nil), "ibc.applications.interchain_accounts.controller.v1.MsgRegisterInterchainAccount")
 	proto.RegisterType((*MsgRegisterInterchainAccountResponse)(nil), "ibc.applications.interchain_accounts.controller.v1.MsgRegisterInterchainAccountResponse")
@@ -279,6 +460,10 @@ func init() {
 	proto.RegisterType((*MsgSendTxResponse)(nil), "ibc.applications.interchain_accounts.controller.v1.MsgSendTxResponse")
 	proto.RegisterType((*MsgUpdateParams)(nil), "ibc.applications.interchain_accounts.controller.v1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "ibc.applications.interchain_accounts.controller.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgScheduleTx)(nil), "ibc.applications.interchain_accounts.controller.v1.MsgScheduleTx")
+	proto.RegisterType((*MsgScheduleTxResponse)(nil), "ibc.applications.interchain_accounts.controller.v1.MsgScheduleTxResponse")
+	proto.RegisterType((*MsgCancelScheduledTx)(nil), "ibc.applications.interchain_accounts.controller.v1.MsgCancelScheduledTx")
+	proto.RegisterType((*MsgCancelScheduledTxResponse)(nil), "ibc.applications.interchain_accounts.controller.v1.MsgCancelScheduledTxResponse")
 }
 
 func init() {
@@ -286,49 +471,69 @@ func init() {
 }
 
 var fileDescriptor_7def041328c84a30 = []byte{
-	// 671 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x4f, 0x4f, 0x13, 0x4d,
-	0x1c, 0xee, 0xbe, 0x94, 0x02, 0x03, 0x2f, 0xbc, 0xef, 0x86, 0x48, 0xd9, 0x68, 0xc1, 0xea, 0x01,
-	0x49, 0x98, 0x49, 0xeb, 0xbf, 0x58, 0xe3, 0x41, 0xc0, 0x43, 0x63, 0x1a, 0x9b, 0x15, 0x13, 0xe2,
-	0xa5, 0x99, 0xce, 0x4e, 0x86, 0x91, 0xee, 0xcc, 0x3a, 0x33, 0x5d, 0xf1, 0x66, 0x3c, 0x19, 0x0f,
-	0xc6, 0x83, 0x1f, 0x80, 0x8f, 0xc0, 0xdd, 0x0f, 0x20, 0x47, 0x8e, 0x9e, 0x8c, 0x81, 0x03, 0x37,
-	0x3f, 0x83, 0xd9, 0x3f, 0xdd, 0xa2, 0x20, 0xc1, 0xc2, 0x6d, 0x7f, 0xbf, 0x99, 0xe7, 0xf9, 0x3d,
-	0xcf, 0x33, 0xb3, 0x03, 0xee, 0xf3, 0x36, 0x41, 0x38, 0x08, 0x3a, 0x9c, 0x60, 0xc3, 0xa5, 0xd0,
-	0x88, 0x0b, 0x43, 0x15, 0xd9, 0xc0, 0x5c, 0xb4, 0x30, 0x21, 0xb2, 0x2b, 0x8c, 0x46, 0x44, 0x0a,
-	0xa3, 0x64, 0xa7, 0x43, 0x15, 0x0a, 0x2b, 0xc8, 0x6c, 0xc1, 0x40, 0x49, 0x23, 0xed, 0x2a, 0x6f,
-	0x13, 0x78, 0x14, 0x0c, 0x4f, 0x00, 0xc3, 0x3e, 0x18, 0x86, 0x15, 0x67, 0x9a, 0x49, 0x26, 0x63,
-	0x38, 0x8a, 0xbe, 0x12, 0x26, 0xe7, 0xd6, 0x99, 0x64, 0x84, 0x15, 0x14, 0x60, 0xb2, 0x49, 0x4d,
-	0x8a, 0x5a, 0x19, 0x40, 0xfc, 0x11, 0x35, 0x09, 0xc9, 0x0c, 0x91, 0xda, 0x97, 0x1a, 0xf9, 0x9a,
-	0x45, 0xeb, 0xbe, 0x66, 0xe9, 0xc2, 0xd5, 0x88, 0x9d, 0x48, 0x45, 0x11, 0xd9, 0xc0, 0x42, 0xd0,
-	0x4e, 0x0c, 0x4f, 0x3e, 0x93, 0x2d, 0xe5, 0xcf, 0x16, 0xb8, 0xdc, 0xd0, 0xcc, 0xa5, 0x8c, 0x6b,
-	0x43, 0x55, 0x3d, 0x9b, 0xfe, 0x30, 0x19, 0x6e, 0x4f, 0x83, 0x61, 0xf9, 0x4a, 0x50, 0x55, 0xb4,
-	0xe6, 0xad, 0x85, 0x31, 0x37, 0x29, 0xec, 0x6b, 0xe0, 0x5f, 0x22, 0x85, 0xa0, 0x24, 0x12, 0xdd,
-	0xe2, 0x5e, 0xf1, 0x9f, 0x78, 0x75, 0xa2, 0xdf, 0xac, 0x7b, 0x76, 0x11, 0x8c, 0x84, 0x54, 0x69,
-	0x2e, 0x45, 0x71, 0x28, 0x5e, 0xee, 0x95, 0xf6, 0x1d, 0x30, 0x2a, 0x95, 0x47, 0x15, 0x17, 0xac,
-	0x98, 0x9f, 0xb7, 0x16, 0x26, 0xab, 0x0e, 0x8c, 0x4e, 0x22, 0xd2, 0x0a, 0x7b, 0x02, 0xc3, 0x0a,
-	0x7c, 0x12, 0x6d, 0x72, 0xb3, 0xbd, 0xb5, 0xc9, 0x77, 0xdb, 0x73, 0xb9, 0xb7, 0x87, 0x3b, 0x8b,
-	0x89, 0x8c, 0xb2, 0x07, 0xae, 0x9f, 0x26, 0xde, 0xa5, 0x3a, 0x90, 0x42, 0x53, 0xfb, 0x0a, 0x00,
-	0x29, 0x6b, 0xa4, 0x35, 0x71, 0x32, 0x96, 0x76, 0xea, 0x9e, 0x3d, 0x03, 0x46, 0x02, 0xa9, 0x4c,
-	0xdf, 0x47, 0x21, 0x2a, 0xeb, 0x5e, 0x2d, 0x1f, 0xcd, 0x2b, 0xff, 0xb0, 0xc0, 0x58, 0x43, 0xb3,
-	0xa7, 0x54, 0x78, 0x6b, 0x5b, 0xe7, 0x09, 0x64, 0x13, 0x8c, 0x27, 0xa7, 0xdf, 0xf2, 0xb0, 0xc1,
-	0x71, 0x28, 0xe3, 0xd5, 0x55, 0x78, 0xa6, 0x3b, 0x18, 0x56, 0xe0, 0x31, 0x7f, 0xcd, 0x98, 0x6c,
-	0x15, 0x1b, 0xbc, 0x9c, 0xdf, 0xfd, 0x36, 0x97, 0x73, 0x41, 0x90, 0x75, 0xec, 0x1b, 0xe0, 0x3f,
-	0x45, 0x3b, 0xd8, 0xf0, 0x90, 0xb6, 0x0c, 0xf7, 0xa9, 0xec, 0x9a, 0x38, 0xeb, 0xbc, 0x3b, 0xd5,
-	0xeb, 0xaf, 0x25, 0xed, 0x63, 0xb1, 0xde, 0x06, 0xff, 0x67, 0x7e, 0xb3, 0x0c, 0x1d, 0x30, 0xaa,
-	0xe9, 0xcb, 0x2e, 0x15, 0x84, 0xc6, 0xd6, 0xf3, 0x6e, 0x56, 0xa7, 0x39, 0x7d, 0xb2, 0xc0, 0x54,
-	0x43, 0xb3, 0x67, 0x81, 0x87, 0x0d, 0x6d, 0x62, 0x85, 0x7d, 0x6d, 0x5f, 0x02, 0x05, 0xcd, 0x59,
-	0x3f, 0xae, 0xb4, 0xb2, 0xd7, 0x41, 0x21, 0x88, 0x77, 0xc4, 0x41, 0x8d, 0x57, 0x6b, 0xf0, 0xef,
-	0xff, 0x44, 0x98, 0xcc, 0x48, 0xbd, 0xa7, 0x7c, 0xb5, 0xa9, 0x9e, 0x99, 0x74, 0x54, 0x79, 0x16,
-	0xcc, 0xfc, 0xa6, 0xaa, 0xe7, 0xa9, 0xfa, 0x3e, 0x0f, 0x86, 0x1a, 0x9a, 0xd9, 0x5f, 0x2c, 0x30,
-	0xfb, 0xe7, 0x5f, 0xa0, 0x39, 0x88, 0xb6, 0xd3, 0xee, 0xa5, 0xb3, 0x7e, 0xd1, 0x8c, 0xd9, 0x29,
-	0x7d, 0xb0, 0x40, 0x21, 0xbd, 0xa8, 0x0f, 0x06, 0x1c, 0x92, 0xc0, 0x9d, 0x47, 0xe7, 0x82, 0x67,
-	0x82, 0xb6, 0x2d, 0x30, 0xf1, 0xcb, 0x8d, 0x58, 0x19, 0x90, 0xf7, 0x28, 0x89, 0xf3, 0xf8, 0x02,
-	0x48, 0x7a, 0x12, 0x9d, 0xe1, 0x37, 0x87, 0x3b, 0x8b, 0xd6, 0xf2, 0x8b, 0xdd, 0xfd, 0x92, 0xb5,
-	0xb7, 0x5f, 0xb2, 0xbe, 0xef, 0x97, 0xac, 0x8f, 0x07, 0xa5, 0xdc, 0xde, 0x41, 0x29, 0xf7, 0xf5,
-	0xa0, 0x94, 0x7b, 0xde, 0x64, 0xdc, 0x6c, 0x74, 0xdb, 0x90, 0x48, 0x1f, 0xa5, 0x6f, 0x2d, 0x6f,
-	0x93, 0x25, 0x26, 0x51, 0x78, 0x0f, 0xf9, 0xd2, 0xeb, 0x76, 0xa8, 0x8e, 0x5e, 0x71, 0x8d, 0xaa,
-	0x77, 0x97, 0xfa, 0x3a, 0x96, 0x4e, 0x7a, 0xc0, 0xcd, 0xeb, 0x80, 0xea, 0x76, 0x21, 0x7e, 0x7d,
-	0x6f, 0xfe, 0x0c, 0x00, 0x00, 0xff, 0xff, 0x9e, 0x8a, 0x4a, 0x4c, 0xbd, 0x06, 0x00, 0x00,
+	// 989 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xf6, 0x36, 0x8e, 0xe3, 0x4c, 0x7e, 0xd1, 0x55, 0x20, 0x9b, 0x15, 0xd8, 0xc1, 0x20, 0x14,
+	0x2a, 0x65, 0x16, 0x9b, 0x5f, 0x22, 0x08, 0xa1, 0x26, 0x29, 0xc2, 0xaa, 0x2c, 0xac, 0x6d, 0x90,
+	0x2a, 0x2e, 0xd6, 0x78, 0x76, 0xb2, 0x59, 0x62, 0xcf, 0x2c, 0x3b, 0xb3, 0x8b, 0xb9, 0x21, 0x4e,
+	0x70, 0x41, 0x95, 0xe0, 0x80, 0x38, 0xf5, 0xcc, 0x85, 0xde, 0xf8, 0x13, 0xe8, 0xb1, 0x47, 0xb8,
+	0xd0, 0x2a, 0x39, 0xf4, 0xdf, 0x40, 0x33, 0x3b, 0x3b, 0x4e, 0xe3, 0xb4, 0x2a, 0x4e, 0x6e, 0x9c,
+	0xec, 0x79, 0x6f, 0xde, 0xf7, 0xbe, 0xf7, 0x79, 0xe6, 0x1b, 0x83, 0x0f, 0xa3, 0x3e, 0xf6, 0x50,
+	0x1c, 0x0f, 0x22, 0x8c, 0x44, 0xc4, 0x28, 0xf7, 0x22, 0x2a, 0x48, 0x82, 0x0f, 0x51, 0x44, 0x7b,
+	0x08, 0x63, 0x96, 0x52, 0xc1, 0x3d, 0xcc, 0xa8, 0x48, 0xd8, 0x60, 0x40, 0x12, 0x2f, 0x6b, 0x7a,
+	0x62, 0x04, 0xe3, 0x84, 0x09, 0x66, 0xb7, 0xa2, 0x3e, 0x86, 0xa7, 0x8b, 0xe1, 0x39, 0xc5, 0x70,
+	0x5c, 0x0c, 0xb3, 0xa6, 0xbb, 0x1a, 0xb2, 0x90, 0xa9, 0x72, 0x4f, 0x7e, 0xcb, 0x91, 0xdc, 0x77,
+	0x9e, 0x8b, 0x46, 0xd6, 0xf4, 0x62, 0x84, 0x8f, 0x88, 0xd0, 0x55, 0xbb, 0x53, 0x90, 0x1f, 0xaf,
+	0x34, 0xc8, 0x1a, 0x66, 0x7c, 0xc8, 0xb8, 0x37, 0xe4, 0xa1, 0xcc, 0x0f, 0x79, 0xa8, 0x13, 0xaf,
+	0x4a, 0x74, 0xcc, 0x12, 0xe2, 0xe1, 0x43, 0x44, 0x29, 0x19, 0xa8, 0xf2, 0xfc, 0xab, 0xde, 0x52,
+	0x0b, 0x19, 0x0b, 0x07, 0xc4, 0x53, 0xab, 0x7e, 0x7a, 0xe0, 0x05, 0x69, 0xa2, 0x98, 0xe8, 0x7c,
+	0xfd, 0x6c, 0x5e, 0x44, 0x43, 0xc2, 0x05, 0x1a, 0xc6, 0x05, 0x80, 0x6e, 0xde, 0x47, 0x9c, 0x78,
+	0x59, 0xb3, 0x4f, 0x04, 0x92, 0x14, 0x23, 0x0d, 0xd0, 0x78, 0x64, 0x81, 0x97, 0x3b, 0x3c, 0xf4,
+	0x49, 0x18, 0x71, 0x41, 0x92, 0xb6, 0x19, 0xef, 0x7a, 0x3e, 0x9d, 0xbd, 0x0a, 0x66, 0xd9, 0xd7,
+	0x94, 0x24, 0x8e, 0xb5, 0x61, 0x6d, 0xce, 0xfb, 0xf9, 0xc2, 0x7e, 0x0d, 0x2c, 0x61, 0x46, 0x29,
+	0xc1, 0x92, 0x4b, 0x2f, 0x0a, 0x9c, 0x2b, 0x2a, 0xbb, 0x38, 0x0e, 0xb6, 0x03, 0xdb, 0x01, 0x73,
+	0x19, 0x49, 0x78, 0xc4, 0xa8, 0x33, 0xa3, 0xd2, 0xc5, 0xd2, 0x7e, 0x0f, 0x54, 0x59, 0x12, 0x90,
+	0x24, 0xa2, 0xa1, 0x53, 0xde, 0xb0, 0x36, 0x97, 0x5b, 0x2e, 0x94, 0x3f, 0xb5, 0x14, 0x03, 0x16,
+	0x0a, 0x64, 0x4d, 0xf8, 0x99, 0xdc, 0xe4, 0x9b, 0xbd, 0xb2, 0xad, 0x56, 0xbd, 0x17, 0xd1, 0x80,
+	0x8c, 0x9c, 0xd9, 0x0d, 0x6b, 0xb3, 0xec, 0x2f, 0xea, 0x60, 0x5b, 0xc6, 0xb6, 0x97, 0xbf, 0xbf,
+	0x5b, 0x2f, 0x7d, 0xf7, 0xf8, 0xde, 0xb5, 0x9c, 0x6b, 0x23, 0x00, 0xaf, 0x3f, 0x6b, 0x42, 0x9f,
+	0xf0, 0x98, 0x51, 0x4e, 0xec, 0x57, 0x00, 0xd0, 0xad, 0xe5, 0x40, 0xf9, 0xb8, 0xf3, 0x3a, 0xd2,
+	0x0e, 0xec, 0x35, 0x30, 0x17, 0xb3, 0x44, 0x8c, 0x87, 0xad, 0xc8, 0x65, 0x3b, 0xd8, 0x2e, 0xcb,
+	0x7e, 0x8d, 0x9f, 0xae, 0x80, 0xf9, 0x0e, 0x0f, 0x6f, 0x11, 0x1a, 0xec, 0x8f, 0x2e, 0xa2, 0xda,
+	0x11, 0x58, 0xc8, 0xcf, 0x60, 0x2f, 0x40, 0x02, 0x29, 0xe5, 0x16, 0x5a, 0x7b, 0xf0, 0xb9, 0x6e,
+	0x42, 0xd6, 0x84, 0x13, 0xf3, 0x75, 0x15, 0xd8, 0x1e, 0x12, 0x68, 0xa7, 0x7c, 0xff, 0x9f, 0x7a,
+	0xc9, 0x07, 0xb1, 0x89, 0xd8, 0x6f, 0x82, 0x17, 0x12, 0x32, 0x40, 0x22, 0xca, 0x48, 0x4f, 0x1e,
+	0x1d, 0x96, 0x0a, 0xf5, 0x83, 0x94, 0xfd, 0x95, 0x22, 0xbe, 0x9f, 0x87, 0xa7, 0xd3, 0xfe, 0x5d,
+	0x70, 0xd5, 0x88, 0x62, 0x84, 0x76, 0x41, 0x95, 0x93, 0xaf, 0x52, 0x42, 0x31, 0x51, 0xfa, 0x94,
+	0x7d, 0xb3, 0xd6, 0x62, 0xfe, 0x6c, 0x81, 0x95, 0x0e, 0x0f, 0x3f, 0x8f, 0x03, 0x24, 0x48, 0x17,
+	0x25, 0x68, 0xc8, 0xed, 0x97, 0x40, 0x85, 0x47, 0xe1, 0x58, 0x53, 0xbd, 0xb2, 0x6f, 0x83, 0x4a,
+	0xac, 0x76, 0x28, 0x35, 0x17, 0x5a, 0xdb, 0xf0, 0xbf, 0x9b, 0x06, 0xcc, 0x7b, 0x68, 0x81, 0x34,
+	0xde, 0xf6, 0x4a, 0x31, 0x8c, 0x6e, 0xd5, 0x58, 0x07, 0x6b, 0x67, 0x58, 0x15, 0x33, 0x35, 0xfe,
+	0x28, 0x83, 0x25, 0x39, 0x29, 0x3e, 0x24, 0x41, 0x3a, 0x20, 0xff, 0xdf, 0x23, 0x60, 0xdf, 0x04,
+	0xcb, 0x64, 0x44, 0x70, 0xaa, 0x06, 0x94, 0x80, 0x4e, 0x45, 0xf1, 0x77, 0x61, 0xee, 0x55, 0xb0,
+	0xf0, 0x2a, 0xb8, 0x5f, 0x78, 0xd5, 0x4e, 0x55, 0xb2, 0xba, 0xf3, 0xb0, 0x6e, 0xf9, 0x4b, 0xa6,
+	0x56, 0x66, 0xed, 0x8f, 0x41, 0x55, 0x0d, 0x99, 0xa1, 0x81, 0x33, 0xa7, 0x60, 0xd6, 0x27, 0x60,
+	0xf6, 0xb4, 0x25, 0xe6, 0x28, 0xbf, 0x48, 0x14, 0x53, 0x64, 0xd7, 0x00, 0x30, 0x88, 0xdc, 0xa9,
+	0x2a, 0xbe, 0xa7, 0x22, 0x76, 0x0c, 0xc6, 0x1d, 0x7b, 0x07, 0x84, 0x38, 0xf3, 0x1b, 0x33, 0xaa,
+	0x4b, 0xee, 0x9b, 0x50, 0xfa, 0x26, 0xd4, 0xbe, 0x09, 0x77, 0x59, 0x44, 0x77, 0xde, 0x92, 0x5d,
+	0x7e, 0x7b, 0x58, 0xdf, 0x0c, 0x23, 0x71, 0x98, 0xf6, 0x21, 0x66, 0x43, 0x4f, 0x9b, 0x6c, 0xfe,
+	0xb1, 0xc5, 0x83, 0x23, 0x4f, 0x7c, 0x13, 0x13, 0xae, 0x0a, 0xb8, 0xbf, 0x68, 0x3a, 0x7c, 0x42,
+	0xc8, 0xc4, 0x15, 0xb9, 0x01, 0x5e, 0x7c, 0xe2, 0xe0, 0x98, 0x6b, 0xf2, 0x06, 0x58, 0xe1, 0x3a,
+	0x1a, 0xf4, 0xc4, 0xa8, 0x30, 0xa5, 0xb2, 0xbf, 0x64, 0xc2, 0xfb, 0x23, 0xe3, 0x3f, 0x01, 0x58,
+	0xed, 0xf0, 0x70, 0x17, 0x51, 0x4c, 0x06, 0xb7, 0xc6, 0xf9, 0xa7, 0x1c, 0xc3, 0x73, 0xb0, 0xaf,
+	0x9c, 0x87, 0x7d, 0x96, 0xec, 0x0f, 0xf9, 0x73, 0x31, 0xd1, 0xc6, 0x90, 0xc6, 0xa0, 0x92, 0x90,
+	0x83, 0x94, 0x4a, 0xae, 0x97, 0x2e, 0xa4, 0x86, 0xce, 0x27, 0x6e, 0xfd, 0x5d, 0x01, 0x33, 0x1d,
+	0x1e, 0xda, 0x7f, 0x5a, 0x60, 0xfd, 0xe9, 0xef, 0x57, 0x77, 0x1a, 0x3b, 0x78, 0xd6, 0x7b, 0xe1,
+	0xde, 0xbe, 0x6c, 0x44, 0x23, 0xde, 0x8f, 0x16, 0xa8, 0xe8, 0x07, 0xe4, 0xa3, 0x29, 0x9b, 0xe4,
+	0xe5, 0xee, 0x8d, 0x0b, 0x95, 0x1b, 0x42, 0x77, 0x2d, 0xb0, 0xf8, 0x84, 0x09, 0xef, 0x4e, 0x89,
+	0x7b, 0x1a, 0xc4, 0xbd, 0x79, 0x09, 0x20, 0x86, 0xe2, 0xaf, 0x16, 0x00, 0xa7, 0x5c, 0xf7, 0xfa,
+	0xb4, 0x83, 0x1b, 0x08, 0xb7, 0x7d, 0x61, 0x08, 0x43, 0xee, 0x77, 0x0b, 0x5c, 0x9d, 0xbc, 0x92,
+	0x9f, 0x4e, 0xd9, 0x60, 0x02, 0xc9, 0xed, 0x5e, 0x16, 0x52, 0xc1, 0xd8, 0x9d, 0xfd, 0xf6, 0xf1,
+	0xbd, 0x6b, 0xd6, 0xce, 0x97, 0xf7, 0x8f, 0x6b, 0xd6, 0x83, 0xe3, 0x9a, 0xf5, 0xe8, 0xb8, 0x66,
+	0xdd, 0x39, 0xa9, 0x95, 0x1e, 0x9c, 0xd4, 0x4a, 0x7f, 0x9d, 0xd4, 0x4a, 0x5f, 0x74, 0x27, 0x6f,
+	0x6b, 0xd4, 0xc7, 0x5b, 0x21, 0xf3, 0xb2, 0x0f, 0xbc, 0x21, 0x93, 0x78, 0x5c, 0xfe, 0x65, 0xe6,
+	0x5e, 0xeb, 0xfd, 0xad, 0x31, 0x99, 0xad, 0xf3, 0xfe, 0x2d, 0xab, 0xbb, 0xdd, 0xaf, 0x28, 0x27,
+	0x7f, 0xfb, 0xdf, 0x01, 0x00, 0xdd, 0x45, 0x7c, 0x5b, 0x2a, 0x0c, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -349,6 +554,10 @@ type MsgClient interface {
 	SendTx(ctx context.Context, in *MsgSendTx, opts ...grpc.CallOption) (*MsgSendTxResponse, error)
 	// UpdateParams defines a rpc handler for MsgUpdateParams.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// ScheduleTx defines a rpc handler for MsgScheduleTx.
+	ScheduleTx(ctx context.Context, in *MsgScheduleTx, opts ...grpc.CallOption) (*MsgScheduleTxResponse, error)
+	// CancelScheduledTx defines a rpc handler for MsgCancelScheduledTx.
+	CancelScheduledTx(ctx context.Context, in *MsgCancelScheduledTx, opts ...grpc.CallOption) (*MsgCancelScheduledTxResponse, error)
 }
 
 type msgClient struct {
@@ -386,6 +595,24 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) ScheduleTx(ctx context.Context, in *MsgScheduleTx, opts ...grpc.CallOption) (*MsgScheduleTxResponse, error) {
+	out := new(MsgScheduleTxResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.interchain_accounts.controller.v1.Msg/ScheduleTx", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelScheduledTx(ctx context.Context, in *MsgCancelScheduledTx, opts ...grpc.CallOption) (*MsgCancelScheduledTxResponse, error) {
+	out := new(MsgCancelScheduledTxResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.interchain_accounts.controller.v1.Msg/CancelScheduledTx", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// RegisterInterchainAccount defines a rpc handler for MsgRegisterInterchainAccount.
@@ -394,6 +621,10 @@ type MsgServer interface {
 	SendTx(context.Context, *MsgSendTx) (*MsgSendTxResponse, error)
 	// UpdateParams defines a rpc handler for MsgUpdateParams.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// ScheduleTx defines a rpc handler for MsgScheduleTx.
+	ScheduleTx(context.Context, *MsgScheduleTx) (*MsgScheduleTxResponse, error)
+	// CancelScheduledTx defines a rpc handler for MsgCancelScheduledTx.
+	CancelScheduledTx(context.Context, *MsgCancelScheduledTx) (*MsgCancelScheduledTxResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -409,6 +640,12 @@ func (*UnimplementedMsgServer) SendTx(ctx context.Context, req *MsgSendTx) (*Msg
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) ScheduleTx(ctx context.Context, req *MsgScheduleTx) (*MsgScheduleTxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleTx not implemented")
+}
+func (*UnimplementedMsgServer) CancelScheduledTx(ctx context.Context, req *MsgCancelScheduledTx) (*MsgCancelScheduledTxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelScheduledTx not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -468,6 +705,43 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ScheduleTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgScheduleTx)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ScheduleTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.interchain_accounts.controller.v1.Msg/ScheduleTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ScheduleTx(ctx, req.(*MsgScheduleTx))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelScheduledTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelScheduledTx)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelScheduledTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.interchain_accounts.controller.v1.Msg/CancelScheduledTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelScheduledTx(ctx, req.(*MsgCancelScheduledTx))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ibc.applications.interchain_accounts.controller.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -484,6 +758,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "ScheduleTx",
+			Handler:    _Msg_ScheduleTx_Handler,
+		},
+		{
+			MethodName: "CancelScheduledTx",
+			Handler:    _Msg_CancelScheduledTx_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ibc/applications/interchain_accounts/controller/v1/tx.proto",
@@ -509,6 +791,11 @@ func (m *MsgRegisterInterchainAccount) MarshalToSizedBuffer(dAtA []byte) (int, e
 	_ = i
 	var l int
 	_ = l
+	if m.AccountIndex != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AccountIndex))
+		i--
+		dAtA[i] = 0x28
+	}
 	if m.Ordering != 0 {
 		i = encodeVarintTx(dAtA, i, uint64(m.Ordering))
 		i--
@@ -595,6 +882,11 @@ func (m *MsgSendTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.AccountIndex != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AccountIndex))
+		i--
+		dAtA[i] = 0x28
+	}
 	if m.RelativeTimeout != 0 {
 		i = encodeVarintTx(dAtA, i, uint64(m.RelativeTimeout))
 		i--
@@ -718,82 +1010,280 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgScheduleTx) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgRegisterInterchainAccount) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgScheduleTx) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgScheduleTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.ExecutionFee) > 0 {
+		for iNdEx := len(m.ExecutionFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExecutionFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
 	}
-	l = len(m.ConnectionId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.Executions != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Executions))
+		i--
+		dAtA[i] = 0x40
 	}
-	l = len(m.Version)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Interval, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Interval):])
+	if err3 != nil {
+		return 0, err3
 	}
-	if m.Ordering != 0 {
-		n += 1 + sovTx(uint64(m.Ordering))
+	i -= n3
+	i = encodeVarintTx(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x3a
+	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ExecutionTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExecutionTime):])
+	if err4 != nil {
+		return 0, err4
 	}
-	return n
-}
-
-func (m *MsgRegisterInterchainAccountResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	i -= n4
+	i = encodeVarintTx(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x32
+	if m.AccountIndex != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AccountIndex))
+		i--
+		dAtA[i] = 0x28
 	}
-	var l int
-	_ = l
-	l = len(m.ChannelId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.RelativeTimeout != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RelativeTimeout))
+		i--
+		dAtA[i] = 0x20
 	}
-	l = len(m.PortId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.PacketData.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgSendTx) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgScheduleTxResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgScheduleTxResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgScheduleTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ConnectionId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.PacketData.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if m.RelativeTimeout != 0 {
-		n += 1 + sovTx(uint64(m.RelativeTimeout))
+	if m.ScheduledTxId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ScheduledTxId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgSendTxResponse) Size() (n int) {
-	if m == nil {
+func (m *MsgCancelScheduledTx) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelScheduledTx) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelScheduledTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ScheduledTxId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ScheduledTxId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelScheduledTxResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelScheduledTxResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelScheduledTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Refund) > 0 {
+		for iNdEx := len(m.Refund) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Refund[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgRegisterInterchainAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Ordering != 0 {
+		n += 1 + sovTx(uint64(m.Ordering))
+	}
+	if m.AccountIndex != 0 {
+		n += 1 + sovTx(uint64(m.AccountIndex))
+	}
+	return n
+}
+
+func (m *MsgRegisterInterchainAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSendTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.PacketData.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.RelativeTimeout != 0 {
+		n += 1 + sovTx(uint64(m.RelativeTimeout))
+	}
+	if m.AccountIndex != 0 {
+		n += 1 + sovTx(uint64(m.AccountIndex))
+	}
+	return n
+}
+
+func (m *MsgSendTxResponse) Size() (n int) {
+	if m == nil {
 		return 0
 	}
 	var l int
@@ -828,6 +1318,87 @@ func (m *MsgUpdateParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgScheduleTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.PacketData.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.RelativeTimeout != 0 {
+		n += 1 + sovTx(uint64(m.RelativeTimeout))
+	}
+	if m.AccountIndex != 0 {
+		n += 1 + sovTx(uint64(m.AccountIndex))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExecutionTime)
+	n += 1 + l + sovTx(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Interval)
+	n += 1 + l + sovTx(uint64(l))
+	if m.Executions != 0 {
+		n += 1 + sovTx(uint64(m.Executions))
+	}
+	if len(m.ExecutionFee) > 0 {
+		for _, e := range m.ExecutionFee {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgScheduleTxResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ScheduledTxId != 0 {
+		n += 1 + sovTx(uint64(m.ScheduledTxId))
+	}
+	return n
+}
+
+func (m *MsgCancelScheduledTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ScheduledTxId != 0 {
+		n += 1 + sovTx(uint64(m.ScheduledTxId))
+	}
+	return n
+}
+
+func (m *MsgCancelScheduledTxResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Refund) > 0 {
+		for _, e := range m.Refund {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -978,6 +1549,25 @@ func (m *MsgRegisterInterchainAccount) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountIndex", wireType)
+			}
+			m.AccountIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AccountIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1258,6 +1848,25 @@ func (m *MsgSendTx) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountIndex", wireType)
+			}
+			m.AccountIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AccountIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1513,6 +2122,564 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgScheduleTx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgScheduleTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgScheduleTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketData", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PacketData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelativeTimeout", wireType)
+			}
+			m.RelativeTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RelativeTimeout |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountIndex", wireType)
+			}
+			m.AccountIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AccountIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ExecutionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Interval, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Executions", wireType)
+			}
+			m.Executions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Executions |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExecutionFee = append(m.ExecutionFee, types2.Coin{})
+			if err := m.ExecutionFee[len(m.ExecutionFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgScheduleTxResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgScheduleTxResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgScheduleTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledTxId", wireType)
+			}
+			m.ScheduledTxId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScheduledTxId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelScheduledTx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelScheduledTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelScheduledTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledTxId", wireType)
+			}
+			m.ScheduledTxId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScheduledTxId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelScheduledTxResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelScheduledTxResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelScheduledTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Refund", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Refund = append(m.Refund, types2.Coin{})
+			if err := m.Refund[len(m.Refund)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1,6 +1,8 @@
 package types
 
 import (
+	errorsmod "cosmossdk.io/errors"
+
 	controllertypes "github.com/cosmos/ibc-go/v9/modules/apps/27-interchain-accounts/controller/types"
 	hosttypes "github.com/cosmos/ibc-go/v9/modules/apps/27-interchain-accounts/host/types"
 	icatypes "github.com/cosmos/ibc-go/v9/modules/apps/27-interchain-accounts/types"
@@ -77,7 +79,24 @@ func (gs ControllerGenesisState) Validate() error {
 		}
 	}
 
-	return nil
+	scheduledTxIDs := make(map[uint64]bool, len(gs.ScheduledTxs))
+	for _, scheduledTx := range gs.ScheduledTxs {
+		if err := scheduledTx.Validate(); err != nil {
+			return err
+		}
+
+		if scheduledTxIDs[scheduledTx.Id] {
+			return errorsmod.Wrapf(controllertypes.ErrInvalidScheduledTx, "duplicate scheduled transaction id %d", scheduledTx.Id)
+		}
+
+		if scheduledTx.Id >= gs.NextScheduledTxId {
+			return errorsmod.Wrapf(controllertypes.ErrInvalidScheduledTx, "scheduled transaction id %d must be less than the next scheduled transaction id %d", scheduledTx.Id, gs.NextScheduledTxId)
+		}
+
+		scheduledTxIDs[scheduledTx.Id] = true
+	}
+
+	return gs.Params.Validate()
 }
 
 // DefaultHostGenesis creates and returns the default interchain accounts HostGenesisState
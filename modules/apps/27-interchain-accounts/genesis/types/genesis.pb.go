@@ -84,6 +84,8 @@ type ControllerGenesisState struct {
 	InterchainAccounts []RegisteredInterchainAccount `protobuf:"bytes,2,rep,name=interchain_accounts,json=interchainAccounts,proto3" json:"interchain_accounts"`
 	Ports              []string                      `protobuf:"bytes,3,rep,name=ports,proto3" json:"ports,omitempty"`
 	Params             types.Params                  `protobuf:"bytes,4,opt,name=params,proto3" json:"params"`
+	ScheduledTxs       []types.ScheduledTx           `protobuf:"bytes,5,rep,name=scheduled_txs,json=scheduledTxs,proto3" json:"scheduled_txs"`
+	NextScheduledTxId  uint64                        `protobuf:"varint,6,opt,name=next_scheduled_tx_id,json=nextScheduledTxId,proto3" json:"next_scheduled_tx_id,omitempty"`
 }
 
 func (m *ControllerGenesisState) Reset()         { *m = ControllerGenesisState{} }
@@ -147,6 +149,20 @@ func (m *ControllerGenesisState) GetParams() types.Params {
 	return types.Params{}
 }
 
+func (m *ControllerGenesisState) GetScheduledTxs() []types.ScheduledTx {
+	if m != nil {
+		return m.ScheduledTxs
+	}
+	return nil
+}
+
+func (m *ControllerGenesisState) GetNextScheduledTxId() uint64 {
+	if m != nil {
+		return m.NextScheduledTxId
+	}
+	return 0
+}
+
 // HostGenesisState defines the interchain accounts host genesis state
 type HostGenesisState struct {
 	ActiveChannels     []ActiveChannel               `protobuf:"bytes,1,rep,name=active_channels,json=activeChannels,proto3" json:"active_channels"`
@@ -360,44 +376,48 @@ func init() {
 }
 
 var fileDescriptor_d4aa48c8e29a1947 = []byte{
-	// 590 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x95, 0xcf, 0x8b, 0xd3, 0x40,
-	0x14, 0xc7, 0x9b, 0xb4, 0x56, 0x3b, 0xfb, 0xc3, 0x65, 0x76, 0x5d, 0xc3, 0x8a, 0xb1, 0xd4, 0x83,
-	0xbd, 0x34, 0xa1, 0x55, 0x58, 0x14, 0x14, 0xba, 0x45, 0xd6, 0x82, 0x0b, 0x12, 0x2f, 0xe2, 0x25,
-	0x4c, 0x27, 0x43, 0x3a, 0x90, 0x64, 0x42, 0xde, 0xb4, 0xe2, 0x59, 0xc1, 0xa3, 0xfe, 0x09, 0xfe,
-	0x39, 0x7b, 0xdc, 0xa3, 0x27, 0x91, 0xf6, 0x0f, 0xf0, 0x2f, 0x10, 0x64, 0x26, 0xd9, 0xb6, 0xd6,
-	0x2a, 0x2d, 0x1e, 0x3d, 0x65, 0xe6, 0x7d, 0xf3, 0xbe, 0xef, 0x93, 0xf7, 0x92, 0x0c, 0x7a, 0xcc,
-	0x07, 0xd4, 0x25, 0x69, 0x1a, 0x71, 0x4a, 0x24, 0x17, 0x09, 0xb8, 0x3c, 0x91, 0x2c, 0xa3, 0x43,
-	0xc2, 0x13, 0x9f, 0x50, 0x2a, 0x46, 0x89, 0x04, 0x37, 0x64, 0x09, 0x03, 0x0e, 0xee, 0xb8, 0x7d,
-	0xb9, 0x74, 0xd2, 0x4c, 0x48, 0x81, 0x5d, 0x3e, 0xa0, 0xce, 0x62, 0xba, 0xb3, 0x22, 0xdd, 0xb9,
-	0xcc, 0x19, 0xb7, 0x8f, 0x0e, 0x42, 0x11, 0x0a, 0x9d, 0xeb, 0xaa, 0x55, 0x6e, 0x73, 0xd4, 0x5b,
-	0x8b, 0x82, 0x8a, 0x44, 0x66, 0x22, 0x8a, 0x58, 0xa6, 0x40, 0xe6, 0xbb, 0xc2, 0xe4, 0x78, 0x2d,
-	0x93, 0xa1, 0x00, 0xa9, 0xd2, 0xd5, 0x35, 0x4f, 0x6c, 0x7c, 0x34, 0xd1, 0xf6, 0x69, 0x8e, 0xf8,
-	0x52, 0x12, 0xc9, 0xf0, 0x07, 0x03, 0x59, 0x73, 0x7b, 0xbf, 0xc0, 0xf7, 0x41, 0x89, 0x96, 0x51,
-	0x37, 0x9a, 0x5b, 0x9d, 0x53, 0x67, 0xc3, 0x27, 0x77, 0x7a, 0x33, 0xc3, 0xc5, 0x5a, 0x27, 0x95,
-	0xf3, 0xaf, 0x77, 0x4a, 0xde, 0x21, 0x5d, 0xa9, 0xe2, 0x11, 0xc2, 0x0a, 0x74, 0x09, 0xc1, 0xd4,
-	0x08, 0xdd, 0x8d, 0x11, 0x9e, 0x09, 0x90, 0x2b, 0x8a, 0xef, 0x0d, 0x97, 0xe2, 0x8d, 0x1f, 0x26,
-	0x3a, 0x5c, 0xcd, 0x8b, 0x63, 0x74, 0x9d, 0x50, 0xc9, 0xc7, 0xcc, 0xa7, 0x43, 0x92, 0x24, 0x2c,
-	0x02, 0xcb, 0xa8, 0x97, 0x9b, 0x5b, 0x9d, 0x27, 0x1b, 0xe3, 0x74, 0xb5, 0x4f, 0x2f, 0xb7, 0x29,
-	0x58, 0x76, 0xc9, 0x62, 0x10, 0xf0, 0x3b, 0x03, 0xed, 0xaf, 0xb0, 0xb1, 0x4c, 0x5d, 0xf3, 0xf9,
-	0xc6, 0x35, 0x3d, 0x16, 0x72, 0x90, 0x2c, 0x63, 0x41, 0x7f, 0x76, 0x63, 0x37, 0xbf, 0xaf, 0x20,
-	0xc0, 0x7c, 0x59, 0x00, 0x7c, 0x80, 0xae, 0xa4, 0x22, 0x93, 0x60, 0x95, 0xeb, 0xe5, 0x66, 0xcd,
-	0xcb, 0x37, 0xf8, 0x15, 0xaa, 0xa6, 0x24, 0x23, 0x31, 0x58, 0x15, 0x3d, 0x90, 0x47, 0xeb, 0xd1,
-	0x2c, 0xbc, 0xb8, 0xe3, 0xb6, 0xf3, 0x42, 0x3b, 0x14, 0xb5, 0x0b, 0xbf, 0xc6, 0x77, 0x13, 0xed,
-	0x2d, 0x0f, 0xeb, 0xff, 0xec, 0x3c, 0x46, 0x15, 0xd5, 0x6c, 0xab, 0x5c, 0x37, 0x9a, 0x35, 0x4f,
-	0xaf, 0xb1, 0xb7, 0xd4, 0xf7, 0x07, 0xeb, 0xb1, 0xe8, 0x2f, 0xfe, 0x4f, 0x1d, 0xff, 0x6c, 0xa0,
-	0x9d, 0x5f, 0xba, 0x82, 0xef, 0xa2, 0x1d, 0x2a, 0x92, 0x84, 0x51, 0xe5, 0xe8, 0xf3, 0x40, 0x7f,
-	0xf8, 0x35, 0x6f, 0x7b, 0x1e, 0xec, 0x07, 0xf8, 0x26, 0xba, 0xaa, 0x90, 0x94, 0x6c, 0x6a, 0xb9,
-	0xaa, 0xb6, 0xfd, 0x00, 0xdf, 0x46, 0xa8, 0x98, 0x92, 0xd2, 0x72, 0xfa, 0x5a, 0x11, 0xe9, 0x07,
-	0xb8, 0x83, 0x6e, 0x70, 0xf0, 0x63, 0x1e, 0x04, 0x11, 0x7b, 0x43, 0x32, 0xe6, 0xb3, 0x84, 0x0c,
-	0x22, 0x16, 0xe8, 0x27, 0xba, 0xe6, 0xed, 0x73, 0x38, 0x9b, 0x69, 0x4f, 0x73, 0xa9, 0xf1, 0xde,
-	0x40, 0xb7, 0xfe, 0xd2, 0xc4, 0x7f, 0x04, 0xbe, 0xa7, 0xde, 0x2e, 0x6d, 0xe4, 0x93, 0x20, 0xc8,
-	0x18, 0x40, 0x41, 0xbd, 0x5b, 0x84, 0xbb, 0x79, 0xf4, 0x24, 0x3c, 0x9f, 0xd8, 0xc6, 0xc5, 0xc4,
-	0x36, 0xbe, 0x4d, 0x6c, 0xe3, 0xd3, 0xd4, 0x2e, 0x5d, 0x4c, 0xed, 0xd2, 0x97, 0xa9, 0x5d, 0x7a,
-	0x7d, 0x16, 0x72, 0x39, 0x1c, 0x0d, 0x1c, 0x2a, 0x62, 0x97, 0x0a, 0x88, 0x05, 0xa8, 0xe3, 0xa1,
-	0x15, 0x0a, 0x77, 0xfc, 0xd0, 0x8d, 0x45, 0x30, 0x8a, 0x18, 0xa8, 0x1f, 0x34, 0xb8, 0x9d, 0xe3,
-	0xd6, 0x7c, 0x42, 0xad, 0xdf, 0x8e, 0x19, 0xf9, 0x36, 0x65, 0x30, 0xa8, 0xea, 0xbf, 0xf3, 0xfd,
-	0x9f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x3b, 0x52, 0x16, 0x78, 0xa3, 0x06, 0x00, 0x00,
+	// 642 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x95, 0x4f, 0x6f, 0x13, 0x3d,
+	0x10, 0xc6, 0xe3, 0x24, 0xcd, 0xfb, 0xd6, 0xfd, 0x43, 0x71, 0x4b, 0x59, 0x15, 0x11, 0xa2, 0x70,
+	0x20, 0x97, 0xee, 0xaa, 0x01, 0xa9, 0x02, 0x09, 0x50, 0x5a, 0xa1, 0x12, 0x89, 0x4a, 0x68, 0xcb,
+	0x01, 0x71, 0x59, 0x39, 0xb6, 0xb5, 0x31, 0xda, 0xac, 0xa3, 0x1d, 0x27, 0x94, 0x33, 0x48, 0x1c,
+	0xe1, 0xcc, 0x89, 0x8f, 0xd3, 0x63, 0x8f, 0x9c, 0x10, 0x6a, 0x3f, 0x00, 0x5f, 0x01, 0xd9, 0xbb,
+	0x4d, 0x96, 0x10, 0x50, 0x02, 0x47, 0x4e, 0xb1, 0xe7, 0xd9, 0x79, 0xe6, 0x67, 0x7b, 0x94, 0xc1,
+	0xf7, 0x65, 0x87, 0x79, 0xb4, 0xdf, 0x8f, 0x24, 0xa3, 0x5a, 0xaa, 0x18, 0x3c, 0x19, 0x6b, 0x91,
+	0xb0, 0x2e, 0x95, 0x71, 0x40, 0x19, 0x53, 0x83, 0x58, 0x83, 0x17, 0x8a, 0x58, 0x80, 0x04, 0x6f,
+	0xb8, 0x73, 0xb1, 0x74, 0xfb, 0x89, 0xd2, 0x8a, 0x78, 0xb2, 0xc3, 0xdc, 0x7c, 0xba, 0x3b, 0x25,
+	0xdd, 0xbd, 0xc8, 0x19, 0xee, 0x6c, 0x6d, 0x84, 0x2a, 0x54, 0x36, 0xd7, 0x33, 0xab, 0xd4, 0x66,
+	0x6b, 0x7f, 0x26, 0x0a, 0xa6, 0x62, 0x9d, 0xa8, 0x28, 0x12, 0x89, 0x01, 0x19, 0xef, 0x32, 0x93,
+	0xdd, 0x99, 0x4c, 0xba, 0x0a, 0xb4, 0x49, 0x37, 0xbf, 0x69, 0x62, 0xfd, 0x7d, 0x11, 0x2f, 0x1f,
+	0xa4, 0x88, 0x47, 0x9a, 0x6a, 0x41, 0xde, 0x21, 0xec, 0x8c, 0xed, 0x83, 0x0c, 0x3f, 0x00, 0x23,
+	0x3a, 0xa8, 0x86, 0x1a, 0x4b, 0xcd, 0x03, 0x77, 0xce, 0x93, 0xbb, 0xfb, 0x23, 0xc3, 0x7c, 0xad,
+	0xbd, 0xf2, 0xc9, 0x97, 0x1b, 0x05, 0x7f, 0x93, 0x4d, 0x55, 0xc9, 0x00, 0x13, 0x03, 0x3a, 0x81,
+	0x50, 0xb4, 0x08, 0xad, 0xb9, 0x11, 0x1e, 0x2b, 0xd0, 0x53, 0x8a, 0xaf, 0x75, 0x27, 0xe2, 0xf5,
+	0x8f, 0x65, 0xbc, 0x39, 0x9d, 0x97, 0xf4, 0xf0, 0x25, 0xca, 0xb4, 0x1c, 0x8a, 0x80, 0x75, 0x69,
+	0x1c, 0x8b, 0x08, 0x1c, 0x54, 0x2b, 0x35, 0x96, 0x9a, 0x0f, 0xe6, 0xc6, 0x69, 0x59, 0x9f, 0xfd,
+	0xd4, 0x26, 0x63, 0x59, 0xa5, 0xf9, 0x20, 0x90, 0x37, 0x08, 0xaf, 0x4f, 0xb1, 0x71, 0x8a, 0xb6,
+	0xe6, 0x93, 0xb9, 0x6b, 0xfa, 0x22, 0x94, 0xa0, 0x45, 0x22, 0x78, 0x7b, 0xf4, 0x61, 0x2b, 0xfd,
+	0x2e, 0x23, 0x20, 0x72, 0x52, 0x00, 0xb2, 0x81, 0x17, 0xfa, 0x2a, 0xd1, 0xe0, 0x94, 0x6a, 0xa5,
+	0xc6, 0xa2, 0x9f, 0x6e, 0xc8, 0x73, 0x5c, 0xe9, 0xd3, 0x84, 0xf6, 0xc0, 0x29, 0xdb, 0x07, 0xb9,
+	0x37, 0x1b, 0x4d, 0xae, 0x71, 0x87, 0x3b, 0xee, 0x53, 0xeb, 0x90, 0xd5, 0xce, 0xfc, 0xc8, 0x4b,
+	0xbc, 0x02, 0xac, 0x2b, 0xf8, 0x20, 0x12, 0x3c, 0xd0, 0xc7, 0xe0, 0x2c, 0xd8, 0xe3, 0x3e, 0xfc,
+	0x93, 0x02, 0x47, 0x17, 0x46, 0xcf, 0x8e, 0xb3, 0x2a, 0xcb, 0x30, 0x0e, 0x01, 0xf1, 0xf0, 0x46,
+	0x2c, 0x8e, 0x75, 0x90, 0x2f, 0x18, 0x48, 0xee, 0x54, 0x6a, 0xa8, 0x51, 0xf6, 0x2f, 0x1b, 0x2d,
+	0x67, 0xd1, 0xe6, 0xf5, 0x6f, 0x45, 0xbc, 0x36, 0xd9, 0x49, 0xff, 0x66, 0x5b, 0x10, 0x5c, 0x36,
+	0x9d, 0xe0, 0x94, 0x6a, 0xa8, 0xb1, 0xe8, 0xdb, 0x35, 0xf1, 0x27, 0x9a, 0xe2, 0xce, 0x6c, 0x2c,
+	0xf6, 0xef, 0xe8, 0x17, 0xed, 0x50, 0xff, 0x84, 0xf0, 0xca, 0x0f, 0xb7, 0x42, 0x6e, 0xe2, 0x15,
+	0xa6, 0xe2, 0x58, 0x30, 0xe3, 0x68, 0x5e, 0x0b, 0x59, 0x84, 0xe5, 0x71, 0xb0, 0xcd, 0xc9, 0x55,
+	0xfc, 0x9f, 0x41, 0x32, 0x72, 0xd1, 0xca, 0x15, 0xb3, 0x6d, 0x73, 0x72, 0x1d, 0xe3, 0xec, 0x95,
+	0x8c, 0x96, 0xd2, 0x2f, 0x66, 0x91, 0x36, 0x27, 0x4d, 0x7c, 0x45, 0x42, 0xd0, 0x93, 0x9c, 0x47,
+	0xe2, 0x15, 0x4d, 0x44, 0x20, 0x62, 0xda, 0x89, 0x04, 0xb7, 0x27, 0xfa, 0xdf, 0x5f, 0x97, 0x70,
+	0x38, 0xd2, 0x1e, 0xa5, 0x52, 0xfd, 0x2d, 0xc2, 0xd7, 0x7e, 0x73, 0x89, 0x7f, 0x09, 0x7c, 0xcb,
+	0x74, 0x97, 0x35, 0x0a, 0x28, 0xe7, 0x89, 0x00, 0xc8, 0xa8, 0x57, 0xb3, 0x70, 0x2b, 0x8d, 0xee,
+	0x85, 0x27, 0x67, 0x55, 0x74, 0x7a, 0x56, 0x45, 0x5f, 0xcf, 0xaa, 0xe8, 0xc3, 0x79, 0xb5, 0x70,
+	0x7a, 0x5e, 0x2d, 0x7c, 0x3e, 0xaf, 0x16, 0x5e, 0x1c, 0x86, 0x52, 0x77, 0x07, 0x1d, 0x97, 0xa9,
+	0x9e, 0xc7, 0x14, 0xf4, 0x14, 0x98, 0xd9, 0xb5, 0x1d, 0x2a, 0x6f, 0x78, 0xd7, 0xeb, 0x29, 0xd3,
+	0xde, 0x60, 0xa6, 0x07, 0x78, 0xcd, 0xdd, 0xed, 0xf1, 0x0b, 0x6d, 0xff, 0x34, 0x03, 0xf5, 0xeb,
+	0xbe, 0x80, 0x4e, 0xc5, 0x8e, 0x8e, 0xdb, 0xdf, 0x07, 0x00, 0xea, 0x24, 0xb6, 0xb4, 0x40, 0x07,
+	0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -463,6 +483,25 @@ func (m *ControllerGenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.NextScheduledTxId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextScheduledTxId))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.ScheduledTxs) > 0 {
+		for iNdEx := len(m.ScheduledTxs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScheduledTxs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -729,6 +768,15 @@ func (m *ControllerGenesisState) Size() (n int) {
 	}
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.ScheduledTxs) > 0 {
+		for _, e := range m.ScheduledTxs {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextScheduledTxId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextScheduledTxId))
+	}
 	return n
 }
 
@@ -1088,6 +1136,59 @@ func (m *ControllerGenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledTxs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScheduledTxs = append(m.ScheduledTxs, types.ScheduledTx{})
+			if err := m.ScheduledTxs[len(m.ScheduledTxs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextScheduledTxId", wireType)
+			}
+			m.NextScheduledTxId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextScheduledTxId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
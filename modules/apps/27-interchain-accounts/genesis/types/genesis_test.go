@@ -2,6 +2,7 @@ package types_test
 
 import (
 	"testing"
+	"time"
 
 	testifysuite "github.com/stretchr/testify/suite"
 
@@ -20,6 +21,22 @@ var (
 	TestPortID, _ = icatypes.NewControllerPortID(TestOwnerAddress)
 )
 
+// scheduledTx returns a valid scheduled transaction with the provided identifier
+func scheduledTx(id uint64) controllertypes.ScheduledTx {
+	return controllertypes.ScheduledTx{
+		Id:           id,
+		Owner:        TestOwnerAddress,
+		ConnectionId: ibctesting.FirstConnectionID,
+		PacketData: icatypes.InterchainAccountPacketData{
+			Type: icatypes.EXECUTE_TX,
+			Data: []byte("data"),
+		},
+		RelativeTimeout:     uint64(time.Minute.Nanoseconds()),
+		NextExecutionTime:   time.Unix(1700000000, 0).UTC(),
+		RemainingExecutions: 1,
+	}
+}
+
 type GenesisTypesTestSuite struct {
 	testifysuite.Suite
 }
@@ -187,6 +204,41 @@ func (suite *GenesisTypesTestSuite) TestValidateControllerGenesisState() {
 			},
 			false,
 		},
+		{
+			"success: scheduled transactions",
+			func() {
+				genesisState.ScheduledTxs = []controllertypes.ScheduledTx{scheduledTx(0), scheduledTx(1)}
+				genesisState.NextScheduledTxId = 2
+			},
+			true,
+		},
+		{
+			"failed to validate scheduled transactions - invalid scheduled transaction",
+			func() {
+				invalidScheduledTx := scheduledTx(0)
+				invalidScheduledTx.RemainingExecutions = 0
+
+				genesisState.ScheduledTxs = []controllertypes.ScheduledTx{invalidScheduledTx}
+				genesisState.NextScheduledTxId = 1
+			},
+			false,
+		},
+		{
+			"failed to validate scheduled transactions - duplicate identifier",
+			func() {
+				genesisState.ScheduledTxs = []controllertypes.ScheduledTx{scheduledTx(0), scheduledTx(0)}
+				genesisState.NextScheduledTxId = 1
+			},
+			false,
+		},
+		{
+			"failed to validate scheduled transactions - identifier not less than next identifier",
+			func() {
+				genesisState.ScheduledTxs = []controllertypes.ScheduledTx{scheduledTx(1)}
+				genesisState.NextScheduledTxId = 1
+			},
+			false,
+		},
 	}
 
 	for _, tc := range testCases {
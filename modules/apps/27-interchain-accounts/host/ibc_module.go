@@ -116,18 +116,17 @@ func (im IBCModule) OnRecvPacket(
 	ctx context.Context,
 	_ string,
 	packet channeltypes.Packet,
-	_ sdk.AccAddress,
+	relayer sdk.AccAddress,
 ) ibcexported.Acknowledgement {
 	if !im.keeper.GetParams(ctx).HostEnabled {
 		im.keeper.Logger(ctx).Info("host submodule is disabled")
 		keeper.EmitHostDisabledEvent(ctx, packet)
-		return channeltypes.NewErrorAcknowledgement(types.ErrHostSubModuleDisabled)
+		return im.keeper.NewAcknowledgement(ctx, packet, nil, types.ErrHostSubModuleDisabled)
 	}
 
-	txResponse, err := im.keeper.OnRecvPacket(ctx, packet)
-	ack := channeltypes.NewResultAcknowledgement(txResponse)
+	txResponse, err := im.keeper.OnRecvPacket(ctx, packet, relayer)
+	ack := im.keeper.NewAcknowledgement(ctx, packet, txResponse, err)
 	if err != nil {
-		ack = channeltypes.NewErrorAcknowledgement(err)
 		im.keeper.Logger(ctx).Error(fmt.Sprintf("%s sequence %d", err.Error(), packet.Sequence))
 	} else {
 		im.keeper.Logger(ctx).Info("successfully handled packet", "sequence", packet.Sequence)
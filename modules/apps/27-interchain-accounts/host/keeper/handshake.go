@@ -50,7 +50,7 @@ func (k Keeper) OnChanOpenTry(
 	// set here the HostConnectionId in case the controller did not set it
 	metadata.HostConnectionId = connectionHops[0]
 
-	if err = icatypes.ValidateHostMetadata(ctx, k.channelKeeper, connectionHops, metadata); err != nil {
+	if err = icatypes.ValidateHostMetadata(ctx, k.channelKeeper, k.encodingRegistry, connectionHops, metadata); err != nil {
 		return "", err
 	}
 
@@ -96,12 +96,7 @@ func (k Keeper) OnChanOpenTry(
 	}
 
 	metadata.Address = accAddress.String()
-	versionBytes, err := icatypes.ModuleCdc.MarshalJSON(&metadata)
-	if err != nil {
-		return "", err
-	}
-
-	return string(versionBytes), nil
+	return string(metadata.GetBytes()), nil
 }
 
 // OnChanOpenConfirm completes the handshake process by setting the active channel in state on the host chain
@@ -141,6 +136,7 @@ func (Keeper) OnChanCloseConfirm(
 // The following may be changed:
 // - tx type (must be supported)
 // - encoding (must be supported)
+// - ack format (must be supported)
 // - order
 //
 // The following may not be changed:
@@ -178,7 +174,7 @@ func (k Keeper) OnChanUpgradeTry(ctx context.Context, portID, channelID string,
 
 	// ValidateHostMetadata will ensure the ICS27 protocol version has not changed and that the
 	// tx type and encoding are supported. It also validates the connection params against the counterparty metadata.
-	if err := icatypes.ValidateHostMetadata(ctx, k.channelKeeper, proposedConnectionHops, proposedCounterpartyMetadata); err != nil {
+	if err := icatypes.ValidateHostMetadata(ctx, k.channelKeeper, k.encodingRegistry, proposedConnectionHops, proposedCounterpartyMetadata); err != nil {
 		return "", errorsmod.Wrap(err, "invalid metadata")
 	}
 
@@ -52,6 +52,12 @@ type Keeper struct {
 	// mqsAllowList is a list of all module safe query paths
 	mqsAllowList []string
 
+	// messageFilters restrict the contents of the messages executed by interchain accounts
+	messageFilters []types.MessageFilter
+
+	// encodingRegistry contains the encodings supported for the packet data of interchain accounts
+	encodingRegistry *icatypes.TxEncodingRegistry
+
 	// the address capable of executing a MsgUpdateParams message. Typically, this
 	// should be the x/gov module account.
 	authority string
@@ -74,18 +80,19 @@ func NewKeeper(
 	}
 
 	return Keeper{
-		storeService:   storeService,
-		cdc:            cdc,
-		legacySubspace: legacySubspace,
-		ics4Wrapper:    ics4Wrapper,
-		channelKeeper:  channelKeeper,
-		portKeeper:     portKeeper,
-		accountKeeper:  accountKeeper,
-		scopedKeeper:   scopedKeeper,
-		msgRouter:      msgRouter,
-		queryRouter:    queryRouter,
-		mqsAllowList:   newModuleQuerySafeAllowList(),
-		authority:      authority,
+		storeService:     storeService,
+		cdc:              cdc,
+		legacySubspace:   legacySubspace,
+		ics4Wrapper:      ics4Wrapper,
+		channelKeeper:    channelKeeper,
+		portKeeper:       portKeeper,
+		accountKeeper:    accountKeeper,
+		scopedKeeper:     scopedKeeper,
+		msgRouter:        msgRouter,
+		queryRouter:      queryRouter,
+		mqsAllowList:     newModuleQuerySafeAllowList(),
+		encodingRegistry: icatypes.NewTxEncodingRegistry(),
+		authority:        authority,
 	}
 }
 
@@ -96,6 +103,19 @@ func (k *Keeper) WithICS4Wrapper(wrapper porttypes.ICS4Wrapper) {
 	k.ics4Wrapper = wrapper
 }
 
+// WithMessageFilters sets the message filters applied to the messages executed by interchain accounts.
+// This function must be called right after the keeper is created, before it is passed to the IBC module.
+func (k *Keeper) WithMessageFilters(filters ...types.MessageFilter) {
+	k.messageFilters = filters
+}
+
+// RegisterTxEncoding registers an additional encoding format which may be negotiated in the ICS27 Metadata
+// of interchain account channels. The protobuf and proto3 JSON encodings are registered by default.
+// This function must be called right after the keeper is created, before any channel handshake is executed.
+func (k *Keeper) RegisterTxEncoding(format string, encoding icatypes.TxEncoding) {
+	k.encodingRegistry.Register(format, encoding)
+}
+
 // GetICS4Wrapper returns the ICS4Wrapper.
 func (k Keeper) GetICS4Wrapper() porttypes.ICS4Wrapper {
 	return k.ics4Wrapper
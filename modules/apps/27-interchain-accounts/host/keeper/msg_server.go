@@ -2,14 +2,11 @@ package keeper
 
 import (
 	"context"
-	"slices"
 
 	errorsmod "cosmossdk.io/errors"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
-	abci "github.com/cometbft/cometbft/abci/types"
-
 	"github.com/cosmos/ibc-go/v9/modules/apps/27-interchain-accounts/host/types"
 	ibcerrors "github.com/cosmos/ibc-go/v9/modules/core/errors"
 )
@@ -33,29 +30,12 @@ func (m msgServer) ModuleQuerySafe(goCtx context.Context, msg *types.MsgModuleQu
 
 	responses := make([][]byte, len(msg.Requests))
 	for i, query := range msg.Requests {
-		isModuleQuerySafe := slices.Contains(m.mqsAllowList, query.Path)
-		if !isModuleQuerySafe {
-			return nil, errorsmod.Wrapf(ibcerrors.ErrInvalidRequest, "not module query safe: %s", query.Path)
-		}
-
-		route := m.queryRouter.Route(query.Path)
-		if route == nil {
-			return nil, errorsmod.Wrapf(ibcerrors.ErrInvalidRequest, "no route to query: %s", query.Path)
-		}
-
-		res, err := route(ctx, &abci.RequestQuery{
-			Path: query.Path,
-			Data: query.Data,
-		})
+		res, err := m.queryModuleSafe(ctx, query.Path, query.Data)
 		if err != nil {
-			m.Logger(ctx).Debug("query failed", "path", query.Path, "error", err)
 			return nil, err
 		}
-		if res == nil || res.Value == nil {
-			return nil, errorsmod.Wrapf(ibcerrors.ErrInvalidRequest, "no response for query: %s", query.Path)
-		}
 
-		responses[i] = res.Value
+		responses[i] = res
 	}
 
 	return &types.MsgModuleQuerySafeResponse{Responses: responses, Height: uint64(ctx.BlockHeight())}, nil
@@ -2,23 +2,33 @@ package keeper
 
 import (
 	"context"
+	"slices"
 
 	"github.com/cosmos/gogoproto/proto"
 
 	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	abci "github.com/cometbft/cometbft/abci/types"
 
 	"github.com/cosmos/ibc-go/v9/modules/apps/27-interchain-accounts/host/types"
 	icatypes "github.com/cosmos/ibc-go/v9/modules/apps/27-interchain-accounts/types"
 	channeltypes "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
 	ibcerrors "github.com/cosmos/ibc-go/v9/modules/core/errors"
+	ibcexported "github.com/cosmos/ibc-go/v9/modules/core/exported"
 )
 
 // OnRecvPacket handles a given interchain accounts packet on a destination host chain.
 // If the transaction is successfully executed, the transaction response bytes will be returned.
-func (k Keeper) OnRecvPacket(ctx context.Context, packet channeltypes.Packet) ([]byte, error) {
+// The fee set in the packet data, if any, is paid by the interchain account to the relayer.
+// If the queries are successfully executed, the query response bytes will be returned.
+// If the structured acknowledgement format was negotiated on the channel, the protobuf encoded ExecutionResult
+// of the transaction is returned instead of the transaction response bytes.
+func (k Keeper) OnRecvPacket(ctx context.Context, packet channeltypes.Packet, relayer sdk.AccAddress) ([]byte, error) {
 	var data icatypes.InterchainAccountPacketData
 	err := data.UnmarshalJSON(packet.GetData())
 	if err != nil {
@@ -31,68 +41,180 @@ func (k Keeper) OnRecvPacket(ctx context.Context, packet channeltypes.Packet) ([
 		return nil, err
 	}
 
+	encoding, found := k.encodingRegistry.GetEncoding(metadata.Encoding)
+	if !found {
+		return nil, errorsmod.Wrapf(icatypes.ErrInvalidCodec, "unsupported encoding format %s", metadata.Encoding)
+	}
+
 	switch data.Type {
 	case icatypes.EXECUTE_TX:
-		msgs, err := icatypes.DeserializeCosmosTx(k.cdc, data.Data, metadata.Encoding)
+		msgs, err := encoding.Deserialize(k.cdc, data.Data)
 		if err != nil {
 			return nil, errorsmod.Wrapf(err, "failed to deserialize interchain account transaction")
 		}
 
-		txResponse, err := k.executeTx(ctx, packet.SourcePort, packet.DestinationPort, packet.DestinationChannel, msgs)
+		result, err := k.executeTx(ctx, packet.SourcePort, packet.DestinationPort, packet.DestinationChannel, msgs, data.GasLimit, data.Fee, relayer)
 		if err != nil {
 			return nil, errorsmod.Wrapf(err, "failed to execute interchain account transaction")
 		}
+
+		if metadata.AckFormat == icatypes.AckFormatStructured {
+			return k.cdc.Marshal(result)
+		}
+
+		txResponse, err := proto.Marshal(newTxMsgData(result))
+		if err != nil {
+			return nil, errorsmod.Wrap(err, "failed to marshal tx data")
+		}
 		return txResponse, nil
+	case icatypes.QUERY:
+		queryEncoding, ok := encoding.(icatypes.QueryEncoding)
+		if !ok {
+			return nil, errorsmod.Wrapf(icatypes.ErrInvalidCodec, "encoding format %s does not support queries", metadata.Encoding)
+		}
+
+		requests, err := queryEncoding.DeserializeQuery(k.cdc, data.Data)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "failed to deserialize interchain account query")
+		}
+
+		queryResponse, err := k.executeQuery(ctx, requests)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "failed to execute interchain account query")
+		}
+		return queryResponse, nil
 	default:
 		return nil, icatypes.ErrUnknownDataType
 	}
 }
 
-// executeTx attempts to execute the provided transaction. It begins by authenticating the transaction signer.
-// If authentication succeeds, it does basic validation of the messages before attempting to deliver each message
-// into state. The state changes will only be committed if all messages in the transaction succeed. Thus the
-// execution of the transaction is atomic, all state changes are reverted if a single message fails.
-func (k Keeper) executeTx(ctx context.Context, sourcePort, destPort, destChannel string, msgs []sdk.Msg) ([]byte, error) {
+// NewAcknowledgement returns the acknowledgement of the packet in the acknowledgement format negotiated on its channel.
+// A result acknowledgement containing the provided result is returned if the error is nil, otherwise an error acknowledgement.
+func (k Keeper) NewAcknowledgement(ctx context.Context, packet channeltypes.Packet, result []byte, err error) ibcexported.Acknowledgement {
+	metadata, metadataErr := k.getAppMetadata(ctx, packet.DestinationPort, packet.DestinationChannel)
+	if metadataErr == nil && metadata.AckFormat == icatypes.AckFormatStructured {
+		if err != nil {
+			return icatypes.NewErrorAcknowledgement(err)
+		}
+
+		return icatypes.NewResultAcknowledgement(result)
+	}
+
+	if err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+
+	return channeltypes.NewResultAcknowledgement(result)
+}
+
+// executeTx attempts to execute the provided transaction. It begins by authenticating the transaction signer and the
+// payment of the fee, which is subject to the same host params as a bank MsgSend included in the transaction.
+// If authentication succeeds, it pays the fee to the relayer and does basic validation of the messages before
+// attempting to deliver each message into state. The state changes will only be committed if all messages in the
+// transaction succeed. Thus the execution of the transaction is atomic, all state changes are reverted if a single
+// message fails. If a gas limit is provided, the execution fails once it consumes more gas than the limit, and the gas
+// consumed by the execution, up to the limit, is charged to the relayer whether the execution succeeds or fails.
+// The errors of the messages are wrapped in a MsgExecutionError recording the index of the failed message.
+func (k Keeper) executeTx(ctx context.Context, sourcePort, destPort, destChannel string, msgs []sdk.Msg, gasLimit uint64, fee sdk.Coins, relayer sdk.AccAddress) (result *icatypes.ExecutionResult, err error) {
 	channel, found := k.channelKeeper.GetChannel(ctx, destPort, destChannel)
 	if !found {
 		return nil, channeltypes.ErrChannelNotFound
 	}
 
-	if err := k.authenticateTx(ctx, msgs, channel.ConnectionHops[0], sourcePort); err != nil {
+	connectionID := channel.ConnectionHops[0]
+
+	// the fee is paid with a bank MsgSend which is authenticated together with the messages of the transaction,
+	// such that the fee is subject to the allowlist, message constraints and message filters of the host
+	authMsgs := msgs
+	var feeMsg *banktypes.MsgSend
+	if !fee.IsZero() {
+		feeMsg, err = k.newFeeMsg(ctx, connectionID, sourcePort, fee, relayer)
+		if err != nil {
+			return nil, err
+		}
+
+		authMsgs = append(slices.Clone(msgs), feeMsg)
+	}
+
+	if err := k.authenticateTx(ctx, authMsgs, connectionID, sourcePort); err != nil {
 		return nil, err
 	}
 
-	txMsgData := &sdk.TxMsgData{
-		MsgResponses: make([]*codectypes.Any, len(msgs)),
+	result = &icatypes.ExecutionResult{
+		MsgResults: make([]icatypes.MsgResult, len(msgs)),
 	}
 
+	// msgIndex is the index of the message being executed, it is -1 until the execution of the messages starts
+	msgIndex := -1
+
 	// CacheContext returns a new context with the multi-store branched into a cached storage object
 	// writeCache is called only if all msgs succeed, performing state transitions atomically
 	sdkCtx := sdk.UnwrapSDKContext(ctx) // TODO: https://github.com/cosmos/ibc-go/issues/5917
 	cacheCtx, writeCache := sdkCtx.CacheContext()
+
+	if gasLimit > 0 {
+		// the gas meter of the execution is capped at the gas remaining for the relayer, such that the execution
+		// cannot consume more gas than the relayer provided
+		gasMeter := storetypes.NewGasMeter(min(gasLimit, sdkCtx.GasMeter().GasRemaining()))
+		cacheCtx = cacheCtx.WithGasMeter(gasMeter)
+
+		defer func() {
+			if r := recover(); r != nil {
+				// only recover from running out of the gas limit of the transaction, not of the gas of the relayer
+				if _, ok := r.(storetypes.ErrorOutOfGas); !ok || !gasMeter.IsPastLimit() || gasMeter.Limit() < gasLimit {
+					panic(r)
+				}
+
+				result, err = nil, errorsmod.Wrapf(ibcerrors.ErrOutOfGas, "interchain account transaction exceeded gas limit %d", gasLimit)
+				if msgIndex >= 0 {
+					err = &icatypes.MsgExecutionError{MsgIndex: msgIndex, Err: err}
+				}
+			}
+
+			// the gas consumed by the execution is charged to the relayer whether the execution succeeds or fails
+			sdkCtx.GasMeter().ConsumeGas(gasMeter.GasConsumedToLimit(), "interchain account transaction execution")
+		}()
+	}
+
+	if feeMsg != nil {
+		if _, err := k.executeMsg(cacheCtx, feeMsg); err != nil {
+			return nil, errorsmod.Wrap(err, "failed to pay interchain account transaction fee")
+		}
+	}
+
 	for i, msg := range msgs {
+		msgIndex = i
+
 		if m, ok := msg.(sdk.HasValidateBasic); ok {
 			if err := m.ValidateBasic(); err != nil {
-				return nil, err
+				return nil, &icatypes.MsgExecutionError{MsgIndex: i, Err: err}
 			}
 		}
 
-		protoAny, err := k.executeMsg(cacheCtx, msg)
+		msgResult, err := k.executeMsg(cacheCtx, msg)
 		if err != nil {
-			return nil, err
+			return nil, &icatypes.MsgExecutionError{MsgIndex: i, Err: err}
 		}
 
-		txMsgData.MsgResponses[i] = protoAny
+		result.MsgResults[i] = *msgResult
 	}
 
 	writeCache()
 
-	txResponse, err := proto.Marshal(txMsgData)
-	if err != nil {
-		return nil, errorsmod.Wrap(err, "failed to marshal tx data")
+	return result, nil
+}
+
+// newTxMsgData returns the TxMsgData containing the message responses of the provided execution result.
+func newTxMsgData(result *icatypes.ExecutionResult) *sdk.TxMsgData {
+	txMsgData := &sdk.TxMsgData{
+		MsgResponses: make([]*codectypes.Any, len(result.MsgResults)),
+	}
+
+	for i, msgResult := range result.MsgResults {
+		txMsgData.MsgResponses[i] = msgResult.MsgResponse
 	}
 
-	return txResponse, nil
+	return txMsgData
 }
 
 // authenticateTx ensures the provided msgs contain the correct interchain account signer address retrieved
@@ -103,11 +225,25 @@ func (k Keeper) authenticateTx(ctx context.Context, msgs []sdk.Msg, connectionID
 		return errorsmod.Wrapf(icatypes.ErrInterchainAccountNotFound, "failed to retrieve interchain account on port %s", portID)
 	}
 
-	allowMsgs := k.GetParams(ctx).AllowMessages
+	params := k.GetParams(ctx)
+	allowMsgs := params.AllowMessagesForController(connectionID, portID)
 	for _, msg := range msgs {
 		if !types.ContainsMsgType(allowMsgs, msg) {
 			return errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "message type not allowed: %s", sdk.MsgTypeURL(msg))
 		}
+	}
+
+	// the message constraints are checked against all the messages of the tx at once
+	if err := params.CheckMessageConstraints(msgs); err != nil {
+		return err
+	}
+
+	for _, msg := range msgs {
+		for _, filter := range k.messageFilters {
+			if err := filter.FilterMessage(ctx, connectionID, portID, msg); err != nil {
+				return errorsmod.Wrapf(err, "message filtered: %s", sdk.MsgTypeURL(msg))
+			}
+		}
 
 		// obtain the message signers using the proto signer annotations
 		// the msgv2 return value is discarded as it is not used
@@ -129,9 +265,28 @@ func (k Keeper) authenticateTx(ctx context.Context, msgs []sdk.Msg, connectionID
 	return nil
 }
 
+// newFeeMsg returns the bank MsgSend paying the fee from the interchain account of the controller port on the given
+// connection to the relayer.
+func (k Keeper) newFeeMsg(ctx context.Context, connectionID, portID string, fee sdk.Coins, relayer sdk.AccAddress) (*banktypes.MsgSend, error) {
+	if relayer.Empty() {
+		return nil, errorsmod.Wrap(ibcerrors.ErrInvalidAddress, "relayer address must be set to pay the interchain account transaction fee")
+	}
+
+	interchainAccountAddr, found := k.GetInterchainAccountAddress(ctx, connectionID, portID)
+	if !found {
+		return nil, errorsmod.Wrapf(icatypes.ErrInterchainAccountNotFound, "failed to retrieve interchain account on port %s", portID)
+	}
+
+	return &banktypes.MsgSend{
+		FromAddress: interchainAccountAddr,
+		ToAddress:   relayer.String(),
+		Amount:      fee,
+	}, nil
+}
+
 // Attempts to get the message handler from the router and if found will then execute the message.
-// If the message execution is successful, the proto marshaled message response will be returned.
-func (k Keeper) executeMsg(ctx sdk.Context, msg sdk.Msg) (*codectypes.Any, error) {
+// If the message execution is successful, the message response and the types of the emitted events will be returned.
+func (k Keeper) executeMsg(ctx sdk.Context, msg sdk.Msg) (*icatypes.MsgResult, error) {
 	handler := k.msgRouter.Handler(msg)
 	if handler == nil {
 		return nil, icatypes.ErrInvalidRoute
@@ -151,5 +306,64 @@ func (k Keeper) executeMsg(ctx sdk.Context, msg sdk.Msg) (*codectypes.Any, error
 		return nil, errorsmod.Wrapf(ibcerrors.ErrLogic, "got nil Msg response for msg %s", sdk.MsgTypeURL(msg))
 	}
 
-	return msgResponse, nil
+	eventTypes := make([]string, len(res.GetEvents()))
+	for i, event := range res.GetEvents() {
+		eventTypes[i] = event.Type
+	}
+
+	return &icatypes.MsgResult{
+		MsgResponse: msgResponse,
+		EventTypes:  eventTypes,
+	}, nil
+}
+
+// executeQuery executes the provided query requests and returns the protobuf encoded CosmosQueryResponse.
+// Only queries labeled as module_query_safe may be executed. The queries are executed on a branched
+// context whose state changes are discarded.
+func (k Keeper) executeQuery(ctx context.Context, requests []icatypes.CosmosQueryRequest) ([]byte, error) {
+	if len(requests) == 0 {
+		return nil, errorsmod.Wrap(ibcerrors.ErrInvalidRequest, "query requests cannot be empty")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx) // TODO: https://github.com/cosmos/ibc-go/issues/5917
+	cacheCtx, _ := sdkCtx.CacheContext()
+
+	responses := make([][]byte, len(requests))
+	for i, request := range requests {
+		res, err := k.queryModuleSafe(cacheCtx, request.Path, request.Data)
+		if err != nil {
+			return nil, err
+		}
+
+		responses[i] = res
+	}
+
+	return k.cdc.Marshal(&icatypes.CosmosQueryResponse{Responses: responses, Height: uint64(sdkCtx.BlockHeight())})
+}
+
+// queryModuleSafe routes the query to the query router if the query path is labeled as module_query_safe
+// and returns the response value.
+func (k Keeper) queryModuleSafe(ctx sdk.Context, path string, data []byte) ([]byte, error) {
+	if !slices.Contains(k.mqsAllowList, path) {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrInvalidRequest, "not module query safe: %s", path)
+	}
+
+	route := k.queryRouter.Route(path)
+	if route == nil {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrInvalidRequest, "no route to query: %s", path)
+	}
+
+	res, err := route(ctx, &abci.RequestQuery{
+		Path: path,
+		Data: data,
+	})
+	if err != nil {
+		k.Logger(ctx).Debug("query failed", "path", path, "error", err)
+		return nil, err
+	}
+	if res == nil || res.Value == nil {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrInvalidRequest, "no response for query: %s", path)
+	}
+
+	return res.Value, nil
 }
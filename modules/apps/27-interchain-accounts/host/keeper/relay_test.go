@@ -1,16 +1,22 @@
 package keeper_test
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"strings"
 	"time"
 
 	"github.com/cosmos/gogoproto/proto"
 
+	errorsmod "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
 
+	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	disttypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
@@ -452,6 +458,156 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 			},
 			icatypes.ErrInterchainAccountNotFound,
 		},
+		{
+			"interchain account successfully executes banktypes.MsgSend allowed by the controller allowlist",
+			func(encoding string) {
+				interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+				suite.Require().True(found)
+
+				msg := &banktypes.MsgSend{
+					FromAddress: interchainAccountAddr,
+					ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+					Amount:      sdk.NewCoins(ibctesting.TestCoin),
+				}
+
+				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []proto.Message{msg}, encoding)
+				suite.Require().NoError(err)
+
+				icaPacketData := icatypes.InterchainAccountPacketData{
+					Type: icatypes.EXECUTE_TX,
+					Data: data,
+				}
+
+				packetData = icaPacketData.GetBytes()
+
+				params := types.NewParams(true, []string{sdk.MsgTypeURL((*stakingtypes.MsgDelegate)(nil))})
+				params.ControllerAllowMessages = []types.ControllerAllowMessages{
+					types.NewControllerAllowMessages(path.EndpointB.ConnectionID, icatypes.ControllerPortPrefix, []string{sdk.MsgTypeURL(msg)}),
+				}
+				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+			},
+			nil,
+		},
+		{
+			"unauthorised: message type allowed chain-wide but not by the controller allowlist",
+			func(encoding string) {
+				interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+				suite.Require().True(found)
+
+				msg := &banktypes.MsgSend{
+					FromAddress: interchainAccountAddr,
+					ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+					Amount:      sdk.NewCoins(ibctesting.TestCoin),
+				}
+
+				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []proto.Message{msg}, encoding)
+				suite.Require().NoError(err)
+
+				icaPacketData := icatypes.InterchainAccountPacketData{
+					Type: icatypes.EXECUTE_TX,
+					Data: data,
+				}
+
+				packetData = icaPacketData.GetBytes()
+
+				params := types.NewParams(true, []string{"*"})
+				params.ControllerAllowMessages = []types.ControllerAllowMessages{
+					types.NewControllerAllowMessages(path.EndpointB.ConnectionID, "", []string{sdk.MsgTypeURL((*stakingtypes.MsgDelegate)(nil))}),
+				}
+				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"unauthorised: message does not satisfy the message constraint",
+			func(encoding string) {
+				interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+				suite.Require().True(found)
+
+				msg := &banktypes.MsgSend{
+					FromAddress: interchainAccountAddr,
+					ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+					Amount:      sdk.NewCoins(ibctesting.TestCoin),
+				}
+
+				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []proto.Message{msg}, encoding)
+				suite.Require().NoError(err)
+
+				icaPacketData := icatypes.InterchainAccountPacketData{
+					Type: icatypes.EXECUTE_TX,
+					Data: data,
+				}
+
+				packetData = icaPacketData.GetBytes()
+
+				params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)})
+				params.MessageConstraints = []types.MessageConstraint{
+					types.NewMessageConstraint(sdk.MsgTypeURL(msg), []string{interchainAccountAddr}, nil, nil),
+				}
+				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"unauthorised: summed amount of the messages exceeds the max amount of the message constraint",
+			func(encoding string) {
+				interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+				suite.Require().True(found)
+
+				msg := &banktypes.MsgSend{
+					FromAddress: interchainAccountAddr,
+					ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+					Amount:      sdk.NewCoins(ibctesting.TestCoin),
+				}
+
+				// each message is within the max amount, but not their sum
+				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []proto.Message{msg, msg}, encoding)
+				suite.Require().NoError(err)
+
+				icaPacketData := icatypes.InterchainAccountPacketData{
+					Type: icatypes.EXECUTE_TX,
+					Data: data,
+				}
+
+				packetData = icaPacketData.GetBytes()
+
+				params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)})
+				params.MessageConstraints = []types.MessageConstraint{
+					types.NewMessageConstraint(sdk.MsgTypeURL(msg), nil, nil, sdk.NewCoins(ibctesting.TestCoin)),
+				}
+				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"unauthorised: message rejected by message filter",
+			func(encoding string) {
+				interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+				suite.Require().True(found)
+
+				msg := &banktypes.MsgSend{
+					FromAddress: interchainAccountAddr,
+					ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+					Amount:      sdk.NewCoins(ibctesting.TestCoin),
+				}
+
+				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []proto.Message{msg}, encoding)
+				suite.Require().NoError(err)
+
+				icaPacketData := icatypes.InterchainAccountPacketData{
+					Type: icatypes.EXECUTE_TX,
+					Data: data,
+				}
+
+				packetData = icaPacketData.GetBytes()
+
+				params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)})
+				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+				suite.chainB.GetSimApp().ICAHostKeeper.WithMessageFilters(rejectMessageFilter{})
+			},
+			ibcerrors.ErrUnauthorized,
+		},
 		{
 			"unauthorised: message type not allowed", // NOTE: do not update params to explicitly force the error
 			func(encoding string) {
@@ -542,7 +698,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 						0,
 					)
 
-					txResponse, err := suite.chainB.GetSimApp().ICAHostKeeper.OnRecvPacket(suite.chainB.GetContext(), packet)
+					txResponse, err := suite.chainB.GetSimApp().ICAHostKeeper.OnRecvPacket(suite.chainB.GetContext(), packet, suite.chainB.SenderAccount.GetAddress())
 
 					expPass := tc.expErr == nil
 					if expPass {
@@ -871,7 +1027,7 @@ func (suite *KeeperTestSuite) TestJSONOnRecvPacket() {
 					0,
 				)
 
-				txResponse, err := suite.chainB.GetSimApp().ICAHostKeeper.OnRecvPacket(suite.chainB.GetContext(), packet)
+				txResponse, err := suite.chainB.GetSimApp().ICAHostKeeper.OnRecvPacket(suite.chainB.GetContext(), packet, suite.chainB.SenderAccount.GetAddress())
 
 				expPass := tc.expErr == nil
 				if expPass {
@@ -886,6 +1042,274 @@ func (suite *KeeperTestSuite) TestJSONOnRecvPacket() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestOnRecvPacketGasLimitAndFee() {
+	var (
+		path       *ibctesting.Path
+		packetData icatypes.InterchainAccountPacketData
+		relayer    sdk.AccAddress
+	)
+
+	fee := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100)))
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success: no gas limit and no fee",
+			func() {},
+			nil,
+		},
+		{
+			"success: execution within gas limit",
+			func() {
+				packetData.GasLimit = 1_000_000
+			},
+			nil,
+		},
+		{
+			"success: fee paid to relayer",
+			func() {
+				packetData.Fee = fee
+			},
+			nil,
+		},
+		{
+			"failure: execution exceeds gas limit",
+			func() {
+				packetData.GasLimit = 1000
+			},
+			ibcerrors.ErrOutOfGas,
+		},
+		{
+			"failure: message execution fails within gas limit",
+			func() {
+				packetData.GasLimit = 1_000_000
+				packetData.Fee = fee
+
+				interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+				suite.Require().True(found)
+
+				msg := &banktypes.MsgSend{
+					FromAddress: interchainAccountAddr,
+					ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+					Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100_000_000))),
+				}
+
+				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []proto.Message{msg}, icatypes.EncodingProtobuf)
+				suite.Require().NoError(err)
+				packetData.Data = data
+			},
+			sdkerrors.ErrInsufficientFunds,
+		},
+		{
+			"failure: fee exceeds interchain account balance",
+			func() {
+				packetData.Fee = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100_000_000)))
+			},
+			sdkerrors.ErrInsufficientFunds,
+		},
+		{
+			"failure: relayer address is empty",
+			func() {
+				packetData.Fee = fee
+				relayer = nil
+			},
+			ibcerrors.ErrInvalidAddress,
+		},
+		{
+			"failure: fee payment with MsgSend is not allowed by the host",
+			func() {
+				packetData.Fee = fee
+
+				interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+				suite.Require().True(found)
+
+				msg := &disttypes.MsgSetWithdrawAddress{
+					DelegatorAddress: interchainAccountAddr,
+					WithdrawAddress:  interchainAccountAddr,
+				}
+
+				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []proto.Message{msg}, icatypes.EncodingProtobuf)
+				suite.Require().NoError(err)
+				packetData.Data = data
+
+				params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)})
+				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"failure: fee payment does not satisfy the message constraints",
+			func() {
+				packetData.Fee = fee
+
+				params := suite.chainB.GetSimApp().ICAHostKeeper.GetParams(suite.chainB.GetContext())
+				params.MessageConstraints = []types.MessageConstraint{
+					types.NewMessageConstraint(sdk.MsgTypeURL((*banktypes.MsgSend)(nil)), []string{suite.chainB.SenderAccount.GetAddress().String()}, nil, nil),
+				}
+				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			path = NewICAPath(suite.chainA, suite.chainB, icatypes.EncodingProtobuf, channeltypes.ORDERED)
+			path.SetupConnections()
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			suite.fundICAWallet(suite.chainB.GetContext(), path.EndpointA.ChannelConfig.PortID, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(1000000))))
+
+			interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+			suite.Require().True(found)
+
+			msg := &banktypes.MsgSend{
+				FromAddress: interchainAccountAddr,
+				ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+				Amount:      sdk.NewCoins(ibctesting.TestCoin),
+			}
+
+			data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []proto.Message{msg}, icatypes.EncodingProtobuf)
+			suite.Require().NoError(err)
+
+			packetData = icatypes.InterchainAccountPacketData{
+				Type: icatypes.EXECUTE_TX,
+				Data: data,
+			}
+
+			params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)})
+			suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+			relayer = suite.chainB.SenderAccounts[1].SenderAccount.GetAddress()
+
+			tc.malleate() // malleate mutates test data
+
+			packet := channeltypes.NewPacket(
+				packetData.GetBytes(),
+				suite.chainA.SenderAccount.GetSequence(),
+				path.EndpointA.ChannelConfig.PortID,
+				path.EndpointA.ChannelID,
+				path.EndpointB.ChannelConfig.PortID,
+				path.EndpointB.ChannelID,
+				suite.chainB.GetTimeoutHeight(),
+				0,
+			)
+
+			ctx := suite.chainB.GetContext()
+			relayerBalance := suite.chainB.GetSimApp().BankKeeper.GetAllBalances(ctx, suite.chainB.SenderAccounts[1].SenderAccount.GetAddress())
+
+			// the gas consumed when executing the packet without a gas limit, on a discarded cached context
+			unlimitedPacketData := packetData
+			unlimitedPacketData.GasLimit = 0
+			unlimitedPacket := packet
+			unlimitedPacket.Data = unlimitedPacketData.GetBytes()
+			unlimitedCtx, _ := ctx.CacheContext()
+			unlimitedCtx = unlimitedCtx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+			_, _ = suite.chainB.GetSimApp().ICAHostKeeper.OnRecvPacket(unlimitedCtx, unlimitedPacket, relayer)
+
+			gasConsumed := ctx.GasMeter().GasConsumed()
+
+			txResponse, err := suite.chainB.GetSimApp().ICAHostKeeper.OnRecvPacket(ctx, packet, relayer)
+
+			expPass := tc.expErr == nil
+			if packetData.GasLimit > 0 {
+				// the gas consumed by the execution, up to the gas limit, is charged to the context of the relayer
+				// whether the execution succeeds or fails
+				if errors.Is(tc.expErr, ibcerrors.ErrOutOfGas) {
+					suite.Require().Less(ctx.GasMeter().GasConsumed()-gasConsumed, unlimitedCtx.GasMeter().GasConsumed())
+					suite.Require().GreaterOrEqual(ctx.GasMeter().GasConsumed()-gasConsumed, packetData.GasLimit)
+				} else {
+					suite.Require().Equal(unlimitedCtx.GasMeter().GasConsumed(), ctx.GasMeter().GasConsumed()-gasConsumed)
+				}
+			}
+
+			if expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(txResponse)
+
+				expRelayerBalance := relayerBalance.Add(packetData.Fee...)
+				suite.Require().Equal(expRelayerBalance, suite.chainB.GetSimApp().BankKeeper.GetAllBalances(ctx, relayer))
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().Nil(txResponse)
+
+				// the fee is not paid if the execution fails
+				suite.Require().Equal(relayerBalance, suite.chainB.GetSimApp().BankKeeper.GetAllBalances(ctx, suite.chainB.SenderAccounts[1].SenderAccount.GetAddress()))
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestOnRecvPacketGasLimitExceedsRelayerGas() {
+	suite.SetupTest() // reset
+
+	path := NewICAPath(suite.chainA, suite.chainB, icatypes.EncodingProtobuf, channeltypes.ORDERED)
+	path.SetupConnections()
+
+	err := SetupICAPath(path, TestOwnerAddress)
+	suite.Require().NoError(err)
+
+	suite.fundICAWallet(suite.chainB.GetContext(), path.EndpointA.ChannelConfig.PortID, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(1000000))))
+
+	interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+	suite.Require().True(found)
+
+	msg := &banktypes.MsgSend{
+		FromAddress: interchainAccountAddr,
+		ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+		Amount:      sdk.NewCoins(ibctesting.TestCoin),
+	}
+
+	data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []proto.Message{msg}, icatypes.EncodingProtobuf)
+	suite.Require().NoError(err)
+
+	packetData := icatypes.InterchainAccountPacketData{
+		Type:     icatypes.EXECUTE_TX,
+		Data:     data,
+		GasLimit: 1_000_000,
+	}
+
+	params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)})
+	suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+	packet := channeltypes.NewPacket(
+		packetData.GetBytes(),
+		suite.chainA.SenderAccount.GetSequence(),
+		path.EndpointA.ChannelConfig.PortID,
+		path.EndpointA.ChannelID,
+		path.EndpointB.ChannelConfig.PortID,
+		path.EndpointB.ChannelID,
+		suite.chainB.GetTimeoutHeight(),
+		0,
+	)
+
+	// the relayer provides less gas than the gas limit of the transaction and too little gas for its execution, the
+	// execution must fail with an out of gas panic of the relayer instead of an error acknowledgement
+	ctx := suite.chainB.GetContext().WithGasMeter(storetypes.NewGasMeter(10_000))
+	defer func() {
+		_, ok := recover().(storetypes.ErrorOutOfGas)
+		suite.Require().True(ok)
+	}()
+
+	_, _ = suite.chainB.GetSimApp().ICAHostKeeper.OnRecvPacket(ctx, packet, suite.chainB.SenderAccounts[1].SenderAccount.GetAddress())
+}
+
+// rejectMessageFilter is a message filter that rejects all messages.
+type rejectMessageFilter struct{}
+
+func (rejectMessageFilter) FilterMessage(_ context.Context, _, _ string, msg sdk.Msg) error {
+	return errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "message %s rejected", sdk.MsgTypeURL(msg))
+}
+
 func (suite *KeeperTestSuite) fundICAWallet(ctx sdk.Context, portID string, amount sdk.Coins) {
 	interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(ctx, ibctesting.FirstConnectionID, portID)
 	suite.Require().True(found)
@@ -905,3 +1329,360 @@ func getTestProposalMessage() sdk.Msg {
 	_, _, addr := testdata.KeyTestPubAddr()
 	return banktypes.NewMsgSend(authtypes.NewModuleAddress("gov"), addr, sdk.NewCoins(sdk.NewCoin("stake", sdkmath.NewInt(1000))))
 }
+
+func (suite *KeeperTestSuite) TestOnRecvPacketQuery() {
+	var (
+		path         *ibctesting.Path
+		requests     []icatypes.CosmosQueryRequest
+		expResponses [][]byte
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func(encoding string)
+		expErr   error
+	}{
+		{
+			"success: balance query",
+			func(encoding string) {},
+			nil,
+		},
+		{
+			"success: multiple queries",
+			func(encoding string) {
+				paramsQueryBz, err := (&stakingtypes.QueryParamsRequest{}).Marshal()
+				suite.Require().NoError(err)
+
+				requests = append(requests, icatypes.CosmosQueryRequest{
+					Path: "/cosmos.staking.v1beta1.Query/Params",
+					Data: paramsQueryBz,
+				})
+
+				params, err := suite.chainB.GetSimApp().StakingKeeper.GetParams(suite.chainB.GetContext())
+				suite.Require().NoError(err)
+
+				expParamsRespBz, err := (&stakingtypes.QueryParamsResponse{Params: params}).Marshal()
+				suite.Require().NoError(err)
+
+				expResponses = append(expResponses, expParamsRespBz)
+			},
+			nil,
+		},
+		{
+			"failure: not module query safe",
+			func(encoding string) {
+				paramsQueryBz, err := (&transfertypes.QueryParamsRequest{}).Marshal()
+				suite.Require().NoError(err)
+
+				requests = append(requests, icatypes.CosmosQueryRequest{
+					Path: "/ibc.applications.transfer.v1.Query/Params",
+					Data: paramsQueryBz,
+				})
+			},
+			ibcerrors.ErrInvalidRequest,
+		},
+		{
+			"failure: no query requests",
+			func(encoding string) {
+				requests = nil
+			},
+			ibcerrors.ErrInvalidRequest,
+		},
+	}
+
+	for _, encoding := range []string{icatypes.EncodingProtobuf, icatypes.EncodingProto3JSON} {
+		for _, tc := range testCases {
+			tc := tc
+
+			suite.Run(tc.msg, func() {
+				suite.SetupTest() // reset
+
+				path = NewICAPath(suite.chainA, suite.chainB, encoding, channeltypes.ORDERED)
+				path.SetupConnections()
+
+				err := SetupICAPath(path, TestOwnerAddress)
+				suite.Require().NoError(err)
+
+				interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+				suite.Require().True(found)
+
+				balanceQueryBz, err := banktypes.NewQueryBalanceRequest(sdk.MustAccAddressFromBech32(interchainAccountAddr), sdk.DefaultBondDenom).Marshal()
+				suite.Require().NoError(err)
+
+				requests = []icatypes.CosmosQueryRequest{
+					{
+						Path: "/cosmos.bank.v1beta1.Query/Balance",
+						Data: balanceQueryBz,
+					},
+				}
+
+				balance := suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), sdk.MustAccAddressFromBech32(interchainAccountAddr), sdk.DefaultBondDenom)
+				expBalanceRespBz, err := (&banktypes.QueryBalanceResponse{Balance: &balance}).Marshal()
+				suite.Require().NoError(err)
+
+				expResponses = [][]byte{expBalanceRespBz}
+
+				tc.malleate(encoding) // malleate mutates test data
+
+				data, err := icatypes.SerializeCosmosQuery(suite.chainA.GetSimApp().AppCodec(), requests, encoding)
+				suite.Require().NoError(err)
+
+				packetData := icatypes.InterchainAccountPacketData{
+					Type: icatypes.QUERY,
+					Data: data,
+				}
+
+				packet := channeltypes.NewPacket(
+					packetData.GetBytes(),
+					suite.chainA.SenderAccount.GetSequence(),
+					path.EndpointA.ChannelConfig.PortID,
+					path.EndpointA.ChannelID,
+					path.EndpointB.ChannelConfig.PortID,
+					path.EndpointB.ChannelID,
+					suite.chainB.GetTimeoutHeight(),
+					0,
+				)
+
+				ctx := suite.chainB.GetContext()
+				queryResponse, err := suite.chainB.GetSimApp().ICAHostKeeper.OnRecvPacket(ctx, packet, suite.chainB.SenderAccount.GetAddress())
+
+				if tc.expErr == nil {
+					suite.Require().NoError(err)
+
+					var res icatypes.CosmosQueryResponse
+					suite.Require().NoError(suite.chainB.GetSimApp().AppCodec().Unmarshal(queryResponse, &res))
+					suite.Require().Equal(expResponses, res.Responses)
+					suite.Require().Equal(uint64(ctx.BlockHeight()), res.Height)
+				} else {
+					suite.Require().ErrorIs(err, tc.expErr)
+					suite.Require().Nil(queryResponse)
+				}
+			})
+		}
+	}
+}
+
+// mockTxEncoding defines a TxEncoding registered under a custom encoding format which
+// serializes transactions as protobuf encoded CosmosTx and does not support queries.
+type mockTxEncoding struct{}
+
+// Serialize implements icatypes.TxEncoding
+func (mockTxEncoding) Serialize(cdc codec.Codec, msgs []proto.Message) ([]byte, error) {
+	return icatypes.SerializeCosmosTx(cdc, msgs, icatypes.EncodingProtobuf)
+}
+
+// Deserialize implements icatypes.TxEncoding
+func (mockTxEncoding) Deserialize(cdc codec.Codec, data []byte) ([]sdk.Msg, error) {
+	return icatypes.DeserializeCosmosTx(cdc, data, icatypes.EncodingProtobuf)
+}
+
+func (suite *KeeperTestSuite) TestOnRecvPacketRegisteredTxEncoding() {
+	const encodingFormat = "mock"
+
+	testCases := []struct {
+		name       string
+		packetType icatypes.Type
+		expErr     error
+	}{
+		{
+			"success: transaction decoded with the registered encoding",
+			icatypes.EXECUTE_TX,
+			nil,
+		},
+		{
+			"failure: registered encoding does not support queries",
+			icatypes.QUERY,
+			icatypes.ErrInvalidCodec,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			suite.chainA.GetSimApp().ICAControllerKeeper.RegisterTxEncoding(encodingFormat, mockTxEncoding{})
+			suite.chainB.GetSimApp().ICAHostKeeper.RegisterTxEncoding(encodingFormat, mockTxEncoding{})
+
+			metadata := icatypes.NewMetadata(icatypes.Version, ibctesting.FirstConnectionID, ibctesting.FirstConnectionID, "", encodingFormat, icatypes.TxTypeSDKMultiMsg)
+			version := string(metadata.GetBytes())
+
+			path := NewICAPath(suite.chainA, suite.chainB, icatypes.EncodingProtobuf, channeltypes.ORDERED)
+			path.EndpointA.ChannelConfig.Version = version
+			path.EndpointB.ChannelConfig.Version = version
+			path.SetupConnections()
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			suite.fundICAWallet(suite.chainB.GetContext(), path.EndpointA.ChannelConfig.PortID, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(1000000))))
+
+			interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+			suite.Require().True(found)
+
+			msg := &banktypes.MsgSend{
+				FromAddress: interchainAccountAddr,
+				ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+				Amount:      sdk.NewCoins(ibctesting.TestCoin),
+			}
+
+			data, err := mockTxEncoding{}.Serialize(suite.chainA.GetSimApp().AppCodec(), []proto.Message{msg})
+			suite.Require().NoError(err)
+
+			packetData := icatypes.InterchainAccountPacketData{
+				Type: tc.packetType,
+				Data: data,
+			}
+
+			params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)})
+			suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+			packet := channeltypes.NewPacket(
+				packetData.GetBytes(),
+				suite.chainA.SenderAccount.GetSequence(),
+				path.EndpointA.ChannelConfig.PortID,
+				path.EndpointA.ChannelID,
+				path.EndpointB.ChannelConfig.PortID,
+				path.EndpointB.ChannelID,
+				suite.chainB.GetTimeoutHeight(),
+				0,
+			)
+
+			txResponse, err := suite.chainB.GetSimApp().ICAHostKeeper.OnRecvPacket(suite.chainB.GetContext(), packet, suite.chainB.SenderAccount.GetAddress())
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(txResponse)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().Nil(txResponse)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestOnRecvPacketStructuredAck() {
+	var msgs []proto.Message
+
+	testCases := []struct {
+		name     string
+		malleate func(icaAddress string)
+		expError *icatypes.ExecutionError
+	}{
+		{
+			"success: per message results",
+			func(icaAddress string) {},
+			nil,
+		},
+		{
+			"failure: second message fails",
+			func(icaAddress string) {
+				msgs[1] = &banktypes.MsgSend{
+					FromAddress: icaAddress,
+					ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+					Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100000000))),
+				}
+			},
+			&icatypes.ExecutionError{MsgIndex: 1, Codespace: sdkerrors.ErrInsufficientFunds.Codespace(), Code: sdkerrors.ErrInsufficientFunds.ABCICode()},
+		},
+		{
+			"failure: message type not allowed",
+			func(icaAddress string) {
+				msgs[1] = &stakingtypes.MsgDelegate{
+					DelegatorAddress: icaAddress,
+					ValidatorAddress: suite.chainB.Vals.Validators[0].Address.String(),
+					Amount:           sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(5000)),
+				}
+			},
+			&icatypes.ExecutionError{MsgIndex: -1, Codespace: ibcerrors.ErrUnauthorized.Codespace(), Code: ibcerrors.ErrUnauthorized.ABCICode()},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			metadata := icatypes.NewDefaultMetadata(ibctesting.FirstConnectionID, ibctesting.FirstConnectionID)
+			metadata.AckFormat = icatypes.AckFormatStructured
+			version := string(metadata.GetBytes())
+
+			path := NewICAPath(suite.chainA, suite.chainB, icatypes.EncodingProtobuf, channeltypes.ORDERED)
+			path.EndpointA.ChannelConfig.Version = version
+			path.EndpointB.ChannelConfig.Version = version
+			path.SetupConnections()
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			suite.fundICAWallet(suite.chainB.GetContext(), path.EndpointA.ChannelConfig.PortID, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(10000))))
+
+			interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+			suite.Require().True(found)
+
+			msgs = []proto.Message{
+				&banktypes.MsgSend{
+					FromAddress: interchainAccountAddr,
+					ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+					Amount:      sdk.NewCoins(ibctesting.TestCoin),
+				},
+				&banktypes.MsgSend{
+					FromAddress: interchainAccountAddr,
+					ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+					Amount:      sdk.NewCoins(ibctesting.TestCoin),
+				},
+			}
+
+			tc.malleate(interchainAccountAddr)
+
+			data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), msgs, icatypes.EncodingProtobuf)
+			suite.Require().NoError(err)
+
+			packetData := icatypes.InterchainAccountPacketData{
+				Type: icatypes.EXECUTE_TX,
+				Data: data,
+			}
+
+			params := types.NewParams(true, []string{sdk.MsgTypeURL(&banktypes.MsgSend{})})
+			suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+			packet := channeltypes.NewPacket(
+				packetData.GetBytes(),
+				suite.chainA.SenderAccount.GetSequence(),
+				path.EndpointA.ChannelConfig.PortID,
+				path.EndpointA.ChannelID,
+				path.EndpointB.ChannelConfig.PortID,
+				path.EndpointB.ChannelID,
+				suite.chainB.GetTimeoutHeight(),
+				0,
+			)
+
+			ctx := suite.chainB.GetContext()
+			result, err := suite.chainB.GetSimApp().ICAHostKeeper.OnRecvPacket(ctx, packet, suite.chainB.SenderAccount.GetAddress())
+			ack := suite.chainB.GetSimApp().ICAHostKeeper.NewAcknowledgement(ctx, packet, result, err)
+
+			var structuredAck icatypes.InterchainAccountAcknowledgement
+			suite.Require().NoError(icatypes.ModuleCdc.UnmarshalJSON(ack.Acknowledgement(), &structuredAck))
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+				suite.Require().True(ack.Success())
+
+				var executionResult icatypes.ExecutionResult
+				suite.Require().NoError(suite.chainB.GetSimApp().AppCodec().Unmarshal(structuredAck.GetResult(), &executionResult))
+				suite.Require().Len(executionResult.MsgResults, len(msgs))
+
+				for _, msgResult := range executionResult.MsgResults {
+					suite.Require().Equal(sdk.MsgTypeURL(&banktypes.MsgSendResponse{}), msgResult.MsgResponse.TypeUrl)
+					suite.Require().Contains(msgResult.EventTypes, banktypes.EventTypeTransfer)
+				}
+			} else {
+				suite.Require().Error(err)
+				suite.Require().False(ack.Success())
+				suite.Require().Equal(tc.expError, structuredAck.GetError())
+			}
+		})
+	}
+}
@@ -5,6 +5,9 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
 	math "math"
@@ -29,6 +32,12 @@ type Params struct {
 	HostEnabled bool `protobuf:"varint,1,opt,name=host_enabled,json=hostEnabled,proto3" json:"host_enabled,omitempty"`
 	// allow_messages defines a list of sdk message typeURLs allowed to be executed on a host chain.
 	AllowMessages []string `protobuf:"bytes,2,rep,name=allow_messages,json=allowMessages,proto3" json:"allow_messages,omitempty"`
+	// controller_allow_messages defines allowlists scoped to interchain accounts of specific controllers.
+	// They take precedence over allow_messages, which remains the allowlist for all other interchain accounts.
+	ControllerAllowMessages []ControllerAllowMessages `protobuf:"bytes,3,rep,name=controller_allow_messages,json=controllerAllowMessages,proto3" json:"controller_allow_messages"`
+	// message_constraints defines constraints on the contents of messages executed by interchain accounts.
+	// They are applied in addition to the allowlists.
+	MessageConstraints []MessageConstraint `protobuf:"bytes,4,rep,name=message_constraints,json=messageConstraints,proto3" json:"message_constraints"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -78,6 +87,162 @@ func (m *Params) GetAllowMessages() []string {
 	return nil
 }
 
+func (m *Params) GetControllerAllowMessages() []ControllerAllowMessages {
+	if m != nil {
+		return m.ControllerAllowMessages
+	}
+	return nil
+}
+
+func (m *Params) GetMessageConstraints() []MessageConstraint {
+	if m != nil {
+		return m.MessageConstraints
+	}
+	return nil
+}
+
+// MessageConstraint defines constraints on the contents of messages of a given type executed by interchain accounts.
+// Constraints are supported for bank MsgSend and staking MsgDelegate, MsgUndelegate and MsgBeginRedelegate messages.
+type MessageConstraint struct {
+	// type_url is the sdk message typeURL the constraint applies to.
+	TypeUrl string `protobuf:"bytes,1,opt,name=type_url,json=typeUrl,proto3" json:"type_url,omitempty"`
+	// allowed_recipients restricts the recipient addresses of bank MsgSend messages. If empty, any recipient is allowed.
+	AllowedRecipients []string `protobuf:"bytes,2,rep,name=allowed_recipients,json=allowedRecipients,proto3" json:"allowed_recipients,omitempty"`
+	// allowed_validators restricts the validator addresses of staking messages. For MsgBeginRedelegate it applies to
+	// the destination validator. If empty, any validator is allowed.
+	AllowedValidators []string `protobuf:"bytes,3,rep,name=allowed_validators,json=allowedValidators,proto3" json:"allowed_validators,omitempty"`
+	// max_amount restricts the amount of each message. Denominations not present are not allowed. If empty, any
+	// amount is allowed.
+	MaxAmount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=max_amount,json=maxAmount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"max_amount"`
+}
+
+func (m *MessageConstraint) Reset()         { *m = MessageConstraint{} }
+func (m *MessageConstraint) String() string { return proto.CompactTextString(m) }
+func (*MessageConstraint) ProtoMessage()    {}
+func (*MessageConstraint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48e202774f13d08e, []int{1}
+}
+func (m *MessageConstraint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MessageConstraint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MessageConstraint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MessageConstraint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MessageConstraint.Merge(m, src)
+}
+func (m *MessageConstraint) XXX_Size() int {
+	return m.Size()
+}
+func (m *MessageConstraint) XXX_DiscardUnknown() {
+	xxx_messageInfo_MessageConstraint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MessageConstraint proto.InternalMessageInfo
+
+func (m *MessageConstraint) GetTypeUrl() string {
+	if m != nil {
+		return m.TypeUrl
+	}
+	return ""
+}
+
+func (m *MessageConstraint) GetAllowedRecipients() []string {
+	if m != nil {
+		return m.AllowedRecipients
+	}
+	return nil
+}
+
+func (m *MessageConstraint) GetAllowedValidators() []string {
+	if m != nil {
+		return m.AllowedValidators
+	}
+	return nil
+}
+
+func (m *MessageConstraint) GetMaxAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.MaxAmount
+	}
+	return nil
+}
+
+// ControllerAllowMessages defines the list of sdk message typeURLs allowed to be executed on a host chain
+// by the interchain accounts of a controller.
+type ControllerAllowMessages struct {
+	// connection_id is the identifier of the connection on the host chain to the controller chain.
+	ConnectionId string `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	// port_id_prefix restricts the allowlist to the controller port identifiers starting with this prefix.
+	// If empty, the allowlist applies to all controller ports on the connection.
+	PortIdPrefix string `protobuf:"bytes,2,opt,name=port_id_prefix,json=portIdPrefix,proto3" json:"port_id_prefix,omitempty"`
+	// allow_messages defines a list of sdk message typeURLs allowed to be executed on a host chain.
+	AllowMessages []string `protobuf:"bytes,3,rep,name=allow_messages,json=allowMessages,proto3" json:"allow_messages,omitempty"`
+}
+
+func (m *ControllerAllowMessages) Reset()         { *m = ControllerAllowMessages{} }
+func (m *ControllerAllowMessages) String() string { return proto.CompactTextString(m) }
+func (*ControllerAllowMessages) ProtoMessage()    {}
+func (*ControllerAllowMessages) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48e202774f13d08e, []int{2}
+}
+func (m *ControllerAllowMessages) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ControllerAllowMessages) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ControllerAllowMessages.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ControllerAllowMessages) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ControllerAllowMessages.Merge(m, src)
+}
+func (m *ControllerAllowMessages) XXX_Size() int {
+	return m.Size()
+}
+func (m *ControllerAllowMessages) XXX_DiscardUnknown() {
+	xxx_messageInfo_ControllerAllowMessages.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ControllerAllowMessages proto.InternalMessageInfo
+
+func (m *ControllerAllowMessages) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
+}
+
+func (m *ControllerAllowMessages) GetPortIdPrefix() string {
+	if m != nil {
+		return m.PortIdPrefix
+	}
+	return ""
+}
+
+func (m *ControllerAllowMessages) GetAllowMessages() []string {
+	if m != nil {
+		return m.AllowMessages
+	}
+	return nil
+}
+
 // QueryRequest defines the parameters for a particular query request
 // by an interchain account.
 type QueryRequest struct {
@@ -93,7 +258,7 @@ func (m *QueryRequest) Reset()         { *m = QueryRequest{} }
 func (m *QueryRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryRequest) ProtoMessage()    {}
 func (*QueryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_48e202774f13d08e, []int{1}
+	return fileDescriptor_48e202774f13d08e, []int{3}
 }
 func (m *QueryRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -138,6 +303,8 @@ func (m *QueryRequest) GetData() []byte {
 
 func init() {
 	proto.RegisterType((*Params)(nil), "ibc.applications.interchain_accounts.host.v1.Params")
+	proto.RegisterType((*MessageConstraint)(nil), "ibc.applications.interchain_accounts.host.v1.MessageConstraint")
+	proto.RegisterType((*ControllerAllowMessages)(nil), "ibc.applications.interchain_accounts.host.v1.ControllerAllowMessages")
 	proto.RegisterType((*QueryRequest)(nil), "ibc.applications.interchain_accounts.host.v1.QueryRequest")
 }
 
@@ -146,25 +313,43 @@ func init() {
 }
 
 var fileDescriptor_48e202774f13d08e = []byte{
-	// 284 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0xd0, 0x31, 0x4b, 0xfc, 0x30,
-	0x18, 0x06, 0xf0, 0xcb, 0xfd, 0xff, 0x1c, 0x5e, 0x3c, 0x1d, 0x3a, 0xdd, 0x14, 0xce, 0x03, 0xa1,
-	0x83, 0x6d, 0x38, 0x05, 0x0f, 0x57, 0xc1, 0x45, 0x10, 0x34, 0xa3, 0x4b, 0x79, 0x93, 0x86, 0x36,
-	0xd0, 0x36, 0xb5, 0x6f, 0x5a, 0xb9, 0x6f, 0xe1, 0xc7, 0x72, 0xbc, 0xd1, 0x51, 0xda, 0x2f, 0x22,
-	0x8d, 0x82, 0x0a, 0x4e, 0x79, 0xf8, 0xc1, 0x13, 0x78, 0x1f, 0xba, 0x35, 0x52, 0x71, 0xa8, 0xeb,
-	0xc2, 0x28, 0x70, 0xc6, 0x56, 0xc8, 0x4d, 0xe5, 0x74, 0xa3, 0x72, 0x30, 0x55, 0x02, 0x4a, 0xd9,
-	0xb6, 0x72, 0xc8, 0x73, 0x8b, 0x8e, 0x77, 0x1b, 0xff, 0xc6, 0x75, 0x63, 0x9d, 0x0d, 0xce, 0x8c,
-	0x54, 0xf1, 0xcf, 0x62, 0xfc, 0x47, 0x31, 0xf6, 0x85, 0x6e, 0xb3, 0x16, 0x74, 0x76, 0x0f, 0x0d,
-	0x94, 0x18, 0x9c, 0xd0, 0xc5, 0x88, 0x89, 0xae, 0x40, 0x16, 0x3a, 0x5d, 0x92, 0x15, 0x09, 0x0f,
-	0xc4, 0xe1, 0x68, 0x37, 0x9f, 0x14, 0x9c, 0xd2, 0x63, 0x28, 0x0a, 0xfb, 0x9c, 0x94, 0x1a, 0x11,
-	0x32, 0x8d, 0xcb, 0xe9, 0xea, 0x5f, 0x38, 0x17, 0x47, 0x5e, 0xef, 0xbe, 0x70, 0x7d, 0x49, 0x17,
-	0x0f, 0xad, 0x6e, 0x76, 0x42, 0x3f, 0xb5, 0x1a, 0x5d, 0x10, 0xd0, 0xff, 0x35, 0xb8, 0xdc, 0xff,
-	0x38, 0x17, 0x3e, 0x8f, 0x96, 0x82, 0x83, 0xe5, 0x74, 0x45, 0xc2, 0x85, 0xf0, 0xf9, 0x3a, 0x7d,
-	0xed, 0x19, 0xd9, 0xf7, 0x8c, 0xbc, 0xf7, 0x8c, 0xbc, 0x0c, 0x6c, 0xb2, 0x1f, 0xd8, 0xe4, 0x6d,
-	0x60, 0x93, 0xc7, 0xdb, 0xcc, 0xb8, 0xbc, 0x95, 0xb1, 0xb2, 0x25, 0x57, 0x16, 0x4b, 0x8b, 0xdc,
-	0x48, 0x15, 0x65, 0x96, 0x77, 0x57, 0xbc, 0xb4, 0x69, 0x5b, 0x68, 0x1c, 0xc7, 0x42, 0x7e, 0xbe,
-	0x8d, 0xbe, 0xcf, 0x8d, 0x7e, 0xef, 0xe4, 0x76, 0xb5, 0x46, 0x39, 0xf3, 0x33, 0x5d, 0x7c, 0x04,
-	0x00, 0x00, 0xff, 0xff, 0x81, 0xd5, 0x47, 0x47, 0x61, 0x01, 0x00, 0x00,
+	// 566 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0x41, 0x6f, 0xd3, 0x30,
+	0x14, 0x6e, 0xda, 0x6a, 0xac, 0x5e, 0x37, 0x69, 0x06, 0x69, 0xed, 0x0e, 0x59, 0x29, 0x20, 0xf5,
+	0x40, 0x63, 0x3a, 0x24, 0x26, 0x4e, 0x68, 0xad, 0x76, 0x18, 0x12, 0xd2, 0x88, 0x04, 0x07, 0x2e,
+	0x91, 0xe3, 0x98, 0xd6, 0x90, 0xd8, 0xc1, 0x76, 0x42, 0xf7, 0x0b, 0x38, 0x70, 0xe1, 0x77, 0xf0,
+	0x4b, 0x76, 0xdc, 0x81, 0x03, 0x27, 0x40, 0xed, 0x9f, 0xe0, 0x88, 0xec, 0x84, 0xb6, 0x6c, 0xe5,
+	0xb0, 0x93, 0x9d, 0xef, 0xbd, 0xf7, 0x3d, 0xbf, 0x2f, 0xdf, 0x03, 0x47, 0x2c, 0x24, 0x08, 0xa7,
+	0x69, 0xcc, 0x08, 0xd6, 0x4c, 0x70, 0x85, 0x18, 0xd7, 0x54, 0x92, 0x09, 0x66, 0x3c, 0xc0, 0x84,
+	0x88, 0x8c, 0x6b, 0x85, 0x26, 0x42, 0x69, 0x94, 0x0f, 0xec, 0xe9, 0xa5, 0x52, 0x68, 0x01, 0x1f,
+	0xb2, 0x90, 0x78, 0xab, 0x85, 0xde, 0x9a, 0x42, 0xcf, 0x16, 0xe4, 0x83, 0xfd, 0x3b, 0x63, 0x31,
+	0x16, 0xb6, 0x10, 0x99, 0x5b, 0xc1, 0xb1, 0xef, 0x12, 0xa1, 0x12, 0xa1, 0x50, 0x88, 0x15, 0x45,
+	0xf9, 0x20, 0xa4, 0x1a, 0x0f, 0x10, 0x11, 0x8c, 0x17, 0xf1, 0xee, 0xb7, 0x2a, 0xd8, 0x38, 0xc3,
+	0x12, 0x27, 0x0a, 0xde, 0x05, 0x4d, 0xc3, 0x15, 0x50, 0x8e, 0xc3, 0x98, 0x46, 0x2d, 0xa7, 0xe3,
+	0xf4, 0x36, 0xfd, 0x2d, 0x83, 0x9d, 0x14, 0x10, 0x7c, 0x00, 0x76, 0x70, 0x1c, 0x8b, 0x8f, 0x41,
+	0x42, 0x95, 0xc2, 0x63, 0xaa, 0x5a, 0xd5, 0x4e, 0xad, 0xd7, 0xf0, 0xb7, 0x2d, 0xfa, 0xa2, 0x04,
+	0xe1, 0x27, 0x07, 0xb4, 0x89, 0xe0, 0x5a, 0x8a, 0x38, 0xa6, 0x32, 0xb8, 0x52, 0x52, 0xeb, 0xd4,
+	0x7a, 0x5b, 0x87, 0x27, 0xde, 0x4d, 0xa6, 0xf3, 0x46, 0x0b, 0xba, 0xe3, 0xd5, 0x56, 0xc3, 0xfa,
+	0xc5, 0x8f, 0x83, 0x8a, 0xbf, 0x47, 0xd6, 0x87, 0x61, 0x0e, 0x6e, 0x97, 0x7d, 0x03, 0x22, 0xb8,
+	0xd2, 0x12, 0x33, 0xae, 0x55, 0xab, 0x6e, 0x9f, 0xf0, 0xec, 0x66, 0x4f, 0x28, 0x49, 0x47, 0x0b,
+	0x9e, 0xb2, 0x39, 0x4c, 0xae, 0x06, 0x54, 0xf7, 0xb7, 0x03, 0x76, 0xaf, 0xe5, 0xc3, 0x36, 0xd8,
+	0xd4, 0xe7, 0x29, 0x0d, 0x32, 0x19, 0x5b, 0x75, 0x1b, 0xfe, 0x2d, 0xf3, 0xfd, 0x4a, 0xc6, 0xb0,
+	0x0f, 0xa0, 0x95, 0x89, 0x46, 0x81, 0xa4, 0x84, 0xa5, 0x8c, 0x72, 0xfd, 0x57, 0xdd, 0xdd, 0x32,
+	0xe2, 0x2f, 0x02, 0xab, 0xe9, 0x39, 0x8e, 0x59, 0x84, 0xb5, 0x90, 0x85, 0xb2, 0xcb, 0xf4, 0xd7,
+	0x8b, 0x00, 0x7c, 0x07, 0x40, 0x82, 0xa7, 0x01, 0x4e, 0xcc, 0x44, 0xe5, 0xf4, 0x6d, 0xaf, 0xb0,
+	0x86, 0x67, 0xac, 0xe1, 0x95, 0xd6, 0xf0, 0x46, 0x82, 0xf1, 0xe1, 0x23, 0x33, 0xd7, 0xd7, 0x9f,
+	0x07, 0xbd, 0x31, 0xd3, 0x93, 0x2c, 0xf4, 0x88, 0x48, 0x50, 0xe9, 0xa3, 0xe2, 0xe8, 0xab, 0xe8,
+	0x3d, 0x32, 0x4f, 0x57, 0xb6, 0x40, 0xf9, 0x8d, 0x04, 0x4f, 0x8f, 0x2d, 0x7b, 0xf7, 0xb3, 0x03,
+	0xf6, 0xfe, 0xf3, 0xb7, 0xe0, 0x3d, 0xb0, 0x4d, 0x04, 0xe7, 0x94, 0x18, 0xb5, 0x03, 0x16, 0x95,
+	0x2a, 0x34, 0x97, 0xe0, 0x69, 0x04, 0xef, 0x83, 0x9d, 0x54, 0x48, 0x1d, 0xb0, 0x28, 0x48, 0x25,
+	0x7d, 0xcb, 0xa6, 0xad, 0x6a, 0x91, 0x65, 0xd0, 0xd3, 0xe8, 0xcc, 0x62, 0x6b, 0xac, 0x58, 0x5b,
+	0x63, 0xc5, 0xee, 0x13, 0xd0, 0x7c, 0x99, 0x51, 0x79, 0xee, 0xd3, 0x0f, 0x19, 0x55, 0x1a, 0x42,
+	0x50, 0x4f, 0xb1, 0x9e, 0x94, 0x8d, 0xed, 0xdd, 0x60, 0x11, 0xd6, 0xd8, 0xb6, 0x69, 0xfa, 0xf6,
+	0x3e, 0x8c, 0x2e, 0x66, 0xae, 0x73, 0x39, 0x73, 0x9d, 0x5f, 0x33, 0xd7, 0xf9, 0x32, 0x77, 0x2b,
+	0x97, 0x73, 0xb7, 0xf2, 0x7d, 0xee, 0x56, 0xde, 0x3c, 0xbf, 0x2e, 0x0a, 0x0b, 0x49, 0x7f, 0x2c,
+	0x50, 0xfe, 0x14, 0x25, 0x22, 0xca, 0x62, 0xaa, 0xcc, 0xba, 0x2b, 0x74, 0x78, 0xd4, 0x5f, 0xfa,
+	0xa9, 0xff, 0xef, 0xa6, 0x5b, 0xf1, 0xc2, 0x0d, 0xbb, 0x84, 0x8f, 0xff, 0x0c, 0x00, 0x59, 0xce,
+	0x92, 0xb9, 0x23, 0x04, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -187,6 +372,34 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.MessageConstraints) > 0 {
+		for iNdEx := len(m.MessageConstraints) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MessageConstraints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintHost(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.ControllerAllowMessages) > 0 {
+		for iNdEx := len(m.ControllerAllowMessages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ControllerAllowMessages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintHost(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.AllowMessages) > 0 {
 		for iNdEx := len(m.AllowMessages) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.AllowMessages[iNdEx])
@@ -209,6 +422,114 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MessageConstraint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MessageConstraint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MessageConstraint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MaxAmount) > 0 {
+		for iNdEx := len(m.MaxAmount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MaxAmount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintHost(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.AllowedValidators) > 0 {
+		for iNdEx := len(m.AllowedValidators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedValidators[iNdEx])
+			copy(dAtA[i:], m.AllowedValidators[iNdEx])
+			i = encodeVarintHost(dAtA, i, uint64(len(m.AllowedValidators[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.AllowedRecipients) > 0 {
+		for iNdEx := len(m.AllowedRecipients) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedRecipients[iNdEx])
+			copy(dAtA[i:], m.AllowedRecipients[iNdEx])
+			i = encodeVarintHost(dAtA, i, uint64(len(m.AllowedRecipients[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.TypeUrl) > 0 {
+		i -= len(m.TypeUrl)
+		copy(dAtA[i:], m.TypeUrl)
+		i = encodeVarintHost(dAtA, i, uint64(len(m.TypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ControllerAllowMessages) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ControllerAllowMessages) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ControllerAllowMessages) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AllowMessages) > 0 {
+		for iNdEx := len(m.AllowMessages) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowMessages[iNdEx])
+			copy(dAtA[i:], m.AllowMessages[iNdEx])
+			i = encodeVarintHost(dAtA, i, uint64(len(m.AllowMessages[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.PortIdPrefix) > 0 {
+		i -= len(m.PortIdPrefix)
+		copy(dAtA[i:], m.PortIdPrefix)
+		i = encodeVarintHost(dAtA, i, uint64(len(m.PortIdPrefix)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintHost(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -272,6 +593,72 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovHost(uint64(l))
 		}
 	}
+	if len(m.ControllerAllowMessages) > 0 {
+		for _, e := range m.ControllerAllowMessages {
+			l = e.Size()
+			n += 1 + l + sovHost(uint64(l))
+		}
+	}
+	if len(m.MessageConstraints) > 0 {
+		for _, e := range m.MessageConstraints {
+			l = e.Size()
+			n += 1 + l + sovHost(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MessageConstraint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TypeUrl)
+	if l > 0 {
+		n += 1 + l + sovHost(uint64(l))
+	}
+	if len(m.AllowedRecipients) > 0 {
+		for _, s := range m.AllowedRecipients {
+			l = len(s)
+			n += 1 + l + sovHost(uint64(l))
+		}
+	}
+	if len(m.AllowedValidators) > 0 {
+		for _, s := range m.AllowedValidators {
+			l = len(s)
+			n += 1 + l + sovHost(uint64(l))
+		}
+	}
+	if len(m.MaxAmount) > 0 {
+		for _, e := range m.MaxAmount {
+			l = e.Size()
+			n += 1 + l + sovHost(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ControllerAllowMessages) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovHost(uint64(l))
+	}
+	l = len(m.PortIdPrefix)
+	if l > 0 {
+		n += 1 + l + sovHost(uint64(l))
+	}
+	if len(m.AllowMessages) > 0 {
+		for _, s := range m.AllowMessages {
+			l = len(s)
+			n += 1 + l + sovHost(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -379,6 +766,400 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.AllowMessages = append(m.AllowMessages, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ControllerAllowMessages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ControllerAllowMessages = append(m.ControllerAllowMessages, ControllerAllowMessages{})
+			if err := m.ControllerAllowMessages[len(m.ControllerAllowMessages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageConstraints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MessageConstraints = append(m.MessageConstraints, MessageConstraint{})
+			if err := m.MessageConstraints[len(m.MessageConstraints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHost(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHost
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MessageConstraint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHost
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MessageConstraint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MessageConstraint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedRecipients", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedRecipients = append(m.AllowedRecipients, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedValidators", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedValidators = append(m.AllowedValidators, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxAmount = append(m.MaxAmount, types.Coin{})
+			if err := m.MaxAmount[len(m.MaxAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHost(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHost
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ControllerAllowMessages) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHost
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ControllerAllowMessages: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ControllerAllowMessages: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortIdPrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortIdPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowMessages", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowMessages = append(m.AllowMessages, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipHost(dAtA[iNdEx:])
@@ -0,0 +1,132 @@
+package types
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	ibcerrors "github.com/cosmos/ibc-go/v9/modules/core/errors"
+)
+
+// MessageFilter defines an interface to restrict the contents of the messages executed by interchain accounts.
+// Message filters are registered on the host keeper and applied after the allowlist and the message constraints
+// of the params.
+type MessageFilter interface {
+	// FilterMessage returns an error if the message must not be executed by the interchain account
+	// of the controller port on the given host connection.
+	FilterMessage(ctx context.Context, connectionID, portID string, msg sdk.Msg) error
+}
+
+// supportedConstraintTypeURLs are the message typeURLs for which MessageConstraint can be declared.
+var supportedConstraintTypeURLs = []string{
+	sdk.MsgTypeURL((*banktypes.MsgSend)(nil)),
+	sdk.MsgTypeURL((*stakingtypes.MsgDelegate)(nil)),
+	sdk.MsgTypeURL((*stakingtypes.MsgUndelegate)(nil)),
+	sdk.MsgTypeURL((*stakingtypes.MsgBeginRedelegate)(nil)),
+}
+
+// NewMessageConstraint creates a new MessageConstraint instance
+func NewMessageConstraint(typeURL string, allowedRecipients, allowedValidators []string, maxAmount sdk.Coins) MessageConstraint {
+	return MessageConstraint{
+		TypeUrl:           typeURL,
+		AllowedRecipients: allowedRecipients,
+		AllowedValidators: allowedValidators,
+		MaxAmount:         maxAmount,
+	}
+}
+
+// Validate performs basic validation of the MessageConstraint
+func (c MessageConstraint) Validate() error {
+	if !slices.Contains(supportedConstraintTypeURLs, c.TypeUrl) {
+		return fmt.Errorf("message constraints are not supported for message type %s", c.TypeUrl)
+	}
+
+	if len(c.AllowedRecipients) > 0 && c.TypeUrl != sdk.MsgTypeURL((*banktypes.MsgSend)(nil)) {
+		return fmt.Errorf("allowed recipients are not supported for message type %s", c.TypeUrl)
+	}
+
+	if len(c.AllowedValidators) > 0 && c.TypeUrl == sdk.MsgTypeURL((*banktypes.MsgSend)(nil)) {
+		return fmt.Errorf("allowed validators are not supported for message type %s", c.TypeUrl)
+	}
+
+	for _, addr := range append(slices.Clone(c.AllowedRecipients), c.AllowedValidators...) {
+		if strings.TrimSpace(addr) == "" {
+			return fmt.Errorf("message constraint for message type %s must not contain empty addresses", c.TypeUrl)
+		}
+	}
+
+	if err := c.MaxAmount.Validate(); err != nil {
+		return errorsmod.Wrapf(err, "invalid max amount for message type %s", c.TypeUrl)
+	}
+
+	return nil
+}
+
+// Check returns an error if the messages do not satisfy the constraint. Messages of a different type than the
+// type of the constraint are not checked. The amounts of all the messages of the type of the constraint are summed
+// before being compared to the max amount, such that the max amount cannot be exceeded by splitting it across messages.
+func (c MessageConstraint) Check(msgs ...sdk.Msg) error {
+	var totalAmount sdk.Coins
+	for _, msg := range msgs {
+		if c.TypeUrl != sdk.MsgTypeURL(msg) {
+			continue
+		}
+
+		var (
+			recipient, validator string
+			amount               sdk.Coins
+		)
+
+		switch msg := msg.(type) {
+		case *banktypes.MsgSend:
+			recipient, amount = msg.ToAddress, msg.Amount
+		case *stakingtypes.MsgDelegate:
+			validator, amount = msg.ValidatorAddress, sdk.NewCoins(msg.Amount)
+		case *stakingtypes.MsgUndelegate:
+			validator, amount = msg.ValidatorAddress, sdk.NewCoins(msg.Amount)
+		case *stakingtypes.MsgBeginRedelegate:
+			validator, amount = msg.ValidatorDstAddress, sdk.NewCoins(msg.Amount)
+		default:
+			continue
+		}
+
+		if len(c.AllowedRecipients) > 0 && !slices.Contains(c.AllowedRecipients, recipient) {
+			return errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "recipient %s is not allowed for message type %s", recipient, c.TypeUrl)
+		}
+
+		if len(c.AllowedValidators) > 0 && !slices.Contains(c.AllowedValidators, validator) {
+			return errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "validator %s is not allowed for message type %s", validator, c.TypeUrl)
+		}
+
+		totalAmount = totalAmount.Add(amount...)
+	}
+
+	if len(c.MaxAmount) > 0 && !totalAmount.IsAllLTE(c.MaxAmount) {
+		return errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "amount %s exceeds the max amount %s for message type %s", totalAmount, c.MaxAmount, c.TypeUrl)
+	}
+
+	return nil
+}
+
+func validateMessageConstraints(constraints []MessageConstraint) error {
+	seen := make(map[string]bool, len(constraints))
+	for _, c := range constraints {
+		if err := c.Validate(); err != nil {
+			return err
+		}
+
+		if seen[c.TypeUrl] {
+			return fmt.Errorf("duplicate message constraint for message type %s", c.TypeUrl)
+		}
+		seen[c.TypeUrl] = true
+	}
+
+	return nil
+}
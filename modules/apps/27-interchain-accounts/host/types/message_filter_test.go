@@ -0,0 +1,198 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/cosmos/ibc-go/v9/modules/apps/27-interchain-accounts/host/types"
+	ibcerrors "github.com/cosmos/ibc-go/v9/modules/core/errors"
+)
+
+const (
+	recipient = "cosmos1recipient"
+	validator = "cosmosvaloper1validator"
+)
+
+func TestMessageConstraintValidate(t *testing.T) {
+	sendTypeURL := sdk.MsgTypeURL((*banktypes.MsgSend)(nil))
+	delegateTypeURL := sdk.MsgTypeURL((*stakingtypes.MsgDelegate)(nil))
+
+	testCases := []struct {
+		name       string
+		constraint types.MessageConstraint
+		expPass    bool
+	}{
+		{"success: send constraint", types.NewMessageConstraint(sendTypeURL, []string{recipient}, nil, sdk.NewCoins(sdk.NewInt64Coin("stake", 100))), true},
+		{"success: delegate constraint", types.NewMessageConstraint(delegateTypeURL, nil, []string{validator}, nil), true},
+		{"failure: unsupported message type", types.NewMessageConstraint(sdk.MsgTypeURL((*stakingtypes.MsgCreateValidator)(nil)), nil, nil, nil), false},
+		{"failure: recipients for delegate constraint", types.NewMessageConstraint(delegateTypeURL, []string{recipient}, nil, nil), false},
+		{"failure: validators for send constraint", types.NewMessageConstraint(sendTypeURL, nil, []string{validator}, nil), false},
+		{"failure: empty address", types.NewMessageConstraint(sendTypeURL, []string{" "}, nil, nil), false},
+		{"failure: invalid max amount", types.NewMessageConstraint(sendTypeURL, nil, nil, sdk.Coins{sdk.Coin{Denom: "stake", Amount: sdkmath.NewInt(-1)}}), false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.constraint.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+
+	params := types.DefaultParams()
+	params.MessageConstraints = []types.MessageConstraint{
+		types.NewMessageConstraint(sendTypeURL, []string{recipient}, nil, nil),
+		types.NewMessageConstraint(sendTypeURL, nil, nil, nil),
+	}
+	require.Error(t, params.Validate(), "duplicate message constraint")
+}
+
+func TestMessageConstraintCheck(t *testing.T) {
+	sendTypeURL := sdk.MsgTypeURL((*banktypes.MsgSend)(nil))
+	redelegateTypeURL := sdk.MsgTypeURL((*stakingtypes.MsgBeginRedelegate)(nil))
+	maxAmount := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+
+	testCases := []struct {
+		name       string
+		constraint types.MessageConstraint
+		msg        sdk.Msg
+		expErr     error
+	}{
+		{
+			"success: allowed recipient and amount",
+			types.NewMessageConstraint(sendTypeURL, []string{recipient}, nil, maxAmount),
+			&banktypes.MsgSend{ToAddress: recipient, Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 100))},
+			nil,
+		},
+		{
+			"success: message of other type is not checked",
+			types.NewMessageConstraint(sendTypeURL, []string{recipient}, nil, maxAmount),
+			&stakingtypes.MsgDelegate{ValidatorAddress: validator, Amount: sdk.NewInt64Coin("stake", 1000)},
+			nil,
+		},
+		{
+			"success: allowed destination validator",
+			types.NewMessageConstraint(redelegateTypeURL, nil, []string{validator}, nil),
+			&stakingtypes.MsgBeginRedelegate{ValidatorSrcAddress: "cosmosvaloper1other", ValidatorDstAddress: validator, Amount: sdk.NewInt64Coin("stake", 1000)},
+			nil,
+		},
+		{
+			"failure: recipient not allowed",
+			types.NewMessageConstraint(sendTypeURL, []string{recipient}, nil, nil),
+			&banktypes.MsgSend{ToAddress: "cosmos1other", Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 1))},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"failure: amount exceeds max amount",
+			types.NewMessageConstraint(sendTypeURL, nil, nil, maxAmount),
+			&banktypes.MsgSend{ToAddress: recipient, Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 101))},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"failure: denomination not in max amount",
+			types.NewMessageConstraint(sendTypeURL, nil, nil, maxAmount),
+			&banktypes.MsgSend{ToAddress: recipient, Amount: sdk.NewCoins(sdk.NewInt64Coin("atom", 1))},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"failure: summed amount of messages exceeds max amount",
+			types.NewMessageConstraint(sendTypeURL, nil, nil, maxAmount),
+			nil,
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"failure: destination validator not allowed",
+			types.NewMessageConstraint(redelegateTypeURL, nil, []string{validator}, nil),
+			&stakingtypes.MsgBeginRedelegate{ValidatorSrcAddress: validator, ValidatorDstAddress: "cosmosvaloper1other", Amount: sdk.NewInt64Coin("stake", 1)},
+			ibcerrors.ErrUnauthorized,
+		},
+	}
+
+	for _, tc := range testCases {
+		msgs := []sdk.Msg{tc.msg}
+		if tc.msg == nil {
+			// each message is within the max amount, but not their sum
+			msgs = []sdk.Msg{
+				&banktypes.MsgSend{ToAddress: recipient, Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 60))},
+				&banktypes.MsgSend{ToAddress: recipient, Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 60))},
+			}
+		}
+
+		err := tc.constraint.Check(msgs...)
+		if tc.expErr == nil {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.ErrorIs(t, err, tc.expErr, tc.name)
+		}
+	}
+}
+
+func TestCheckMessageConstraints(t *testing.T) {
+	sendTypeURL := sdk.MsgTypeURL((*banktypes.MsgSend)(nil))
+	maxAmount := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+
+	execMsg := authz.NewMsgExec(sdk.AccAddress(recipient), []sdk.Msg{
+		&banktypes.MsgSend{ToAddress: "cosmos1other", Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 1000))},
+	})
+
+	testCases := []struct {
+		name        string
+		constraints []types.MessageConstraint
+		msgs        []sdk.Msg
+		expErr      error
+	}{
+		{
+			"success: messages within constraints",
+			[]types.MessageConstraint{types.NewMessageConstraint(sendTypeURL, []string{recipient}, nil, maxAmount)},
+			[]sdk.Msg{
+				&banktypes.MsgSend{ToAddress: recipient, Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 50))},
+				&banktypes.MsgSend{ToAddress: recipient, Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 50))},
+				&govv1.MsgVote{ProposalId: 1, Voter: recipient, Option: govv1.OptionYes},
+			},
+			nil,
+		},
+		{
+			"success: messages of types without constraints are not checked",
+			[]types.MessageConstraint{types.NewMessageConstraint(sendTypeURL, []string{recipient}, nil, maxAmount)},
+			[]sdk.Msg{
+				&banktypes.MsgMultiSend{
+					Inputs:  []banktypes.Input{banktypes.NewInput(sdk.AccAddress(recipient), sdk.NewCoins(sdk.NewInt64Coin("stake", 1000)))},
+					Outputs: []banktypes.Output{banktypes.NewOutput(sdk.AccAddress("other"), sdk.NewCoins(sdk.NewInt64Coin("stake", 1000)))},
+				},
+				&execMsg,
+			},
+			nil,
+		},
+		{
+			"failure: summed amount of messages exceeds max amount",
+			[]types.MessageConstraint{types.NewMessageConstraint(sendTypeURL, nil, nil, maxAmount)},
+			[]sdk.Msg{
+				&banktypes.MsgSend{ToAddress: recipient, Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 100))},
+				&banktypes.MsgSend{ToAddress: recipient, Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 1))},
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+	}
+
+	for _, tc := range testCases {
+		params := types.DefaultParams()
+		params.MessageConstraints = tc.constraints
+
+		err := params.CheckMessageConstraints(tc.msgs)
+		if tc.expErr == nil {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.ErrorIs(t, err, tc.expErr, tc.name)
+		}
+	}
+}
@@ -4,6 +4,12 @@ import (
 	"fmt"
 	"slices"
 	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	host "github.com/cosmos/ibc-go/v9/modules/core/24-host"
 )
 
 const (
@@ -26,9 +32,91 @@ func DefaultParams() Params {
 	return NewParams(DefaultHostEnabled, []string{AllowAllHostMsgs})
 }
 
+// NewControllerAllowMessages creates a new ControllerAllowMessages instance
+func NewControllerAllowMessages(connectionID, portIDPrefix string, allowMsgs []string) ControllerAllowMessages {
+	return ControllerAllowMessages{
+		ConnectionId:  connectionID,
+		PortIdPrefix:  portIDPrefix,
+		AllowMessages: allowMsgs,
+	}
+}
+
 // Validate validates all host submodule parameters
 func (p Params) Validate() error {
-	return validateAllowlist(p.AllowMessages)
+	if err := validateAllowlist(p.AllowMessages); err != nil {
+		return err
+	}
+
+	if err := validateControllerAllowMessages(p.ControllerAllowMessages); err != nil {
+		return err
+	}
+
+	return validateMessageConstraints(p.MessageConstraints)
+}
+
+// CheckMessageConstraints returns an error if the messages executed together by an interchain account do not satisfy
+// the message constraints. Each message constraint only applies to the messages of the type it names, messages of
+// other types are left to the allowlist.
+func (p Params) CheckMessageConstraints(msgs []sdk.Msg) error {
+	for _, c := range p.MessageConstraints {
+		if err := c.Check(msgs...); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AllowMessagesForController returns the allowlist applied to the interchain account of the controller port on the given host
+// connection. The controller allowlist of the connection with the longest matching port identifier prefix is returned,
+// falling back to the chain-wide allowlist if no controller allowlist matches.
+func (p Params) AllowMessagesForController(connectionID, portID string) []string {
+	allowMsgs, matchedPrefixLen := p.AllowMessages, -1
+	for _, controllerAllowMsgs := range p.ControllerAllowMessages {
+		if controllerAllowMsgs.ConnectionId != connectionID || !strings.HasPrefix(portID, controllerAllowMsgs.PortIdPrefix) {
+			continue
+		}
+
+		if len(controllerAllowMsgs.PortIdPrefix) > matchedPrefixLen {
+			allowMsgs, matchedPrefixLen = controllerAllowMsgs.AllowMessages, len(controllerAllowMsgs.PortIdPrefix)
+		}
+	}
+
+	return allowMsgs
+}
+
+// Validate performs basic validation of the ControllerAllowMessages
+func (c ControllerAllowMessages) Validate() error {
+	if err := host.ConnectionIdentifierValidator(c.ConnectionId); err != nil {
+		return errorsmod.Wrapf(err, "invalid controller allowlist connection identifier")
+	}
+
+	if strings.TrimSpace(c.PortIdPrefix) != c.PortIdPrefix {
+		return fmt.Errorf("controller allowlist port identifier prefix must not contain leading or trailing whitespace: %s", c.PortIdPrefix)
+	}
+
+	return validateAllowlist(c.AllowMessages)
+}
+
+func validateControllerAllowMessages(controllerAllowMsgs []ControllerAllowMessages) error {
+	if len(controllerAllowMsgs) > MaxAllowListLength {
+		return fmt.Errorf("controller allow lists length must not exceed %d items", MaxAllowListLength)
+	}
+
+	seen := make(map[string]bool, len(controllerAllowMsgs))
+	for _, c := range controllerAllowMsgs {
+		if err := c.Validate(); err != nil {
+			return err
+		}
+
+		key := c.ConnectionId + "/" + c.PortIdPrefix
+		if seen[key] {
+			return fmt.Errorf("duplicate controller allow list for connection %s and port identifier prefix %s", c.ConnectionId, c.PortIdPrefix)
+		}
+		seen[key] = true
+	}
+
+	return nil
 }
 
 func validateAllowlist(allowMsgs []string) error {
@@ -15,4 +15,51 @@ func TestValidateParams(t *testing.T) {
 	require.Error(t, types.NewParams(true, []string{" "}).Validate())
 	require.Error(t, types.NewParams(true, []string{"*", "/cosmos.bank.v1beta1.MsgSend"}).Validate())
 	require.Error(t, types.NewParams(true, make([]string, types.MaxAllowListLength+1)).Validate())
+
+	params := types.DefaultParams()
+	params.ControllerAllowMessages = []types.ControllerAllowMessages{
+		types.NewControllerAllowMessages("connection-0", "", []string{"/cosmos.bank.v1beta1.MsgSend"}),
+		types.NewControllerAllowMessages("connection-0", "icacontroller-dao", []string{"*"}),
+	}
+	require.NoError(t, params.Validate())
+
+	params.ControllerAllowMessages = []types.ControllerAllowMessages{types.NewControllerAllowMessages("", "", []string{"/cosmos.bank.v1beta1.MsgSend"})}
+	require.Error(t, params.Validate())
+
+	params.ControllerAllowMessages = []types.ControllerAllowMessages{types.NewControllerAllowMessages("connection-0", " icacontroller-", []string{"/cosmos.bank.v1beta1.MsgSend"})}
+	require.Error(t, params.Validate())
+
+	params.ControllerAllowMessages = []types.ControllerAllowMessages{types.NewControllerAllowMessages("connection-0", "", []string{""})}
+	require.Error(t, params.Validate())
+
+	params.ControllerAllowMessages = []types.ControllerAllowMessages{
+		types.NewControllerAllowMessages("connection-0", "icacontroller-", []string{"/cosmos.bank.v1beta1.MsgSend"}),
+		types.NewControllerAllowMessages("connection-0", "icacontroller-", []string{"*"}),
+	}
+	require.Error(t, params.Validate())
+}
+
+func TestAllowMessagesForController(t *testing.T) {
+	params := types.NewParams(true, []string{"/cosmos.bank.v1beta1.MsgSend"})
+	params.ControllerAllowMessages = []types.ControllerAllowMessages{
+		types.NewControllerAllowMessages("connection-0", "", []string{"/cosmos.staking.v1beta1.MsgDelegate"}),
+		types.NewControllerAllowMessages("connection-0", "icacontroller-dao", []string{"*"}),
+		types.NewControllerAllowMessages("connection-1", "icacontroller-dao", []string{"/cosmos.gov.v1.MsgVote"}),
+	}
+
+	testCases := []struct {
+		name         string
+		connectionID string
+		portID       string
+		expAllowMsgs []string
+	}{
+		{"longest matching port identifier prefix", "connection-0", "icacontroller-dao-treasury", []string{"*"}},
+		{"empty port identifier prefix matches all ports", "connection-0", "icacontroller-alice", []string{"/cosmos.staking.v1beta1.MsgDelegate"}},
+		{"port identifier prefix does not match", "connection-1", "icacontroller-alice", []string{"/cosmos.bank.v1beta1.MsgSend"}},
+		{"connection without controller allowlist", "connection-2", "icacontroller-dao", []string{"/cosmos.bank.v1beta1.MsgSend"}},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.expAllowMsgs, params.AllowMessagesForController(tc.connectionID, tc.portID), tc.name)
+	}
 }
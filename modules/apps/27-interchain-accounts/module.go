@@ -39,6 +39,7 @@ var (
 	_ module.HasServices         = (*AppModule)(nil)
 	_ module.HasProposalMsgs     = (*AppModule)(nil)
 	_ appmodule.AppModule        = (*AppModule)(nil)
+	_ appmodule.HasEndBlocker    = (*AppModule)(nil)
 
 	_ porttypes.IBCModule = (*host.IBCModule)(nil)
 )
@@ -143,6 +144,10 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	}); err != nil {
 		panic(fmt.Errorf("failed to migrate interchainaccounts app from version 2 to 3 (self-managed params migration): %v", err))
 	}
+
+	if err := cfg.RegisterMigration(types.ModuleName, 3, controllerMigrator.MigrateScheduledTxParams); err != nil {
+		panic(fmt.Errorf("failed to migrate interchainaccounts app from version 3 to 4 (scheduled transaction params migration): %v", err))
+	}
 }
 
 // InitGenesis performs genesis initialization for the interchain accounts module.
@@ -180,8 +185,18 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 	return cdc.MustMarshalJSON(gs)
 }
 
+// EndBlock implements the appmodule.HasEndBlocker interface. It executes the due scheduled transactions of the
+// controller submodule.
+func (am AppModule) EndBlock(ctx context.Context) error {
+	if am.controllerKeeper != nil {
+		am.controllerKeeper.EndBlocker(ctx)
+	}
+
+	return nil
+}
+
 // ConsensusVersion implements AppModule/ConsensusVersion.
-func (AppModule) ConsensusVersion() uint64 { return 3 }
+func (AppModule) ConsensusVersion() uint64 { return 4 }
 
 // AppModuleSimulation functions
 
@@ -27,9 +27,7 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { controllerEnabled = RandomEnabled(r) },
 	)
 
-	controllerParams := controllertypes.Params{
-		ControllerEnabled: controllerEnabled,
-	}
+	controllerParams := controllertypes.NewParams(controllerEnabled)
 
 	controllerGenesisState := genesistypes.ControllerGenesisState{
 		ActiveChannels:     nil,
@@ -0,0 +1,82 @@
+package types
+
+import (
+	"errors"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	ibcexported "github.com/cosmos/ibc-go/v9/modules/core/exported"
+)
+
+var _ ibcexported.Acknowledgement = (*InterchainAccountAcknowledgement)(nil)
+
+// MsgExecutionError wraps the error returned by the execution of a message of an interchain account
+// transaction, recording the index of the message in the transaction.
+type MsgExecutionError struct {
+	MsgIndex int
+	Err      error
+}
+
+// Error implements the error interface.
+func (e *MsgExecutionError) Error() string {
+	return e.Err.Error()
+}
+
+// Cause returns the underlying error, so that its ABCI code can be retrieved.
+func (e *MsgExecutionError) Cause() error {
+	return e.Err
+}
+
+// Unwrap returns the underlying error.
+func (e *MsgExecutionError) Unwrap() error {
+	return e.Err
+}
+
+// NewResultAcknowledgement returns a new structured acknowledgement containing the provided result.
+func NewResultAcknowledgement(result []byte) InterchainAccountAcknowledgement {
+	return InterchainAccountAcknowledgement{
+		Response: &InterchainAccountAcknowledgement_Result{
+			Result: result,
+		},
+	}
+}
+
+// NewErrorAcknowledgement returns a new structured acknowledgement containing the deterministic ABCI codespace
+// and code of the provided error. If the error was returned by the execution of a message, the index of the
+// message is included, otherwise the message index is -1.
+func NewErrorAcknowledgement(err error) InterchainAccountAcknowledgement {
+	// the ABCI log is not deterministic and therefore only the codespace and code are included
+	codespace, code, _ := errorsmod.ABCIInfo(err, false)
+
+	msgIndex := int64(-1)
+
+	var msgErr *MsgExecutionError
+	if errors.As(err, &msgErr) {
+		msgIndex = int64(msgErr.MsgIndex)
+	}
+
+	return InterchainAccountAcknowledgement{
+		Response: &InterchainAccountAcknowledgement_Error{
+			Error: &ExecutionError{
+				MsgIndex:  msgIndex,
+				Codespace: codespace,
+				Code:      code,
+			},
+		},
+	}
+}
+
+// Success implements the Acknowledgement interface. The acknowledgement is
+// considered successful if it is a result acknowledgement.
+func (ack InterchainAccountAcknowledgement) Success() bool {
+	_, ok := ack.Response.(*InterchainAccountAcknowledgement_Result)
+	return ok
+}
+
+// Acknowledgement implements the Acknowledgement interface. It returns the
+// acknowledgement serialised using JSON.
+func (ack InterchainAccountAcknowledgement) Acknowledgement() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&ack))
+}
@@ -0,0 +1,58 @@
+package types_test
+
+import (
+	"errors"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/ibc-go/v9/modules/apps/27-interchain-accounts/types"
+	ibcerrors "github.com/cosmos/ibc-go/v9/modules/core/errors"
+)
+
+func (suite *TypesTestSuite) TestAcknowledgement() {
+	testCases := []struct {
+		name       string
+		ack        types.InterchainAccountAcknowledgement
+		expSuccess bool
+		expError   *types.ExecutionError
+	}{
+		{
+			"success: result acknowledgement",
+			types.NewResultAcknowledgement([]byte("result")),
+			true,
+			nil,
+		},
+		{
+			"success: error acknowledgement of a failed message",
+			types.NewErrorAcknowledgement(errorsmod.Wrap(&types.MsgExecutionError{MsgIndex: 2, Err: ibcerrors.ErrInsufficientFunds}, "failed to execute interchain account transaction")),
+			false,
+			&types.ExecutionError{MsgIndex: 2, Codespace: ibcerrors.ErrInsufficientFunds.Codespace(), Code: ibcerrors.ErrInsufficientFunds.ABCICode()},
+		},
+		{
+			"success: error acknowledgement not related to a message",
+			types.NewErrorAcknowledgement(types.ErrInterchainAccountNotFound),
+			false,
+			&types.ExecutionError{MsgIndex: -1, Codespace: types.ModuleName, Code: types.ErrInterchainAccountNotFound.ABCICode()},
+		},
+		{
+			"success: error acknowledgement of an unregistered error",
+			types.NewErrorAcknowledgement(&types.MsgExecutionError{MsgIndex: 0, Err: errors.New("unregistered error")}),
+			false,
+			&types.ExecutionError{MsgIndex: 0, Codespace: errorsmod.UndefinedCodespace, Code: 1},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.Require().Equal(tc.expSuccess, tc.ack.Success())
+			suite.Require().Equal(tc.expError, tc.ack.GetError())
+
+			var ack types.InterchainAccountAcknowledgement
+			err := types.ModuleCdc.UnmarshalJSON(tc.ack.Acknowledgement(), &ack)
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.ack, ack)
+		})
+	}
+}
@@ -470,3 +470,38 @@ func (suite *TypesTestSuite) TestUnsupportedEncodingType() {
 	_, err = types.DeserializeCosmosTx(suite.chainA.Codec, data, types.EncodingProtobuf)
 	suite.Require().NoError(err)
 }
+
+func (suite *TypesTestSuite) TestSerializeAndDeserializeCosmosQuery() {
+	requests := []types.CosmosQueryRequest{
+		{
+			Path: "/cosmos.bank.v1beta1.Query/Balance",
+			Data: []byte("balance request"),
+		},
+		{
+			Path: "/cosmos.staking.v1beta1.Query/Params",
+		},
+	}
+
+	for _, encoding := range []string{types.EncodingProtobuf, types.EncodingProto3JSON} {
+		bz, err := types.SerializeCosmosQuery(suite.chainA.Codec, requests, encoding)
+		suite.Require().NoError(err)
+
+		deserializedRequests, err := types.DeserializeCosmosQuery(suite.chainA.Codec, bz, encoding)
+		suite.Require().NoError(err)
+		suite.Require().Len(deserializedRequests, len(requests))
+		for i, request := range deserializedRequests {
+			suite.Require().Equal(requests[i].Path, request.Path)
+			suite.Require().Equal(len(requests[i].Data), len(request.Data))
+		}
+
+		_, err = types.DeserializeCosmosQuery(suite.chainA.Codec, []byte("invalid"), encoding)
+		suite.Require().ErrorIs(err, ibcerrors.ErrInvalidType)
+	}
+
+	bz, err := types.SerializeCosmosQuery(suite.chainA.Codec, requests, "unsupported")
+	suite.Require().ErrorIs(err, types.ErrInvalidCodec)
+	suite.Require().Nil(bz)
+
+	_, err = types.DeserializeCosmosQuery(suite.chainA.Codec, []byte{}, "unsupported")
+	suite.Require().ErrorIs(err, types.ErrInvalidCodec)
+}
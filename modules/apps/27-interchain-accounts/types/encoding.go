@@ -0,0 +1,120 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	_ TxEncoding    = (*cosmosTxEncoding)(nil)
+	_ QueryEncoding = (*cosmosTxEncoding)(nil)
+)
+
+// TxEncoding defines the interface used to serialize and deserialize the data of interchain account transactions
+// for an encoding format negotiated in the ICS27 Metadata during the channel handshake.
+type TxEncoding interface {
+	// Serialize serializes the provided messages into interchain account packet data bytes.
+	Serialize(cdc codec.Codec, msgs []proto.Message) ([]byte, error)
+	// Deserialize deserializes interchain account packet data bytes into the messages to be executed on the host chain.
+	Deserialize(cdc codec.Codec, data []byte) ([]sdk.Msg, error)
+}
+
+// QueryEncoding may optionally be implemented by a TxEncoding to support interchain account query packets.
+type QueryEncoding interface {
+	// SerializeQuery serializes the provided query requests into interchain account packet data bytes.
+	SerializeQuery(cdc codec.Codec, requests []CosmosQueryRequest) ([]byte, error)
+	// DeserializeQuery deserializes interchain account packet data bytes into the query requests to be executed on the host chain.
+	DeserializeQuery(cdc codec.Codec, data []byte) ([]CosmosQueryRequest, error)
+}
+
+// TxEncodingRegistry maps the encoding formats supported by a controller or host chain to their TxEncoding.
+type TxEncodingRegistry struct {
+	encodings map[string]TxEncoding
+}
+
+// NewTxEncodingRegistry creates and returns a new TxEncodingRegistry containing the protobuf and proto3 JSON encodings.
+func NewTxEncodingRegistry() *TxEncodingRegistry {
+	registry := &TxEncodingRegistry{
+		encodings: make(map[string]TxEncoding),
+	}
+
+	registry.Register(EncodingProtobuf, cosmosTxEncoding{format: EncodingProtobuf})
+	registry.Register(EncodingProto3JSON, cosmosTxEncoding{format: EncodingProto3JSON})
+
+	return registry
+}
+
+// Register registers the provided TxEncoding for the encoding format. It panics if the encoding format
+// is empty or already registered.
+func (r *TxEncodingRegistry) Register(format string, encoding TxEncoding) {
+	if strings.TrimSpace(format) == "" {
+		panic(errors.New("encoding format cannot be empty"))
+	}
+
+	if encoding == nil {
+		panic(fmt.Errorf("encoding for format %s cannot be nil", format))
+	}
+
+	if _, found := r.encodings[format]; found {
+		panic(fmt.Errorf("encoding format %s is already registered", format))
+	}
+
+	r.encodings[format] = encoding
+}
+
+// GetEncoding returns the TxEncoding registered for the encoding format and a boolean indicating if it was found.
+func (r *TxEncodingRegistry) GetEncoding(format string) (TxEncoding, bool) {
+	encoding, found := r.encodings[format]
+	return encoding, found
+}
+
+// IsSupported returns true if a TxEncoding is registered for the encoding format, otherwise false.
+func (r *TxEncodingRegistry) IsSupported(format string) bool {
+	_, found := r.encodings[format]
+	return found
+}
+
+// Formats returns the sorted list of registered encoding formats.
+func (r *TxEncodingRegistry) Formats() []string {
+	formats := make([]string, 0, len(r.encodings))
+	for format := range r.encodings {
+		formats = append(formats, format)
+	}
+
+	slices.Sort(formats)
+
+	return formats
+}
+
+// cosmosTxEncoding implements the TxEncoding and QueryEncoding interfaces for the CosmosTx and CosmosQuery
+// types using the protobuf or proto3 JSON encoding formats.
+type cosmosTxEncoding struct {
+	format string
+}
+
+// Serialize implements TxEncoding.
+func (e cosmosTxEncoding) Serialize(cdc codec.Codec, msgs []proto.Message) ([]byte, error) {
+	return SerializeCosmosTx(cdc, msgs, e.format)
+}
+
+// Deserialize implements TxEncoding.
+func (e cosmosTxEncoding) Deserialize(cdc codec.Codec, data []byte) ([]sdk.Msg, error) {
+	return DeserializeCosmosTx(cdc, data, e.format)
+}
+
+// SerializeQuery implements QueryEncoding.
+func (e cosmosTxEncoding) SerializeQuery(cdc codec.Codec, requests []CosmosQueryRequest) ([]byte, error) {
+	return SerializeCosmosQuery(cdc, requests, e.format)
+}
+
+// DeserializeQuery implements QueryEncoding.
+func (e cosmosTxEncoding) DeserializeQuery(cdc codec.Codec, data []byte) ([]CosmosQueryRequest, error) {
+	return DeserializeCosmosQuery(cdc, data, e.format)
+}
@@ -0,0 +1,100 @@
+package types_test
+
+import (
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/cosmos/ibc-go/v9/modules/apps/27-interchain-accounts/types"
+)
+
+// mockEncodingFormat defines the encoding format of mockTxEncoding
+const mockEncodingFormat = "mock"
+
+var _ types.TxEncoding = (*mockTxEncoding)(nil)
+
+// mockTxEncoding defines a mock TxEncoding which encodes transactions as the list of their message type URLs
+type mockTxEncoding struct{}
+
+// Serialize implements types.TxEncoding
+func (mockTxEncoding) Serialize(_ codec.Codec, msgs []proto.Message) ([]byte, error) {
+	var bz []byte
+	for _, msg := range msgs {
+		bz = append(bz, []byte(sdk.MsgTypeURL(msg)+";")...)
+	}
+
+	return bz, nil
+}
+
+// Deserialize implements types.TxEncoding
+func (mockTxEncoding) Deserialize(_ codec.Codec, _ []byte) ([]sdk.Msg, error) {
+	return []sdk.Msg{&banktypes.MsgSend{}}, nil
+}
+
+func (suite *TypesTestSuite) TestTxEncodingRegistry() {
+	registry := types.NewTxEncodingRegistry()
+	suite.Require().Equal([]string{types.EncodingProtobuf, types.EncodingProto3JSON}, registry.Formats())
+
+	// the default encodings support queries
+	for _, format := range []string{types.EncodingProtobuf, types.EncodingProto3JSON} {
+		encoding, found := registry.GetEncoding(format)
+		suite.Require().True(found)
+		suite.Require().Implements((*types.QueryEncoding)(nil), encoding)
+	}
+
+	suite.Require().False(registry.IsSupported(mockEncodingFormat))
+	_, found := registry.GetEncoding(mockEncodingFormat)
+	suite.Require().False(found)
+
+	registry.Register(mockEncodingFormat, mockTxEncoding{})
+	suite.Require().True(registry.IsSupported(mockEncodingFormat))
+	suite.Require().Equal([]string{mockEncodingFormat, types.EncodingProtobuf, types.EncodingProto3JSON}, registry.Formats())
+
+	encoding, found := registry.GetEncoding(mockEncodingFormat)
+	suite.Require().True(found)
+
+	bz, err := encoding.Serialize(suite.chainA.Codec, []proto.Message{&banktypes.MsgSend{}})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]byte(sdk.MsgTypeURL(&banktypes.MsgSend{})+";"), bz)
+
+	suite.Require().Panics(func() {
+		registry.Register(mockEncodingFormat, mockTxEncoding{})
+	}, "registering an encoding format twice must panic")
+
+	suite.Require().Panics(func() {
+		registry.Register(" ", mockTxEncoding{})
+	}, "registering an empty encoding format must panic")
+
+	suite.Require().Panics(func() {
+		registry.Register("nil", nil)
+	}, "registering a nil encoding must panic")
+}
+
+func (suite *TypesTestSuite) TestDefaultTxEncodings() {
+	msgs := []proto.Message{
+		&banktypes.MsgSend{
+			FromAddress: TestOwnerAddress,
+			ToAddress:   TestOwnerAddress,
+		},
+	}
+
+	registry := types.NewTxEncodingRegistry()
+	for _, format := range registry.Formats() {
+		encoding, found := registry.GetEncoding(format)
+		suite.Require().True(found)
+
+		bz, err := encoding.Serialize(suite.chainA.Codec, msgs)
+		suite.Require().NoError(err)
+
+		expBz, err := types.SerializeCosmosTx(suite.chainA.Codec, msgs, format)
+		suite.Require().NoError(err)
+		suite.Require().Equal(expBz, bz)
+
+		deserializedMsgs, err := encoding.Deserialize(suite.chainA.Codec, bz)
+		suite.Require().NoError(err)
+		suite.Require().Len(deserializedMsgs, 1)
+		suite.Require().Equal(msgs[0].(*banktypes.MsgSend).FromAddress, deserializedMsgs[0].(*banktypes.MsgSend).FromAddress)
+	}
+}
@@ -23,4 +23,5 @@ var (
 	ErrInvalidTimeoutTimestamp     = errorsmod.Register(ModuleName, 17, "timeout timestamp must be in the future")
 	ErrInvalidCodec                = errorsmod.Register(ModuleName, 18, "codec is not supported")
 	ErrInvalidAccountReopening     = errorsmod.Register(ModuleName, 19, "invalid account reopening")
+	ErrInvalidAckFormat            = errorsmod.Register(ModuleName, 20, "invalid acknowledgement format")
 )
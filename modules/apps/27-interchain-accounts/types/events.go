@@ -2,10 +2,21 @@ package types
 
 // ICS27 Interchain Accounts events
 const (
-	EventTypePacket = "ics27_packet"
+	EventTypePacket              = "ics27_packet"
+	EventTypeReopenActiveChannel = "ics27_reopen_active_channel"
+	EventTypeScheduleTx          = "ics27_schedule_tx"
+	EventTypeCancelScheduledTx   = "ics27_cancel_scheduled_tx"
+	EventTypeExecuteScheduledTx  = "ics27_execute_scheduled_tx"
 
-	AttributeKeyAckError            = "error"
-	AttributeKeyHostChannelID       = "host_channel_id"
-	AttributeKeyControllerChannelID = "controller_channel_id"
-	AttributeKeyAckSuccess          = "success"
+	AttributeKeyAckError               = "error"
+	AttributeKeyHostChannelID          = "host_channel_id"
+	AttributeKeyControllerChannelID    = "controller_channel_id"
+	AttributeKeyAckSuccess             = "success"
+	AttributeKeyControllerPortID       = "controller_port_id"
+	AttributeKeyControllerConnectionID = "controller_connection_id"
+	AttributeKeyClosedChannelID        = "closed_channel_id"
+	AttributeKeyOwner                  = "owner"
+	AttributeKeyScheduledTxID          = "scheduled_tx_id"
+	AttributeKeySequence               = "sequence"
+	AttributeKeyExecutionError         = "execution_error"
 )
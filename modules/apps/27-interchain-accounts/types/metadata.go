@@ -2,8 +2,11 @@ package types
 
 import (
 	"context"
+	"encoding/json"
 	"slices"
 
+	"github.com/cosmos/gogoproto/jsonpb"
+
 	errorsmod "cosmossdk.io/errors"
 
 	connectiontypes "github.com/cosmos/ibc-go/v9/modules/core/03-connection/types"
@@ -18,8 +21,25 @@ const (
 
 	// TxTypeSDKMultiMsg defines the multi message transaction type supported by the Cosmos SDK
 	TxTypeSDKMultiMsg = "sdk_multi_msg"
+
+	// AckFormatStructured defines the acknowledgement format in which the host chain writes an
+	// InterchainAccountAcknowledgement containing the result of each message or the details of the failure
+	AckFormatStructured = "structured"
 )
 
+var _ jsonpb.JSONPBMarshaler = (*Metadata)(nil)
+
+// metadataJSON defines the JSON encoding of Metadata, the field order matches the proto definition.
+type metadataJSON struct {
+	Version                string `json:"version"`
+	ControllerConnectionID string `json:"controller_connection_id"`
+	HostConnectionID       string `json:"host_connection_id"`
+	Address                string `json:"address"`
+	Encoding               string `json:"encoding"`
+	TxType                 string `json:"tx_type"`
+	AckFormat              string `json:"ack_format,omitempty"`
+}
+
 // NewMetadata creates and returns a new ICS27 Metadata instance
 func NewMetadata(version, controllerConnectionID, hostConnectionID, accAddress, encoding, txType string) Metadata {
 	return Metadata{
@@ -51,7 +71,26 @@ func NewDefaultMetadata(controllerConnectionID, hostConnectionID string) Metadat
 func NewDefaultMetadataString(controllerConnectionID, hostConnectionID string) string {
 	metadata := NewDefaultMetadata(controllerConnectionID, hostConnectionID)
 
-	return string(ModuleCdc.MustMarshalJSON(&metadata))
+	return string(metadata.GetBytes())
+}
+
+// GetBytes returns the JSON marshalled metadata.
+func (metadata Metadata) GetBytes() []byte {
+	return ModuleCdc.MustMarshalJSON(&metadata)
+}
+
+// MarshalJSONPB implements jsonpb.JSONPBMarshaler. If the ack format is not set, it is omitted
+// so that the version string can be decoded by chains which do not support it.
+func (metadata Metadata) MarshalJSONPB(*jsonpb.Marshaler) ([]byte, error) {
+	return json.Marshal(metadataJSON{
+		Version:                metadata.Version,
+		ControllerConnectionID: metadata.ControllerConnectionId,
+		HostConnectionID:       metadata.HostConnectionId,
+		Address:                metadata.Address,
+		Encoding:               metadata.Encoding,
+		TxType:                 metadata.TxType,
+		AckFormat:              metadata.AckFormat,
+	})
 }
 
 // MetadataFromVersion parses Metadata from a json encoded version string.
@@ -75,13 +114,14 @@ func IsPreviousMetadataEqual(previousVersion string, metadata Metadata) bool {
 		previousMetadata.ControllerConnectionId == metadata.ControllerConnectionId &&
 		previousMetadata.HostConnectionId == metadata.HostConnectionId &&
 		previousMetadata.Encoding == metadata.Encoding &&
-		previousMetadata.TxType == metadata.TxType)
+		previousMetadata.TxType == metadata.TxType &&
+		previousMetadata.AckFormat == metadata.AckFormat)
 }
 
 // ValidateControllerMetadata performs validation of the provided ICS27 controller metadata parameters as well
-// as the connection params against the provided metadata
-func ValidateControllerMetadata(ctx context.Context, channelKeeper ChannelKeeper, connectionHops []string, metadata Metadata) error {
-	if !isSupportedEncoding(metadata.Encoding) {
+// as the connection params against the provided metadata. The encoding must be registered in the provided registry.
+func ValidateControllerMetadata(ctx context.Context, channelKeeper ChannelKeeper, encodingRegistry *TxEncodingRegistry, connectionHops []string, metadata Metadata) error {
+	if !encodingRegistry.IsSupported(metadata.Encoding) {
 		return errorsmod.Wrapf(ErrInvalidCodec, "unsupported encoding format %s", metadata.Encoding)
 	}
 
@@ -89,6 +129,10 @@ func ValidateControllerMetadata(ctx context.Context, channelKeeper ChannelKeeper
 		return errorsmod.Wrapf(ErrUnknownDataType, "unsupported transaction type %s", metadata.TxType)
 	}
 
+	if !isSupportedAckFormat(metadata.AckFormat) {
+		return errorsmod.Wrapf(ErrInvalidAckFormat, "unsupported acknowledgement format %s", metadata.AckFormat)
+	}
+
 	connection, err := channelKeeper.GetConnection(ctx, connectionHops[0])
 	if err != nil {
 		return err
@@ -111,9 +155,10 @@ func ValidateControllerMetadata(ctx context.Context, channelKeeper ChannelKeeper
 	return nil
 }
 
-// ValidateHostMetadata performs validation of the provided ICS27 host metadata parameters
-func ValidateHostMetadata(ctx context.Context, channelKeeper ChannelKeeper, connectionHops []string, metadata Metadata) error {
-	if !isSupportedEncoding(metadata.Encoding) {
+// ValidateHostMetadata performs validation of the provided ICS27 host metadata parameters.
+// The encoding must be registered in the provided registry.
+func ValidateHostMetadata(ctx context.Context, channelKeeper ChannelKeeper, encodingRegistry *TxEncodingRegistry, connectionHops []string, metadata Metadata) error {
+	if !encodingRegistry.IsSupported(metadata.Encoding) {
 		return errorsmod.Wrapf(ErrInvalidCodec, "unsupported encoding format %s", metadata.Encoding)
 	}
 
@@ -121,6 +166,10 @@ func ValidateHostMetadata(ctx context.Context, channelKeeper ChannelKeeper, conn
 		return errorsmod.Wrapf(ErrUnknownDataType, "unsupported transaction type %s", metadata.TxType)
 	}
 
+	if !isSupportedAckFormat(metadata.AckFormat) {
+		return errorsmod.Wrapf(ErrInvalidAckFormat, "unsupported acknowledgement format %s", metadata.AckFormat)
+	}
+
 	connection, err := channelKeeper.GetConnection(ctx, connectionHops[0])
 	if err != nil {
 		return err
@@ -143,14 +192,9 @@ func ValidateHostMetadata(ctx context.Context, channelKeeper ChannelKeeper, conn
 	return nil
 }
 
-// isSupportedEncoding returns true if the provided encoding is supported, otherwise false
-func isSupportedEncoding(encoding string) bool {
-	return slices.Contains(getSupportedEncoding(), encoding)
-}
-
-// getSupportedEncoding returns a string slice of supported encoding formats
-func getSupportedEncoding() []string {
-	return []string{EncodingProtobuf, EncodingProto3JSON}
+// isSupportedAckFormat returns true if the provided acknowledgement format is supported, otherwise false
+func isSupportedAckFormat(ackFormat string) bool {
+	return ackFormat == "" || ackFormat == AckFormatStructured
 }
 
 // isSupportedTxType returns true if the provided transaction type is supported, otherwise false
@@ -38,6 +38,9 @@ type Metadata struct {
 	Encoding string `protobuf:"bytes,5,opt,name=encoding,proto3" json:"encoding,omitempty"`
 	// tx_type defines the type of transactions the interchain account can execute
 	TxType string `protobuf:"bytes,6,opt,name=tx_type,json=txType,proto3" json:"tx_type,omitempty"`
+	// ack_format defines the format of the acknowledgements written by the host chain. If empty, the standard
+	// channel acknowledgements are written.
+	AckFormat string `protobuf:"bytes,7,opt,name=ack_format,json=ackFormat,proto3" json:"ack_format,omitempty"`
 }
 
 func (m *Metadata) Reset()         { *m = Metadata{} }
@@ -115,6 +118,13 @@ func (m *Metadata) GetTxType() string {
 	return ""
 }
 
+func (m *Metadata) GetAckFormat() string {
+	if m != nil {
+		return m.AckFormat
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Metadata)(nil), "ibc.applications.interchain_accounts.v1.Metadata")
 }
@@ -124,26 +134,28 @@ func init() {
 }
 
 var fileDescriptor_c29c32e397d1f21e = []byte{
-	// 304 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x90, 0xbd, 0x4e, 0xc3, 0x30,
-	0x14, 0x85, 0x1b, 0x7e, 0xda, 0xe2, 0x09, 0x79, 0x00, 0x8b, 0x21, 0x42, 0x2c, 0x30, 0xd0, 0x58,
-	0x05, 0x89, 0x9f, 0x15, 0xc4, 0xc0, 0xc0, 0x82, 0x98, 0x58, 0x22, 0xe7, 0xda, 0x6a, 0x2d, 0x25,
-	0xbe, 0x91, 0x7d, 0x1b, 0xb5, 0x6f, 0xc1, 0x63, 0x31, 0x76, 0x44, 0x62, 0x41, 0xed, 0x8b, 0xa0,
-	0x84, 0x92, 0x02, 0x62, 0x3c, 0xfa, 0xce, 0x67, 0x5f, 0x1d, 0x76, 0x61, 0x33, 0x90, 0xaa, 0x2c,
-	0x73, 0x0b, 0x8a, 0x2c, 0xba, 0x20, 0xad, 0x23, 0xe3, 0x61, 0xac, 0xac, 0x4b, 0x15, 0x00, 0x4e,
-	0x1c, 0x05, 0x59, 0x0d, 0x65, 0x61, 0x48, 0x69, 0x45, 0x2a, 0x29, 0x3d, 0x12, 0xf2, 0x63, 0x9b,
-	0x41, 0xf2, 0xd3, 0x4b, 0xfe, 0xf1, 0x92, 0x6a, 0x78, 0xf4, 0x1e, 0xb1, 0xfe, 0xc3, 0xca, 0xe5,
-	0x82, 0xf5, 0x2a, 0xe3, 0x83, 0x45, 0x27, 0xa2, 0xc3, 0xe8, 0x64, 0xe7, 0xf1, 0x3b, 0xf2, 0x2b,
-	0x26, 0x00, 0x1d, 0x79, 0xcc, 0x73, 0xe3, 0x53, 0x40, 0xe7, 0x0c, 0xd4, 0xef, 0xa6, 0x56, 0x8b,
-	0x8d, 0xa6, 0xba, 0xb7, 0xe6, 0xb7, 0x2d, 0xbe, 0xd7, 0xfc, 0x94, 0xf1, 0x31, 0x06, 0xfa, 0xe3,
-	0x6c, 0x36, 0xce, 0x6e, 0x4d, 0x7e, 0xb5, 0x05, 0xeb, 0x29, 0xad, 0xbd, 0x09, 0x41, 0x6c, 0x7d,
-	0x5d, 0xb0, 0x8a, 0xfc, 0x80, 0xf5, 0x8d, 0x03, 0xd4, 0xd6, 0x8d, 0xc4, 0x76, 0x83, 0xda, 0xcc,
-	0xf7, 0x59, 0x8f, 0xa6, 0x29, 0xcd, 0x4a, 0x23, 0xba, 0x0d, 0xea, 0xd2, 0xf4, 0x69, 0x56, 0x9a,
-	0x9b, 0xf4, 0x75, 0x11, 0x47, 0xf3, 0x45, 0x1c, 0x7d, 0x2c, 0xe2, 0xe8, 0x65, 0x19, 0x77, 0xe6,
-	0xcb, 0xb8, 0xf3, 0xb6, 0x8c, 0x3b, 0xcf, 0x77, 0x23, 0x4b, 0xe3, 0x49, 0x96, 0x00, 0x16, 0x12,
-	0x30, 0x14, 0x18, 0xa4, 0xcd, 0x60, 0x30, 0x42, 0x59, 0x5d, 0xcb, 0x02, 0xf5, 0x24, 0x37, 0xa1,
-	0x1e, 0x3e, 0xc8, 0xb3, 0xcb, 0xc1, 0x7a, 0xbb, 0x41, 0xbb, 0x79, 0xfd, 0x5b, 0xc8, 0xba, 0xcd,
-	0xdc, 0xe7, 0x9f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xfe, 0x71, 0xe5, 0xf3, 0xa8, 0x01, 0x00, 0x00,
+	// 322 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0xbd, 0x4e, 0xf3, 0x30,
+	0x14, 0x86, 0x9b, 0xef, 0xa7, 0x69, 0x3d, 0x21, 0x0f, 0x60, 0x21, 0x11, 0x21, 0x16, 0x18, 0x68,
+	0xac, 0x82, 0xc4, 0xcf, 0x0a, 0x02, 0x89, 0x81, 0x05, 0x31, 0xb1, 0x44, 0xce, 0xb1, 0x69, 0xad,
+	0x26, 0x3e, 0x91, 0xed, 0x46, 0xed, 0x5d, 0x70, 0x59, 0x8c, 0x1d, 0x19, 0x51, 0x7b, 0x17, 0x4c,
+	0x28, 0xa6, 0xb4, 0x80, 0x18, 0x5f, 0x3f, 0xe7, 0xb1, 0x8e, 0xce, 0x4b, 0x4e, 0x74, 0x0e, 0x5c,
+	0x54, 0x55, 0xa1, 0x41, 0x78, 0x8d, 0xc6, 0x71, 0x6d, 0xbc, 0xb2, 0x30, 0x14, 0xda, 0x64, 0x02,
+	0x00, 0xc7, 0xc6, 0x3b, 0x5e, 0xf7, 0x79, 0xa9, 0xbc, 0x90, 0xc2, 0x8b, 0xb4, 0xb2, 0xe8, 0x91,
+	0xee, 0xeb, 0x1c, 0xd2, 0xaf, 0x5e, 0xfa, 0x8b, 0x97, 0xd6, 0xfd, 0xbd, 0xb7, 0x88, 0x74, 0x6e,
+	0x97, 0x2e, 0x65, 0x24, 0xae, 0x95, 0x75, 0x1a, 0x0d, 0x8b, 0x76, 0xa3, 0x83, 0xee, 0xdd, 0x67,
+	0xa4, 0x67, 0x84, 0x01, 0x1a, 0x6f, 0xb1, 0x28, 0x94, 0xcd, 0x00, 0x8d, 0x51, 0xd0, 0xfc, 0x9b,
+	0x69, 0xc9, 0xfe, 0x84, 0xd1, 0xcd, 0x35, 0xbf, 0x5c, 0xe1, 0x1b, 0x49, 0x0f, 0x09, 0x1d, 0xa2,
+	0xf3, 0x3f, 0x9c, 0xbf, 0xc1, 0xd9, 0x68, 0xc8, 0xb7, 0x69, 0x46, 0x62, 0x21, 0xa5, 0x55, 0xce,
+	0xb1, 0x7f, 0x1f, 0x1b, 0x2c, 0x23, 0xdd, 0x26, 0x1d, 0x65, 0x00, 0xa5, 0x36, 0x03, 0xf6, 0x3f,
+	0xa0, 0x55, 0xa6, 0x5b, 0x24, 0xf6, 0x93, 0xcc, 0x4f, 0x2b, 0xc5, 0xda, 0x01, 0xb5, 0xfd, 0xe4,
+	0x7e, 0x5a, 0x29, 0xba, 0x43, 0x88, 0x80, 0x51, 0xf6, 0x88, 0xb6, 0x14, 0x9e, 0xc5, 0x81, 0x75,
+	0x05, 0x8c, 0xae, 0xc3, 0xc3, 0x45, 0xf6, 0x3c, 0x4f, 0xa2, 0xd9, 0x3c, 0x89, 0x5e, 0xe7, 0x49,
+	0xf4, 0xb4, 0x48, 0x5a, 0xb3, 0x45, 0xd2, 0x7a, 0x59, 0x24, 0xad, 0x87, 0xab, 0x81, 0xf6, 0xc3,
+	0x71, 0x9e, 0x02, 0x96, 0x1c, 0xd0, 0x95, 0xe8, 0xb8, 0xce, 0xa1, 0x37, 0x40, 0x5e, 0x9f, 0xf3,
+	0x12, 0xe5, 0xb8, 0x50, 0xae, 0xe9, 0xc5, 0xf1, 0xa3, 0xd3, 0xde, 0xfa, 0xb4, 0xbd, 0x55, 0x25,
+	0xcd, 0x32, 0x2e, 0x6f, 0x87, 0x36, 0x8e, 0xdf, 0x07, 0x00, 0x3c, 0x33, 0xbe, 0xb9, 0xc7, 0x01,
+	0x00, 0x00,
 }
 
 func (m *Metadata) Marshal() (dAtA []byte, err error) {
@@ -166,6 +178,13 @@ func (m *Metadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AckFormat) > 0 {
+		i -= len(m.AckFormat)
+		copy(dAtA[i:], m.AckFormat)
+		i = encodeVarintMetadata(dAtA, i, uint64(len(m.AckFormat)))
+		i--
+		dAtA[i] = 0x3a
+	}
 	if len(m.TxType) > 0 {
 		i -= len(m.TxType)
 		copy(dAtA[i:], m.TxType)
@@ -252,6 +271,10 @@ func (m *Metadata) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovMetadata(uint64(l))
 	}
+	l = len(m.AckFormat)
+	if l > 0 {
+		n += 1 + l + sovMetadata(uint64(l))
+	}
 	return n
 }
 
@@ -482,6 +505,38 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 			}
 			m.TxType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AckFormat", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AckFormat = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMetadata(dAtA[iNdEx:])
@@ -77,6 +77,17 @@ func (suite *TypesTestSuite) TestIsPreviousMetadataEqual() {
 			},
 			false,
 		},
+		{
+			"unequal acknowledgement format",
+			func() {
+				metadata.AckFormat = types.AckFormatStructured
+
+				versionBytes, err := types.ModuleCdc.MarshalJSON(&metadata)
+				suite.Require().NoError(err)
+				previousVersion = string(versionBytes)
+			},
+			false,
+		},
 		{
 			"unequal controller connection",
 			func() {
@@ -177,6 +188,13 @@ func (suite *TypesTestSuite) TestValidateControllerMetadata() {
 			},
 			true,
 		},
+		{
+			"success with registered encoding format",
+			func() {
+				metadata.Encoding = mockEncodingFormat
+			},
+			true,
+		},
 		{
 			"unsupported encoding format",
 			func() {
@@ -205,6 +223,21 @@ func (suite *TypesTestSuite) TestValidateControllerMetadata() {
 			},
 			false,
 		},
+		{
+			"unsupported acknowledgement format",
+			func() {
+				metadata = types.Metadata{
+					Version:                types.Version,
+					ControllerConnectionId: ibctesting.FirstConnectionID,
+					HostConnectionId:       ibctesting.FirstConnectionID,
+					Address:                TestOwnerAddress,
+					Encoding:               types.EncodingProtobuf,
+					TxType:                 types.TxTypeSDKMultiMsg,
+					AckFormat:              "invalid-ack-format",
+				}
+			},
+			false,
+		},
 		{
 			"invalid controller connection",
 			func() {
@@ -275,9 +308,13 @@ func (suite *TypesTestSuite) TestValidateControllerMetadata() {
 
 			tc.malleate() // malleate mutates test data
 
+			encodingRegistry := types.NewTxEncodingRegistry()
+			encodingRegistry.Register(mockEncodingFormat, mockTxEncoding{})
+
 			err := types.ValidateControllerMetadata(
 				suite.chainA.GetContext(),
 				suite.chainA.App.GetIBCKeeper().ChannelKeeper,
+				encodingRegistry,
 				[]string{ibctesting.FirstConnectionID},
 				metadata,
 			)
@@ -332,6 +369,13 @@ func (suite *TypesTestSuite) TestValidateHostMetadata() {
 			},
 			true,
 		},
+		{
+			"success with registered encoding format",
+			func() {
+				metadata.Encoding = mockEncodingFormat
+			},
+			true,
+		},
 		{
 			"unsupported encoding format",
 			func() {
@@ -360,6 +404,21 @@ func (suite *TypesTestSuite) TestValidateHostMetadata() {
 			},
 			false,
 		},
+		{
+			"unsupported acknowledgement format",
+			func() {
+				metadata = types.Metadata{
+					Version:                types.Version,
+					ControllerConnectionId: ibctesting.FirstConnectionID,
+					HostConnectionId:       ibctesting.FirstConnectionID,
+					Address:                TestOwnerAddress,
+					Encoding:               types.EncodingProtobuf,
+					TxType:                 types.TxTypeSDKMultiMsg,
+					AckFormat:              "invalid-ack-format",
+				}
+			},
+			false,
+		},
 		{
 			"invalid controller connection",
 			func() {
@@ -430,9 +489,13 @@ func (suite *TypesTestSuite) TestValidateHostMetadata() {
 
 			tc.malleate() // malleate mutates test data
 
+			encodingRegistry := types.NewTxEncodingRegistry()
+			encodingRegistry.Register(mockEncodingFormat, mockTxEncoding{})
+
 			err := types.ValidateHostMetadata(
 				suite.chainA.GetContext(),
 				suite.chainA.App.GetIBCKeeper().ChannelKeeper,
+				encodingRegistry,
 				[]string{ibctesting.FirstConnectionID},
 				metadata,
 			)
@@ -445,3 +508,21 @@ func (suite *TypesTestSuite) TestValidateHostMetadata() {
 		})
 	}
 }
+
+func (suite *TypesTestSuite) TestMetadataGetBytes() {
+	metadata := types.NewDefaultMetadata(ibctesting.FirstConnectionID, ibctesting.FirstConnectionID)
+
+	// unset ack format is omitted
+	suite.Require().Equal(`{"version":"ics27-1","controller_connection_id":"connection-0","host_connection_id":"connection-0","address":"","encoding":"proto3","tx_type":"sdk_multi_msg"}`, string(metadata.GetBytes()))
+
+	decoded, err := types.MetadataFromVersion(string(metadata.GetBytes()))
+	suite.Require().NoError(err)
+	suite.Require().Equal(metadata, decoded)
+
+	metadata.AckFormat = types.AckFormatStructured
+	suite.Require().Equal(`{"version":"ics27-1","controller_connection_id":"connection-0","host_connection_id":"connection-0","address":"","encoding":"proto3","tx_type":"sdk_multi_msg","ack_format":"structured"}`, string(metadata.GetBytes()))
+
+	decoded, err = types.MetadataFromVersion(string(metadata.GetBytes()))
+	suite.Require().NoError(err)
+	suite.Require().Equal(metadata, decoded)
+}
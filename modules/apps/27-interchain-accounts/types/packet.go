@@ -2,7 +2,8 @@ package types
 
 import (
 	"encoding/json"
-	"strings"
+
+	"github.com/cosmos/gogoproto/jsonpb"
 
 	errorsmod "cosmossdk.io/errors"
 
@@ -15,11 +16,21 @@ import (
 var (
 	_ ibcexported.PacketData         = (*InterchainAccountPacketData)(nil)
 	_ ibcexported.PacketDataProvider = (*InterchainAccountPacketData)(nil)
+	_ jsonpb.JSONPBMarshaler         = (*InterchainAccountPacketData)(nil)
 )
 
 // MaxMemoCharLength defines the maximum length for the InterchainAccountPacketData memo field
 const MaxMemoCharLength = 32768
 
+// packetDataJSON defines the JSON encoding of InterchainAccountPacketData, the field order matches the proto definition.
+type packetDataJSON struct {
+	Type     string    `json:"type"`
+	Data     []byte    `json:"data"`
+	Memo     string    `json:"memo"`
+	GasLimit uint64    `json:"gas_limit,string,omitempty"`
+	Fee      sdk.Coins `json:"fee,omitempty"`
+}
+
 // ValidateBasic performs basic validation of the interchain account packet data.
 // The memo may be empty.
 func (iapd InterchainAccountPacketData) ValidateBasic() error {
@@ -35,6 +46,15 @@ func (iapd InterchainAccountPacketData) ValidateBasic() error {
 		return errorsmod.Wrapf(ErrInvalidOutgoingData, "packet data memo cannot be greater than %d characters", MaxMemoCharLength)
 	}
 
+	if err := iapd.Fee.Validate(); err != nil {
+		return errorsmod.Wrapf(ErrInvalidOutgoingData, "invalid packet data fee: %s", err)
+	}
+
+	// the gas limit and fee only apply to the execution of transactions
+	if iapd.Type == QUERY && (iapd.GasLimit != 0 || !iapd.Fee.IsZero()) {
+		return errorsmod.Wrap(ErrInvalidOutgoingData, "gas limit and fee are not supported for query packet data")
+	}
+
 	return nil
 }
 
@@ -43,6 +63,18 @@ func (iapd InterchainAccountPacketData) GetBytes() []byte {
 	return ModuleCdc.MustMarshalJSON(&iapd)
 }
 
+// MarshalJSONPB implements jsonpb.JSONPBMarshaler. If the gas limit or the fee are not set, they are omitted
+// so that the packet data can be decoded by host chains which do not support them.
+func (iapd InterchainAccountPacketData) MarshalJSONPB(*jsonpb.Marshaler) ([]byte, error) {
+	return json.Marshal(packetDataJSON{
+		Type:     iapd.Type.String(),
+		Data:     iapd.Data,
+		Memo:     iapd.Memo,
+		GasLimit: iapd.GasLimit,
+		Fee:      iapd.Fee,
+	})
+}
+
 // UnmarshalJSON unmarshals raw JSON bytes into an InterchainAccountPacketData.
 func (iapd *InterchainAccountPacketData) UnmarshalJSON(bz []byte) error {
 	return ModuleCdc.UnmarshalJSON(bz, iapd)
@@ -61,16 +93,16 @@ func (ct CosmosTx) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
 }
 
 // GetPacketSender returns the sender address of the interchain accounts packet data.
-// It is obtained from the source port ID by cutting off the ControllerPortPrefix.
-// If the source port ID does not have the ControllerPortPrefix, then an empty string is returned.
+// It is obtained from the source port ID by cutting off the ControllerPortPrefix and the account index, if any.
+// If the source port ID is not a valid controller port ID, then an empty string is returned.
 //
 // NOTE:
 //   - The sender address is set by the packet sender and may not have been validated a signature
 //     check if the packet sender isn't the interchain accounts module.
 //   - The sender address must only be used by modules on the sending chain.
 func (InterchainAccountPacketData) GetPacketSender(sourcePortID string) string {
-	icaOwner, found := strings.CutPrefix(sourcePortID, ControllerPortPrefix)
-	if !found {
+	icaOwner, _, err := ParseControllerPortID(sourcePortID)
+	if err != nil {
 		return ""
 	}
 	return icaOwner
@@ -5,7 +5,9 @@ package types
 
 import (
 	fmt "fmt"
-	types "github.com/cosmos/cosmos-sdk/codec/types"
+	types1 "github.com/cosmos/cosmos-sdk/codec/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -33,16 +35,20 @@ const (
 	UNSPECIFIED Type = 0
 	// Execute a transaction on an interchain accounts host chain
 	EXECUTE_TX Type = 1
+	// Execute read-only queries on an interchain accounts host chain
+	QUERY Type = 2
 )
 
 var Type_name = map[int32]string{
 	0: "TYPE_UNSPECIFIED",
 	1: "TYPE_EXECUTE_TX",
+	2: "TYPE_QUERY",
 }
 
 var Type_value = map[string]int32{
 	"TYPE_UNSPECIFIED": 0,
 	"TYPE_EXECUTE_TX":  1,
+	"TYPE_QUERY":       2,
 }
 
 func (x Type) String() string {
@@ -53,11 +59,19 @@ func (Type) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_89a080d7401cd393, []int{0}
 }
 
-// InterchainAccountPacketData is comprised of a raw transaction, type of transaction and optional memo field.
+// InterchainAccountPacketData is comprised of a raw transaction, type of transaction, optional memo field
+// and optional gas limit and fee for the execution of the transaction on the host chain.
 type InterchainAccountPacketData struct {
 	Type Type   `protobuf:"varint,1,opt,name=type,proto3,enum=ibc.applications.interchain_accounts.v1.Type" json:"type,omitempty"`
 	Data []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
 	Memo string `protobuf:"bytes,3,opt,name=memo,proto3" json:"memo,omitempty"`
+	// gas_limit is the maximum gas the host chain may consume to execute the transaction. If zero, the execution
+	// is only limited by the gas remaining in the transaction of the relayer. The gas consumed by the execution is
+	// only charged to the relayer if the execution succeeds.
+	GasLimit uint64 `protobuf:"varint,4,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+	// fee is deducted from the interchain account and paid to the relayer of the packet when the transaction
+	// is executed successfully.
+	Fee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=fee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee"`
 }
 
 func (m *InterchainAccountPacketData) Reset()         { *m = InterchainAccountPacketData{} }
@@ -114,9 +128,23 @@ func (m *InterchainAccountPacketData) GetMemo() string {
 	return ""
 }
 
+func (m *InterchainAccountPacketData) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
+	}
+	return 0
+}
+
+func (m *InterchainAccountPacketData) GetFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Fee
+	}
+	return nil
+}
+
 // CosmosTx contains a list of sdk.Msg's. It should be used when sending transactions to an SDK host chain.
 type CosmosTx struct {
-	Messages []*types.Any `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
+	Messages []*types1.Any `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
 }
 
 func (m *CosmosTx) Reset()         { *m = CosmosTx{} }
@@ -152,17 +180,438 @@ func (m *CosmosTx) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_CosmosTx proto.InternalMessageInfo
 
-func (m *CosmosTx) GetMessages() []*types.Any {
+func (m *CosmosTx) GetMessages() []*types1.Any {
 	if m != nil {
 		return m.Messages
 	}
 	return nil
 }
 
+// CosmosQuery contains a list of query requests. It should be used when sending queries to an SDK host chain.
+type CosmosQuery struct {
+	Requests []CosmosQueryRequest `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests"`
+}
+
+func (m *CosmosQuery) Reset()         { *m = CosmosQuery{} }
+func (m *CosmosQuery) String() string { return proto.CompactTextString(m) }
+func (*CosmosQuery) ProtoMessage()    {}
+func (*CosmosQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_89a080d7401cd393, []int{2}
+}
+func (m *CosmosQuery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CosmosQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CosmosQuery.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CosmosQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CosmosQuery.Merge(m, src)
+}
+func (m *CosmosQuery) XXX_Size() int {
+	return m.Size()
+}
+func (m *CosmosQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_CosmosQuery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CosmosQuery proto.InternalMessageInfo
+
+func (m *CosmosQuery) GetRequests() []CosmosQueryRequest {
+	if m != nil {
+		return m.Requests
+	}
+	return nil
+}
+
+// CosmosQueryRequest defines the parameters for a particular query request sent to an SDK host chain.
+type CosmosQueryRequest struct {
+	// path defines the path of the query request as defined by ADR-021.
+	// https://github.com/cosmos/cosmos-sdk/blob/main/docs/architecture/adr-021-protobuf-query-encoding.md#custom-query-registration-and-routing
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// data defines the payload of the query request as defined by ADR-021.
+	// https://github.com/cosmos/cosmos-sdk/blob/main/docs/architecture/adr-021-protobuf-query-encoding.md#custom-query-registration-and-routing
+	Data []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *CosmosQueryRequest) Reset()         { *m = CosmosQueryRequest{} }
+func (m *CosmosQueryRequest) String() string { return proto.CompactTextString(m) }
+func (*CosmosQueryRequest) ProtoMessage()    {}
+func (*CosmosQueryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_89a080d7401cd393, []int{3}
+}
+func (m *CosmosQueryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CosmosQueryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CosmosQueryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CosmosQueryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CosmosQueryRequest.Merge(m, src)
+}
+func (m *CosmosQueryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *CosmosQueryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CosmosQueryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CosmosQueryRequest proto.InternalMessageInfo
+
+func (m *CosmosQueryRequest) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *CosmosQueryRequest) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+// CosmosQueryResponse contains the responses to the query requests of a CosmosQuery. It is returned in the
+// acknowledgement result of a query packet.
+type CosmosQueryResponse struct {
+	// responses defines the response of each query request, in the order of the requests.
+	Responses [][]byte `protobuf:"bytes,1,rep,name=responses,proto3" json:"responses,omitempty"`
+	// height defines the block height of the host chain at which the queries were executed.
+	Height uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *CosmosQueryResponse) Reset()         { *m = CosmosQueryResponse{} }
+func (m *CosmosQueryResponse) String() string { return proto.CompactTextString(m) }
+func (*CosmosQueryResponse) ProtoMessage()    {}
+func (*CosmosQueryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_89a080d7401cd393, []int{4}
+}
+func (m *CosmosQueryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CosmosQueryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CosmosQueryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CosmosQueryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CosmosQueryResponse.Merge(m, src)
+}
+func (m *CosmosQueryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *CosmosQueryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CosmosQueryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CosmosQueryResponse proto.InternalMessageInfo
+
+func (m *CosmosQueryResponse) GetResponses() [][]byte {
+	if m != nil {
+		return m.Responses
+	}
+	return nil
+}
+
+func (m *CosmosQueryResponse) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// InterchainAccountAcknowledgement is the acknowledgement written by the host chain for the packets of channels
+// which negotiated the structured acknowledgement format.
+type InterchainAccountAcknowledgement struct {
+	// response contains either the result or the error of the packet handling.
+	//
+	// Types that are valid to be assigned to Response:
+	//	*InterchainAccountAcknowledgement_Result
+	//	*InterchainAccountAcknowledgement_Error
+	Response isInterchainAccountAcknowledgement_Response `protobuf_oneof:"response"`
+}
+
+func (m *InterchainAccountAcknowledgement) Reset()         { *m = InterchainAccountAcknowledgement{} }
+func (m *InterchainAccountAcknowledgement) String() string { return proto.CompactTextString(m) }
+func (*InterchainAccountAcknowledgement) ProtoMessage()    {}
+func (*InterchainAccountAcknowledgement) Descriptor() ([]byte, []int) {
+	return fileDescriptor_89a080d7401cd393, []int{5}
+}
+func (m *InterchainAccountAcknowledgement) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InterchainAccountAcknowledgement) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InterchainAccountAcknowledgement.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InterchainAccountAcknowledgement) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InterchainAccountAcknowledgement.Merge(m, src)
+}
+func (m *InterchainAccountAcknowledgement) XXX_Size() int {
+	return m.Size()
+}
+func (m *InterchainAccountAcknowledgement) XXX_DiscardUnknown() {
+	xxx_messageInfo_InterchainAccountAcknowledgement.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InterchainAccountAcknowledgement proto.InternalMessageInfo
+
+type isInterchainAccountAcknowledgement_Response interface {
+	isInterchainAccountAcknowledgement_Response()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type InterchainAccountAcknowledgement_Result struct {
+	Result []byte `protobuf:"bytes,1,opt,name=result,proto3,oneof" json:"result,omitempty"`
+}
+type InterchainAccountAcknowledgement_Error struct {
+	Error *ExecutionError `protobuf:"bytes,2,opt,name=error,proto3,oneof" json:"error,omitempty"`
+}
+
+func (*InterchainAccountAcknowledgement_Result) isInterchainAccountAcknowledgement_Response() {}
+func (*InterchainAccountAcknowledgement_Error) isInterchainAccountAcknowledgement_Response()  {}
+
+func (m *InterchainAccountAcknowledgement) GetResponse() isInterchainAccountAcknowledgement_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (m *InterchainAccountAcknowledgement) GetResult() []byte {
+	if x, ok := m.GetResponse().(*InterchainAccountAcknowledgement_Result); ok {
+		return x.Result
+	}
+	return nil
+}
+
+func (m *InterchainAccountAcknowledgement) GetError() *ExecutionError {
+	if x, ok := m.GetResponse().(*InterchainAccountAcknowledgement_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*InterchainAccountAcknowledgement) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*InterchainAccountAcknowledgement_Result)(nil),
+		(*InterchainAccountAcknowledgement_Error)(nil),
+	}
+}
+
+// ExecutionResult contains the result of the execution of each message of an interchain account transaction.
+type ExecutionResult struct {
+	// msg_results defines the result of each message, in the order of the messages of the transaction.
+	MsgResults []MsgResult `protobuf:"bytes,1,rep,name=msg_results,json=msgResults,proto3" json:"msg_results"`
+}
+
+func (m *ExecutionResult) Reset()         { *m = ExecutionResult{} }
+func (m *ExecutionResult) String() string { return proto.CompactTextString(m) }
+func (*ExecutionResult) ProtoMessage()    {}
+func (*ExecutionResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_89a080d7401cd393, []int{6}
+}
+func (m *ExecutionResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExecutionResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExecutionResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExecutionResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExecutionResult.Merge(m, src)
+}
+func (m *ExecutionResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExecutionResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExecutionResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExecutionResult proto.InternalMessageInfo
+
+func (m *ExecutionResult) GetMsgResults() []MsgResult {
+	if m != nil {
+		return m.MsgResults
+	}
+	return nil
+}
+
+// MsgResult contains the response and a summary of the events emitted by the execution of a message of an
+// interchain account transaction.
+type MsgResult struct {
+	// msg_response defines the response of the message.
+	MsgResponse *types1.Any `protobuf:"bytes,1,opt,name=msg_response,json=msgResponse,proto3" json:"msg_response,omitempty"`
+	// event_types defines the types of the events emitted by the message, in the order of emission.
+	EventTypes []string `protobuf:"bytes,2,rep,name=event_types,json=eventTypes,proto3" json:"event_types,omitempty"`
+}
+
+func (m *MsgResult) Reset()         { *m = MsgResult{} }
+func (m *MsgResult) String() string { return proto.CompactTextString(m) }
+func (*MsgResult) ProtoMessage()    {}
+func (*MsgResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_89a080d7401cd393, []int{7}
+}
+func (m *MsgResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResult.Merge(m, src)
+}
+func (m *MsgResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgResult proto.InternalMessageInfo
+
+func (m *MsgResult) GetMsgResponse() *types1.Any {
+	if m != nil {
+		return m.MsgResponse
+	}
+	return nil
+}
+
+func (m *MsgResult) GetEventTypes() []string {
+	if m != nil {
+		return m.EventTypes
+	}
+	return nil
+}
+
+// ExecutionError contains the deterministic details of a failed packet handling.
+type ExecutionError struct {
+	// msg_index defines the index of the message of the interchain account transaction whose execution failed.
+	// It is -1 if the failure did not occur during the execution of a message.
+	MsgIndex int64 `protobuf:"varint,1,opt,name=msg_index,json=msgIndex,proto3" json:"msg_index,omitempty"`
+	// codespace defines the codespace of the error.
+	Codespace string `protobuf:"bytes,2,opt,name=codespace,proto3" json:"codespace,omitempty"`
+	// code defines the ABCI code of the error.
+	Code uint32 `protobuf:"varint,3,opt,name=code,proto3" json:"code,omitempty"`
+}
+
+func (m *ExecutionError) Reset()         { *m = ExecutionError{} }
+func (m *ExecutionError) String() string { return proto.CompactTextString(m) }
+func (*ExecutionError) ProtoMessage()    {}
+func (*ExecutionError) Descriptor() ([]byte, []int) {
+	return fileDescriptor_89a080d7401cd393, []int{8}
+}
+func (m *ExecutionError) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExecutionError) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExecutionError.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExecutionError) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExecutionError.Merge(m, src)
+}
+func (m *ExecutionError) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExecutionError) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExecutionError.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExecutionError proto.InternalMessageInfo
+
+func (m *ExecutionError) GetMsgIndex() int64 {
+	if m != nil {
+		return m.MsgIndex
+	}
+	return 0
+}
+
+func (m *ExecutionError) GetCodespace() string {
+	if m != nil {
+		return m.Codespace
+	}
+	return ""
+}
+
+func (m *ExecutionError) GetCode() uint32 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("ibc.applications.interchain_accounts.v1.Type", Type_name, Type_value)
 	proto.RegisterType((*InterchainAccountPacketData)(nil), "ibc.applications.interchain_accounts.v1.InterchainAccountPacketData")
 	proto.RegisterType((*CosmosTx)(nil), "ibc.applications.interchain_accounts.v1.CosmosTx")
+	proto.RegisterType((*CosmosQuery)(nil), "ibc.applications.interchain_accounts.v1.CosmosQuery")
+	proto.RegisterType((*CosmosQueryRequest)(nil), "ibc.applications.interchain_accounts.v1.CosmosQueryRequest")
+	proto.RegisterType((*CosmosQueryResponse)(nil), "ibc.applications.interchain_accounts.v1.CosmosQueryResponse")
+	proto.RegisterType((*InterchainAccountAcknowledgement)(nil), "ibc.applications.interchain_accounts.v1.InterchainAccountAcknowledgement")
+	proto.RegisterType((*ExecutionResult)(nil), "ibc.applications.interchain_accounts.v1.ExecutionResult")
+	proto.RegisterType((*MsgResult)(nil), "ibc.applications.interchain_accounts.v1.MsgResult")
+	proto.RegisterType((*ExecutionError)(nil), "ibc.applications.interchain_accounts.v1.ExecutionError")
 }
 
 func init() {
@@ -170,32 +619,56 @@ func init() {
 }
 
 var fileDescriptor_89a080d7401cd393 = []byte{
-	// 393 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x51, 0xc1, 0xaa, 0xd3, 0x40,
-	0x14, 0xcd, 0xf8, 0x82, 0x3c, 0xe7, 0xc9, 0x7b, 0x25, 0xbc, 0x45, 0x8c, 0x10, 0xc2, 0x13, 0x31,
-	0x08, 0x99, 0xb1, 0x55, 0x10, 0xc1, 0x4d, 0x6d, 0x23, 0x74, 0x23, 0x25, 0xa6, 0x50, 0xdd, 0x84,
-	0xc9, 0x74, 0x4c, 0x07, 0x9b, 0x4c, 0xe8, 0x4c, 0x8a, 0xf9, 0x83, 0xd2, 0x95, 0x3f, 0xd0, 0x95,
-	0x3f, 0xe3, 0xb2, 0x4b, 0x97, 0xd2, 0xfe, 0x88, 0x64, 0x82, 0x6d, 0x17, 0x2e, 0xdc, 0x1d, 0x0e,
-	0xf7, 0x9c, 0x7b, 0xcf, 0x3d, 0xf0, 0x15, 0x4f, 0x29, 0x26, 0x65, 0xb9, 0xe0, 0x94, 0x28, 0x2e,
-	0x0a, 0x89, 0x79, 0xa1, 0xd8, 0x92, 0xce, 0x09, 0x2f, 0x12, 0x42, 0xa9, 0xa8, 0x0a, 0x25, 0xf1,
-	0xaa, 0x8b, 0x4b, 0x42, 0xbf, 0x32, 0x85, 0xca, 0xa5, 0x50, 0xc2, 0x7a, 0xc6, 0x53, 0x8a, 0xce,
-	0x55, 0xe8, 0x1f, 0x2a, 0xb4, 0xea, 0x3a, 0x8f, 0x32, 0x21, 0xb2, 0x05, 0xc3, 0x5a, 0x96, 0x56,
-	0x5f, 0x30, 0x29, 0xea, 0xd6, 0xc3, 0xb9, 0xcd, 0x44, 0x26, 0x34, 0xc4, 0x0d, 0x6a, 0xd9, 0xbb,
-	0x35, 0x80, 0x8f, 0x47, 0x47, 0xaf, 0x7e, 0x6b, 0x35, 0xd6, 0xbb, 0x87, 0x44, 0x11, 0xab, 0x0f,
-	0x4d, 0x55, 0x97, 0xcc, 0x06, 0x1e, 0xf0, 0xaf, 0x7b, 0x01, 0xfa, 0xcf, 0x43, 0x50, 0x5c, 0x97,
-	0x2c, 0xd2, 0x52, 0xcb, 0x82, 0xe6, 0x8c, 0x28, 0x62, 0xdf, 0xf3, 0x80, 0xff, 0x30, 0xd2, 0xb8,
-	0xe1, 0x72, 0x96, 0x0b, 0xfb, 0xc2, 0x03, 0xfe, 0x83, 0x48, 0xe3, 0xbb, 0xb7, 0xf0, 0x72, 0x20,
-	0x64, 0x2e, 0x64, 0xfc, 0xcd, 0x7a, 0x01, 0x2f, 0x73, 0x26, 0x25, 0xc9, 0x98, 0xb4, 0x81, 0x77,
-	0xe1, 0x5f, 0xf5, 0x6e, 0x51, 0x1b, 0x0d, 0xfd, 0x8d, 0x86, 0xfa, 0x45, 0x1d, 0x1d, 0xa7, 0x9e,
-	0x4f, 0xa1, 0xd9, 0xec, 0xb4, 0x9e, 0xc2, 0x4e, 0xfc, 0x69, 0x1c, 0x26, 0x93, 0x0f, 0x1f, 0xc7,
-	0xe1, 0x60, 0xf4, 0x7e, 0x14, 0x0e, 0x3b, 0x86, 0x73, 0xb3, 0xd9, 0x7a, 0x57, 0x67, 0x94, 0xf5,
-	0x04, 0xde, 0xe8, 0xb1, 0x70, 0x1a, 0x0e, 0x26, 0x71, 0x98, 0xc4, 0xd3, 0x0e, 0x70, 0xae, 0x37,
-	0x5b, 0x0f, 0x9e, 0x18, 0xc7, 0x5c, 0xff, 0x70, 0x8d, 0x77, 0xc9, 0xcf, 0xbd, 0x0b, 0x76, 0x7b,
-	0x17, 0xfc, 0xde, 0xbb, 0xe0, 0xfb, 0xc1, 0x35, 0x76, 0x07, 0xd7, 0xf8, 0x75, 0x70, 0x8d, 0xcf,
-	0x61, 0xc6, 0xd5, 0xbc, 0x4a, 0x11, 0x15, 0x39, 0xa6, 0xfa, 0x74, 0xcc, 0x53, 0x1a, 0x64, 0x02,
-	0xaf, 0xde, 0xe0, 0x5c, 0xcc, 0xaa, 0x05, 0x93, 0x4d, 0xd9, 0x12, 0xf7, 0x5e, 0x07, 0xa7, 0x47,
-	0x05, 0xc7, 0x9e, 0x9b, 0xff, 0xc8, 0xf4, 0xbe, 0x8e, 0xf4, 0xf2, 0x4f, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0x67, 0x47, 0x70, 0x6e, 0x1c, 0x02, 0x00, 0x00,
+	// 779 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0x4d, 0x6f, 0xe4, 0x44,
+	0x10, 0xb5, 0x33, 0x4e, 0x34, 0xae, 0x09, 0x49, 0xd4, 0xac, 0x90, 0x33, 0x8b, 0x1c, 0xcb, 0x08,
+	0x31, 0x42, 0x1a, 0x7b, 0x33, 0x20, 0x45, 0x88, 0xbd, 0x24, 0x59, 0xa3, 0x8d, 0xf8, 0xca, 0x36,
+	0x13, 0x69, 0x83, 0xb4, 0xb2, 0xda, 0x9e, 0x5e, 0x8f, 0x15, 0xdb, 0x6d, 0xdc, 0xed, 0x21, 0x73,
+	0xe7, 0x80, 0x72, 0xe2, 0xca, 0x21, 0x27, 0x6e, 0xfc, 0x92, 0x3d, 0xee, 0x91, 0x13, 0xa0, 0xe4,
+	0x8f, 0xa0, 0x6e, 0x7b, 0x26, 0x59, 0x25, 0x48, 0x39, 0xb9, 0xaa, 0xba, 0xea, 0xbd, 0xaa, 0x57,
+	0xdd, 0x86, 0xcf, 0xd3, 0x28, 0xf6, 0x49, 0x59, 0x66, 0x69, 0x4c, 0x44, 0xca, 0x0a, 0xee, 0xa7,
+	0x85, 0xa0, 0x55, 0x3c, 0x25, 0x69, 0x11, 0x92, 0x38, 0x66, 0x75, 0x21, 0xb8, 0x3f, 0xdb, 0xf5,
+	0x4b, 0x12, 0x9f, 0x51, 0xe1, 0x95, 0x15, 0x13, 0x0c, 0x7d, 0x92, 0x46, 0xb1, 0x77, 0xbb, 0xca,
+	0xbb, 0xa7, 0xca, 0x9b, 0xed, 0xf6, 0xb7, 0x13, 0xc6, 0x92, 0x8c, 0xfa, 0xaa, 0x2c, 0xaa, 0x5f,
+	0xfb, 0xa4, 0x98, 0x37, 0x18, 0xfd, 0x47, 0x09, 0x4b, 0x98, 0x32, 0x7d, 0x69, 0xb5, 0x51, 0x3b,
+	0x66, 0x3c, 0x67, 0xdc, 0x8f, 0x08, 0xa7, 0xfe, 0x6c, 0x37, 0xa2, 0x82, 0xec, 0xfa, 0x31, 0x4b,
+	0x8b, 0xe6, 0xdc, 0xfd, 0x65, 0x05, 0x1e, 0x1f, 0x2d, 0xb9, 0xf6, 0x1b, 0xaa, 0x63, 0xd5, 0xdb,
+	0x33, 0x22, 0x08, 0xda, 0x07, 0x43, 0xcc, 0x4b, 0x6a, 0xe9, 0x8e, 0x3e, 0xd8, 0x18, 0x0d, 0xbd,
+	0x07, 0x36, 0xea, 0x8d, 0xe7, 0x25, 0xc5, 0xaa, 0x14, 0x21, 0x30, 0x26, 0x44, 0x10, 0x6b, 0xc5,
+	0xd1, 0x07, 0xeb, 0x58, 0xd9, 0x32, 0x96, 0xd3, 0x9c, 0x59, 0x1d, 0x47, 0x1f, 0x98, 0x58, 0xd9,
+	0xe8, 0x31, 0x98, 0x09, 0xe1, 0x61, 0x96, 0xe6, 0xa9, 0xb0, 0x0c, 0x47, 0x1f, 0x18, 0xb8, 0x9b,
+	0x10, 0xfe, 0x8d, 0xf4, 0xd1, 0x2b, 0xe8, 0xbc, 0xa6, 0xd4, 0x5a, 0x75, 0x3a, 0x83, 0xde, 0x68,
+	0xdb, 0x6b, 0xa6, 0xf2, 0xe4, 0x54, 0x5e, 0x3b, 0x95, 0x77, 0xc8, 0xd2, 0xe2, 0xe0, 0xc9, 0x9b,
+	0xbf, 0x77, 0xb4, 0x3f, 0xff, 0xd9, 0x19, 0x24, 0xa9, 0x98, 0xd6, 0x91, 0x17, 0xb3, 0xdc, 0x6f,
+	0x25, 0x68, 0x3e, 0x43, 0x3e, 0x39, 0xf3, 0x65, 0x5f, 0x5c, 0x15, 0x70, 0x2c, 0x71, 0xdd, 0xa7,
+	0xd0, 0x3d, 0x54, 0xc7, 0xe3, 0x73, 0xf4, 0x04, 0xba, 0x39, 0xe5, 0x9c, 0x24, 0x94, 0x5b, 0xba,
+	0xe2, 0x7b, 0xe4, 0x35, 0xb2, 0x7b, 0x0b, 0xd9, 0xbd, 0xfd, 0x62, 0x8e, 0x97, 0x59, 0x6e, 0x06,
+	0xbd, 0xa6, 0xfa, 0x45, 0x4d, 0xab, 0x39, 0x7a, 0x05, 0xdd, 0x8a, 0xfe, 0x54, 0x53, 0x2e, 0x16,
+	0x00, 0x5f, 0x3e, 0x58, 0xb7, 0x5b, 0x38, 0xb8, 0xc1, 0x38, 0x30, 0xe4, 0x48, 0x78, 0x09, 0xe9,
+	0x3e, 0x05, 0x74, 0x37, 0x4b, 0x2a, 0x5a, 0x12, 0x31, 0x55, 0x8b, 0x32, 0xb1, 0xb2, 0xef, 0x53,
+	0xde, 0xfd, 0x1a, 0xde, 0x7f, 0xa7, 0x9a, 0x97, 0xac, 0xe0, 0x14, 0x7d, 0x08, 0x66, 0xd5, 0xda,
+	0x4d, 0xd3, 0xeb, 0xf8, 0x26, 0x80, 0x3e, 0x80, 0xb5, 0x29, 0x4d, 0x93, 0xa9, 0x50, 0x50, 0x06,
+	0x6e, 0x3d, 0xf7, 0x77, 0x1d, 0x9c, 0x3b, 0xb7, 0x67, 0x3f, 0x3e, 0x2b, 0xd8, 0xcf, 0x19, 0x9d,
+	0x24, 0x34, 0xa7, 0x85, 0x40, 0x16, 0xac, 0x55, 0x94, 0xd7, 0x99, 0x50, 0xbd, 0xad, 0x3f, 0xd7,
+	0x70, 0xeb, 0xa3, 0xef, 0x61, 0x95, 0x56, 0x15, 0xab, 0x14, 0x6a, 0x6f, 0xb4, 0xf7, 0x60, 0x95,
+	0x82, 0x73, 0x1a, 0xd7, 0x32, 0x23, 0x90, 0xe5, 0xcf, 0x35, 0xdc, 0xe0, 0x1c, 0x00, 0x74, 0x17,
+	0x4d, 0xbb, 0x19, 0x6c, 0x2e, 0xd3, 0x70, 0xc3, 0x77, 0x0a, 0xbd, 0x9c, 0x27, 0x61, 0xc3, 0xbe,
+	0xd8, 0xcd, 0xe8, 0xc1, 0xac, 0xdf, 0xf2, 0xa4, 0x01, 0x6a, 0x57, 0x02, 0xf9, 0x22, 0xc0, 0x5d,
+	0x0a, 0xe6, 0xf2, 0x18, 0xed, 0xc1, 0x7a, 0xcb, 0xa3, 0x5a, 0x51, 0x73, 0xff, 0xdf, 0x2d, 0xea,
+	0x35, 0x20, 0xcd, 0x16, 0x76, 0xa0, 0x47, 0x67, 0xb4, 0x10, 0xa1, 0xba, 0xa0, 0xd6, 0x8a, 0xd3,
+	0x19, 0x98, 0x18, 0x54, 0x48, 0x3e, 0x28, 0xee, 0x86, 0xb0, 0xf1, 0xee, 0xec, 0xf2, 0xd5, 0x48,
+	0xae, 0xb4, 0x98, 0xd0, 0x73, 0x45, 0xd4, 0xc1, 0xdd, 0x9c, 0x27, 0x47, 0xd2, 0x97, 0x5b, 0x8d,
+	0xd9, 0x84, 0xf2, 0x92, 0xc4, 0x54, 0x89, 0x6c, 0xe2, 0x9b, 0x80, 0xbc, 0x1e, 0xd2, 0x51, 0x8f,
+	0xf0, 0x3d, 0xac, 0xec, 0x4f, 0x39, 0x18, 0x92, 0x09, 0x7d, 0x0c, 0x5b, 0xe3, 0xd3, 0xe3, 0x20,
+	0x3c, 0xf9, 0xee, 0x87, 0xe3, 0xe0, 0xf0, 0xe8, 0xab, 0xa3, 0xe0, 0xd9, 0x96, 0xd6, 0xdf, 0xbc,
+	0xb8, 0x74, 0x7a, 0xb7, 0x42, 0xe8, 0x23, 0xd8, 0x54, 0x69, 0xc1, 0xcb, 0xe0, 0xf0, 0x64, 0x1c,
+	0x84, 0xe3, 0x97, 0x5b, 0x7a, 0x7f, 0xe3, 0xe2, 0xd2, 0x81, 0x9b, 0x08, 0xda, 0x06, 0x50, 0x49,
+	0x2f, 0x4e, 0x02, 0x7c, 0xba, 0xb5, 0xd2, 0x37, 0x2f, 0x2e, 0x9d, 0x55, 0xe5, 0xf4, 0x8d, 0x5f,
+	0xff, 0xb0, 0xb5, 0x83, 0xf0, 0xcd, 0x95, 0xad, 0xbf, 0xbd, 0xb2, 0xf5, 0x7f, 0xaf, 0x6c, 0xfd,
+	0xb7, 0x6b, 0x5b, 0x7b, 0x7b, 0x6d, 0x6b, 0x7f, 0x5d, 0xdb, 0xda, 0x8f, 0xc1, 0xdd, 0x67, 0x9c,
+	0x46, 0xf1, 0x30, 0x61, 0xfe, 0xec, 0x0b, 0x3f, 0x67, 0x93, 0x3a, 0xa3, 0x5c, 0xfe, 0x6e, 0xb9,
+	0x3f, 0xda, 0x1b, 0xde, 0xac, 0x6d, 0xb8, 0xfc, 0xd3, 0x2a, 0x21, 0xa3, 0x35, 0x25, 0xf9, 0x67,
+	0xff, 0x0d, 0x00, 0x14, 0x18, 0x16, 0xc0, 0x9e, 0x05, 0x00, 0x00,
 }
 
 func (m *InterchainAccountPacketData) Marshal() (dAtA []byte, err error) {
@@ -218,6 +691,25 @@ func (m *InterchainAccountPacketData) MarshalToSizedBuffer(dAtA []byte) (int, er
 	_ = i
 	var l int
 	_ = l
+	if len(m.Fee) > 0 {
+		for iNdEx := len(m.Fee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPacket(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.GasLimit != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.Memo) > 0 {
 		i -= len(m.Memo)
 		copy(dAtA[i:], m.Memo)
@@ -267,69 +759,1209 @@ func (m *CosmosTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				if err != nil {
 					return 0, err
 				}
-				i -= size
-				i = encodeVarintPacket(dAtA, i, uint64(size))
+				i -= size
+				i = encodeVarintPacket(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CosmosQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CosmosQuery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CosmosQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Requests) > 0 {
+		for iNdEx := len(m.Requests) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Requests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPacket(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CosmosQueryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CosmosQueryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CosmosQueryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Path) > 0 {
+		i -= len(m.Path)
+		copy(dAtA[i:], m.Path)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Path)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CosmosQueryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CosmosQueryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CosmosQueryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Responses) > 0 {
+		for iNdEx := len(m.Responses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Responses[iNdEx])
+			copy(dAtA[i:], m.Responses[iNdEx])
+			i = encodeVarintPacket(dAtA, i, uint64(len(m.Responses[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InterchainAccountAcknowledgement) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InterchainAccountAcknowledgement) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InterchainAccountAcknowledgement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Response != nil {
+		{
+			size := m.Response.Size()
+			i -= size
+			if _, err := m.Response.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InterchainAccountAcknowledgement_Result) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InterchainAccountAcknowledgement_Result) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Result != nil {
+		i -= len(m.Result)
+		copy(dAtA[i:], m.Result)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Result)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+func (m *InterchainAccountAcknowledgement_Error) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InterchainAccountAcknowledgement_Error) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Error != nil {
+		{
+			size, err := m.Error.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPacket(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+func (m *ExecutionResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExecutionResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExecutionResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MsgResults) > 0 {
+		for iNdEx := len(m.MsgResults) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MsgResults[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPacket(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EventTypes) > 0 {
+		for iNdEx := len(m.EventTypes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.EventTypes[iNdEx])
+			copy(dAtA[i:], m.EventTypes[iNdEx])
+			i = encodeVarintPacket(dAtA, i, uint64(len(m.EventTypes[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.MsgResponse != nil {
+		{
+			size, err := m.MsgResponse.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPacket(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExecutionError) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExecutionError) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExecutionError) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Code != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.Code))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Codespace) > 0 {
+		i -= len(m.Codespace)
+		copy(dAtA[i:], m.Codespace)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Codespace)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.MsgIndex != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.MsgIndex))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPacket(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPacket(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *InterchainAccountPacketData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovPacket(uint64(m.Type))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	l = len(m.Memo)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovPacket(uint64(m.GasLimit))
+	}
+	if len(m.Fee) > 0 {
+		for _, e := range m.Fee {
+			l = e.Size()
+			n += 1 + l + sovPacket(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CosmosTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Messages) > 0 {
+		for _, e := range m.Messages {
+			l = e.Size()
+			n += 1 + l + sovPacket(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CosmosQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Requests) > 0 {
+		for _, e := range m.Requests {
+			l = e.Size()
+			n += 1 + l + sovPacket(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CosmosQueryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+
+func (m *CosmosQueryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Responses) > 0 {
+		for _, b := range m.Responses {
+			l = len(b)
+			n += 1 + l + sovPacket(uint64(l))
+		}
+	}
+	if m.Height != 0 {
+		n += 1 + sovPacket(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *InterchainAccountAcknowledgement) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Response != nil {
+		n += m.Response.Size()
+	}
+	return n
+}
+
+func (m *InterchainAccountAcknowledgement_Result) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Result != nil {
+		l = len(m.Result)
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+func (m *InterchainAccountAcknowledgement_Error) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+func (m *ExecutionResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MsgResults) > 0 {
+		for _, e := range m.MsgResults {
+			l = e.Size()
+			n += 1 + l + sovPacket(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MsgResponse != nil {
+		l = m.MsgResponse.Size()
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	if len(m.EventTypes) > 0 {
+		for _, s := range m.EventTypes {
+			l = len(s)
+			n += 1 + l + sovPacket(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ExecutionError) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MsgIndex != 0 {
+		n += 1 + sovPacket(uint64(m.MsgIndex))
+	}
+	l = len(m.Codespace)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	if m.Code != 0 {
+		n += 1 + sovPacket(uint64(m.Code))
+	}
+	return n
+}
+
+func sovPacket(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPacket(x uint64) (n int) {
+	return sovPacket(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *InterchainAccountPacketData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InterchainAccountPacketData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InterchainAccountPacketData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= Type(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Memo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fee = append(m.Fee, types.Coin{})
+			if err := m.Fee[len(m.Fee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CosmosTx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CosmosTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CosmosTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Messages = append(m.Messages, &types1.Any{})
+			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CosmosQuery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CosmosQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CosmosQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Requests", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Requests = append(m.Requests, CosmosQueryRequest{})
+			if err := m.Requests[len(m.Requests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CosmosQueryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CosmosQueryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CosmosQueryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CosmosQueryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CosmosQueryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CosmosQueryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Responses", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Responses = append(m.Responses, make([]byte, postIndex-iNdEx))
+			copy(m.Responses[len(m.Responses)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InterchainAccountAcknowledgement) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InterchainAccountAcknowledgement: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InterchainAccountAcknowledgement: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			i--
-			dAtA[i] = 0xa
+			if byteLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := make([]byte, postIndex-iNdEx)
+			copy(v, dAtA[iNdEx:postIndex])
+			m.Response = &InterchainAccountAcknowledgement_Result{v}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ExecutionError{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Response = &InterchainAccountAcknowledgement_Error{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintPacket(dAtA []byte, offset int, v uint64) int {
-	offset -= sovPacket(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *InterchainAccountPacketData) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Type != 0 {
-		n += 1 + sovPacket(uint64(m.Type))
-	}
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovPacket(uint64(l))
-	}
-	l = len(m.Memo)
-	if l > 0 {
-		n += 1 + l + sovPacket(uint64(l))
-	}
-	return n
-}
 
-func (m *CosmosTx) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Messages) > 0 {
-		for _, e := range m.Messages {
-			l = e.Size()
-			n += 1 + l + sovPacket(uint64(l))
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovPacket(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozPacket(x uint64) (n int) {
-	return sovPacket(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *InterchainAccountPacketData) Unmarshal(dAtA []byte) error {
+func (m *ExecutionResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -352,17 +1984,17 @@ func (m *InterchainAccountPacketData) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InterchainAccountPacketData: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExecutionResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InterchainAccountPacketData: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExecutionResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgResults", wireType)
 			}
-			m.Type = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPacket
@@ -372,16 +2004,81 @@ func (m *InterchainAccountPacketData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Type |= Type(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgResults = append(m.MsgResults, MsgResult{})
+			if err := m.MsgResults[len(m.MsgResults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgResponse", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPacket
@@ -391,29 +2088,31 @@ func (m *InterchainAccountPacketData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPacket
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPacket
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
+			if m.MsgResponse == nil {
+				m.MsgResponse = &types1.Any{}
+			}
+			if err := m.MsgResponse.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EventTypes", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -441,7 +2140,7 @@ func (m *InterchainAccountPacketData) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Memo = string(dAtA[iNdEx:postIndex])
+			m.EventTypes = append(m.EventTypes, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -464,7 +2163,7 @@ func (m *InterchainAccountPacketData) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CosmosTx) Unmarshal(dAtA []byte) error {
+func (m *ExecutionError) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -487,17 +2186,36 @@ func (m *CosmosTx) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CosmosTx: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExecutionError: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CosmosTx: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExecutionError: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgIndex", wireType)
+			}
+			m.MsgIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MsgIndex |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Codespace", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPacket
@@ -507,26 +2225,43 @@ func (m *CosmosTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPacket
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPacket
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Messages = append(m.Messages, &types.Any{})
-			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Codespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPacket(dAtA[iNdEx:])
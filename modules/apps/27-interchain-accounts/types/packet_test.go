@@ -3,6 +3,10 @@ package types_test
 import (
 	"fmt"
 
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/cosmos/ibc-go/v9/modules/apps/27-interchain-accounts/types"
 	ibctesting "github.com/cosmos/ibc-go/v9/testing"
 )
@@ -30,6 +34,42 @@ func (suite *TypesTestSuite) TestValidateBasic() {
 			},
 			true,
 		},
+		{
+			"success, gas limit and fee",
+			types.InterchainAccountPacketData{
+				Type:     types.EXECUTE_TX,
+				Data:     []byte("data"),
+				GasLimit: 100000,
+				Fee:      sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+			},
+			true,
+		},
+		{
+			"success, query",
+			types.InterchainAccountPacketData{
+				Type: types.QUERY,
+				Data: []byte("data"),
+			},
+			true,
+		},
+		{
+			"query with gas limit",
+			types.InterchainAccountPacketData{
+				Type:     types.QUERY,
+				Data:     []byte("data"),
+				GasLimit: 100000,
+			},
+			false,
+		},
+		{
+			"query with fee",
+			types.InterchainAccountPacketData{
+				Type: types.QUERY,
+				Data: []byte("data"),
+				Fee:  sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+			},
+			false,
+		},
 		{
 			"type unspecified",
 			types.InterchainAccountPacketData{
@@ -66,6 +106,15 @@ func (suite *TypesTestSuite) TestValidateBasic() {
 			},
 			false,
 		},
+		{
+			"invalid fee",
+			types.InterchainAccountPacketData{
+				Type: types.EXECUTE_TX,
+				Data: []byte("data"),
+				Fee:  sdk.Coins{sdk.Coin{Denom: "stake", Amount: sdkmath.ZeroInt()}},
+			},
+			false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -84,6 +133,29 @@ func (suite *TypesTestSuite) TestValidateBasic() {
 	}
 }
 
+func (suite *TypesTestSuite) TestGetBytes() {
+	packetData := types.InterchainAccountPacketData{
+		Type: types.EXECUTE_TX,
+		Data: []byte("data"),
+		Memo: "memo",
+	}
+
+	// unset gas limit and fee are omitted
+	suite.Require().Equal(`{"type":"TYPE_EXECUTE_TX","data":"ZGF0YQ==","memo":"memo"}`, string(packetData.GetBytes()))
+
+	var decoded types.InterchainAccountPacketData
+	suite.Require().NoError(decoded.UnmarshalJSON(packetData.GetBytes()))
+	suite.Require().Equal(packetData, decoded)
+
+	packetData.GasLimit = 100000
+	packetData.Fee = sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	suite.Require().Equal(`{"type":"TYPE_EXECUTE_TX","data":"ZGF0YQ==","memo":"memo","gas_limit":"100000","fee":[{"denom":"stake","amount":"100"}]}`, string(packetData.GetBytes()))
+
+	decoded = types.InterchainAccountPacketData{}
+	suite.Require().NoError(decoded.UnmarshalJSON(packetData.GetBytes()))
+	suite.Require().Equal(packetData, decoded)
+}
+
 func (suite *TypesTestSuite) TestGetPacketSender() {
 	testCases := []struct {
 		name      string
@@ -95,6 +167,11 @@ func (suite *TypesTestSuite) TestGetPacketSender() {
 			types.ControllerPortPrefix + ibctesting.TestAccAddress,
 			ibctesting.TestAccAddress,
 		},
+		{
+			"success: port id has prefix and account index",
+			types.ControllerPortPrefix + ibctesting.TestAccAddress + ".1",
+			ibctesting.TestAccAddress,
+		},
 		{
 			"failure: missing prefix",
 			ibctesting.TestAccAddress,
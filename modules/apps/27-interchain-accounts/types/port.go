@@ -1,17 +1,72 @@
 package types
 
 import (
+	"strconv"
 	"strings"
 
 	errorsmod "cosmossdk.io/errors"
 )
 
+// controllerPortAccountIndexSeparator separates the owner from the account index in a controller port identifier
+const controllerPortAccountIndexSeparator = "."
+
 // NewControllerPortID creates and returns a new prefixed controller port identifier using the provided owner string
 func NewControllerPortID(owner string) (string, error) {
+	return NewControllerPortIDWithIndex(owner, 0)
+}
+
+// NewControllerPortIDWithIndex creates and returns a new prefixed controller port identifier using the provided owner string
+// and account index. The account index allows a single owner to register multiple interchain accounts on the same connection.
+// The port identifier of account index 0 is equal to the port identifier returned by NewControllerPortID, any other index is
+// appended to the owner in the format {owner}.{index}. Owners which already end in an account index suffix are rejected,
+// as their port identifiers would collide with the port identifiers of other owners and account indices.
+func NewControllerPortIDWithIndex(owner string, accountIndex uint64) (string, error) {
 	if strings.TrimSpace(owner) == "" {
 		return "", errorsmod.Wrap(ErrInvalidAccountAddress, "owner address cannot be empty")
 	}
 
+	if _, index := cutAccountIndex(owner); index != 0 {
+		return "", errorsmod.Wrapf(ErrInvalidAccountAddress, "owner address %s must not end with an account index suffix", owner)
+	}
+
 	ownerWithPrefix := ControllerPortPrefix + owner
-	return ownerWithPrefix, nil
+	if accountIndex == 0 {
+		return ownerWithPrefix, nil
+	}
+
+	return ownerWithPrefix + controllerPortAccountIndexSeparator + strconv.FormatUint(accountIndex, 10), nil
+}
+
+// ParseControllerPortID parses the owner and the account index from the provided controller port identifier.
+// An account index of 0 is returned for port identifiers which do not contain an account index.
+func ParseControllerPortID(portID string) (string, uint64, error) {
+	owner, found := strings.CutPrefix(portID, ControllerPortPrefix)
+	if !found {
+		return "", 0, errorsmod.Wrapf(ErrInvalidControllerPort, "expected %s{owner-account-address}, got %s", ControllerPortPrefix, portID)
+	}
+
+	owner, accountIndex := cutAccountIndex(owner)
+	if strings.TrimSpace(owner) == "" {
+		return "", 0, errorsmod.Wrap(ErrInvalidAccountAddress, "owner address cannot be empty")
+	}
+
+	return owner, accountIndex, nil
+}
+
+// cutAccountIndex splits the account index suffix from the provided owner, returning the owner unchanged and an account
+// index of 0 if it does not end in an account index suffix.
+func cutAccountIndex(owner string) (string, uint64) {
+	idx := strings.LastIndex(owner, controllerPortAccountIndexSeparator)
+	if idx == -1 {
+		return owner, 0
+	}
+
+	indexStr := owner[idx+len(controllerPortAccountIndexSeparator):]
+	// only canonically formatted non-zero indices are produced by NewControllerPortIDWithIndex
+	index, err := strconv.ParseUint(indexStr, 10, 64)
+	if err != nil || index == 0 || strconv.FormatUint(index, 10) != indexStr {
+		return owner, 0
+	}
+
+	return owner[:idx], index
 }
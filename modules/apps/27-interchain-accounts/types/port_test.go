@@ -55,3 +55,136 @@ func (suite *TypesTestSuite) TestNewControllerPortID() {
 		})
 	}
 }
+
+func (suite *TypesTestSuite) TestNewControllerPortIDWithIndex() {
+	testCases := []struct {
+		name         string
+		owner        string
+		accountIndex uint64
+		expValue     string
+		expPass      bool
+	}{
+		{
+			"success: account index 0",
+			TestOwnerAddress,
+			0,
+			types.ControllerPortPrefix + TestOwnerAddress,
+			true,
+		},
+		{
+			"success: non-zero account index",
+			TestOwnerAddress,
+			7,
+			types.ControllerPortPrefix + TestOwnerAddress + ".7",
+			true,
+		},
+		{
+			"success: owner ending in a non canonical account index suffix",
+			TestOwnerAddress + ".07",
+			1,
+			types.ControllerPortPrefix + TestOwnerAddress + ".07.1",
+			true,
+		},
+		{
+			"invalid owner address",
+			"    ",
+			1,
+			"",
+			false,
+		},
+		{
+			"failure: owner ends in an account index suffix",
+			TestOwnerAddress + ".1",
+			0,
+			"",
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			portID, err := types.NewControllerPortIDWithIndex(tc.owner, tc.accountIndex)
+
+			if tc.expPass {
+				suite.Require().NoError(err, tc.name)
+				suite.Require().Equal(tc.expValue, portID)
+			} else {
+				suite.Require().Error(err, tc.name)
+				suite.Require().Empty(portID)
+			}
+		})
+	}
+}
+
+func (suite *TypesTestSuite) TestParseControllerPortID() {
+	testCases := []struct {
+		name            string
+		portID          string
+		expOwner        string
+		expAccountIndex uint64
+		expErr          error
+	}{
+		{
+			"success: no account index",
+			types.ControllerPortPrefix + TestOwnerAddress,
+			TestOwnerAddress,
+			0,
+			nil,
+		},
+		{
+			"success: account index",
+			types.ControllerPortPrefix + TestOwnerAddress + ".7",
+			TestOwnerAddress,
+			7,
+			nil,
+		},
+		{
+			"success: non canonical account index is part of the owner",
+			types.ControllerPortPrefix + TestOwnerAddress + ".07",
+			TestOwnerAddress + ".07",
+			0,
+			nil,
+		},
+		{
+			"success: zero account index is part of the owner",
+			types.ControllerPortPrefix + TestOwnerAddress + ".0",
+			TestOwnerAddress + ".0",
+			0,
+			nil,
+		},
+		{
+			"failure: missing prefix",
+			TestOwnerAddress,
+			"",
+			0,
+			types.ErrInvalidControllerPort,
+		},
+		{
+			"failure: empty owner",
+			types.ControllerPortPrefix + ".1",
+			"",
+			0,
+			types.ErrInvalidAccountAddress,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			owner, accountIndex, err := types.ParseControllerPortID(tc.portID)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expOwner, owner)
+				suite.Require().Equal(tc.expAccountIndex, accountIndex)
+
+				portID, err := types.NewControllerPortIDWithIndex(owner, accountIndex)
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.portID, portID)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
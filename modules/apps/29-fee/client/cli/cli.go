@@ -26,6 +26,10 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdCounterpartyPayee(),
 		GetCmdFeeEnabledChannel(),
 		GetCmdFeeEnabledChannels(),
+		GetCmdTopIncentivizedPackets(),
+		GetCmdRelayerStats(),
+		GetCmdFeeBudget(),
+		GetCmdCancellationDelay(),
 	)
 
 	return queryCmd
@@ -45,6 +49,7 @@ func NewTxCmd() *cobra.Command {
 		NewRegisterPayeeCmd(),
 		NewRegisterCounterpartyPayeeCmd(),
 		NewPayPacketFeeAsyncTxCmd(),
+		NewCancelPacketFeeTxCmd(),
 	)
 
 	return txCmd
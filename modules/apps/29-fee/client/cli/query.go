@@ -15,6 +15,8 @@ import (
 	channeltypes "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
 )
 
+const flagLimit = "limit"
+
 // GetCmdIncentivizedPacket returns the unrelayed incentivized packet for a given packetID
 func GetCmdIncentivizedPacket() *cobra.Command {
 	cmd := &cobra.Command{
@@ -437,3 +439,144 @@ func GetCmdIncentivizedPacketsForChannel() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdTopIncentivizedPackets returns the command handler for the Query/TopIncentivizedPackets rpc.
+func GetCmdTopIncentivizedPackets() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "top-packets [port-id] [channel-id] [denom]",
+		Short:   "Query for the unrelayed incentivized packets on a given channel ranked by the total escrowed fee in a denomination",
+		Long:    "Query for the unrelayed incentivized packets on a given channel ranked by the total escrowed fee in a denomination. Packets without fees in the denomination are excluded.",
+		Args:    cobra.ExactArgs(3),
+		Example: fmt.Sprintf("%s query ibc-fee top-packets transfer channel-5 stake --limit 5", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			limit, err := cmd.Flags().GetUint64(flagLimit)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryTopIncentivizedPacketsRequest{
+				PortId:    args[0],
+				ChannelId: args[1],
+				Denom:     args[2],
+				Limit:     limit,
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.TopIncentivizedPackets(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Uint64(flagLimit, types.DefaultTopIncentivizedPacketsLimit, "Maximum number of packets returned.")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdRelayerStats returns the command handler for the Query/RelayerStats rpc.
+func GetCmdRelayerStats() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "relayer-stats [relayer]",
+		Short:   "Query the fee payout statistics and recent payouts of a relayer address",
+		Long:    "Query the fee payout statistics and recent payouts of a relayer address",
+		Args:    cobra.ExactArgs(1),
+		Example: fmt.Sprintf("%s query ibc-fee relayer-stats cosmos1layxcsmyye0dc0har9sdfzwckaz8sjwlfsj8zs", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if _, err := sdk.AccAddressFromBech32(args[0]); err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryRelayerStatsRequest{
+				RelayerAddress: args[0],
+			}
+
+			res, err := queryClient.RelayerStats(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdFeeBudget returns the command handler for the Query/FeeBudget rpc.
+func GetCmdFeeBudget() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "fee-budget",
+		Short:   "Query the remaining fee budget and the policy used to incentivize outgoing packets from it",
+		Long:    "Query the remaining fee budget and the policy used to incentivize outgoing packets from it",
+		Args:    cobra.NoArgs,
+		Example: fmt.Sprintf("%s query ibc-fee fee-budget", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.FeeBudget(cmd.Context(), &types.QueryFeeBudgetRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdCancellationDelay returns the command handler for the Query/CancellationDelay rpc.
+func GetCmdCancellationDelay() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "cancellation-delay",
+		Short:   "Query the delay after which escrowed packet fees may be cancelled",
+		Long:    "Query the delay after which escrowed packet fees may be cancelled by their refund address",
+		Args:    cobra.NoArgs,
+		Example: fmt.Sprintf("%s query ibc-fee cancellation-delay", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.CancellationDelay(cmd.Context(), &types.QueryCancellationDelayRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -148,3 +148,34 @@ func NewPayPacketFeeAsyncTxCmd() *cobra.Command {
 
 	return cmd
 }
+
+// NewCancelPacketFeeTxCmd returns the command to create a MsgCancelPacketFee
+func NewCancelPacketFeeTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "cancel-packet-fee [src-port] [src-channel] [sequence]",
+		Short:   "Cancel the fees escrowed by the sender to incentivize an existing IBC packet",
+		Long:    strings.TrimSpace(`Cancel the fees escrowed by the sender to incentivize an existing IBC packet. The fees are refunded to the sender once the cancellation delay has passed, as long as the packet has not been acknowledged or timed out.`),
+		Example: fmt.Sprintf("%s tx ibc-fee cancel-packet-fee transfer channel-0 1", version.AppName),
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			seq, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			packetID := channeltypes.NewPacketID(args[0], args[1], seq)
+			msg := types.NewMsgCancelPacketFee(packetID, clientCtx.GetFromAddress().String())
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -27,6 +27,14 @@ func (k Keeper) escrowPacketFee(ctx context.Context, packetID channeltypes.Packe
 	}
 
 	coins := packetFee.Fee.Total()
+	if k.feeConverter != nil {
+		for _, coin := range coins {
+			if !k.feeConverter.IsAllowedDenom(ctx, coin.Denom) {
+				return errorsmod.Wrapf(types.ErrDenomNotAllowed, "fees cannot be escrowed in %s", coin.Denom)
+			}
+		}
+	}
+
 	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, refundAddr, types.ModuleName, coins); err != nil {
 		return err
 	}
@@ -41,6 +49,10 @@ func (k Keeper) escrowPacketFee(ctx context.Context, packetID channeltypes.Packe
 	packetFees := types.NewPacketFees(fees)
 	k.SetFeesInEscrow(ctx, packetID, packetFees)
 
+	// the cancellation delay of the fees escrowed by the refund address restarts with every newly escrowed fee
+	sdkCtx := sdk.UnwrapSDKContext(ctx) // TODO: https://github.com/cosmos/ibc-go/issues/5917
+	k.SetFeeEscrowTime(ctx, packetID, packetFee.RefundAddress, sdkCtx.BlockTime())
+
 	emitIncentivizedPacketEvent(ctx, packetID, packetFees)
 
 	return nil
@@ -74,7 +86,7 @@ func (k Keeper) DistributePacketFeesOnAcknowledgement(ctx context.Context, forwa
 			panic(fmt.Errorf("could not parse refundAcc %s to sdk.AccAddress", packetFee.RefundAddress))
 		}
 
-		k.distributePacketFeeOnAcknowledgement(cacheCtx, refundAddr, forwardAddr, reverseRelayer, packetFee)
+		k.distributePacketFeeOnAcknowledgement(cacheCtx, refundAddr, forwardAddr, reverseRelayer, packetFee, packetID)
 	}
 
 	// write the cache
@@ -86,18 +98,18 @@ func (k Keeper) DistributePacketFeesOnAcknowledgement(ctx context.Context, forwa
 
 // distributePacketFeeOnAcknowledgement pays the receive fee for a given packetID while refunding the timeout fee to the refund account associated with the Fee.
 // If there was no forward relayer or the associated forward relayer address is blocked, the receive fee is refunded.
-func (k Keeper) distributePacketFeeOnAcknowledgement(ctx context.Context, refundAddr, forwardRelayer, reverseRelayer sdk.AccAddress, packetFee types.PacketFee) {
+func (k Keeper) distributePacketFeeOnAcknowledgement(ctx context.Context, refundAddr, forwardRelayer, reverseRelayer sdk.AccAddress, packetFee types.PacketFee, packetID channeltypes.PacketId) {
 	// distribute fee to valid forward relayer address otherwise refund the fee
 	if !forwardRelayer.Empty() && !k.bankKeeper.BlockedAddr(forwardRelayer) {
 		// distribute fee for forward relaying
-		k.distributeFee(ctx, forwardRelayer, refundAddr, packetFee.Fee.RecvFee)
+		k.distributeRelayerFee(ctx, forwardRelayer, refundAddr, packetID, packetFee.Fee.RecvFee)
 	} else {
 		// refund onRecv fee as forward relayer is not valid address
 		k.distributeFee(ctx, refundAddr, refundAddr, packetFee.Fee.RecvFee)
 	}
 
 	// distribute fee for reverse relaying
-	k.distributeFee(ctx, reverseRelayer, refundAddr, packetFee.Fee.AckFee)
+	k.distributeRelayerFee(ctx, reverseRelayer, refundAddr, packetID, packetFee.Fee.AckFee)
 
 	// refund unused amount from the escrowed fee
 	refundCoins := packetFee.Fee.Total().Sub(packetFee.Fee.RecvFee...).Sub(packetFee.Fee.AckFee...)
@@ -129,7 +141,7 @@ func (k Keeper) DistributePacketFeesOnTimeout(ctx context.Context, timeoutRelaye
 			panic(fmt.Errorf("could not parse refundAcc %s to sdk.AccAddress", packetFee.RefundAddress))
 		}
 
-		k.distributePacketFeeOnTimeout(cacheCtx, refundAddr, timeoutRelayer, packetFee)
+		k.distributePacketFeeOnTimeout(cacheCtx, refundAddr, timeoutRelayer, packetFee, packetID)
 	}
 
 	// write the cache
@@ -140,45 +152,85 @@ func (k Keeper) DistributePacketFeesOnTimeout(ctx context.Context, timeoutRelaye
 }
 
 // distributePacketFeeOnTimeout pays the timeout fee to the timeout relayer and refunds the acknowledgement & receive fee.
-func (k Keeper) distributePacketFeeOnTimeout(ctx context.Context, refundAddr, timeoutRelayer sdk.AccAddress, packetFee types.PacketFee) {
+func (k Keeper) distributePacketFeeOnTimeout(ctx context.Context, refundAddr, timeoutRelayer sdk.AccAddress, packetFee types.PacketFee, packetID channeltypes.PacketId) {
 	// distribute fee for timeout relaying
-	k.distributeFee(ctx, timeoutRelayer, refundAddr, packetFee.Fee.TimeoutFee)
+	k.distributeRelayerFee(ctx, timeoutRelayer, refundAddr, packetID, packetFee.Fee.TimeoutFee)
 
 	// refund unused amount from the escrowed fee
 	refundCoins := packetFee.Fee.Total().Sub(packetFee.Fee.TimeoutFee...)
 	k.distributeFee(ctx, refundAddr, refundAddr, refundCoins)
 }
 
+// distributeRelayerFee will attempt to distribute the escrowed fee to the relayer address. If the fee is paid out to
+// the relayer address, the payout is recorded in the payout history of the relayer address for the given packetID.
+func (k Keeper) distributeRelayerFee(ctx context.Context, relayer, refundAccAddress sdk.AccAddress, packetID channeltypes.PacketId, fee sdk.Coins) {
+	distributedFee := k.distributeFee(ctx, relayer, refundAccAddress, fee)
+	if distributedFee != nil && !bytes.Equal(relayer, refundAccAddress) {
+		k.recordRelayerPayout(ctx, relayer, packetID, distributedFee)
+	}
+}
+
 // distributeFee will attempt to distribute the escrowed fee to the receiver address.
-// If the distribution fails for any reason (such as the receiving address being blocked),
-// the state changes will be discarded.
-func (k Keeper) distributeFee(ctx context.Context, receiver, refundAccAddress sdk.AccAddress, fee sdk.Coins) {
+// If a fee converter is set, the fee is converted before it is distributed to a receiver other than the refund address.
+// If the distribution fails for any reason (such as the receiving address being blocked or the conversion failing),
+// the state changes will be discarded. The fee distributed to the receiver address is returned, or nil if the fee
+// could not be distributed to the receiver address.
+func (k Keeper) distributeFee(ctx context.Context, receiver, refundAccAddress sdk.AccAddress, fee sdk.Coins) sdk.Coins {
 	// cache context before trying to distribute fees
 	sdkCtx := sdk.UnwrapSDKContext(ctx) // TODO: https://github.com/cosmos/ibc-go/issues/7223
 	cacheCtx, writeFn := sdkCtx.CacheContext()
 
-	err := k.bankKeeper.SendCoinsFromModuleToAccount(cacheCtx, types.ModuleName, receiver, fee)
+	distributedFee, err := k.convertFee(cacheCtx, receiver, refundAccAddress, fee)
+	if err == nil {
+		err = k.bankKeeper.SendCoinsFromModuleToAccount(cacheCtx, types.ModuleName, receiver, distributedFee)
+	}
+
 	if err != nil {
 		if bytes.Equal(receiver, refundAccAddress) {
 			k.Logger(ctx).Error("error distributing fee", "receiver address", receiver, "fee", fee)
-			return // if sending to the refund address already failed, then return (no-op)
+			return nil // if sending to the refund address already failed, then return (no-op)
 		}
 
-		// if an error is returned from x/bank and the receiver is not the refundAccAddress
+		// discard the state changes of the fee conversion, the fee is refunded in the escrowed denominations
+		cacheCtx, writeFn = sdkCtx.CacheContext()
+
+		// if an error is returned from x/bank or the fee conversion and the receiver is not the refundAccAddress
 		// then attempt to refund the fee to the original sender
 		err := k.bankKeeper.SendCoinsFromModuleToAccount(cacheCtx, types.ModuleName, refundAccAddress, fee)
 		if err != nil {
 			k.Logger(ctx).Error("error refunding fee to the original sender", "refund address", refundAccAddress, "fee", fee)
-			return // if sending to the refund address fails, no-op
+			return nil // if sending to the refund address fails, no-op
 		}
 
 		emitDistributeFeeEvent(ctx, refundAccAddress.String(), fee)
-	} else {
-		emitDistributeFeeEvent(ctx, receiver.String(), fee)
+
+		// write the cache
+		writeFn()
+
+		return nil
 	}
 
+	emitDistributeFeeEvent(ctx, receiver.String(), distributedFee)
+
 	// write the cache
 	writeFn()
+
+	return distributedFee
+}
+
+// convertFee converts the fee distributed to the receiver using the fee converter, if set.
+// Fees refunded to the refund address are not converted.
+func (k Keeper) convertFee(ctx context.Context, receiver, refundAccAddress sdk.AccAddress, fee sdk.Coins) (sdk.Coins, error) {
+	if k.feeConverter == nil || fee.IsZero() || bytes.Equal(receiver, refundAccAddress) {
+		return fee, nil
+	}
+
+	convertedFee, err := k.feeConverter.ConvertFee(ctx, fee)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "failed to convert fee %s", fee)
+	}
+
+	return convertedFee, nil
 }
 
 // RefundFeesOnChannelClosure will refund all fees associated with the given port and channel identifiers.
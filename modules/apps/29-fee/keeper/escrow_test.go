@@ -1,9 +1,12 @@
 package keeper_test
 
 import (
+	"context"
+
 	sdkmath "cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 
 	"github.com/cometbft/cometbft/crypto/secp256k1"
 
@@ -592,3 +595,351 @@ func (suite *KeeperTestSuite) TestRefundFeesOnChannelClosure() {
 		})
 	}
 }
+
+// stableDenom is the denomination of the fees converted by the mockFeeConverter.
+const stableDenom = "ustable"
+
+var _ types.FeeConverter = (*mockFeeConverter)(nil)
+
+// mockFeeConverter is a stand-in for a fee converter using a price oracle. It accepts fees in the bond denomination
+// and in the stable denomination, and converts the stable denomination into the bond denomination at a fixed rate
+// by exchanging them with a treasury account.
+type mockFeeConverter struct {
+	bankKeeper types.BankKeeper
+	treasury   sdk.AccAddress
+	rate       int64
+}
+
+// IsAllowedDenom implements types.FeeConverter
+func (mockFeeConverter) IsAllowedDenom(_ context.Context, denom string) bool {
+	return denom == sdk.DefaultBondDenom || denom == stableDenom
+}
+
+// ConvertFee implements types.FeeConverter
+func (c mockFeeConverter) ConvertFee(ctx context.Context, fee sdk.Coins) (sdk.Coins, error) {
+	stableAmount := fee.AmountOf(stableDenom)
+	if stableAmount.IsZero() {
+		return fee, nil
+	}
+
+	stableFee := sdk.NewCoins(sdk.NewCoin(stableDenom, stableAmount))
+	if err := c.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, c.treasury, stableFee); err != nil {
+		return nil, err
+	}
+
+	convertedFee := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, stableAmount.MulRaw(c.rate)))
+	if err := c.bankKeeper.SendCoinsFromAccountToModule(ctx, c.treasury, types.ModuleName, convertedFee); err != nil {
+		return nil, err
+	}
+
+	return fee.Sub(stableFee...).Add(convertedFee...), nil
+}
+
+func (suite *KeeperTestSuite) TestDistributePacketFeesWithFeeConverter() {
+	var (
+		forwardRelayer sdk.AccAddress
+		reverseRelayer sdk.AccAddress
+		refundAcc      sdk.AccAddress
+		feeConverter   mockFeeConverter
+		fee            types.Fee
+	)
+
+	testCases := []struct {
+		name      string
+		malleate  func()
+		expResult func()
+	}{
+		{
+			"success: relayers are paid converted fees, unused fee is refunded in the stable denomination",
+			func() {},
+			func() {
+				// the relayers are paid the converted fees
+				balance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), forwardRelayer, sdk.DefaultBondDenom)
+				suite.Require().Equal(sdk.NewInt64Coin(sdk.DefaultBondDenom, 200), balance)
+
+				balance = suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), reverseRelayer, sdk.DefaultBondDenom)
+				suite.Require().Equal(sdk.NewInt64Coin(sdk.DefaultBondDenom, 400), balance)
+
+				// timeout_fee - (recv_fee + ack_fee) is refunded without conversion
+				balance = suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), refundAcc, stableDenom)
+				suite.Require().Equal(sdk.NewInt64Coin(stableDenom, 100), balance)
+
+				// the treasury holds the converted fees
+				balance = suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), feeConverter.treasury, stableDenom)
+				suite.Require().Equal(sdk.NewInt64Coin(stableDenom, 300), balance)
+
+				// check the module acc wallet is now empty
+				balances := suite.chainA.GetSimApp().BankKeeper.GetAllBalances(suite.chainA.GetContext(), suite.chainA.GetSimApp().IBCFeeKeeper.GetFeeModuleAddress())
+				suite.Require().Empty(balances)
+			},
+		},
+		{
+			"success: fees in the bond denomination are not converted",
+			func() {
+				fee = types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+			},
+			func() {
+				balance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), forwardRelayer, sdk.DefaultBondDenom)
+				suite.Require().Equal(defaultRecvFee[0], balance)
+
+				balance = suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), reverseRelayer, sdk.DefaultBondDenom)
+				suite.Require().Equal(defaultAckFee[0], balance)
+
+				balance = suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), feeConverter.treasury, stableDenom)
+				suite.Require().True(balance.IsZero())
+			},
+		},
+		{
+			"conversion fails: fees are refunded in the stable denomination",
+			func() {
+				// the treasury cannot pay the converted fees
+				feeConverter.treasury = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+			},
+			func() {
+				balance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), forwardRelayer, sdk.DefaultBondDenom)
+				suite.Require().True(balance.IsZero())
+
+				balance = suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), reverseRelayer, sdk.DefaultBondDenom)
+				suite.Require().True(balance.IsZero())
+
+				balance = suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), refundAcc, stableDenom)
+				suite.Require().Equal(sdk.NewInt64Coin(stableDenom, 400), balance)
+
+				balances := suite.chainA.GetSimApp().BankKeeper.GetAllBalances(suite.chainA.GetContext(), suite.chainA.GetSimApp().IBCFeeKeeper.GetFeeModuleAddress())
+				suite.Require().Empty(balances)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()  // reset
+			suite.path.Setup() // setup channel
+
+			forwardRelayer = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+			reverseRelayer = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+			refundAcc = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+			feeConverter = mockFeeConverter{
+				bankKeeper: suite.chainA.GetSimApp().BankKeeper,
+				treasury:   suite.chainA.SenderAccount.GetAddress(),
+				rate:       2,
+			}
+
+			fee = types.NewFee(
+				sdk.NewCoins(sdk.NewInt64Coin(stableDenom, 100)),
+				sdk.NewCoins(sdk.NewInt64Coin(stableDenom, 200)),
+				sdk.NewCoins(sdk.NewInt64Coin(stableDenom, 400)),
+			)
+
+			tc.malleate()
+
+			// fund the refund account with the stable denomination
+			stableCoins := sdk.NewCoins(sdk.NewInt64Coin(stableDenom, 400))
+			err := suite.chainA.GetSimApp().BankKeeper.MintCoins(suite.chainA.GetContext(), minttypes.ModuleName, stableCoins)
+			suite.Require().NoError(err)
+			err = suite.chainA.GetSimApp().BankKeeper.SendCoinsFromModuleToAccount(suite.chainA.GetContext(), minttypes.ModuleName, refundAcc, stableCoins)
+			suite.Require().NoError(err)
+
+			// escrow the packet fee & store the fee in state
+			packetID := channeltypes.NewPacketID(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, 1)
+			packetFee := types.NewPacketFee(fee, refundAcc.String(), []string{})
+			err = suite.chainA.GetSimApp().BankKeeper.SendCoinsFromAccountToModule(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), types.ModuleName, fee.Total().Sub(sdk.NewCoins(sdk.NewCoin(stableDenom, fee.Total().AmountOf(stableDenom)))...))
+			suite.Require().NoError(err)
+			err = suite.chainA.GetSimApp().BankKeeper.SendCoinsFromAccountToModule(suite.chainA.GetContext(), refundAcc, types.ModuleName, sdk.NewCoins(sdk.NewCoin(stableDenom, fee.Total().AmountOf(stableDenom))))
+			suite.Require().NoError(err)
+			suite.chainA.GetSimApp().IBCFeeKeeper.SetFeesInEscrow(suite.chainA.GetContext(), packetID, types.NewPacketFees([]types.PacketFee{packetFee}))
+
+			feeKeeper := suite.chainA.GetSimApp().IBCFeeKeeper
+			feeKeeper.WithFeeConverter(feeConverter)
+			feeKeeper.DistributePacketFeesOnAcknowledgement(suite.chainA.GetContext(), forwardRelayer.String(), reverseRelayer, []types.PacketFee{packetFee}, packetID)
+
+			suite.Require().False(feeKeeper.IsLocked(suite.chainA.GetContext()))
+			suite.Require().False(feeKeeper.HasFeesInEscrow(suite.chainA.GetContext(), packetID))
+
+			tc.expResult()
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestPayPacketFeeWithFeeConverter() {
+	testCases := []struct {
+		name   string
+		fee    types.Fee
+		expErr error
+	}{
+		{
+			"success: fee in allowed denominations",
+			types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee),
+			nil,
+		},
+		{
+			"failure: fee denomination not allowed",
+			types.NewFee(defaultRecvFee, defaultAckFee, invalidCoins),
+			types.ErrDenomNotAllowed,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.path.Setup() // setup channel
+
+			feeKeeper := suite.chainA.GetSimApp().IBCFeeKeeper
+			feeKeeper.WithFeeConverter(mockFeeConverter{})
+
+			msg := types.NewMsgPayPacketFee(tc.fee, suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, suite.chainA.SenderAccount.GetAddress().String(), nil)
+			_, err := feeKeeper.PayPacketFee(suite.chainA.GetContext(), msg)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestDistributePacketFeesRecordsRelayerPayouts() {
+	var (
+		forwardRelayer sdk.AccAddress
+		reverseRelayer sdk.AccAddress
+		packetID       channeltypes.PacketId
+		packetFees     []types.PacketFee
+		onTimeout      bool
+	)
+
+	testCases := []struct {
+		name      string
+		malleate  func()
+		expResult func()
+	}{
+		{
+			"success: payouts recorded on acknowledgement",
+			func() {},
+			func() {
+				expForwardStats := types.NewRelayerStats(forwardRelayer.String(), 1, defaultRecvFee.MulInt(sdkmath.NewInt(2)))
+				forwardStats, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetRelayerStats(suite.chainA.GetContext(), forwardRelayer.String())
+				suite.Require().True(found)
+				suite.Require().Equal(expForwardStats, forwardStats)
+
+				expReverseStats := types.NewRelayerStats(reverseRelayer.String(), 1, defaultAckFee.MulInt(sdkmath.NewInt(2)))
+				reverseStats, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetRelayerStats(suite.chainA.GetContext(), reverseRelayer.String())
+				suite.Require().True(found)
+				suite.Require().Equal(expReverseStats, reverseStats)
+
+				payouts := suite.chainA.GetSimApp().IBCFeeKeeper.GetRecentRelayerPayouts(suite.chainA.GetContext(), reverseRelayer.String())
+				suite.Require().Len(payouts, 1)
+				suite.Require().Equal(packetID, payouts[0].PacketId)
+				suite.Require().Equal(defaultAckFee.MulInt(sdkmath.NewInt(2)), payouts[0].Fee)
+				suite.Require().Equal(suite.chainA.GetContext().BlockHeight(), payouts[0].Height)
+
+				// the refund address does not earn fees
+				_, found = suite.chainA.GetSimApp().IBCFeeKeeper.GetRelayerStats(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress().String())
+				suite.Require().False(found)
+			},
+		},
+		{
+			"success: forward and reverse relayer fees of the same packet are recorded as a single payout",
+			func() {
+				reverseRelayer = forwardRelayer
+			},
+			func() {
+				expFee := defaultRecvFee.Add(defaultAckFee...).MulInt(sdkmath.NewInt(2))
+
+				relayerStats, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetRelayerStats(suite.chainA.GetContext(), forwardRelayer.String())
+				suite.Require().True(found)
+				suite.Require().Equal(types.NewRelayerStats(forwardRelayer.String(), 1, expFee), relayerStats)
+
+				payouts := suite.chainA.GetSimApp().IBCFeeKeeper.GetRecentRelayerPayouts(suite.chainA.GetContext(), forwardRelayer.String())
+				suite.Require().Len(payouts, 1)
+				suite.Require().Equal(expFee, payouts[0].Fee)
+			},
+		},
+		{
+			"success: payout recorded on timeout",
+			func() {
+				onTimeout = true
+			},
+			func() {
+				relayerStats, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetRelayerStats(suite.chainA.GetContext(), reverseRelayer.String())
+				suite.Require().True(found)
+				suite.Require().Equal(types.NewRelayerStats(reverseRelayer.String(), 1, defaultTimeoutFee.MulInt(sdkmath.NewInt(2))), relayerStats)
+
+				_, found = suite.chainA.GetSimApp().IBCFeeKeeper.GetRelayerStats(suite.chainA.GetContext(), forwardRelayer.String())
+				suite.Require().False(found)
+			},
+		},
+		{
+			"success: oldest payout is pruned",
+			func() {
+				fee := sdk.NewCoins(ibctesting.TestCoin)
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetRelayerStats(suite.chainA.GetContext(), types.NewRelayerStats(reverseRelayer.String(), types.MaxRelayerPayoutHistory, fee))
+				for i := uint64(0); i < types.MaxRelayerPayoutHistory; i++ {
+					payoutPacketID := channeltypes.NewPacketID(ibctesting.MockFeePort, ibctesting.FirstChannelID, i+100)
+					suite.chainA.GetSimApp().IBCFeeKeeper.SetRelayerPayout(suite.chainA.GetContext(), types.NewRelayerPayout(reverseRelayer.String(), i, payoutPacketID, fee, 1))
+				}
+			},
+			func() {
+				relayerStats, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetRelayerStats(suite.chainA.GetContext(), reverseRelayer.String())
+				suite.Require().True(found)
+				suite.Require().Equal(uint64(types.MaxRelayerPayoutHistory+1), relayerStats.PacketsRelayed)
+
+				_, found = suite.chainA.GetSimApp().IBCFeeKeeper.GetRelayerPayout(suite.chainA.GetContext(), reverseRelayer.String(), 0)
+				suite.Require().False(found)
+
+				payouts := suite.chainA.GetSimApp().IBCFeeKeeper.GetRecentRelayerPayouts(suite.chainA.GetContext(), reverseRelayer.String())
+				suite.Require().Len(payouts, types.MaxRelayerPayoutHistory)
+				suite.Require().Equal(uint64(1), payouts[0].Index)
+				suite.Require().Equal(packetID, payouts[len(payouts)-1].PacketId)
+			},
+		},
+		{
+			"no payout recorded for blocked relayer address",
+			func() {
+				reverseRelayer = suite.chainA.GetSimApp().AccountKeeper.GetModuleAccount(suite.chainA.GetContext(), transfertypes.ModuleName).GetAddress()
+			},
+			func() {
+				_, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetRelayerStats(suite.chainA.GetContext(), reverseRelayer.String())
+				suite.Require().False(found)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()  // reset
+			suite.path.Setup() // setup channel
+
+			forwardRelayer = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+			reverseRelayer = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+			refundAcc := suite.chainA.SenderAccount.GetAddress()
+			onTimeout = false
+
+			packetID = channeltypes.NewPacketID(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, 1)
+			packetFee := types.NewPacketFee(types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee), refundAcc.String(), nil)
+			packetFees = []types.PacketFee{packetFee, packetFee}
+
+			tc.malleate()
+
+			// escrow the packet fees & store the fees in state
+			suite.chainA.GetSimApp().IBCFeeKeeper.SetFeesInEscrow(suite.chainA.GetContext(), packetID, types.NewPacketFees(packetFees))
+			err := suite.chainA.GetSimApp().BankKeeper.SendCoinsFromAccountToModule(suite.chainA.GetContext(), refundAcc, types.ModuleName, packetFee.Fee.Total().Add(packetFee.Fee.Total()...))
+			suite.Require().NoError(err)
+
+			if onTimeout {
+				suite.chainA.GetSimApp().IBCFeeKeeper.DistributePacketFeesOnTimeout(suite.chainA.GetContext(), reverseRelayer, packetFees, packetID)
+			} else {
+				suite.chainA.GetSimApp().IBCFeeKeeper.DistributePacketFeesOnAcknowledgement(suite.chainA.GetContext(), forwardRelayer.String(), reverseRelayer, packetFees, packetID)
+			}
+
+			tc.expResult()
+		})
+	}
+}
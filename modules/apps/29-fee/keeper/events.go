@@ -94,3 +94,43 @@ func emitDistributeFeeEvent(ctx context.Context, receiver string, fee sdk.Coins)
 		),
 	})
 }
+
+// emitFeeBudgetDepletedEvent emits an event signalling that the fee budget could not cover the fee used to incentivize
+// the packet with the given packetID
+func emitFeeBudgetDepletedEvent(ctx context.Context, packetID channeltypes.PacketId, fee types.Fee, budget sdk.Coins) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx) // TODO: https://github.com/cosmos/ibc-go/issues/7223
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeFeeBudgetDepleted,
+			sdk.NewAttribute(channeltypes.AttributeKeyPortID, packetID.PortId),
+			sdk.NewAttribute(channeltypes.AttributeKeyChannelID, packetID.ChannelId),
+			sdk.NewAttribute(channeltypes.AttributeKeySequence, fmt.Sprint(packetID.Sequence)),
+			sdk.NewAttribute(types.AttributeKeyFee, fee.Total().String()),
+			sdk.NewAttribute(types.AttributeKeyBudget, budget.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	})
+}
+
+// emitCancelPacketFeeEvent emits an event containing information on the packet fees cancelled and refunded to the
+// refund address for the given packetID
+func emitCancelPacketFeeEvent(ctx context.Context, packetID channeltypes.PacketId, refundAddr string, fee sdk.Coins) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx) // TODO: https://github.com/cosmos/ibc-go/issues/7223
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCancelPacketFee,
+			sdk.NewAttribute(channeltypes.AttributeKeyPortID, packetID.PortId),
+			sdk.NewAttribute(channeltypes.AttributeKeyChannelID, packetID.ChannelId),
+			sdk.NewAttribute(channeltypes.AttributeKeySequence, fmt.Sprint(packetID.Sequence)),
+			sdk.NewAttribute(types.AttributeKeyRefundAddress, refundAddr),
+			sdk.NewAttribute(types.AttributeKeyFee, fee.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	})
+}
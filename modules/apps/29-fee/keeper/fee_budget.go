@@ -0,0 +1,123 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v9/modules/apps/29-fee/types"
+	channeltypes "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
+)
+
+// GetFeeBudgetAddress returns the address of the fee budget module account. It returns nil if the
+// fee budget module account has not been registered with the account keeper.
+func (k Keeper) GetFeeBudgetAddress() sdk.AccAddress {
+	return k.authKeeper.GetModuleAddress(types.FeeBudgetName)
+}
+
+// GetFeeBudgetBalance returns the remaining balance of the fee budget.
+func (k Keeper) GetFeeBudgetBalance(ctx context.Context) sdk.Coins {
+	budgetAddr := k.GetFeeBudgetAddress()
+	if budgetAddr == nil {
+		return sdk.NewCoins()
+	}
+
+	return k.bankKeeper.GetAllBalances(ctx, budgetAddr)
+}
+
+// GetAutoIncentivePolicy returns the policy used to incentivize outgoing packets from the fee budget.
+func (k Keeper) GetAutoIncentivePolicy(ctx context.Context) types.AutoIncentivePolicy {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.KeyAutoIncentivePolicy())
+	if err != nil {
+		panic(err)
+	}
+
+	var policy types.AutoIncentivePolicy
+	if len(bz) == 0 {
+		return policy
+	}
+
+	k.cdc.MustUnmarshal(bz, &policy)
+	return policy
+}
+
+// SetAutoIncentivePolicy sets the policy used to incentivize outgoing packets from the fee budget.
+func (k Keeper) SetAutoIncentivePolicy(ctx context.Context, policy types.AutoIncentivePolicy) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz := k.cdc.MustMarshal(&policy)
+	if err := store.Set(types.KeyAutoIncentivePolicy(), bz); err != nil {
+		panic(err)
+	}
+}
+
+// getAutoIncentivizedPacketCount returns the number of outgoing packets incentivized from the fee budget in the
+// current block. The count stored for a previous block is ignored.
+func (k Keeper) getAutoIncentivizedPacketCount(ctx context.Context) uint64 {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.KeyAutoIncentivizedPacketCount())
+	if err != nil {
+		panic(err)
+	}
+
+	// the count is stored together with the height of the block it was counted in
+	if len(bz) != 16 || sdk.BigEndianToUint64(bz[:8]) != uint64(sdk.UnwrapSDKContext(ctx).BlockHeight()) {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz[8:])
+}
+
+// setAutoIncentivizedPacketCount sets the number of outgoing packets incentivized from the fee budget in the current block.
+func (k Keeper) setAutoIncentivizedPacketCount(ctx context.Context, count uint64) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz := append(sdk.Uint64ToBigEndian(uint64(sdk.UnwrapSDKContext(ctx).BlockHeight())), sdk.Uint64ToBigEndian(count)...)
+	if err := store.Set(types.KeyAutoIncentivizedPacketCount(), bz); err != nil {
+		panic(err)
+	}
+}
+
+// autoIncentivizePacket escrows the fee of the auto incentive policy from the fee budget for the packet with the given
+// packetID, if the packet is sent on a channel selected by the policy. The fee budget is used as the refund address, so
+// that unused fees are returned to it. At most the maximum number of packets per block of the policy are incentivized
+// in a block, such that the fee budget cannot be drained by sending many packets in a short time. If the fee budget
+// cannot cover the fee, the packet is not incentivized and a fee budget depleted event is emitted. A failure to escrow
+// the fee never fails the sending of the packet.
+func (k Keeper) autoIncentivizePacket(ctx context.Context, packetID channeltypes.PacketId) {
+	policy := k.GetAutoIncentivePolicy(ctx)
+	if !policy.HasChannel(packetID.PortId, packetID.ChannelId) {
+		return
+	}
+
+	count := k.getAutoIncentivizedPacketCount(ctx)
+	if count >= policy.MaxPacketsPerBlock {
+		k.Logger(ctx).Debug("maximum number of auto incentivized packets per block reached", "port-id", packetID.PortId, "channel-id", packetID.ChannelId, "sequence", packetID.Sequence, "max-packets-per-block", policy.MaxPacketsPerBlock)
+		return
+	}
+
+	budgetAddr := k.GetFeeBudgetAddress()
+	if budgetAddr == nil {
+		k.Logger(ctx).Error("fee budget module account is not registered", "module account", types.FeeBudgetName)
+		return
+	}
+
+	budget := k.bankKeeper.GetAllBalances(ctx, budgetAddr)
+	if !budget.IsAllGTE(policy.Fee.Total()) {
+		emitFeeBudgetDepletedEvent(ctx, packetID, policy.Fee, budget)
+		return
+	}
+
+	// cache context so that no state changes are written if escrowing the fee fails
+	sdkCtx := sdk.UnwrapSDKContext(ctx) // TODO: https://github.com/cosmos/ibc-go/issues/5917
+	cacheCtx, writeFn := sdkCtx.CacheContext()
+
+	packetFee := types.NewPacketFee(policy.Fee, budgetAddr.String(), nil)
+	if err := k.escrowPacketFee(cacheCtx, packetID, packetFee); err != nil {
+		k.Logger(ctx).Error("failed to escrow fee from the fee budget", "port-id", packetID.PortId, "channel-id", packetID.ChannelId, "sequence", packetID.Sequence, "error", err.Error())
+		return
+	}
+
+	k.setAutoIncentivizedPacketCount(cacheCtx, count+1)
+
+	writeFn()
+}
@@ -0,0 +1,203 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+
+	"github.com/cosmos/ibc-go/v9/modules/apps/29-fee/types"
+	clienttypes "github.com/cosmos/ibc-go/v9/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v9/testing"
+	ibcmock "github.com/cosmos/ibc-go/v9/testing/mock"
+)
+
+func (suite *KeeperTestSuite) TestSendPacketAutoIncentivize() {
+	var (
+		policy types.AutoIncentivePolicy
+		budget sdk.Coins
+	)
+
+	fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+
+	testCases := []struct {
+		name         string
+		malleate     func()
+		expEscrowed  bool
+		expDepletion bool
+	}{
+		{
+			"success: fee escrowed from the fee budget",
+			func() {},
+			true,
+			false,
+		},
+		{
+			"channel is not selected by the policy",
+			func() {
+				policy.Channels = []types.FeeEnabledChannel{{PortId: ibctesting.MockFeePort, ChannelId: "channel-100"}}
+			},
+			false,
+			false,
+		},
+		{
+			"fee budget is depleted",
+			func() {
+				budget = fee.Total().Sub(sdk.NewCoin(sdk.DefaultBondDenom, defaultRecvFee.AmountOf(sdk.DefaultBondDenom)))
+			},
+			false,
+			true,
+		},
+		{
+			"channel is not fee enabled",
+			func() {
+				suite.chainA.GetSimApp().IBCFeeKeeper.DeleteFeeEnabled(suite.chainA.GetContext(), suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID)
+			},
+			false,
+			false,
+		},
+		{
+			"fee module is locked",
+			func() {
+				lockFeeModule(suite.chainA)
+			},
+			false,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.path.Setup()
+
+			policy = types.NewAutoIncentivePolicy(fee, []types.FeeEnabledChannel{
+				{PortId: suite.path.EndpointA.ChannelConfig.PortID, ChannelId: suite.path.EndpointA.ChannelID},
+			}, 1)
+			budget = fee.Total()
+
+			tc.malleate()
+
+			suite.chainA.GetSimApp().IBCFeeKeeper.SetAutoIncentivePolicy(suite.chainA.GetContext(), policy)
+
+			// fund the fee budget
+			budgetAddr := suite.chainA.GetSimApp().IBCFeeKeeper.GetFeeBudgetAddress()
+			err := suite.chainA.GetSimApp().BankKeeper.MintCoins(suite.chainA.GetContext(), minttypes.ModuleName, budget)
+			suite.Require().NoError(err)
+			err = suite.chainA.GetSimApp().BankKeeper.SendCoinsFromModuleToAccount(suite.chainA.GetContext(), minttypes.ModuleName, budgetAddr, budget)
+			suite.Require().NoError(err)
+
+			ctx := suite.chainA.GetContext()
+			sequence, err := suite.chainA.GetSimApp().IBCFeeKeeper.SendPacket(ctx, suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, clienttypes.NewHeight(1, 100), 0, ibcmock.MockPacketData)
+			suite.Require().NoError(err)
+
+			packetID := channeltypes.NewPacketID(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, sequence)
+			feesInEscrow, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetFeesInEscrow(ctx, packetID)
+			remainingBudget := suite.chainA.GetSimApp().IBCFeeKeeper.GetFeeBudgetBalance(ctx)
+
+			if tc.expEscrowed {
+				suite.Require().True(found)
+				suite.Require().Equal([]types.PacketFee{types.NewPacketFee(fee, budgetAddr.String(), nil)}, feesInEscrow.PacketFees)
+				suite.Require().True(remainingBudget.IsZero())
+			} else {
+				suite.Require().False(found)
+				suite.Require().Equal(budget, remainingBudget)
+			}
+
+			var depleted bool
+			for _, event := range ctx.EventManager().Events() {
+				if event.Type == types.EventTypeFeeBudgetDepleted {
+					depleted = true
+				}
+			}
+
+			suite.Require().Equal(tc.expDepletion, depleted)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestSendPacketAutoIncentivizeMaxPacketsPerBlock() {
+	suite.path.Setup()
+
+	const (
+		maxPacketsPerBlock = 2
+		sentPackets        = 5
+	)
+
+	fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+	policy := types.NewAutoIncentivePolicy(fee, []types.FeeEnabledChannel{
+		{PortId: suite.path.EndpointA.ChannelConfig.PortID, ChannelId: suite.path.EndpointA.ChannelID},
+	}, maxPacketsPerBlock)
+	suite.chainA.GetSimApp().IBCFeeKeeper.SetAutoIncentivePolicy(suite.chainA.GetContext(), policy)
+
+	// fund the fee budget with enough fees for all the packets
+	budget := fee.Total().MulInt(sdkmath.NewInt(sentPackets))
+	budgetAddr := suite.chainA.GetSimApp().IBCFeeKeeper.GetFeeBudgetAddress()
+	err := suite.chainA.GetSimApp().BankKeeper.MintCoins(suite.chainA.GetContext(), minttypes.ModuleName, budget)
+	suite.Require().NoError(err)
+	err = suite.chainA.GetSimApp().BankKeeper.SendCoinsFromModuleToAccount(suite.chainA.GetContext(), minttypes.ModuleName, budgetAddr, budget)
+	suite.Require().NoError(err)
+
+	// sendPackets sends packets in a single block and returns the number of packets incentivized from the fee budget
+	sendPackets := func(ctx sdk.Context, n int) int {
+		var incentivized int
+		for i := 0; i < n; i++ {
+			sequence, err := suite.chainA.GetSimApp().IBCFeeKeeper.SendPacket(ctx, suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, clienttypes.NewHeight(1, 100), 0, ibcmock.MockPacketData)
+			suite.Require().NoError(err)
+
+			packetID := channeltypes.NewPacketID(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, sequence)
+			if _, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetFeesInEscrow(ctx, packetID); found {
+				incentivized++
+			}
+		}
+
+		return incentivized
+	}
+
+	// spamming packets in a single block only drains the fees of the maximum number of packets per block
+	ctx := suite.chainA.GetContext()
+	suite.Require().Equal(maxPacketsPerBlock, sendPackets(ctx, sentPackets))
+	expBudget := budget.Sub(fee.Total().MulInt(sdkmath.NewInt(maxPacketsPerBlock))...)
+	suite.Require().Equal(expBudget, suite.chainA.GetSimApp().IBCFeeKeeper.GetFeeBudgetBalance(ctx))
+
+	// packets are incentivized again in the next block
+	suite.coordinator.CommitBlock(suite.chainA)
+	ctx = suite.chainA.GetContext()
+	suite.Require().Equal(1, sendPackets(ctx, 1))
+	expBudget = expBudget.Sub(fee.Total()...)
+	suite.Require().Equal(expBudget, suite.chainA.GetSimApp().IBCFeeKeeper.GetFeeBudgetBalance(ctx))
+}
+
+func (suite *KeeperTestSuite) TestAutoIncentivizedPacketRefundsFeeBudget() {
+	suite.path.Setup()
+
+	fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+	policy := types.NewAutoIncentivePolicy(fee, []types.FeeEnabledChannel{
+		{PortId: suite.path.EndpointA.ChannelConfig.PortID, ChannelId: suite.path.EndpointA.ChannelID},
+	}, 1)
+	suite.chainA.GetSimApp().IBCFeeKeeper.SetAutoIncentivePolicy(suite.chainA.GetContext(), policy)
+
+	// fund the fee budget
+	budgetAddr := suite.chainA.GetSimApp().IBCFeeKeeper.GetFeeBudgetAddress()
+	err := suite.chainA.GetSimApp().BankKeeper.MintCoins(suite.chainA.GetContext(), minttypes.ModuleName, fee.Total())
+	suite.Require().NoError(err)
+	err = suite.chainA.GetSimApp().BankKeeper.SendCoinsFromModuleToAccount(suite.chainA.GetContext(), minttypes.ModuleName, budgetAddr, fee.Total())
+	suite.Require().NoError(err)
+
+	sequence, err := suite.chainA.GetSimApp().IBCFeeKeeper.SendPacket(suite.chainA.GetContext(), suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, clienttypes.NewHeight(1, 100), 0, ibcmock.MockPacketData)
+	suite.Require().NoError(err)
+
+	packetID := channeltypes.NewPacketID(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, sequence)
+	feesInEscrow, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetFeesInEscrow(suite.chainA.GetContext(), packetID)
+	suite.Require().True(found)
+
+	timeoutRelayer := suite.chainA.SenderAccounts[1].SenderAccount.GetAddress()
+	suite.chainA.GetSimApp().IBCFeeKeeper.DistributePacketFeesOnTimeout(suite.chainA.GetContext(), timeoutRelayer, feesInEscrow.PacketFees, packetID)
+
+	// the unused receive and acknowledgement fees are refunded to the fee budget
+	expBudget := fee.Total().Sub(fee.TimeoutFee...)
+	suite.Require().Equal(expBudget, suite.chainA.GetSimApp().IBCFeeKeeper.GetFeeBudgetBalance(suite.chainA.GetContext()))
+}
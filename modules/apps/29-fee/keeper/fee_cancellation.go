@@ -0,0 +1,190 @@
+package keeper
+
+import (
+	"context"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v9/modules/apps/29-fee/types"
+	channeltypes "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
+)
+
+// GetCancellationDelay returns the delay after which escrowed packet fees may be cancelled by their refund address.
+// If no delay has been set in state, the default cancellation delay is returned.
+func (k Keeper) GetCancellationDelay(ctx context.Context) time.Duration {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.KeyCancellationDelay())
+	if err != nil {
+		panic(err)
+	}
+
+	if len(bz) == 0 {
+		return types.DefaultCancellationDelay
+	}
+
+	return time.Duration(sdk.BigEndianToUint64(bz))
+}
+
+// SetCancellationDelay sets the delay after which escrowed packet fees may be cancelled by their refund address.
+func (k Keeper) SetCancellationDelay(ctx context.Context, cancellationDelay time.Duration) {
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Set(types.KeyCancellationDelay(), sdk.Uint64ToBigEndian(uint64(cancellationDelay))); err != nil {
+		panic(err)
+	}
+}
+
+// GetFeeEscrowTime returns the time at which packet fees were last escrowed by the refund address for the given packetID
+func (k Keeper) GetFeeEscrowTime(ctx context.Context, packetID channeltypes.PacketId, refundAddr string) (time.Time, bool) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.KeyFeeEscrowTime(packetID, refundAddr))
+	if err != nil {
+		panic(err)
+	}
+
+	if len(bz) == 0 {
+		return time.Time{}, false
+	}
+
+	escrowTime, err := sdk.ParseTimeBytes(bz)
+	if err != nil {
+		panic(err)
+	}
+
+	return escrowTime, true
+}
+
+// SetFeeEscrowTime stores the time at which packet fees were escrowed by the refund address for the given packetID
+func (k Keeper) SetFeeEscrowTime(ctx context.Context, packetID channeltypes.PacketId, refundAddr string, escrowTime time.Time) {
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Set(types.KeyFeeEscrowTime(packetID, refundAddr), sdk.FormatTimeBytes(escrowTime)); err != nil {
+		panic(err)
+	}
+}
+
+// DeleteFeeEscrowTime deletes the time at which packet fees were escrowed by the refund address for the given packetID
+func (k Keeper) DeleteFeeEscrowTime(ctx context.Context, packetID channeltypes.PacketId, refundAddr string) {
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Delete(types.KeyFeeEscrowTime(packetID, refundAddr)); err != nil {
+		panic(err)
+	}
+}
+
+// GetAllFeeEscrowTimes returns all the fee escrow times stored in state
+func (k Keeper) GetAllFeeEscrowTimes(ctx context.Context) []types.PacketFeeEscrowTime {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte(types.FeeEscrowTimePrefix))
+	defer sdk.LogDeferred(k.Logger(ctx), func() error { return iterator.Close() })
+
+	var escrowTimes []types.PacketFeeEscrowTime
+	for ; iterator.Valid(); iterator.Next() {
+		packetID, refundAddr, err := types.ParseKeyFeeEscrowTime(string(iterator.Key()))
+		if err != nil {
+			panic(err)
+		}
+
+		escrowTime, err := sdk.ParseTimeBytes(iterator.Value())
+		if err != nil {
+			panic(err)
+		}
+
+		escrowTimes = append(escrowTimes, types.NewPacketFeeEscrowTime(packetID, refundAddr, escrowTime))
+	}
+
+	return escrowTimes
+}
+
+// setMissingFeeEscrowTimes sets the fee escrow time of every refund address with packet fees in escrow but no
+// recorded escrow time to the current block time.
+func (k Keeper) setMissingFeeEscrowTimes(ctx context.Context) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx) // TODO: https://github.com/cosmos/ibc-go/issues/5917
+	for _, identifiedFees := range k.GetAllIdentifiedPacketFees(ctx) {
+		for _, packetFee := range identifiedFees.PacketFees {
+			if _, found := k.GetFeeEscrowTime(ctx, identifiedFees.PacketId, packetFee.RefundAddress); !found {
+				k.SetFeeEscrowTime(ctx, identifiedFees.PacketId, packetFee.RefundAddress, sdkCtx.BlockTime())
+			}
+		}
+	}
+}
+
+// deleteFeeEscrowTimes deletes the fee escrow times of all refund addresses for the given packetID
+func (k Keeper) deleteFeeEscrowTimes(ctx context.Context, packetID channeltypes.PacketId) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iterator := storetypes.KVStorePrefixIterator(store, append(types.KeyFeeEscrowTimePacketPrefix(packetID), '/'))
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+
+	sdk.LogDeferred(k.Logger(ctx), func() error { return iterator.Close() })
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// cancelPacketFee refunds all the packet fees escrowed by the refund address for the given packetID and returns the
+// refunded fees. Fees may only be cancelled once the cancellation delay has passed since the refund address last
+// escrowed fees for the packet, and only while the packet commitment is still present, that is, while the packet
+// has not yet been acknowledged or timed out.
+func (k Keeper) cancelPacketFee(ctx context.Context, packetID channeltypes.PacketId, refundAddr sdk.AccAddress) (sdk.Coins, error) {
+	feesInEscrow, found := k.GetFeesInEscrow(ctx, packetID)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrFeeNotFound, "channel: %s, port: %s, sequence: %d", packetID.ChannelId, packetID.PortId, packetID.Sequence)
+	}
+
+	if bz := k.GetPacketCommitment(ctx, packetID.PortId, packetID.ChannelId, packetID.Sequence); len(bz) == 0 {
+		return nil, errorsmod.Wrapf(channeltypes.ErrPacketCommitmentNotFound, "packet has already been acknowledged or timed out")
+	}
+
+	var (
+		refundFees    sdk.Coins
+		remainingFees []types.PacketFee
+	)
+
+	for _, packetFee := range feesInEscrow.PacketFees {
+		if packetFee.RefundAddress == refundAddr.String() {
+			refundFees = refundFees.Add(packetFee.Fee.Total()...)
+		} else {
+			remainingFees = append(remainingFees, packetFee)
+		}
+	}
+
+	if refundFees.Empty() {
+		return nil, errorsmod.Wrapf(types.ErrFeeNotFound, "no fees escrowed by refund address: %s", refundAddr)
+	}
+
+	escrowTime, found := k.GetFeeEscrowTime(ctx, packetID, refundAddr.String())
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrFeeNotCancellable, "escrow time not found for refund address: %s", refundAddr)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx) // TODO: https://github.com/cosmos/ibc-go/issues/5917
+	cancellableTime := escrowTime.Add(k.GetCancellationDelay(ctx))
+	if sdkCtx.BlockTime().Before(cancellableTime) {
+		return nil, errorsmod.Wrapf(types.ErrFeeNotCancellable, "fees may not be cancelled before %s", cancellableTime)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, refundAddr, refundFees); err != nil {
+		return nil, err
+	}
+
+	k.DeleteFeeEscrowTime(ctx, packetID, refundAddr.String())
+
+	packetFees := types.NewPacketFees(remainingFees)
+	if len(remainingFees) > 0 {
+		k.SetFeesInEscrow(ctx, packetID, packetFees)
+	} else {
+		k.DeleteFeesInEscrow(ctx, packetID)
+	}
+
+	emitCancelPacketFeeEvent(ctx, packetID, refundAddr.String(), refundFees)
+	emitIncentivizedPacketEvent(ctx, packetID, packetFees)
+
+	return refundFees, nil
+}
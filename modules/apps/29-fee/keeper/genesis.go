@@ -27,6 +27,31 @@ func (k Keeper) InitGenesis(ctx sdk.Context, state types.GenesisState) {
 	for _, enabledChan := range state.FeeEnabledChannels {
 		k.SetFeeEnabled(ctx, enabledChan.PortId, enabledChan.ChannelId)
 	}
+
+	for _, relayerStats := range state.RelayerStats {
+		k.SetRelayerStats(ctx, relayerStats)
+	}
+
+	for _, relayerPayout := range state.RelayerPayouts {
+		k.SetRelayerPayout(ctx, relayerPayout)
+	}
+
+	k.SetAutoIncentivePolicy(ctx, state.AutoIncentivePolicy)
+
+	cancellationDelay := state.CancellationDelay
+	if cancellationDelay == 0 {
+		cancellationDelay = types.DefaultCancellationDelay
+	}
+
+	k.SetCancellationDelay(ctx, cancellationDelay)
+
+	for _, escrowTime := range state.FeeEscrowTimes {
+		k.SetFeeEscrowTime(ctx, escrowTime.PacketId, escrowTime.RefundAddress, escrowTime.EscrowTime)
+	}
+
+	// fees escrowed in genesis states exported before fee escrow times were introduced may only be cancelled
+	// once the cancellation delay has passed since the import
+	k.setMissingFeeEscrowTimes(ctx)
 }
 
 // ExportGenesis returns the fee middleware application exported genesis
@@ -37,5 +62,10 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		RegisteredPayees:             k.GetAllPayees(ctx),
 		RegisteredCounterpartyPayees: k.GetAllCounterpartyPayees(ctx),
 		ForwardRelayers:              k.GetAllForwardRelayerAddresses(ctx),
+		RelayerStats:                 k.GetAllRelayerStats(ctx),
+		RelayerPayouts:               k.GetAllRelayerPayouts(ctx),
+		AutoIncentivePolicy:          k.GetAutoIncentivePolicy(ctx),
+		CancellationDelay:            k.GetCancellationDelay(ctx),
+		FeeEscrowTimes:               k.GetAllFeeEscrowTimes(ctx),
 	}
 }
@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	"time"
+
 	"github.com/cosmos/ibc-go/v9/modules/apps/29-fee/types"
 	channeltypes "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
 	ibctesting "github.com/cosmos/ibc-go/v9/testing"
@@ -42,6 +44,21 @@ func (suite *KeeperTestSuite) TestInitGenesis() {
 				ChannelId:         ibctesting.FirstChannelID,
 			},
 		},
+		RelayerStats: []types.RelayerStats{
+			types.NewRelayerStats(suite.chainB.SenderAccount.GetAddress().String(), 1, defaultRecvFee),
+		},
+		RelayerPayouts: []types.RelayerPayout{
+			types.NewRelayerPayout(suite.chainB.SenderAccount.GetAddress().String(), 0, packetID, defaultRecvFee, 1),
+		},
+		AutoIncentivePolicy: types.NewAutoIncentivePolicy(
+			types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee),
+			[]types.FeeEnabledChannel{{PortId: ibctesting.MockFeePort, ChannelId: ibctesting.FirstChannelID}},
+			1,
+		),
+		CancellationDelay: time.Hour,
+		FeeEscrowTimes: []types.PacketFeeEscrowTime{
+			types.NewPacketFeeEscrowTime(packetID, suite.chainA.SenderAccount.GetAddress().String(), suite.chainA.GetContext().BlockTime().UTC()),
+		},
 	}
 
 	suite.chainA.GetSimApp().IBCFeeKeeper.InitGenesis(suite.chainA.GetContext(), genesisState)
@@ -64,6 +81,27 @@ func (suite *KeeperTestSuite) TestInitGenesis() {
 	counterpartyPayeeAddr, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetCounterpartyPayeeAddress(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress().String(), ibctesting.FirstChannelID)
 	suite.Require().True(found)
 	suite.Require().Equal(genesisState.RegisteredCounterpartyPayees[0].CounterpartyPayee, counterpartyPayeeAddr)
+
+	// check relayer stats
+	relayerStats, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetRelayerStats(suite.chainA.GetContext(), suite.chainB.SenderAccount.GetAddress().String())
+	suite.Require().True(found)
+	suite.Require().Equal(genesisState.RelayerStats[0], relayerStats)
+
+	// check relayer payouts
+	relayerPayout, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetRelayerPayout(suite.chainA.GetContext(), suite.chainB.SenderAccount.GetAddress().String(), 0)
+	suite.Require().True(found)
+	suite.Require().Equal(genesisState.RelayerPayouts[0], relayerPayout)
+
+	// check auto incentive policy
+	suite.Require().Equal(genesisState.AutoIncentivePolicy, suite.chainA.GetSimApp().IBCFeeKeeper.GetAutoIncentivePolicy(suite.chainA.GetContext()))
+
+	// check cancellation delay
+	suite.Require().Equal(genesisState.CancellationDelay, suite.chainA.GetSimApp().IBCFeeKeeper.GetCancellationDelay(suite.chainA.GetContext()))
+
+	// check fee escrow times
+	escrowTime, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetFeeEscrowTime(suite.chainA.GetContext(), packetID, suite.chainA.SenderAccount.GetAddress().String())
+	suite.Require().True(found)
+	suite.Require().Equal(genesisState.FeeEscrowTimes[0].EscrowTime, escrowTime)
 }
 
 func (suite *KeeperTestSuite) TestExportGenesis() {
@@ -97,6 +135,23 @@ func (suite *KeeperTestSuite) TestExportGenesis() {
 	// set forward relayer address
 	suite.chainA.GetSimApp().IBCFeeKeeper.SetRelayerAddressForAsyncAck(suite.chainA.GetContext(), packetID, suite.chainA.SenderAccount.GetAddress().String())
 
+	// set relayer stats and payout
+	relayerStats := types.NewRelayerStats(suite.chainB.SenderAccount.GetAddress().String(), 1, defaultAckFee)
+	suite.chainA.GetSimApp().IBCFeeKeeper.SetRelayerStats(suite.chainA.GetContext(), relayerStats)
+
+	relayerPayout := types.NewRelayerPayout(suite.chainB.SenderAccount.GetAddress().String(), 0, packetID, defaultAckFee, 1)
+	suite.chainA.GetSimApp().IBCFeeKeeper.SetRelayerPayout(suite.chainA.GetContext(), relayerPayout)
+
+	// set auto incentive policy
+	policy := types.NewAutoIncentivePolicy(fee, []types.FeeEnabledChannel{{PortId: ibctesting.MockFeePort, ChannelId: ibctesting.FirstChannelID}}, 1)
+	suite.chainA.GetSimApp().IBCFeeKeeper.SetAutoIncentivePolicy(suite.chainA.GetContext(), policy)
+
+	// set cancellation delay and fee escrow time
+	suite.chainA.GetSimApp().IBCFeeKeeper.SetCancellationDelay(suite.chainA.GetContext(), time.Hour)
+
+	escrowTime := types.NewPacketFeeEscrowTime(packetID, refundAcc.String(), suite.chainA.GetContext().BlockTime().UTC())
+	suite.chainA.GetSimApp().IBCFeeKeeper.SetFeeEscrowTime(suite.chainA.GetContext(), packetID, refundAcc.String(), escrowTime.EscrowTime)
+
 	// export genesis
 	genesisState := suite.chainA.GetSimApp().IBCFeeKeeper.ExportGenesis(suite.chainA.GetContext())
 
@@ -123,4 +178,46 @@ func (suite *KeeperTestSuite) TestExportGenesis() {
 	suite.Require().Equal(suite.chainA.SenderAccount.GetAddress().String(), genesisState.RegisteredCounterpartyPayees[0].Relayer)
 	suite.Require().Equal(suite.chainB.SenderAccount.GetAddress().String(), genesisState.RegisteredCounterpartyPayees[0].CounterpartyPayee)
 	suite.Require().Equal(ibctesting.FirstChannelID, genesisState.RegisteredCounterpartyPayees[0].ChannelId)
+
+	// check relayer stats and payouts
+	suite.Require().Equal([]types.RelayerStats{relayerStats}, genesisState.RelayerStats)
+	suite.Require().Equal([]types.RelayerPayout{relayerPayout}, genesisState.RelayerPayouts)
+
+	// check auto incentive policy
+	suite.Require().Equal(policy, genesisState.AutoIncentivePolicy)
+
+	// check cancellation delay and fee escrow times
+	suite.Require().Equal(time.Hour, genesisState.CancellationDelay)
+	suite.Require().Equal([]types.PacketFeeEscrowTime{escrowTime}, genesisState.FeeEscrowTimes)
+}
+
+func (suite *KeeperTestSuite) TestInitGenesisUnsetCancellationDelay() {
+	packetID := channeltypes.NewPacketID(ibctesting.MockFeePort, ibctesting.FirstChannelID, 1)
+	refundAddr := suite.chainA.SenderAccount.GetAddress().String()
+
+	// genesis states exported before fee cancellation have neither a cancellation delay nor fee escrow times
+	genesisState := types.GenesisState{
+		IdentifiedFees: []types.IdentifiedPacketFees{
+			{
+				PacketId: packetID,
+				PacketFees: []types.PacketFee{
+					types.NewPacketFee(types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee), refundAddr, nil),
+				},
+			},
+		},
+	}
+
+	suite.Require().NoError(genesisState.Validate())
+
+	ctx := suite.chainA.GetContext()
+	suite.chainA.GetSimApp().IBCFeeKeeper.InitGenesis(ctx, genesisState)
+
+	// check the default cancellation delay is applied
+	suite.Require().Equal(types.DefaultCancellationDelay, suite.chainA.GetSimApp().IBCFeeKeeper.GetCancellationDelay(ctx))
+	suite.Require().Equal(types.DefaultCancellationDelay, suite.chainA.GetSimApp().IBCFeeKeeper.ExportGenesis(ctx).CancellationDelay)
+
+	// check the fee escrow time is set to the block time of the import
+	escrowTime, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetFeeEscrowTime(ctx, packetID, refundAddr)
+	suite.Require().True(found)
+	suite.Require().Equal(ctx.BlockTime().UTC(), escrowTime)
 }
@@ -2,11 +2,13 @@ package keeper
 
 import (
 	"context"
+	"sort"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
 	"cosmossdk.io/store/prefix"
 
 	"github.com/cosmos/cosmos-sdk/runtime"
@@ -287,3 +289,134 @@ func (k Keeper) FeeEnabledChannel(goCtx context.Context, req *types.QueryFeeEnab
 		FeeEnabled: isFeeEnabled,
 	}, nil
 }
+
+// TopIncentivizedPackets implements the Query/TopIncentivizedPackets gRPC method
+func (k Keeper) TopIncentivizedPackets(goCtx context.Context, req *types.QueryTopIncentivizedPacketsRequest) (*types.QueryTopIncentivizedPacketsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := validate.GRPCRequest(req.PortId, req.ChannelId); err != nil {
+		return nil, err
+	}
+
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	limit := req.Limit
+	if limit == 0 {
+		limit = types.DefaultTopIncentivizedPacketsLimit
+	}
+
+	if limit > types.MaxTopIncentivizedPacketsLimit {
+		return nil, status.Errorf(codes.InvalidArgument, "limit must not exceed %d, got %d", types.MaxTopIncentivizedPacketsLimit, limit)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !k.channelKeeper.HasChannel(ctx, req.PortId, req.ChannelId) {
+		return nil, status.Error(
+			codes.NotFound,
+			errorsmod.Wrapf(channeltypes.ErrChannelNotFound, "port-id: %s, channel-id %s", req.PortId, req.ChannelId).Error(),
+		)
+	}
+
+	type rankedPacket struct {
+		packetFees types.IdentifiedPacketFees
+		amount     sdkmath.Int
+	}
+
+	var rankedPackets []rankedPacket
+	for _, identifiedPacketFees := range k.GetIdentifiedPacketFeesForChannel(ctx, req.PortId, req.ChannelId) {
+		amount := sdkmath.ZeroInt()
+		for _, packetFee := range identifiedPacketFees.PacketFees {
+			amount = amount.Add(packetFee.Fee.Total().AmountOf(req.Denom))
+		}
+
+		// packets which are not incentivized in the requested denomination are excluded
+		if amount.IsZero() {
+			continue
+		}
+
+		rankedPackets = append(rankedPackets, rankedPacket{packetFees: identifiedPacketFees, amount: amount})
+	}
+
+	// rank packets by the total escrowed fee in descending order, packets with equal fees are ranked by sequence
+	sort.SliceStable(rankedPackets, func(i, j int) bool {
+		if !rankedPackets[i].amount.Equal(rankedPackets[j].amount) {
+			return rankedPackets[i].amount.GT(rankedPackets[j].amount)
+		}
+
+		return rankedPackets[i].packetFees.PacketId.Sequence < rankedPackets[j].packetFees.PacketId.Sequence
+	})
+
+	if uint64(len(rankedPackets)) > limit {
+		rankedPackets = rankedPackets[:limit]
+	}
+
+	packets := make([]types.IdentifiedPacketFees, len(rankedPackets))
+	for i, rankedPacket := range rankedPackets {
+		packets[i] = rankedPacket.packetFees
+	}
+
+	return &types.QueryTopIncentivizedPacketsResponse{
+		IncentivizedPackets: packets,
+	}, nil
+}
+
+// RelayerStats implements the Query/RelayerStats gRPC method
+func (k Keeper) RelayerStats(goCtx context.Context, req *types.QueryRelayerStatsRequest) (*types.QueryRelayerStatsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(req.RelayerAddress); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	relayerStats, found := k.GetRelayerStats(ctx, req.RelayerAddress)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "relayer stats not found for address: %s", req.RelayerAddress)
+	}
+
+	return &types.QueryRelayerStatsResponse{
+		RelayerStats: relayerStats,
+		Payouts:      k.GetRecentRelayerPayouts(ctx, req.RelayerAddress),
+	}, nil
+}
+
+// FeeBudget implements the Query/FeeBudget gRPC method
+func (k Keeper) FeeBudget(goCtx context.Context, req *types.QueryFeeBudgetRequest) (*types.QueryFeeBudgetResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	var address string
+	if budgetAddr := k.GetFeeBudgetAddress(); budgetAddr != nil {
+		address = budgetAddr.String()
+	}
+
+	return &types.QueryFeeBudgetResponse{
+		Address:             address,
+		Balance:             k.GetFeeBudgetBalance(ctx),
+		AutoIncentivePolicy: k.GetAutoIncentivePolicy(ctx),
+	}, nil
+}
+
+// CancellationDelay implements the Query/CancellationDelay gRPC method
+func (k Keeper) CancellationDelay(goCtx context.Context, req *types.QueryCancellationDelayRequest) (*types.QueryCancellationDelayResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QueryCancellationDelayResponse{
+		CancellationDelay: k.GetCancellationDelay(ctx),
+	}, nil
+}
@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"fmt"
+	"time"
 
 	sdkmath "cosmossdk.io/math"
 
@@ -879,3 +880,339 @@ func (suite *KeeperTestSuite) TestQueryFeeEnabledChannel() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestQueryTopIncentivizedPackets() {
+	var (
+		req             *types.QueryTopIncentivizedPacketsRequest
+		expPacketIDs    []channeltypes.PacketId
+		packetIDs       []channeltypes.PacketId
+		stableFeePacket channeltypes.PacketId
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		errMsg   string
+	}{
+		{
+			"success",
+			func() {
+				expPacketIDs = []channeltypes.PacketId{packetIDs[1], packetIDs[0], packetIDs[2]}
+			},
+			"",
+		},
+		{
+			"success: limit",
+			func() {
+				req.Limit = 2
+
+				expPacketIDs = []channeltypes.PacketId{packetIDs[1], packetIDs[0]}
+			},
+			"",
+		},
+		{
+			"success: packets ranked by denomination",
+			func() {
+				req.Denom = "ustable"
+
+				expPacketIDs = []channeltypes.PacketId{stableFeePacket}
+			},
+			"",
+		},
+		{
+			"success: no packets incentivized in denomination",
+			func() {
+				req.Denom = "uatom"
+
+				expPacketIDs = []channeltypes.PacketId{}
+			},
+			"",
+		},
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			"InvalidArgument",
+		},
+		{
+			"invalid port ID",
+			func() {
+				req.PortId = ""
+			},
+			"InvalidArgument",
+		},
+		{
+			"invalid denom",
+			func() {
+				req.Denom = ""
+			},
+			"InvalidArgument",
+		},
+		{
+			"limit exceeds maximum",
+			func() {
+				req.Limit = types.MaxTopIncentivizedPacketsLimit + 1
+			},
+			"InvalidArgument",
+		},
+		{
+			"channel not found",
+			func() {
+				req.ChannelId = ibctesting.InvalidID
+			},
+			"NotFound",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			suite.path.Setup()
+
+			refundAcc := suite.chainA.SenderAccount.GetAddress().String()
+			newFee := func(denom string, amount int64) types.Fee {
+				coins := sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(amount)))
+				return types.NewFee(coins, coins, coins)
+			}
+
+			// packets are escrowed with total fees of 300, 600 and 300 stake respectively
+			packetIDs = nil
+			packetFees := [][]types.PacketFee{
+				{types.NewPacketFee(newFee(sdk.DefaultBondDenom, 100), refundAcc, nil)},
+				{types.NewPacketFee(newFee(sdk.DefaultBondDenom, 100), refundAcc, nil), types.NewPacketFee(newFee(sdk.DefaultBondDenom, 100), refundAcc, nil)},
+				{types.NewPacketFee(newFee(sdk.DefaultBondDenom, 100), refundAcc, nil)},
+			}
+
+			for i, fees := range packetFees {
+				packetID := channeltypes.NewPacketID(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, uint64(i+1))
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetFeesInEscrow(suite.chainA.GetContext(), packetID, types.NewPacketFees(fees))
+				packetIDs = append(packetIDs, packetID)
+			}
+
+			stableFeePacket = channeltypes.NewPacketID(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, 4)
+			suite.chainA.GetSimApp().IBCFeeKeeper.SetFeesInEscrow(suite.chainA.GetContext(), stableFeePacket, types.NewPacketFees([]types.PacketFee{types.NewPacketFee(newFee("ustable", 100), refundAcc, nil)}))
+
+			req = &types.QueryTopIncentivizedPacketsRequest{
+				PortId:    suite.path.EndpointA.ChannelConfig.PortID,
+				ChannelId: suite.path.EndpointA.ChannelID,
+				Denom:     sdk.DefaultBondDenom,
+			}
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			res, err := suite.chainA.GetSimApp().IBCFeeKeeper.TopIncentivizedPackets(ctx, req)
+
+			if tc.errMsg == "" {
+				suite.Require().NoError(err)
+				suite.Require().Len(res.IncentivizedPackets, len(expPacketIDs))
+
+				for i, packetID := range expPacketIDs {
+					suite.Require().Equal(packetID, res.IncentivizedPackets[i].PacketId)
+				}
+			} else {
+				suite.Require().ErrorContains(err, tc.errMsg)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryRelayerStats() {
+	var (
+		req             *types.QueryRelayerStatsRequest
+		expRelayerStats types.RelayerStats
+		expPayouts      []types.RelayerPayout
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		errMsg   string
+	}{
+		{
+			"success",
+			func() {},
+			"",
+		},
+		{
+			"success: pruned payouts are not returned",
+			func() {
+				expRelayerStats.PacketsRelayed = types.MaxRelayerPayoutHistory + 1
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetRelayerStats(suite.chainA.GetContext(), expRelayerStats)
+
+				expPayouts = nil
+				for i := uint64(1); i <= types.MaxRelayerPayoutHistory; i++ {
+					payout := types.NewRelayerPayout(req.RelayerAddress, i, channeltypes.NewPacketID(ibctesting.MockFeePort, ibctesting.FirstChannelID, i), expRelayerStats.FeesEarned, 1)
+					suite.chainA.GetSimApp().IBCFeeKeeper.SetRelayerPayout(suite.chainA.GetContext(), payout)
+					expPayouts = append(expPayouts, payout)
+				}
+			},
+			"",
+		},
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			"InvalidArgument",
+		},
+		{
+			"invalid relayer address",
+			func() {
+				req.RelayerAddress = "invalid-addr"
+			},
+			"InvalidArgument",
+		},
+		{
+			"relayer stats not found",
+			func() {
+				req.RelayerAddress = suite.chainA.SenderAccounts[1].SenderAccount.GetAddress().String()
+			},
+			"NotFound",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			relayer := suite.chainA.SenderAccount.GetAddress().String()
+			fee := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100)))
+
+			expRelayerStats = types.NewRelayerStats(relayer, 1, fee)
+			suite.chainA.GetSimApp().IBCFeeKeeper.SetRelayerStats(suite.chainA.GetContext(), expRelayerStats)
+
+			payout := types.NewRelayerPayout(relayer, 0, channeltypes.NewPacketID(ibctesting.MockFeePort, ibctesting.FirstChannelID, 1), fee, 1)
+			suite.chainA.GetSimApp().IBCFeeKeeper.SetRelayerPayout(suite.chainA.GetContext(), payout)
+			expPayouts = []types.RelayerPayout{payout}
+
+			req = &types.QueryRelayerStatsRequest{
+				RelayerAddress: relayer,
+			}
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			res, err := suite.chainA.GetSimApp().IBCFeeKeeper.RelayerStats(ctx, req)
+
+			if tc.errMsg == "" {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expRelayerStats, res.RelayerStats)
+				suite.Require().Equal(expPayouts, res.Payouts)
+			} else {
+				suite.Require().ErrorContains(err, tc.errMsg)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryFeeBudget() {
+	var req *types.QueryFeeBudgetRequest
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		errMsg   string
+	}{
+		{
+			"success",
+			func() {},
+			"",
+		},
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			"InvalidArgument",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			policy := types.NewAutoIncentivePolicy(
+				types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee),
+				[]types.FeeEnabledChannel{{PortId: ibctesting.MockFeePort, ChannelId: ibctesting.FirstChannelID}},
+				1,
+			)
+			suite.chainA.GetSimApp().IBCFeeKeeper.SetAutoIncentivePolicy(suite.chainA.GetContext(), policy)
+
+			budgetAddr := suite.chainA.GetSimApp().IBCFeeKeeper.GetFeeBudgetAddress()
+			budget := sdk.NewCoins(ibctesting.TestCoin)
+			err := suite.chainA.GetSimApp().BankKeeper.SendCoins(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), budgetAddr, budget)
+			suite.Require().NoError(err)
+
+			req = &types.QueryFeeBudgetRequest{}
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			res, err := suite.chainA.GetSimApp().IBCFeeKeeper.FeeBudget(ctx, req)
+
+			if tc.errMsg == "" {
+				suite.Require().NoError(err)
+				suite.Require().Equal(budgetAddr.String(), res.Address)
+				suite.Require().Equal(budget, res.Balance)
+				suite.Require().Equal(policy, res.AutoIncentivePolicy)
+			} else {
+				suite.Require().ErrorContains(err, tc.errMsg)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryCancellationDelay() {
+	var req *types.QueryCancellationDelayRequest
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		errMsg   string
+	}{
+		{
+			"success",
+			func() {},
+			"",
+		},
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			"InvalidArgument",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			suite.chainA.GetSimApp().IBCFeeKeeper.SetCancellationDelay(suite.chainA.GetContext(), time.Hour)
+
+			req = &types.QueryCancellationDelayRequest{}
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			res, err := suite.chainA.GetSimApp().IBCFeeKeeper.CancellationDelay(ctx, req)
+
+			if tc.errMsg == "" {
+				suite.Require().NoError(err)
+				suite.Require().Equal(time.Hour, res.CancellationDelay)
+			} else {
+				suite.Require().ErrorContains(err, tc.errMsg)
+			}
+		})
+	}
+}
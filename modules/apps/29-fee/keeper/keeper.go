@@ -2,6 +2,8 @@ package keeper
 
 import (
 	"context"
+	"errors"
+	"strings"
 
 	corestore "cosmossdk.io/core/store"
 	"cosmossdk.io/log"
@@ -35,6 +37,11 @@ type Keeper struct {
 	channelKeeper types.ChannelKeeper
 	portKeeper    types.PortKeeper
 	bankKeeper    types.BankKeeper
+	feeConverter  types.FeeConverter
+
+	// the address capable of executing a MsgUpdateAutoIncentivePolicy message. Typically, this
+	// should be the x/gov module account.
+	authority string
 }
 
 // NewKeeper creates a new 29-fee Keeper instance
@@ -42,7 +49,12 @@ func NewKeeper(
 	cdc codec.BinaryCodec, storeService corestore.KVStoreService,
 	ics4Wrapper porttypes.ICS4Wrapper, channelKeeper types.ChannelKeeper,
 	portKeeper types.PortKeeper, authKeeper types.AccountKeeper, bankKeeper types.BankKeeper,
+	authority string,
 ) Keeper {
+	if strings.TrimSpace(authority) == "" {
+		panic(errors.New("authority must be non-empty"))
+	}
+
 	return Keeper{
 		cdc:           cdc,
 		storeService:  storeService,
@@ -51,6 +63,7 @@ func NewKeeper(
 		portKeeper:    portKeeper,
 		authKeeper:    authKeeper,
 		bankKeeper:    bankKeeper,
+		authority:     authority,
 	}
 }
 
@@ -61,11 +74,22 @@ func (k *Keeper) WithICS4Wrapper(wrapper porttypes.ICS4Wrapper) {
 	k.ics4Wrapper = wrapper
 }
 
+// WithFeeConverter sets the fee converter used to accept fees in other denominations than the ones paid to relayers.
+// This function must be called right after the keeper is created, before it is passed to the middleware.
+func (k *Keeper) WithFeeConverter(feeConverter types.FeeConverter) {
+	k.feeConverter = feeConverter
+}
+
 // GetICS4Wrapper returns the ICS4Wrapper.
 func (k Keeper) GetICS4Wrapper() porttypes.ICS4Wrapper {
 	return k.ics4Wrapper
 }
 
+// GetAuthority returns the 29-fee module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
 // Logger returns a module-specific logger.
 func (Keeper) Logger(ctx context.Context) log.Logger {
 	sdkCtx := sdk.UnwrapSDKContext(ctx) // TODO: https://github.com/cosmos/ibc-go/issues/5917
@@ -379,13 +403,15 @@ func (k Keeper) SetFeesInEscrow(ctx context.Context, packetID channeltypes.Packe
 	}
 }
 
-// DeleteFeesInEscrow deletes the fee associated with the given packetID
+// DeleteFeesInEscrow deletes the fee associated with the given packetID, along with the fee escrow times of the packet
 func (k Keeper) DeleteFeesInEscrow(ctx context.Context, packetID channeltypes.PacketId) {
 	store := k.storeService.OpenKVStore(ctx)
 	key := types.KeyFeesInEscrow(packetID)
 	if err := store.Delete(key); err != nil {
 		panic(err)
 	}
+
+	k.deleteFeeEscrowTimes(ctx, packetID)
 }
 
 // GetIdentifiedPacketFeesForChannel returns all the currently escrowed fees on a given channel.
@@ -437,6 +463,166 @@ func (k Keeper) GetAllIdentifiedPacketFees(ctx context.Context) []types.Identifi
 	return identifiedFees
 }
 
+// GetRelayerStats returns the fee payout statistics of the given relayer address
+func (k Keeper) GetRelayerStats(ctx context.Context, relayerAddr string) (types.RelayerStats, bool) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.KeyRelayerStats(relayerAddr))
+	if err != nil {
+		panic(err)
+	}
+
+	if len(bz) == 0 {
+		return types.RelayerStats{}, false
+	}
+
+	var relayerStats types.RelayerStats
+	k.cdc.MustUnmarshal(bz, &relayerStats)
+
+	return relayerStats, true
+}
+
+// SetRelayerStats stores the fee payout statistics of a relayer address
+func (k Keeper) SetRelayerStats(ctx context.Context, relayerStats types.RelayerStats) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz := k.cdc.MustMarshal(&relayerStats)
+	if err := store.Set(types.KeyRelayerStats(relayerStats.RelayerAddress), bz); err != nil {
+		panic(err)
+	}
+}
+
+// GetAllRelayerStats returns the fee payout statistics of all relayer addresses stored in state
+func (k Keeper) GetAllRelayerStats(ctx context.Context) []types.RelayerStats {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte(types.RelayerStatsPrefix))
+	defer sdk.LogDeferred(k.Logger(ctx), func() error { return iterator.Close() })
+
+	var relayerStats []types.RelayerStats
+	for ; iterator.Valid(); iterator.Next() {
+		var stats types.RelayerStats
+		k.cdc.MustUnmarshal(iterator.Value(), &stats)
+
+		relayerStats = append(relayerStats, stats)
+	}
+
+	return relayerStats
+}
+
+// GetRelayerPayout returns the payout stored at the given index of the payout history of the relayer address
+func (k Keeper) GetRelayerPayout(ctx context.Context, relayerAddr string, index uint64) (types.RelayerPayout, bool) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.KeyRelayerPayout(relayerAddr, index))
+	if err != nil {
+		panic(err)
+	}
+
+	if len(bz) == 0 {
+		return types.RelayerPayout{}, false
+	}
+
+	var relayerPayout types.RelayerPayout
+	k.cdc.MustUnmarshal(bz, &relayerPayout)
+
+	return relayerPayout, true
+}
+
+// SetRelayerPayout stores a payout in the payout history of a relayer address
+func (k Keeper) SetRelayerPayout(ctx context.Context, relayerPayout types.RelayerPayout) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz := k.cdc.MustMarshal(&relayerPayout)
+	if err := store.Set(types.KeyRelayerPayout(relayerPayout.RelayerAddress, relayerPayout.Index), bz); err != nil {
+		panic(err)
+	}
+}
+
+// DeleteRelayerPayout deletes the payout stored at the given index of the payout history of the relayer address
+func (k Keeper) DeleteRelayerPayout(ctx context.Context, relayerAddr string, index uint64) {
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Delete(types.KeyRelayerPayout(relayerAddr, index)); err != nil {
+		panic(err)
+	}
+}
+
+// GetRecentRelayerPayouts returns the payouts in the payout history of the relayer address, ordered from oldest to newest
+func (k Keeper) GetRecentRelayerPayouts(ctx context.Context, relayerAddr string) []types.RelayerPayout {
+	relayerStats, found := k.GetRelayerStats(ctx, relayerAddr)
+	if !found {
+		return nil
+	}
+
+	var start uint64
+	if relayerStats.PacketsRelayed > types.MaxRelayerPayoutHistory {
+		start = relayerStats.PacketsRelayed - types.MaxRelayerPayoutHistory
+	}
+
+	var relayerPayouts []types.RelayerPayout
+	for index := start; index < relayerStats.PacketsRelayed; index++ {
+		if relayerPayout, found := k.GetRelayerPayout(ctx, relayerAddr, index); found {
+			relayerPayouts = append(relayerPayouts, relayerPayout)
+		}
+	}
+
+	return relayerPayouts
+}
+
+// GetAllRelayerPayouts returns the payout history of all relayer addresses stored in state
+func (k Keeper) GetAllRelayerPayouts(ctx context.Context) []types.RelayerPayout {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte(types.RelayerPayoutPrefix))
+	defer sdk.LogDeferred(k.Logger(ctx), func() error { return iterator.Close() })
+
+	var relayerPayouts []types.RelayerPayout
+	for ; iterator.Valid(); iterator.Next() {
+		var payout types.RelayerPayout
+		k.cdc.MustUnmarshal(iterator.Value(), &payout)
+
+		relayerPayouts = append(relayerPayouts, payout)
+	}
+
+	return relayerPayouts
+}
+
+// recordRelayerPayout records the fee paid out to the relayer address for relaying the packet with the given packetID.
+// The fee is added to the payout statistics of the relayer address and to its most recent payout if it was recorded
+// for the same packet, otherwise a new payout is appended to the payout history. Payouts which exceed the maximum
+// payout history length are pruned.
+func (k Keeper) recordRelayerPayout(ctx context.Context, relayerAddr sdk.AccAddress, packetID channeltypes.PacketId, fee sdk.Coins) {
+	if fee.IsZero() {
+		return
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx) // TODO: https://github.com/cosmos/ibc-go/issues/5917
+
+	relayer := relayerAddr.String()
+	relayerStats, found := k.GetRelayerStats(ctx, relayer)
+	if !found {
+		relayerStats = types.NewRelayerStats(relayer, 0, sdk.NewCoins())
+	}
+
+	relayerStats.FeesEarned = relayerStats.FeesEarned.Add(fee...)
+
+	if relayerStats.PacketsRelayed > 0 {
+		latestPayout, found := k.GetRelayerPayout(ctx, relayer, relayerStats.PacketsRelayed-1)
+		if found && latestPayout.PacketId == packetID {
+			latestPayout.Fee = latestPayout.Fee.Add(fee...)
+			latestPayout.Height = sdkCtx.BlockHeight()
+
+			k.SetRelayerPayout(ctx, latestPayout)
+			k.SetRelayerStats(ctx, relayerStats)
+			return
+		}
+	}
+
+	k.SetRelayerPayout(ctx, types.NewRelayerPayout(relayer, relayerStats.PacketsRelayed, packetID, fee, sdkCtx.BlockHeight()))
+
+	// prune the oldest payout once the payout history exceeds its maximum length
+	if relayerStats.PacketsRelayed >= types.MaxRelayerPayoutHistory {
+		k.DeleteRelayerPayout(ctx, relayer, relayerStats.PacketsRelayed-types.MaxRelayerPayoutHistory)
+	}
+
+	relayerStats.PacketsRelayed++
+	k.SetRelayerStats(ctx, relayerStats)
+}
+
 // MustMarshalFees attempts to encode a Fee object and returns the
 // raw encoded bytes. It panics on error.
 func (k Keeper) MustMarshalFees(fees types.PacketFees) []byte {
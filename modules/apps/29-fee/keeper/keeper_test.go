@@ -109,6 +109,7 @@ func (suite *KeeperTestSuite) TestFeesInEscrow() {
 	packetFees := []types.PacketFee{packetFee, packetFee, packetFee, packetFee, packetFee}
 
 	suite.chainA.GetSimApp().IBCFeeKeeper.SetFeesInEscrow(suite.chainA.GetContext(), packetID, types.NewPacketFees(packetFees))
+	suite.chainA.GetSimApp().IBCFeeKeeper.SetFeeEscrowTime(suite.chainA.GetContext(), packetID, suite.chainA.SenderAccount.GetAddress().String(), suite.chainA.GetContext().BlockTime())
 
 	// retrieve the fees in escrow and assert the length of PacketFees
 	feesInEscrow, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetFeesInEscrow(suite.chainA.GetContext(), packetID)
@@ -119,6 +120,10 @@ func (suite *KeeperTestSuite) TestFeesInEscrow() {
 	suite.chainA.GetSimApp().IBCFeeKeeper.DeleteFeesInEscrow(suite.chainA.GetContext(), packetID)
 	hasFeesInEscrow := suite.chainA.GetSimApp().IBCFeeKeeper.HasFeesInEscrow(suite.chainA.GetContext(), packetID)
 	suite.Require().False(hasFeesInEscrow)
+
+	// the fee escrow times of the packet are deleted along with the fees
+	_, found = suite.chainA.GetSimApp().IBCFeeKeeper.GetFeeEscrowTime(suite.chainA.GetContext(), packetID, suite.chainA.SenderAccount.GetAddress().String())
+	suite.Require().False(found)
 }
 
 func (suite *KeeperTestSuite) TestIsLocked() {
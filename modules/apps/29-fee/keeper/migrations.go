@@ -46,6 +46,15 @@ func (m Migrator) Migrate1to2(ctx sdk.Context) error {
 	return nil
 }
 
+// Migrate2to3 migrates ibc-fee module from ConsensusVersion 2 to 3
+// by recording the current block time as the escrow time of the fees already in escrow,
+// so that they may only be cancelled once the cancellation delay has passed.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	m.keeper.setMissingFeeEscrowTimes(ctx)
+
+	return nil
+}
+
 // legacyTotal returns the legacy total amount for a given Fee
 // The total amount is the RecvFee + AckFee + TimeoutFee
 func legacyTotal(f types.Fee) sdk.Coins {
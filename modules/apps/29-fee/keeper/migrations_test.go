@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	"time"
+
 	sdkmath "cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -199,3 +201,36 @@ func (suite *KeeperTestSuite) TestMigrate1to2() {
 		tc.assert(err)
 	}
 }
+
+func (suite *KeeperTestSuite) TestMigrate2to3() {
+	suite.path.Setup()
+
+	refundAddr := suite.chainA.SenderAccount.GetAddress().String()
+	otherRefundAddr := suite.chainA.SenderAccounts[1].SenderAccount.GetAddress().String()
+	packetID := channeltypes.NewPacketID(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, 1)
+
+	fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+	packetFees := []types.PacketFee{
+		types.NewPacketFee(fee, refundAddr, nil),
+		types.NewPacketFee(fee, otherRefundAddr, nil),
+	}
+
+	ctx := suite.chainA.GetContext()
+	suite.chainA.GetSimApp().IBCFeeKeeper.SetFeesInEscrow(ctx, packetID, types.NewPacketFees(packetFees))
+
+	// the escrow time of the other refund address is already recorded and must be preserved
+	otherEscrowTime := ctx.BlockTime().Add(-time.Hour)
+	suite.chainA.GetSimApp().IBCFeeKeeper.SetFeeEscrowTime(ctx, packetID, otherRefundAddr, otherEscrowTime)
+
+	migrator := keeper.NewMigrator(suite.chainA.GetSimApp().IBCFeeKeeper)
+	err := migrator.Migrate2to3(ctx)
+	suite.Require().NoError(err)
+
+	escrowTime, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetFeeEscrowTime(ctx, packetID, refundAddr)
+	suite.Require().True(found)
+	suite.Require().Equal(ctx.BlockTime().UTC(), escrowTime)
+
+	escrowTime, found = suite.chainA.GetSimApp().IBCFeeKeeper.GetFeeEscrowTime(ctx, packetID, otherRefundAddr)
+	suite.Require().True(found)
+	suite.Require().Equal(otherEscrowTime.UTC(), escrowTime)
+}
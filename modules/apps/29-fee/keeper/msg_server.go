@@ -168,3 +168,71 @@ func (k Keeper) PayPacketFeeAsync(goCtx context.Context, msg *types.MsgPayPacket
 
 	return &types.MsgPayPacketFeeAsyncResponse{}, nil
 }
+
+// UpdateAutoIncentivePolicy defines a rpc handler method for MsgUpdateAutoIncentivePolicy
+// UpdateAutoIncentivePolicy may only be called by the module authority and sets the policy used to incentivize
+// outgoing packets on the selected channels from the fee budget
+func (k Keeper) UpdateAutoIncentivePolicy(goCtx context.Context, msg *types.MsgUpdateAutoIncentivePolicy) (*types.MsgUpdateAutoIncentivePolicyResponse, error) {
+	if k.GetAuthority() != msg.Signer {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Signer)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if len(msg.Policy.Channels) > 0 {
+		budgetAddr := k.GetFeeBudgetAddress()
+		if budgetAddr == nil {
+			return nil, errorsmod.Wrapf(types.ErrInvalidFeeBudget, "module account %s is not registered", types.FeeBudgetName)
+		}
+
+		// unused fees are refunded to the fee budget
+		if k.bankKeeper.BlockedAddr(budgetAddr) {
+			return nil, errorsmod.Wrapf(types.ErrInvalidFeeBudget, "module account %s is not allowed to receive refunds", types.FeeBudgetName)
+		}
+	}
+
+	k.SetAutoIncentivePolicy(ctx, msg.Policy)
+
+	return &types.MsgUpdateAutoIncentivePolicyResponse{}, nil
+}
+
+// CancelPacketFee defines a rpc handler method for MsgCancelPacketFee
+// CancelPacketFee may be called by the refund address of escrowed packet fees to reclaim them once the
+// cancellation delay has passed since they were escrowed, as long as the packet has not been acknowledged or timed out
+func (k Keeper) CancelPacketFee(goCtx context.Context, msg *types.MsgCancelPacketFee) (*types.MsgCancelPacketFeeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if k.IsLocked(ctx) {
+		return nil, types.ErrFeeModuleLocked
+	}
+
+	refundAddr, err := sdk.AccAddressFromBech32(msg.RefundAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	refundedFees, err := k.cancelPacketFee(ctx, msg.PacketId, refundAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCancelPacketFeeResponse{RefundedFees: refundedFees}, nil
+}
+
+// UpdateCancellationDelay defines a rpc handler method for MsgUpdateCancellationDelay
+// UpdateCancellationDelay may only be called by the module authority and sets the delay after which escrowed
+// packet fees may be cancelled
+func (k Keeper) UpdateCancellationDelay(goCtx context.Context, msg *types.MsgUpdateCancellationDelay) (*types.MsgUpdateCancellationDelayResponse, error) {
+	if k.GetAuthority() != msg.Signer {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Signer)
+	}
+
+	if err := types.ValidateCancellationDelay(msg.CancellationDelay); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	k.SetCancellationDelay(ctx, msg.CancellationDelay)
+
+	return &types.MsgUpdateCancellationDelayResponse{}, nil
+}
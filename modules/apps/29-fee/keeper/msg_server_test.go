@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	sdkmath "cosmossdk.io/math"
 
@@ -612,3 +613,300 @@ func (suite *KeeperTestSuite) TestPayPacketFeeAsync() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestUpdateAutoIncentivePolicy() {
+	var msg *types.MsgUpdateAutoIncentivePolicy
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"success: empty policy",
+			func() {
+				msg.Policy = types.AutoIncentivePolicy{}
+			},
+			nil,
+		},
+		{
+			"unauthorized signer",
+			func() {
+				msg.Signer = suite.chainA.SenderAccount.GetAddress().String()
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			policy := types.NewAutoIncentivePolicy(
+				types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee),
+				[]types.FeeEnabledChannel{{PortId: ibctesting.MockFeePort, ChannelId: ibctesting.FirstChannelID}},
+				1,
+			)
+			msg = types.NewMsgUpdateAutoIncentivePolicy(suite.chainA.GetSimApp().IBCFeeKeeper.GetAuthority(), policy)
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			res, err := suite.chainA.GetSimApp().IBCFeeKeeper.UpdateAutoIncentivePolicy(ctx, msg)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(msg.Policy, suite.chainA.GetSimApp().IBCFeeKeeper.GetAutoIncentivePolicy(ctx))
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().Nil(res)
+				suite.Require().Equal(types.AutoIncentivePolicy{}, suite.chainA.GetSimApp().IBCFeeKeeper.GetAutoIncentivePolicy(ctx))
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestCancelPacketFee() {
+	var (
+		packet           channeltypes.Packet
+		blockTime        time.Time
+		expRefundedFees  sdk.Coins
+		expRemainingFees []types.PacketFee
+		msg              *types.MsgCancelPacketFee
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"success with packet fees escrowed by another refund address",
+			func() {
+				fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+				packetFee := types.NewPacketFee(fee, suite.chainA.SenderAccounts[1].SenderAccount.GetAddress().String(), nil)
+
+				_, err := suite.chainA.GetSimApp().IBCFeeKeeper.PayPacketFeeAsync(suite.chainA.GetContext(), types.NewMsgPayPacketFeeAsync(msg.PacketId, packetFee))
+				suite.Require().NoError(err)
+
+				expRemainingFees = []types.PacketFee{packetFee}
+			},
+			nil,
+		},
+		{
+			"success with multiple packet fees escrowed by the refund address",
+			func() {
+				fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+				packetFee := types.NewPacketFee(fee, msg.RefundAddress, nil)
+
+				_, err := suite.chainA.GetSimApp().IBCFeeKeeper.PayPacketFeeAsync(suite.chainA.GetContext(), types.NewMsgPayPacketFeeAsync(msg.PacketId, packetFee))
+				suite.Require().NoError(err)
+
+				expRefundedFees = expRefundedFees.Add(fee.Total()...)
+			},
+			nil,
+		},
+		{
+			"success with updated cancellation delay",
+			func() {
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetCancellationDelay(suite.chainA.GetContext(), time.Minute)
+				blockTime = suite.chainA.GetContext().BlockTime().Add(time.Minute)
+			},
+			nil,
+		},
+		{
+			"fee module is locked",
+			func() {
+				lockFeeModule(suite.chainA)
+			},
+			types.ErrFeeModuleLocked,
+		},
+		{
+			"invalid refund address",
+			func() {
+				msg.RefundAddress = "invalid-address"
+			},
+			errors.New("decoding bech32 failed"),
+		},
+		{
+			"fees not found for packet",
+			func() {
+				msg.PacketId.Sequence++
+			},
+			types.ErrFeeNotFound,
+		},
+		{
+			"no fees escrowed by the refund address",
+			func() {
+				msg.RefundAddress = suite.chainA.SenderAccounts[1].SenderAccount.GetAddress().String()
+			},
+			types.ErrFeeNotFound,
+		},
+		{
+			"packet already acknowledged",
+			func() {
+				feesInEscrow, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetFeesInEscrow(suite.chainA.GetContext(), msg.PacketId)
+				suite.Require().True(found)
+
+				err := suite.path.RelayPacket(packet)
+				suite.Require().NoError(err)
+
+				// restore the packet fees distributed on acknowledgement
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetFeesInEscrow(suite.chainA.GetContext(), msg.PacketId, feesInEscrow)
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetFeeEscrowTime(suite.chainA.GetContext(), msg.PacketId, msg.RefundAddress, suite.chainA.GetContext().BlockTime())
+				blockTime = suite.chainA.GetContext().BlockTime().Add(types.DefaultCancellationDelay)
+			},
+			channeltypes.ErrPacketCommitmentNotFound,
+		},
+		{
+			"escrow time not found",
+			func() {
+				suite.chainA.GetSimApp().IBCFeeKeeper.DeleteFeeEscrowTime(suite.chainA.GetContext(), msg.PacketId, msg.RefundAddress)
+			},
+			types.ErrFeeNotCancellable,
+		},
+		{
+			"cancellation delay has not passed",
+			func() {
+				blockTime = blockTime.Add(-time.Nanosecond)
+			},
+			types.ErrFeeNotCancellable,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.path.Setup() // setup channel
+
+			timeoutHeight := clienttypes.NewHeight(clienttypes.ParseChainID(suite.chainB.ChainID), 100)
+
+			// send a packet to incentivize
+			sequence, err := suite.path.EndpointA.SendPacket(timeoutHeight, 0, ibctesting.MockPacketData)
+			suite.Require().NoError(err)
+			packetID := channeltypes.NewPacketID(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, sequence)
+			packet = channeltypes.NewPacket(ibctesting.MockPacketData, packetID.Sequence, packetID.PortId, packetID.ChannelId, suite.path.EndpointB.ChannelConfig.PortID, suite.path.EndpointB.ChannelID, timeoutHeight, 0)
+
+			fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+			refundAddr := suite.chainA.SenderAccount.GetAddress()
+			packetFee := types.NewPacketFee(fee, refundAddr.String(), nil)
+
+			_, err = suite.chainA.GetSimApp().IBCFeeKeeper.PayPacketFeeAsync(suite.chainA.GetContext(), types.NewMsgPayPacketFeeAsync(packetID, packetFee))
+			suite.Require().NoError(err)
+
+			blockTime = suite.chainA.GetContext().BlockTime().Add(types.DefaultCancellationDelay)
+			expRefundedFees = fee.Total()
+			expRemainingFees = nil
+			msg = types.NewMsgCancelPacketFee(packetID, refundAddr.String())
+
+			tc.malleate()
+
+			refundBalance := suite.chainA.GetSimApp().BankKeeper.GetAllBalances(suite.chainA.GetContext(), refundAddr)
+
+			ctx := suite.chainA.GetContext().WithBlockTime(blockTime)
+			res, err := suite.chainA.GetSimApp().IBCFeeKeeper.CancelPacketFee(ctx, msg)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expRefundedFees, res.RefundedFees)
+
+				expBalance := refundBalance.Add(expRefundedFees...)
+				suite.Require().Equal(expBalance, suite.chainA.GetSimApp().BankKeeper.GetAllBalances(ctx, refundAddr))
+
+				_, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetFeeEscrowTime(ctx, packetID, refundAddr.String())
+				suite.Require().False(found)
+
+				feesInEscrow, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetFeesInEscrow(ctx, packetID)
+				if len(expRemainingFees) == 0 {
+					suite.Require().False(found)
+				} else {
+					suite.Require().True(found)
+					suite.Require().Equal(expRemainingFees, feesInEscrow.PacketFees)
+				}
+
+				expEvent := sdk.NewEvent(
+					types.EventTypeCancelPacketFee,
+					sdk.NewAttribute(channeltypes.AttributeKeyPortID, packetID.PortId),
+					sdk.NewAttribute(channeltypes.AttributeKeyChannelID, packetID.ChannelId),
+					sdk.NewAttribute(channeltypes.AttributeKeySequence, fmt.Sprint(packetID.Sequence)),
+					sdk.NewAttribute(types.AttributeKeyRefundAddress, refundAddr.String()),
+					sdk.NewAttribute(types.AttributeKeyFee, expRefundedFees.String()),
+				)
+				suite.Require().Contains(ctx.EventManager().Events(), expEvent)
+			} else {
+				ibctesting.RequireErrorIsOrContains(suite.T(), err, tc.expErr, err.Error())
+				suite.Require().Nil(res)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestUpdateCancellationDelay() {
+	var msg *types.MsgUpdateCancellationDelay
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"zero cancellation delay",
+			func() {
+				msg.CancellationDelay = 0
+			},
+			types.ErrInvalidCancellationDelay,
+		},
+		{
+			"unauthorized signer",
+			func() {
+				msg.Signer = suite.chainA.SenderAccount.GetAddress().String()
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			msg = types.NewMsgUpdateCancellationDelay(suite.chainA.GetSimApp().IBCFeeKeeper.GetAuthority(), time.Hour)
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			res, err := suite.chainA.GetSimApp().IBCFeeKeeper.UpdateCancellationDelay(ctx, msg)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(msg.CancellationDelay, suite.chainA.GetSimApp().IBCFeeKeeper.GetCancellationDelay(ctx))
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().Nil(res)
+				suite.Require().Equal(types.DefaultCancellationDelay, suite.chainA.GetSimApp().IBCFeeKeeper.GetCancellationDelay(ctx))
+			}
+		})
+	}
+}
@@ -13,6 +13,8 @@ import (
 )
 
 // SendPacket wraps the ICS4Wrapper SendPacket function
+// If the packet is sent on a fee enabled channel selected by the auto incentive policy, the fee of the policy
+// is escrowed from the fee budget for the packet.
 func (k Keeper) SendPacket(
 	ctx context.Context,
 	sourcePort string,
@@ -21,7 +23,16 @@ func (k Keeper) SendPacket(
 	timeoutTimestamp uint64,
 	data []byte,
 ) (uint64, error) {
-	return k.ics4Wrapper.SendPacket(ctx, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data)
+	sequence, err := k.ics4Wrapper.SendPacket(ctx, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data)
+	if err != nil {
+		return 0, err
+	}
+
+	if k.IsFeeEnabled(ctx, sourcePort, sourceChannel) && !k.IsLocked(ctx) {
+		k.autoIncentivizePacket(ctx, channeltypes.NewPacketID(sourcePort, sourceChannel, sequence))
+	}
+
+	return sequence, nil
 }
 
 // WriteAcknowledgement wraps IBC ChannelKeeper's WriteAcknowledgement function
@@ -15,6 +15,9 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgPayPacketFeeAsync{}, "cosmos-sdk/MsgPayPacketFeeAsync")
 	legacy.RegisterAminoMsg(cdc, &MsgRegisterPayee{}, "cosmos-sdk/MsgRegisterPayee")
 	legacy.RegisterAminoMsg(cdc, &MsgRegisterCounterpartyPayee{}, "cosmos-sdk/MsgRegisterCounterpartyPayee")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateAutoIncentivePolicy{}, "cosmos-sdk/MsgUpdateAutoIncentivePolicy")
+	legacy.RegisterAminoMsg(cdc, &MsgCancelPacketFee{}, "cosmos-sdk/MsgCancelPacketFee")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateCancellationDelay{}, "cosmos-sdk/MsgUpdateCancellationDelay")
 }
 
 // RegisterInterfaces register the 29-fee module interfaces to protobuf
@@ -26,6 +29,9 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgPayPacketFeeAsync{},
 		&MsgRegisterPayee{},
 		&MsgRegisterCounterpartyPayee{},
+		&MsgUpdateAutoIncentivePolicy{},
+		&MsgCancelPacketFee{},
+		&MsgUpdateCancellationDelay{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -17,4 +17,8 @@ var (
 	ErrRelayerNotFoundForAsyncAck    = errorsmod.Register(ModuleName, 10, "relayer address must be stored for async WriteAcknowledgement")
 	ErrFeeModuleLocked               = errorsmod.Register(ModuleName, 11, "the fee module is currently locked, a severe bug has been detected")
 	ErrUnsupportedAction             = errorsmod.Register(ModuleName, 12, "unsupported action")
+	ErrDenomNotAllowed               = errorsmod.Register(ModuleName, 13, "fee denomination is not allowed")
+	ErrInvalidFeeBudget              = errorsmod.Register(ModuleName, 14, "invalid fee budget")
+	ErrInvalidCancellationDelay      = errorsmod.Register(ModuleName, 15, "invalid cancellation delay")
+	ErrFeeNotCancellable             = errorsmod.Register(ModuleName, 16, "packet fee cannot be cancelled")
 )
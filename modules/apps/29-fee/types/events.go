@@ -6,6 +6,8 @@ const (
 	EventTypeRegisterPayee             = "register_payee"
 	EventTypeRegisterCounterpartyPayee = "register_counterparty_payee"
 	EventTypeDistributeFee             = "distribute_fee"
+	EventTypeFeeBudgetDepleted         = "fee_budget_depleted"
+	EventTypeCancelPacketFee           = "cancel_packet_fee"
 
 	AttributeKeyRecvFee           = "recv_fee"
 	AttributeKeyAckFee            = "ack_fee"
@@ -16,4 +18,6 @@ const (
 	AttributeKeyCounterpartyPayee = "counterparty_payee"
 	AttributeKeyReceiver          = "receiver"
 	AttributeKeyFee               = "fee"
+	AttributeKeyBudget            = "budget"
+	AttributeKeyRefundAddress     = "refund_address"
 )
@@ -0,0 +1,60 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	host "github.com/cosmos/ibc-go/v9/modules/core/24-host"
+	ibcerrors "github.com/cosmos/ibc-go/v9/modules/core/errors"
+)
+
+// NewAutoIncentivePolicy creates and returns a new AutoIncentivePolicy struct
+func NewAutoIncentivePolicy(fee Fee, channels []FeeEnabledChannel, maxPacketsPerBlock uint64) AutoIncentivePolicy {
+	return AutoIncentivePolicy{
+		Fee:                fee,
+		Channels:           channels,
+		MaxPacketsPerBlock: maxPacketsPerBlock,
+	}
+}
+
+// Validate performs basic stateless validation of the associated AutoIncentivePolicy.
+// A policy without channels disables the incentivization of outgoing packets, in which case the fee may be empty and
+// the maximum number of packets per block may be zero.
+func (p AutoIncentivePolicy) Validate() error {
+	seen := make(map[FeeEnabledChannel]bool)
+	for _, channel := range p.Channels {
+		if err := host.PortIdentifierValidator(channel.PortId); err != nil {
+			return errorsmod.Wrap(err, "invalid port ID")
+		}
+
+		if err := host.ChannelIdentifierValidator(channel.ChannelId); err != nil {
+			return errorsmod.Wrap(err, "invalid channel ID")
+		}
+
+		if seen[channel] {
+			return errorsmod.Wrapf(ibcerrors.ErrInvalidRequest, "duplicate channel: port ID (%s) channel ID (%s)", channel.PortId, channel.ChannelId)
+		}
+
+		seen[channel] = true
+	}
+
+	if len(p.Channels) == 0 && p.Fee.RecvFee.Empty() && p.Fee.AckFee.Empty() && p.Fee.TimeoutFee.Empty() {
+		return nil
+	}
+
+	if len(p.Channels) > 0 && p.MaxPacketsPerBlock == 0 {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidRequest, "maximum number of packets per block cannot be zero")
+	}
+
+	return p.Fee.Validate()
+}
+
+// HasChannel returns true if outgoing packets on the channel with the given identifiers are incentivized by the policy.
+func (p AutoIncentivePolicy) HasChannel(portID, channelID string) bool {
+	for _, channel := range p.Channels {
+		if channel.PortId == portID && channel.ChannelId == channelID {
+			return true
+		}
+	}
+
+	return false
+}
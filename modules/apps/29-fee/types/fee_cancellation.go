@@ -0,0 +1,46 @@
+package types
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	channeltypes "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
+)
+
+// DefaultCancellationDelay is the delay after which escrowed packet fees may be cancelled if no delay has been set in state
+const DefaultCancellationDelay = 24 * time.Hour
+
+// ValidateCancellationDelay returns an error if the cancellation delay is not positive.
+// A zero cancellation delay would allow escrowed packet fees to be cancelled immediately.
+func ValidateCancellationDelay(cancellationDelay time.Duration) error {
+	if cancellationDelay <= 0 {
+		return errorsmod.Wrapf(ErrInvalidCancellationDelay, "cancellation delay must be positive: %s", cancellationDelay)
+	}
+
+	return nil
+}
+
+// NewPacketFeeEscrowTime creates and returns a new PacketFeeEscrowTime struct
+func NewPacketFeeEscrowTime(packetID channeltypes.PacketId, refundAddr string, escrowTime time.Time) PacketFeeEscrowTime {
+	return PacketFeeEscrowTime{
+		PacketId:      packetID,
+		RefundAddress: refundAddr,
+		EscrowTime:    escrowTime,
+	}
+}
+
+// Validate performs basic stateless validation of the associated PacketFeeEscrowTime
+func (p PacketFeeEscrowTime) Validate() error {
+	if err := p.PacketId.Validate(); err != nil {
+		return err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(p.RefundAddress); err != nil {
+		return errorsmod.Wrap(err, "failed to convert refund address into sdk.AccAddress")
+	}
+
+	return nil
+}
@@ -0,0 +1,19 @@
+package types
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// FeeConverter defines an optional hook allowing chains to accept fees escrowed in denominations other than
+// the denominations paid to relayers, for example using a price oracle. If a fee converter is set on the fee
+// keeper, fees may only be escrowed in allowed denominations and the fees paid to relayers are converted when
+// they are distributed. Refunds are paid in the escrowed denominations.
+type FeeConverter interface {
+	// IsAllowedDenom returns true if fees may be escrowed in the provided denomination.
+	IsAllowedDenom(ctx context.Context, denom string) bool
+	// ConvertFee converts the fee held in escrow by the fee module account before it is distributed to a relayer
+	// and returns the converted fee. The fee module account must hold the converted fee once ConvertFee returns.
+	ConvertFee(ctx context.Context, fee sdk.Coins) (sdk.Coins, error)
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	"strings"
+	"time"
 
 	errorsmod "cosmossdk.io/errors"
 
@@ -18,6 +19,11 @@ func NewGenesisState(
 	registeredPayees []RegisteredPayee,
 	registeredCounterpartyPayees []RegisteredCounterpartyPayee,
 	forwardRelayers []ForwardRelayerAddress,
+	relayerStats []RelayerStats,
+	relayerPayouts []RelayerPayout,
+	autoIncentivePolicy AutoIncentivePolicy,
+	cancellationDelay time.Duration,
+	feeEscrowTimes []PacketFeeEscrowTime,
 ) *GenesisState {
 	return &GenesisState{
 		IdentifiedFees:               identifiedFees,
@@ -25,6 +31,11 @@ func NewGenesisState(
 		RegisteredPayees:             registeredPayees,
 		RegisteredCounterpartyPayees: registeredCounterpartyPayees,
 		ForwardRelayers:              forwardRelayers,
+		RelayerStats:                 relayerStats,
+		RelayerPayouts:               relayerPayouts,
+		AutoIncentivePolicy:          autoIncentivePolicy,
+		CancellationDelay:            cancellationDelay,
+		FeeEscrowTimes:               feeEscrowTimes,
 	}
 }
 
@@ -36,6 +47,11 @@ func DefaultGenesisState() *GenesisState {
 		FeeEnabledChannels:           []FeeEnabledChannel{},
 		RegisteredPayees:             []RegisteredPayee{},
 		RegisteredCounterpartyPayees: []RegisteredCounterpartyPayee{},
+		RelayerStats:                 []RelayerStats{},
+		RelayerPayouts:               []RelayerPayout{},
+		AutoIncentivePolicy:          AutoIncentivePolicy{},
+		CancellationDelay:            DefaultCancellationDelay,
+		FeeEscrowTimes:               []PacketFeeEscrowTime{},
 	}
 }
 
@@ -110,5 +126,58 @@ func (gs GenesisState) Validate() error {
 		}
 	}
 
+	// Validate RelayerStats
+	packetsRelayed := make(map[string]uint64)
+	for _, stats := range gs.RelayerStats {
+		if err := stats.Validate(); err != nil {
+			return err
+		}
+
+		if _, found := packetsRelayed[stats.RelayerAddress]; found {
+			return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "duplicate relayer stats for address: %s", stats.RelayerAddress)
+		}
+
+		packetsRelayed[stats.RelayerAddress] = stats.PacketsRelayed
+	}
+
+	// Validate RelayerPayouts
+	for _, payout := range gs.RelayerPayouts {
+		if err := payout.Validate(); err != nil {
+			return err
+		}
+
+		count, found := packetsRelayed[payout.RelayerAddress]
+		if !found || payout.Index >= count {
+			return errorsmod.Wrapf(ibcerrors.ErrInvalidRequest, "payout index %d exceeds the number of packets relayed by address: %s", payout.Index, payout.RelayerAddress)
+		}
+	}
+
+	if err := gs.AutoIncentivePolicy.Validate(); err != nil {
+		return err
+	}
+
+	// a zero cancellation delay is unset, as in genesis states exported before the delay was introduced,
+	// and the default cancellation delay is applied on import
+	if gs.CancellationDelay != 0 {
+		if err := ValidateCancellationDelay(gs.CancellationDelay); err != nil {
+			return err
+		}
+	}
+
+	// Validate FeeEscrowTimes
+	seenEscrowTimes := make(map[string]bool)
+	for _, escrowTime := range gs.FeeEscrowTimes {
+		if err := escrowTime.Validate(); err != nil {
+			return err
+		}
+
+		key := string(KeyFeeEscrowTime(escrowTime.PacketId, escrowTime.RefundAddress))
+		if seenEscrowTimes[key] {
+			return errorsmod.Wrapf(ibcerrors.ErrInvalidRequest, "duplicate fee escrow time for packet with portID: %s, channelID: %s, sequence: %d and refund address: %s", escrowTime.PacketId.PortId, escrowTime.PacketId.ChannelId, escrowTime.PacketId.Sequence, escrowTime.RefundAddress)
+		}
+
+		seenEscrowTimes[key] = true
+	}
+
 	return nil
 }
@@ -5,18 +5,25 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	types "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -36,6 +43,16 @@ type GenesisState struct {
 	RegisteredCounterpartyPayees []RegisteredCounterpartyPayee `protobuf:"bytes,4,rep,name=registered_counterparty_payees,json=registeredCounterpartyPayees,proto3" json:"registered_counterparty_payees"`
 	// list of forward relayer addresses
 	ForwardRelayers []ForwardRelayerAddress `protobuf:"bytes,5,rep,name=forward_relayers,json=forwardRelayers,proto3" json:"forward_relayers"`
+	// list of relayer payout statistics
+	RelayerStats []RelayerStats `protobuf:"bytes,6,rep,name=relayer_stats,json=relayerStats,proto3" json:"relayer_stats"`
+	// list of recent relayer payouts
+	RelayerPayouts []RelayerPayout `protobuf:"bytes,7,rep,name=relayer_payouts,json=relayerPayouts,proto3" json:"relayer_payouts"`
+	// the policy used to incentivize outgoing packets from the fee budget
+	AutoIncentivePolicy AutoIncentivePolicy `protobuf:"bytes,8,opt,name=auto_incentive_policy,json=autoIncentivePolicy,proto3" json:"auto_incentive_policy"`
+	// the delay after which escrowed packet fees may be cancelled by their refund address
+	CancellationDelay time.Duration `protobuf:"bytes,9,opt,name=cancellation_delay,json=cancellationDelay,proto3,stdduration" json:"cancellation_delay"`
+	// list of the times at which packet fees were escrowed
+	FeeEscrowTimes []PacketFeeEscrowTime `protobuf:"bytes,10,rep,name=fee_escrow_times,json=feeEscrowTimes,proto3" json:"fee_escrow_times"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -106,6 +123,41 @@ func (m *GenesisState) GetForwardRelayers() []ForwardRelayerAddress {
 	return nil
 }
 
+func (m *GenesisState) GetRelayerStats() []RelayerStats {
+	if m != nil {
+		return m.RelayerStats
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRelayerPayouts() []RelayerPayout {
+	if m != nil {
+		return m.RelayerPayouts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAutoIncentivePolicy() AutoIncentivePolicy {
+	if m != nil {
+		return m.AutoIncentivePolicy
+	}
+	return AutoIncentivePolicy{}
+}
+
+func (m *GenesisState) GetCancellationDelay() time.Duration {
+	if m != nil {
+		return m.CancellationDelay
+	}
+	return 0
+}
+
+func (m *GenesisState) GetFeeEscrowTimes() []PacketFeeEscrowTime {
+	if m != nil {
+		return m.FeeEscrowTimes
+	}
+	return nil
+}
+
 // FeeEnabledChannel contains the PortID & ChannelID for a fee enabled channel
 type FeeEnabledChannel struct {
 	// unique port identifier
@@ -161,6 +213,71 @@ func (m *FeeEnabledChannel) GetChannelId() string {
 	return ""
 }
 
+// AutoIncentivePolicy defines the fee which is escrowed from the fee budget for every packet sent on the selected
+// channels
+type AutoIncentivePolicy struct {
+	// the fee escrowed for every packet sent on the selected channels
+	Fee Fee `protobuf:"bytes,1,opt,name=fee,proto3" json:"fee"`
+	// list of channels on which outgoing packets are incentivized
+	Channels []FeeEnabledChannel `protobuf:"bytes,2,rep,name=channels,proto3" json:"channels"`
+	// maximum number of outgoing packets incentivized from the fee budget in a single block
+	MaxPacketsPerBlock uint64 `protobuf:"varint,3,opt,name=max_packets_per_block,json=maxPacketsPerBlock,proto3" json:"max_packets_per_block,omitempty"`
+}
+
+func (m *AutoIncentivePolicy) Reset()         { *m = AutoIncentivePolicy{} }
+func (m *AutoIncentivePolicy) String() string { return proto.CompactTextString(m) }
+func (*AutoIncentivePolicy) ProtoMessage()    {}
+func (*AutoIncentivePolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7191992e856dff95, []int{2}
+}
+func (m *AutoIncentivePolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AutoIncentivePolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AutoIncentivePolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AutoIncentivePolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AutoIncentivePolicy.Merge(m, src)
+}
+func (m *AutoIncentivePolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *AutoIncentivePolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_AutoIncentivePolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AutoIncentivePolicy proto.InternalMessageInfo
+
+func (m *AutoIncentivePolicy) GetFee() Fee {
+	if m != nil {
+		return m.Fee
+	}
+	return Fee{}
+}
+
+func (m *AutoIncentivePolicy) GetChannels() []FeeEnabledChannel {
+	if m != nil {
+		return m.Channels
+	}
+	return nil
+}
+
+func (m *AutoIncentivePolicy) GetMaxPacketsPerBlock() uint64 {
+	if m != nil {
+		return m.MaxPacketsPerBlock
+	}
+	return 0
+}
+
 // RegisteredPayee contains the relayer address and payee address for a specific channel
 type RegisteredPayee struct {
 	// unique channel identifier
@@ -175,7 +292,7 @@ func (m *RegisteredPayee) Reset()         { *m = RegisteredPayee{} }
 func (m *RegisteredPayee) String() string { return proto.CompactTextString(m) }
 func (*RegisteredPayee) ProtoMessage()    {}
 func (*RegisteredPayee) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7191992e856dff95, []int{2}
+	return fileDescriptor_7191992e856dff95, []int{3}
 }
 func (m *RegisteredPayee) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -240,7 +357,7 @@ func (m *RegisteredCounterpartyPayee) Reset()         { *m = RegisteredCounterpa
 func (m *RegisteredCounterpartyPayee) String() string { return proto.CompactTextString(m) }
 func (*RegisteredCounterpartyPayee) ProtoMessage()    {}
 func (*RegisteredCounterpartyPayee) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7191992e856dff95, []int{3}
+	return fileDescriptor_7191992e856dff95, []int{4}
 }
 func (m *RegisteredCounterpartyPayee) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -302,7 +419,7 @@ func (m *ForwardRelayerAddress) Reset()         { *m = ForwardRelayerAddress{} }
 func (m *ForwardRelayerAddress) String() string { return proto.CompactTextString(m) }
 func (*ForwardRelayerAddress) ProtoMessage()    {}
 func (*ForwardRelayerAddress) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7191992e856dff95, []int{4}
+	return fileDescriptor_7191992e856dff95, []int{5}
 }
 func (m *ForwardRelayerAddress) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -345,12 +462,226 @@ func (m *ForwardRelayerAddress) GetPacketId() types.PacketId {
 	return types.PacketId{}
 }
 
+// RelayerStats contains the fee payout statistics of a relayer address
+type RelayerStats struct {
+	// the address to which the fees were paid out
+	RelayerAddress string `protobuf:"bytes,1,opt,name=relayer_address,json=relayerAddress,proto3" json:"relayer_address,omitempty"`
+	// the number of packets for which fees were paid out to the relayer address
+	PacketsRelayed uint64 `protobuf:"varint,2,opt,name=packets_relayed,json=packetsRelayed,proto3" json:"packets_relayed,omitempty"`
+	// the total fees paid out to the relayer address
+	FeesEarned github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=fees_earned,json=feesEarned,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fees_earned"`
+}
+
+func (m *RelayerStats) Reset()         { *m = RelayerStats{} }
+func (m *RelayerStats) String() string { return proto.CompactTextString(m) }
+func (*RelayerStats) ProtoMessage()    {}
+func (*RelayerStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7191992e856dff95, []int{6}
+}
+func (m *RelayerStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RelayerStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RelayerStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RelayerStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RelayerStats.Merge(m, src)
+}
+func (m *RelayerStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *RelayerStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_RelayerStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RelayerStats proto.InternalMessageInfo
+
+func (m *RelayerStats) GetRelayerAddress() string {
+	if m != nil {
+		return m.RelayerAddress
+	}
+	return ""
+}
+
+func (m *RelayerStats) GetPacketsRelayed() uint64 {
+	if m != nil {
+		return m.PacketsRelayed
+	}
+	return 0
+}
+
+func (m *RelayerStats) GetFeesEarned() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.FeesEarned
+	}
+	return nil
+}
+
+// RelayerPayout contains the fees paid out to a relayer address for relaying a packet
+type RelayerPayout struct {
+	// the address to which the fees were paid out
+	RelayerAddress string `protobuf:"bytes,1,opt,name=relayer_address,json=relayerAddress,proto3" json:"relayer_address,omitempty"`
+	// the index of the payout in the payout history of the relayer address
+	Index uint64 `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	// unique packet identifier comprised of the channel ID, port ID and sequence
+	PacketId types.PacketId `protobuf:"bytes,3,opt,name=packet_id,json=packetId,proto3" json:"packet_id"`
+	// the fees paid out for relaying the packet
+	Fee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=fee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee"`
+	// the block height at which the fees were paid out
+	Height int64 `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *RelayerPayout) Reset()         { *m = RelayerPayout{} }
+func (m *RelayerPayout) String() string { return proto.CompactTextString(m) }
+func (*RelayerPayout) ProtoMessage()    {}
+func (*RelayerPayout) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7191992e856dff95, []int{7}
+}
+func (m *RelayerPayout) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RelayerPayout) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RelayerPayout.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RelayerPayout) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RelayerPayout.Merge(m, src)
+}
+func (m *RelayerPayout) XXX_Size() int {
+	return m.Size()
+}
+func (m *RelayerPayout) XXX_DiscardUnknown() {
+	xxx_messageInfo_RelayerPayout.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RelayerPayout proto.InternalMessageInfo
+
+func (m *RelayerPayout) GetRelayerAddress() string {
+	if m != nil {
+		return m.RelayerAddress
+	}
+	return ""
+}
+
+func (m *RelayerPayout) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *RelayerPayout) GetPacketId() types.PacketId {
+	if m != nil {
+		return m.PacketId
+	}
+	return types.PacketId{}
+}
+
+func (m *RelayerPayout) GetFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Fee
+	}
+	return nil
+}
+
+func (m *RelayerPayout) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// PacketFeeEscrowTime contains the time at which packet fees were last escrowed by a refund address for a packet
+type PacketFeeEscrowTime struct {
+	// unique packet identifier comprised of the channel ID, port ID and sequence
+	PacketId types.PacketId `protobuf:"bytes,1,opt,name=packet_id,json=packetId,proto3" json:"packet_id"`
+	// the refund address of the escrowed packet fees
+	RefundAddress string `protobuf:"bytes,2,opt,name=refund_address,json=refundAddress,proto3" json:"refund_address,omitempty"`
+	// the block time at which packet fees were last escrowed by the refund address
+	EscrowTime time.Time `protobuf:"bytes,3,opt,name=escrow_time,json=escrowTime,proto3,stdtime" json:"escrow_time"`
+}
+
+func (m *PacketFeeEscrowTime) Reset()         { *m = PacketFeeEscrowTime{} }
+func (m *PacketFeeEscrowTime) String() string { return proto.CompactTextString(m) }
+func (*PacketFeeEscrowTime) ProtoMessage()    {}
+func (*PacketFeeEscrowTime) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7191992e856dff95, []int{8}
+}
+func (m *PacketFeeEscrowTime) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PacketFeeEscrowTime) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PacketFeeEscrowTime.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PacketFeeEscrowTime) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PacketFeeEscrowTime.Merge(m, src)
+}
+func (m *PacketFeeEscrowTime) XXX_Size() int {
+	return m.Size()
+}
+func (m *PacketFeeEscrowTime) XXX_DiscardUnknown() {
+	xxx_messageInfo_PacketFeeEscrowTime.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PacketFeeEscrowTime proto.InternalMessageInfo
+
+func (m *PacketFeeEscrowTime) GetPacketId() types.PacketId {
+	if m != nil {
+		return m.PacketId
+	}
+	return types.PacketId{}
+}
+
+func (m *PacketFeeEscrowTime) GetRefundAddress() string {
+	if m != nil {
+		return m.RefundAddress
+	}
+	return ""
+}
+
+func (m *PacketFeeEscrowTime) GetEscrowTime() time.Time {
+	if m != nil {
+		return m.EscrowTime
+	}
+	return time.Time{}
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "ibc.applications.fee.v1.GenesisState")
 	proto.RegisterType((*FeeEnabledChannel)(nil), "ibc.applications.fee.v1.FeeEnabledChannel")
+	proto.RegisterType((*AutoIncentivePolicy)(nil), "ibc.applications.fee.v1.AutoIncentivePolicy")
 	proto.RegisterType((*RegisteredPayee)(nil), "ibc.applications.fee.v1.RegisteredPayee")
 	proto.RegisterType((*RegisteredCounterpartyPayee)(nil), "ibc.applications.fee.v1.RegisteredCounterpartyPayee")
 	proto.RegisterType((*ForwardRelayerAddress)(nil), "ibc.applications.fee.v1.ForwardRelayerAddress")
+	proto.RegisterType((*RelayerStats)(nil), "ibc.applications.fee.v1.RelayerStats")
+	proto.RegisterType((*RelayerPayout)(nil), "ibc.applications.fee.v1.RelayerPayout")
+	proto.RegisterType((*PacketFeeEscrowTime)(nil), "ibc.applications.fee.v1.PacketFeeEscrowTime")
 }
 
 func init() {
@@ -358,42 +689,72 @@ func init() {
 }
 
 var fileDescriptor_7191992e856dff95 = []byte{
-	// 550 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xcf, 0x6f, 0xd3, 0x30,
-	0x14, 0x6e, 0xf6, 0x93, 0x7a, 0x88, 0xae, 0x56, 0xd1, 0xa2, 0xc1, 0xc2, 0xa8, 0x84, 0x54, 0x21,
-	0x35, 0x51, 0x0b, 0x1c, 0x76, 0x03, 0x26, 0x86, 0x2a, 0x0e, 0x4c, 0xe5, 0x06, 0x48, 0x21, 0xb1,
-	0x5f, 0x32, 0x8b, 0x36, 0x8e, 0x6c, 0xb7, 0xa8, 0x37, 0x2e, 0xdc, 0xe1, 0xbf, 0xda, 0x71, 0x47,
-	0x4e, 0x08, 0xb5, 0xff, 0x08, 0xb2, 0xe3, 0x8c, 0xae, 0x23, 0x08, 0x71, 0xf3, 0x7b, 0xef, 0xfb,
-	0xde, 0x67, 0x7f, 0x4f, 0xcf, 0xe8, 0x01, 0x8b, 0x49, 0x10, 0xe5, 0xf9, 0x88, 0x91, 0x48, 0x31,
-	0x9e, 0xc9, 0x20, 0x01, 0x08, 0xa6, 0xbd, 0x20, 0x85, 0x0c, 0x24, 0x93, 0x7e, 0x2e, 0xb8, 0xe2,
-	0x78, 0x8f, 0xc5, 0xc4, 0x5f, 0x86, 0xf9, 0x09, 0x80, 0x3f, 0xed, 0xed, 0xb7, 0x52, 0x9e, 0x72,
-	0x83, 0x09, 0xf4, 0xa9, 0x80, 0xef, 0xdf, 0xaf, 0xea, 0xaa, 0x59, 0x4b, 0x10, 0xc2, 0x05, 0x04,
-	0xe4, 0x2c, 0xca, 0x32, 0x18, 0xe9, 0xb2, 0x3d, 0x16, 0x90, 0xf6, 0xb7, 0x0d, 0x74, 0xf3, 0x65,
-	0x71, 0x8d, 0x37, 0x2a, 0x52, 0x80, 0xdf, 0xa3, 0x06, 0xa3, 0x90, 0x29, 0x96, 0x30, 0xa0, 0x61,
-	0x02, 0x20, 0x5d, 0xe7, 0x70, 0xbd, 0xb3, 0xd3, 0xef, 0xfa, 0x15, 0xf7, 0xf3, 0x07, 0x97, 0xf8,
-	0xd3, 0x88, 0x7c, 0x04, 0x75, 0x02, 0x20, 0x9f, 0x6f, 0x9c, 0xff, 0xb8, 0x57, 0x1b, 0xde, 0xfa,
-	0xdd, 0x4b, 0x67, 0x71, 0x8c, 0x5a, 0x09, 0x40, 0x08, 0x59, 0x14, 0x8f, 0x80, 0x86, 0xf6, 0x2e,
-	0xd2, 0x5d, 0x33, 0x12, 0x0f, 0x2b, 0x25, 0x4e, 0x00, 0x5e, 0x14, 0x9c, 0xe3, 0x82, 0x62, 0xfb,
-	0xe3, 0x64, 0xb5, 0x20, 0xf1, 0x3b, 0xd4, 0x14, 0x90, 0x32, 0xa9, 0x40, 0x00, 0x0d, 0xf3, 0x68,
-	0xa6, 0xdf, 0xb0, 0x6e, 0x04, 0x3a, 0x95, 0x02, 0xc3, 0x4b, 0xc6, 0xa9, 0x26, 0xd8, 0xf6, 0xbb,
-	0xe2, 0x6a, 0x5a, 0xe2, 0xcf, 0x0e, 0xf2, 0x96, 0xba, 0x13, 0x3e, 0xc9, 0x14, 0x88, 0x3c, 0x12,
-	0x6a, 0x56, 0x4a, 0x6d, 0x18, 0xa9, 0xc7, 0xff, 0x20, 0x75, 0xbc, 0xc4, 0x5e, 0x96, 0xbd, 0x2b,
-	0xaa, 0x21, 0x12, 0x87, 0x68, 0x37, 0xe1, 0xe2, 0x53, 0x24, 0x68, 0x28, 0x60, 0x14, 0xcd, 0x40,
-	0x48, 0x77, 0xd3, 0x68, 0xfa, 0xd5, 0xfe, 0x15, 0x84, 0x61, 0x81, 0x7f, 0x46, 0xa9, 0x00, 0x59,
-	0xce, 0xa8, 0x91, 0x5c, 0x29, 0xca, 0xf6, 0x2b, 0xd4, 0xbc, 0xe6, 0x37, 0xde, 0x43, 0xdb, 0x39,
-	0x17, 0x2a, 0x64, 0xd4, 0x75, 0x0e, 0x9d, 0x4e, 0x7d, 0xb8, 0xa5, 0xc3, 0x01, 0xc5, 0x07, 0x08,
-	0xd9, 0x31, 0xea, 0xda, 0x9a, 0xa9, 0xd5, 0x6d, 0x66, 0x40, 0xdb, 0x1f, 0x50, 0x63, 0xc5, 0xdb,
-	0x15, 0x86, 0xb3, 0xc2, 0xc0, 0x2e, 0xda, 0xb6, 0xef, 0xb2, 0xdd, 0xca, 0x10, 0xb7, 0xd0, 0xa6,
-	0xf1, 0xd8, 0x5d, 0x37, 0xf9, 0x22, 0x68, 0x7f, 0x71, 0xd0, 0x9d, 0xbf, 0x78, 0xfa, 0xff, 0x72,
-	0x5d, 0x84, 0xaf, 0xcf, 0xd7, 0x6a, 0x37, 0xc9, 0xaa, 0x4e, 0x5b, 0xa2, 0xdb, 0x7f, 0xb4, 0x59,
-	0x2b, 0x44, 0xc5, 0xd1, 0xaa, 0x97, 0x21, 0x7e, 0x8a, 0xea, 0xb9, 0x59, 0x99, 0xd2, 0xba, 0x9d,
-	0xfe, 0x81, 0x99, 0xa1, 0x5e, 0x5a, 0xbf, 0xdc, 0xd4, 0x69, 0xcf, 0x2f, 0x16, 0x6b, 0x40, 0xed,
-	0xc8, 0x6e, 0xe4, 0x65, 0xfc, 0xfa, 0x7c, 0xee, 0x39, 0x17, 0x73, 0xcf, 0xf9, 0x39, 0xf7, 0x9c,
-	0xaf, 0x0b, 0xaf, 0x76, 0xb1, 0xf0, 0x6a, 0xdf, 0x17, 0x5e, 0xed, 0xed, 0x93, 0x94, 0xa9, 0xb3,
-	0x49, 0xec, 0x13, 0x3e, 0x0e, 0x08, 0x97, 0x63, 0x2e, 0x03, 0x16, 0x93, 0x6e, 0xca, 0x83, 0xe9,
-	0x51, 0x30, 0xe6, 0x74, 0x32, 0x02, 0xa9, 0xff, 0x0f, 0x19, 0xf4, 0x8f, 0xba, 0xfa, 0xeb, 0x50,
-	0xb3, 0x1c, 0x64, 0xbc, 0x65, 0xfe, 0x85, 0x47, 0xbf, 0x02, 0x00, 0x00, 0xff, 0xff, 0x42, 0x7b,
-	0x2e, 0xd1, 0xb5, 0x04, 0x00, 0x00,
+	// 1028 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xcf, 0xc6, 0xf9, 0x3b, 0xce, 0xdf, 0x49, 0x42, 0xb7, 0xa1, 0x75, 0x8c, 0xa5, 0x50, 0x0b,
+	0x91, 0x5d, 0x12, 0xca, 0xa1, 0x37, 0x9a, 0x34, 0x45, 0x16, 0x48, 0x58, 0x4b, 0xb9, 0x40, 0xd1,
+	0x32, 0xbb, 0xfb, 0xd6, 0x59, 0x65, 0xbd, 0xb3, 0x9a, 0x19, 0xa7, 0xf1, 0x8d, 0x0b, 0xe2, 0xda,
+	0x23, 0x9f, 0x81, 0xef, 0x81, 0xd4, 0x03, 0x42, 0x3d, 0x70, 0xe0, 0x44, 0x51, 0xf2, 0x45, 0xd0,
+	0xfc, 0x59, 0x67, 0x6d, 0xc7, 0xa1, 0x0a, 0x9c, 0xec, 0x99, 0xf7, 0xde, 0xef, 0xf7, 0xe6, 0xcd,
+	0xfb, 0xbd, 0x59, 0xb4, 0x9b, 0x04, 0xa1, 0x4b, 0xf2, 0x3c, 0x4d, 0x42, 0x22, 0x12, 0x9a, 0x71,
+	0x37, 0x06, 0x70, 0xcf, 0xf6, 0xdd, 0x0e, 0x64, 0xc0, 0x13, 0xee, 0xe4, 0x8c, 0x0a, 0x8a, 0xef,
+	0x24, 0x41, 0xe8, 0x94, 0xdd, 0x9c, 0x18, 0xc0, 0x39, 0xdb, 0xdf, 0xde, 0xec, 0xd0, 0x0e, 0x55,
+	0x3e, 0xae, 0xfc, 0xa7, 0xdd, 0xb7, 0x6b, 0x21, 0xe5, 0x5d, 0xca, 0xdd, 0x80, 0x70, 0x09, 0x16,
+	0x80, 0x20, 0xfb, 0x6e, 0x48, 0x93, 0xac, 0xb0, 0x77, 0x28, 0xed, 0xa4, 0xe0, 0xaa, 0x55, 0xd0,
+	0x8b, 0xdd, 0xa8, 0xc7, 0x14, 0xae, 0xb1, 0xef, 0x8c, 0xda, 0x45, 0xd2, 0x05, 0x2e, 0x48, 0x37,
+	0x37, 0x0e, 0xef, 0x4d, 0x4a, 0x5b, 0xa6, 0x55, 0x72, 0x09, 0x29, 0x03, 0x37, 0x3c, 0x21, 0x59,
+	0x06, 0xa9, 0x34, 0x9b, 0xbf, 0xda, 0xa5, 0xf1, 0xc7, 0x3c, 0x5a, 0xfa, 0x4c, 0x9f, 0xf3, 0x2b,
+	0x41, 0x04, 0xe0, 0xe7, 0x68, 0x35, 0x89, 0x20, 0x13, 0x49, 0x9c, 0x40, 0xe4, 0xc7, 0x00, 0xdc,
+	0xb6, 0xea, 0x95, 0x66, 0xf5, 0x60, 0xcf, 0x99, 0x50, 0x00, 0xa7, 0x35, 0xf0, 0x6f, 0x93, 0xf0,
+	0x14, 0xc4, 0x53, 0x00, 0x7e, 0x38, 0xf3, 0xea, 0xaf, 0x9d, 0x29, 0x6f, 0xe5, 0x0a, 0x4b, 0xee,
+	0xe2, 0x00, 0x6d, 0xc6, 0x00, 0x3e, 0x64, 0x24, 0x48, 0x21, 0xf2, 0x4d, 0x2e, 0xdc, 0x9e, 0x56,
+	0x14, 0x1f, 0x4c, 0xa4, 0x78, 0x0a, 0x70, 0xac, 0x63, 0x8e, 0x74, 0x88, 0xc1, 0xc7, 0xf1, 0xa8,
+	0x81, 0xe3, 0x6f, 0xd1, 0x3a, 0x83, 0x4e, 0xc2, 0x05, 0x30, 0x88, 0xfc, 0x9c, 0xf4, 0xe5, 0x19,
+	0x2a, 0x8a, 0xa0, 0x39, 0x91, 0xc0, 0x1b, 0x44, 0xb4, 0x65, 0x80, 0x81, 0x5f, 0x63, 0xc3, 0xdb,
+	0x1c, 0xff, 0x60, 0xa1, 0x5a, 0x09, 0x3d, 0xa4, 0xbd, 0x4c, 0x00, 0xcb, 0x09, 0x13, 0xfd, 0x82,
+	0x6a, 0x46, 0x51, 0x3d, 0x7c, 0x0b, 0xaa, 0xa3, 0x52, 0x74, 0x99, 0xf6, 0x1e, 0x9b, 0xec, 0xc2,
+	0xb1, 0x8f, 0xd6, 0x62, 0xca, 0x5e, 0x10, 0x16, 0xf9, 0x0c, 0x52, 0xd2, 0x07, 0xc6, 0xed, 0x59,
+	0xc5, 0xe9, 0x4c, 0xae, 0x9f, 0x0e, 0xf0, 0xb4, 0xff, 0xe3, 0x28, 0x62, 0xc0, 0x8b, 0x3b, 0x5a,
+	0x8d, 0x87, 0x8c, 0x1c, 0xb7, 0xd1, 0xb2, 0x01, 0xf6, 0xb9, 0x20, 0x82, 0xdb, 0x73, 0x0a, 0x7d,
+	0xf7, 0x86, 0x13, 0x29, 0x6f, 0xd9, 0x40, 0x05, 0xe8, 0x12, 0x2b, 0xed, 0xe1, 0xaf, 0xd1, 0x6a,
+	0x81, 0x98, 0x93, 0x3e, 0xed, 0x09, 0x6e, 0xcf, 0x2b, 0xcc, 0xf7, 0xff, 0x0d, 0xb3, 0xad, 0xdc,
+	0x8b, 0x6e, 0x62, 0xe5, 0x4d, 0x8e, 0x63, 0xb4, 0x45, 0x7a, 0x82, 0xfa, 0x49, 0x16, 0xca, 0x2e,
+	0x3b, 0x03, 0x3f, 0xa7, 0x69, 0x12, 0xf6, 0xed, 0x85, 0xba, 0xd5, 0xac, 0x1e, 0x7c, 0x38, 0x11,
+	0xfc, 0x71, 0x4f, 0xd0, 0x56, 0x11, 0xd4, 0x56, 0x31, 0x86, 0x62, 0x83, 0x8c, 0x9b, 0xb0, 0x87,
+	0x70, 0x48, 0xb2, 0x10, 0xd2, 0x54, 0xc1, 0xf8, 0x91, 0x4c, 0xc3, 0x5e, 0x54, 0x24, 0x77, 0x1d,
+	0x2d, 0x54, 0xa7, 0x10, 0xaa, 0xf3, 0xc4, 0x08, 0xf9, 0x70, 0x41, 0x22, 0xfe, 0xfc, 0x66, 0xc7,
+	0xf2, 0xd6, 0xcb, 0xe1, 0x4f, 0x64, 0x34, 0x7e, 0x8e, 0xd6, 0x94, 0x12, 0x78, 0xc8, 0xe8, 0x0b,
+	0x5f, 0x89, 0xdb, 0x46, 0xf5, 0xca, 0x8d, 0x69, 0x0f, 0xe4, 0x75, 0xac, 0xa2, 0x9e, 0x25, 0xdd,
+	0xa2, 0x63, 0x56, 0xe2, 0xf2, 0x26, 0x6f, 0x7c, 0x8e, 0xd6, 0xc7, 0x24, 0x83, 0xef, 0xa0, 0xf9,
+	0x9c, 0x32, 0xe1, 0x27, 0x91, 0x6d, 0xd5, 0xad, 0xe6, 0xa2, 0x37, 0x27, 0x97, 0xad, 0x08, 0xdf,
+	0x47, 0xc8, 0x28, 0x51, 0xda, 0xa6, 0x95, 0x6d, 0xd1, 0xec, 0xb4, 0xa2, 0xc6, 0x6f, 0x16, 0xda,
+	0xb8, 0xa6, 0x62, 0xf8, 0x21, 0xaa, 0xc4, 0x00, 0x0a, 0xab, 0x7a, 0x70, 0xef, 0x26, 0xed, 0x9a,
+	0x2c, 0xa5, 0x3b, 0xfe, 0x02, 0x2d, 0xfc, 0x67, 0xd9, 0x0f, 0x10, 0xf0, 0x3e, 0xda, 0xea, 0x92,
+	0x73, 0x3f, 0x57, 0x95, 0xe1, 0x7e, 0x0e, 0xcc, 0x0f, 0x52, 0x1a, 0x9e, 0xda, 0x95, 0xba, 0xd5,
+	0x9c, 0xf1, 0x70, 0x97, 0x9c, 0xeb, 0xaa, 0xf1, 0x36, 0xb0, 0x43, 0x69, 0x69, 0x7c, 0x8f, 0x56,
+	0x47, 0xd4, 0x3e, 0x52, 0x00, 0x6b, 0xa4, 0x00, 0xd8, 0x46, 0xf3, 0xa6, 0xf3, 0x4c, 0x71, 0x8a,
+	0x25, 0xde, 0x44, 0xb3, 0x4a, 0xf5, 0x8a, 0x6e, 0xd1, 0xd3, 0x8b, 0xc6, 0x8f, 0x16, 0x7a, 0xf7,
+	0x06, 0x95, 0xdf, 0x9e, 0x6e, 0x0f, 0xe1, 0xf1, 0x89, 0x63, 0xb8, 0xd7, 0xc3, 0x51, 0x9e, 0x06,
+	0x47, 0x5b, 0xd7, 0x0a, 0x5f, 0x32, 0x10, 0xfd, 0xd7, 0xb0, 0x17, 0x4b, 0xfc, 0x29, 0x5a, 0xd4,
+	0xb5, 0x2c, 0x3a, 0xa1, 0x7a, 0x70, 0x5f, 0x5d, 0x8f, 0x7c, 0x46, 0x9c, 0xe2, 0xed, 0x18, 0xf4,
+	0x62, 0x2b, 0x2a, 0x6e, 0x24, 0x37, 0xeb, 0xc6, 0xef, 0x16, 0x5a, 0x2a, 0x0f, 0x04, 0xfc, 0xe0,
+	0x4a, 0xfc, 0xc3, 0xa4, 0x2b, 0x6c, 0x38, 0xab, 0x07, 0x68, 0xb5, 0xb8, 0x47, 0x6d, 0xd1, 0x19,
+	0xcc, 0x78, 0x2b, 0x66, 0x5b, 0xc3, 0x46, 0x38, 0x45, 0x55, 0xf9, 0x30, 0xf9, 0x40, 0x58, 0x06,
+	0x91, 0x99, 0xed, 0x77, 0x1d, 0xfd, 0xe2, 0x3a, 0xf2, 0xc5, 0x75, 0xcc, 0x8b, 0xeb, 0x1c, 0xd1,
+	0x24, 0x3b, 0xfc, 0x48, 0xa6, 0xf8, 0xcb, 0x9b, 0x9d, 0x66, 0x27, 0x11, 0x27, 0xbd, 0xc0, 0x09,
+	0x69, 0xd7, 0x35, 0xcf, 0xb3, 0xfe, 0xd9, 0xe3, 0xd1, 0xa9, 0x2b, 0xfa, 0x39, 0x70, 0x15, 0xc0,
+	0x3d, 0x24, 0xf1, 0x8f, 0x15, 0x7c, 0xe3, 0xa7, 0x69, 0xb4, 0x3c, 0x34, 0x8d, 0xde, 0xfe, 0x44,
+	0x9b, 0x68, 0x36, 0xc9, 0x22, 0x38, 0x37, 0xe7, 0xd0, 0x8b, 0xe1, 0x1a, 0x57, 0x6e, 0x51, 0x63,
+	0xfc, 0x9d, 0x56, 0xde, 0xcc, 0xff, 0x7f, 0x70, 0x25, 0xd1, 0x77, 0xd0, 0xdc, 0x09, 0x24, 0x9d,
+	0x13, 0x61, 0xcf, 0xd6, 0xad, 0x66, 0xc5, 0x33, 0xab, 0xc6, 0xaf, 0x16, 0xda, 0xb8, 0x66, 0x06,
+	0x0d, 0x1f, 0xc8, 0xba, 0xcd, 0x81, 0x76, 0xd1, 0x0a, 0x83, 0xb8, 0x97, 0x45, 0x83, 0x82, 0xea,
+	0xce, 0x5f, 0xd6, 0xbb, 0x45, 0x3d, 0x8f, 0x51, 0xb5, 0x34, 0x30, 0x4d, 0xed, 0xb6, 0xc7, 0x26,
+	0xf0, 0xb3, 0xe2, 0x53, 0x49, 0x8f, 0xe0, 0x97, 0x72, 0x04, 0x23, 0xb8, 0x9a, 0x99, 0x5f, 0xbe,
+	0xba, 0xa8, 0x59, 0xaf, 0x2f, 0x6a, 0xd6, 0xdf, 0x17, 0x35, 0xeb, 0xe5, 0x65, 0x6d, 0xea, 0xf5,
+	0x65, 0x6d, 0xea, 0xcf, 0xcb, 0xda, 0xd4, 0x37, 0x9f, 0x8c, 0x17, 0x2a, 0x09, 0xc2, 0xbd, 0x0e,
+	0x75, 0xcf, 0x1e, 0xb9, 0x5d, 0x1a, 0xf5, 0x52, 0xe0, 0xf2, 0xa3, 0x8b, 0xbb, 0x07, 0x8f, 0xf6,
+	0xe4, 0xf7, 0x96, 0xaa, 0x5d, 0x30, 0xa7, 0xa8, 0x3f, 0xfe, 0x67, 0x00, 0x95, 0x61, 0xe9, 0x39,
+	0x4b, 0x0a, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -416,6 +777,66 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.FeeEscrowTimes) > 0 {
+		for iNdEx := len(m.FeeEscrowTimes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeEscrowTimes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.CancellationDelay, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.CancellationDelay):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintGenesis(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x4a
+	{
+		size, err := m.AutoIncentivePolicy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if len(m.RelayerPayouts) > 0 {
+		for iNdEx := len(m.RelayerPayouts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RelayerPayouts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.RelayerStats) > 0 {
+		for iNdEx := len(m.RelayerStats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RelayerStats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.ForwardRelayers) > 0 {
 		for iNdEx := len(m.ForwardRelayers) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -526,7 +947,7 @@ func (m *FeeEnabledChannel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *RegisteredPayee) Marshal() (dAtA []byte, err error) {
+func (m *AutoIncentivePolicy) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -536,41 +957,93 @@ func (m *RegisteredPayee) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RegisteredPayee) MarshalTo(dAtA []byte) (int, error) {
+func (m *AutoIncentivePolicy) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RegisteredPayee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AutoIncentivePolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Payee) > 0 {
-		i -= len(m.Payee)
-		copy(dAtA[i:], m.Payee)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Payee)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Relayer) > 0 {
-		i -= len(m.Relayer)
-		copy(dAtA[i:], m.Relayer)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Relayer)))
+	if m.MaxPacketsPerBlock != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxPacketsPerBlock))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x18
 	}
-	if len(m.ChannelId) > 0 {
-		i -= len(m.ChannelId)
-		copy(dAtA[i:], m.ChannelId)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ChannelId)))
-		i--
-		dAtA[i] = 0xa
+	if len(m.Channels) > 0 {
+		for iNdEx := len(m.Channels) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Channels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *RegisteredCounterpartyPayee) Marshal() (dAtA []byte, err error) {
+	{
+		size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *RegisteredPayee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RegisteredPayee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RegisteredPayee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Payee) > 0 {
+		i -= len(m.Payee)
+		copy(dAtA[i:], m.Payee)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Payee)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Relayer) > 0 {
+		i -= len(m.Relayer)
+		copy(dAtA[i:], m.Relayer)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Relayer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RegisteredCounterpartyPayee) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -654,6 +1127,167 @@ func (m *ForwardRelayerAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RelayerStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RelayerStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RelayerStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FeesEarned) > 0 {
+		for iNdEx := len(m.FeesEarned) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeesEarned[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.PacketsRelayed != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.PacketsRelayed))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.RelayerAddress) > 0 {
+		i -= len(m.RelayerAddress)
+		copy(dAtA[i:], m.RelayerAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.RelayerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RelayerPayout) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RelayerPayout) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RelayerPayout) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Fee) > 0 {
+		for iNdEx := len(m.Fee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size, err := m.PacketId.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Index != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.RelayerAddress) > 0 {
+		i -= len(m.RelayerAddress)
+		copy(dAtA[i:], m.RelayerAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.RelayerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PacketFeeEscrowTime) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PacketFeeEscrowTime) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PacketFeeEscrowTime) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EscrowTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EscrowTime):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintGenesis(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.RefundAddress) > 0 {
+		i -= len(m.RefundAddress)
+		copy(dAtA[i:], m.RefundAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.RefundAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.PacketId.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -701,6 +1335,28 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.RelayerStats) > 0 {
+		for _, e := range m.RelayerStats {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RelayerPayouts) > 0 {
+		for _, e := range m.RelayerPayouts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.AutoIncentivePolicy.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.CancellationDelay)
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.FeeEscrowTimes) > 0 {
+		for _, e := range m.FeeEscrowTimes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -721,6 +1377,26 @@ func (m *FeeEnabledChannel) Size() (n int) {
 	return n
 }
 
+func (m *AutoIncentivePolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Fee.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Channels) > 0 {
+		for _, e := range m.Channels {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.MaxPacketsPerBlock != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxPacketsPerBlock))
+	}
+	return n
+}
+
 func (m *RegisteredPayee) Size() (n int) {
 	if m == nil {
 		return 0
@@ -778,6 +1454,72 @@ func (m *ForwardRelayerAddress) Size() (n int) {
 	return n
 }
 
+func (m *RelayerStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RelayerAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.PacketsRelayed != 0 {
+		n += 1 + sovGenesis(uint64(m.PacketsRelayed))
+	}
+	if len(m.FeesEarned) > 0 {
+		for _, e := range m.FeesEarned {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RelayerPayout) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RelayerAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Index != 0 {
+		n += 1 + sovGenesis(uint64(m.Index))
+	}
+	l = m.PacketId.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Fee) > 0 {
+		for _, e := range m.Fee {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.Height != 0 {
+		n += 1 + sovGenesis(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *PacketFeeEscrowTime) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PacketId.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = len(m.RefundAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EscrowTime)
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
 func sovGenesis(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -873,19 +1615,735 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			if postIndex > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeEnabledChannels = append(m.FeeEnabledChannels, FeeEnabledChannel{})
+			if err := m.FeeEnabledChannels[len(m.FeeEnabledChannels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegisteredPayees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RegisteredPayees = append(m.RegisteredPayees, RegisteredPayee{})
+			if err := m.RegisteredPayees[len(m.RegisteredPayees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegisteredCounterpartyPayees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RegisteredCounterpartyPayees = append(m.RegisteredCounterpartyPayees, RegisteredCounterpartyPayee{})
+			if err := m.RegisteredCounterpartyPayees[len(m.RegisteredCounterpartyPayees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardRelayers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ForwardRelayers = append(m.ForwardRelayers, ForwardRelayerAddress{})
+			if err := m.ForwardRelayers[len(m.ForwardRelayers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayerStats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RelayerStats = append(m.RelayerStats, RelayerStats{})
+			if err := m.RelayerStats[len(m.RelayerStats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayerPayouts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RelayerPayouts = append(m.RelayerPayouts, RelayerPayout{})
+			if err := m.RelayerPayouts[len(m.RelayerPayouts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoIncentivePolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AutoIncentivePolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CancellationDelay", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.CancellationDelay, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeEscrowTimes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeEscrowTimes = append(m.FeeEscrowTimes, PacketFeeEscrowTime{})
+			if err := m.FeeEscrowTimes[len(m.FeeEscrowTimes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeEnabledChannel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeEnabledChannel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeEnabledChannel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AutoIncentivePolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AutoIncentivePolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AutoIncentivePolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channels = append(m.Channels, FeeEnabledChannel{})
+			if err := m.Channels[len(m.Channels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPacketsPerBlock", wireType)
+			}
+			m.MaxPacketsPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPacketsPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RegisteredPayee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RegisteredPayee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RegisteredPayee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Relayer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Relayer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RegisteredCounterpartyPayee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FeeEnabledChannels = append(m.FeeEnabledChannels, FeeEnabledChannel{})
-			if err := m.FeeEnabledChannels[len(m.FeeEnabledChannels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 3:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RegisteredCounterpartyPayee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RegisteredCounterpartyPayee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RegisteredPayees", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -895,31 +2353,29 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RegisteredPayees = append(m.RegisteredPayees, RegisteredPayee{})
-			if err := m.RegisteredPayees[len(m.RegisteredPayees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RegisteredCounterpartyPayees", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Relayer", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -929,31 +2385,29 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RegisteredCounterpartyPayees = append(m.RegisteredCounterpartyPayees, RegisteredCounterpartyPayee{})
-			if err := m.RegisteredCounterpartyPayees[len(m.RegisteredCounterpartyPayees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Relayer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ForwardRelayers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyPayee", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -963,25 +2417,23 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ForwardRelayers = append(m.ForwardRelayers, ForwardRelayerAddress{})
-			if err := m.ForwardRelayers[len(m.ForwardRelayers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.CounterpartyPayee = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1004,7 +2456,7 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FeeEnabledChannel) Unmarshal(dAtA []byte) error {
+func (m *ForwardRelayerAddress) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1027,15 +2479,15 @@ func (m *FeeEnabledChannel) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FeeEnabledChannel: wiretype end group for non-group")
+			return fmt.Errorf("proto: ForwardRelayerAddress: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FeeEnabledChannel: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ForwardRelayerAddress: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1063,13 +2515,13 @@ func (m *FeeEnabledChannel) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PortId = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketId", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1079,23 +2531,24 @@ func (m *FeeEnabledChannel) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			if err := m.PacketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1118,7 +2571,7 @@ func (m *FeeEnabledChannel) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RegisteredPayee) Unmarshal(dAtA []byte) error {
+func (m *RelayerStats) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1141,15 +2594,15 @@ func (m *RegisteredPayee) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RegisteredPayee: wiretype end group for non-group")
+			return fmt.Errorf("proto: RelayerStats: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RegisteredPayee: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RelayerStats: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayerAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1177,13 +2630,13 @@ func (m *RegisteredPayee) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			m.RelayerAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Relayer", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketsRelayed", wireType)
 			}
-			var stringLen uint64
+			m.PacketsRelayed = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1193,29 +2646,16 @@ func (m *RegisteredPayee) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.PacketsRelayed |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Relayer = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Payee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeesEarned", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1225,23 +2665,25 @@ func (m *RegisteredPayee) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Payee = string(dAtA[iNdEx:postIndex])
+			m.FeesEarned = append(m.FeesEarned, types1.Coin{})
+			if err := m.FeesEarned[len(m.FeesEarned)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1264,7 +2706,7 @@ func (m *RegisteredPayee) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RegisteredCounterpartyPayee) Unmarshal(dAtA []byte) error {
+func (m *RelayerPayout) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1287,15 +2729,15 @@ func (m *RegisteredCounterpartyPayee) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RegisteredCounterpartyPayee: wiretype end group for non-group")
+			return fmt.Errorf("proto: RelayerPayout: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RegisteredCounterpartyPayee: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RelayerPayout: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayerAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1323,13 +2765,32 @@ func (m *RegisteredCounterpartyPayee) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			m.RelayerAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Relayer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketId", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1339,29 +2800,30 @@ func (m *RegisteredCounterpartyPayee) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Relayer = string(dAtA[iNdEx:postIndex])
+			if err := m.PacketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyPayee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1371,24 +2833,45 @@ func (m *RegisteredCounterpartyPayee) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CounterpartyPayee = string(dAtA[iNdEx:postIndex])
+			m.Fee = append(m.Fee, types1.Coin{})
+			if err := m.Fee[len(m.Fee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1410,7 +2893,7 @@ func (m *RegisteredCounterpartyPayee) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ForwardRelayerAddress) Unmarshal(dAtA []byte) error {
+func (m *PacketFeeEscrowTime) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1433,15 +2916,48 @@ func (m *ForwardRelayerAddress) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ForwardRelayerAddress: wiretype end group for non-group")
+			return fmt.Errorf("proto: PacketFeeEscrowTime: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ForwardRelayerAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PacketFeeEscrowTime: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PacketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1469,11 +2985,11 @@ func (m *ForwardRelayerAddress) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.RefundAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PacketId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1500,7 +3016,7 @@ func (m *ForwardRelayerAddress) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PacketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EscrowTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
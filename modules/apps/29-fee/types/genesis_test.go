@@ -3,6 +3,7 @@ package types_test
 import (
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -133,6 +134,104 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			host.ErrInvalidID,
 		},
+		{
+			"invalid relayer stats: invalid relayer address",
+			func() {
+				genState.RelayerStats[0].RelayerAddress = ""
+			},
+			errors.New("failed to convert relayer address into sdk.AccAddress"),
+		},
+		{
+			"invalid relayer stats: invalid fees earned",
+			func() {
+				genState.RelayerStats[0].FeesEarned = sdk.Coins{sdk.Coin{Denom: "", Amount: defaultRecvFee[0].Amount}}
+			},
+			ibcerrors.ErrInvalidCoins,
+		},
+		{
+			"invalid relayer stats: duplicate relayer address",
+			func() {
+				genState.RelayerStats = append(genState.RelayerStats, genState.RelayerStats[0])
+			},
+			ibcerrors.ErrInvalidAddress,
+		},
+		{
+			"invalid relayer payout: invalid packet",
+			func() {
+				genState.RelayerPayouts[0].PacketId = channeltypes.PacketId{}
+			},
+			host.ErrInvalidID,
+		},
+		{
+			"invalid relayer payout: index exceeds packets relayed",
+			func() {
+				genState.RelayerPayouts[0].Index = 1
+			},
+			ibcerrors.ErrInvalidRequest,
+		},
+		{
+			"invalid relayer payout: relayer stats not found",
+			func() {
+				genState.RelayerPayouts[0].RelayerAddress = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+			},
+			ibcerrors.ErrInvalidRequest,
+		},
+		{
+			"invalid relayer payout: negative height",
+			func() {
+				genState.RelayerPayouts[0].Height = -1
+			},
+			ibcerrors.ErrInvalidHeight,
+		},
+		{
+			"invalid auto incentive policy: invalid channel ID",
+			func() {
+				genState.AutoIncentivePolicy.Channels[0].ChannelId = ""
+			},
+			host.ErrInvalidID,
+		},
+		{
+			"invalid auto incentive policy: invalid fee",
+			func() {
+				genState.AutoIncentivePolicy.Fee = types.NewFee(sdk.Coins{}, sdk.Coins{}, sdk.Coins{})
+			},
+			ibcerrors.ErrInvalidCoins,
+		},
+		{
+			"success - unset cancellation delay",
+			func() {
+				genState.CancellationDelay = 0
+			},
+			nil,
+		},
+		{
+			"invalid cancellation delay: negative delay",
+			func() {
+				genState.CancellationDelay = -time.Second
+			},
+			types.ErrInvalidCancellationDelay,
+		},
+		{
+			"invalid fee escrow time: invalid packet",
+			func() {
+				genState.FeeEscrowTimes[0].PacketId = channeltypes.PacketId{}
+			},
+			host.ErrInvalidID,
+		},
+		{
+			"invalid fee escrow time: invalid refund address",
+			func() {
+				genState.FeeEscrowTimes[0].RefundAddress = ""
+			},
+			errors.New("failed to convert refund address into sdk.AccAddress"),
+		},
+		{
+			"invalid fee escrow time: duplicate fee escrow time",
+			func() {
+				genState.FeeEscrowTimes = append(genState.FeeEscrowTimes, genState.FeeEscrowTimes[0])
+			},
+			ibcerrors.ErrInvalidRequest,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -171,6 +270,21 @@ func TestValidateGenesis(t *testing.T) {
 						ChannelId: ibctesting.FirstChannelID,
 					},
 				},
+				RelayerStats: []types.RelayerStats{
+					types.NewRelayerStats(defaultAccAddress, 1, defaultRecvFee),
+				},
+				RelayerPayouts: []types.RelayerPayout{
+					types.NewRelayerPayout(defaultAccAddress, 0, channeltypes.NewPacketID(ibctesting.MockFeePort, ibctesting.FirstChannelID, 1), defaultRecvFee, 1),
+				},
+				AutoIncentivePolicy: types.NewAutoIncentivePolicy(
+					types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee),
+					[]types.FeeEnabledChannel{{PortId: ibctesting.MockFeePort, ChannelId: ibctesting.FirstChannelID}},
+					1,
+				),
+				CancellationDelay: types.DefaultCancellationDelay,
+				FeeEscrowTimes: []types.PacketFeeEscrowTime{
+					types.NewPacketFeeEscrowTime(channeltypes.NewPacketID(ibctesting.MockFeePort, ibctesting.FirstChannelID, 1), defaultAccAddress, time.Unix(1, 0).UTC()),
+				},
 			}
 
 			tc.malleate()
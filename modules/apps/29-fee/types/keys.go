@@ -24,6 +24,9 @@ const (
 	// QuerierRoute is the querier route for IBC fee module
 	QuerierRoute = ModuleName
 
+	// FeeBudgetName defines the name of the module account holding the fee budget used to incentivize outgoing packets
+	FeeBudgetName = "feeibc-budget"
+
 	Version = "ics29-1"
 
 	// FeeEnabledKeyPrefix is the key prefix for storing fee enabled flag
@@ -40,6 +43,15 @@ const (
 
 	// ForwardRelayerPrefix is the key prefix for forward relayer addresses stored in state for async acknowledgements
 	ForwardRelayerPrefix = "forwardRelayer"
+
+	// RelayerStatsPrefix is the key prefix for the relayer payout statistics stored in state
+	RelayerStatsPrefix = "relayerStats"
+
+	// RelayerPayoutPrefix is the key prefix for the relayer payout history stored in state
+	RelayerPayoutPrefix = "relayerPayout"
+
+	// FeeEscrowTimePrefix is the key prefix for the time at which packet fees were escrowed by a refund address
+	FeeEscrowTimePrefix = "feeEscrowTime"
 )
 
 // KeyLocked returns the key used to lock and unlock the fee module. This key is used
@@ -48,6 +60,22 @@ func KeyLocked() []byte {
 	return []byte("locked")
 }
 
+// KeyAutoIncentivePolicy returns the key used to store the policy used to incentivize outgoing packets from the fee budget.
+func KeyAutoIncentivePolicy() []byte {
+	return []byte("autoIncentivePolicy")
+}
+
+// KeyAutoIncentivizedPacketCount returns the key used to store the number of outgoing packets incentivized from the fee
+// budget in the current block.
+func KeyAutoIncentivizedPacketCount() []byte {
+	return []byte("autoIncentivizedPacketCount")
+}
+
+// KeyCancellationDelay returns the key used to store the delay after which escrowed packet fees may be cancelled.
+func KeyCancellationDelay() []byte {
+	return []byte("cancellationDelay")
+}
+
 // KeyFeeEnabled returns the key that stores a flag to determine if fee logic should
 // be enabled for the given port and channel identifiers.
 func KeyFeeEnabled(portID, channelID string) []byte {
@@ -158,3 +186,41 @@ func ParseKeyFeesInEscrow(key string) (channeltypes.PacketId, error) {
 func KeyFeesInEscrowChannelPrefix(portID, channelID string) []byte {
 	return []byte(fmt.Sprintf("%s/%s/%s", FeesInEscrowPrefix, portID, channelID))
 }
+
+// KeyRelayerStats returns the key for relayer address -> relayer payout statistics mapping
+func KeyRelayerStats(relayerAddr string) []byte {
+	return []byte(fmt.Sprintf("%s/%s", RelayerStatsPrefix, relayerAddr))
+}
+
+// KeyRelayerPayout returns the key for the relayer payout stored at the given index of the payout history of the relayer address
+func KeyRelayerPayout(relayerAddr string, index uint64) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%d", RelayerPayoutPrefix, relayerAddr, index))
+}
+
+// KeyFeeEscrowTime returns the key for the time at which packet fees were escrowed by the refund address for the given packetID
+func KeyFeeEscrowTime(packetID channeltypes.PacketId, refundAddr string) []byte {
+	return []byte(fmt.Sprintf("%s/%s", KeyFeeEscrowTimePacketPrefix(packetID), refundAddr))
+}
+
+// ParseKeyFeeEscrowTime parses the key used to store the fee escrow time and returns the packetID and refund address
+func ParseKeyFeeEscrowTime(key string) (channeltypes.PacketId, string, error) {
+	keySplit := strings.Split(key, "/")
+	if len(keySplit) != 5 {
+		return channeltypes.PacketId{}, "", errorsmod.Wrapf(
+			ibcerrors.ErrLogic, "key provided is incorrect: the key split has incorrect length, expected %d, got %d", 5, len(keySplit),
+		)
+	}
+
+	seq, err := strconv.ParseUint(keySplit[3], 10, 64)
+	if err != nil {
+		return channeltypes.PacketId{}, "", err
+	}
+
+	packetID := channeltypes.NewPacketID(keySplit[1], keySplit[2], seq)
+	return packetID, keySplit[4], nil
+}
+
+// KeyFeeEscrowTimePacketPrefix returns the key prefix for the fee escrow times of the given packetID
+func KeyFeeEscrowTimePacketPrefix(packetID channeltypes.PacketId) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s/%d", FeeEscrowTimePrefix, packetID.PortId, packetID.ChannelId, packetID.Sequence))
+}
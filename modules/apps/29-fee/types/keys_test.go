@@ -218,3 +218,43 @@ func TestParseKeyCounterpartyPayee(t *testing.T) {
 		}
 	}
 }
+
+func TestParseKeyFeeEscrowTime(t *testing.T) {
+	refundAddr := "refund_address"
+
+	testCases := []struct {
+		name   string
+		key    string
+		expErr error
+	}{
+		{
+			"success",
+			string(types.KeyFeeEscrowTime(validPacketID, refundAddr)),
+			nil,
+		},
+		{
+			"incorrect key - key split has incorrect length",
+			"feeEscrowTime/transfer/channel-0/1",
+			ibcerrors.ErrLogic,
+		},
+		{
+			"incorrect key - sequence is not correct",
+			"feeEscrowTime/transfer/channel-0/sequence/refund_address",
+			errors.New("invalid syntax"),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		packetID, address, err := types.ParseKeyFeeEscrowTime(tc.key)
+
+		if tc.expErr == nil {
+			require.NoError(t, err)
+			require.Equal(t, validPacketID, packetID)
+			require.Equal(t, refundAddr, address)
+		} else {
+			ibctesting.RequireErrorIsOrContains(t, err, tc.expErr, err.Error())
+		}
+	}
+}
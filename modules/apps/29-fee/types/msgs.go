@@ -2,6 +2,7 @@ package types
 
 import (
 	"strings"
+	"time"
 
 	errorsmod "cosmossdk.io/errors"
 
@@ -19,11 +20,13 @@ var (
 	_ sdk.Msg = (*MsgRegisterCounterpartyPayee)(nil)
 	_ sdk.Msg = (*MsgPayPacketFee)(nil)
 	_ sdk.Msg = (*MsgPayPacketFeeAsync)(nil)
+	_ sdk.Msg = (*MsgUpdateAutoIncentivePolicy)(nil)
 
 	_ sdk.HasValidateBasic = (*MsgRegisterPayee)(nil)
 	_ sdk.HasValidateBasic = (*MsgRegisterCounterpartyPayee)(nil)
 	_ sdk.HasValidateBasic = (*MsgPayPacketFee)(nil)
 	_ sdk.HasValidateBasic = (*MsgPayPacketFeeAsync)(nil)
+	_ sdk.HasValidateBasic = (*MsgUpdateAutoIncentivePolicy)(nil)
 )
 
 // NewMsgRegisterPayee creates a new instance of MsgRegisterPayee
@@ -147,3 +150,61 @@ func (msg MsgPayPacketFeeAsync) ValidateBasic() error {
 
 	return msg.PacketFee.Validate()
 }
+
+// NewMsgUpdateAutoIncentivePolicy creates a new instance of MsgUpdateAutoIncentivePolicy
+func NewMsgUpdateAutoIncentivePolicy(signer string, policy AutoIncentivePolicy) *MsgUpdateAutoIncentivePolicy {
+	return &MsgUpdateAutoIncentivePolicy{
+		Signer: signer,
+		Policy: policy,
+	}
+}
+
+// ValidateBasic performs a basic check of the MsgUpdateAutoIncentivePolicy fields
+func (msg MsgUpdateAutoIncentivePolicy) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	return msg.Policy.Validate()
+}
+
+// NewMsgCancelPacketFee creates a new instance of MsgCancelPacketFee
+func NewMsgCancelPacketFee(packetID channeltypes.PacketId, refundAddr string) *MsgCancelPacketFee {
+	return &MsgCancelPacketFee{
+		PacketId:      packetID,
+		RefundAddress: refundAddr,
+	}
+}
+
+// ValidateBasic performs a basic check of the MsgCancelPacketFee fields
+func (msg MsgCancelPacketFee) ValidateBasic() error {
+	if err := msg.PacketId.Validate(); err != nil {
+		return err
+	}
+
+	_, err := sdk.AccAddressFromBech32(msg.RefundAddress)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	return nil
+}
+
+// NewMsgUpdateCancellationDelay creates a new instance of MsgUpdateCancellationDelay
+func NewMsgUpdateCancellationDelay(signer string, cancellationDelay time.Duration) *MsgUpdateCancellationDelay {
+	return &MsgUpdateCancellationDelay{
+		Signer:            signer,
+		CancellationDelay: cancellationDelay,
+	}
+}
+
+// ValidateBasic performs a basic check of the MsgUpdateCancellationDelay fields
+func (msg MsgUpdateCancellationDelay) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	return ValidateCancellationDelay(msg.CancellationDelay)
+}
@@ -3,6 +3,7 @@ package types_test
 import (
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -407,3 +408,232 @@ func TestPayPacketFeeAsyncGetSigners(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, refundAddr.Bytes(), signers[0])
 }
+
+func TestMsgUpdateAutoIncentivePolicyValidation(t *testing.T) {
+	var msg *types.MsgUpdateAutoIncentivePolicy
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"success: empty policy disables auto incentivization",
+			func() {
+				msg.Policy = types.AutoIncentivePolicy{}
+			},
+			nil,
+		},
+		{
+			"invalid signer address",
+			func() {
+				msg.Signer = invalidAddress
+			},
+			ibcerrors.ErrInvalidAddress,
+		},
+		{
+			"invalid port ID",
+			func() {
+				msg.Policy.Channels[0].PortId = ""
+			},
+			host.ErrInvalidID,
+		},
+		{
+			"invalid channel ID",
+			func() {
+				msg.Policy.Channels[0].ChannelId = ""
+			},
+			host.ErrInvalidID,
+		},
+		{
+			"duplicate channel",
+			func() {
+				msg.Policy.Channels = append(msg.Policy.Channels, msg.Policy.Channels[0])
+			},
+			ibcerrors.ErrInvalidRequest,
+		},
+		{
+			"zero maximum number of packets per block",
+			func() {
+				msg.Policy.MaxPacketsPerBlock = 0
+			},
+			ibcerrors.ErrInvalidRequest,
+		},
+		{
+			"invalid fee: all fees are zero",
+			func() {
+				msg.Policy.Fee = types.NewFee(sdk.Coins{}, sdk.Coins{}, sdk.Coins{})
+			},
+			ibcerrors.ErrInvalidCoins,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			policy := types.NewAutoIncentivePolicy(
+				types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee),
+				[]types.FeeEnabledChannel{{PortId: ibctesting.MockFeePort, ChannelId: ibctesting.FirstChannelID}},
+				1,
+			)
+			msg = types.NewMsgUpdateAutoIncentivePolicy(defaultAccAddress, policy)
+
+			tc.malleate()
+
+			err := msg.ValidateBasic()
+
+			if tc.expErr == nil {
+				require.NoError(t, err)
+			} else {
+				ibctesting.RequireErrorIsOrContains(t, err, tc.expErr, err.Error())
+			}
+		})
+	}
+}
+
+func TestUpdateAutoIncentivePolicyGetSigners(t *testing.T) {
+	signer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	msg := types.NewMsgUpdateAutoIncentivePolicy(signer.String(), types.AutoIncentivePolicy{})
+
+	encodingCfg := moduletestutil.MakeTestEncodingConfig(modulefee.AppModuleBasic{})
+	signers, _, err := encodingCfg.Codec.GetMsgV1Signers(msg)
+	require.NoError(t, err)
+	require.Equal(t, signer.Bytes(), signers[0])
+}
+
+func TestMsgCancelPacketFeeValidation(t *testing.T) {
+	var msg *types.MsgCancelPacketFee
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"invalid packetID: invalid port ID",
+			func() {
+				msg.PacketId.PortId = ""
+			},
+			host.ErrInvalidID,
+		},
+		{
+			"invalid packetID: invalid sequence",
+			func() {
+				msg.PacketId.Sequence = 0
+			},
+			host.ErrInvalidPacket,
+		},
+		{
+			"invalid refund address",
+			func() {
+				msg.RefundAddress = invalidAddress
+			},
+			ibcerrors.ErrInvalidAddress,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			packetID := channeltypes.NewPacketID(ibctesting.MockFeePort, ibctesting.FirstChannelID, 1)
+			msg = types.NewMsgCancelPacketFee(packetID, defaultAccAddress)
+
+			tc.malleate()
+
+			err := msg.ValidateBasic()
+
+			if tc.expErr == nil {
+				require.NoError(t, err)
+			} else {
+				ibctesting.RequireErrorIsOrContains(t, err, tc.expErr, err.Error())
+			}
+		})
+	}
+}
+
+func TestCancelPacketFeeGetSigners(t *testing.T) {
+	refundAddr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	msg := types.NewMsgCancelPacketFee(channeltypes.NewPacketID(ibctesting.MockFeePort, ibctesting.FirstChannelID, 1), refundAddr.String())
+
+	encodingCfg := moduletestutil.MakeTestEncodingConfig(modulefee.AppModuleBasic{})
+	signers, _, err := encodingCfg.Codec.GetMsgV1Signers(msg)
+	require.NoError(t, err)
+	require.Equal(t, refundAddr.Bytes(), signers[0])
+}
+
+func TestMsgUpdateCancellationDelayValidation(t *testing.T) {
+	var msg *types.MsgUpdateCancellationDelay
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"zero cancellation delay",
+			func() {
+				msg.CancellationDelay = 0
+			},
+			types.ErrInvalidCancellationDelay,
+		},
+		{
+			"invalid signer address",
+			func() {
+				msg.Signer = invalidAddress
+			},
+			ibcerrors.ErrInvalidAddress,
+		},
+		{
+			"negative cancellation delay",
+			func() {
+				msg.CancellationDelay = -time.Second
+			},
+			types.ErrInvalidCancellationDelay,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			msg = types.NewMsgUpdateCancellationDelay(defaultAccAddress, time.Hour)
+
+			tc.malleate()
+
+			err := msg.ValidateBasic()
+
+			if tc.expErr == nil {
+				require.NoError(t, err)
+			} else {
+				ibctesting.RequireErrorIsOrContains(t, err, tc.expErr, err.Error())
+			}
+		})
+	}
+}
+
+func TestUpdateCancellationDelayGetSigners(t *testing.T) {
+	signer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	msg := types.NewMsgUpdateCancellationDelay(signer.String(), time.Hour)
+
+	encodingCfg := moduletestutil.MakeTestEncodingConfig(modulefee.AppModuleBasic{})
+	signers, _, err := encodingCfg.Codec.GetMsgV1Signers(msg)
+	require.NoError(t, err)
+	require.Equal(t, signer.Bytes(), signers[0])
+}
@@ -12,20 +12,24 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	types "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -1069,6 +1073,410 @@ func (m *QueryFeeEnabledChannelResponse) GetFeeEnabled() bool {
 	return false
 }
 
+// QueryTopIncentivizedPacketsRequest defines the request type for the TopIncentivizedPackets rpc
+type QueryTopIncentivizedPacketsRequest struct {
+	// unique port identifier
+	PortId string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// unique channel identifier
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// the denomination by which the packets are ranked
+	Denom string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+	// the maximum number of packets returned, defaults to 10 if unset
+	Limit uint64 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (m *QueryTopIncentivizedPacketsRequest) Reset()         { *m = QueryTopIncentivizedPacketsRequest{} }
+func (m *QueryTopIncentivizedPacketsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTopIncentivizedPacketsRequest) ProtoMessage()    {}
+func (*QueryTopIncentivizedPacketsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0638a8a78ca2503c, []int{20}
+}
+func (m *QueryTopIncentivizedPacketsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTopIncentivizedPacketsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTopIncentivizedPacketsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTopIncentivizedPacketsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTopIncentivizedPacketsRequest.Merge(m, src)
+}
+func (m *QueryTopIncentivizedPacketsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTopIncentivizedPacketsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTopIncentivizedPacketsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTopIncentivizedPacketsRequest proto.InternalMessageInfo
+
+func (m *QueryTopIncentivizedPacketsRequest) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *QueryTopIncentivizedPacketsRequest) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *QueryTopIncentivizedPacketsRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *QueryTopIncentivizedPacketsRequest) GetLimit() uint64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+// QueryTopIncentivizedPacketsResponse defines the response type for the TopIncentivizedPackets rpc
+type QueryTopIncentivizedPacketsResponse struct {
+	// list of incentivized packets ranked by the total escrowed fee in descending order
+	IncentivizedPackets []IdentifiedPacketFees `protobuf:"bytes,1,rep,name=incentivized_packets,json=incentivizedPackets,proto3" json:"incentivized_packets"`
+}
+
+func (m *QueryTopIncentivizedPacketsResponse) Reset()         { *m = QueryTopIncentivizedPacketsResponse{} }
+func (m *QueryTopIncentivizedPacketsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTopIncentivizedPacketsResponse) ProtoMessage()    {}
+func (*QueryTopIncentivizedPacketsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0638a8a78ca2503c, []int{21}
+}
+func (m *QueryTopIncentivizedPacketsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTopIncentivizedPacketsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTopIncentivizedPacketsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTopIncentivizedPacketsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTopIncentivizedPacketsResponse.Merge(m, src)
+}
+func (m *QueryTopIncentivizedPacketsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTopIncentivizedPacketsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTopIncentivizedPacketsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTopIncentivizedPacketsResponse proto.InternalMessageInfo
+
+func (m *QueryTopIncentivizedPacketsResponse) GetIncentivizedPackets() []IdentifiedPacketFees {
+	if m != nil {
+		return m.IncentivizedPackets
+	}
+	return nil
+}
+
+// QueryRelayerStatsRequest defines the request type for the RelayerStats rpc
+type QueryRelayerStatsRequest struct {
+	// the address to which the fees were paid out
+	RelayerAddress string `protobuf:"bytes,1,opt,name=relayer_address,json=relayerAddress,proto3" json:"relayer_address,omitempty"`
+}
+
+func (m *QueryRelayerStatsRequest) Reset()         { *m = QueryRelayerStatsRequest{} }
+func (m *QueryRelayerStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRelayerStatsRequest) ProtoMessage()    {}
+func (*QueryRelayerStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0638a8a78ca2503c, []int{22}
+}
+func (m *QueryRelayerStatsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRelayerStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRelayerStatsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRelayerStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRelayerStatsRequest.Merge(m, src)
+}
+func (m *QueryRelayerStatsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRelayerStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRelayerStatsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRelayerStatsRequest proto.InternalMessageInfo
+
+func (m *QueryRelayerStatsRequest) GetRelayerAddress() string {
+	if m != nil {
+		return m.RelayerAddress
+	}
+	return ""
+}
+
+// QueryRelayerStatsResponse defines the response type for the RelayerStats rpc
+type QueryRelayerStatsResponse struct {
+	// the fee payout statistics of the relayer address
+	RelayerStats RelayerStats `protobuf:"bytes,1,opt,name=relayer_stats,json=relayerStats,proto3" json:"relayer_stats"`
+	// list of the most recent payouts to the relayer address, ordered from oldest to newest
+	Payouts []RelayerPayout `protobuf:"bytes,2,rep,name=payouts,proto3" json:"payouts"`
+}
+
+func (m *QueryRelayerStatsResponse) Reset()         { *m = QueryRelayerStatsResponse{} }
+func (m *QueryRelayerStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRelayerStatsResponse) ProtoMessage()    {}
+func (*QueryRelayerStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0638a8a78ca2503c, []int{23}
+}
+func (m *QueryRelayerStatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRelayerStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRelayerStatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRelayerStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRelayerStatsResponse.Merge(m, src)
+}
+func (m *QueryRelayerStatsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRelayerStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRelayerStatsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRelayerStatsResponse proto.InternalMessageInfo
+
+func (m *QueryRelayerStatsResponse) GetRelayerStats() RelayerStats {
+	if m != nil {
+		return m.RelayerStats
+	}
+	return RelayerStats{}
+}
+
+func (m *QueryRelayerStatsResponse) GetPayouts() []RelayerPayout {
+	if m != nil {
+		return m.Payouts
+	}
+	return nil
+}
+
+// QueryFeeBudgetRequest defines the request type for the FeeBudget rpc
+type QueryFeeBudgetRequest struct {
+}
+
+func (m *QueryFeeBudgetRequest) Reset()         { *m = QueryFeeBudgetRequest{} }
+func (m *QueryFeeBudgetRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeBudgetRequest) ProtoMessage()    {}
+func (*QueryFeeBudgetRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0638a8a78ca2503c, []int{24}
+}
+func (m *QueryFeeBudgetRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeBudgetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeBudgetRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeBudgetRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeBudgetRequest.Merge(m, src)
+}
+func (m *QueryFeeBudgetRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeBudgetRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeBudgetRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeBudgetRequest proto.InternalMessageInfo
+
+// QueryFeeBudgetResponse defines the response type for the FeeBudget rpc
+type QueryFeeBudgetResponse struct {
+	// the address of the fee budget module account
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// the remaining balance of the fee budget
+	Balance github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=balance,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"balance"`
+	// the policy used to incentivize outgoing packets from the fee budget
+	AutoIncentivePolicy AutoIncentivePolicy `protobuf:"bytes,3,opt,name=auto_incentive_policy,json=autoIncentivePolicy,proto3" json:"auto_incentive_policy"`
+}
+
+func (m *QueryFeeBudgetResponse) Reset()         { *m = QueryFeeBudgetResponse{} }
+func (m *QueryFeeBudgetResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeBudgetResponse) ProtoMessage()    {}
+func (*QueryFeeBudgetResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0638a8a78ca2503c, []int{25}
+}
+func (m *QueryFeeBudgetResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeBudgetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeBudgetResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeBudgetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeBudgetResponse.Merge(m, src)
+}
+func (m *QueryFeeBudgetResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeBudgetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeBudgetResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeBudgetResponse proto.InternalMessageInfo
+
+func (m *QueryFeeBudgetResponse) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *QueryFeeBudgetResponse) GetBalance() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Balance
+	}
+	return nil
+}
+
+func (m *QueryFeeBudgetResponse) GetAutoIncentivePolicy() AutoIncentivePolicy {
+	if m != nil {
+		return m.AutoIncentivePolicy
+	}
+	return AutoIncentivePolicy{}
+}
+
+// QueryCancellationDelayRequest defines the request type for the CancellationDelay rpc
+type QueryCancellationDelayRequest struct {
+}
+
+func (m *QueryCancellationDelayRequest) Reset()         { *m = QueryCancellationDelayRequest{} }
+func (m *QueryCancellationDelayRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCancellationDelayRequest) ProtoMessage()    {}
+func (*QueryCancellationDelayRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0638a8a78ca2503c, []int{26}
+}
+func (m *QueryCancellationDelayRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCancellationDelayRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCancellationDelayRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCancellationDelayRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCancellationDelayRequest.Merge(m, src)
+}
+func (m *QueryCancellationDelayRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCancellationDelayRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCancellationDelayRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCancellationDelayRequest proto.InternalMessageInfo
+
+// QueryCancellationDelayResponse defines the response type for the CancellationDelay rpc
+type QueryCancellationDelayResponse struct {
+	// the delay after which escrowed packet fees may be cancelled by their refund address
+	CancellationDelay time.Duration `protobuf:"bytes,1,opt,name=cancellation_delay,json=cancellationDelay,proto3,stdduration" json:"cancellation_delay"`
+}
+
+func (m *QueryCancellationDelayResponse) Reset()         { *m = QueryCancellationDelayResponse{} }
+func (m *QueryCancellationDelayResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCancellationDelayResponse) ProtoMessage()    {}
+func (*QueryCancellationDelayResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0638a8a78ca2503c, []int{27}
+}
+func (m *QueryCancellationDelayResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCancellationDelayResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCancellationDelayResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCancellationDelayResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCancellationDelayResponse.Merge(m, src)
+}
+func (m *QueryCancellationDelayResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCancellationDelayResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCancellationDelayResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCancellationDelayResponse proto.InternalMessageInfo
+
+func (m *QueryCancellationDelayResponse) GetCancellationDelay() time.Duration {
+	if m != nil {
+		return m.CancellationDelay
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*QueryIncentivizedPacketsRequest)(nil), "ibc.applications.fee.v1.QueryIncentivizedPacketsRequest")
 	proto.RegisterType((*QueryIncentivizedPacketsResponse)(nil), "ibc.applications.fee.v1.QueryIncentivizedPacketsResponse")
@@ -1090,6 +1498,14 @@ func init() {
 	proto.RegisterType((*QueryFeeEnabledChannelsResponse)(nil), "ibc.applications.fee.v1.QueryFeeEnabledChannelsResponse")
 	proto.RegisterType((*QueryFeeEnabledChannelRequest)(nil), "ibc.applications.fee.v1.QueryFeeEnabledChannelRequest")
 	proto.RegisterType((*QueryFeeEnabledChannelResponse)(nil), "ibc.applications.fee.v1.QueryFeeEnabledChannelResponse")
+	proto.RegisterType((*QueryTopIncentivizedPacketsRequest)(nil), "ibc.applications.fee.v1.QueryTopIncentivizedPacketsRequest")
+	proto.RegisterType((*QueryTopIncentivizedPacketsResponse)(nil), "ibc.applications.fee.v1.QueryTopIncentivizedPacketsResponse")
+	proto.RegisterType((*QueryRelayerStatsRequest)(nil), "ibc.applications.fee.v1.QueryRelayerStatsRequest")
+	proto.RegisterType((*QueryRelayerStatsResponse)(nil), "ibc.applications.fee.v1.QueryRelayerStatsResponse")
+	proto.RegisterType((*QueryFeeBudgetRequest)(nil), "ibc.applications.fee.v1.QueryFeeBudgetRequest")
+	proto.RegisterType((*QueryFeeBudgetResponse)(nil), "ibc.applications.fee.v1.QueryFeeBudgetResponse")
+	proto.RegisterType((*QueryCancellationDelayRequest)(nil), "ibc.applications.fee.v1.QueryCancellationDelayRequest")
+	proto.RegisterType((*QueryCancellationDelayResponse)(nil), "ibc.applications.fee.v1.QueryCancellationDelayResponse")
 }
 
 func init() {
@@ -1097,86 +1513,110 @@ func init() {
 }
 
 var fileDescriptor_0638a8a78ca2503c = []byte{
-	// 1251 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0xdf, 0x6e, 0xdc, 0xc4,
-	0x17, 0xce, 0x6c, 0xd3, 0x26, 0x39, 0x49, 0xa5, 0x5f, 0x26, 0x91, 0x9a, 0x5a, 0xc9, 0x26, 0x75,
-	0x7f, 0xa5, 0x21, 0x68, 0x6d, 0xb2, 0x55, 0x49, 0x73, 0x05, 0x49, 0x20, 0x25, 0x50, 0x68, 0x58,
-	0x22, 0x81, 0x10, 0x68, 0xeb, 0xb5, 0x67, 0x1d, 0x2b, 0x1b, 0x8f, 0x6b, 0x7b, 0x57, 0xa4, 0x21,
-	0xfc, 0x2f, 0x20, 0x81, 0x54, 0x24, 0x9e, 0x02, 0x24, 0x1e, 0x80, 0x37, 0xe8, 0x55, 0x15, 0xa9,
-	0x17, 0x20, 0x2e, 0x00, 0x25, 0x88, 0x67, 0xe0, 0x02, 0x24, 0xe4, 0x99, 0xf1, 0xae, 0x37, 0xb6,
-	0xf7, 0x4f, 0xd8, 0x84, 0xab, 0xd8, 0x33, 0x73, 0xce, 0xf9, 0xbe, 0x6f, 0xc6, 0x73, 0xbe, 0x0d,
-	0x5c, 0xb6, 0x4a, 0xba, 0xaa, 0x39, 0x4e, 0xc5, 0xd2, 0x35, 0xdf, 0xa2, 0xb6, 0xa7, 0x96, 0x09,
-	0x51, 0x6b, 0xf3, 0xea, 0xdd, 0x2a, 0x71, 0x77, 0x14, 0xc7, 0xa5, 0x3e, 0xc5, 0x17, 0xac, 0x92,
-	0xae, 0x44, 0x17, 0x29, 0x65, 0x42, 0x94, 0xda, 0xbc, 0x34, 0x6e, 0x52, 0x93, 0xb2, 0x35, 0x6a,
-	0xf0, 0xc4, 0x97, 0x4b, 0x93, 0x26, 0xa5, 0x66, 0x85, 0xa8, 0x9a, 0x63, 0xa9, 0x9a, 0x6d, 0x53,
-	0x5f, 0x04, 0xf1, 0xd9, 0xac, 0x4e, 0xbd, 0x6d, 0xea, 0xa9, 0x25, 0xcd, 0x0b, 0x0a, 0x95, 0x88,
-	0xaf, 0xcd, 0xab, 0x3a, 0xb5, 0x6c, 0x31, 0x3f, 0x17, 0x9d, 0x67, 0x28, 0xea, 0xab, 0x1c, 0xcd,
-	0xb4, 0x6c, 0x96, 0x4c, 0xac, 0xbd, 0x94, 0x86, 0x3e, 0xc0, 0xc7, 0x97, 0x5c, 0x49, 0x5b, 0x62,
-	0x12, 0x9b, 0x78, 0x96, 0x17, 0xcd, 0xa4, 0x53, 0x97, 0xa8, 0xfa, 0xa6, 0x66, 0xdb, 0xa4, 0x12,
-	0x2c, 0x11, 0x8f, 0x7c, 0x89, 0xfc, 0x15, 0x82, 0xe9, 0xd7, 0x02, 0x3c, 0x6b, 0xb6, 0x4e, 0x6c,
-	0xdf, 0xaa, 0x59, 0xf7, 0x88, 0xb1, 0xae, 0xe9, 0x5b, 0xc4, 0xf7, 0x0a, 0xe4, 0x6e, 0x95, 0x78,
-	0x3e, 0x5e, 0x05, 0x68, 0x80, 0x9c, 0x40, 0x33, 0x68, 0x76, 0x38, 0xff, 0x84, 0xc2, 0x19, 0x29,
-	0x01, 0x23, 0x85, 0xeb, 0x2a, 0x18, 0x29, 0xeb, 0x9a, 0x49, 0x44, 0x6c, 0x21, 0x12, 0x89, 0x2f,
-	0xc1, 0x08, 0x5b, 0x58, 0xdc, 0x24, 0x96, 0xb9, 0xe9, 0x4f, 0x64, 0x66, 0xd0, 0x6c, 0x7f, 0x61,
-	0x98, 0x8d, 0xbd, 0xc8, 0x86, 0xe4, 0xc7, 0x08, 0x66, 0xd2, 0xe1, 0x78, 0x0e, 0xb5, 0x3d, 0x82,
-	0xcb, 0x30, 0x6e, 0x45, 0xa6, 0x8b, 0x0e, 0x9f, 0x9f, 0x40, 0x33, 0x67, 0x66, 0x87, 0xf3, 0x39,
-	0x25, 0x65, 0x63, 0x95, 0x35, 0x23, 0x88, 0x29, 0x5b, 0x61, 0xc6, 0x55, 0x42, 0xbc, 0xe5, 0xfe,
-	0x87, 0xbf, 0x4c, 0xf7, 0x15, 0xc6, 0xac, 0x78, 0x3d, 0x7c, 0xb3, 0x89, 0x77, 0x86, 0xf1, 0xbe,
-	0xda, 0x96, 0x37, 0x07, 0x19, 0x25, 0x2e, 0xdf, 0x47, 0x90, 0x4d, 0x61, 0x15, 0x6a, 0xfc, 0x1c,
-	0x0c, 0x71, 0x1a, 0x45, 0xcb, 0x10, 0x12, 0x4f, 0x31, 0x22, 0xc1, 0xf6, 0x29, 0xe1, 0x9e, 0xd5,
-	0x82, 0x22, 0xc1, 0xaa, 0x35, 0x43, 0x00, 0x1f, 0x74, 0xc4, 0x7b, 0x27, 0xea, 0x7e, 0x9e, 0xbe,
-	0xd9, 0x75, 0x71, 0x0d, 0x18, 0x4b, 0x10, 0x57, 0x40, 0x3a, 0x96, 0xb6, 0x38, 0xae, 0xad, 0xfc,
-	0x08, 0xc1, 0x93, 0x69, 0xfb, 0xbc, 0x4a, 0xdd, 0x15, 0xce, 0xb7, 0xd7, 0x07, 0xf0, 0x02, 0x0c,
-	0x38, 0xd4, 0x65, 0x12, 0x07, 0xea, 0x0c, 0x15, 0xce, 0x05, 0xaf, 0x6b, 0x06, 0x9e, 0x02, 0x10,
-	0x12, 0x07, 0x73, 0x67, 0xd8, 0xdc, 0x90, 0x18, 0x49, 0x90, 0xb6, 0x3f, 0x2e, 0xed, 0x8f, 0x08,
-	0xe6, 0x3a, 0x21, 0x24, 0x54, 0xbe, 0xd3, 0xc3, 0x23, 0x7c, 0xc2, 0x87, 0xf7, 0x1d, 0xb8, 0xc8,
-	0x88, 0x6d, 0x50, 0x5f, 0xab, 0x14, 0x88, 0x5e, 0x63, 0x35, 0x7b, 0x75, 0x6c, 0xe5, 0xcf, 0x10,
-	0x48, 0x49, 0xf9, 0x85, 0x50, 0x9b, 0x30, 0xe4, 0x12, 0xbd, 0x56, 0x2c, 0x13, 0x12, 0xaa, 0x73,
-	0xb1, 0x89, 0x45, 0x88, 0x7f, 0x85, 0x5a, 0xf6, 0xf2, 0xd3, 0x41, 0xf2, 0xef, 0x7e, 0x9d, 0x9e,
-	0x35, 0x2d, 0x7f, 0xb3, 0x5a, 0x52, 0x74, 0xba, 0xad, 0x8a, 0x9b, 0x97, 0xff, 0xc9, 0x79, 0xc6,
-	0x96, 0xea, 0xef, 0x38, 0xc4, 0x63, 0x01, 0x5e, 0x61, 0xd0, 0x15, 0x15, 0xe5, 0xb7, 0x61, 0xa2,
-	0x81, 0x63, 0x49, 0xdf, 0xea, 0x2d, 0xcd, 0x4f, 0x50, 0x54, 0xc6, 0x7a, 0xfa, 0xfa, 0x8d, 0x36,
-	0xa8, 0xe9, 0x5b, 0x27, 0x46, 0x72, 0x40, 0xe3, 0xf5, 0xe4, 0x3b, 0x30, 0xd9, 0x00, 0xb1, 0x61,
-	0x6d, 0x13, 0x5a, 0xf5, 0x7b, 0xcb, 0xf3, 0x01, 0x82, 0xa9, 0x94, 0x12, 0x82, 0xab, 0x0d, 0x23,
-	0x3e, 0x1f, 0x3e, 0x31, 0xbe, 0xc3, 0x7e, 0xa3, 0xae, 0x7c, 0x0b, 0x46, 0x19, 0xa0, 0x75, 0x6d,
-	0x87, 0x84, 0xb7, 0xc2, 0x91, 0x0f, 0x1e, 0x1d, 0xfd, 0xe0, 0x27, 0x60, 0xc0, 0x25, 0x15, 0x6d,
-	0x87, 0xb8, 0xe2, 0xa2, 0x08, 0x5f, 0xe5, 0x45, 0xc0, 0xd1, 0x6c, 0x82, 0xd3, 0x65, 0x38, 0xef,
-	0x04, 0x03, 0x45, 0xcd, 0x30, 0x5c, 0xe2, 0x79, 0x22, 0xe3, 0x08, 0x1b, 0x5c, 0xe2, 0x63, 0xf2,
-	0x9b, 0x42, 0x99, 0x15, 0x5a, 0xb5, 0x7d, 0xe2, 0x3a, 0x9a, 0xeb, 0xf7, 0x08, 0xd4, 0x6d, 0xd1,
-	0x5e, 0x12, 0x32, 0x0b, 0x80, 0x39, 0xc0, 0x7a, 0x64, 0xb2, 0xc8, 0x80, 0x89, 0x12, 0xa3, 0xfa,
-	0xd1, 0x30, 0xf9, 0xcb, 0xb0, 0x61, 0xad, 0x12, 0xf2, 0x82, 0xad, 0x95, 0x2a, 0xc4, 0x10, 0x37,
-	0xd8, 0x7f, 0x61, 0x0a, 0x1e, 0x85, 0x6d, 0x2b, 0x09, 0x8d, 0x20, 0x58, 0x82, 0xf1, 0x32, 0x21,
-	0x45, 0xc2, 0xa7, 0x8b, 0x42, 0xb5, 0xf0, 0x74, 0xcd, 0xa5, 0x5e, 0xa8, 0xb1, 0x94, 0x61, 0xd3,
-	0x2a, 0xc7, 0x6a, 0xf5, 0xee, 0x4a, 0x7d, 0x43, 0x9c, 0x84, 0x58, 0xf1, 0x50, 0xdc, 0x48, 0xa3,
-	0x42, 0x2d, 0x1a, 0x55, 0xe6, 0xc8, 0x11, 0x91, 0x97, 0xd2, 0xb6, 0xad, 0xae, 0xd3, 0x34, 0x0c,
-	0x47, 0x74, 0x62, 0xd9, 0x07, 0x0b, 0xd0, 0x20, 0x9b, 0xff, 0x63, 0x14, 0xce, 0xb2, 0x1c, 0xf8,
-	0x07, 0x04, 0x63, 0x09, 0xdd, 0x0c, 0xdf, 0x48, 0x15, 0xb3, 0x8d, 0x91, 0x94, 0x16, 0x8f, 0x11,
-	0xc9, 0x71, 0xcb, 0xb9, 0x8f, 0x1f, 0xff, 0xfe, 0x4d, 0xe6, 0x2a, 0xbe, 0xa2, 0x0a, 0xeb, 0x5b,
-	0xb7, 0xbc, 0x49, 0x7d, 0x14, 0x3f, 0xc8, 0x00, 0x8e, 0xa7, 0xc3, 0x0b, 0xdd, 0x02, 0x08, 0x91,
-	0xdf, 0xe8, 0x3e, 0x50, 0x00, 0xbf, 0x8f, 0x18, 0xf2, 0x0f, 0xf0, 0x5e, 0x0c, 0x79, 0x78, 0x48,
-	0xd5, 0xdd, 0xfa, 0xa5, 0xab, 0x34, 0x76, 0x77, 0x4f, 0x0d, 0xf6, 0xbc, 0x69, 0x52, 0x9c, 0x89,
-	0x3d, 0xd5, 0x0b, 0x60, 0xd9, 0x3a, 0x69, 0x9a, 0x0d, 0x07, 0xf7, 0x92, 0x24, 0xc1, 0x7f, 0x23,
-	0x98, 0x6a, 0xe9, 0x4d, 0xf0, 0x72, 0xd7, 0xbb, 0x13, 0x73, 0x6a, 0xd2, 0xca, 0xbf, 0xca, 0x21,
-	0x24, 0x7b, 0x9d, 0x29, 0xf6, 0x0a, 0x7e, 0xb9, 0x85, 0x62, 0x49, 0x3a, 0x85, 0xea, 0x24, 0x9e,
-	0x88, 0xbf, 0x10, 0x9c, 0x6f, 0xb2, 0x18, 0x38, 0xdf, 0x1a, 0x6b, 0x92, 0xdf, 0x91, 0xae, 0x75,
-	0x15, 0x23, 0xf8, 0x7c, 0xc4, 0x8f, 0xc0, 0x2e, 0xde, 0x39, 0xbd, 0x23, 0xe0, 0x07, 0x48, 0x8a,
-	0x75, 0xeb, 0x84, 0xff, 0x44, 0x30, 0x12, 0xb5, 0x1e, 0x78, 0xbe, 0x03, 0x26, 0xcd, 0x2e, 0x48,
-	0xca, 0x77, 0x13, 0x22, 0xb8, 0x7f, 0xc8, 0xb9, 0xdf, 0xc3, 0xef, 0x9e, 0x36, 0xf7, 0xd0, 0x50,
-	0xe1, 0x2f, 0x32, 0xf0, 0xbf, 0xa3, 0x6e, 0x04, 0x5f, 0xef, 0x80, 0x4b, 0xdc, 0x20, 0x49, 0xcf,
-	0x74, 0x1b, 0x26, 0x64, 0xf8, 0x94, 0xcb, 0xf0, 0x3e, 0x7e, 0xef, 0xb4, 0x65, 0x88, 0x7a, 0x2d,
-	0xfc, 0x2d, 0x82, 0xb3, 0xac, 0xc3, 0xe3, 0xb9, 0xd6, 0x44, 0xa2, 0xbe, 0x44, 0x7a, 0xaa, 0xa3,
-	0xb5, 0x82, 0xe9, 0x4d, 0x46, 0x74, 0x09, 0x3f, 0xdb, 0xe1, 0xc7, 0x2b, 0x3c, 0x8c, 0xa7, 0xee,
-	0x8a, 0xa7, 0x3d, 0x95, 0x99, 0x13, 0xfc, 0x33, 0x82, 0xd1, 0x98, 0xa1, 0xc1, 0x6d, 0x36, 0x20,
-	0xcd, 0x5b, 0x49, 0x0b, 0x5d, 0xc7, 0x09, 0x3e, 0x1b, 0x8c, 0xcf, 0xab, 0xf8, 0xd6, 0xf1, 0xf9,
-	0xc4, 0x9d, 0x17, 0xfe, 0x1e, 0x01, 0x8e, 0xbb, 0x99, 0x76, 0xfd, 0x29, 0xd5, 0x8d, 0xb5, 0xeb,
-	0x4f, 0xe9, 0xc6, 0x49, 0xfe, 0x3f, 0xe3, 0x97, 0xc5, 0x93, 0x31, 0x7e, 0x11, 0x9f, 0x80, 0xf7,
-	0x11, 0x8c, 0xc6, 0x92, 0xb4, 0xdb, 0x8c, 0x34, 0x7b, 0x23, 0x2d, 0x74, 0x1d, 0x27, 0xc0, 0xbe,
-	0xc4, 0xc0, 0x3e, 0x8f, 0x97, 0x8f, 0xd9, 0x19, 0x22, 0x94, 0x96, 0x6f, 0x3f, 0x3c, 0xc8, 0xa2,
-	0xfd, 0x83, 0x2c, 0xfa, 0xed, 0x20, 0x8b, 0xbe, 0x3e, 0xcc, 0xf6, 0xed, 0x1f, 0x66, 0xfb, 0x7e,
-	0x3a, 0xcc, 0xf6, 0xbd, 0x75, 0x3d, 0xfe, 0x43, 0xc3, 0x2a, 0xe9, 0x39, 0x93, 0xaa, 0xb5, 0x45,
-	0x75, 0x9b, 0x1a, 0xd5, 0x0a, 0xf1, 0x78, 0xf1, 0xfc, 0x62, 0x2e, 0xa8, 0xcf, 0x7e, 0x7b, 0x94,
-	0xce, 0xb1, 0xff, 0xa8, 0x5d, 0xfb, 0x27, 0x00, 0x00, 0xff, 0xff, 0x17, 0xf5, 0x3e, 0x92, 0x7e,
-	0x14, 0x00, 0x00,
+	// 1642 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0xcf, 0x6f, 0x1b, 0x45,
+	0x1b, 0xce, 0xb8, 0x3f, 0x92, 0xbc, 0x49, 0xbf, 0xef, 0xcb, 0x34, 0x6d, 0x9c, 0xfd, 0x1a, 0x27,
+	0xdd, 0x90, 0x36, 0xa4, 0x64, 0x97, 0xb8, 0x2a, 0x69, 0x04, 0x12, 0x24, 0x29, 0x29, 0x81, 0x42,
+	0x53, 0xb7, 0x52, 0x11, 0x02, 0xb9, 0xeb, 0xf5, 0xd8, 0x59, 0xc5, 0xd9, 0xdd, 0x7a, 0xd7, 0x11,
+	0x69, 0x09, 0x50, 0xa0, 0x50, 0x09, 0x50, 0x41, 0x5c, 0xf8, 0x17, 0x40, 0x42, 0x82, 0x03, 0x07,
+	0xfe, 0x83, 0x9e, 0xaa, 0x48, 0x3d, 0x80, 0x38, 0x50, 0xd4, 0x72, 0xe4, 0x0f, 0xe0, 0x00, 0x12,
+	0xda, 0xd9, 0x77, 0x9c, 0xb5, 0x77, 0xd7, 0x8e, 0x83, 0x1b, 0x4e, 0xf6, 0xce, 0xcc, 0xfb, 0xce,
+	0xf3, 0x3c, 0x33, 0xf3, 0xce, 0x3c, 0x30, 0x6a, 0xe4, 0x74, 0x55, 0xb3, 0xed, 0x92, 0xa1, 0x6b,
+	0xae, 0x61, 0x99, 0x8e, 0x5a, 0x60, 0x4c, 0x5d, 0x9b, 0x52, 0xaf, 0x56, 0x58, 0x79, 0x5d, 0xb1,
+	0xcb, 0x96, 0x6b, 0xd1, 0x01, 0x23, 0xa7, 0x2b, 0xc1, 0x41, 0x4a, 0x81, 0x31, 0x65, 0x6d, 0x4a,
+	0xea, 0x2f, 0x5a, 0x45, 0x8b, 0x8f, 0x51, 0xbd, 0x7f, 0xfe, 0x70, 0x29, 0x55, 0xb4, 0xac, 0x62,
+	0x89, 0xa9, 0xfc, 0x2b, 0x57, 0x29, 0xa8, 0xf9, 0x4a, 0x99, 0xc7, 0x61, 0xff, 0x11, 0xec, 0xd7,
+	0x6c, 0x43, 0xd5, 0x4c, 0xd3, 0x72, 0x31, 0x29, 0x46, 0xeb, 0x96, 0xb3, 0x6a, 0x39, 0x6a, 0x4e,
+	0x73, 0x3c, 0x20, 0x39, 0xe6, 0x6a, 0x53, 0xaa, 0x6e, 0x19, 0x22, 0x7a, 0x22, 0xd8, 0xcf, 0x51,
+	0x56, 0x47, 0xd9, 0x5a, 0xd1, 0x30, 0x83, 0x33, 0x1d, 0x8d, 0x63, 0xe7, 0xe1, 0xf7, 0x87, 0x8c,
+	0xc5, 0x0d, 0x29, 0x32, 0x93, 0x39, 0x86, 0x13, 0xcc, 0xa4, 0x5b, 0x65, 0xa6, 0xea, 0xcb, 0x9a,
+	0x69, 0xb2, 0x92, 0x37, 0x04, 0xff, 0xfa, 0x43, 0xe4, 0x4f, 0x08, 0x0c, 0x5f, 0xf0, 0xf0, 0x2c,
+	0x9a, 0x3a, 0x33, 0x5d, 0x63, 0xcd, 0xb8, 0xc6, 0xf2, 0x4b, 0x9a, 0xbe, 0xc2, 0x5c, 0x27, 0xc3,
+	0xae, 0x56, 0x98, 0xe3, 0xd2, 0x05, 0x80, 0x2d, 0x90, 0x49, 0x32, 0x42, 0xc6, 0x7b, 0xd2, 0xc7,
+	0x14, 0x9f, 0x91, 0xe2, 0x31, 0x52, 0x7c, 0xdd, 0x91, 0x91, 0xb2, 0xa4, 0x15, 0x19, 0xc6, 0x66,
+	0x02, 0x91, 0xf4, 0x28, 0xf4, 0xf2, 0x81, 0xd9, 0x65, 0x66, 0x14, 0x97, 0xdd, 0x64, 0x62, 0x84,
+	0x8c, 0xef, 0xcd, 0xf4, 0xf0, 0xb6, 0x17, 0x78, 0x93, 0x7c, 0x8f, 0xc0, 0x48, 0x3c, 0x1c, 0xc7,
+	0xb6, 0x4c, 0x87, 0xd1, 0x02, 0xf4, 0x1b, 0x81, 0xee, 0xac, 0xed, 0xf7, 0x27, 0xc9, 0xc8, 0x9e,
+	0xf1, 0x9e, 0xf4, 0xa4, 0x12, 0xb3, 0xf0, 0xca, 0x62, 0xde, 0x8b, 0x29, 0x18, 0x22, 0xe3, 0x02,
+	0x63, 0xce, 0xdc, 0xde, 0x3b, 0xbf, 0x0c, 0x77, 0x64, 0x0e, 0x1a, 0xe1, 0xf9, 0xe8, 0xd9, 0x1a,
+	0xde, 0x09, 0xce, 0xfb, 0x78, 0x53, 0xde, 0x3e, 0xc8, 0x20, 0x71, 0xf9, 0x26, 0x81, 0x54, 0x0c,
+	0x2b, 0xa1, 0xf1, 0x73, 0xd0, 0xed, 0xd3, 0xc8, 0x1a, 0x79, 0x94, 0x78, 0x88, 0x13, 0xf1, 0x96,
+	0x4f, 0x11, 0x6b, 0xb6, 0xe6, 0x4d, 0xe2, 0x8d, 0x5a, 0xcc, 0x23, 0xf0, 0x2e, 0x1b, 0xbf, 0xb7,
+	0xa3, 0xee, 0x47, 0xf1, 0x8b, 0x5d, 0x15, 0x37, 0x0f, 0x07, 0x23, 0xc4, 0x45, 0x48, 0x3b, 0xd2,
+	0x96, 0x86, 0xb5, 0x95, 0xef, 0x12, 0x78, 0x3c, 0x6e, 0x9d, 0x17, 0xac, 0xf2, 0xbc, 0xcf, 0xb7,
+	0xdd, 0x1b, 0x70, 0x00, 0x3a, 0x6d, 0xab, 0xcc, 0x25, 0xf6, 0xd4, 0xe9, 0xce, 0xec, 0xf7, 0x3e,
+	0x17, 0xf3, 0x74, 0x08, 0x00, 0x25, 0xf6, 0xfa, 0xf6, 0xf0, 0xbe, 0x6e, 0x6c, 0x89, 0x90, 0x76,
+	0x6f, 0x58, 0xda, 0x1f, 0x09, 0x4c, 0x6c, 0x87, 0x10, 0xaa, 0x7c, 0xa5, 0x8d, 0x5b, 0xf8, 0x11,
+	0x6f, 0xde, 0x37, 0x60, 0x90, 0x13, 0xbb, 0x64, 0xb9, 0x5a, 0x29, 0xc3, 0xf4, 0x35, 0x3e, 0x67,
+	0xbb, 0xb6, 0xad, 0xfc, 0x21, 0x01, 0x29, 0x2a, 0x3f, 0x0a, 0xb5, 0x0c, 0xdd, 0x65, 0xa6, 0xaf,
+	0x65, 0x0b, 0x8c, 0x09, 0x75, 0x06, 0x6b, 0x58, 0x08, 0xfc, 0xf3, 0x96, 0x61, 0xce, 0x3d, 0xe9,
+	0x25, 0xff, 0xfa, 0xfe, 0xf0, 0x78, 0xd1, 0x70, 0x97, 0x2b, 0x39, 0x45, 0xb7, 0x56, 0x55, 0xac,
+	0xbc, 0xfe, 0xcf, 0xa4, 0x93, 0x5f, 0x51, 0xdd, 0x75, 0x9b, 0x39, 0x3c, 0xc0, 0xc9, 0x74, 0x95,
+	0x71, 0x46, 0xf9, 0x75, 0x48, 0x6e, 0xe1, 0x98, 0xd5, 0x57, 0xda, 0x4b, 0xf3, 0x7d, 0x02, 0x83,
+	0x11, 0xe9, 0xab, 0x15, 0xad, 0x4b, 0xd3, 0x57, 0x1e, 0x19, 0xc9, 0x4e, 0xcd, 0x9f, 0x4f, 0xbe,
+	0x02, 0x47, 0xb6, 0x40, 0x5c, 0x32, 0x56, 0x99, 0x55, 0x71, 0xdb, 0xcb, 0xf3, 0x36, 0x81, 0xa1,
+	0x98, 0x29, 0x90, 0xab, 0x09, 0xbd, 0xae, 0xdf, 0xfc, 0xc8, 0xf8, 0xf6, 0xb8, 0x5b, 0xf3, 0xca,
+	0xe7, 0xa0, 0x8f, 0x03, 0x5a, 0xd2, 0xd6, 0x99, 0xa8, 0x0a, 0x75, 0x07, 0x9e, 0xd4, 0x1f, 0xf8,
+	0x24, 0x74, 0x96, 0x59, 0x49, 0x5b, 0x67, 0x65, 0x2c, 0x14, 0xe2, 0x53, 0x9e, 0x01, 0x1a, 0xcc,
+	0x86, 0x9c, 0x46, 0xe1, 0x80, 0xed, 0x35, 0x64, 0xb5, 0x7c, 0xbe, 0xcc, 0x1c, 0x07, 0x33, 0xf6,
+	0xf2, 0xc6, 0x59, 0xbf, 0x4d, 0x7e, 0x15, 0x95, 0x99, 0xb7, 0x2a, 0xa6, 0xcb, 0xca, 0xb6, 0x56,
+	0x76, 0xdb, 0x04, 0xea, 0x3c, 0xa4, 0xe2, 0x32, 0x23, 0xc0, 0x49, 0xa0, 0x7a, 0xa0, 0x33, 0xcb,
+	0x81, 0xe1, 0x14, 0x7d, 0x7a, 0x7d, 0x98, 0xfc, 0xb1, 0xb8, 0xb0, 0x16, 0x18, 0x7b, 0xde, 0xd4,
+	0x72, 0x25, 0x96, 0xc7, 0x0a, 0xf6, 0x6f, 0x3c, 0x0a, 0xee, 0x8a, 0x6b, 0x2b, 0x0a, 0x0d, 0x12,
+	0xcc, 0x41, 0x7f, 0x81, 0xb1, 0x2c, 0xf3, 0xbb, 0xb3, 0xa8, 0x9a, 0xd8, 0x5d, 0x13, 0xb1, 0x05,
+	0x35, 0x94, 0x52, 0x5c, 0x5a, 0x85, 0xd0, 0x5c, 0xed, 0x2b, 0xa9, 0x97, 0x71, 0x27, 0x84, 0x26,
+	0x17, 0xe2, 0x06, 0x2e, 0x2a, 0xd2, 0xe0, 0xa2, 0x4a, 0xd4, 0x6d, 0x11, 0x79, 0x36, 0x6e, 0xd9,
+	0xaa, 0x3a, 0x0d, 0x43, 0x4f, 0x40, 0x27, 0x9e, 0xbd, 0x2b, 0x03, 0x5b, 0x64, 0xe5, 0x5b, 0x04,
+	0x64, 0x3c, 0xc0, 0x76, 0x83, 0x37, 0xe1, 0x0e, 0x11, 0xd2, 0x7e, 0xd8, 0x97, 0x67, 0xa6, 0xb5,
+	0x8a, 0x97, 0xac, 0xff, 0xe1, 0xb5, 0x96, 0x8c, 0x55, 0x43, 0xdc, 0xac, 0xfe, 0x87, 0xfc, 0x29,
+	0x81, 0xd1, 0x86, 0x50, 0x76, 0xf7, 0x3d, 0x28, 0xcf, 0xe3, 0x0d, 0x91, 0xf1, 0x8f, 0xdd, 0x45,
+	0x57, 0xdb, 0xd2, 0xe3, 0x38, 0xfc, 0x17, 0x4f, 0x63, 0x5d, 0x0d, 0xf8, 0x0f, 0x36, 0x8b, 0x2a,
+	0xf0, 0xbd, 0xb8, 0x08, 0x6a, 0xb3, 0x20, 0x95, 0x25, 0x38, 0x20, 0xd2, 0x38, 0x5e, 0x07, 0x1e,
+	0xac, 0xb1, 0x58, 0x0e, 0xc1, 0x2c, 0x88, 0xbd, 0xb7, 0x1c, 0x68, 0xa3, 0x0b, 0xd0, 0x69, 0x6b,
+	0xeb, 0x56, 0xc5, 0x75, 0x92, 0x09, 0xae, 0xc7, 0xb1, 0x66, 0xb9, 0x96, 0xf8, 0x70, 0x4c, 0x26,
+	0x82, 0xe5, 0x01, 0x38, 0x24, 0xb6, 0xd6, 0x5c, 0x25, 0x5f, 0xac, 0xbe, 0x5c, 0xe5, 0x1b, 0x09,
+	0x38, 0x5c, 0xdf, 0x83, 0x6c, 0x92, 0xd0, 0x59, 0x2b, 0x86, 0xf8, 0xa4, 0x0c, 0x3a, 0x73, 0x5a,
+	0x49, 0x33, 0x75, 0x96, 0x4c, 0xb4, 0xbf, 0xfe, 0x8b, 0xdc, 0xb4, 0x00, 0x87, 0xb4, 0x8a, 0x6b,
+	0x65, 0xc5, 0x6a, 0xb2, 0xac, 0x6d, 0x95, 0x0c, 0x7d, 0x9d, 0xef, 0xbe, 0x9e, 0xf4, 0x13, 0xb1,
+	0x52, 0xcc, 0x56, 0x5c, 0x4b, 0x6c, 0x39, 0xb6, 0xc4, 0x63, 0xc4, 0xce, 0xd0, 0xc2, 0x5d, 0xf2,
+	0xb0, 0x28, 0xed, 0xde, 0xac, 0xa5, 0x12, 0xcf, 0x75, 0xc6, 0x53, 0x53, 0x88, 0xe4, 0x42, 0x2a,
+	0x6e, 0x00, 0x6a, 0x95, 0x01, 0xaa, 0x07, 0x3a, 0xb3, 0x79, 0xaf, 0x17, 0x97, 0x7f, 0x50, 0xf1,
+	0xcd, 0xa7, 0x22, 0xcc, 0xa9, 0x72, 0x06, 0xcd, 0xe9, 0x5c, 0x97, 0x07, 0xea, 0xcb, 0xfb, 0xc3,
+	0x24, 0xd3, 0xa7, 0xd7, 0xe7, 0x4e, 0x6f, 0x0e, 0xc0, 0x3e, 0x3e, 0x2d, 0xfd, 0x81, 0xc0, 0xc1,
+	0x88, 0x23, 0x44, 0x4f, 0xc7, 0x2a, 0xd0, 0xc4, 0x14, 0x4a, 0x33, 0x3b, 0x88, 0xf4, 0xa9, 0xca,
+	0x93, 0xef, 0xdd, 0xfb, 0xed, 0x8b, 0xc4, 0x71, 0x3a, 0xa6, 0xa2, 0x8d, 0xad, 0xda, 0xd7, 0xa8,
+	0x63, 0x4c, 0x6f, 0x27, 0x80, 0x86, 0xd3, 0xd1, 0xe9, 0x56, 0x01, 0x08, 0xe4, 0xa7, 0x5b, 0x0f,
+	0x44, 0xe0, 0x37, 0x09, 0x47, 0xfe, 0x0e, 0xdd, 0x08, 0x21, 0x17, 0x17, 0x8e, 0x7a, 0xbd, 0xfa,
+	0x80, 0x52, 0xb6, 0xea, 0xe0, 0x86, 0xea, 0x55, 0xc7, 0x9a, 0x4e, 0xac, 0x9e, 0x1b, 0xaa, 0xe3,
+	0xc1, 0x32, 0x75, 0x56, 0xd3, 0x2b, 0x1a, 0x37, 0xa2, 0x24, 0xa1, 0x7f, 0x11, 0x18, 0x6a, 0xe8,
+	0x33, 0xe8, 0x5c, 0xcb, 0xab, 0x13, 0x72, 0x5d, 0xd2, 0xfc, 0x3f, 0xca, 0x81, 0x92, 0x5d, 0xe4,
+	0x8a, 0xbd, 0x4c, 0x5f, 0x6a, 0xa0, 0x58, 0x94, 0x4e, 0x42, 0x9d, 0xc8, 0x1d, 0xf1, 0x27, 0x81,
+	0x03, 0x35, 0x76, 0x81, 0xa6, 0x1b, 0x63, 0x8d, 0xf2, 0x2e, 0xd2, 0xc9, 0x96, 0x62, 0x90, 0xcf,
+	0x0d, 0x7f, 0x0b, 0x5c, 0xa7, 0xeb, 0xbb, 0xb7, 0x05, 0x5c, 0x0f, 0x49, 0xb6, 0x6a, 0x83, 0xe8,
+	0x1f, 0x04, 0x7a, 0x83, 0x36, 0x82, 0x4e, 0x6d, 0x83, 0x49, 0xad, 0xa3, 0x91, 0xd2, 0xad, 0x84,
+	0x20, 0xf7, 0x77, 0x7d, 0xee, 0xd7, 0xe8, 0x9b, 0xbb, 0xcd, 0x5d, 0x98, 0x23, 0x7a, 0x2b, 0x01,
+	0xff, 0xab, 0x77, 0x16, 0xf4, 0xd4, 0x36, 0xb8, 0x84, 0xcd, 0x8e, 0xf4, 0x54, 0xab, 0x61, 0x28,
+	0xc3, 0x07, 0xbe, 0x0c, 0x6f, 0xd3, 0xb7, 0x76, 0x5b, 0x86, 0xa0, 0x6f, 0xa2, 0x5f, 0x11, 0xd8,
+	0xc7, 0x5f, 0xeb, 0x74, 0xa2, 0x31, 0x91, 0xa0, 0xc7, 0x90, 0x4e, 0x6c, 0x6b, 0x2c, 0x32, 0x3d,
+	0xcb, 0x89, 0xce, 0xd2, 0x67, 0xb7, 0x79, 0x78, 0xf1, 0xe1, 0xe1, 0xa8, 0xd7, 0xf1, 0xdf, 0x86,
+	0xca, 0x8d, 0x06, 0xfd, 0x99, 0x40, 0x5f, 0xc8, 0x9c, 0xd0, 0x26, 0x0b, 0x10, 0xe7, 0x93, 0xa4,
+	0xe9, 0x96, 0xe3, 0x90, 0xcf, 0x25, 0xce, 0xe7, 0x15, 0x7a, 0x6e, 0xe7, 0x7c, 0xc2, 0x2e, 0x8a,
+	0x7e, 0x43, 0x80, 0x86, 0x9d, 0x49, 0xb3, 0xfb, 0x29, 0xd6, 0x59, 0x49, 0xa7, 0x5b, 0x0f, 0x44,
+	0x7e, 0x8f, 0x71, 0x7e, 0x29, 0x7a, 0x24, 0xc4, 0x2f, 0xf0, 0xe6, 0xa7, 0x9b, 0x04, 0xfa, 0x42,
+	0x49, 0x9a, 0x2d, 0x46, 0x9c, 0x55, 0x91, 0xa6, 0x5b, 0x8e, 0x43, 0xb0, 0x2f, 0x72, 0xb0, 0x67,
+	0xe8, 0xdc, 0x0e, 0x6f, 0x86, 0x20, 0xa5, 0xdf, 0x09, 0x1c, 0x8e, 0x36, 0x09, 0xf4, 0xe9, 0x66,
+	0xa7, 0xbc, 0x81, 0xcb, 0x91, 0x9e, 0xd9, 0x59, 0x30, 0x32, 0xbc, 0xcc, 0x19, 0x5e, 0xa0, 0xe7,
+	0x77, 0xc8, 0xd0, 0xb5, 0xec, 0x6c, 0xe4, 0xfd, 0xf7, 0x2d, 0x81, 0xde, 0xe0, 0xc3, 0xbf, 0xd9,
+	0x05, 0x10, 0x61, 0x58, 0xa4, 0x74, 0x2b, 0x21, 0x48, 0x68, 0x86, 0x13, 0x3a, 0x49, 0xa7, 0x42,
+	0x84, 0x42, 0x27, 0x45, 0xb8, 0xa0, 0x0d, 0x95, 0xfb, 0x18, 0xfa, 0x39, 0x81, 0xee, 0xaa, 0x41,
+	0xa0, 0x4a, 0xd3, 0x4d, 0x53, 0xe3, 0x31, 0x24, 0x75, 0xdb, 0xe3, 0x11, 0xe9, 0x28, 0x47, 0x3a,
+	0x44, 0xff, 0x1f, 0x79, 0x12, 0x72, 0x3e, 0x8a, 0xef, 0xbc, 0xaa, 0x54, 0xff, 0x68, 0x6e, 0x5a,
+	0x95, 0x62, 0x9e, 0xf8, 0xd2, 0x74, 0xcb, 0x71, 0x88, 0xf5, 0x04, 0xc7, 0x3a, 0x46, 0x47, 0xc3,
+	0xdb, 0x24, 0x64, 0x08, 0xe6, 0xce, 0xdf, 0x79, 0x90, 0x22, 0x9b, 0x0f, 0x52, 0xe4, 0xd7, 0x07,
+	0x29, 0xf2, 0xd9, 0xc3, 0x54, 0xc7, 0xe6, 0xc3, 0x54, 0xc7, 0x4f, 0x0f, 0x53, 0x1d, 0xaf, 0x9d,
+	0x0a, 0xdb, 0x23, 0x23, 0xa7, 0x4f, 0x16, 0x2d, 0x75, 0x6d, 0x46, 0x5d, 0xb5, 0xf2, 0x95, 0x12,
+	0x73, 0xfc, 0xec, 0xe9, 0x99, 0x49, 0x6f, 0x02, 0xee, 0x98, 0x72, 0xfb, 0xb9, 0xa7, 0x38, 0xf9,
+	0xf7, 0x00, 0x6a, 0x9a, 0x0c, 0x5e, 0x54, 0x1b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1211,6 +1651,15 @@ type QueryClient interface {
 	FeeEnabledChannels(ctx context.Context, in *QueryFeeEnabledChannelsRequest, opts ...grpc.CallOption) (*QueryFeeEnabledChannelsResponse, error)
 	// FeeEnabledChannel returns true if the provided port and channel identifiers belong to a fee enabled channel
 	FeeEnabledChannel(ctx context.Context, in *QueryFeeEnabledChannelRequest, opts ...grpc.CallOption) (*QueryFeeEnabledChannelResponse, error)
+	// TopIncentivizedPackets returns the incentivized packets for a specific channel ranked by the total escrowed fee
+	// in the given denomination
+	TopIncentivizedPackets(ctx context.Context, in *QueryTopIncentivizedPacketsRequest, opts ...grpc.CallOption) (*QueryTopIncentivizedPacketsResponse, error)
+	// RelayerStats returns the fee payout statistics and recent payouts of a relayer address
+	RelayerStats(ctx context.Context, in *QueryRelayerStatsRequest, opts ...grpc.CallOption) (*QueryRelayerStatsResponse, error)
+	// FeeBudget returns the remaining fee budget and the policy used to incentivize outgoing packets from it
+	FeeBudget(ctx context.Context, in *QueryFeeBudgetRequest, opts ...grpc.CallOption) (*QueryFeeBudgetResponse, error)
+	// CancellationDelay returns the delay after which escrowed packet fees may be cancelled by their refund address
+	CancellationDelay(ctx context.Context, in *QueryCancellationDelayRequest, opts ...grpc.CallOption) (*QueryCancellationDelayResponse, error)
 }
 
 type queryClient struct {
@@ -1311,28 +1760,73 @@ func (c *queryClient) FeeEnabledChannel(ctx context.Context, in *QueryFeeEnabled
 	return out, nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// IncentivizedPackets returns all incentivized packets and their associated fees
-	IncentivizedPackets(context.Context, *QueryIncentivizedPacketsRequest) (*QueryIncentivizedPacketsResponse, error)
-	// IncentivizedPacket returns all packet fees for a packet given its identifier
-	IncentivizedPacket(context.Context, *QueryIncentivizedPacketRequest) (*QueryIncentivizedPacketResponse, error)
-	// Gets all incentivized packets for a specific channel
-	IncentivizedPacketsForChannel(context.Context, *QueryIncentivizedPacketsForChannelRequest) (*QueryIncentivizedPacketsForChannelResponse, error)
-	// TotalRecvFees returns the total receive fees for a packet given its identifier
-	TotalRecvFees(context.Context, *QueryTotalRecvFeesRequest) (*QueryTotalRecvFeesResponse, error)
-	// TotalAckFees returns the total acknowledgement fees for a packet given its identifier
-	TotalAckFees(context.Context, *QueryTotalAckFeesRequest) (*QueryTotalAckFeesResponse, error)
-	// TotalTimeoutFees returns the total timeout fees for a packet given its identifier
-	TotalTimeoutFees(context.Context, *QueryTotalTimeoutFeesRequest) (*QueryTotalTimeoutFeesResponse, error)
-	// Payee returns the registered payee address for a specific channel given the relayer address
-	Payee(context.Context, *QueryPayeeRequest) (*QueryPayeeResponse, error)
-	// CounterpartyPayee returns the registered counterparty payee for forward relaying
-	CounterpartyPayee(context.Context, *QueryCounterpartyPayeeRequest) (*QueryCounterpartyPayeeResponse, error)
-	// FeeEnabledChannels returns a list of all fee enabled channels
-	FeeEnabledChannels(context.Context, *QueryFeeEnabledChannelsRequest) (*QueryFeeEnabledChannelsResponse, error)
-	// FeeEnabledChannel returns true if the provided port and channel identifiers belong to a fee enabled channel
-	FeeEnabledChannel(context.Context, *QueryFeeEnabledChannelRequest) (*QueryFeeEnabledChannelResponse, error)
+func (c *queryClient) TopIncentivizedPackets(ctx context.Context, in *QueryTopIncentivizedPacketsRequest, opts ...grpc.CallOption) (*QueryTopIncentivizedPacketsResponse, error) {
+	out := new(QueryTopIncentivizedPacketsResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.fee.v1.Query/TopIncentivizedPackets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RelayerStats(ctx context.Context, in *QueryRelayerStatsRequest, opts ...grpc.CallOption) (*QueryRelayerStatsResponse, error) {
+	out := new(QueryRelayerStatsResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.fee.v1.Query/RelayerStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FeeBudget(ctx context.Context, in *QueryFeeBudgetRequest, opts ...grpc.CallOption) (*QueryFeeBudgetResponse, error) {
+	out := new(QueryFeeBudgetResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.fee.v1.Query/FeeBudget", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CancellationDelay(ctx context.Context, in *QueryCancellationDelayRequest, opts ...grpc.CallOption) (*QueryCancellationDelayResponse, error) {
+	out := new(QueryCancellationDelayResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.fee.v1.Query/CancellationDelay", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// IncentivizedPackets returns all incentivized packets and their associated fees
+	IncentivizedPackets(context.Context, *QueryIncentivizedPacketsRequest) (*QueryIncentivizedPacketsResponse, error)
+	// IncentivizedPacket returns all packet fees for a packet given its identifier
+	IncentivizedPacket(context.Context, *QueryIncentivizedPacketRequest) (*QueryIncentivizedPacketResponse, error)
+	// Gets all incentivized packets for a specific channel
+	IncentivizedPacketsForChannel(context.Context, *QueryIncentivizedPacketsForChannelRequest) (*QueryIncentivizedPacketsForChannelResponse, error)
+	// TotalRecvFees returns the total receive fees for a packet given its identifier
+	TotalRecvFees(context.Context, *QueryTotalRecvFeesRequest) (*QueryTotalRecvFeesResponse, error)
+	// TotalAckFees returns the total acknowledgement fees for a packet given its identifier
+	TotalAckFees(context.Context, *QueryTotalAckFeesRequest) (*QueryTotalAckFeesResponse, error)
+	// TotalTimeoutFees returns the total timeout fees for a packet given its identifier
+	TotalTimeoutFees(context.Context, *QueryTotalTimeoutFeesRequest) (*QueryTotalTimeoutFeesResponse, error)
+	// Payee returns the registered payee address for a specific channel given the relayer address
+	Payee(context.Context, *QueryPayeeRequest) (*QueryPayeeResponse, error)
+	// CounterpartyPayee returns the registered counterparty payee for forward relaying
+	CounterpartyPayee(context.Context, *QueryCounterpartyPayeeRequest) (*QueryCounterpartyPayeeResponse, error)
+	// FeeEnabledChannels returns a list of all fee enabled channels
+	FeeEnabledChannels(context.Context, *QueryFeeEnabledChannelsRequest) (*QueryFeeEnabledChannelsResponse, error)
+	// FeeEnabledChannel returns true if the provided port and channel identifiers belong to a fee enabled channel
+	FeeEnabledChannel(context.Context, *QueryFeeEnabledChannelRequest) (*QueryFeeEnabledChannelResponse, error)
+	// TopIncentivizedPackets returns the incentivized packets for a specific channel ranked by the total escrowed fee
+	// in the given denomination
+	TopIncentivizedPackets(context.Context, *QueryTopIncentivizedPacketsRequest) (*QueryTopIncentivizedPacketsResponse, error)
+	// RelayerStats returns the fee payout statistics and recent payouts of a relayer address
+	RelayerStats(context.Context, *QueryRelayerStatsRequest) (*QueryRelayerStatsResponse, error)
+	// FeeBudget returns the remaining fee budget and the policy used to incentivize outgoing packets from it
+	FeeBudget(context.Context, *QueryFeeBudgetRequest) (*QueryFeeBudgetResponse, error)
+	// CancellationDelay returns the delay after which escrowed packet fees may be cancelled by their refund address
+	CancellationDelay(context.Context, *QueryCancellationDelayRequest) (*QueryCancellationDelayResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1369,6 +1863,18 @@ func (*UnimplementedQueryServer) FeeEnabledChannels(ctx context.Context, req *Qu
 func (*UnimplementedQueryServer) FeeEnabledChannel(ctx context.Context, req *QueryFeeEnabledChannelRequest) (*QueryFeeEnabledChannelResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FeeEnabledChannel not implemented")
 }
+func (*UnimplementedQueryServer) TopIncentivizedPackets(ctx context.Context, req *QueryTopIncentivizedPacketsRequest) (*QueryTopIncentivizedPacketsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TopIncentivizedPackets not implemented")
+}
+func (*UnimplementedQueryServer) RelayerStats(ctx context.Context, req *QueryRelayerStatsRequest) (*QueryRelayerStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RelayerStats not implemented")
+}
+func (*UnimplementedQueryServer) FeeBudget(ctx context.Context, req *QueryFeeBudgetRequest) (*QueryFeeBudgetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeBudget not implemented")
+}
+func (*UnimplementedQueryServer) CancellationDelay(ctx context.Context, req *QueryCancellationDelayRequest) (*QueryCancellationDelayResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancellationDelay not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1554,6 +2060,79 @@ func _Query_FeeEnabledChannel_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_TopIncentivizedPackets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTopIncentivizedPacketsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TopIncentivizedPackets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.fee.v1.Query/TopIncentivizedPackets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TopIncentivizedPackets(ctx, req.(*QueryTopIncentivizedPacketsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RelayerStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRelayerStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RelayerStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.fee.v1.Query/RelayerStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RelayerStats(ctx, req.(*QueryRelayerStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FeeBudget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeeBudgetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeeBudget(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.fee.v1.Query/FeeBudget",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeeBudget(ctx, req.(*QueryFeeBudgetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CancellationDelay_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCancellationDelayRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CancellationDelay(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.fee.v1.Query/CancellationDelay",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CancellationDelay(ctx, req.(*QueryCancellationDelayRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ibc.applications.fee.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1598,6 +2177,22 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "FeeEnabledChannel",
 			Handler:    _Query_FeeEnabledChannel_Handler,
 		},
+		{
+			MethodName: "TopIncentivizedPackets",
+			Handler:    _Query_TopIncentivizedPackets_Handler,
+		},
+		{
+			MethodName: "RelayerStats",
+			Handler:    _Query_RelayerStats_Handler,
+		},
+		{
+			MethodName: "FeeBudget",
+			Handler:    _Query_FeeBudget_Handler,
+		},
+		{
+			MethodName: "CancellationDelay",
+			Handler:    _Query_CancellationDelay_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ibc/applications/fee/v1/query.proto",
@@ -2369,92 +2964,386 @@ func (m *QueryFeeEnabledChannelResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryTopIncentivizedPacketsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryIncentivizedPacketsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *QueryTopIncentivizedPacketsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTopIncentivizedPacketsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Limit != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.QueryHeight != 0 {
-		n += 1 + sovQuery(uint64(m.QueryHeight))
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryIncentivizedPacketsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryTopIncentivizedPacketsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTopIncentivizedPacketsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTopIncentivizedPacketsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if len(m.IncentivizedPackets) > 0 {
-		for _, e := range m.IncentivizedPackets {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+		for iNdEx := len(m.IncentivizedPackets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IncentivizedPackets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryIncentivizedPacketRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryRelayerStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRelayerStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRelayerStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.PacketId.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	if m.QueryHeight != 0 {
-		n += 1 + sovQuery(uint64(m.QueryHeight))
+	if len(m.RelayerAddress) > 0 {
+		i -= len(m.RelayerAddress)
+		copy(dAtA[i:], m.RelayerAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RelayerAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryIncentivizedPacketResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryRelayerStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.IncentivizedPacket.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryIncentivizedPacketsForChannelRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryRelayerStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRelayerStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.PortId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.ChannelId)
+	if len(m.Payouts) > 0 {
+		for iNdEx := len(m.Payouts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Payouts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.RelayerStats.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeBudgetRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeBudgetRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeBudgetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeBudgetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeBudgetResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeBudgetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.AutoIncentivePolicy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Balance) > 0 {
+		for iNdEx := len(m.Balance) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Balance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCancellationDelayRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCancellationDelayRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCancellationDelayRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCancellationDelayResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCancellationDelayResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCancellationDelayResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n14, err14 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.CancellationDelay, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.CancellationDelay):])
+	if err14 != nil {
+		return 0, err14
+	}
+	i -= n14
+	i = encodeVarintQuery(dAtA, i, uint64(n14))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryIncentivizedPacketsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.QueryHeight != 0 {
+		n += 1 + sovQuery(uint64(m.QueryHeight))
+	}
+	return n
+}
+
+func (m *QueryIncentivizedPacketsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.IncentivizedPackets) > 0 {
+		for _, e := range m.IncentivizedPackets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIncentivizedPacketRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PacketId.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.QueryHeight != 0 {
+		n += 1 + sovQuery(uint64(m.QueryHeight))
+	}
+	return n
+}
+
+func (m *QueryIncentivizedPacketResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.IncentivizedPacket.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryIncentivizedPacketsForChannelRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChannelId)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
@@ -2677,21 +3566,550 @@ func (m *QueryFeeEnabledChannelResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
-	var l int
-	_ = l
-	if m.FeeEnabled {
-		n += 2
+	var l int
+	_ = l
+	if m.FeeEnabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryTopIncentivizedPacketsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovQuery(uint64(m.Limit))
+	}
+	return n
+}
+
+func (m *QueryTopIncentivizedPacketsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.IncentivizedPackets) > 0 {
+		for _, e := range m.IncentivizedPackets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryRelayerStatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RelayerAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRelayerStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.RelayerStats.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Payouts) > 0 {
+		for _, e := range m.Payouts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryFeeBudgetRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryFeeBudgetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Balance) > 0 {
+		for _, e := range m.Balance {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.AutoIncentivePolicy.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryCancellationDelayRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryCancellationDelayResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.CancellationDelay)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryIncentivizedPacketsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIncentivizedPacketsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIncentivizedPacketsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryHeight", wireType)
+			}
+			m.QueryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QueryHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIncentivizedPacketsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIncentivizedPacketsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIncentivizedPacketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncentivizedPackets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IncentivizedPackets = append(m.IncentivizedPackets, IdentifiedPacketFees{})
+			if err := m.IncentivizedPackets[len(m.IncentivizedPackets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIncentivizedPacketRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIncentivizedPacketRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIncentivizedPacketRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PacketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryHeight", wireType)
+			}
+			m.QueryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QueryHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
+func (m *QueryIncentivizedPacketResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIncentivizedPacketResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIncentivizedPacketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncentivizedPacket", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.IncentivizedPacket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryIncentivizedPacketsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryIncentivizedPacketsForChannelRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2714,10 +4132,10 @@ func (m *QueryIncentivizedPacketsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryIncentivizedPacketsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryIncentivizedPacketsForChannelRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryIncentivizedPacketsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryIncentivizedPacketsForChannelRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2757,6 +4175,70 @@ func (m *QueryIncentivizedPacketsRequest) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field QueryHeight", wireType)
 			}
@@ -2796,7 +4278,7 @@ func (m *QueryIncentivizedPacketsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryIncentivizedPacketsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryIncentivizedPacketsForChannelResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2819,10 +4301,10 @@ func (m *QueryIncentivizedPacketsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryIncentivizedPacketsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryIncentivizedPacketsForChannelResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryIncentivizedPacketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryIncentivizedPacketsForChannelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2854,7 +4336,7 @@ func (m *QueryIncentivizedPacketsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IncentivizedPackets = append(m.IncentivizedPackets, IdentifiedPacketFees{})
+			m.IncentivizedPackets = append(m.IncentivizedPackets, &IdentifiedPacketFees{})
 			if err := m.IncentivizedPackets[len(m.IncentivizedPackets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -2916,7 +4398,90 @@ func (m *QueryIncentivizedPacketsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryIncentivizedPacketRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTotalRecvFeesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTotalRecvFeesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTotalRecvFeesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PacketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTotalRecvFeesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2939,15 +4504,15 @@ func (m *QueryIncentivizedPacketRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryIncentivizedPacketRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTotalRecvFeesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryIncentivizedPacketRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTotalRecvFeesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PacketId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecvFees", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2974,29 +4539,11 @@ func (m *QueryIncentivizedPacketRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PacketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RecvFees = append(m.RecvFees, types1.Coin{})
+			if err := m.RecvFees[len(m.RecvFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QueryHeight", wireType)
-			}
-			m.QueryHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.QueryHeight |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3018,7 +4565,7 @@ func (m *QueryIncentivizedPacketRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryIncentivizedPacketResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTotalAckFeesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3041,15 +4588,15 @@ func (m *QueryIncentivizedPacketResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryIncentivizedPacketResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTotalAckFeesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryIncentivizedPacketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTotalAckFeesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IncentivizedPacket", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketId", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3076,7 +4623,7 @@ func (m *QueryIncentivizedPacketResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.IncentivizedPacket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PacketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3101,7 +4648,7 @@ func (m *QueryIncentivizedPacketResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryIncentivizedPacketsForChannelRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTotalAckFeesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3124,15 +4671,15 @@ func (m *QueryIncentivizedPacketsForChannelRequest) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryIncentivizedPacketsForChannelRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTotalAckFeesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryIncentivizedPacketsForChannelRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTotalAckFeesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AckFees", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3159,96 +4706,11 @@ func (m *QueryIncentivizedPacketsForChannelRequest) Unmarshal(dAtA []byte) error
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.AckFees = append(m.AckFees, types1.Coin{})
+			if err := m.AckFees[len(m.AckFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PortId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ChannelId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QueryHeight", wireType)
-			}
-			m.QueryHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.QueryHeight |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3270,7 +4732,7 @@ func (m *QueryIncentivizedPacketsForChannelRequest) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryIncentivizedPacketsForChannelResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTotalTimeoutFeesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3293,15 +4755,15 @@ func (m *QueryIncentivizedPacketsForChannelResponse) Unmarshal(dAtA []byte) erro
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryIncentivizedPacketsForChannelResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTotalTimeoutFeesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryIncentivizedPacketsForChannelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTotalTimeoutFeesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IncentivizedPackets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketId", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3328,14 +4790,63 @@ func (m *QueryIncentivizedPacketsForChannelResponse) Unmarshal(dAtA []byte) erro
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IncentivizedPackets = append(m.IncentivizedPackets, &IdentifiedPacketFees{})
-			if err := m.IncentivizedPackets[len(m.IncentivizedPackets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PacketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTotalTimeoutFeesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTotalTimeoutFeesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTotalTimeoutFeesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutFees", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3362,10 +4873,8 @@ func (m *QueryIncentivizedPacketsForChannelResponse) Unmarshal(dAtA []byte) erro
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TimeoutFees = append(m.TimeoutFees, types1.Coin{})
+			if err := m.TimeoutFees[len(m.TimeoutFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3390,7 +4899,7 @@ func (m *QueryIncentivizedPacketsForChannelResponse) Unmarshal(dAtA []byte) erro
 	}
 	return nil
 }
-func (m *QueryTotalRecvFeesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPayeeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3413,17 +4922,17 @@ func (m *QueryTotalRecvFeesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTotalRecvFeesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPayeeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTotalRecvFeesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPayeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PacketId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3433,24 +4942,55 @@ func (m *QueryTotalRecvFeesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Relayer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PacketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Relayer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3473,7 +5013,7 @@ func (m *QueryTotalRecvFeesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTotalRecvFeesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPayeeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3496,17 +5036,17 @@ func (m *QueryTotalRecvFeesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTotalRecvFeesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPayeeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTotalRecvFeesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPayeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecvFees", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PayeeAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3516,25 +5056,23 @@ func (m *QueryTotalRecvFeesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RecvFees = append(m.RecvFees, types1.Coin{})
-			if err := m.RecvFees[len(m.RecvFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PayeeAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3557,7 +5095,7 @@ func (m *QueryTotalRecvFeesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTotalAckFeesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCounterpartyPayeeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3580,17 +5118,17 @@ func (m *QueryTotalAckFeesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTotalAckFeesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCounterpartyPayeeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTotalAckFeesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCounterpartyPayeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PacketId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3600,24 +5138,55 @@ func (m *QueryTotalAckFeesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PacketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Relayer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Relayer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3640,7 +5209,7 @@ func (m *QueryTotalAckFeesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTotalAckFeesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCounterpartyPayeeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3663,17 +5232,17 @@ func (m *QueryTotalAckFeesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTotalAckFeesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCounterpartyPayeeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTotalAckFeesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCounterpartyPayeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AckFees", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyPayee", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3683,25 +5252,23 @@ func (m *QueryTotalAckFeesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AckFees = append(m.AckFees, types1.Coin{})
-			if err := m.AckFees[len(m.AckFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.CounterpartyPayee = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3724,7 +5291,7 @@ func (m *QueryTotalAckFeesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTotalTimeoutFeesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryFeeEnabledChannelsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3747,15 +5314,15 @@ func (m *QueryTotalTimeoutFeesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTotalTimeoutFeesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFeeEnabledChannelsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTotalTimeoutFeesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFeeEnabledChannelsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PacketId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3782,10 +5349,32 @@ func (m *QueryTotalTimeoutFeesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PacketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryHeight", wireType)
+			}
+			m.QueryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QueryHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3807,7 +5396,7 @@ func (m *QueryTotalTimeoutFeesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTotalTimeoutFeesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFeeEnabledChannelsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3830,15 +5419,15 @@ func (m *QueryTotalTimeoutFeesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTotalTimeoutFeesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFeeEnabledChannelsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTotalTimeoutFeesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFeeEnabledChannelsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutFees", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeEnabledChannels", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3865,8 +5454,44 @@ func (m *QueryTotalTimeoutFeesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TimeoutFees = append(m.TimeoutFees, types1.Coin{})
-			if err := m.TimeoutFees[len(m.TimeoutFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.FeeEnabledChannels = append(m.FeeEnabledChannels, FeeEnabledChannel{})
+			if err := m.FeeEnabledChannels[len(m.FeeEnabledChannels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3891,7 +5516,7 @@ func (m *QueryTotalTimeoutFeesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPayeeRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryFeeEnabledChannelRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3914,15 +5539,15 @@ func (m *QueryPayeeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPayeeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFeeEnabledChannelRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPayeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFeeEnabledChannelRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3950,11 +5575,11 @@ func (m *QueryPayeeRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			m.PortId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Relayer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3982,7 +5607,7 @@ func (m *QueryPayeeRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Relayer = string(dAtA[iNdEx:postIndex])
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4005,7 +5630,7 @@ func (m *QueryPayeeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPayeeResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFeeEnabledChannelResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4028,17 +5653,17 @@ func (m *QueryPayeeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPayeeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFeeEnabledChannelResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPayeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFeeEnabledChannelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PayeeAddress", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeEnabled", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4048,24 +5673,12 @@ func (m *QueryPayeeResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PayeeAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.FeeEnabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4087,7 +5700,7 @@ func (m *QueryPayeeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCounterpartyPayeeRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTopIncentivizedPacketsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4110,13 +5723,45 @@ func (m *QueryCounterpartyPayeeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCounterpartyPayeeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTopIncentivizedPacketsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCounterpartyPayeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTopIncentivizedPacketsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
 			}
@@ -4148,9 +5793,9 @@ func (m *QueryCounterpartyPayeeRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.ChannelId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Relayer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4178,8 +5823,27 @@ func (m *QueryCounterpartyPayeeRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Relayer = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4201,7 +5865,7 @@ func (m *QueryCounterpartyPayeeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCounterpartyPayeeResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTopIncentivizedPacketsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4224,17 +5888,17 @@ func (m *QueryCounterpartyPayeeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCounterpartyPayeeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTopIncentivizedPacketsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCounterpartyPayeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTopIncentivizedPacketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyPayee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IncentivizedPackets", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4244,23 +5908,25 @@ func (m *QueryCounterpartyPayeeResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CounterpartyPayee = string(dAtA[iNdEx:postIndex])
+			m.IncentivizedPackets = append(m.IncentivizedPackets, IdentifiedPacketFees{})
+			if err := m.IncentivizedPackets[len(m.IncentivizedPackets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4283,7 +5949,7 @@ func (m *QueryCounterpartyPayeeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFeeEnabledChannelsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRelayerStatsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4306,17 +5972,17 @@ func (m *QueryFeeEnabledChannelsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFeeEnabledChannelsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRelayerStatsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFeeEnabledChannelsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRelayerStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayerAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4326,47 +5992,24 @@ func (m *QueryFeeEnabledChannelsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.RelayerAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QueryHeight", wireType)
-			}
-			m.QueryHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.QueryHeight |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4388,7 +6031,7 @@ func (m *QueryFeeEnabledChannelsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFeeEnabledChannelsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRelayerStatsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4411,15 +6054,15 @@ func (m *QueryFeeEnabledChannelsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFeeEnabledChannelsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRelayerStatsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFeeEnabledChannelsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRelayerStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeeEnabledChannels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayerStats", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4446,14 +6089,13 @@ func (m *QueryFeeEnabledChannelsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FeeEnabledChannels = append(m.FeeEnabledChannels, FeeEnabledChannel{})
-			if err := m.FeeEnabledChannels[len(m.FeeEnabledChannels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RelayerStats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Payouts", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4480,10 +6122,8 @@ func (m *QueryFeeEnabledChannelsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Payouts = append(m.Payouts, RelayerPayout{})
+			if err := m.Payouts[len(m.Payouts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4508,7 +6148,7 @@ func (m *QueryFeeEnabledChannelsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFeeEnabledChannelRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryFeeBudgetRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4531,15 +6171,65 @@ func (m *QueryFeeEnabledChannelRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFeeEnabledChannelRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFeeBudgetRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFeeEnabledChannelRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFeeBudgetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeeBudgetResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeBudgetResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeBudgetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4567,13 +6257,13 @@ func (m *QueryFeeEnabledChannelRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PortId = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4583,23 +6273,58 @@ func (m *QueryFeeEnabledChannelRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			m.Balance = append(m.Balance, types1.Coin{})
+			if err := m.Balance[len(m.Balance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoIncentivePolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AutoIncentivePolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4622,7 +6347,7 @@ func (m *QueryFeeEnabledChannelRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFeeEnabledChannelResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCancellationDelayRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4645,17 +6370,67 @@ func (m *QueryFeeEnabledChannelResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFeeEnabledChannelResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCancellationDelayRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFeeEnabledChannelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCancellationDelayRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCancellationDelayResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCancellationDelayResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCancellationDelayResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeeEnabled", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CancellationDelay", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4665,12 +6440,25 @@ func (m *QueryFeeEnabledChannelResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.FeeEnabled = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.CancellationDelay, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -0,0 +1,76 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	channeltypes "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
+	ibcerrors "github.com/cosmos/ibc-go/v9/modules/core/errors"
+)
+
+const (
+	// MaxRelayerPayoutHistory is the maximum number of payouts stored in the payout history of a relayer address.
+	// Older payouts are pruned as new payouts are recorded.
+	MaxRelayerPayoutHistory = 100
+
+	// DefaultTopIncentivizedPacketsLimit is the number of packets returned by the TopIncentivizedPackets query if no limit is provided
+	DefaultTopIncentivizedPacketsLimit = 10
+
+	// MaxTopIncentivizedPacketsLimit is the maximum number of packets returned by the TopIncentivizedPackets query
+	MaxTopIncentivizedPacketsLimit = 100
+)
+
+// NewRelayerStats creates and returns a new RelayerStats struct
+func NewRelayerStats(relayerAddr string, packetsRelayed uint64, feesEarned sdk.Coins) RelayerStats {
+	return RelayerStats{
+		RelayerAddress: relayerAddr,
+		PacketsRelayed: packetsRelayed,
+		FeesEarned:     feesEarned,
+	}
+}
+
+// Validate performs basic stateless validation of the associated RelayerStats
+func (rs RelayerStats) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(rs.RelayerAddress); err != nil {
+		return errorsmod.Wrap(err, "failed to convert relayer address into sdk.AccAddress")
+	}
+
+	if err := rs.FeesEarned.Validate(); err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidCoins, "invalid fees earned: %s", err)
+	}
+
+	return nil
+}
+
+// NewRelayerPayout creates and returns a new RelayerPayout struct
+func NewRelayerPayout(relayerAddr string, index uint64, packetID channeltypes.PacketId, fee sdk.Coins, height int64) RelayerPayout {
+	return RelayerPayout{
+		RelayerAddress: relayerAddr,
+		Index:          index,
+		PacketId:       packetID,
+		Fee:            fee,
+		Height:         height,
+	}
+}
+
+// Validate performs basic stateless validation of the associated RelayerPayout
+func (rp RelayerPayout) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(rp.RelayerAddress); err != nil {
+		return errorsmod.Wrap(err, "failed to convert relayer address into sdk.AccAddress")
+	}
+
+	if err := rp.PacketId.Validate(); err != nil {
+		return err
+	}
+
+	if err := rp.Fee.Validate(); err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidCoins, "invalid payout fee: %s", err)
+	}
+
+	if rp.Height < 0 {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidHeight, "payout height must not be negative: %d", rp.Height)
+	}
+
+	return nil
+}
@@ -6,24 +6,30 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	types "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -360,6 +366,249 @@ func (m *MsgPayPacketFeeAsyncResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgPayPacketFeeAsyncResponse proto.InternalMessageInfo
 
+// MsgUpdateAutoIncentivePolicy defines the request type for the UpdateAutoIncentivePolicy rpc
+type MsgUpdateAutoIncentivePolicy struct {
+	// signer address
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// the policy used to incentivize outgoing packets from the fee budget
+	Policy AutoIncentivePolicy `protobuf:"bytes,2,opt,name=policy,proto3" json:"policy"`
+}
+
+func (m *MsgUpdateAutoIncentivePolicy) Reset()         { *m = MsgUpdateAutoIncentivePolicy{} }
+func (m *MsgUpdateAutoIncentivePolicy) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateAutoIncentivePolicy) ProtoMessage()    {}
+func (*MsgUpdateAutoIncentivePolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05c93128649f1b96, []int{8}
+}
+func (m *MsgUpdateAutoIncentivePolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateAutoIncentivePolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateAutoIncentivePolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateAutoIncentivePolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateAutoIncentivePolicy.Merge(m, src)
+}
+func (m *MsgUpdateAutoIncentivePolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateAutoIncentivePolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateAutoIncentivePolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateAutoIncentivePolicy proto.InternalMessageInfo
+
+// MsgUpdateAutoIncentivePolicyResponse defines the response type for the UpdateAutoIncentivePolicy rpc
+type MsgUpdateAutoIncentivePolicyResponse struct {
+}
+
+func (m *MsgUpdateAutoIncentivePolicyResponse) Reset()         { *m = MsgUpdateAutoIncentivePolicyResponse{} }
+func (m *MsgUpdateAutoIncentivePolicyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateAutoIncentivePolicyResponse) ProtoMessage()    {}
+func (*MsgUpdateAutoIncentivePolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05c93128649f1b96, []int{9}
+}
+func (m *MsgUpdateAutoIncentivePolicyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateAutoIncentivePolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateAutoIncentivePolicyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateAutoIncentivePolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateAutoIncentivePolicyResponse.Merge(m, src)
+}
+func (m *MsgUpdateAutoIncentivePolicyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateAutoIncentivePolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateAutoIncentivePolicyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateAutoIncentivePolicyResponse proto.InternalMessageInfo
+
+// MsgCancelPacketFee defines the request type for the CancelPacketFee rpc
+type MsgCancelPacketFee struct {
+	// unique packet identifier comprised of the channel ID, port ID and sequence
+	PacketId types.PacketId `protobuf:"bytes,1,opt,name=packet_id,json=packetId,proto3" json:"packet_id"`
+	// the refund address of the packet fees to cancel
+	RefundAddress string `protobuf:"bytes,2,opt,name=refund_address,json=refundAddress,proto3" json:"refund_address,omitempty"`
+}
+
+func (m *MsgCancelPacketFee) Reset()         { *m = MsgCancelPacketFee{} }
+func (m *MsgCancelPacketFee) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelPacketFee) ProtoMessage()    {}
+func (*MsgCancelPacketFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05c93128649f1b96, []int{10}
+}
+func (m *MsgCancelPacketFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelPacketFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelPacketFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelPacketFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelPacketFee.Merge(m, src)
+}
+func (m *MsgCancelPacketFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelPacketFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelPacketFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelPacketFee proto.InternalMessageInfo
+
+// MsgCancelPacketFeeResponse defines the response type for the CancelPacketFee rpc
+type MsgCancelPacketFeeResponse struct {
+	// the total fees refunded to the refund address
+	RefundedFees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=refunded_fees,json=refundedFees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"refunded_fees"`
+}
+
+func (m *MsgCancelPacketFeeResponse) Reset()         { *m = MsgCancelPacketFeeResponse{} }
+func (m *MsgCancelPacketFeeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelPacketFeeResponse) ProtoMessage()    {}
+func (*MsgCancelPacketFeeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05c93128649f1b96, []int{11}
+}
+func (m *MsgCancelPacketFeeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelPacketFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelPacketFeeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelPacketFeeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelPacketFeeResponse.Merge(m, src)
+}
+func (m *MsgCancelPacketFeeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelPacketFeeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelPacketFeeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelPacketFeeResponse proto.InternalMessageInfo
+
+func (m *MsgCancelPacketFeeResponse) GetRefundedFees() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RefundedFees
+	}
+	return nil
+}
+
+// MsgUpdateCancellationDelay defines the request type for the UpdateCancellationDelay rpc
+type MsgUpdateCancellationDelay struct {
+	// signer address
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// the delay after which escrowed packet fees may be cancelled by their refund address
+	CancellationDelay time.Duration `protobuf:"bytes,2,opt,name=cancellation_delay,json=cancellationDelay,proto3,stdduration" json:"cancellation_delay"`
+}
+
+func (m *MsgUpdateCancellationDelay) Reset()         { *m = MsgUpdateCancellationDelay{} }
+func (m *MsgUpdateCancellationDelay) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateCancellationDelay) ProtoMessage()    {}
+func (*MsgUpdateCancellationDelay) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05c93128649f1b96, []int{12}
+}
+func (m *MsgUpdateCancellationDelay) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateCancellationDelay) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateCancellationDelay.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateCancellationDelay) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateCancellationDelay.Merge(m, src)
+}
+func (m *MsgUpdateCancellationDelay) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateCancellationDelay) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateCancellationDelay.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateCancellationDelay proto.InternalMessageInfo
+
+// MsgUpdateCancellationDelayResponse defines the response type for the UpdateCancellationDelay rpc
+type MsgUpdateCancellationDelayResponse struct {
+}
+
+func (m *MsgUpdateCancellationDelayResponse) Reset()         { *m = MsgUpdateCancellationDelayResponse{} }
+func (m *MsgUpdateCancellationDelayResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateCancellationDelayResponse) ProtoMessage()    {}
+func (*MsgUpdateCancellationDelayResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05c93128649f1b96, []int{13}
+}
+func (m *MsgUpdateCancellationDelayResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateCancellationDelayResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateCancellationDelayResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateCancellationDelayResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateCancellationDelayResponse.Merge(m, src)
+}
+func (m *MsgUpdateCancellationDelayResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateCancellationDelayResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateCancellationDelayResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateCancellationDelayResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgRegisterPayee)(nil), "ibc.applications.fee.v1.MsgRegisterPayee")
 	proto.RegisterType((*MsgRegisterPayeeResponse)(nil), "ibc.applications.fee.v1.MsgRegisterPayeeResponse")
@@ -369,56 +618,85 @@ func init() {
 	proto.RegisterType((*MsgPayPacketFeeResponse)(nil), "ibc.applications.fee.v1.MsgPayPacketFeeResponse")
 	proto.RegisterType((*MsgPayPacketFeeAsync)(nil), "ibc.applications.fee.v1.MsgPayPacketFeeAsync")
 	proto.RegisterType((*MsgPayPacketFeeAsyncResponse)(nil), "ibc.applications.fee.v1.MsgPayPacketFeeAsyncResponse")
+	proto.RegisterType((*MsgUpdateAutoIncentivePolicy)(nil), "ibc.applications.fee.v1.MsgUpdateAutoIncentivePolicy")
+	proto.RegisterType((*MsgUpdateAutoIncentivePolicyResponse)(nil), "ibc.applications.fee.v1.MsgUpdateAutoIncentivePolicyResponse")
+	proto.RegisterType((*MsgCancelPacketFee)(nil), "ibc.applications.fee.v1.MsgCancelPacketFee")
+	proto.RegisterType((*MsgCancelPacketFeeResponse)(nil), "ibc.applications.fee.v1.MsgCancelPacketFeeResponse")
+	proto.RegisterType((*MsgUpdateCancellationDelay)(nil), "ibc.applications.fee.v1.MsgUpdateCancellationDelay")
+	proto.RegisterType((*MsgUpdateCancellationDelayResponse)(nil), "ibc.applications.fee.v1.MsgUpdateCancellationDelayResponse")
 }
 
 func init() { proto.RegisterFile("ibc/applications/fee/v1/tx.proto", fileDescriptor_05c93128649f1b96) }
 
 var fileDescriptor_05c93128649f1b96 = []byte{
-	// 702 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0x4d, 0x4f, 0xdb, 0x4a,
-	0x14, 0x8d, 0xc9, 0xe3, 0x23, 0x17, 0xde, 0xe3, 0xc5, 0x42, 0x2f, 0xc1, 0x0f, 0x0c, 0xb5, 0x50,
-	0x4b, 0x23, 0xc5, 0x26, 0xa9, 0x50, 0x45, 0xd4, 0x2e, 0x0a, 0x2a, 0x12, 0x52, 0x51, 0xa3, 0x2c,
-	0xbb, 0x41, 0x8e, 0x73, 0x31, 0x2e, 0xb1, 0xc7, 0xf2, 0x38, 0x51, 0xbd, 0xab, 0x58, 0x55, 0x5d,
-	0xb5, 0xff, 0xa0, 0xcb, 0x2e, 0xba, 0xe0, 0x67, 0xb0, 0x64, 0xd9, 0x4d, 0xab, 0x0a, 0x2a, 0xf1,
-	0x0f, 0xba, 0xae, 0x66, 0x3c, 0xb6, 0x4c, 0x42, 0x50, 0x5a, 0xa9, 0x1b, 0xcb, 0x73, 0xcf, 0x99,
-	0x33, 0xf7, 0x1c, 0xcd, 0xd5, 0xc0, 0xaa, 0xd3, 0xb6, 0x0c, 0xd3, 0xf7, 0xbb, 0x8e, 0x65, 0x86,
-	0x0e, 0xf1, 0xa8, 0x71, 0x88, 0x68, 0xf4, 0x6b, 0x46, 0xf8, 0x4a, 0xf7, 0x03, 0x12, 0x12, 0xb9,
-	0xe4, 0xb4, 0x2d, 0x3d, 0xcb, 0xd0, 0x0f, 0x11, 0xf5, 0x7e, 0x4d, 0x29, 0x9a, 0xae, 0xe3, 0x11,
-	0x83, 0x7f, 0x63, 0xae, 0xb2, 0x60, 0x13, 0x9b, 0xf0, 0x5f, 0x83, 0xfd, 0x89, 0xea, 0x9d, 0x51,
-	0x67, 0x30, 0xa1, 0x0c, 0xc5, 0x22, 0x01, 0x1a, 0xd6, 0x91, 0xe9, 0x79, 0xd8, 0x65, 0xb0, 0xf8,
-	0x15, 0x94, 0x92, 0x45, 0xa8, 0x4b, 0xa8, 0xe1, 0x52, 0x9b, 0x81, 0x2e, 0xb5, 0x63, 0x40, 0xfb,
-	0x24, 0xc1, 0xbf, 0xfb, 0xd4, 0x6e, 0xa1, 0xed, 0xd0, 0x10, 0x83, 0xa6, 0x19, 0x21, 0xca, 0x25,
-	0x98, 0xf6, 0x49, 0x10, 0x1e, 0x38, 0x9d, 0xb2, 0xb4, 0x2a, 0xad, 0x17, 0x5a, 0x53, 0x6c, 0xb9,
-	0xd7, 0x91, 0x97, 0x01, 0x84, 0x2e, 0xc3, 0x26, 0x38, 0x56, 0x10, 0x95, 0xbd, 0x8e, 0x5c, 0x86,
-	0xe9, 0x00, 0xbb, 0x66, 0x84, 0x41, 0x39, 0xcf, 0xb1, 0x64, 0x29, 0x2f, 0xc0, 0xa4, 0xcf, 0xa4,
-	0xcb, 0x7f, 0xf1, 0x7a, 0xbc, 0x68, 0x6c, 0xbc, 0xf9, 0xb0, 0x92, 0x3b, 0xb9, 0x3a, 0xad, 0x24,
-	0xbc, 0xb7, 0x57, 0xa7, 0x95, 0xff, 0xe3, 0x56, 0xab, 0xb4, 0x73, 0x6c, 0x0c, 0x76, 0xa6, 0x29,
-	0x50, 0x1e, 0xac, 0xb5, 0x90, 0xfa, 0xc4, 0xa3, 0xa8, 0x7d, 0x91, 0x60, 0x29, 0x03, 0xee, 0x90,
-	0x9e, 0x17, 0x62, 0xe0, 0x9b, 0x41, 0x18, 0xfd, 0x29, 0x5b, 0x55, 0x90, 0xad, 0xcc, 0x31, 0x07,
-	0x59, 0x8f, 0x45, 0x6b, 0xb0, 0x81, 0xc6, 0xa3, 0x9b, 0xfc, 0xde, 0xbb, 0xd9, 0xef, 0x50, 0xfb,
-	0xda, 0x5d, 0x58, 0xbb, 0x0d, 0x4f, 0x73, 0x38, 0x99, 0x80, 0xf9, 0x7d, 0x6a, 0x37, 0xcd, 0xa8,
-	0x69, 0x5a, 0xc7, 0x18, 0xee, 0x22, 0xca, 0x5b, 0x90, 0x3f, 0x44, 0xe4, 0xb6, 0x67, 0xeb, 0x4b,
-	0xfa, 0x88, 0x5b, 0xa9, 0xef, 0x22, 0x6e, 0x17, 0xce, 0xbe, 0xae, 0xe4, 0x3e, 0x5e, 0x9d, 0x56,
-	0xa4, 0x16, 0xdb, 0x23, 0xaf, 0xc1, 0x3f, 0x94, 0xf4, 0x02, 0x0b, 0x0f, 0x92, 0xf0, 0xe2, 0x80,
-	0xe6, 0xe2, 0x6a, 0x33, 0x8e, 0xb0, 0x02, 0x45, 0xc1, 0xca, 0x24, 0x19, 0xa7, 0x35, 0x1f, 0x03,
-	0x3b, 0x69, 0x9e, 0xff, 0xc1, 0x14, 0x75, 0x6c, 0x0f, 0x03, 0x91, 0x94, 0x58, 0xc9, 0x0a, 0xcc,
-	0x88, 0x5c, 0x68, 0x79, 0x72, 0x35, 0xbf, 0x5e, 0x68, 0xa5, 0xeb, 0x86, 0x9e, 0x44, 0x27, 0xc8,
-	0x2c, 0x39, 0xe5, 0x7a, 0x72, 0x59, 0xc3, 0xda, 0x22, 0x94, 0x06, 0x4a, 0x69, 0x3e, 0xdf, 0x25,
-	0x58, 0x18, 0xc0, 0x9e, 0xd0, 0xc8, 0xb3, 0xe4, 0xa7, 0x50, 0xf0, 0x79, 0x25, 0xb9, 0x21, 0xb3,
-	0xf5, 0x65, 0x1e, 0x15, 0x9b, 0x2d, 0x3d, 0x19, 0xa8, 0x7e, 0x4d, 0x8f, 0xf7, 0xed, 0x75, 0xb2,
-	0x59, 0xcd, 0xf8, 0xa2, 0x28, 0x3f, 0x03, 0x10, 0x32, 0x2c, 0xf2, 0x09, 0xae, 0xa3, 0x8d, 0x8c,
-	0x3c, 0xed, 0x21, 0x2b, 0x26, 0xfa, 0xd8, 0x45, 0x6c, 0x3c, 0x4c, 0x8c, 0x67, 0x44, 0x99, 0xf9,
-	0x95, 0xd1, 0xe6, 0xb9, 0x1b, 0x4d, 0xe5, 0xd3, 0x30, 0x54, 0x4f, 0x62, 0xa8, 0xff, 0xc8, 0x43,
-	0x7e, 0x9f, 0xda, 0xb2, 0x0b, 0x7f, 0x5f, 0x9f, 0xfe, 0xfb, 0x23, 0x7b, 0x1d, 0x1c, 0x3d, 0xa5,
-	0x36, 0x36, 0x35, 0x39, 0x56, 0x7e, 0x2f, 0xc1, 0xe2, 0xe8, 0x11, 0xdd, 0x1c, 0x47, 0x70, 0x68,
-	0x9b, 0xf2, 0xf8, 0xb7, 0xb6, 0xa5, 0x3d, 0xbd, 0x84, 0xb9, 0x6b, 0xd3, 0xb2, 0x7e, 0x9b, 0x5c,
-	0x96, 0xa9, 0x6c, 0x8c, 0xcb, 0x4c, 0xcf, 0x8a, 0xa0, 0x38, 0x7c, 0xf3, 0xaa, 0xe3, 0xca, 0x70,
-	0xba, 0xb2, 0xf9, 0x4b, 0xf4, 0xe4, 0x68, 0x65, 0xf2, 0x35, 0xbb, 0x5c, 0xdb, 0xcf, 0xcf, 0x2e,
-	0x54, 0xe9, 0xfc, 0x42, 0x95, 0xbe, 0x5d, 0xa8, 0xd2, 0xbb, 0x4b, 0x35, 0x77, 0x7e, 0xa9, 0xe6,
-	0x3e, 0x5f, 0xaa, 0xb9, 0x17, 0x9b, 0xb6, 0x13, 0x1e, 0xf5, 0xda, 0xba, 0x45, 0x5c, 0x43, 0x3c,
-	0x18, 0x4e, 0xdb, 0xaa, 0xda, 0xc4, 0xe8, 0x6f, 0x19, 0x2e, 0xe9, 0xf4, 0xba, 0x48, 0xd9, 0x5b,
-	0x44, 0x8d, 0xfa, 0x56, 0x95, 0x3d, 0x43, 0x61, 0xe4, 0x23, 0x6d, 0x4f, 0xf1, 0xa7, 0xe4, 0xc1,
-	0xcf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x7f, 0x85, 0x8d, 0x1d, 0x0f, 0x07, 0x00, 0x00,
+	// 1059 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcf, 0x6f, 0xe3, 0x44,
+	0x14, 0xae, 0xdb, 0xed, 0x8f, 0x4c, 0xbb, 0x5b, 0x62, 0x55, 0x24, 0x35, 0x6d, 0x52, 0xac, 0x16,
+	0x42, 0x20, 0xf6, 0x26, 0x55, 0x85, 0x9a, 0x65, 0x25, 0x9a, 0x2e, 0x95, 0x8a, 0xa8, 0x88, 0x22,
+	0x71, 0xe1, 0x12, 0x39, 0xe3, 0x17, 0xaf, 0xd9, 0xc4, 0x63, 0x79, 0x9c, 0x88, 0xdc, 0x60, 0x4f,
+	0x08, 0x84, 0x04, 0x37, 0xc4, 0x69, 0x8f, 0x08, 0x71, 0xe8, 0x91, 0x1b, 0x07, 0x2e, 0x7b, 0xdc,
+	0x23, 0x17, 0x58, 0xd4, 0x22, 0xf5, 0xdf, 0x40, 0xe3, 0x19, 0xbb, 0x8e, 0x13, 0x97, 0xb6, 0x12,
+	0x97, 0xc8, 0xf3, 0xde, 0x37, 0x6f, 0xbe, 0xef, 0x9b, 0x1f, 0x2f, 0x68, 0xcb, 0xee, 0x60, 0xdd,
+	0x70, 0xdd, 0x9e, 0x8d, 0x0d, 0xdf, 0x26, 0x0e, 0xd5, 0xbb, 0x00, 0xfa, 0xb0, 0xaa, 0xfb, 0x9f,
+	0x6b, 0xae, 0x47, 0x7c, 0x22, 0xe7, 0xec, 0x0e, 0xd6, 0xe2, 0x08, 0xad, 0x0b, 0xa0, 0x0d, 0xab,
+	0x4a, 0xd6, 0xe8, 0xdb, 0x0e, 0xd1, 0x83, 0x5f, 0x8e, 0x55, 0x0a, 0x16, 0x21, 0x56, 0x0f, 0xf4,
+	0x60, 0xd4, 0x19, 0x74, 0x75, 0x73, 0xe0, 0x05, 0x93, 0x44, 0x7e, 0xcd, 0x22, 0x16, 0x09, 0x3e,
+	0x75, 0xf6, 0x25, 0xa2, 0xaf, 0xa7, 0x71, 0x60, 0x0b, 0x71, 0xc8, 0x4e, 0x1a, 0xc4, 0x02, 0x07,
+	0xa8, 0x4d, 0xe3, 0x95, 0x30, 0xf1, 0x40, 0xc7, 0x8f, 0x0d, 0xc7, 0x81, 0x1e, 0x83, 0x88, 0x4f,
+	0x01, 0xc9, 0x61, 0x42, 0xfb, 0x84, 0xea, 0x7d, 0x6a, 0xb1, 0x64, 0x9f, 0x5a, 0x21, 0x77, 0x91,
+	0xe8, 0x18, 0x94, 0x55, 0xee, 0x80, 0x6f, 0x54, 0x75, 0x4c, 0x6c, 0xc1, 0x5d, 0xfd, 0x45, 0x42,
+	0xaf, 0x9c, 0x50, 0xab, 0x05, 0x96, 0x4d, 0x7d, 0xf0, 0x9a, 0xc6, 0x08, 0x40, 0xce, 0xa1, 0x45,
+	0x97, 0x78, 0x7e, 0xdb, 0x36, 0xf3, 0xd2, 0x96, 0x54, 0xca, 0xb4, 0x16, 0xd8, 0xf0, 0xd8, 0x94,
+	0x37, 0x11, 0x12, 0xeb, 0xb2, 0xdc, 0x6c, 0x90, 0xcb, 0x88, 0xc8, 0xb1, 0x29, 0xe7, 0xd1, 0xa2,
+	0x07, 0x3d, 0x63, 0x04, 0x5e, 0x7e, 0x2e, 0xc8, 0x85, 0x43, 0x79, 0x0d, 0xcd, 0xbb, 0xac, 0x74,
+	0xfe, 0x4e, 0x10, 0xe7, 0x83, 0xfa, 0xfd, 0xaf, 0x9e, 0x15, 0x67, 0x9e, 0x5e, 0x9c, 0x96, 0x43,
+	0xdc, 0xd7, 0x17, 0xa7, 0xe5, 0xd7, 0x38, 0xe3, 0x0a, 0x35, 0x9f, 0xe8, 0x49, 0x66, 0xaa, 0x82,
+	0xf2, 0xc9, 0x58, 0x0b, 0xa8, 0x4b, 0x1c, 0x0a, 0xea, 0x9f, 0x12, 0xda, 0x88, 0x25, 0x0f, 0xc9,
+	0xc0, 0xf1, 0xc1, 0x73, 0x0d, 0xcf, 0x1f, 0xfd, 0x5f, 0xb2, 0x2a, 0x48, 0xc6, 0xb1, 0x65, 0xda,
+	0x71, 0x8d, 0x59, 0x9c, 0x24, 0x50, 0x7f, 0x6f, 0x9a, 0xde, 0x37, 0xa7, 0xeb, 0x9d, 0xa0, 0xaf,
+	0xbe, 0x81, 0xb6, 0xaf, 0xca, 0x47, 0x3e, 0x3c, 0x9d, 0x45, 0xab, 0x27, 0xd4, 0x6a, 0x1a, 0xa3,
+	0xa6, 0x81, 0x9f, 0x80, 0x7f, 0x04, 0x20, 0xef, 0xa3, 0xb9, 0x2e, 0x40, 0x20, 0x7b, 0xb9, 0xb6,
+	0xa1, 0xa5, 0x1c, 0x7e, 0xed, 0x08, 0xa0, 0x91, 0x79, 0xfe, 0x57, 0x71, 0xe6, 0xa7, 0x8b, 0xd3,
+	0xb2, 0xd4, 0x62, 0x73, 0xe4, 0x6d, 0x74, 0x8f, 0x92, 0x81, 0x87, 0xa1, 0x1d, 0x9a, 0xc7, 0x0d,
+	0x5a, 0xe1, 0xd1, 0x26, 0xb7, 0xb0, 0x8c, 0xb2, 0x02, 0x15, 0x73, 0x92, 0xbb, 0xb5, 0xca, 0x13,
+	0x87, 0x91, 0x9f, 0xaf, 0xa2, 0x05, 0x6a, 0x5b, 0x0e, 0x78, 0xc2, 0x29, 0x31, 0x92, 0x15, 0xb4,
+	0x24, 0x7c, 0xa1, 0xf9, 0xf9, 0xad, 0xb9, 0x52, 0xa6, 0x15, 0x8d, 0xeb, 0x5a, 0x68, 0x9d, 0x00,
+	0x33, 0xe7, 0x94, 0x71, 0xe7, 0xe2, 0x82, 0xd5, 0x75, 0x94, 0x4b, 0x84, 0x22, 0x7f, 0xfe, 0x91,
+	0xd0, 0x5a, 0x22, 0x77, 0x40, 0x47, 0x0e, 0x96, 0x3f, 0x40, 0x19, 0x37, 0x88, 0x84, 0x27, 0x64,
+	0xb9, 0xb6, 0x19, 0x58, 0xc5, 0xee, 0x9e, 0x16, 0x5e, 0xb8, 0x61, 0x55, 0xe3, 0xf3, 0x8e, 0xcd,
+	0xb8, 0x57, 0x4b, 0xae, 0x08, 0xca, 0x1f, 0x21, 0x24, 0xca, 0x30, 0xcb, 0x67, 0x83, 0x3a, 0x6a,
+	0xaa, 0xe5, 0x11, 0x87, 0x78, 0x31, 0xc1, 0xe3, 0x08, 0xa0, 0xfe, 0x6e, 0x28, 0x3c, 0x56, 0x94,
+	0x89, 0x2f, 0xa6, 0x8b, 0x0f, 0xd4, 0xa8, 0x05, 0xb4, 0x31, 0x2d, 0x1e, 0xd9, 0xf0, 0x1b, 0xbf,
+	0x2e, 0x9f, 0xb8, 0xa6, 0xe1, 0xc3, 0xc1, 0xc0, 0x27, 0xc7, 0x0e, 0x06, 0xc7, 0xb7, 0x87, 0xd0,
+	0x24, 0x3d, 0x1b, 0x8f, 0x62, 0xdb, 0x24, 0x8d, 0x6d, 0xd3, 0x87, 0x68, 0xc1, 0x0d, 0x10, 0x42,
+	0xdb, 0x3b, 0xa9, 0xda, 0xa6, 0x54, 0x6d, 0xdc, 0x61, 0x2a, 0x5b, 0xa2, 0x42, 0xfd, 0xc1, 0x94,
+	0x6d, 0x4d, 0x5c, 0x88, 0x54, 0x82, 0xe2, 0x42, 0xa4, 0xe6, 0x23, 0xa5, 0xbf, 0x4a, 0x48, 0x3e,
+	0xa1, 0xd6, 0xa1, 0xe1, 0x60, 0xe8, 0x5d, 0xde, 0x89, 0xf7, 0x6f, 0xbc, 0xdd, 0x9c, 0xfb, 0xe5,
+	0x4e, 0xef, 0xa0, 0x7b, 0x1e, 0x74, 0x07, 0x8e, 0xd9, 0x36, 0x4c, 0xd3, 0x03, 0x4a, 0xc5, 0xd5,
+	0xb8, 0xcb, 0xa3, 0x07, 0x3c, 0x58, 0xdf, 0x0f, 0x45, 0x26, 0xd0, 0x4c, 0xec, 0xe6, 0xb8, 0xd8,
+	0x04, 0x47, 0xf5, 0x99, 0x84, 0x94, 0xc9, 0x70, 0xa8, 0x4c, 0xfe, 0x52, 0x42, 0x62, 0x2d, 0x30,
+	0xd9, 0xc1, 0xa0, 0x79, 0x69, 0x6b, 0xae, 0xb4, 0x5c, 0x5b, 0xd7, 0x78, 0x59, 0x8d, 0x3d, 0xfb,
+	0x9a, 0x78, 0xf6, 0xb5, 0x43, 0x62, 0x3b, 0x8d, 0x03, 0xa6, 0xe1, 0xe7, 0x97, 0xc5, 0x92, 0x65,
+	0xfb, 0x8f, 0x07, 0x1d, 0x0d, 0x93, 0xbe, 0x2e, 0x7a, 0x44, 0x8c, 0x8a, 0x3f, 0x72, 0x81, 0x06,
+	0x13, 0xe8, 0x8f, 0x17, 0xa7, 0xe5, 0x95, 0x1e, 0x58, 0x06, 0x1e, 0xb5, 0x59, 0xe3, 0xa0, 0xad,
+	0x95, 0x70, 0xc9, 0x23, 0x00, 0xaa, 0xfe, 0xce, 0x29, 0xf2, 0x6d, 0xe0, 0x44, 0x7b, 0xc1, 0x31,
+	0x78, 0xc4, 0xee, 0x6e, 0xea, 0x29, 0x6a, 0x21, 0x19, 0xc7, 0xc0, 0x6d, 0x93, 0xa1, 0xc5, 0x89,
+	0x5a, 0xd7, 0x78, 0xc7, 0xd5, 0xc2, 0x8e, 0xab, 0x3d, 0x12, 0x1d, 0xb7, 0xb1, 0xc4, 0xe8, 0xff,
+	0xf0, 0xb2, 0x28, 0xb5, 0xb2, 0x38, 0xb9, 0xd6, 0xa5, 0xd1, 0xb1, 0xd3, 0xb4, 0x33, 0xed, 0x34,
+	0x4d, 0xd0, 0x54, 0xb7, 0x91, 0x9a, 0x9e, 0x0d, 0xfd, 0xae, 0x7d, 0xbb, 0x88, 0xe6, 0x4e, 0xa8,
+	0x25, 0xf7, 0xd1, 0xdd, 0xf1, 0x8e, 0xf9, 0x56, 0xea, 0x1d, 0x48, 0xb6, 0x2b, 0xa5, 0x7a, 0x6d,
+	0x68, 0xb4, 0xcd, 0xdf, 0x4b, 0x68, 0x3d, 0xbd, 0xad, 0xed, 0x5d, 0xa7, 0xe0, 0xc4, 0x34, 0xe5,
+	0xe1, 0xad, 0xa6, 0x45, 0x9c, 0x3e, 0x43, 0x2b, 0x63, 0x1d, 0xa6, 0x74, 0x55, 0xb9, 0x38, 0x52,
+	0xb9, 0x7f, 0x5d, 0x64, 0xb4, 0xd6, 0x08, 0x65, 0x27, 0x5f, 0xeb, 0xca, 0x75, 0xcb, 0x04, 0x70,
+	0x65, 0xef, 0x46, 0xf0, 0x31, 0xeb, 0xd3, 0x9f, 0xc8, 0x2b, 0x8b, 0xa6, 0x4e, 0x53, 0x1e, 0xde,
+	0x6a, 0x5a, 0xc4, 0x89, 0xa2, 0xd5, 0xe4, 0x5b, 0xf6, 0xf6, 0x55, 0x15, 0x13, 0x60, 0x65, 0xf7,
+	0x06, 0xe0, 0x68, 0xd1, 0x6f, 0x24, 0x94, 0x4b, 0xbb, 0xe3, 0xbb, 0xff, 0xad, 0x67, 0x62, 0x92,
+	0xf2, 0xe0, 0x16, 0x93, 0x42, 0x36, 0xca, 0xfc, 0x17, 0xac, 0x4f, 0x36, 0x3e, 0x7e, 0x7e, 0x56,
+	0x90, 0x5e, 0x9c, 0x15, 0xa4, 0xbf, 0xcf, 0x0a, 0xd2, 0x77, 0xe7, 0x85, 0x99, 0x17, 0xe7, 0x85,
+	0x99, 0x3f, 0xce, 0x0b, 0x33, 0x9f, 0xee, 0x4d, 0x3e, 0x6f, 0x76, 0x07, 0x57, 0x2c, 0xa2, 0x0f,
+	0xf7, 0xf5, 0x3e, 0x31, 0x07, 0x3d, 0xa0, 0xec, 0xaf, 0x37, 0xd5, 0x6b, 0xfb, 0x15, 0xf6, 0xaf,
+	0x3b, 0x78, 0xf1, 0x3a, 0x0b, 0xc1, 0x8b, 0xb3, 0xfb, 0xef, 0x00, 0x55, 0x49, 0x83, 0xb4, 0x41,
+	0x0c, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -455,6 +733,18 @@ type MsgClient interface {
 	// PayPacketFeeAsync is an open callback that may be called by any module/user that wishes to escrow funds in order to
 	// incentivize the relaying of a known packet (i.e. at a particular sequence)
 	PayPacketFeeAsync(ctx context.Context, in *MsgPayPacketFeeAsync, opts ...grpc.CallOption) (*MsgPayPacketFeeAsyncResponse, error)
+	// UpdateAutoIncentivePolicy defines a rpc handler method for MsgUpdateAutoIncentivePolicy
+	// UpdateAutoIncentivePolicy may only be called by the module authority and sets the policy used to incentivize
+	// outgoing packets on the selected channels from the fee budget
+	UpdateAutoIncentivePolicy(ctx context.Context, in *MsgUpdateAutoIncentivePolicy, opts ...grpc.CallOption) (*MsgUpdateAutoIncentivePolicyResponse, error)
+	// CancelPacketFee defines a rpc handler method for MsgCancelPacketFee
+	// CancelPacketFee may be called by the refund address of escrowed packet fees to reclaim them once the
+	// cancellation delay has passed since they were escrowed, as long as the packet has not been acknowledged or timed out
+	CancelPacketFee(ctx context.Context, in *MsgCancelPacketFee, opts ...grpc.CallOption) (*MsgCancelPacketFeeResponse, error)
+	// UpdateCancellationDelay defines a rpc handler method for MsgUpdateCancellationDelay
+	// UpdateCancellationDelay may only be called by the module authority and sets the delay after which escrowed
+	// packet fees may be cancelled
+	UpdateCancellationDelay(ctx context.Context, in *MsgUpdateCancellationDelay, opts ...grpc.CallOption) (*MsgUpdateCancellationDelayResponse, error)
 }
 
 type msgClient struct {
@@ -501,6 +791,33 @@ func (c *msgClient) PayPacketFeeAsync(ctx context.Context, in *MsgPayPacketFeeAs
 	return out, nil
 }
 
+func (c *msgClient) UpdateAutoIncentivePolicy(ctx context.Context, in *MsgUpdateAutoIncentivePolicy, opts ...grpc.CallOption) (*MsgUpdateAutoIncentivePolicyResponse, error) {
+	out := new(MsgUpdateAutoIncentivePolicyResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.fee.v1.Msg/UpdateAutoIncentivePolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelPacketFee(ctx context.Context, in *MsgCancelPacketFee, opts ...grpc.CallOption) (*MsgCancelPacketFeeResponse, error) {
+	out := new(MsgCancelPacketFeeResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.fee.v1.Msg/CancelPacketFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateCancellationDelay(ctx context.Context, in *MsgUpdateCancellationDelay, opts ...grpc.CallOption) (*MsgUpdateCancellationDelayResponse, error) {
+	out := new(MsgUpdateCancellationDelayResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.fee.v1.Msg/UpdateCancellationDelay", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// RegisterPayee defines a rpc handler method for MsgRegisterPayee
@@ -525,6 +842,18 @@ type MsgServer interface {
 	// PayPacketFeeAsync is an open callback that may be called by any module/user that wishes to escrow funds in order to
 	// incentivize the relaying of a known packet (i.e. at a particular sequence)
 	PayPacketFeeAsync(context.Context, *MsgPayPacketFeeAsync) (*MsgPayPacketFeeAsyncResponse, error)
+	// UpdateAutoIncentivePolicy defines a rpc handler method for MsgUpdateAutoIncentivePolicy
+	// UpdateAutoIncentivePolicy may only be called by the module authority and sets the policy used to incentivize
+	// outgoing packets on the selected channels from the fee budget
+	UpdateAutoIncentivePolicy(context.Context, *MsgUpdateAutoIncentivePolicy) (*MsgUpdateAutoIncentivePolicyResponse, error)
+	// CancelPacketFee defines a rpc handler method for MsgCancelPacketFee
+	// CancelPacketFee may be called by the refund address of escrowed packet fees to reclaim them once the
+	// cancellation delay has passed since they were escrowed, as long as the packet has not been acknowledged or timed out
+	CancelPacketFee(context.Context, *MsgCancelPacketFee) (*MsgCancelPacketFeeResponse, error)
+	// UpdateCancellationDelay defines a rpc handler method for MsgUpdateCancellationDelay
+	// UpdateCancellationDelay may only be called by the module authority and sets the delay after which escrowed
+	// packet fees may be cancelled
+	UpdateCancellationDelay(context.Context, *MsgUpdateCancellationDelay) (*MsgUpdateCancellationDelayResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -543,6 +872,15 @@ func (*UnimplementedMsgServer) PayPacketFee(ctx context.Context, req *MsgPayPack
 func (*UnimplementedMsgServer) PayPacketFeeAsync(ctx context.Context, req *MsgPayPacketFeeAsync) (*MsgPayPacketFeeAsyncResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PayPacketFeeAsync not implemented")
 }
+func (*UnimplementedMsgServer) UpdateAutoIncentivePolicy(ctx context.Context, req *MsgUpdateAutoIncentivePolicy) (*MsgUpdateAutoIncentivePolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAutoIncentivePolicy not implemented")
+}
+func (*UnimplementedMsgServer) CancelPacketFee(ctx context.Context, req *MsgCancelPacketFee) (*MsgCancelPacketFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelPacketFee not implemented")
+}
+func (*UnimplementedMsgServer) UpdateCancellationDelay(ctx context.Context, req *MsgUpdateCancellationDelay) (*MsgUpdateCancellationDelayResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCancellationDelay not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -620,6 +958,61 @@ func _Msg_PayPacketFeeAsync_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateAutoIncentivePolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateAutoIncentivePolicy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateAutoIncentivePolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.fee.v1.Msg/UpdateAutoIncentivePolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateAutoIncentivePolicy(ctx, req.(*MsgUpdateAutoIncentivePolicy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelPacketFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelPacketFee)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelPacketFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.fee.v1.Msg/CancelPacketFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelPacketFee(ctx, req.(*MsgCancelPacketFee))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateCancellationDelay_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateCancellationDelay)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateCancellationDelay(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.fee.v1.Msg/UpdateCancellationDelay",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateCancellationDelay(ctx, req.(*MsgUpdateCancellationDelay))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ibc.applications.fee.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -640,6 +1033,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "PayPacketFeeAsync",
 			Handler:    _Msg_PayPacketFeeAsync_Handler,
 		},
+		{
+			MethodName: "UpdateAutoIncentivePolicy",
+			Handler:    _Msg_UpdateAutoIncentivePolicy_Handler,
+		},
+		{
+			MethodName: "CancelPacketFee",
+			Handler:    _Msg_CancelPacketFee_Handler,
+		},
+		{
+			MethodName: "UpdateCancellationDelay",
+			Handler:    _Msg_UpdateCancellationDelay_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ibc/applications/fee/v1/tx.proto",
@@ -945,44 +1350,245 @@ func (m *MsgPayPacketFeeAsyncResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgUpdateAutoIncentivePolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgRegisterPayee) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgUpdateAutoIncentivePolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateAutoIncentivePolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.PortId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ChannelId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Relayer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.Policy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	l = len(m.Payee)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgRegisterPayeeResponse) Size() (n int) {
-	if m == nil {
+func (m *MsgUpdateAutoIncentivePolicyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateAutoIncentivePolicyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateAutoIncentivePolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelPacketFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelPacketFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelPacketFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RefundAddress) > 0 {
+		i -= len(m.RefundAddress)
+		copy(dAtA[i:], m.RefundAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RefundAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.PacketId.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelPacketFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelPacketFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelPacketFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RefundedFees) > 0 {
+		for iNdEx := len(m.RefundedFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RefundedFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateCancellationDelay) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateCancellationDelay) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateCancellationDelay) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n6, err6 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.CancellationDelay, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.CancellationDelay):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintTx(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateCancellationDelayResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateCancellationDelayResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateCancellationDelayResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgRegisterPayee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Relayer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Payee)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRegisterPayeeResponse) Size() (n int) {
+	if m == nil {
 		return 0
 	}
 	var l int
@@ -1084,6 +1690,84 @@ func (m *MsgPayPacketFeeAsyncResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgUpdateAutoIncentivePolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Policy.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateAutoIncentivePolicyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelPacketFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PacketId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.RefundAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelPacketFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RefundedFees) > 0 {
+		for _, e := range m.RefundedFees {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUpdateCancellationDelay) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.CancellationDelay)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateCancellationDelayResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1973,6 +2657,535 @@ func (m *MsgPayPacketFeeAsyncResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgUpdateAutoIncentivePolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateAutoIncentivePolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateAutoIncentivePolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateAutoIncentivePolicyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateAutoIncentivePolicyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateAutoIncentivePolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelPacketFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelPacketFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelPacketFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PacketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RefundAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelPacketFeeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelPacketFeeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelPacketFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundedFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RefundedFees = append(m.RefundedFees, types1.Coin{})
+			if err := m.RefundedFees[len(m.RefundedFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateCancellationDelay) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateCancellationDelay: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateCancellationDelay: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CancellationDelay", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.CancellationDelay, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateCancellationDelayResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateCancellationDelayResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateCancellationDelayResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
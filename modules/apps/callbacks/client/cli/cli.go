@@ -0,0 +1,41 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+)
+
+// GetQueryCmd returns the query commands for the ibc-callbacks middleware
+func GetQueryCmd() *cobra.Command {
+	queryCmd := &cobra.Command{
+		Use:                        "ibc-callbacks",
+		Short:                      "IBC callbacks middleware query subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+	}
+
+	queryCmd.AddCommand(
+		GetCmdPendingCallback(),
+		GetCmdPendingCallbacks(),
+	)
+
+	return queryCmd
+}
+
+// NewTxCmd returns the transaction commands for the ibc-callbacks middleware
+func NewTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        "ibc-callbacks",
+		Short:                      "IBC callbacks middleware transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		NewRetryCallbackTxCmd(),
+	)
+
+	return txCmd
+}
@@ -0,0 +1,92 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/cosmos/ibc-go/modules/apps/callbacks/types"
+	channeltypes "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
+)
+
+// GetCmdPendingCallback returns the pending source callback for a given packetID
+func GetCmdPendingCallback() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "pending-callback [src-port] [src-channel] [sequence]",
+		Short:   "Query for a source callback pending retry by port-id, channel-id and packet sequence.",
+		Long:    "Query for a source callback pending retry by port-id, channel-id and packet sequence.",
+		Args:    cobra.ExactArgs(3),
+		Example: fmt.Sprintf("%s query ibc-callbacks pending-callback transfer channel-0 1", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			seq, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			packetID := channeltypes.NewPacketID(args[0], args[1], seq)
+
+			if err := packetID.Validate(); err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.PendingCallback(cmd.Context(), &types.QueryPendingCallbackRequest{PacketId: packetID})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdPendingCallbacks returns all of the source callbacks pending retry
+func GetCmdPendingCallbacks() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "pending-callbacks",
+		Short:   "Query for all of the source callbacks pending retry.",
+		Long:    "Query for all of the source callbacks pending retry.",
+		Args:    cobra.NoArgs,
+		Example: fmt.Sprintf("%s query ibc-callbacks pending-callbacks", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.PendingCallbacks(cmd.Context(), &types.QueryPendingCallbacksRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "pending callbacks")
+
+	return cmd
+}
@@ -0,0 +1,48 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/cosmos/ibc-go/modules/apps/callbacks/types"
+	channeltypes "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
+)
+
+// NewRetryCallbackTxCmd returns the command to create a MsgRetryCallback
+func NewRetryCallbackTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "retry-callback [src-port] [src-channel] [sequence]",
+		Short:   "Retry the execution of a source callback which ran out of gas",
+		Long:    strings.TrimSpace(`Retry the execution of a source callback which ran out of gas. The sender pays for the gas consumed by the callback, which may use all of the gas remaining in the transaction.`),
+		Example: fmt.Sprintf("%s tx ibc-callbacks retry-callback transfer channel-0 1", version.AppName),
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			seq, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			packetID := channeltypes.NewPacketID(args[0], args[1], seq)
+			msg := types.NewMsgRetryCallback(packetID, clientCtx.GetFromAddress().String())
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
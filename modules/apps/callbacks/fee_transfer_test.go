@@ -116,6 +116,7 @@ func (s *CallbacksTestSuite) TestIncentivizedTransferCallbacks() {
 					contractAddress,
 					_ string,
 					_ string,
+					_ []byte,
 				) error {
 					expAck := channeltypes.NewResultAcknowledgement([]byte{byte(1)}).Acknowledgement()
 					s.Require().Equal(expAck, acknowledgement)
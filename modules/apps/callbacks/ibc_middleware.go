@@ -10,6 +10,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/cosmos/ibc-go/modules/apps/callbacks/keeper"
 	"github.com/cosmos/ibc-go/modules/apps/callbacks/types"
 	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
 	clienttypes "github.com/cosmos/ibc-go/v9/modules/core/02-client/types"
@@ -37,6 +38,10 @@ type IBCMiddleware struct {
 	// is reverted if the relayer hadn't provided the minimum(userDefinedGas, maxCallbackGas).
 	// If the actor hasn't defined a gas limit, then it is assumed to be the maxCallbackGas.
 	maxCallbackGas uint64
+
+	// retryKeeper is optional. If set, source callbacks which run out of gas without the possibility of being
+	// retried by the relayer are stored in a retry queue so that they may be re-executed via MsgRetryCallback.
+	retryKeeper *keeper.Keeper
 }
 
 // NewIBCMiddleware creates a new IBCMiddleware given the keeper and underlying application.
@@ -70,6 +75,22 @@ func NewIBCMiddleware(
 	}
 }
 
+// NewIBCMiddlewareWithRetryQueue creates a new IBCMiddleware given the keeper and underlying application.
+// Source callbacks which run out of gas within their commit gas limit are stored in the retry queue of the
+// given retry keeper, from which they may be re-executed via MsgRetryCallback.
+func NewIBCMiddlewareWithRetryQueue(
+	app porttypes.IBCModule, ics4Wrapper porttypes.ICS4Wrapper,
+	contractKeeper types.ContractKeeper, maxCallbackGas uint64, retryKeeper *keeper.Keeper,
+) IBCMiddleware {
+	if retryKeeper == nil {
+		panic(errors.New("retry keeper cannot be nil"))
+	}
+
+	im := NewIBCMiddleware(app, ics4Wrapper, contractKeeper, maxCallbackGas)
+	im.retryKeeper = retryKeeper
+	return im
+}
+
 // WithICS4Wrapper sets the ICS4Wrapper. This function may be used after the
 // middleware's creation to set the middleware which is above this module in
 // the IBC application stack.
@@ -103,6 +124,12 @@ func (im IBCMiddleware) SendPacket(
 	packet := channeltypes.NewPacket(data, seq, sourcePort, sourceChannel, "", "", timeoutHeight, timeoutTimestamp)
 
 	sdkCtx := sdk.UnwrapSDKContext(ctx) // TODO: https://github.com/cosmos/ibc-go/issues/5917
+
+	// packets with malformed calldata are rejected, since their callbacks would otherwise silently not be executed
+	if err := types.ValidateCalldata(sdkCtx, im.app, packet); err != nil {
+		return 0, err
+	}
+
 	callbackData, err := types.GetSourceCallbackData(sdkCtx, im.app, packet, im.maxCallbackGas)
 	// SendPacket is not blocked if the packet does not opt-in to callbacks
 	if err != nil {
@@ -111,7 +138,7 @@ func (im IBCMiddleware) SendPacket(
 
 	callbackExecutor := func(cachedCtx sdk.Context) error {
 		return im.contractKeeper.IBCSendPacketCallback(
-			cachedCtx, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data, callbackData.CallbackAddress, callbackData.SenderAddress, callbackData.ApplicationVersion,
+			cachedCtx, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data, callbackData.CallbackAddress, callbackData.SenderAddress, callbackData.ApplicationVersion, callbackData.Calldata,
 		)
 	}
 
@@ -154,7 +181,7 @@ func (im IBCMiddleware) OnAcknowledgementPacket(
 
 	callbackExecutor := func(cachedCtx sdk.Context) error {
 		return im.contractKeeper.IBCOnAcknowledgementPacketCallback(
-			cachedCtx, packet, acknowledgement, relayer, callbackData.CallbackAddress, callbackData.SenderAddress, callbackData.ApplicationVersion,
+			cachedCtx, packet, acknowledgement, relayer, callbackData.CallbackAddress, callbackData.SenderAddress, callbackData.ApplicationVersion, callbackData.Calldata,
 		)
 	}
 
@@ -165,6 +192,8 @@ func (im IBCMiddleware) OnAcknowledgementPacket(
 		types.CallbackTypeAcknowledgementPacket, callbackData, err,
 	)
 
+	im.queueCallback(sdkCtx, types.NewPendingCallback(packet, types.CallbackTypeAcknowledgementPacket, acknowledgement, relayer, callbackData), err)
+
 	return nil
 }
 
@@ -189,7 +218,7 @@ func (im IBCMiddleware) OnTimeoutPacket(ctx context.Context, channelVersion stri
 	}
 
 	callbackExecutor := func(cachedCtx sdk.Context) error {
-		return im.contractKeeper.IBCOnTimeoutPacketCallback(cachedCtx, packet, relayer, callbackData.CallbackAddress, callbackData.SenderAddress, callbackData.ApplicationVersion)
+		return im.contractKeeper.IBCOnTimeoutPacketCallback(cachedCtx, packet, relayer, callbackData.CallbackAddress, callbackData.SenderAddress, callbackData.ApplicationVersion, callbackData.Calldata)
 	}
 
 	// callback execution errors are not allowed to block the packet lifecycle, they are only used in event emissions
@@ -199,6 +228,8 @@ func (im IBCMiddleware) OnTimeoutPacket(ctx context.Context, channelVersion stri
 		types.CallbackTypeTimeoutPacket, callbackData, err,
 	)
 
+	im.queueCallback(sdkCtx, types.NewPendingCallback(packet, types.CallbackTypeTimeoutPacket, nil, relayer, callbackData), err)
+
 	return nil
 }
 
@@ -226,7 +257,7 @@ func (im IBCMiddleware) OnRecvPacket(ctx context.Context, channelVersion string,
 	}
 
 	callbackExecutor := func(cachedCtx sdk.Context) error {
-		return im.contractKeeper.IBCReceivePacketCallback(cachedCtx, packet, ack, callbackData.CallbackAddress, callbackData.ApplicationVersion)
+		return im.contractKeeper.IBCReceivePacketCallback(cachedCtx, packet, ack, callbackData.CallbackAddress, callbackData.ApplicationVersion, callbackData.Calldata)
 	}
 
 	// callback execution errors are not allowed to block the packet lifecycle, they are only used in event emissions
@@ -269,7 +300,7 @@ func (im IBCMiddleware) WriteAcknowledgement(
 	}
 
 	callbackExecutor := func(cachedCtx sdk.Context) error {
-		return im.contractKeeper.IBCReceivePacketCallback(cachedCtx, packet, ack, callbackData.CallbackAddress, callbackData.ApplicationVersion)
+		return im.contractKeeper.IBCReceivePacketCallback(cachedCtx, packet, ack, callbackData.CallbackAddress, callbackData.ApplicationVersion, callbackData.Calldata)
 	}
 
 	// callback execution errors are not allowed to block the packet lifecycle, they are only used in event emissions
@@ -331,6 +362,17 @@ func (IBCMiddleware) processCallback(
 	return err
 }
 
+// queueCallback stores the pending source callback in the retry queue if the retry queue is enabled and the callback
+// ran out of gas. Callbacks which fail for any other reason are not queued.
+func (im IBCMiddleware) queueCallback(ctx sdk.Context, pendingCallback types.PendingCallback, err error) {
+	if im.retryKeeper == nil || !errors.Is(err, types.ErrCallbackOutOfGas) {
+		return
+	}
+
+	im.retryKeeper.SetPendingCallback(ctx, pendingCallback)
+	types.EmitPendingCallbackEvent(ctx, types.EventTypeCallbackQueued, pendingCallback, err)
+}
+
 // OnChanOpenInit defers to the underlying application
 func (im IBCMiddleware) OnChanOpenInit(
 	ctx context.Context,
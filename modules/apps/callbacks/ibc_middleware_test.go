@@ -132,6 +132,24 @@ func (s *CallbacksTestSuite) TestSendPacket() {
 			false,
 			nil,
 		},
+		{
+			"failure: source callback calldata is not base64 encoded",
+			func() {
+				packetData.Memo = fmt.Sprintf(`{"src_callback": {"address": "%s", "calldata": "not base64!"}}`, simapp.SuccessContract)
+			},
+			"none", // malformed calldata should result in no callback execution
+			false,
+			types.ErrInvalidCalldata,
+		},
+		{
+			"failure: destination callback calldata is not base64 encoded",
+			func() {
+				packetData.Memo = fmt.Sprintf(`{"src_callback": {"address": "%s"}, "dest_callback": {"address": "%s", "calldata": "not base64!"}}`, simapp.SuccessContract, simapp.SuccessContract)
+			},
+			"none", // malformed calldata should result in no callback execution
+			false,
+			types.ErrInvalidCalldata,
+		},
 		{
 			"failure: ics4Wrapper SendPacket call fails",
 			func() {
@@ -0,0 +1,19 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/modules/apps/callbacks/types"
+)
+
+// InitGenesis initializes the ibc-callbacks middleware retry queue from a provided genesis state
+func (k Keeper) InitGenesis(ctx sdk.Context, state types.GenesisState) {
+	for _, pendingCallback := range state.PendingCallbacks {
+		k.SetPendingCallback(ctx, pendingCallback)
+	}
+}
+
+// ExportGenesis returns the ibc-callbacks middleware exported genesis
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	return types.NewGenesisState(k.GetAllPendingCallbacks(ctx))
+}
@@ -0,0 +1,26 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/ibc-go/modules/apps/callbacks/testing/simapp"
+	"github.com/cosmos/ibc-go/modules/apps/callbacks/types"
+)
+
+func (suite *KeeperTestSuite) TestInitExportGenesis() {
+	genesisState := types.NewGenesisState([]types.PendingCallback{
+		suite.newPendingCallback(1, types.CallbackTypeAcknowledgementPacket, simapp.SuccessContract),
+		suite.newPendingCallback(2, types.CallbackTypeTimeoutPacket, simapp.ErrorContract),
+	})
+
+	ctx := suite.chainA.GetContext()
+	callbacksKeeper := GetSimApp(suite.chainA).CallbacksKeeper
+
+	callbacksKeeper.InitGenesis(ctx, *genesisState)
+
+	for _, expPendingCallback := range genesisState.PendingCallbacks {
+		pendingCallback, found := callbacksKeeper.GetPendingCallback(ctx, expPendingCallback.PacketID())
+		suite.Require().True(found)
+		suite.Require().Equal(expPendingCallback, pendingCallback)
+	}
+
+	suite.Require().Equal(genesisState, callbacksKeeper.ExportGenesis(ctx))
+}
@@ -0,0 +1,63 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/cosmos/ibc-go/modules/apps/callbacks/types"
+)
+
+var _ types.QueryServer = (*Keeper)(nil)
+
+// PendingCallbacks implements the Query/PendingCallbacks gRPC method
+func (k Keeper) PendingCallbacks(ctx context.Context, req *types.QueryPendingCallbacksRequest) (*types.QueryPendingCallbacksResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	var pendingCallbacks []types.PendingCallback
+
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), []byte(types.PendingCallbackPrefix))
+	pagination, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		pendingCallbacks = append(pendingCallbacks, k.MustUnmarshalPendingCallback(value))
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &types.QueryPendingCallbacksResponse{
+		PendingCallbacks: pendingCallbacks,
+		Pagination:       pagination,
+	}, nil
+}
+
+// PendingCallback implements the Query/PendingCallback gRPC method
+func (k Keeper) PendingCallback(ctx context.Context, req *types.QueryPendingCallbackRequest) (*types.QueryPendingCallbackResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := req.PacketId.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	pendingCallback, found := k.GetPendingCallback(ctx, req.PacketId)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			errorsmod.Wrapf(types.ErrPendingCallbackNotFound, "port: %s, channel: %s, sequence: %d", req.PacketId.PortId, req.PacketId.ChannelId, req.PacketId.Sequence).Error())
+	}
+
+	return &types.QueryPendingCallbackResponse{
+		PendingCallback: pendingCallback,
+	}, nil
+}
@@ -0,0 +1,152 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/cosmos/ibc-go/modules/apps/callbacks/testing/simapp"
+	"github.com/cosmos/ibc-go/modules/apps/callbacks/types"
+	channeltypes "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v9/testing"
+)
+
+func (suite *KeeperTestSuite) TestQueryPendingCallbacks() {
+	var (
+		req                 *types.QueryPendingCallbacksRequest
+		expPendingCallbacks []types.PendingCallback
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {
+				req = &types.QueryPendingCallbacksRequest{
+					Pagination: &query.PageRequest{
+						Limit:      5,
+						CountTotal: false,
+					},
+				}
+
+				expPendingCallbacks = []types.PendingCallback{
+					suite.newPendingCallback(1, types.CallbackTypeAcknowledgementPacket, simapp.SuccessContract),
+					suite.newPendingCallback(2, types.CallbackTypeTimeoutPacket, simapp.SuccessContract),
+					suite.newPendingCallback(3, types.CallbackTypeAcknowledgementPacket, simapp.SuccessContract),
+				}
+
+				for _, pendingCallback := range expPendingCallbacks {
+					GetSimApp(suite.chainA).CallbacksKeeper.SetPendingCallback(suite.chainA.GetContext(), pendingCallback)
+				}
+			},
+			true,
+		},
+		{
+			"empty pagination",
+			func() {
+				expPendingCallbacks = nil
+				req = &types.QueryPendingCallbacksRequest{}
+			},
+			true,
+		},
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			tc.malleate() // malleate mutates test data
+
+			ctx := suite.chainA.GetContext()
+			res, err := GetSimApp(suite.chainA).CallbacksKeeper.PendingCallbacks(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(expPendingCallbacks, res.PendingCallbacks)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryPendingCallback() {
+	var (
+		req                *types.QueryPendingCallbackRequest
+		expPendingCallback types.PendingCallback
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"pending callback not found",
+			func() {
+				req.PacketId = channeltypes.NewPacketID(ibctesting.MockPort, ibctesting.FirstChannelID, 2)
+			},
+			fmt.Errorf("rpc error: code = NotFound desc = port: %s, channel: %s, sequence: %d: %s", ibctesting.MockPort, ibctesting.FirstChannelID, 2, types.ErrPendingCallbackNotFound.Error()),
+		},
+		{
+			"invalid packet id",
+			func() {
+				req.PacketId = channeltypes.NewPacketID(ibctesting.MockPort, ibctesting.FirstChannelID, 0)
+			},
+			fmt.Errorf("rpc error: code = InvalidArgument desc = packet sequence cannot be 0: invalid packet"),
+		},
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			fmt.Errorf("rpc error: code = InvalidArgument desc = empty request"),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			expPendingCallback = suite.newPendingCallback(1, types.CallbackTypeAcknowledgementPacket, simapp.SuccessContract)
+			GetSimApp(suite.chainA).CallbacksKeeper.SetPendingCallback(suite.chainA.GetContext(), expPendingCallback)
+
+			req = &types.QueryPendingCallbackRequest{
+				PacketId: expPendingCallback.PacketID(),
+			}
+
+			tc.malleate() // malleate mutates test data
+
+			ctx := suite.chainA.GetContext()
+			res, err := GetSimApp(suite.chainA).CallbacksKeeper.PendingCallback(ctx, req)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expPendingCallback, res.PendingCallback)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Equal(tc.expErr.Error(), err.Error())
+			}
+		})
+	}
+}
@@ -0,0 +1,122 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	corestore "cosmossdk.io/core/store"
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/modules/apps/callbacks/types"
+	channeltypes "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v9/modules/core/exported"
+)
+
+// Keeper defines the ibc-callbacks keeper, which maintains the queue of source callbacks pending retry
+type Keeper struct {
+	storeService corestore.KVStoreService
+	cdc          codec.BinaryCodec
+
+	contractKeeper types.ContractKeeper
+
+	// maxRetryAttempts defines the number of times a pending callback may fail to execute when retried
+	// before it is removed from the retry queue.
+	maxRetryAttempts uint64
+}
+
+// NewKeeper creates a new ibc-callbacks Keeper instance
+func NewKeeper(
+	cdc codec.BinaryCodec, storeService corestore.KVStoreService,
+	contractKeeper types.ContractKeeper, maxRetryAttempts uint64,
+) Keeper {
+	if contractKeeper == nil {
+		panic(errors.New("contract keeper cannot be nil"))
+	}
+
+	if maxRetryAttempts == 0 {
+		panic(errors.New("maxRetryAttempts cannot be zero"))
+	}
+
+	return Keeper{
+		cdc:              cdc,
+		storeService:     storeService,
+		contractKeeper:   contractKeeper,
+		maxRetryAttempts: maxRetryAttempts,
+	}
+}
+
+// GetMaxRetryAttempts returns the number of times a pending callback may fail to execute when retried.
+func (k Keeper) GetMaxRetryAttempts() uint64 {
+	return k.maxRetryAttempts
+}
+
+// Logger returns a module-specific logger.
+func (Keeper) Logger(ctx context.Context) log.Logger {
+	sdkCtx := sdk.UnwrapSDKContext(ctx) // TODO: https://github.com/cosmos/ibc-go/issues/5917
+	return sdkCtx.Logger().With("module", "x/"+ibcexported.ModuleName+"-"+types.ModuleName)
+}
+
+// GetPendingCallback returns the pending source callback for the given packetID
+func (k Keeper) GetPendingCallback(ctx context.Context, packetID channeltypes.PacketId) (types.PendingCallback, bool) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.KeyPendingCallback(packetID))
+	if err != nil {
+		panic(err)
+	}
+
+	if len(bz) == 0 {
+		return types.PendingCallback{}, false
+	}
+
+	return k.MustUnmarshalPendingCallback(bz), true
+}
+
+// SetPendingCallback stores the pending source callback under the identifier of its packet
+func (k Keeper) SetPendingCallback(ctx context.Context, pendingCallback types.PendingCallback) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz := k.MustMarshalPendingCallback(pendingCallback)
+	if err := store.Set(types.KeyPendingCallback(pendingCallback.PacketID()), bz); err != nil {
+		panic(err)
+	}
+}
+
+// DeletePendingCallback deletes the pending source callback for the given packetID
+func (k Keeper) DeletePendingCallback(ctx context.Context, packetID channeltypes.PacketId) {
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Delete(types.KeyPendingCallback(packetID)); err != nil {
+		panic(err)
+	}
+}
+
+// GetAllPendingCallbacks returns all the pending source callbacks stored in state
+func (k Keeper) GetAllPendingCallbacks(ctx context.Context) []types.PendingCallback {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte(types.PendingCallbackPrefix))
+	defer sdk.LogDeferred(k.Logger(ctx), func() error { return iterator.Close() })
+
+	var pendingCallbacks []types.PendingCallback
+	for ; iterator.Valid(); iterator.Next() {
+		pendingCallbacks = append(pendingCallbacks, k.MustUnmarshalPendingCallback(iterator.Value()))
+	}
+
+	return pendingCallbacks
+}
+
+// MustMarshalPendingCallback attempts to encode a PendingCallback object and returns the
+// raw encoded bytes. It panics on error.
+func (k Keeper) MustMarshalPendingCallback(pendingCallback types.PendingCallback) []byte {
+	return k.cdc.MustMarshal(&pendingCallback)
+}
+
+// MustUnmarshalPendingCallback attempts to decode and return a PendingCallback object from
+// raw encoded bytes. It panics on error.
+func (k Keeper) MustUnmarshalPendingCallback(bz []byte) types.PendingCallback {
+	var pendingCallback types.PendingCallback
+	k.cdc.MustUnmarshal(bz, &pendingCallback)
+	return pendingCallback
+}
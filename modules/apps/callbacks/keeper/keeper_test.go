@@ -0,0 +1,110 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/suite"
+
+	"cosmossdk.io/log"
+
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+
+	"github.com/cosmos/ibc-go/modules/apps/callbacks/testing/simapp"
+	"github.com/cosmos/ibc-go/modules/apps/callbacks/types"
+	clienttypes "github.com/cosmos/ibc-go/v9/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v9/testing"
+	ibcmock "github.com/cosmos/ibc-go/v9/testing/mock"
+)
+
+func init() {
+	ibctesting.DefaultTestingAppInit = SetupTestingApp
+}
+
+// SetupTestingApp provides the duplicated simapp which is specific to the callbacks module on chain creation.
+func SetupTestingApp() (ibctesting.TestingApp, map[string]json.RawMessage) {
+	db := dbm.NewMemDB()
+	app := simapp.NewSimApp(log.NewNopLogger(), db, nil, true, simtestutil.EmptyAppOptions{})
+	return app, app.DefaultGenesis()
+}
+
+// GetSimApp returns the duplicated SimApp from within the callbacks directory.
+func GetSimApp(chain *ibctesting.TestChain) *simapp.SimApp {
+	app, ok := chain.App.(*simapp.SimApp)
+	if !ok {
+		panic(errors.New("chain is not a simapp.SimApp"))
+	}
+	return app
+}
+
+// KeeperTestSuite is a testing suite to test keeper functions
+type KeeperTestSuite struct {
+	suite.Suite
+
+	coordinator *ibctesting.Coordinator
+
+	chainA *ibctesting.TestChain
+	chainB *ibctesting.TestChain
+}
+
+// SetupTest creates a coordinator with 2 test chains.
+func (suite *KeeperTestSuite) SetupTest() {
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 2)
+	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+	suite.chainB = suite.coordinator.GetChain(ibctesting.GetChainID(2))
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+// newPendingCallback returns a pending callback of the given type for a packet with the given sequence,
+// to be executed on the given contract address
+func (suite *KeeperTestSuite) newPendingCallback(sequence uint64, callbackType types.CallbackType, contractAddress string) types.PendingCallback {
+	packet := channeltypes.NewPacket(
+		ibcmock.MockPacketData, sequence, ibctesting.MockPort, ibctesting.FirstChannelID,
+		ibctesting.MockPort, ibctesting.FirstChannelID, clienttypes.NewHeight(1, 100), 0,
+	)
+
+	var ack []byte
+	if callbackType == types.CallbackTypeAcknowledgementPacket {
+		ack = ibcmock.MockAcknowledgement.Acknowledgement()
+	}
+
+	callbackData := types.CallbackData{
+		CallbackAddress:    contractAddress,
+		SenderAddress:      suite.chainA.SenderAccount.GetAddress().String(),
+		ApplicationVersion: ibcmock.Version,
+	}
+
+	return types.NewPendingCallback(packet, callbackType, ack, suite.chainA.SenderAccount.GetAddress(), callbackData)
+}
+
+func (suite *KeeperTestSuite) TestPendingCallbacks() {
+	ctx := suite.chainA.GetContext()
+	callbacksKeeper := GetSimApp(suite.chainA).CallbacksKeeper
+
+	expPendingCallbacks := []types.PendingCallback{
+		suite.newPendingCallback(1, types.CallbackTypeAcknowledgementPacket, simapp.SuccessContract),
+		suite.newPendingCallback(2, types.CallbackTypeTimeoutPacket, simapp.SuccessContract),
+	}
+
+	for _, pendingCallback := range expPendingCallbacks {
+		callbacksKeeper.SetPendingCallback(ctx, pendingCallback)
+	}
+
+	pendingCallback, found := callbacksKeeper.GetPendingCallback(ctx, expPendingCallbacks[0].PacketID())
+	suite.Require().True(found)
+	suite.Require().Equal(expPendingCallbacks[0], pendingCallback)
+
+	suite.Require().Equal(expPendingCallbacks, callbacksKeeper.GetAllPendingCallbacks(ctx))
+
+	callbacksKeeper.DeletePendingCallback(ctx, expPendingCallbacks[0].PacketID())
+
+	_, found = callbacksKeeper.GetPendingCallback(ctx, expPendingCallbacks[0].PacketID())
+	suite.Require().False(found)
+	suite.Require().Equal(expPendingCallbacks[1:], callbacksKeeper.GetAllPendingCallbacks(ctx))
+}
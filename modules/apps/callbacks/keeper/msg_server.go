@@ -0,0 +1,26 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/modules/apps/callbacks/types"
+)
+
+var _ types.MsgServer = (*Keeper)(nil)
+
+// RetryCallback defines a rpc handler method for MsgRetryCallback
+// RetryCallback may be called by any account to re-execute a pending source callback. The signer pays for the gas
+// consumed by the callback. A callback which fails to execute remains in the retry queue until it has failed the
+// maximum number of retry attempts.
+func (k Keeper) RetryCallback(goCtx context.Context, msg *types.MsgRetryCallback) (*types.MsgRetryCallbackResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	success, err := k.retryCallback(ctx, msg.PacketId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRetryCallbackResponse{Success: success}, nil
+}
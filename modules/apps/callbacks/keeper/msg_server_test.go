@@ -0,0 +1,148 @@
+package keeper_test
+
+import (
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/ibc-go/modules/apps/callbacks/testing/simapp"
+	"github.com/cosmos/ibc-go/modules/apps/callbacks/types"
+	channeltypes "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v9/testing"
+)
+
+func (suite *KeeperTestSuite) TestRetryCallback() {
+	var (
+		pendingCallback types.PendingCallback
+		msg             *types.MsgRetryCallback
+	)
+
+	testCases := []struct {
+		name        string
+		malleate    func()
+		expErr      error
+		expSuccess  bool
+		expAttempts uint64 // expected attempts of the pending callback if it is not removed from the retry queue
+		expRemoved  bool
+	}{
+		{
+			"success: acknowledgement callback",
+			func() {},
+			nil,
+			true,
+			0,
+			true,
+		},
+		{
+			"success: timeout callback",
+			func() {
+				pendingCallback = suite.newPendingCallback(1, types.CallbackTypeTimeoutPacket, simapp.SuccessContract)
+			},
+			nil,
+			true,
+			0,
+			true,
+		},
+		{
+			"success: callback errors and its attempts are incremented",
+			func() {
+				pendingCallback.CallbackAddress = simapp.ErrorContract
+			},
+			nil,
+			false,
+			1,
+			false,
+		},
+		{
+			"success: callback panics and its attempts are incremented",
+			func() {
+				pendingCallback.CallbackAddress = simapp.PanicContract
+			},
+			nil,
+			false,
+			1,
+			false,
+		},
+		{
+			"success: callback errors and is removed after reaching the maximum number of retry attempts",
+			func() {
+				pendingCallback.CallbackAddress = simapp.ErrorContract
+				pendingCallback.Attempts = GetSimApp(suite.chainA).CallbacksKeeper.GetMaxRetryAttempts() - 1
+			},
+			nil,
+			false,
+			0,
+			true,
+		},
+		{
+			"failure: pending callback not found",
+			func() {
+				msg.PacketId = channeltypes.NewPacketID(ibctesting.MockPort, ibctesting.FirstChannelID, 2)
+			},
+			types.ErrPendingCallbackNotFound,
+			false,
+			0,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			pendingCallback = suite.newPendingCallback(1, types.CallbackTypeAcknowledgementPacket, simapp.SuccessContract)
+			msg = types.NewMsgRetryCallback(pendingCallback.PacketID(), suite.chainA.SenderAccount.GetAddress().String())
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext().WithGasMeter(storetypes.NewGasMeter(1_000_000))
+			callbacksKeeper := GetSimApp(suite.chainA).CallbacksKeeper
+			mockContractKeeper := GetSimApp(suite.chainA).MockContractKeeper
+
+			callbacksKeeper.SetPendingCallback(ctx, pendingCallback)
+
+			res, err := callbacksKeeper.RetryCallback(ctx, msg)
+
+			if tc.expErr != nil {
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().Nil(res)
+				return
+			}
+
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expSuccess, res.Success)
+			suite.Require().Equal(1, mockContractKeeper.Counters[types.CallbackType(pendingCallback.CallbackType)])
+
+			// state changes of the callback are only written if it succeeds
+			if tc.expSuccess {
+				suite.Require().Equal(uint8(1), mockContractKeeper.GetStateEntryCounter(ctx))
+			} else {
+				suite.Require().Equal(uint8(0), mockContractKeeper.GetStateEntryCounter(ctx))
+			}
+
+			storedCallback, found := callbacksKeeper.GetPendingCallback(ctx, msg.PacketId)
+			if tc.expRemoved {
+				suite.Require().False(found)
+			} else {
+				suite.Require().True(found)
+				suite.Require().Equal(tc.expAttempts, storedCallback.Attempts)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestRetryCallbackOutOfGas() {
+	pendingCallback := suite.newPendingCallback(1, types.CallbackTypeAcknowledgementPacket, simapp.OogPanicContract)
+	msg := types.NewMsgRetryCallback(pendingCallback.PacketID(), suite.chainA.SenderAccount.GetAddress().String())
+
+	ctx := suite.chainA.GetContext().WithGasMeter(storetypes.NewGasMeter(1_000_000))
+	callbacksKeeper := GetSimApp(suite.chainA).CallbacksKeeper
+	callbacksKeeper.SetPendingCallback(ctx, pendingCallback)
+
+	// the transaction is reverted so that the attempt is not counted and the callback may be retried with more gas
+	suite.Require().PanicsWithValue(storetypes.ErrorOutOfGas{
+		Descriptor: "ibc acknowledgement_packet callback retry out of gas",
+	}, func() {
+		_, _ = callbacksKeeper.RetryCallback(ctx, msg)
+	})
+}
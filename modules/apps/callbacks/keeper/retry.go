@@ -0,0 +1,90 @@
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/modules/apps/callbacks/types"
+	channeltypes "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
+)
+
+// retryCallback re-executes the pending source callback for the given packetID. If the callback executes
+// successfully it is removed from the retry queue. Otherwise its failed attempts are incremented, and it is removed
+// from the retry queue once the maximum number of retry attempts has been reached.
+func (k Keeper) retryCallback(ctx sdk.Context, packetID channeltypes.PacketId) (bool, error) {
+	pendingCallback, found := k.GetPendingCallback(ctx, packetID)
+	if !found {
+		return false, errorsmod.Wrapf(types.ErrPendingCallbackNotFound, "port: %s, channel: %s, sequence: %d", packetID.PortId, packetID.ChannelId, packetID.Sequence)
+	}
+
+	err := k.executeCallback(ctx, pendingCallback)
+	if err == nil {
+		k.DeletePendingCallback(ctx, packetID)
+		types.EmitPendingCallbackEvent(ctx, types.EventTypeCallbackRetry, pendingCallback, nil)
+		return true, nil
+	}
+
+	pendingCallback.Attempts++
+	if pendingCallback.Attempts >= k.maxRetryAttempts {
+		k.DeletePendingCallback(ctx, packetID)
+		k.Logger(ctx).Info("pending callback removed after reaching the maximum number of retry attempts", "port-id", packetID.PortId, "channel-id", packetID.ChannelId, "sequence", packetID.Sequence, "attempts", pendingCallback.Attempts)
+	} else {
+		k.SetPendingCallback(ctx, pendingCallback)
+	}
+
+	types.EmitPendingCallbackEvent(ctx, types.EventTypeCallbackRetry, pendingCallback, err)
+	return false, nil
+}
+
+// executeCallback executes the pending callback on the contract keeper and writes its state changes only if it
+// succeeds. The callback may consume all the gas remaining in the transaction. If it runs out of gas, the transaction
+// is reverted via a panic so that the attempt is not counted and the callback may be retried with a higher gas limit.
+func (k Keeper) executeCallback(ctx sdk.Context, pendingCallback types.PendingCallback) (err error) {
+	relayer, err := sdk.AccAddressFromBech32(pendingCallback.Relayer)
+	if err != nil {
+		return err
+	}
+
+	callbackType := types.CallbackType(pendingCallback.CallbackType)
+
+	cachedCtx, writeFn := ctx.CacheContext()
+	cachedCtx = cachedCtx.WithGasMeter(storetypes.NewGasMeter(ctx.GasMeter().GasRemaining()))
+
+	defer func() {
+		// consume the minimum of g.consumed and g.limit
+		ctx.GasMeter().ConsumeGas(cachedCtx.GasMeter().GasConsumedToLimit(), fmt.Sprintf("ibc %s callback retry", callbackType))
+
+		if r := recover(); r != nil {
+			err = errorsmod.Wrapf(types.ErrCallbackPanic, "ibc %s callback panicked with: %v", callbackType, r)
+		}
+
+		if cachedCtx.GasMeter().IsPastLimit() {
+			panic(storetypes.ErrorOutOfGas{Descriptor: fmt.Sprintf("ibc %s callback retry out of gas", callbackType)})
+		}
+	}()
+
+	switch callbackType {
+	case types.CallbackTypeAcknowledgementPacket:
+		err = k.contractKeeper.IBCOnAcknowledgementPacketCallback(
+			cachedCtx, pendingCallback.Packet, pendingCallback.Acknowledgement, relayer, pendingCallback.CallbackAddress,
+			pendingCallback.SenderAddress, pendingCallback.ApplicationVersion, pendingCallback.Calldata,
+		)
+	case types.CallbackTypeTimeoutPacket:
+		err = k.contractKeeper.IBCOnTimeoutPacketCallback(
+			cachedCtx, pendingCallback.Packet, relayer, pendingCallback.CallbackAddress,
+			pendingCallback.SenderAddress, pendingCallback.ApplicationVersion, pendingCallback.Calldata,
+		)
+	default:
+		err = errorsmod.Wrapf(types.ErrInvalidPendingCallback, "invalid callback type %s", callbackType)
+	}
+
+	if err == nil {
+		writeFn()
+	}
+
+	return err
+}
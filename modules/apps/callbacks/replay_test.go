@@ -38,6 +38,7 @@ func (s *CallbacksTestSuite) TestTransferTimeoutReplayProtection() {
 				packet channeltypes.Packet,
 				_ sdk.AccAddress,
 				_, _, _ string,
+				_ []byte,
 			) error {
 				// only replay the timeout packet twice. We could replay it more times
 				callbackCount++
@@ -120,6 +121,7 @@ func (s *CallbacksTestSuite) TestTransferErrorAcknowledgementReplayProtection()
 				ack []byte,
 				_ sdk.AccAddress,
 				_, _, _ string,
+				_ []byte,
 			) error {
 				// only replay the ack packet twice. We could replay it more times
 				callbackCount++
@@ -198,6 +200,7 @@ func (s *CallbacksTestSuite) TestTransferSuccessAcknowledgementReplayProtection(
 				ack []byte,
 				_ sdk.AccAddress,
 				_, _, _ string,
+				_ []byte,
 			) error {
 				// only replay the ack packet twice. We could replay it more times
 				callbackCount++
@@ -266,6 +269,7 @@ func (s *CallbacksTestSuite) TestTransferRecvPacketReplayProtection() {
 				packet ibcexported.PacketI,
 				_ ibcexported.Acknowledgement,
 				_, _ string,
+				_ []byte,
 			) error {
 				callbackCount++
 				if callbackCount == 2 {
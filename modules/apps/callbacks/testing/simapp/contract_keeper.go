@@ -57,6 +57,7 @@ type ContractKeeper struct {
 		contractAddress,
 		packetSenderAddress string,
 		version string,
+		calldata []byte,
 	) error
 
 	IBCOnAcknowledgementPacketCallbackFn func(
@@ -67,6 +68,7 @@ type ContractKeeper struct {
 		contractAddress,
 		packetSenderAddress string,
 		version string,
+		calldata []byte,
 	) error
 
 	IBCOnTimeoutPacketCallbackFn func(
@@ -76,6 +78,7 @@ type ContractKeeper struct {
 		contractAddress,
 		packetSenderAddress string,
 		version string,
+		calldata []byte,
 	) error
 
 	IBCReceivePacketCallbackFn func(
@@ -84,6 +87,7 @@ type ContractKeeper struct {
 		ack ibcexported.Acknowledgement,
 		contractAddress string,
 		version string,
+		calldata []byte,
 	) error
 }
 
@@ -117,19 +121,19 @@ func NewContractKeeper(key storetypes.StoreKey) *ContractKeeper {
 		Counters: make(map[callbacktypes.CallbackType]int),
 	}
 
-	k.IBCSendPacketCallbackFn = func(ctx sdk.Context, _, _ string, _ clienttypes.Height, _ uint64, _ []byte, contractAddress, _, _ string) error {
+	k.IBCSendPacketCallbackFn = func(ctx sdk.Context, _, _ string, _ clienttypes.Height, _ uint64, _ []byte, contractAddress, _, _ string, _ []byte) error {
 		return k.ProcessMockCallback(ctx, callbacktypes.CallbackTypeSendPacket, contractAddress)
 	}
 
-	k.IBCOnAcknowledgementPacketCallbackFn = func(ctx sdk.Context, _ channeltypes.Packet, _ []byte, _ sdk.AccAddress, contractAddress, _, _ string) error {
+	k.IBCOnAcknowledgementPacketCallbackFn = func(ctx sdk.Context, _ channeltypes.Packet, _ []byte, _ sdk.AccAddress, contractAddress, _, _ string, _ []byte) error {
 		return k.ProcessMockCallback(ctx, callbacktypes.CallbackTypeAcknowledgementPacket, contractAddress)
 	}
 
-	k.IBCOnTimeoutPacketCallbackFn = func(ctx sdk.Context, _ channeltypes.Packet, _ sdk.AccAddress, contractAddress, _, _ string) error {
+	k.IBCOnTimeoutPacketCallbackFn = func(ctx sdk.Context, _ channeltypes.Packet, _ sdk.AccAddress, contractAddress, _, _ string, _ []byte) error {
 		return k.ProcessMockCallback(ctx, callbacktypes.CallbackTypeTimeoutPacket, contractAddress)
 	}
 
-	k.IBCReceivePacketCallbackFn = func(ctx sdk.Context, _ ibcexported.PacketI, _ ibcexported.Acknowledgement, contractAddress, _ string) error {
+	k.IBCReceivePacketCallbackFn = func(ctx sdk.Context, _ ibcexported.PacketI, _ ibcexported.Acknowledgement, contractAddress, _ string, _ []byte) error {
 		return k.ProcessMockCallback(ctx, callbacktypes.CallbackTypeReceivePacket, contractAddress)
 	}
 
@@ -153,8 +157,9 @@ func (k ContractKeeper) IBCSendPacketCallback(
 	contractAddress,
 	packetSenderAddress,
 	version string,
+	calldata []byte,
 ) error {
-	return k.IBCSendPacketCallbackFn(ctx, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, packetData, contractAddress, packetSenderAddress, version)
+	return k.IBCSendPacketCallbackFn(ctx, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, packetData, contractAddress, packetSenderAddress, version, calldata)
 }
 
 // IBCOnAcknowledgementPacketCallback increments the stateful entry counter and the acknowledgement_packet callback counter.
@@ -172,8 +177,9 @@ func (k ContractKeeper) IBCOnAcknowledgementPacketCallback(
 	contractAddress,
 	packetSenderAddress,
 	version string,
+	calldata []byte,
 ) error {
-	return k.IBCOnAcknowledgementPacketCallbackFn(ctx, packet, acknowledgement, relayer, contractAddress, packetSenderAddress, version)
+	return k.IBCOnAcknowledgementPacketCallbackFn(ctx, packet, acknowledgement, relayer, contractAddress, packetSenderAddress, version, calldata)
 }
 
 // IBCOnTimeoutPacketCallback increments the stateful entry counter and the timeout_packet callback counter.
@@ -190,8 +196,9 @@ func (k ContractKeeper) IBCOnTimeoutPacketCallback(
 	contractAddress,
 	packetSenderAddress,
 	version string,
+	calldata []byte,
 ) error {
-	return k.IBCOnTimeoutPacketCallbackFn(ctx, packet, relayer, contractAddress, packetSenderAddress, version)
+	return k.IBCOnTimeoutPacketCallbackFn(ctx, packet, relayer, contractAddress, packetSenderAddress, version, calldata)
 }
 
 // IBCReceivePacketCallback increments the stateful entry counter and the receive_packet callback counter.
@@ -207,8 +214,9 @@ func (k ContractKeeper) IBCReceivePacketCallback(
 	ack ibcexported.Acknowledgement,
 	contractAddress,
 	version string,
+	calldata []byte,
 ) error {
-	return k.IBCReceivePacketCallbackFn(ctx, packet, ack, contractAddress, version)
+	return k.IBCReceivePacketCallbackFn(ctx, packet, ack, contractAddress, version, calldata)
 }
 
 // ProcessMockCallback processes a mock callback.
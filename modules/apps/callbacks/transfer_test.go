@@ -1,6 +1,7 @@
 package ibccallbacks_test
 
 import (
+	"encoding/base64"
 	"fmt"
 
 	sdkmath "cosmossdk.io/math"
@@ -11,6 +12,8 @@ import (
 	"github.com/cosmos/ibc-go/modules/apps/callbacks/types"
 	transfertypes "github.com/cosmos/ibc-go/v9/modules/apps/transfer/types"
 	clienttypes "github.com/cosmos/ibc-go/v9/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v9/modules/core/exported"
 	ibctesting "github.com/cosmos/ibc-go/v9/testing"
 )
 
@@ -175,6 +178,117 @@ func (s *CallbacksTestSuite) TestTransferTimeoutCallbacks() {
 	}
 }
 
+func (s *CallbacksTestSuite) TestTransferCallbacksCalldata() {
+	s.SetupTransferTest()
+
+	var srcCalldata, ackCalldata, destCalldata []byte
+
+	mockContractKeeperA := GetSimApp(s.chainA).MockContractKeeper
+	mockContractKeeperA.IBCSendPacketCallbackFn = func(
+		ctx sdk.Context, _, _ string, _ clienttypes.Height, _ uint64, _ []byte, contractAddress, _, _ string, calldata []byte,
+	) error {
+		srcCalldata = calldata
+		return mockContractKeeperA.ProcessMockCallback(ctx, types.CallbackTypeSendPacket, contractAddress)
+	}
+	mockContractKeeperA.IBCOnAcknowledgementPacketCallbackFn = func(
+		ctx sdk.Context, _ channeltypes.Packet, _ []byte, _ sdk.AccAddress, contractAddress, _, _ string, calldata []byte,
+	) error {
+		ackCalldata = calldata
+		return mockContractKeeperA.ProcessMockCallback(ctx, types.CallbackTypeAcknowledgementPacket, contractAddress)
+	}
+
+	mockContractKeeperB := GetSimApp(s.chainB).MockContractKeeper
+	mockContractKeeperB.IBCReceivePacketCallbackFn = func(
+		ctx sdk.Context, _ ibcexported.PacketI, _ ibcexported.Acknowledgement, contractAddress, _ string, calldata []byte,
+	) error {
+		destCalldata = calldata
+		return mockContractKeeperB.ProcessMockCallback(ctx, types.CallbackTypeReceivePacket, contractAddress)
+	}
+
+	memo := fmt.Sprintf(
+		`{"src_callback": {"address": "%s", "calldata": "%s"}, "dest_callback": {"address": "%s", "calldata": "%s"}}`,
+		simapp.SuccessContract, base64.StdEncoding.EncodeToString([]byte("src-intent")),
+		simapp.SuccessContract, base64.StdEncoding.EncodeToString([]byte("dest-intent")),
+	)
+
+	s.ExecuteTransfer(memo)
+
+	s.Require().Equal([]byte("src-intent"), srcCalldata)
+	s.Require().Equal([]byte("src-intent"), ackCalldata)
+	s.Require().Equal([]byte("dest-intent"), destCalldata)
+}
+
+func (s *CallbacksTestSuite) TestTransferCallbackRetry() {
+	testCases := []struct {
+		name         string
+		callbackType types.CallbackType
+	}{
+		{
+			"acknowledgement callback out of gas is queued and retried",
+			types.CallbackTypeAcknowledgementPacket,
+		},
+		{
+			"timeout callback out of gas is queued and retried",
+			types.CallbackTypeTimeoutPacket,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTransferTest()
+
+			// the source callbacks are executed on the contract set below, regardless of the address in the memo
+			contractAddress := simapp.OogErrorContract
+
+			mockContractKeeper := GetSimApp(s.chainA).MockContractKeeper
+			mockContractKeeper.IBCSendPacketCallbackFn = func(
+				ctx sdk.Context, _, _ string, _ clienttypes.Height, _ uint64, _ []byte, _, _, _ string, _ []byte,
+			) error {
+				return mockContractKeeper.ProcessMockCallback(ctx, types.CallbackTypeSendPacket, simapp.SuccessContract)
+			}
+			mockContractKeeper.IBCOnAcknowledgementPacketCallbackFn = func(
+				ctx sdk.Context, _ channeltypes.Packet, _ []byte, _ sdk.AccAddress, _, _, _ string, _ []byte,
+			) error {
+				return mockContractKeeper.ProcessMockCallback(ctx, types.CallbackTypeAcknowledgementPacket, contractAddress)
+			}
+			mockContractKeeper.IBCOnTimeoutPacketCallbackFn = func(
+				ctx sdk.Context, _ channeltypes.Packet, _ sdk.AccAddress, _, _, _ string, _ []byte,
+			) error {
+				return mockContractKeeper.ProcessMockCallback(ctx, types.CallbackTypeTimeoutPacket, contractAddress)
+			}
+
+			memo := fmt.Sprintf(`{"src_callback": {"address": "%s"}}`, simapp.SuccessContract)
+			if tc.callbackType == types.CallbackTypeAcknowledgementPacket {
+				s.ExecuteTransfer(memo)
+			} else {
+				s.ExecuteTransferTimeout(memo)
+			}
+
+			packetID := channeltypes.NewPacketID(s.path.EndpointA.ChannelConfig.PortID, s.path.EndpointA.ChannelID, 1)
+
+			pendingCallback, found := GetSimApp(s.chainA).CallbacksKeeper.GetPendingCallback(s.chainA.GetContext(), packetID)
+			s.Require().True(found)
+			s.Require().Equal(string(tc.callbackType), pendingCallback.CallbackType)
+			s.Require().Equal(uint64(0), pendingCallback.Attempts)
+
+			// only the send packet callback state changes have been committed
+			s.Require().Equal(uint8(1), mockContractKeeper.GetStateEntryCounter(s.chainA.GetContext()))
+
+			contractAddress = simapp.SuccessContract
+
+			msg := types.NewMsgRetryCallback(packetID, s.chainA.SenderAccount.GetAddress().String())
+			_, err := s.chainA.SendMsgs(msg)
+			s.Require().NoError(err)
+
+			_, found = GetSimApp(s.chainA).CallbacksKeeper.GetPendingCallback(s.chainA.GetContext(), packetID)
+			s.Require().False(found)
+
+			s.Require().Equal(uint8(2), mockContractKeeper.GetStateEntryCounter(s.chainA.GetContext()))
+			s.Require().Equal(2, mockContractKeeper.Counters[tc.callbackType])
+		})
+	}
+}
+
 // ExecuteTransfer executes a transfer message on chainA for ibctesting.TestCoin (100 "stake").
 // It checks that the transfer is successful and that the packet is relayed to chainB.
 func (s *CallbacksTestSuite) ExecuteTransfer(memo string) {
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"encoding/base64"
 	"strconv"
 	"strings"
 
@@ -28,13 +29,15 @@ The Memo format is defined like so:
 		"address": {stringCallbackAddress},
 
 		// optional fields
-		"gas_limit": {stringForCallback}
+		"gas_limit": {stringForCallback},
+		"calldata": {base64EncodedCalldata}
 	},
 	"dest_callback": {
 		"address": {stringCallbackAddress},
 
 		// optional fields
-		"gas_limit": {stringForCallback}
+		"gas_limit": {stringForCallback},
+		"calldata": {base64EncodedCalldata}
 	}
 }
 ```
@@ -42,6 +45,10 @@ The Memo format is defined like so:
 We will pass the packet sender info (if available) to the contract keeper for source callback executions. This will allow the contract
 keeper to verify that the packet sender is the same as the callback address if desired.
 
+The calldata is opaque to the callbacks middleware and is passed as is to the contract keeper for every callback
+execution of the packet. This allows contracts to distinguish the intent of a packet without keeping track of the
+packets they sent.
+
 */
 
 // CallbacksCompatibleModule is an interface that combines the IBCModule and PacketDataUnmarshaler
@@ -68,6 +75,8 @@ type CallbackData struct {
 	CommitGasLimit uint64
 	// ApplicationVersion is the base application version.
 	ApplicationVersion string
+	// Calldata is the opaque data passed to the callback actor. It is nil if no calldata is specified.
+	Calldata []byte
 }
 
 // GetSourceCallbackData parses the packet data and returns the source callback data.
@@ -99,6 +108,38 @@ func GetDestCallbackData(
 	return getCallbackData(packetData, version, packet.GetSourcePort(), ctx.GasMeter().GasRemaining(), maxGas, DestinationCallbackKey)
 }
 
+// ValidateCalldata parses the packet data and returns an error if the source or destination callback data
+// specifies calldata which is not a base64 encoded string. Packet data which cannot be parsed or which does
+// not opt-in to callbacks is not validated.
+func ValidateCalldata(
+	ctx sdk.Context,
+	packetDataUnmarshaler porttypes.PacketDataUnmarshaler,
+	packet channeltypes.Packet,
+) error {
+	packetData, _, err := packetDataUnmarshaler.UnmarshalPacketData(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetData())
+	if err != nil {
+		return nil
+	}
+
+	packetDataProvider, ok := packetData.(ibcexported.PacketDataProvider)
+	if !ok {
+		return nil
+	}
+
+	for _, callbackKey := range []string{SourceCallbackKey, DestinationCallbackKey} {
+		callbackData, ok := packetDataProvider.GetCustomPacketData(callbackKey).(map[string]interface{})
+		if callbackData == nil || !ok {
+			continue
+		}
+
+		if _, err := getCalldata(callbackData); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // getCallbackData parses the packet data and returns the callback data.
 // It also checks that the remaining gas is greater than the gas limit specified in the packet data.
 // The addressGetter and gasLimitGetter functions are used to retrieve the callback
@@ -134,6 +175,12 @@ func getCallbackData(
 		}
 	}
 
+	// get the calldata from the callback data
+	calldata, err := getCalldata(callbackData)
+	if err != nil {
+		return CallbackData{}, err
+	}
+
 	// get the gas limit from the callback data
 	executionGasLimit, commitGasLimit := computeExecAndCommitGasLimit(callbackData, remainingGas, maxGas)
 
@@ -143,6 +190,7 @@ func getCallbackData(
 		SenderAddress:      packetSender,
 		CommitGasLimit:     commitGasLimit,
 		ApplicationVersion: version,
+		Calldata:           calldata,
 	}, nil
 }
 
@@ -206,6 +254,35 @@ func getCallbackAddress(callbackData map[string]interface{}) string {
 	return callbackAddress
 }
 
+// getCalldata returns the calldata if it is specified in the callback data.
+// It is assumed that callback data is not nil.
+// If no calldata is specified, nil is returned. If the calldata is not a base64 encoded string, an error is returned.
+//
+// The memo is expected to specify the calldata in the following format:
+// { "{callbackKey}": { ... , "calldata": {base64EncodedCalldata} }
+func getCalldata(callbackData map[string]interface{}) ([]byte, error) {
+	calldataValue, found := callbackData[CalldataKey]
+	if !found {
+		return nil, nil
+	}
+
+	calldataStr, ok := calldataValue.(string)
+	if !ok {
+		return nil, errorsmod.Wrapf(ErrInvalidCalldata, "expected base64 encoded string, got %T", calldataValue)
+	}
+
+	calldata, err := base64.StdEncoding.DecodeString(calldataStr)
+	if err != nil {
+		return nil, errorsmod.Wrap(ErrInvalidCalldata, err.Error())
+	}
+
+	if len(calldata) == 0 {
+		return nil, nil
+	}
+
+	return calldata, nil
+}
+
 // AllowRetry returns true if the callback execution gas limit is less than the commit gas limit.
 func (c CallbackData) AllowRetry() bool {
 	return c.ExecutionGasLimit < c.CommitGasLimit
@@ -0,0 +1,742 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: ibc/applications/callbacks/v1/callbacks.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	types "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// PendingCallback defines a source callback which ran out of gas during the packet lifecycle and has been stored in
+// the retry queue, together with the callback data needed to re-execute it
+type PendingCallback struct {
+	// the packet for which the callback is pending
+	Packet types.Packet `protobuf:"bytes,1,opt,name=packet,proto3" json:"packet"`
+	// the type of the callback, either acknowledgement_packet or timeout_packet
+	CallbackType string `protobuf:"bytes,2,opt,name=callback_type,json=callbackType,proto3" json:"callback_type,omitempty"`
+	// the acknowledgement bytes of the packet, empty for timeout callbacks
+	Acknowledgement []byte `protobuf:"bytes,3,opt,name=acknowledgement,proto3" json:"acknowledgement,omitempty"`
+	// the address of the relayer which relayed the acknowledgement or timeout of the packet
+	Relayer string `protobuf:"bytes,4,opt,name=relayer,proto3" json:"relayer,omitempty"`
+	// the address of the contract to execute the callback on
+	CallbackAddress string `protobuf:"bytes,5,opt,name=callback_address,json=callbackAddress,proto3" json:"callback_address,omitempty"`
+	// the address of the sender of the packet
+	SenderAddress string `protobuf:"bytes,6,opt,name=sender_address,json=senderAddress,proto3" json:"sender_address,omitempty"`
+	// the version of the underlying application
+	ApplicationVersion string `protobuf:"bytes,7,opt,name=application_version,json=applicationVersion,proto3" json:"application_version,omitempty"`
+	// the opaque calldata provided in the callback packet data
+	Calldata []byte `protobuf:"bytes,8,opt,name=calldata,proto3" json:"calldata,omitempty"`
+	// the number of failed retry attempts of the callback
+	Attempts uint64 `protobuf:"varint,9,opt,name=attempts,proto3" json:"attempts,omitempty"`
+}
+
+func (m *PendingCallback) Reset()         { *m = PendingCallback{} }
+func (m *PendingCallback) String() string { return proto.CompactTextString(m) }
+func (*PendingCallback) ProtoMessage()    {}
+func (*PendingCallback) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b7769659511ffe57, []int{0}
+}
+func (m *PendingCallback) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingCallback) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingCallback.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingCallback) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingCallback.Merge(m, src)
+}
+func (m *PendingCallback) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingCallback) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingCallback.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingCallback proto.InternalMessageInfo
+
+func (m *PendingCallback) GetPacket() types.Packet {
+	if m != nil {
+		return m.Packet
+	}
+	return types.Packet{}
+}
+
+func (m *PendingCallback) GetCallbackType() string {
+	if m != nil {
+		return m.CallbackType
+	}
+	return ""
+}
+
+func (m *PendingCallback) GetAcknowledgement() []byte {
+	if m != nil {
+		return m.Acknowledgement
+	}
+	return nil
+}
+
+func (m *PendingCallback) GetRelayer() string {
+	if m != nil {
+		return m.Relayer
+	}
+	return ""
+}
+
+func (m *PendingCallback) GetCallbackAddress() string {
+	if m != nil {
+		return m.CallbackAddress
+	}
+	return ""
+}
+
+func (m *PendingCallback) GetSenderAddress() string {
+	if m != nil {
+		return m.SenderAddress
+	}
+	return ""
+}
+
+func (m *PendingCallback) GetApplicationVersion() string {
+	if m != nil {
+		return m.ApplicationVersion
+	}
+	return ""
+}
+
+func (m *PendingCallback) GetCalldata() []byte {
+	if m != nil {
+		return m.Calldata
+	}
+	return nil
+}
+
+func (m *PendingCallback) GetAttempts() uint64 {
+	if m != nil {
+		return m.Attempts
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*PendingCallback)(nil), "ibc.applications.callbacks.v1.PendingCallback")
+}
+
+func init() {
+	proto.RegisterFile("ibc/applications/callbacks/v1/callbacks.proto", fileDescriptor_b7769659511ffe57)
+}
+
+var fileDescriptor_b7769659511ffe57 = []byte{
+	// 390 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x92, 0x41, 0x8e, 0xd3, 0x30,
+	0x14, 0x86, 0xe3, 0xa1, 0x74, 0x66, 0xcc, 0x0c, 0x45, 0x86, 0x85, 0x55, 0x44, 0x08, 0x20, 0xa4,
+	0xb0, 0x98, 0x58, 0x05, 0xb1, 0x60, 0xc9, 0x70, 0x00, 0x46, 0x11, 0x62, 0xc1, 0x66, 0xe4, 0x38,
+	0x4f, 0x19, 0xab, 0x89, 0x1d, 0xc5, 0x6e, 0x50, 0x6f, 0xc1, 0xb1, 0xba, 0xec, 0x92, 0x15, 0x42,
+	0xed, 0x05, 0x38, 0x02, 0xb2, 0xd3, 0xa4, 0xd1, 0xec, 0xfc, 0xfe, 0xf7, 0xbd, 0xff, 0xb7, 0xec,
+	0x87, 0xaf, 0x64, 0x26, 0x18, 0xaf, 0xeb, 0x52, 0x0a, 0x6e, 0xa5, 0x56, 0x86, 0x09, 0x5e, 0x96,
+	0x19, 0x17, 0x4b, 0xc3, 0xda, 0xc5, 0xb1, 0x48, 0xea, 0x46, 0x5b, 0x4d, 0x5e, 0xc8, 0x4c, 0x24,
+	0x63, 0x3c, 0x39, 0x12, 0xed, 0x62, 0xfe, 0xac, 0xd0, 0x85, 0xf6, 0x24, 0x73, 0xa7, 0x6e, 0x68,
+	0xfe, 0xca, 0x65, 0x08, 0xdd, 0x00, 0x13, 0x77, 0x5c, 0x29, 0x28, 0xbd, 0x73, 0x77, 0xec, 0x90,
+	0xd7, 0xff, 0x4e, 0xf0, 0xec, 0x06, 0x54, 0x2e, 0x55, 0xf1, 0xe5, 0x60, 0x48, 0x3e, 0xe1, 0x69,
+	0xcd, 0xc5, 0x12, 0x2c, 0x45, 0x11, 0x8a, 0x1f, 0xbd, 0x7f, 0x9e, 0xb8, 0x70, 0xe7, 0x93, 0xf4,
+	0xc3, 0xed, 0x22, 0xb9, 0xf1, 0xc8, 0xf5, 0x64, 0xf3, 0xe7, 0x65, 0x90, 0x1e, 0x06, 0xc8, 0x1b,
+	0x7c, 0xd9, 0xdf, 0xeb, 0xd6, 0xae, 0x6b, 0xa0, 0x27, 0x11, 0x8a, 0xcf, 0xd3, 0x8b, 0x5e, 0xfc,
+	0xb6, 0xae, 0x81, 0xc4, 0x78, 0xc6, 0xc5, 0x52, 0xe9, 0x9f, 0x25, 0xe4, 0x05, 0x54, 0xa0, 0x2c,
+	0x7d, 0x10, 0xa1, 0xf8, 0x22, 0xbd, 0x2f, 0x13, 0x8a, 0x4f, 0x1b, 0x28, 0xf9, 0x1a, 0x1a, 0x3a,
+	0xf1, 0x46, 0x7d, 0x49, 0xde, 0xe1, 0x27, 0x43, 0x10, 0xcf, 0xf3, 0x06, 0x8c, 0xa1, 0x0f, 0x3d,
+	0x32, 0xeb, 0xf5, 0xcf, 0x9d, 0x4c, 0xde, 0xe2, 0xc7, 0x06, 0x54, 0x0e, 0xcd, 0x00, 0x4e, 0x3d,
+	0x78, 0xd9, 0xa9, 0x3d, 0xc6, 0xf0, 0xd3, 0xd1, 0xfb, 0xde, 0xb6, 0xd0, 0x18, 0xa9, 0x15, 0x3d,
+	0xf5, 0x2c, 0x19, 0xb5, 0xbe, 0x77, 0x1d, 0x32, 0xc7, 0x67, 0x2e, 0x2a, 0xe7, 0x96, 0xd3, 0x33,
+	0x7f, 0xff, 0xa1, 0x76, 0x3d, 0x6e, 0x2d, 0x54, 0xb5, 0x35, 0xf4, 0x3c, 0x42, 0xf1, 0x24, 0x1d,
+	0xea, 0xeb, 0xaf, 0x9b, 0x5d, 0x88, 0xb6, 0xbb, 0x10, 0xfd, 0xdd, 0x85, 0xe8, 0xd7, 0x3e, 0x0c,
+	0xb6, 0xfb, 0x30, 0xf8, 0xbd, 0x0f, 0x83, 0x1f, 0x1f, 0x0b, 0x69, 0xef, 0x56, 0x59, 0x22, 0x74,
+	0xc5, 0x84, 0x36, 0x95, 0x36, 0x4c, 0x66, 0xe2, 0xaa, 0xd0, 0xac, 0xd2, 0xf9, 0xaa, 0x04, 0xe3,
+	0x16, 0x66, 0xbc, 0x28, 0xee, 0x89, 0x4d, 0x36, 0xf5, 0x5f, 0xf9, 0xe1, 0xff, 0x00, 0x1c, 0x9f,
+	0x61, 0x65, 0x53, 0x02, 0x00, 0x00,
+}
+
+func (m *PendingCallback) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingCallback) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingCallback) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Attempts != 0 {
+		i = encodeVarintCallbacks(dAtA, i, uint64(m.Attempts))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.Calldata) > 0 {
+		i -= len(m.Calldata)
+		copy(dAtA[i:], m.Calldata)
+		i = encodeVarintCallbacks(dAtA, i, uint64(len(m.Calldata)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.ApplicationVersion) > 0 {
+		i -= len(m.ApplicationVersion)
+		copy(dAtA[i:], m.ApplicationVersion)
+		i = encodeVarintCallbacks(dAtA, i, uint64(len(m.ApplicationVersion)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.SenderAddress) > 0 {
+		i -= len(m.SenderAddress)
+		copy(dAtA[i:], m.SenderAddress)
+		i = encodeVarintCallbacks(dAtA, i, uint64(len(m.SenderAddress)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.CallbackAddress) > 0 {
+		i -= len(m.CallbackAddress)
+		copy(dAtA[i:], m.CallbackAddress)
+		i = encodeVarintCallbacks(dAtA, i, uint64(len(m.CallbackAddress)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Relayer) > 0 {
+		i -= len(m.Relayer)
+		copy(dAtA[i:], m.Relayer)
+		i = encodeVarintCallbacks(dAtA, i, uint64(len(m.Relayer)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Acknowledgement) > 0 {
+		i -= len(m.Acknowledgement)
+		copy(dAtA[i:], m.Acknowledgement)
+		i = encodeVarintCallbacks(dAtA, i, uint64(len(m.Acknowledgement)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.CallbackType) > 0 {
+		i -= len(m.CallbackType)
+		copy(dAtA[i:], m.CallbackType)
+		i = encodeVarintCallbacks(dAtA, i, uint64(len(m.CallbackType)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Packet.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintCallbacks(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintCallbacks(dAtA []byte, offset int, v uint64) int {
+	offset -= sovCallbacks(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *PendingCallback) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Packet.Size()
+	n += 1 + l + sovCallbacks(uint64(l))
+	l = len(m.CallbackType)
+	if l > 0 {
+		n += 1 + l + sovCallbacks(uint64(l))
+	}
+	l = len(m.Acknowledgement)
+	if l > 0 {
+		n += 1 + l + sovCallbacks(uint64(l))
+	}
+	l = len(m.Relayer)
+	if l > 0 {
+		n += 1 + l + sovCallbacks(uint64(l))
+	}
+	l = len(m.CallbackAddress)
+	if l > 0 {
+		n += 1 + l + sovCallbacks(uint64(l))
+	}
+	l = len(m.SenderAddress)
+	if l > 0 {
+		n += 1 + l + sovCallbacks(uint64(l))
+	}
+	l = len(m.ApplicationVersion)
+	if l > 0 {
+		n += 1 + l + sovCallbacks(uint64(l))
+	}
+	l = len(m.Calldata)
+	if l > 0 {
+		n += 1 + l + sovCallbacks(uint64(l))
+	}
+	if m.Attempts != 0 {
+		n += 1 + sovCallbacks(uint64(m.Attempts))
+	}
+	return n
+}
+
+func sovCallbacks(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozCallbacks(x uint64) (n int) {
+	return sovCallbacks(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *PendingCallback) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCallbacks
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingCallback: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingCallback: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Packet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallbacks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCallbacks
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCallbacks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Packet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallbacks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCallbacks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCallbacks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CallbackType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Acknowledgement", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallbacks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCallbacks
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCallbacks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Acknowledgement = append(m.Acknowledgement[:0], dAtA[iNdEx:postIndex]...)
+			if m.Acknowledgement == nil {
+				m.Acknowledgement = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Relayer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallbacks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCallbacks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCallbacks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Relayer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallbacks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCallbacks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCallbacks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CallbackAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SenderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallbacks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCallbacks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCallbacks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SenderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallbacks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCallbacks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCallbacks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApplicationVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Calldata", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallbacks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCallbacks
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCallbacks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Calldata = append(m.Calldata[:0], dAtA[iNdEx:postIndex]...)
+			if m.Calldata == nil {
+				m.Calldata = []byte{}
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempts", wireType)
+			}
+			m.Attempts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallbacks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attempts |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCallbacks(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCallbacks
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipCallbacks(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowCallbacks
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCallbacks
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCallbacks
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthCallbacks
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupCallbacks
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthCallbacks
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthCallbacks        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowCallbacks          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupCallbacks = fmt.Errorf("proto: unexpected end of group")
+)
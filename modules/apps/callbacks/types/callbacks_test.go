@@ -1,6 +1,7 @@
 package types_test
 
 import (
+	"encoding/base64"
 	"fmt"
 
 	storetypes "cosmossdk.io/store/types"
@@ -218,6 +219,106 @@ func (s *CallbacksTypesTestSuite) TestGetCallbackData() {
 			},
 			nil,
 		},
+		{
+			"success: source callback with calldata",
+			func() {
+				remainingGas = 2_000_000
+				version = transfertypes.V1
+				packetData = transfertypes.FungibleTokenPacketData{
+					Denom:    ibctesting.TestCoin.Denom,
+					Amount:   ibctesting.TestCoin.Amount.String(),
+					Sender:   sender,
+					Receiver: receiver,
+					Memo:     fmt.Sprintf(`{"src_callback": {"address": "%s", "calldata": "%s"}}`, sender, base64.StdEncoding.EncodeToString([]byte("calldata"))),
+				}
+			},
+			types.CallbackData{
+				CallbackAddress:    sender,
+				SenderAddress:      sender,
+				ExecutionGasLimit:  1_000_000,
+				CommitGasLimit:     1_000_000,
+				ApplicationVersion: transfertypes.V1,
+				Calldata:           []byte("calldata"),
+			},
+			nil,
+		},
+		{
+			"success: source callback with empty calldata",
+			func() {
+				remainingGas = 2_000_000
+				version = transfertypes.V1
+				packetData = transfertypes.FungibleTokenPacketData{
+					Denom:    ibctesting.TestCoin.Denom,
+					Amount:   ibctesting.TestCoin.Amount.String(),
+					Sender:   sender,
+					Receiver: receiver,
+					Memo:     fmt.Sprintf(`{"src_callback": {"address": "%s", "calldata": ""}}`, sender),
+				}
+			},
+			types.CallbackData{
+				CallbackAddress:    sender,
+				SenderAddress:      sender,
+				ExecutionGasLimit:  1_000_000,
+				CommitGasLimit:     1_000_000,
+				ApplicationVersion: transfertypes.V1,
+			},
+			nil,
+		},
+		{
+			"success: destination callback with calldata",
+			func() {
+				callbackKey = types.DestinationCallbackKey
+
+				remainingGas = 2_000_000
+				version = transfertypes.V1
+				packetData = transfertypes.FungibleTokenPacketData{
+					Denom:    ibctesting.TestCoin.Denom,
+					Amount:   ibctesting.TestCoin.Amount.String(),
+					Sender:   sender,
+					Receiver: receiver,
+					Memo:     fmt.Sprintf(`{"dest_callback": {"address": "%s", "calldata": "%s"}}`, sender, base64.StdEncoding.EncodeToString([]byte("calldata"))),
+				}
+			},
+			types.CallbackData{
+				CallbackAddress:    sender,
+				SenderAddress:      "",
+				ExecutionGasLimit:  1_000_000,
+				CommitGasLimit:     1_000_000,
+				ApplicationVersion: transfertypes.V1,
+				Calldata:           []byte("calldata"),
+			},
+			nil,
+		},
+		{
+			"failure: calldata is not base64 encoded",
+			func() {
+				version = transfertypes.V1
+				packetData = transfertypes.FungibleTokenPacketData{
+					Denom:    ibctesting.TestCoin.Denom,
+					Amount:   ibctesting.TestCoin.Amount.String(),
+					Sender:   sender,
+					Receiver: receiver,
+					Memo:     fmt.Sprintf(`{"src_callback": {"address": "%s", "calldata": "not base64!"}}`, sender),
+				}
+			},
+			types.CallbackData{},
+			types.ErrInvalidCalldata,
+		},
+		{
+			"failure: calldata is not a string",
+			func() {
+				version = transfertypes.V1
+				packetData = transfertypes.FungibleTokenPacketData{
+					Denom:    ibctesting.TestCoin.Denom,
+					Amount:   ibctesting.TestCoin.Amount.String(),
+					Sender:   sender,
+					Receiver: receiver,
+					Memo:     fmt.Sprintf(`{"src_callback": {"address": "%s", "calldata": 100}}`, sender),
+				}
+			},
+			types.CallbackData{},
+			types.ErrInvalidCalldata,
+		},
 		{
 			"failure: packet data does not implement PacketDataProvider",
 			func() {
@@ -746,6 +847,79 @@ func (s *CallbacksTypesTestSuite) TestGetDestCallbackDataTransfer() {
 	}
 }
 
+func (s *CallbacksTypesTestSuite) TestValidateCalldataTransfer() {
+	sender := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+	receiver := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+	calldata := base64.StdEncoding.EncodeToString([]byte("calldata"))
+
+	testCases := []struct {
+		name   string
+		memo   string
+		expErr error
+	}{
+		{
+			"success: no callbacks",
+			"",
+			nil,
+		},
+		{
+			"success: source and destination callbacks with calldata",
+			fmt.Sprintf(`{"src_callback": {"address": "%s", "calldata": "%s"}, "dest_callback": {"address": "%s", "calldata": "%s"}}`, sender, calldata, sender, calldata),
+			nil,
+		},
+		{
+			"failure: source callback calldata is not base64 encoded",
+			fmt.Sprintf(`{"src_callback": {"address": "%s", "calldata": "not base64!"}}`, sender),
+			types.ErrInvalidCalldata,
+		},
+		{
+			"failure: destination callback calldata is not base64 encoded",
+			fmt.Sprintf(`{"src_callback": {"address": "%s"}, "dest_callback": {"address": "%s", "calldata": "not base64!"}}`, sender, sender),
+			types.ErrInvalidCalldata,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			s.path.EndpointA.ChannelConfig.Version = transfertypes.V2
+			s.path.EndpointA.ChannelConfig.PortID = transfertypes.ModuleName
+			s.path.EndpointB.ChannelConfig.Version = transfertypes.V2
+			s.path.EndpointB.ChannelConfig.PortID = transfertypes.ModuleName
+
+			packetData := transfertypes.FungibleTokenPacketDataV2{
+				Tokens: transfertypes.Tokens{
+					{
+						Denom:  transfertypes.NewDenom(ibctesting.TestCoin.Denom),
+						Amount: ibctesting.TestCoin.Amount.String(),
+					},
+				},
+				Sender:   sender,
+				Receiver: receiver,
+				Memo:     tc.memo,
+			}
+
+			transferStack, ok := s.chainA.App.GetIBCKeeper().PortKeeper.Route(transfertypes.ModuleName)
+			s.Require().True(ok)
+
+			packetUnmarshaler, ok := transferStack.(types.CallbacksCompatibleModule)
+			s.Require().True(ok)
+
+			s.path.Setup()
+
+			packet := channeltypes.NewPacket(packetData.GetBytes(), 0, transfertypes.PortID, s.path.EndpointA.ChannelID, transfertypes.PortID, s.path.EndpointB.ChannelID, clienttypes.ZeroHeight(), 0)
+			err := types.ValidateCalldata(s.chainA.GetContext(), packetUnmarshaler, packet)
+			if tc.expErr == nil {
+				s.Require().NoError(err)
+			} else {
+				s.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
 func (s *CallbacksTypesTestSuite) TestGetCallbackAddress() {
 	denom := transfertypes.NewDenom(ibctesting.TestCoin.Denom)
 	amount := ibctesting.TestCoin.Amount.String()
@@ -11,4 +11,7 @@ var (
 	ErrCallbackAddressNotFound   = errorsmod.Register(ModuleName, 5, "callback address not found in packet data")
 	ErrCallbackOutOfGas          = errorsmod.Register(ModuleName, 6, "callback out of gas")
 	ErrCallbackPanic             = errorsmod.Register(ModuleName, 7, "callback panic")
+	ErrInvalidCalldata           = errorsmod.Register(ModuleName, 8, "invalid callback calldata")
+	ErrPendingCallbackNotFound   = errorsmod.Register(ModuleName, 9, "pending callback not found")
+	ErrInvalidPendingCallback    = errorsmod.Register(ModuleName, 10, "invalid pending callback")
 )
@@ -11,6 +11,10 @@ const (
 	EventTypeSourceCallback = "ibc_src_callback"
 	// EventTypeDestinationCallback is the event type for a destination callback
 	EventTypeDestinationCallback = "ibc_dest_callback"
+	// EventTypeCallbackQueued is the event type for a source callback stored in the retry queue
+	EventTypeCallbackQueued = "ibc_callback_queued"
+	// EventTypeCallbackRetry is the event type for the retry of a pending source callback
+	EventTypeCallbackRetry = "ibc_callback_retry"
 
 	// AttributeKeyCallbackType denotes the condition that the callback is executed on:
 	//   "acknowledgement": the callback is executed on the acknowledgement of the packet
@@ -44,6 +48,8 @@ const (
 	AttributeKeyCallbackSequence = "packet_sequence"
 	// AttributeKeyCallbackBaseApplicationVersion denotes the callback base application version
 	AttributeKeyCallbackBaseApplicationVersion = "callback_base_application_version"
+	// AttributeKeyCallbackAttempts denotes the number of failed retry attempts of a pending callback
+	AttributeKeyCallbackAttempts = "callback_attempts"
 	// AttributeValueCallbackSuccess denotes that the callback is successfully executed
 	AttributeValueCallbackSuccess = "success"
 	// AttributeValueCallbackFailure denotes that the callback has failed to execute
@@ -102,3 +108,38 @@ func EmitCallbackEvent(
 		),
 	)
 }
+
+// EmitPendingCallbackEvent emits an event of the given type for a pending source callback. The event type is
+// expected to be either EventTypeCallbackQueued or EventTypeCallbackRetry.
+func EmitPendingCallbackEvent(
+	ctx sdk.Context,
+	eventType string,
+	pendingCallback PendingCallback,
+	err error,
+) {
+	attributes := []sdk.Attribute{
+		sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName),
+		sdk.NewAttribute(AttributeKeyCallbackType, pendingCallback.CallbackType),
+		sdk.NewAttribute(AttributeKeyCallbackAddress, pendingCallback.CallbackAddress),
+		sdk.NewAttribute(AttributeKeyCallbackSourcePortID, pendingCallback.Packet.GetSourcePort()),
+		sdk.NewAttribute(AttributeKeyCallbackSourceChannelID, pendingCallback.Packet.GetSourceChannel()),
+		sdk.NewAttribute(AttributeKeyCallbackSequence, fmt.Sprintf("%d", pendingCallback.Packet.GetSequence())),
+		sdk.NewAttribute(AttributeKeyCallbackAttempts, fmt.Sprintf("%d", pendingCallback.Attempts)),
+	}
+	if err == nil {
+		attributes = append(attributes, sdk.NewAttribute(AttributeKeyCallbackResult, AttributeValueCallbackSuccess))
+	} else {
+		attributes = append(
+			attributes,
+			sdk.NewAttribute(AttributeKeyCallbackError, err.Error()),
+			sdk.NewAttribute(AttributeKeyCallbackResult, AttributeValueCallbackFailure),
+		)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			eventType,
+			attributes...,
+		),
+	)
+}
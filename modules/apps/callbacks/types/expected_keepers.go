@@ -25,6 +25,9 @@ type ContractKeeper interface {
 	//
 	// The version provided is the base application version for the given packet send. This allows
 	// contracts to determine how to unmarshal the packetData.
+	//
+	// The calldata provided is the opaque data specified in the callback data of the packet, and is
+	// nil if no calldata was specified. This allows contracts to determine the intent of the packet.
 	IBCSendPacketCallback(
 		cachedCtx sdk.Context,
 		sourcePort string,
@@ -35,6 +38,7 @@ type ContractKeeper interface {
 		contractAddress,
 		packetSenderAddress string,
 		version string,
+		calldata []byte,
 	) error
 	// IBCOnAcknowledgementPacketCallback is called in the source chain when a packet acknowledgement
 	// is received. The packetSenderAddress is determined by the underlying module, and may be empty if
@@ -50,6 +54,9 @@ type ContractKeeper interface {
 	//
 	// The version provided is the base application version for the given packet send. This allows
 	// contracts to determine how to unmarshal the packetData.
+	//
+	// The calldata provided is the opaque data specified in the callback data of the packet, and is
+	// nil if no calldata was specified. This allows contracts to determine the intent of the packet.
 	IBCOnAcknowledgementPacketCallback(
 		cachedCtx sdk.Context,
 		packet channeltypes.Packet,
@@ -58,6 +65,7 @@ type ContractKeeper interface {
 		contractAddress,
 		packetSenderAddress string,
 		version string,
+		calldata []byte,
 	) error
 	// IBCOnTimeoutPacketCallback is called in the source chain when a packet is not received before
 	// the timeout height. The packetSenderAddress is determined by the underlying module, and may be
@@ -73,6 +81,9 @@ type ContractKeeper interface {
 	//
 	// The version provided is the base application version for the given packet send. This allows
 	// contracts to determine how to unmarshal the packetData.
+	//
+	// The calldata provided is the opaque data specified in the callback data of the packet, and is
+	// nil if no calldata was specified. This allows contracts to determine the intent of the packet.
 	IBCOnTimeoutPacketCallback(
 		cachedCtx sdk.Context,
 		packet channeltypes.Packet,
@@ -80,6 +91,7 @@ type ContractKeeper interface {
 		contractAddress,
 		packetSenderAddress string,
 		version string,
+		calldata []byte,
 	) error
 	// IBCReceivePacketCallback is called in the destination chain when a packet acknowledgement is written.
 	// The contract is expected to handle the callback within the user defined gas limit, and handle any errors,
@@ -89,11 +101,15 @@ type ContractKeeper interface {
 	//
 	// The version provided is the base application version for the given packet send. This allows
 	// contracts to determine how to unmarshal the packetData.
+	//
+	// The calldata provided is the opaque data specified in the callback data of the packet, and is
+	// nil if no calldata was specified. This allows contracts to determine the intent of the packet.
 	IBCReceivePacketCallback(
 		cachedCtx sdk.Context,
 		packet ibcexported.PacketI,
 		ack ibcexported.Acknowledgement,
 		contractAddress string,
 		version string,
+		calldata []byte,
 	) error
 }
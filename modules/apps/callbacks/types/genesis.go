@@ -0,0 +1,38 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// NewGenesisState creates a new ibc-callbacks middleware GenesisState instance.
+func NewGenesisState(pendingCallbacks []PendingCallback) *GenesisState {
+	return &GenesisState{
+		PendingCallbacks: pendingCallbacks,
+	}
+}
+
+// DefaultGenesisState returns a GenesisState with no pending callbacks.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		PendingCallbacks: []PendingCallback{},
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any failure.
+func (gs GenesisState) Validate() error {
+	seenPacketIDs := make(map[string]bool)
+	for _, pendingCallback := range gs.PendingCallbacks {
+		if err := pendingCallback.Validate(); err != nil {
+			return err
+		}
+
+		packetID := pendingCallback.PacketID()
+		key := string(KeyPendingCallback(packetID))
+		if seenPacketIDs[key] {
+			return errorsmod.Wrapf(ErrInvalidPendingCallback, "duplicate pending callback for port: %s, channel: %s, sequence: %d", packetID.PortId, packetID.ChannelId, packetID.Sequence)
+		}
+		seenPacketIDs[key] = true
+	}
+
+	return nil
+}
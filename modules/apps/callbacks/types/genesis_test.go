@@ -0,0 +1,122 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/modules/apps/callbacks/types"
+	clienttypes "github.com/cosmos/ibc-go/v9/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
+	ibcerrors "github.com/cosmos/ibc-go/v9/modules/core/errors"
+	ibctesting "github.com/cosmos/ibc-go/v9/testing"
+	ibcmock "github.com/cosmos/ibc-go/v9/testing/mock"
+)
+
+func TestValidateGenesis(t *testing.T) {
+	var genState *types.GenesisState
+
+	newPendingCallback := func(sequence uint64, callbackType types.CallbackType, ack []byte) types.PendingCallback {
+		packet := channeltypes.NewPacket(
+			ibcmock.MockPacketData, sequence, ibctesting.MockPort, ibctesting.FirstChannelID,
+			ibctesting.MockPort, ibctesting.FirstChannelID, clienttypes.NewHeight(1, 100), 0,
+		)
+
+		return types.PendingCallback{
+			Packet:             packet,
+			CallbackType:       string(callbackType),
+			Acknowledgement:    ack,
+			Relayer:            ibctesting.TestAccAddress,
+			CallbackAddress:    ibctesting.TestAccAddress,
+			SenderAddress:      ibctesting.TestAccAddress,
+			ApplicationVersion: ibcmock.Version,
+		}
+	}
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success - default genesis",
+			func() {
+				genState = types.DefaultGenesisState()
+			},
+			nil,
+		},
+		{
+			"success - valid pending callbacks",
+			func() {},
+			nil,
+		},
+		{
+			"invalid packet",
+			func() {
+				genState.PendingCallbacks[0].Packet.Sequence = 0
+			},
+			channeltypes.ErrInvalidPacket,
+		},
+		{
+			"invalid callback type",
+			func() {
+				genState.PendingCallbacks[0].CallbackType = string(types.CallbackTypeSendPacket)
+			},
+			types.ErrInvalidPendingCallback,
+		},
+		{
+			"empty acknowledgement for acknowledgement callback",
+			func() {
+				genState.PendingCallbacks[0].Acknowledgement = nil
+			},
+			types.ErrInvalidPendingCallback,
+		},
+		{
+			"acknowledgement for timeout callback",
+			func() {
+				genState.PendingCallbacks[1].Acknowledgement = ibcmock.MockAcknowledgement.Acknowledgement()
+			},
+			types.ErrInvalidPendingCallback,
+		},
+		{
+			"invalid relayer address",
+			func() {
+				genState.PendingCallbacks[0].Relayer = "invalid-address"
+			},
+			ibcerrors.ErrInvalidAddress,
+		},
+		{
+			"empty callback address",
+			func() {
+				genState.PendingCallbacks[0].CallbackAddress = ""
+			},
+			types.ErrCallbackAddressNotFound,
+		},
+		{
+			"duplicate pending callbacks",
+			func() {
+				genState.PendingCallbacks[1] = genState.PendingCallbacks[0]
+			},
+			types.ErrInvalidPendingCallback,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		genState = types.NewGenesisState([]types.PendingCallback{
+			newPendingCallback(1, types.CallbackTypeAcknowledgementPacket, ibcmock.MockAcknowledgement.Acknowledgement()),
+			newPendingCallback(2, types.CallbackTypeTimeoutPacket, nil),
+		})
+
+		tc.malleate()
+
+		err := genState.Validate()
+
+		if tc.expErr == nil {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.ErrorIs(t, err, tc.expErr, tc.name)
+		}
+	}
+}
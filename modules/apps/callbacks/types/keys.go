@@ -1,10 +1,23 @@
 package types
 
+import (
+	"fmt"
+
+	channeltypes "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
+)
+
 type CallbackType string
 
 const (
 	ModuleName = "ibccallbacks"
 
+	// StoreKey is the store key string for the ibc-callbacks middleware retry queue. It differs from
+	// the module name, as store keys may not be prefixed by the key of another store such as "ibc".
+	StoreKey = "callbacksibc"
+
+	// PendingCallbackPrefix is the key prefix for source callbacks pending retry
+	PendingCallbackPrefix = "pendingCallback"
+
 	CallbackTypeSendPacket            CallbackType = "send_packet"
 	CallbackTypeAcknowledgementPacket CallbackType = "acknowledgement_packet"
 	CallbackTypeTimeoutPacket         CallbackType = "timeout_packet"
@@ -28,4 +41,13 @@ const (
 	// The expected format for ICS20 and ICS27 memo field is as follows:
 	// { "{callbackKey}": { ... , "gas_limit": {stringForCallback} }
 	UserDefinedGasLimitKey = "gas_limit"
+	// Callbacks' packet data may specify opaque calldata, encoded as a base64 string, under this key.
+	// The expected format for ICS20 and ICS27 memo field is as follows:
+	// { "{callbackKey}": { ... , "calldata": {base64EncodedCalldata} }
+	CalldataKey = "calldata"
 )
+
+// KeyPendingCallback returns the key under which the pending source callback for the given packetID is stored
+func KeyPendingCallback(packetID channeltypes.PacketId) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s/%d", PendingCallbackPrefix, packetID.PortId, packetID.ChannelId, packetID.Sequence))
+}
@@ -0,0 +1,37 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	channeltypes "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
+	ibcerrors "github.com/cosmos/ibc-go/v9/modules/core/errors"
+)
+
+var (
+	_ sdk.Msg              = (*MsgRetryCallback)(nil)
+	_ sdk.HasValidateBasic = (*MsgRetryCallback)(nil)
+)
+
+// NewMsgRetryCallback creates a new instance of MsgRetryCallback
+func NewMsgRetryCallback(packetID channeltypes.PacketId, signer string) *MsgRetryCallback {
+	return &MsgRetryCallback{
+		PacketId: packetID,
+		Signer:   signer,
+	}
+}
+
+// ValidateBasic performs a basic check of the MsgRetryCallback fields
+func (msg MsgRetryCallback) ValidateBasic() error {
+	if err := msg.PacketId.Validate(); err != nil {
+		return err
+	}
+
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	return nil
+}
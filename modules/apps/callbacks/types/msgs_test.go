@@ -0,0 +1,59 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/modules/apps/callbacks/types"
+	channeltypes "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
+	ibcerrors "github.com/cosmos/ibc-go/v9/modules/core/errors"
+	ibctesting "github.com/cosmos/ibc-go/v9/testing"
+)
+
+func TestMsgRetryCallbackValidation(t *testing.T) {
+	var msg *types.MsgRetryCallback
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"invalid packet id",
+			func() {
+				msg.PacketId.Sequence = 0
+			},
+			channeltypes.ErrInvalidPacket,
+		},
+		{
+			"invalid signer address",
+			func() {
+				msg.Signer = "invalid-address"
+			},
+			ibcerrors.ErrInvalidAddress,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		packetID := channeltypes.NewPacketID(ibctesting.MockPort, ibctesting.FirstChannelID, 1)
+		msg = types.NewMsgRetryCallback(packetID, ibctesting.TestAccAddress)
+
+		tc.malleate()
+
+		err := msg.ValidateBasic()
+
+		if tc.expErr == nil {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.ErrorIs(t, err, tc.expErr, tc.name)
+		}
+	}
+}
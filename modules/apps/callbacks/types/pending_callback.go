@@ -0,0 +1,66 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	channeltypes "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
+	ibcerrors "github.com/cosmos/ibc-go/v9/modules/core/errors"
+)
+
+// NewPendingCallback creates a new PendingCallback for a source callback of the given type which failed to execute
+// for the given packet. The acknowledgement is expected to be empty for timeout callbacks.
+func NewPendingCallback(
+	packet channeltypes.Packet,
+	callbackType CallbackType,
+	acknowledgement []byte,
+	relayer sdk.AccAddress,
+	callbackData CallbackData,
+) PendingCallback {
+	return PendingCallback{
+		Packet:             packet,
+		CallbackType:       string(callbackType),
+		Acknowledgement:    acknowledgement,
+		Relayer:            relayer.String(),
+		CallbackAddress:    callbackData.CallbackAddress,
+		SenderAddress:      callbackData.SenderAddress,
+		ApplicationVersion: callbackData.ApplicationVersion,
+		Calldata:           callbackData.Calldata,
+	}
+}
+
+// PacketID returns the unique identifier of the packet for which the callback is pending
+func (pc PendingCallback) PacketID() channeltypes.PacketId {
+	return channeltypes.NewPacketID(pc.Packet.GetSourcePort(), pc.Packet.GetSourceChannel(), pc.Packet.GetSequence())
+}
+
+// Validate performs a stateless check of the PendingCallback fields
+func (pc PendingCallback) Validate() error {
+	if err := pc.Packet.ValidateBasic(); err != nil {
+		return err
+	}
+
+	switch CallbackType(pc.CallbackType) {
+	case CallbackTypeAcknowledgementPacket:
+		if len(pc.Acknowledgement) == 0 {
+			return errorsmod.Wrap(ErrInvalidPendingCallback, "acknowledgement cannot be empty for acknowledgement callbacks")
+		}
+	case CallbackTypeTimeoutPacket:
+		if len(pc.Acknowledgement) != 0 {
+			return errorsmod.Wrap(ErrInvalidPendingCallback, "acknowledgement must be empty for timeout callbacks")
+		}
+	default:
+		return errorsmod.Wrapf(ErrInvalidPendingCallback, "invalid callback type %s, expected %s or %s", pc.CallbackType, CallbackTypeAcknowledgementPacket, CallbackTypeTimeoutPacket)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(pc.Relayer); err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "failed to convert relayer address: %s", err)
+	}
+
+	if pc.CallbackAddress == "" {
+		return errorsmod.Wrap(ErrCallbackAddressNotFound, "callback address cannot be empty")
+	}
+
+	return nil
+}
@@ -0,0 +1,1073 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: ibc/applications/callbacks/v1/query.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	types "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QueryPendingCallbacksRequest defines the request type for the PendingCallbacks rpc
+type QueryPendingCallbacksRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPendingCallbacksRequest) Reset()         { *m = QueryPendingCallbacksRequest{} }
+func (m *QueryPendingCallbacksRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingCallbacksRequest) ProtoMessage()    {}
+func (*QueryPendingCallbacksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8e264909e6193ff2, []int{0}
+}
+func (m *QueryPendingCallbacksRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingCallbacksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingCallbacksRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingCallbacksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingCallbacksRequest.Merge(m, src)
+}
+func (m *QueryPendingCallbacksRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingCallbacksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingCallbacksRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingCallbacksRequest proto.InternalMessageInfo
+
+func (m *QueryPendingCallbacksRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryPendingCallbacksResponse defines the response type for the PendingCallbacks rpc
+type QueryPendingCallbacksResponse struct {
+	// list of source callbacks pending retry
+	PendingCallbacks []PendingCallback `protobuf:"bytes,1,rep,name=pending_callbacks,json=pendingCallbacks,proto3" json:"pending_callbacks"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPendingCallbacksResponse) Reset()         { *m = QueryPendingCallbacksResponse{} }
+func (m *QueryPendingCallbacksResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingCallbacksResponse) ProtoMessage()    {}
+func (*QueryPendingCallbacksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8e264909e6193ff2, []int{1}
+}
+func (m *QueryPendingCallbacksResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingCallbacksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingCallbacksResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingCallbacksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingCallbacksResponse.Merge(m, src)
+}
+func (m *QueryPendingCallbacksResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingCallbacksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingCallbacksResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingCallbacksResponse proto.InternalMessageInfo
+
+func (m *QueryPendingCallbacksResponse) GetPendingCallbacks() []PendingCallback {
+	if m != nil {
+		return m.PendingCallbacks
+	}
+	return nil
+}
+
+func (m *QueryPendingCallbacksResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryPendingCallbackRequest defines the request type for the PendingCallback rpc
+type QueryPendingCallbackRequest struct {
+	// unique packet identifier comprised of the source port ID, source channel ID and sequence
+	PacketId types.PacketId `protobuf:"bytes,1,opt,name=packet_id,json=packetId,proto3" json:"packet_id"`
+}
+
+func (m *QueryPendingCallbackRequest) Reset()         { *m = QueryPendingCallbackRequest{} }
+func (m *QueryPendingCallbackRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingCallbackRequest) ProtoMessage()    {}
+func (*QueryPendingCallbackRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8e264909e6193ff2, []int{2}
+}
+func (m *QueryPendingCallbackRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingCallbackRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingCallbackRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingCallbackRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingCallbackRequest.Merge(m, src)
+}
+func (m *QueryPendingCallbackRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingCallbackRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingCallbackRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingCallbackRequest proto.InternalMessageInfo
+
+func (m *QueryPendingCallbackRequest) GetPacketId() types.PacketId {
+	if m != nil {
+		return m.PacketId
+	}
+	return types.PacketId{}
+}
+
+// QueryPendingCallbackResponse defines the response type for the PendingCallback rpc
+type QueryPendingCallbackResponse struct {
+	// the pending source callback
+	PendingCallback PendingCallback `protobuf:"bytes,1,opt,name=pending_callback,json=pendingCallback,proto3" json:"pending_callback"`
+}
+
+func (m *QueryPendingCallbackResponse) Reset()         { *m = QueryPendingCallbackResponse{} }
+func (m *QueryPendingCallbackResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingCallbackResponse) ProtoMessage()    {}
+func (*QueryPendingCallbackResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8e264909e6193ff2, []int{3}
+}
+func (m *QueryPendingCallbackResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingCallbackResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingCallbackResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingCallbackResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingCallbackResponse.Merge(m, src)
+}
+func (m *QueryPendingCallbackResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingCallbackResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingCallbackResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingCallbackResponse proto.InternalMessageInfo
+
+func (m *QueryPendingCallbackResponse) GetPendingCallback() PendingCallback {
+	if m != nil {
+		return m.PendingCallback
+	}
+	return PendingCallback{}
+}
+
+func init() {
+	proto.RegisterType((*QueryPendingCallbacksRequest)(nil), "ibc.applications.callbacks.v1.QueryPendingCallbacksRequest")
+	proto.RegisterType((*QueryPendingCallbacksResponse)(nil), "ibc.applications.callbacks.v1.QueryPendingCallbacksResponse")
+	proto.RegisterType((*QueryPendingCallbackRequest)(nil), "ibc.applications.callbacks.v1.QueryPendingCallbackRequest")
+	proto.RegisterType((*QueryPendingCallbackResponse)(nil), "ibc.applications.callbacks.v1.QueryPendingCallbackResponse")
+}
+
+func init() {
+	proto.RegisterFile("ibc/applications/callbacks/v1/query.proto", fileDescriptor_8e264909e6193ff2)
+}
+
+var fileDescriptor_8e264909e6193ff2 = []byte{
+	// 533 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0xb1, 0x6f, 0x13, 0x31,
+	0x14, 0xc6, 0xe3, 0x14, 0x10, 0xb8, 0x43, 0x83, 0xc5, 0x50, 0x85, 0xe6, 0x28, 0x19, 0x20, 0x54,
+	0xaa, 0x4d, 0x82, 0x58, 0x28, 0x03, 0x2a, 0x12, 0x88, 0x89, 0x92, 0x91, 0x25, 0xf2, 0xf9, 0xcc,
+	0xd5, 0xea, 0xc5, 0x76, 0x63, 0x27, 0x52, 0x85, 0x2a, 0x10, 0x6c, 0x4c, 0x48, 0x0c, 0xfc, 0x39,
+	0xac, 0x95, 0x58, 0x2a, 0xb1, 0x30, 0x21, 0x94, 0xf0, 0x87, 0xa0, 0xb3, 0x7d, 0x69, 0x73, 0x6d,
+	0x53, 0x29, 0x5b, 0x72, 0xef, 0x7b, 0x7e, 0xbf, 0xef, 0xf3, 0xbb, 0x83, 0x0f, 0x44, 0xcc, 0x08,
+	0xd5, 0x3a, 0x13, 0x8c, 0x5a, 0xa1, 0xa4, 0x21, 0x8c, 0x66, 0x59, 0x4c, 0xd9, 0x9e, 0x21, 0xa3,
+	0x36, 0xd9, 0x1f, 0xf2, 0xc1, 0x01, 0xd6, 0x03, 0x65, 0x15, 0x6a, 0x88, 0x98, 0xe1, 0xd3, 0x52,
+	0x3c, 0x95, 0xe2, 0x51, 0xbb, 0x7e, 0x2b, 0x55, 0xa9, 0x72, 0x4a, 0x92, 0xff, 0xf2, 0x4d, 0xf5,
+	0xb5, 0x54, 0xa9, 0x34, 0xe3, 0x84, 0x6a, 0x41, 0xa8, 0x94, 0xca, 0x86, 0x56, 0x5f, 0xdd, 0x60,
+	0xca, 0xf4, 0x95, 0x21, 0x31, 0x35, 0xdc, 0xcf, 0x22, 0xa3, 0x76, 0xcc, 0x2d, 0x6d, 0x13, 0x4d,
+	0x53, 0x21, 0x9d, 0x38, 0x68, 0x37, 0xe7, 0x93, 0x9e, 0xb0, 0x78, 0xf9, 0xdd, 0x5c, 0xce, 0xd4,
+	0x80, 0x13, 0xb6, 0x4b, 0xa5, 0xe4, 0x99, 0x13, 0xf9, 0x9f, 0x5e, 0xd2, 0x7c, 0x07, 0xd7, 0xde,
+	0xe4, 0x33, 0x77, 0xb8, 0x4c, 0x84, 0x4c, 0x9f, 0x17, 0x27, 0x74, 0xf9, 0xfe, 0x90, 0x1b, 0x8b,
+	0x5e, 0x40, 0x78, 0x42, 0xb1, 0x0a, 0xd6, 0x41, 0x6b, 0xb9, 0x73, 0x0f, 0x7b, 0x64, 0x9c, 0x23,
+	0x63, 0x1f, 0x4f, 0x40, 0xc6, 0x3b, 0x34, 0xe5, 0xa1, 0xb7, 0x7b, 0xaa, 0xb3, 0xf9, 0x13, 0xc0,
+	0xc6, 0x05, 0x83, 0x8c, 0x56, 0xd2, 0x70, 0x44, 0xe1, 0x4d, 0xed, 0x6b, 0xbd, 0xa9, 0x8f, 0x55,
+	0xb0, 0xbe, 0xd4, 0x5a, 0xee, 0x60, 0x3c, 0x37, 0x76, 0x5c, 0x3a, 0x73, 0xfb, 0xca, 0xd1, 0x9f,
+	0x3b, 0x95, 0x6e, 0x4d, 0x97, 0x46, 0xa1, 0x97, 0x33, 0x66, 0xaa, 0xce, 0xcc, 0xfd, 0x4b, 0xcd,
+	0x78, 0xbe, 0x19, 0x37, 0x3d, 0x78, 0xfb, 0x3c, 0x33, 0x45, 0x68, 0xcf, 0xe0, 0x0d, 0x4d, 0xd9,
+	0x1e, 0xb7, 0x3d, 0x91, 0x84, 0xcc, 0x1a, 0xce, 0x42, 0x7e, 0x17, 0xb8, 0xb8, 0x80, 0x1c, 0xdc,
+	0xa9, 0x5e, 0x25, 0x81, 0xf8, 0xba, 0x0e, 0xff, 0x9b, 0x1f, 0xce, 0xbf, 0x96, 0x69, 0x58, 0x3d,
+	0x58, 0x2b, 0x87, 0x15, 0x06, 0x2d, 0x96, 0xd5, 0x4a, 0x29, 0xab, 0xce, 0x64, 0x09, 0x5e, 0x75,
+	0x04, 0xe8, 0x07, 0x80, 0xb5, 0xf2, 0xa5, 0xa1, 0xad, 0x4b, 0xa6, 0xcc, 0xdb, 0xa9, 0xfa, 0xd3,
+	0xc5, 0x9a, 0xbd, 0xf5, 0xe6, 0xc3, 0x4f, 0xbf, 0xfe, 0x7d, 0xab, 0x6e, 0xa0, 0x16, 0x09, 0x2f,
+	0x43, 0xe9, 0x25, 0x38, 0xb3, 0x44, 0xe8, 0x7b, 0x15, 0xae, 0x94, 0x8e, 0x43, 0x4f, 0x16, 0x60,
+	0x28, 0xf8, 0xb7, 0x16, 0xea, 0x0d, 0xf8, 0x5f, 0x80, 0xe3, 0xff, 0x0c, 0xd0, 0x47, 0x70, 0x81,
+	0x83, 0xb0, 0x20, 0x86, 0xbc, 0x9f, 0x6e, 0x51, 0xb1, 0x34, 0x3d, 0x91, 0x1c, 0x12, 0xad, 0x06,
+	0x76, 0xa6, 0x98, 0x3f, 0x70, 0x15, 0x93, 0x13, 0x4a, 0xc6, 0x67, 0xaa, 0xc5, 0xc3, 0xc3, 0x33,
+	0xd1, 0x6c, 0xbf, 0x3e, 0x1a, 0x47, 0xe0, 0x78, 0x1c, 0x81, 0xbf, 0xe3, 0x08, 0x7c, 0x9d, 0x44,
+	0x95, 0xe3, 0x49, 0x54, 0xf9, 0x3d, 0x89, 0x2a, 0x6f, 0x1f, 0xa7, 0xc2, 0xee, 0x0e, 0x63, 0xcc,
+	0x54, 0x9f, 0x84, 0x0f, 0x94, 0x88, 0xd9, 0x66, 0xaa, 0x48, 0x5f, 0x25, 0xc3, 0x8c, 0x9b, 0x32,
+	0xb7, 0x3d, 0xd0, 0xdc, 0xc4, 0xd7, 0xdc, 0x57, 0xe5, 0xd1, 0xff, 0x01, 0x00, 0xf5, 0x17, 0x1d,
+	0xe2, 0x53, 0x05, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// PendingCallbacks returns all source callbacks pending retry
+	PendingCallbacks(ctx context.Context, in *QueryPendingCallbacksRequest, opts ...grpc.CallOption) (*QueryPendingCallbacksResponse, error)
+	// PendingCallback returns the pending source callback for a packet given its identifier
+	PendingCallback(ctx context.Context, in *QueryPendingCallbackRequest, opts ...grpc.CallOption) (*QueryPendingCallbackResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) PendingCallbacks(ctx context.Context, in *QueryPendingCallbacksRequest, opts ...grpc.CallOption) (*QueryPendingCallbacksResponse, error) {
+	out := new(QueryPendingCallbacksResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.callbacks.v1.Query/PendingCallbacks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PendingCallback(ctx context.Context, in *QueryPendingCallbackRequest, opts ...grpc.CallOption) (*QueryPendingCallbackResponse, error) {
+	out := new(QueryPendingCallbackResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.callbacks.v1.Query/PendingCallback", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// PendingCallbacks returns all source callbacks pending retry
+	PendingCallbacks(context.Context, *QueryPendingCallbacksRequest) (*QueryPendingCallbacksResponse, error)
+	// PendingCallback returns the pending source callback for a packet given its identifier
+	PendingCallback(context.Context, *QueryPendingCallbackRequest) (*QueryPendingCallbackResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) PendingCallbacks(ctx context.Context, req *QueryPendingCallbacksRequest) (*QueryPendingCallbacksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingCallbacks not implemented")
+}
+func (*UnimplementedQueryServer) PendingCallback(ctx context.Context, req *QueryPendingCallbackRequest) (*QueryPendingCallbackResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingCallback not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_PendingCallbacks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingCallbacksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingCallbacks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.callbacks.v1.Query/PendingCallbacks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingCallbacks(ctx, req.(*QueryPendingCallbacksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingCallback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingCallbackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingCallback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.callbacks.v1.Query/PendingCallback",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingCallback(ctx, req.(*QueryPendingCallbackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ibc.applications.callbacks.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "PendingCallbacks",
+			Handler:    _Query_PendingCallbacks_Handler,
+		},
+		{
+			MethodName: "PendingCallback",
+			Handler:    _Query_PendingCallback_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "ibc/applications/callbacks/v1/query.proto",
+}
+
+func (m *QueryPendingCallbacksRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingCallbacksRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingCallbacksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingCallbacksResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingCallbacksResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingCallbacksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PendingCallbacks) > 0 {
+		for iNdEx := len(m.PendingCallbacks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingCallbacks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingCallbackRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingCallbackRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingCallbackRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.PacketId.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingCallbackResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingCallbackResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingCallbackResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.PendingCallback.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryPendingCallbacksRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingCallbacksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PendingCallbacks) > 0 {
+		for _, e := range m.PendingCallbacks {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingCallbackRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PacketId.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPendingCallbackResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PendingCallback.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryPendingCallbacksRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingCallbacksRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingCallbacksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingCallbacksResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingCallbacksResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingCallbacksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingCallbacks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingCallbacks = append(m.PendingCallbacks, PendingCallback{})
+			if err := m.PendingCallbacks[len(m.PendingCallbacks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingCallbackRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingCallbackRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingCallbackRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PacketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingCallbackResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingCallbackResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingCallbackResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingCallback", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PendingCallback.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
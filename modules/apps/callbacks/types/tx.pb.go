@@ -0,0 +1,631 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: ibc/applications/callbacks/v1/tx.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	types "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MsgRetryCallback defines the request type for the RetryCallback rpc
+type MsgRetryCallback struct {
+	// unique packet identifier comprised of the source port ID, source channel ID and sequence
+	PacketId types.PacketId `protobuf:"bytes,1,opt,name=packet_id,json=packetId,proto3" json:"packet_id"`
+	// the signer address
+	Signer string `protobuf:"bytes,2,opt,name=signer,proto3" json:"signer,omitempty"`
+}
+
+func (m *MsgRetryCallback) Reset()         { *m = MsgRetryCallback{} }
+func (m *MsgRetryCallback) String() string { return proto.CompactTextString(m) }
+func (*MsgRetryCallback) ProtoMessage()    {}
+func (*MsgRetryCallback) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6601d38521d2091e, []int{0}
+}
+func (m *MsgRetryCallback) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRetryCallback) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRetryCallback.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRetryCallback) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRetryCallback.Merge(m, src)
+}
+func (m *MsgRetryCallback) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRetryCallback) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRetryCallback.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRetryCallback proto.InternalMessageInfo
+
+// MsgRetryCallbackResponse defines the response type for the RetryCallback rpc
+type MsgRetryCallbackResponse struct {
+	// true if the callback executed successfully and has been removed from the retry queue
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+}
+
+func (m *MsgRetryCallbackResponse) Reset()         { *m = MsgRetryCallbackResponse{} }
+func (m *MsgRetryCallbackResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRetryCallbackResponse) ProtoMessage()    {}
+func (*MsgRetryCallbackResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6601d38521d2091e, []int{1}
+}
+func (m *MsgRetryCallbackResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRetryCallbackResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRetryCallbackResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRetryCallbackResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRetryCallbackResponse.Merge(m, src)
+}
+func (m *MsgRetryCallbackResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRetryCallbackResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRetryCallbackResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRetryCallbackResponse proto.InternalMessageInfo
+
+func (m *MsgRetryCallbackResponse) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*MsgRetryCallback)(nil), "ibc.applications.callbacks.v1.MsgRetryCallback")
+	proto.RegisterType((*MsgRetryCallbackResponse)(nil), "ibc.applications.callbacks.v1.MsgRetryCallbackResponse")
+}
+
+func init() {
+	proto.RegisterFile("ibc/applications/callbacks/v1/tx.proto", fileDescriptor_6601d38521d2091e)
+}
+
+var fileDescriptor_6601d38521d2091e = []byte{
+	// 389 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x52, 0xcd, 0x6a, 0xdb, 0x40,
+	0x10, 0xd6, 0xf6, 0xc7, 0xb5, 0xb7, 0x14, 0x5a, 0x51, 0x5a, 0xa1, 0x62, 0xd9, 0xf5, 0xa1, 0x18,
+	0x83, 0x77, 0xb1, 0xdb, 0x52, 0xe8, 0x29, 0x38, 0xa7, 0x1c, 0x4c, 0x82, 0x8e, 0xb9, 0x04, 0x69,
+	0xb5, 0xac, 0x17, 0x4b, 0x5a, 0xa1, 0x91, 0x4d, 0x7c, 0x0b, 0xb9, 0x24, 0xe4, 0x94, 0x17, 0x08,
+	0xe4, 0x11, 0xfc, 0x18, 0x3e, 0xfa, 0x98, 0x53, 0x08, 0xf6, 0xc1, 0xaf, 0x11, 0x24, 0xaf, 0x83,
+	0xe3, 0x43, 0x20, 0x97, 0x65, 0xe6, 0x9b, 0x6f, 0xf6, 0xfb, 0x76, 0x67, 0xf0, 0x2f, 0xe9, 0x33,
+	0xea, 0x25, 0x49, 0x28, 0x99, 0x97, 0x49, 0x15, 0x03, 0x65, 0x5e, 0x18, 0xfa, 0x1e, 0x1b, 0x02,
+	0x1d, 0x77, 0x68, 0x76, 0x4a, 0x92, 0x54, 0x65, 0xca, 0xac, 0x4a, 0x9f, 0x91, 0x6d, 0x1e, 0x79,
+	0xe2, 0x91, 0x71, 0xc7, 0xfe, 0xe2, 0x45, 0x32, 0x56, 0xb4, 0x38, 0xd7, 0x1d, 0xf6, 0x57, 0xa1,
+	0x84, 0x2a, 0x42, 0x9a, 0x47, 0x1a, 0xfd, 0x99, 0xeb, 0x31, 0x95, 0x72, 0xca, 0x06, 0x5e, 0x1c,
+	0xf3, 0x30, 0x57, 0xd1, 0xa1, 0xa6, 0x7c, 0x67, 0x0a, 0x22, 0x05, 0x34, 0x02, 0x91, 0x17, 0x23,
+	0x10, 0xeb, 0x42, 0xe3, 0x06, 0xe1, 0xcf, 0x7d, 0x10, 0x2e, 0xcf, 0xd2, 0xc9, 0xbe, 0x56, 0x37,
+	0xf7, 0x70, 0x25, 0xf1, 0xd8, 0x90, 0x67, 0x27, 0x32, 0xb0, 0x50, 0x1d, 0x35, 0x3f, 0x76, 0xab,
+	0x24, 0x37, 0x9b, 0x8b, 0x90, 0xcd, 0xcd, 0xe3, 0x0e, 0x39, 0x2a, 0x58, 0x07, 0x41, 0xef, 0xdd,
+	0xec, 0xbe, 0x66, 0xb8, 0xe5, 0x44, 0xe7, 0xe6, 0x37, 0x5c, 0x02, 0x29, 0x62, 0x9e, 0x5a, 0x6f,
+	0xea, 0xa8, 0x59, 0x71, 0x75, 0xf6, 0x9f, 0x5e, 0xde, 0xd6, 0x8c, 0xf3, 0xd5, 0xb4, 0xa5, 0x81,
+	0xab, 0xd5, 0xb4, 0xf5, 0x63, 0xed, 0xad, 0x0d, 0xc1, 0x90, 0xee, 0x5a, 0x69, 0xfc, 0xc1, 0xd6,
+	0x2e, 0xe6, 0x72, 0x48, 0x54, 0x0c, 0xdc, 0xb4, 0xf0, 0x07, 0x18, 0x31, 0xc6, 0x01, 0x0a, 0x93,
+	0x65, 0x77, 0x93, 0x76, 0x2f, 0x10, 0x7e, 0xdb, 0x07, 0x61, 0x4e, 0xf0, 0xa7, 0xe7, 0x2f, 0xa3,
+	0xe4, 0xc5, 0x3f, 0x27, 0xbb, 0x5a, 0xf6, 0xbf, 0x57, 0x36, 0x6c, 0xcc, 0xd9, 0xef, 0xcf, 0x56,
+	0xd3, 0x16, 0xea, 0x1d, 0xce, 0x16, 0x0e, 0x9a, 0x2f, 0x1c, 0xf4, 0xb0, 0x70, 0xd0, 0xf5, 0xd2,
+	0x31, 0xe6, 0x4b, 0xc7, 0xb8, 0x5b, 0x3a, 0xc6, 0xf1, 0x5f, 0x21, 0xb3, 0xc1, 0xc8, 0x27, 0x4c,
+	0x45, 0x54, 0x4f, 0x47, 0xfa, 0xac, 0x2d, 0x14, 0x8d, 0x54, 0x30, 0x0a, 0x39, 0xe4, 0x2b, 0xb4,
+	0xbd, 0x3a, 0xd9, 0x24, 0xe1, 0xe0, 0x97, 0x8a, 0xb9, 0xfd, 0x7e, 0x1c, 0x00, 0x14, 0x86, 0x16,
+	0x29, 0x65, 0x02, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// RetryCallback defines a rpc handler method for MsgRetryCallback
+	// RetryCallback may be called by any account to re-execute a pending source callback, providing the gas for its
+	// execution
+	RetryCallback(ctx context.Context, in *MsgRetryCallback, opts ...grpc.CallOption) (*MsgRetryCallbackResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) RetryCallback(ctx context.Context, in *MsgRetryCallback, opts ...grpc.CallOption) (*MsgRetryCallbackResponse, error) {
+	out := new(MsgRetryCallbackResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.callbacks.v1.Msg/RetryCallback", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// RetryCallback defines a rpc handler method for MsgRetryCallback
+	// RetryCallback may be called by any account to re-execute a pending source callback, providing the gas for its
+	// execution
+	RetryCallback(context.Context, *MsgRetryCallback) (*MsgRetryCallbackResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) RetryCallback(ctx context.Context, req *MsgRetryCallback) (*MsgRetryCallbackResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetryCallback not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_RetryCallback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRetryCallback)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RetryCallback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.callbacks.v1.Msg/RetryCallback",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RetryCallback(ctx, req.(*MsgRetryCallback))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ibc.applications.callbacks.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RetryCallback",
+			Handler:    _Msg_RetryCallback_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "ibc/applications/callbacks/v1/tx.proto",
+}
+
+func (m *MsgRetryCallback) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRetryCallback) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRetryCallback) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.PacketId.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRetryCallbackResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRetryCallbackResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRetryCallbackResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Success {
+		i--
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgRetryCallback) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PacketId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRetryCallbackResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Success {
+		n += 2
+	}
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgRetryCallback) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRetryCallback: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRetryCallback: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PacketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRetryCallbackResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRetryCallbackResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRetryCallbackResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Success = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTx(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTx
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTx
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTx
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTx        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTx          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTx = fmt.Errorf("proto: unexpected end of group")
+)
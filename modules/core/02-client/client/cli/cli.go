@@ -49,6 +49,7 @@ func NewTxCmd() *cobra.Command {
 		newSubmitMisbehaviourCmd(), // Deprecated
 		newUpgradeClientCmd(),
 		newSubmitRecoverClientProposalCmd(),
+		newSubmitMigrateClientTypeProposalCmd(),
 		newScheduleIBCUpgradeProposalCmd(),
 	)
 
@@ -302,6 +302,64 @@ func newSubmitRecoverClientProposalCmd() *cobra.Command {
 	return cmd
 }
 
+// newSubmitMigrateClientTypeProposalCmd defines the command to migrate an IBC light client to a different client type.
+func newSubmitMigrateClientTypeProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate-client-type [subject-client-id] [substitute-client-id] [flags]",
+		Args:  cobra.ExactArgs(2),
+		Short: "migrate an IBC client to a different client type",
+		Long: `Submit a migrate IBC client type proposal along with an initial deposit
+		Please specify a subject client identifier you want to migrate
+		Please specify the substitute client of a different client type the subject client will be replaced with.
+		The subject client identifier is preserved, so existing connections and channels remain usable.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposal, err := govcli.ReadGovPropFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			subjectClientID, substituteClientID := args[0], args[1]
+
+			authority, _ := cmd.Flags().GetString(FlagAuthority)
+			if authority != "" {
+				if _, err = sdk.AccAddressFromBech32(authority); err != nil {
+					return fmt.Errorf("invalid authority address: %w", err)
+				}
+			} else {
+				authority = sdk.AccAddress(address.Module(govtypes.ModuleName)).String()
+			}
+
+			msg := types.NewMsgMigrateClientType(authority, subjectClientID, substituteClientID)
+
+			if err = msg.ValidateBasic(); err != nil {
+				return fmt.Errorf("error validating %T: %w", types.MsgMigrateClientType{}, err)
+			}
+
+			if err := proposal.SetMsgs([]sdk.Msg{msg}); err != nil {
+				return fmt.Errorf("failed to create migrate client type proposal message: %w", err)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), proposal)
+		},
+	}
+
+	cmd.Flags().String(FlagAuthority, "", "The address of the client module authority (defaults to gov)")
+
+	flags.AddTxFlagsToCmd(cmd)
+	govcli.AddGovPropFlagsToCmd(cmd)
+	err := cmd.MarkFlagRequired(govcli.FlagTitle)
+	if err != nil {
+		panic(err)
+	}
+
+	return cmd
+}
+
 // newScheduleIBCUpgradeProposalCmd defines the command for submitting an IBC software upgrade proposal.
 func newScheduleIBCUpgradeProposalCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -19,6 +19,10 @@ func InitGenesis(ctx sdk.Context, k *keeper.Keeper, gs types.GenesisState) {
 	}
 	k.SetParams(ctx, gs.Params)
 
+	for _, migratedClientType := range gs.MigratedClientTypes {
+		k.SetMigratedClientType(ctx, migratedClientType.ClientId, migratedClientType.ClientType)
+	}
+
 	// Set all client metadata first. This will allow client keeper to overwrite client and consensus state keys
 	// if clients accidentally write to ClientKeeper reserved keys.
 	if len(gs.ClientsMetadata) != 0 {
@@ -67,7 +71,8 @@ func ExportGenesis(ctx sdk.Context, k *keeper.Keeper) types.GenesisState {
 		ClientsConsensus: k.GetAllConsensusStates(ctx),
 		Params:           k.GetParams(ctx),
 		// Warning: CreateLocalhost is deprecated
-		CreateLocalhost:    false,
-		NextClientSequence: k.GetNextClientSequence(ctx),
+		CreateLocalhost:     false,
+		NextClientSequence:  k.GetNextClientSequence(ctx),
+		MigratedClientTypes: k.GetAllMigratedClientTypes(ctx),
 	}
 }
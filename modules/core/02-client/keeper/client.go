@@ -1,7 +1,11 @@
 package keeper
 
 import (
+	"math"
+	"math/bits"
+
 	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -55,6 +59,8 @@ func (k *Keeper) UpdateClient(ctx sdk.Context, clientID string, clientMsg export
 		return errorsmod.Wrapf(types.ErrClientNotActive, "cannot update client (%s) with status %s", clientID, status)
 	}
 
+	gasConsumed := ctx.GasMeter().GasConsumed()
+
 	if err := clientModule.VerifyClientMessage(ctx, clientID, clientMsg); err != nil {
 		return err
 	}
@@ -65,24 +71,110 @@ func (k *Keeper) UpdateClient(ctx sdk.Context, clientID string, clientMsg export
 
 		k.Logger(ctx).Info("client frozen due to misbehaviour", "client-id", clientID)
 
-		clientType := types.MustParseClientIdentifier(clientID)
+		clientType := k.mustGetClientType(ctx, clientID)
 		defer telemetry.ReportUpdateClient(foundMisbehaviour, clientType, clientID)
 		emitSubmitMisbehaviourEvent(ctx, clientID, clientType)
 
 		return nil
 	}
 
+	if err := k.ApplyUpdateRateLimit(ctx, clientID); err != nil {
+		return err
+	}
+
 	consensusHeights := clientModule.UpdateState(ctx, clientID, clientMsg)
 
 	k.Logger(ctx).Info("client state updated", "client-id", clientID, "heights", consensusHeights)
 
-	clientType := types.MustParseClientIdentifier(clientID)
+	clientType := k.mustGetClientType(ctx, clientID)
+	k.ConsumeUpdateGas(ctx, clientType, ctx.GasMeter().GasConsumed()-gasConsumed)
+
 	defer telemetry.ReportUpdateClient(foundMisbehaviour, clientType, clientID)
 	emitUpdateClientEvent(ctx, clientID, clientType, consensusHeights, k.cdc, clientMsg)
 
 	return nil
 }
 
+// ApplyUpdateRateLimit returns an error if the minimum block or time interval between client updates configured
+// in the module params has not elapsed since the last accepted update of the given client. Otherwise, the update
+// is recorded as the last accepted update of the client. Clients marked as exempt in the context using
+// types.WithUpdateRateLimitExemptions are never rate limited. Callers are responsible for not applying the rate
+// limit to client messages submitting misbehaviour.
+//
+// During CheckTx the context holds the header of the latest committed block. The height of the block the update
+// is expected to be included in is therefore used, and the time interval is only enforced during execution as
+// the time of that block is not yet known.
+func (k *Keeper) ApplyUpdateRateLimit(ctx sdk.Context, clientID string) error {
+	if !k.GetParams(ctx).IsUpdateRateLimitEnabled() {
+		return nil
+	}
+
+	if err := k.CheckUpdateRateLimit(ctx, clientID); err != nil {
+		return err
+	}
+
+	height, timestamp := updateHeightAndTimestamp(ctx)
+	k.SetClientLastUpdate(ctx, clientID, height, timestamp)
+
+	return nil
+}
+
+// CheckUpdateRateLimit returns an error if an update of the given client would be rejected by the client update
+// rate limit in the provided context. Unlike ApplyUpdateRateLimit, the update is not recorded.
+func (k *Keeper) CheckUpdateRateLimit(ctx sdk.Context, clientID string) error {
+	params := k.GetParams(ctx)
+	if !params.IsUpdateRateLimitEnabled() || types.IsUpdateRateLimitExempt(ctx, clientID) {
+		return nil
+	}
+
+	lastHeight, lastTimestamp, found := k.GetClientLastUpdate(ctx, clientID)
+	if !found {
+		return nil
+	}
+
+	height, timestamp := updateHeightAndTimestamp(ctx)
+	if height-lastHeight < params.MinUpdateBlockInterval {
+		return errorsmod.Wrapf(types.ErrClientUpdateRateLimited, "client (%s) was last updated at block height %d, minimum block interval between updates is %d", clientID, lastHeight, params.MinUpdateBlockInterval)
+	}
+
+	if !ctx.IsCheckTx() && timestamp-lastTimestamp < params.MinUpdateTimeInterval {
+		return errorsmod.Wrapf(types.ErrClientUpdateRateLimited, "client (%s) was last updated at block time %d, minimum time interval between updates is %d", clientID, lastTimestamp, params.MinUpdateTimeInterval)
+	}
+
+	return nil
+}
+
+// updateHeightAndTimestamp returns the block height and time at which a client update in the provided context is
+// accepted. During CheckTx the height of the block the update is expected to be included in is returned.
+func updateHeightAndTimestamp(ctx sdk.Context) (uint64, uint64) {
+	height := uint64(ctx.BlockHeight())
+	if ctx.IsCheckTx() {
+		height++
+	}
+
+	return height, uint64(ctx.BlockTime().UnixNano())
+}
+
+// ConsumeUpdateGas consumes additional gas for an update of a client of the given client type according to the
+// gas multiplier configured in the module params. The provided gasUsed is the gas consumed while processing the
+// client update, of which the configured fraction in excess of one is consumed again.
+func (k *Keeper) ConsumeUpdateGas(ctx sdk.Context, clientType string, gasUsed storetypes.Gas) {
+	multiplier, found := k.GetParams(ctx).GetUpdateGasMultiplier(clientType)
+	if !found || multiplier.Numerator == multiplier.Denominator {
+		return
+	}
+
+	hi, lo := bits.Mul64(gasUsed, multiplier.Numerator-multiplier.Denominator)
+	if hi >= multiplier.Denominator {
+		// the additional gas overflows uint64, consume the maximum amount of gas
+		ctx.GasMeter().ConsumeGas(math.MaxUint64, "client update gas multiplier")
+		return
+	}
+
+	additionalGas, _ := bits.Div64(hi, lo, multiplier.Denominator)
+	ctx.GasMeter().ConsumeGas(additionalGas, "client update gas multiplier")
+}
+
 // UpgradeClient upgrades the client to a new client state if this new client was committed to
 // by the old client at the specified upgrade height
 func (k *Keeper) UpgradeClient(
@@ -106,7 +198,7 @@ func (k *Keeper) UpgradeClient(
 	latestHeight := clientModule.LatestHeight(ctx, clientID)
 	k.Logger(ctx).Info("client state upgraded", "client-id", clientID, "height", latestHeight.String())
 
-	clientType := types.MustParseClientIdentifier(clientID)
+	clientType := k.mustGetClientType(ctx, clientID)
 	defer telemetry.ReportUpgradeClient(clientType, clientID)
 	emitUpgradeClientEvent(ctx, clientID, clientType, latestHeight)
 
@@ -124,16 +216,28 @@ func (k *Keeper) RecoverClient(ctx sdk.Context, subjectClientID, substituteClien
 		return errorsmod.Wrap(types.ErrRouteNotFound, subjectClientID)
 	}
 
+	substituteClientModule, err := k.Route(ctx, substituteClientID)
+	if err != nil {
+		return errorsmod.Wrap(types.ErrRouteNotFound, substituteClientID)
+	}
+
+	// the stored client types are compared, as either client may have been migrated to a different client type
+	subjectClientType := k.mustGetClientType(ctx, subjectClientID)
+	substituteClientType := k.mustGetClientType(ctx, substituteClientID)
+	if subjectClientType != substituteClientType {
+		return errorsmod.Wrapf(types.ErrInvalidClientType, "subject and substitute clients must be of the same client type: expected %s, got %s", subjectClientType, substituteClientType)
+	}
+
 	if status := clientModule.Status(ctx, subjectClientID); status == exported.Active {
 		return errorsmod.Wrapf(types.ErrInvalidRecoveryClient, "cannot recover subject client (%s) with status %s", subjectClientID, status)
 	}
 
-	if status := clientModule.Status(ctx, substituteClientID); status != exported.Active {
+	if status := substituteClientModule.Status(ctx, substituteClientID); status != exported.Active {
 		return errorsmod.Wrapf(types.ErrClientNotActive, "cannot recover client using substitute client (%s) with status %s", substituteClientID, status)
 	}
 
 	subjectLatestHeight := clientModule.LatestHeight(ctx, subjectClientID)
-	substituteLatestHeight := clientModule.LatestHeight(ctx, substituteClientID)
+	substituteLatestHeight := substituteClientModule.LatestHeight(ctx, substituteClientID)
 	if subjectLatestHeight.GTE(substituteLatestHeight) {
 		return errorsmod.Wrapf(types.ErrInvalidHeight, "subject client state latest height is greater or equal to substitute client state latest height (%s >= %s)", subjectLatestHeight, substituteLatestHeight)
 	}
@@ -144,9 +248,96 @@ func (k *Keeper) RecoverClient(ctx sdk.Context, subjectClientID, substituteClien
 
 	k.Logger(ctx).Info("client recovered", "client-id", subjectClientID)
 
-	clientType := types.MustParseClientIdentifier(subjectClientID)
-	defer telemetry.ReportRecoverClient(clientType, subjectClientID)
-	emitRecoverClientEvent(ctx, subjectClientID, clientType)
+	defer telemetry.ReportRecoverClient(subjectClientType, subjectClientID)
+	emitRecoverClientEvent(ctx, subjectClientID, subjectClientType)
 
 	return nil
 }
+
+// MigrateClientType replaces the subject client with the substitute client, which must be of a different client type,
+// while preserving the client identifier of the subject client. Both light client modules must implement the
+// ChainIDLightClientModule interface, and the substitute client must track the same chain as the subject client.
+// The subject client store is replaced by a copy of the substitute client store, and the client type of the subject
+// client is recorded so that it will be routed to the light client module of the substitute client type. Light client
+// modules implementing the ClientStoreReplacedLightClientModule interface are notified of the replaced client store.
+// The substitute must be Active and its latest height must not be lower than the latest height of the subject.
+func (k *Keeper) MigrateClientType(ctx sdk.Context, subjectClientID, substituteClientID string) error {
+	subjectClientModule, err := k.Route(ctx, subjectClientID)
+	if err != nil {
+		return errorsmod.Wrap(types.ErrRouteNotFound, subjectClientID)
+	}
+
+	substituteClientModule, err := k.Route(ctx, substituteClientID)
+	if err != nil {
+		return errorsmod.Wrap(types.ErrRouteNotFound, substituteClientID)
+	}
+
+	subjectClientType := k.mustGetClientType(ctx, subjectClientID)
+	substituteClientType := k.mustGetClientType(ctx, substituteClientID)
+	if subjectClientType == exported.Localhost || substituteClientType == exported.Localhost {
+		return errorsmod.Wrapf(types.ErrInvalidClientType, "cannot migrate client type of client of type: %s", exported.Localhost)
+	}
+
+	if subjectClientType == substituteClientType {
+		return errorsmod.Wrapf(types.ErrInvalidClientType, "subject and substitute clients must be of different client types, got %s", subjectClientType)
+	}
+
+	if status := substituteClientModule.Status(ctx, substituteClientID); status != exported.Active {
+		return errorsmod.Wrapf(types.ErrClientNotActive, "cannot migrate client using substitute client (%s) with status %s", substituteClientID, status)
+	}
+
+	subjectChainID, err := getChainID(ctx, subjectClientModule, subjectClientID)
+	if err != nil {
+		return err
+	}
+
+	substituteChainID, err := getChainID(ctx, substituteClientModule, substituteClientID)
+	if err != nil {
+		return err
+	}
+
+	if subjectChainID != substituteChainID {
+		return errorsmod.Wrapf(types.ErrInvalidSubstitute, "subject client chain ID (%s) does not match substitute client chain ID (%s)", subjectChainID, substituteChainID)
+	}
+
+	subjectLatestHeight := subjectClientModule.LatestHeight(ctx, subjectClientID)
+	substituteLatestHeight := substituteClientModule.LatestHeight(ctx, substituteClientID)
+	if substituteLatestHeight.LT(subjectLatestHeight) {
+		return errorsmod.Wrapf(types.ErrInvalidHeight, "substitute client state latest height is less than subject client state latest height (%s < %s)", substituteLatestHeight, subjectLatestHeight)
+	}
+
+	k.replaceClientStore(ctx, subjectClientID, substituteClientID)
+
+	for _, clientModule := range []exported.LightClientModule{subjectClientModule, substituteClientModule} {
+		if storeReplacedModule, ok := clientModule.(exported.ClientStoreReplacedLightClientModule); ok {
+			if err := storeReplacedModule.OnClientStoreReplaced(ctx, subjectClientID); err != nil {
+				return err
+			}
+		}
+	}
+
+	if types.MustParseClientIdentifier(subjectClientID) == substituteClientType {
+		// the client has been migrated back to the client type encoded in its identifier
+		k.DeleteMigratedClientType(ctx, subjectClientID)
+	} else {
+		k.SetMigratedClientType(ctx, subjectClientID, substituteClientType)
+	}
+
+	k.Logger(ctx).Info("client type migrated", "client-id", subjectClientID, "client-type", substituteClientType)
+
+	defer telemetry.ReportMigrateClientType(substituteClientType, subjectClientID)
+	emitMigrateClientTypeEvent(ctx, subjectClientID, substituteClientID, substituteClientType)
+
+	return nil
+}
+
+// getChainID returns the chain identifier tracked by the given client if its light client module implements
+// the ChainIDLightClientModule interface.
+func getChainID(ctx sdk.Context, clientModule exported.LightClientModule, clientID string) (string, error) {
+	chainIDModule, ok := clientModule.(exported.ChainIDLightClientModule)
+	if !ok {
+		return "", errorsmod.Wrapf(types.ErrClientTypeNotSupported, "light client module of client (%s) does not expose a chain ID", clientID)
+	}
+
+	return chainIDModule.ChainID(ctx, clientID)
+}
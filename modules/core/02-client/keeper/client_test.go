@@ -1,9 +1,12 @@
 package keeper_test
 
 import (
+	"context"
 	"fmt"
+	"math"
 	"time"
 
+	storetypes "cosmossdk.io/store/types"
 	upgradetypes "cosmossdk.io/x/upgrade/types"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
@@ -325,6 +328,223 @@ func (suite *KeeperTestSuite) TestUpdateClientTendermint() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestUpdateClientRateLimit() {
+	var (
+		path         *ibctesting.Path
+		ctx          sdk.Context
+		updateHeader *ibctm.Header
+	)
+
+	setLastUpdate := func(blocksAgo int64, timeAgo time.Duration) {
+		suite.chainA.App.GetIBCKeeper().ClientKeeper.SetClientLastUpdate(ctx, path.EndpointA.ClientID, uint64(ctx.BlockHeight()-blocksAgo), uint64(ctx.BlockTime().Add(-timeAgo).UnixNano()))
+	}
+
+	testCases := []struct {
+		name      string
+		malleate  func()
+		expError  error
+		expFreeze bool
+	}{
+		{
+			"success: rate limit disabled",
+			func() {
+				params := clienttypes.DefaultParams()
+				suite.chainA.App.GetIBCKeeper().ClientKeeper.SetParams(ctx, params)
+
+				setLastUpdate(0, 0)
+			},
+			nil,
+			false,
+		},
+		{
+			"success: no previous update recorded",
+			func() {},
+			nil,
+			false,
+		},
+		{
+			"success: minimum block and time interval elapsed",
+			func() {
+				setLastUpdate(2, time.Minute)
+			},
+			nil,
+			false,
+		},
+		{
+			"success: client is exempt",
+			func() {
+				setLastUpdate(0, 0)
+
+				ctx = clienttypes.WithUpdateRateLimitExemptions(ctx, path.EndpointA.ClientID)
+			},
+			nil,
+			false,
+		},
+		{
+			"success: misbehaviour is not rate limited",
+			func() {
+				setLastUpdate(0, 0)
+
+				// set conflicting consensus state in store to create misbehaviour scenario
+				conflictConsState := updateHeader.ConsensusState()
+				conflictConsState.Root = commitmenttypes.NewMerkleRoot([]byte("conflicting apphash"))
+				suite.chainA.App.GetIBCKeeper().ClientKeeper.SetClientConsensusState(ctx, path.EndpointA.ClientID, updateHeader.GetHeight(), conflictConsState)
+			},
+			nil,
+			true,
+		},
+		{
+			"failure: minimum block interval not elapsed",
+			func() {
+				setLastUpdate(1, time.Minute)
+			},
+			clienttypes.ErrClientUpdateRateLimited,
+			false,
+		},
+		{
+			"failure: minimum time interval not elapsed",
+			func() {
+				setLastUpdate(2, time.Second)
+			},
+			clienttypes.ErrClientUpdateRateLimited,
+			false,
+		},
+		{
+			"failure: other client is exempt",
+			func() {
+				setLastUpdate(0, 0)
+
+				ctx = clienttypes.WithUpdateRateLimitExemptions(ctx, "07-tendermint-100")
+			},
+			clienttypes.ErrClientUpdateRateLimited,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.SetupClients()
+
+			ctx = suite.chainA.GetContext()
+
+			params := clienttypes.DefaultParams()
+			params.MinUpdateBlockInterval = 2
+			params.MinUpdateTimeInterval = uint64(time.Minute)
+			suite.chainA.App.GetIBCKeeper().ClientKeeper.SetParams(ctx, params)
+
+			var err error
+			updateHeader, err = path.EndpointB.Chain.IBCClientHeader(path.EndpointB.Chain.LatestCommittedHeader, path.EndpointA.GetClientLatestHeight().(clienttypes.Height))
+			suite.Require().NoError(err)
+
+			tc.malleate()
+
+			expPass := tc.expError == nil
+
+			// misbehaviour is only detected on update, the rate limit check alone rejects it
+			checkErr := suite.chainA.App.GetIBCKeeper().ClientKeeper.CheckUpdateRateLimit(ctx, path.EndpointA.ClientID)
+			suite.Require().Equal(expPass && !tc.expFreeze, checkErr == nil)
+
+			err = suite.chainA.App.GetIBCKeeper().ClientKeeper.UpdateClient(ctx, path.EndpointA.ClientID, updateHeader)
+
+			if expPass {
+				suite.Require().NoError(err)
+
+				clientState, ok := path.EndpointA.GetClientState().(*ibctm.ClientState)
+				suite.Require().True(ok)
+				suite.Require().Equal(tc.expFreeze, !clientState.FrozenHeight.IsZero())
+
+				if !tc.expFreeze && suite.chainA.App.GetIBCKeeper().ClientKeeper.GetParams(ctx).IsUpdateRateLimitEnabled() {
+					height, timestamp, found := suite.chainA.App.GetIBCKeeper().ClientKeeper.GetClientLastUpdate(ctx, path.EndpointA.ClientID)
+					suite.Require().True(found)
+					suite.Require().Equal(uint64(ctx.BlockHeight()), height)
+					suite.Require().Equal(uint64(ctx.BlockTime().UnixNano()), timestamp)
+				}
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestUpdateClientGasMultiplier() {
+	// gasUsed returns the gas consumed by a client update in a fresh setup with the given params
+	gasUsed := func(params clienttypes.Params) uint64 {
+		suite.SetupTest()
+		path := ibctesting.NewPath(suite.chainA, suite.chainB)
+		path.SetupClients()
+
+		ctx := suite.chainA.GetContext()
+		suite.chainA.App.GetIBCKeeper().ClientKeeper.SetParams(ctx, params)
+
+		header, err := path.EndpointB.Chain.IBCClientHeader(path.EndpointB.Chain.LatestCommittedHeader, path.EndpointA.GetClientLatestHeight().(clienttypes.Height))
+		suite.Require().NoError(err)
+
+		ctx = ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+		err = suite.chainA.App.GetIBCKeeper().ClientKeeper.UpdateClient(ctx, path.EndpointA.ClientID, header)
+		suite.Require().NoError(err)
+
+		return ctx.GasMeter().GasConsumed()
+	}
+
+	defaultGas := gasUsed(clienttypes.DefaultParams())
+
+	params := clienttypes.DefaultParams()
+	params.UpdateGasMultipliers = []clienttypes.GasMultiplier{clienttypes.NewGasMultiplier(exported.Tendermint, 3, 1)}
+	suite.Require().Greater(gasUsed(params), 2*defaultGas)
+
+	params.UpdateGasMultipliers = []clienttypes.GasMultiplier{clienttypes.NewGasMultiplier(exported.Solomachine, 3, 1)}
+	suite.Require().InDelta(defaultGas, gasUsed(params), 1_000)
+}
+
+func (suite *KeeperTestSuite) TestConsumeUpdateGas() {
+	testCases := []struct {
+		name        string
+		multipliers []clienttypes.GasMultiplier
+		gasUsed     uint64
+		expGas      uint64
+		expPanic    bool
+	}{
+		{"no multiplier", nil, 1_000, 0, false},
+		{"multiplier for other client type", []clienttypes.GasMultiplier{clienttypes.NewGasMultiplier(exported.Solomachine, 3, 1)}, 1_000, 0, false},
+		{"multiplier of one", []clienttypes.GasMultiplier{clienttypes.NewGasMultiplier(exported.Tendermint, 2, 2)}, 1_000, 0, false},
+		{"integer multiplier", []clienttypes.GasMultiplier{clienttypes.NewGasMultiplier(exported.Tendermint, 3, 1)}, 1_000, 2_000, false},
+		{"fractional multiplier", []clienttypes.GasMultiplier{clienttypes.NewGasMultiplier(exported.Tendermint, 3, 2)}, 1_001, 500, false},
+		{"additional gas overflows", []clienttypes.GasMultiplier{clienttypes.NewGasMultiplier(exported.Tendermint, 3, 1)}, math.MaxUint64 / 2, 0, true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			params := clienttypes.DefaultParams()
+			params.UpdateGasMultipliers = tc.multipliers
+			suite.chainA.App.GetIBCKeeper().ClientKeeper.SetParams(suite.chainA.GetContext(), params)
+
+			ctx := suite.chainA.GetContext().WithGasMeter(storetypes.NewGasMeter(math.MaxUint64))
+			consumeFn := func() {
+				suite.chainA.App.GetIBCKeeper().ClientKeeper.ConsumeUpdateGas(ctx, exported.Tendermint, tc.gasUsed)
+			}
+
+			if tc.expPanic {
+				suite.Require().Panics(consumeFn)
+				return
+			}
+
+			// reading the params consumes gas, which is measured separately
+			paramsGas := ctx.GasMeter().GasConsumed()
+			suite.chainA.App.GetIBCKeeper().ClientKeeper.GetParams(ctx)
+			paramsGas = ctx.GasMeter().GasConsumed() - paramsGas
+
+			consumeFn()
+			suite.Require().Equal(tc.expGas+2*paramsGas, ctx.GasMeter().GasConsumed())
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestUpgradeClient() {
 	var (
 		path                                             *ibctesting.Path
@@ -532,6 +752,10 @@ func (suite *KeeperTestSuite) TestUpdateClientEventEmission() {
 }
 
 func (suite *KeeperTestSuite) TestRecoverClient() {
+	// mockClientType is a client type routed to the 07-tendermint light client module,
+	// used to exercise recovery of clients whose client type has been migrated.
+	const mockClientType = "99-mock"
+
 	var (
 		subject, substitute                       string
 		subjectClientState, substituteClientState exported.ClientState
@@ -589,7 +813,7 @@ func (suite *KeeperTestSuite) TestRecoverClient() {
 			func() {
 				substitute = ibctesting.InvalidID
 			},
-			clienttypes.ErrClientNotActive,
+			clienttypes.ErrRouteNotFound,
 		},
 		{
 			"subject and substitute have equal latest height",
@@ -622,6 +846,37 @@ func (suite *KeeperTestSuite) TestRecoverClient() {
 			},
 			clienttypes.ErrClientNotActive,
 		},
+		{
+			"success, subject client migrated to the client type of the substitute",
+			func() {
+				clientKeeper := suite.chainA.App.GetIBCKeeper().ClientKeeper
+				clientKeeper.AddRoute(mockClientType, ibctm.NewLightClientModule(suite.chainA.Codec, clientKeeper.GetStoreProvider()))
+				clientKeeper.SetMigratedClientType(suite.chainA.GetContext(), subject, mockClientType)
+
+				// store the substitute tendermint client under a client identifier of the mock client type
+				tmClientState, ok := substituteClientState.(*ibctm.ClientState)
+				suite.Require().True(ok)
+				consState, found := clientKeeper.GetClientConsensusState(suite.chainA.GetContext(), substitute, tmClientState.LatestHeight)
+				suite.Require().True(found)
+
+				substitute = clienttypes.FormatClientIdentifier(mockClientType, 0)
+				clientKeeper.SetClientState(suite.chainA.GetContext(), substitute, tmClientState)
+				clientKeeper.SetClientConsensusState(suite.chainA.GetContext(), substitute, tmClientState.LatestHeight, consState)
+				clientStore := clientKeeper.ClientStore(suite.chainA.GetContext(), substitute)
+				ibctm.SetProcessedTime(clientStore, tmClientState.LatestHeight, 100)
+				ibctm.SetProcessedHeight(clientStore, tmClientState.LatestHeight, clienttypes.NewHeight(0, 1))
+			},
+			nil,
+		},
+		{
+			"subject and substitute are of different client types",
+			func() {
+				clientKeeper := suite.chainA.App.GetIBCKeeper().ClientKeeper
+				clientKeeper.AddRoute(mockClientType, ibctm.NewLightClientModule(suite.chainA.Codec, clientKeeper.GetStoreProvider()))
+				clientKeeper.SetMigratedClientType(suite.chainA.GetContext(), substitute, mockClientType)
+			},
+			clienttypes.ErrInvalidClientType,
+		},
 		{
 			"light client module RecoverClient fails, substitute client trust level doesn't match subject client trust level",
 			func() {
@@ -670,11 +925,14 @@ func (suite *KeeperTestSuite) TestRecoverClient() {
 			if expPass {
 				suite.Require().NoError(err)
 
+				clientType, err := suite.chainA.App.GetIBCKeeper().ClientKeeper.GetClientType(ctx, subject)
+				suite.Require().NoError(err)
+
 				expectedEvents := sdk.Events{
 					sdk.NewEvent(
 						clienttypes.EventTypeRecoverClient,
 						sdk.NewAttribute(clienttypes.AttributeKeySubjectClientID, subjectPath.EndpointA.ClientID),
-						sdk.NewAttribute(clienttypes.AttributeKeyClientType, subjectPath.EndpointA.GetClientState().ClientType()),
+						sdk.NewAttribute(clienttypes.AttributeKeyClientType, clientType),
 					),
 				}.ToABCIEvents()
 
@@ -693,3 +951,198 @@ func (suite *KeeperTestSuite) TestRecoverClient() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestMigrateClientType() {
+	// mockClientType is a client type routed to the 07-tendermint light client module,
+	// used to exercise migrations between two client types exposing a chain ID.
+	const mockClientType = "99-mock"
+
+	var (
+		subject, substitute   string
+		substituteClientState *ibctm.ClientState
+		mockClientModule      *storeReplacedLightClientModule
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"failure: light client module fails to handle the replaced client store",
+			func() {
+				mockClientModule.err = clienttypes.ErrInvalidClient
+			},
+			clienttypes.ErrInvalidClient,
+		},
+		{
+			"success: subject client is frozen",
+			func() {
+				tmClientState, ok := suite.chainA.GetClientState(subject).(*ibctm.ClientState)
+				suite.Require().True(ok)
+				tmClientState.FrozenHeight = tmClientState.LatestHeight
+				suite.chainA.App.GetIBCKeeper().ClientKeeper.SetClientState(suite.chainA.GetContext(), subject, tmClientState)
+			},
+			nil,
+		},
+		{
+			"subject client does not exist",
+			func() {
+				subject = ibctesting.InvalidID
+			},
+			clienttypes.ErrRouteNotFound,
+		},
+		{
+			"substitute client does not exist",
+			func() {
+				substitute = ibctesting.InvalidID
+			},
+			clienttypes.ErrRouteNotFound,
+		},
+		{
+			"subject and substitute are of the same client type",
+			func() {
+				substitutePath := ibctesting.NewPath(suite.chainA, suite.chainB)
+				substitutePath.SetupClients()
+				substitute = substitutePath.EndpointA.ClientID
+			},
+			clienttypes.ErrInvalidClientType,
+		},
+		{
+			"substitute is localhost client",
+			func() {
+				substitute = exported.LocalhostClientID
+			},
+			clienttypes.ErrInvalidClientType,
+		},
+		{
+			"substitute is frozen",
+			func() {
+				substituteClientState.FrozenHeight = clienttypes.NewHeight(0, 1)
+				suite.chainA.App.GetIBCKeeper().ClientKeeper.SetClientState(suite.chainA.GetContext(), substitute, substituteClientState)
+			},
+			clienttypes.ErrClientNotActive,
+		},
+		{
+			"substitute light client module does not expose a chain ID",
+			func() {
+				substitute = suite.solomachine.CreateClient(suite.chainA)
+			},
+			clienttypes.ErrClientTypeNotSupported,
+		},
+		{
+			"substitute tracks a different chain ID",
+			func() {
+				substituteClientState.ChainId = testChainID
+				suite.chainA.App.GetIBCKeeper().ClientKeeper.SetClientState(suite.chainA.GetContext(), substitute, substituteClientState)
+			},
+			clienttypes.ErrInvalidSubstitute,
+		},
+		{
+			"substitute height is less than subject height",
+			func() {
+				tmClientState, ok := suite.chainA.GetClientState(subject).(*ibctm.ClientState)
+				suite.Require().True(ok)
+				tmClientState.LatestHeight, ok = substituteClientState.LatestHeight.Increment().(clienttypes.Height)
+				suite.Require().True(ok)
+				suite.chainA.App.GetIBCKeeper().ClientKeeper.SetClientState(suite.chainA.GetContext(), subject, tmClientState)
+			},
+			clienttypes.ErrInvalidHeight,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			clientKeeper := suite.chainA.App.GetIBCKeeper().ClientKeeper
+			mockClientModule = &storeReplacedLightClientModule{LightClientModule: ibctm.NewLightClientModule(suite.chainA.Codec, clientKeeper.GetStoreProvider())}
+			clientKeeper.AddRoute(mockClientType, mockClientModule)
+
+			subjectPath := ibctesting.NewPath(suite.chainA, suite.chainB)
+			subjectPath.SetupClients()
+			subject = subjectPath.EndpointA.ClientID
+
+			substitutePath := ibctesting.NewPath(suite.chainA, suite.chainB)
+			substitutePath.SetupClients()
+			err := substitutePath.EndpointA.UpdateClient()
+			suite.Require().NoError(err)
+
+			// store the substitute tendermint client under a client identifier of the mock client type
+			substitute = clienttypes.FormatClientIdentifier(mockClientType, 0)
+			var ok bool
+			substituteClientState, ok = suite.chainA.GetClientState(substitutePath.EndpointA.ClientID).(*ibctm.ClientState)
+			suite.Require().True(ok)
+			consensusState, found := clientKeeper.GetClientConsensusState(suite.chainA.GetContext(), substitutePath.EndpointA.ClientID, substituteClientState.LatestHeight)
+			suite.Require().True(found)
+			clientKeeper.SetClientState(suite.chainA.GetContext(), substitute, substituteClientState)
+			clientKeeper.SetClientConsensusState(suite.chainA.GetContext(), substitute, substituteClientState.LatestHeight, consensusState)
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			err = clientKeeper.MigrateClientType(ctx, subject, substitute)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+
+				expectedEvents := sdk.Events{
+					sdk.NewEvent(
+						clienttypes.EventTypeMigrateClientType,
+						sdk.NewAttribute(clienttypes.AttributeKeySubjectClientID, subject),
+						sdk.NewAttribute(clienttypes.AttributeKeySubstituteClientID, substitute),
+						sdk.NewAttribute(clienttypes.AttributeKeyClientType, mockClientType),
+					),
+				}.ToABCIEvents()
+
+				expectedEvents = sdk.MarkEventsToIndex(expectedEvents, map[string]struct{}{})
+				ibctesting.AssertEvents(&suite.Suite, expectedEvents, ctx.EventManager().Events().ToABCIEvents())
+
+				clientType, err := clientKeeper.GetClientType(ctx, subject)
+				suite.Require().NoError(err)
+				suite.Require().Equal(mockClientType, clientType)
+
+				// the subject client identifier is preserved and now tracks the substitute client state
+				suite.Require().Equal(exported.Active, clientKeeper.GetClientStatus(ctx, subject))
+				suite.Require().Equal(substituteClientState.LatestHeight, clientKeeper.GetClientLatestHeight(ctx, subject))
+
+				// the light client module of the client type migrated to is notified of the replaced client store
+				suite.Require().Equal([]string{subject}, mockClientModule.replacedClientIDs)
+
+				// migrating back to the client type encoded in the client identifier removes the migrated client type
+				backPath := ibctesting.NewPath(suite.chainA, suite.chainB)
+				backPath.SetupClients()
+				err = clientKeeper.MigrateClientType(ctx, subject, backPath.EndpointA.ClientID)
+				suite.Require().NoError(err)
+
+				_, found := clientKeeper.GetMigratedClientType(ctx, subject)
+				suite.Require().False(found)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+// storeReplacedLightClientModule wraps the 07-tendermint light client module and records the clients
+// whose client store has been replaced by a client type migration.
+type storeReplacedLightClientModule struct {
+	ibctm.LightClientModule
+
+	replacedClientIDs []string
+	err               error
+}
+
+// OnClientStoreReplaced implements the exported.ClientStoreReplacedLightClientModule interface.
+func (m *storeReplacedLightClientModule) OnClientStoreReplaced(_ context.Context, clientID string) error {
+	m.replacedClientIDs = append(m.replacedClientIDs, clientID)
+	return m.err
+}
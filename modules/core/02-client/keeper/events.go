@@ -105,6 +105,22 @@ func emitRecoverClientEvent(ctx sdk.Context, clientID, clientType string) {
 	})
 }
 
+// emitMigrateClientTypeEvent emits a migrate client type event
+func emitMigrateClientTypeEvent(ctx sdk.Context, subjectClientID, substituteClientID, clientType string) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeMigrateClientType,
+			sdk.NewAttribute(types.AttributeKeySubjectClientID, subjectClientID),
+			sdk.NewAttribute(types.AttributeKeySubstituteClientID, substituteClientID),
+			sdk.NewAttribute(types.AttributeKeyClientType, clientType),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+}
+
 // emitScheduleIBCSoftwareUpgradeEvent emits a schedule IBC software upgrade event
 func emitScheduleIBCSoftwareUpgradeEvent(ctx sdk.Context, title string, height int64) {
 	ctx.EventManager().EmitEvents(sdk.Events{
@@ -348,7 +348,10 @@ func (q *queryServer) VerifyMembership(c context.Context, req *types.QueryVerify
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	clientType, _, err := types.ParseClientIdentifier(req.ClientId)
+	ctx := sdk.UnwrapSDKContext(c)
+
+	// use the stored client type, as the client may have been migrated to a different client type
+	clientType, err := q.GetClientType(ctx, req.ClientId)
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
@@ -374,8 +377,6 @@ func (q *queryServer) VerifyMembership(c context.Context, req *types.QueryVerify
 		return nil, status.Error(codes.InvalidArgument, "empty value")
 	}
 
-	ctx := sdk.UnwrapSDKContext(c)
-
 	// cache the context to ensure clientState.VerifyMembership does not change state
 	cachedCtx, _ := ctx.CacheContext()
 
@@ -848,6 +848,21 @@ func (suite *KeeperTestSuite) TestQueryVerifyMembershipProof() {
 			},
 			types.ErrInvalidClientType,
 		},
+		{
+			"client migrated to a denied client type is denied",
+			func() {
+				suite.chainA.App.GetIBCKeeper().ClientKeeper.SetMigratedClientType(suite.chainA.GetContext(), path.EndpointA.ClientID, exported.Solomachine)
+
+				req = &types.QueryVerifyMembershipRequest{
+					ClientId:    path.EndpointA.ClientID,
+					Proof:       []byte{0x01},
+					ProofHeight: types.NewHeight(1, 100),
+					MerklePath:  commitmenttypes.NewMerklePath([]byte("/ibc"), host.ChannelKey(mock.PortID, ibctesting.FirstChannelID)),
+					Value:       []byte{0x01},
+				}
+			},
+			types.ErrInvalidClientType,
+		},
 		{
 			"empty proof",
 			func() {
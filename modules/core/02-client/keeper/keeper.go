@@ -72,9 +72,9 @@ func (k *Keeper) GetStoreProvider() types.StoreProvider {
 
 // Route returns the light client module for the given client identifier.
 func (k *Keeper) Route(ctx context.Context, clientID string) (exported.LightClientModule, error) {
-	clientType, _, err := types.ParseClientIdentifier(clientID)
+	clientType, err := k.GetClientType(ctx, clientID)
 	if err != nil {
-		return nil, errorsmod.Wrapf(err, "unable to parse client identifier %s", clientID)
+		return nil, err
 	}
 
 	if !k.GetParams(ctx).IsAllowedClient(clientType) {
@@ -92,6 +92,112 @@ func (k *Keeper) Route(ctx context.Context, clientID string) (exported.LightClie
 	return clientModule, nil
 }
 
+// GetClientType returns the client type of the given client identifier. The client type is parsed from the
+// client identifier, unless the client has been migrated to a different client type using MigrateClientType.
+func (k *Keeper) GetClientType(ctx context.Context, clientID string) (string, error) {
+	clientType, _, err := types.ParseClientIdentifier(clientID)
+	if err != nil {
+		return "", errorsmod.Wrapf(err, "unable to parse client identifier %s", clientID)
+	}
+
+	if migratedClientType, found := k.GetMigratedClientType(ctx, clientID); found {
+		return migratedClientType, nil
+	}
+
+	return clientType, nil
+}
+
+// mustGetClientType returns the client type of the given client identifier. It panics if the client
+// identifier cannot be parsed.
+func (k *Keeper) mustGetClientType(ctx context.Context, clientID string) string {
+	clientType, err := k.GetClientType(ctx, clientID)
+	if err != nil {
+		panic(err)
+	}
+
+	return clientType
+}
+
+// GetMigratedClientType returns the client type a client has been migrated to, if any.
+func (k *Keeper) GetMigratedClientType(ctx context.Context, clientID string) (string, bool) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.MigratedClientTypeKey(clientID))
+	if err != nil {
+		panic(err)
+	}
+	if len(bz) == 0 {
+		return "", false
+	}
+
+	return string(bz), true
+}
+
+// SetMigratedClientType stores the client type a client has been migrated to.
+func (k *Keeper) SetMigratedClientType(ctx context.Context, clientID, clientType string) {
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Set(types.MigratedClientTypeKey(clientID), []byte(clientType)); err != nil {
+		panic(err)
+	}
+}
+
+// DeleteMigratedClientType removes the migrated client type of a client.
+func (k *Keeper) DeleteMigratedClientType(ctx context.Context, clientID string) {
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Delete(types.MigratedClientTypeKey(clientID)); err != nil {
+		panic(err)
+	}
+}
+
+// GetAllMigratedClientTypes returns the client types of all clients which have been migrated to a
+// client type different from the one encoded in their client identifier.
+func (k *Keeper) GetAllMigratedClientTypes(ctx context.Context) []types.MigratedClientType {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte(types.KeyMigratedClientTypePrefix+"/"))
+
+	var migratedClientTypes []types.MigratedClientType
+	defer sdk.LogDeferred(k.Logger(ctx), func() error { return iterator.Close() })
+	for ; iterator.Valid(); iterator.Next() {
+		clientID := strings.TrimPrefix(string(iterator.Key()), types.KeyMigratedClientTypePrefix+"/")
+		migratedClientTypes = append(migratedClientTypes, types.MigratedClientType{
+			ClientId:   clientID,
+			ClientType: string(iterator.Value()),
+		})
+	}
+
+	return migratedClientTypes
+}
+
+// replaceClientStore deletes all keys within the subject client store and copies every key
+// stored within the substitute client store into the subject client store.
+func (k *Keeper) replaceClientStore(ctx context.Context, subjectClientID, substituteClientID string) {
+	subjectClientStore := k.ClientStore(ctx, subjectClientID)
+	substituteClientStore := k.ClientStore(ctx, substituteClientID)
+
+	// collect keys and values before writing, since the store must not be mutated while iterating over it
+	var subjectKeys [][]byte
+	subjectIterator := subjectClientStore.Iterator(nil, nil)
+	for ; subjectIterator.Valid(); subjectIterator.Next() {
+		subjectKeys = append(subjectKeys, subjectIterator.Key())
+	}
+	sdk.LogDeferred(k.Logger(ctx), func() error { return subjectIterator.Close() })
+
+	var substituteKeys, substituteValues [][]byte
+	substituteIterator := substituteClientStore.Iterator(nil, nil)
+	for ; substituteIterator.Valid(); substituteIterator.Next() {
+		substituteKeys = append(substituteKeys, substituteIterator.Key())
+		substituteValues = append(substituteValues, substituteIterator.Value())
+	}
+	sdk.LogDeferred(k.Logger(ctx), func() error { return substituteIterator.Close() })
+
+	for _, key := range subjectKeys {
+		subjectClientStore.Delete(key)
+	}
+
+	for i, key := range substituteKeys {
+		subjectClientStore.Set(key, substituteValues[i])
+	}
+}
+
 // GenerateClientIdentifier returns the next client identifier.
 func (k *Keeper) GenerateClientIdentifier(ctx context.Context, clientType string) string {
 	nextClientSeq := k.GetNextClientSequence(ctx)
@@ -162,6 +268,37 @@ func (k *Keeper) SetNextClientSequence(ctx context.Context, sequence uint64) {
 	}
 }
 
+// GetClientLastUpdate returns the block height and time (in nanoseconds) of the last accepted update of
+// the provided client. The returned boolean is false if no update of the client has been recorded.
+func (k *Keeper) GetClientLastUpdate(ctx context.Context, clientID string) (uint64, uint64, bool) {
+	store := k.storeService.OpenKVStore(ctx)
+	heightBz, err := store.Get(types.ClientLastUpdateHeightKey(clientID))
+	if err != nil {
+		panic(err)
+	}
+	if len(heightBz) == 0 {
+		return 0, 0, false
+	}
+
+	timeBz, err := store.Get(types.ClientLastUpdateTimeKey(clientID))
+	if err != nil {
+		panic(err)
+	}
+
+	return sdk.BigEndianToUint64(heightBz), sdk.BigEndianToUint64(timeBz), true
+}
+
+// SetClientLastUpdate sets the block height and time (in nanoseconds) of the last accepted update of the provided client.
+func (k *Keeper) SetClientLastUpdate(ctx context.Context, clientID string, height, timestamp uint64) {
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Set(types.ClientLastUpdateHeightKey(clientID), sdk.Uint64ToBigEndian(height)); err != nil {
+		panic(err)
+	}
+	if err := store.Set(types.ClientLastUpdateTimeKey(clientID), sdk.Uint64ToBigEndian(timestamp)); err != nil {
+		panic(err)
+	}
+}
+
 // IterateConsensusStates provides an iterator over all stored consensus states.
 // objects. For each State object, cb will be called. If the cb returns true,
 // the iterator will close and stop.
@@ -250,6 +250,16 @@ type Params struct {
 	// and interacted with. If a client type is removed from the allowed clients list, usage
 	// of this client will be disabled until it is added again to the list.
 	AllowedClients []string `protobuf:"bytes,1,rep,name=allowed_clients,json=allowedClients,proto3" json:"allowed_clients,omitempty"`
+	// min_update_block_interval defines the minimum number of blocks which must elapse between two accepted
+	// updates of the same client. Updates submitting misbehaviour, or which are required to verify the proofs
+	// of packet messages contained in the same transaction, are exempt. A value of zero disables the limit.
+	MinUpdateBlockInterval uint64 `protobuf:"varint,2,opt,name=min_update_block_interval,json=minUpdateBlockInterval,proto3" json:"min_update_block_interval,omitempty"`
+	// min_update_time_interval defines the minimum amount of time (in nanoseconds) which must elapse between two
+	// accepted updates of the same client. The same exemptions as for min_update_block_interval apply.
+	// A value of zero disables the limit.
+	MinUpdateTimeInterval uint64 `protobuf:"varint,3,opt,name=min_update_time_interval,json=minUpdateTimeInterval,proto3" json:"min_update_time_interval,omitempty"`
+	// update_gas_multipliers defines the gas multipliers applied to client updates of specific client types.
+	UpdateGasMultipliers []GasMultiplier `protobuf:"bytes,4,rep,name=update_gas_multipliers,json=updateGasMultipliers,proto3" json:"update_gas_multipliers"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -292,47 +302,143 @@ func (m *Params) GetAllowedClients() []string {
 	return nil
 }
 
+func (m *Params) GetMinUpdateBlockInterval() uint64 {
+	if m != nil {
+		return m.MinUpdateBlockInterval
+	}
+	return 0
+}
+
+func (m *Params) GetMinUpdateTimeInterval() uint64 {
+	if m != nil {
+		return m.MinUpdateTimeInterval
+	}
+	return 0
+}
+
+func (m *Params) GetUpdateGasMultipliers() []GasMultiplier {
+	if m != nil {
+		return m.UpdateGasMultipliers
+	}
+	return nil
+}
+
+// GasMultiplier defines a multiplier, expressed as the fraction numerator/denominator, which is applied to
+// the gas consumed by the light client module of the given client type when processing a client update.
+type GasMultiplier struct {
+	// the client type the multiplier applies to
+	ClientType string `protobuf:"bytes,1,opt,name=client_type,json=clientType,proto3" json:"client_type,omitempty"`
+	// the numerator of the multiplier
+	Numerator uint64 `protobuf:"varint,2,opt,name=numerator,proto3" json:"numerator,omitempty"`
+	// the denominator of the multiplier
+	Denominator uint64 `protobuf:"varint,3,opt,name=denominator,proto3" json:"denominator,omitempty"`
+}
+
+func (m *GasMultiplier) Reset()         { *m = GasMultiplier{} }
+func (m *GasMultiplier) String() string { return proto.CompactTextString(m) }
+func (*GasMultiplier) ProtoMessage()    {}
+func (*GasMultiplier) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6bc4c8185546947, []int{5}
+}
+func (m *GasMultiplier) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GasMultiplier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GasMultiplier.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GasMultiplier) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GasMultiplier.Merge(m, src)
+}
+func (m *GasMultiplier) XXX_Size() int {
+	return m.Size()
+}
+func (m *GasMultiplier) XXX_DiscardUnknown() {
+	xxx_messageInfo_GasMultiplier.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GasMultiplier proto.InternalMessageInfo
+
+func (m *GasMultiplier) GetClientType() string {
+	if m != nil {
+		return m.ClientType
+	}
+	return ""
+}
+
+func (m *GasMultiplier) GetNumerator() uint64 {
+	if m != nil {
+		return m.Numerator
+	}
+	return 0
+}
+
+func (m *GasMultiplier) GetDenominator() uint64 {
+	if m != nil {
+		return m.Denominator
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*IdentifiedClientState)(nil), "ibc.core.client.v1.IdentifiedClientState")
 	proto.RegisterType((*ConsensusStateWithHeight)(nil), "ibc.core.client.v1.ConsensusStateWithHeight")
 	proto.RegisterType((*ClientConsensusStates)(nil), "ibc.core.client.v1.ClientConsensusStates")
 	proto.RegisterType((*Height)(nil), "ibc.core.client.v1.Height")
 	proto.RegisterType((*Params)(nil), "ibc.core.client.v1.Params")
+	proto.RegisterType((*GasMultiplier)(nil), "ibc.core.client.v1.GasMultiplier")
 }
 
 func init() { proto.RegisterFile("ibc/core/client/v1/client.proto", fileDescriptor_b6bc4c8185546947) }
 
 var fileDescriptor_b6bc4c8185546947 = []byte{
-	// 456 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0x31, 0x6f, 0xd3, 0x40,
-	0x14, 0xf6, 0xa5, 0x55, 0xd4, 0x5c, 0x50, 0x82, 0x4c, 0x2b, 0x99, 0x20, 0xd9, 0x51, 0x16, 0x32,
-	0xd0, 0x3b, 0x12, 0x06, 0x0a, 0x82, 0x81, 0x74, 0xa1, 0x0b, 0x42, 0x66, 0x40, 0x42, 0x42, 0x91,
-	0x7d, 0xbe, 0xda, 0x27, 0xd9, 0x77, 0x95, 0xef, 0x6c, 0x94, 0x7f, 0xc0, 0x84, 0x90, 0x58, 0x18,
-	0xfb, 0x73, 0x3a, 0x76, 0x64, 0xaa, 0x50, 0xb2, 0xf1, 0x2b, 0x90, 0xef, 0x2e, 0xaa, 0x5c, 0x0a,
-	0x62, 0x7b, 0xf7, 0xbe, 0xef, 0xbd, 0xef, 0xfb, 0x5e, 0x62, 0x18, 0xb0, 0x98, 0x60, 0x22, 0x4a,
-	0x8a, 0x49, 0xce, 0x28, 0x57, 0xb8, 0x9e, 0xd9, 0x0a, 0x9d, 0x95, 0x42, 0x09, 0xd7, 0x65, 0x31,
-	0x41, 0x0d, 0x01, 0xd9, 0x76, 0x3d, 0x1b, 0xed, 0xa7, 0x22, 0x15, 0x1a, 0xc6, 0x4d, 0x65, 0x98,
-	0xa3, 0xfb, 0xa9, 0x10, 0x69, 0x4e, 0xb1, 0x7e, 0xc5, 0xd5, 0x29, 0x8e, 0xf8, 0xca, 0x40, 0x93,
-	0x02, 0x1e, 0x9c, 0x24, 0x94, 0x2b, 0x76, 0xca, 0x68, 0x72, 0xac, 0xf7, 0xbc, 0x53, 0x91, 0xa2,
-	0xee, 0x03, 0xd8, 0x33, 0x6b, 0x97, 0x2c, 0xf1, 0xc0, 0x18, 0x4c, 0x7b, 0xe1, 0x9e, 0x69, 0x9c,
-	0x24, 0xee, 0x53, 0x78, 0xc7, 0x82, 0xb2, 0x21, 0x7b, 0x9d, 0x31, 0x98, 0xf6, 0xe7, 0xfb, 0xc8,
-	0xe8, 0xa0, 0xad, 0x0e, 0x7a, 0xc5, 0x57, 0x61, 0x9f, 0x5c, 0x6f, 0x9d, 0x7c, 0x03, 0xd0, 0x3b,
-	0x16, 0x5c, 0x52, 0x2e, 0x2b, 0xa9, 0x5b, 0xef, 0x99, 0xca, 0x5e, 0x53, 0x96, 0x66, 0xca, 0x3d,
-	0x82, 0xdd, 0x4c, 0x57, 0x5a, 0xaf, 0x3f, 0x1f, 0xa1, 0x3f, 0x13, 0x22, 0xc3, 0x5d, 0xec, 0x5e,
-	0x5c, 0x05, 0x4e, 0x68, 0xf9, 0xee, 0x4b, 0x38, 0x24, 0xdb, 0xad, 0xff, 0x61, 0x69, 0x40, 0x5a,
-	0x16, 0x1a, 0x57, 0x07, 0x26, 0x7b, 0xdb, 0x9b, 0xfc, 0xf7, 0x15, 0x3e, 0xc2, 0xbb, 0x37, 0x54,
-	0xa5, 0xd7, 0x19, 0xef, 0x4c, 0xfb, 0xf3, 0x47, 0xb7, 0x39, 0xff, 0x5b, 0x6e, 0x9b, 0x65, 0xd8,
-	0x36, 0x25, 0x27, 0x5f, 0x00, 0xec, 0xda, 0xcb, 0xbc, 0x80, 0xc3, 0x92, 0xd6, 0x4c, 0x32, 0xc1,
-	0x97, 0xbc, 0x2a, 0x62, 0x5a, 0x6a, 0x33, 0xbb, 0x8b, 0x7b, 0xbf, 0xae, 0x82, 0x9b, 0x50, 0x38,
-	0xd8, 0x36, 0xde, 0xe8, 0x77, 0x6b, 0xda, 0x1e, 0xb8, 0x73, 0xcb, 0xb4, 0x81, 0xae, 0xa7, 0x8d,
-	0xf6, 0xf3, 0xbd, 0xcf, 0xe7, 0x81, 0xf3, 0xfd, 0x3c, 0x70, 0x26, 0x33, 0xd8, 0x7d, 0x1b, 0x95,
-	0x51, 0x21, 0xdd, 0x87, 0x70, 0x18, 0xe5, 0xb9, 0xf8, 0x44, 0x93, 0xa5, 0xc9, 0x27, 0x3d, 0x30,
-	0xde, 0x99, 0xf6, 0xc2, 0x81, 0x6d, 0x9b, 0x6b, 0xca, 0x45, 0x78, 0xb1, 0xf6, 0xc1, 0xe5, 0xda,
-	0x07, 0x3f, 0xd7, 0x3e, 0xf8, 0xba, 0xf1, 0x9d, 0xcb, 0x8d, 0xef, 0xfc, 0xd8, 0xf8, 0xce, 0x87,
-	0xa3, 0x94, 0xa9, 0xac, 0x8a, 0x11, 0x11, 0x05, 0x26, 0x42, 0x16, 0x42, 0x62, 0x16, 0x93, 0xc3,
-	0x54, 0xe0, 0xfa, 0x19, 0x2e, 0x44, 0x52, 0xe5, 0x54, 0x9a, 0xbf, 0xff, 0xe3, 0xf9, 0xa1, 0xfd,
-	0x02, 0xd4, 0xea, 0x8c, 0xca, 0xb8, 0xab, 0x7f, 0xcb, 0x27, 0xbf, 0x03, 0x00, 0x00, 0xff, 0xff,
-	0x94, 0x27, 0x25, 0x2c, 0x21, 0x03, 0x00, 0x00,
+	// 593 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xbf, 0x6f, 0xd3, 0x40,
+	0x18, 0xb5, 0xd3, 0x2a, 0x6a, 0x2e, 0xd0, 0xa0, 0xa3, 0xad, 0xdc, 0x82, 0xec, 0x90, 0x85, 0x0c,
+	0xd4, 0xa6, 0x61, 0x68, 0x8b, 0x60, 0x20, 0x1d, 0xa0, 0x03, 0x08, 0x99, 0x22, 0x24, 0xa4, 0xca,
+	0xf2, 0x8f, 0xab, 0x73, 0xc2, 0x77, 0x67, 0xf9, 0xce, 0x41, 0xd9, 0x19, 0x98, 0x10, 0x12, 0x0b,
+	0x63, 0xff, 0x9c, 0x8e, 0x1d, 0x99, 0x2a, 0xd4, 0x6e, 0xfc, 0x15, 0xc8, 0x77, 0xd7, 0xa4, 0x2e,
+	0x01, 0xb1, 0x9d, 0xdf, 0xfb, 0xde, 0xf7, 0xbd, 0xf7, 0x9d, 0x6d, 0xe0, 0xe0, 0x28, 0xf6, 0x62,
+	0x56, 0x20, 0x2f, 0xce, 0x30, 0xa2, 0xc2, 0x1b, 0x6f, 0xe9, 0x93, 0x9b, 0x17, 0x4c, 0x30, 0x08,
+	0x71, 0x14, 0xbb, 0x55, 0x81, 0xab, 0xe1, 0xf1, 0xd6, 0xc6, 0x4a, 0xca, 0x52, 0x26, 0x69, 0xaf,
+	0x3a, 0xa9, 0xca, 0x8d, 0xf5, 0x94, 0xb1, 0x34, 0x43, 0x9e, 0x7c, 0x8a, 0xca, 0x23, 0x2f, 0xa4,
+	0x13, 0x45, 0xf5, 0x08, 0x58, 0xdd, 0x4f, 0x10, 0x15, 0xf8, 0x08, 0xa3, 0x64, 0x4f, 0xf6, 0x79,
+	0x23, 0x42, 0x81, 0xe0, 0x1d, 0xd0, 0x52, 0x6d, 0x03, 0x9c, 0x58, 0x66, 0xd7, 0xec, 0xb7, 0xfc,
+	0x25, 0x05, 0xec, 0x27, 0x70, 0x1b, 0xdc, 0xd0, 0x24, 0xaf, 0x8a, 0xad, 0x46, 0xd7, 0xec, 0xb7,
+	0x07, 0x2b, 0xae, 0x9a, 0xe3, 0x5e, 0xce, 0x71, 0x9f, 0xd1, 0x89, 0xdf, 0x8e, 0x67, 0x5d, 0x7b,
+	0xdf, 0x4c, 0x60, 0xed, 0x31, 0xca, 0x11, 0xe5, 0x25, 0x97, 0xd0, 0x3b, 0x2c, 0x46, 0x2f, 0x10,
+	0x4e, 0x47, 0x02, 0xee, 0x80, 0xe6, 0x48, 0x9e, 0xe4, 0xbc, 0xf6, 0x60, 0xc3, 0xfd, 0x33, 0xa1,
+	0xab, 0x6a, 0x87, 0x8b, 0x27, 0x67, 0x8e, 0xe1, 0xeb, 0x7a, 0xf8, 0x14, 0x74, 0xe2, 0xcb, 0xae,
+	0xff, 0x61, 0x69, 0x39, 0xae, 0x59, 0xa8, 0x5c, 0xad, 0xaa, 0xec, 0x75, 0x6f, 0xfc, 0xdf, 0x5b,
+	0x38, 0x04, 0xb7, 0xae, 0x4d, 0xe5, 0x56, 0xa3, 0xbb, 0xd0, 0x6f, 0x0f, 0x1e, 0xcc, 0x73, 0xfe,
+	0xb7, 0xdc, 0x3a, 0x4b, 0xa7, 0x6e, 0x8a, 0xf7, 0xbe, 0x98, 0xa0, 0xa9, 0x37, 0xf3, 0x04, 0x74,
+	0x0a, 0x34, 0xc6, 0x1c, 0x33, 0x1a, 0xd0, 0x92, 0x44, 0xa8, 0x90, 0x66, 0x16, 0x87, 0xb7, 0x7f,
+	0x9d, 0x39, 0xd7, 0x29, 0x7f, 0xf9, 0x12, 0x78, 0x25, 0x9f, 0x6b, 0x6a, 0xbd, 0xe0, 0xc6, 0x1c,
+	0xb5, 0xa2, 0x66, 0x6a, 0x35, 0xfb, 0xf1, 0xd2, 0xe7, 0x63, 0xc7, 0xf8, 0x7e, 0xec, 0x18, 0xbd,
+	0x4f, 0x0d, 0xd0, 0x7c, 0x1d, 0x16, 0x21, 0xe1, 0xf0, 0x3e, 0xe8, 0x84, 0x59, 0xc6, 0x3e, 0xa2,
+	0x24, 0x50, 0x01, 0xb9, 0x65, 0x76, 0x17, 0xfa, 0x2d, 0x7f, 0x59, 0xc3, 0x6a, 0x9d, 0x1c, 0xee,
+	0x82, 0x75, 0x82, 0x69, 0x50, 0xe6, 0x49, 0x28, 0x50, 0x10, 0x65, 0x2c, 0xfe, 0x10, 0x60, 0x2a,
+	0x50, 0x31, 0x0e, 0x33, 0xe5, 0xc2, 0x5f, 0x23, 0x98, 0xbe, 0x95, 0xfc, 0xb0, 0xa2, 0xf7, 0x35,
+	0x0b, 0xb7, 0x81, 0x75, 0x45, 0x2a, 0x30, 0x41, 0x33, 0xe5, 0x82, 0x54, 0xae, 0x4e, 0x95, 0x07,
+	0x98, 0xa0, 0xa9, 0xf0, 0x10, 0xac, 0x69, 0x51, 0x1a, 0xf2, 0x80, 0x94, 0x99, 0xc0, 0x79, 0x86,
+	0x51, 0xc1, 0xad, 0x45, 0x79, 0x3b, 0xf7, 0xe6, 0xdd, 0xce, 0xf3, 0x90, 0xbf, 0x9c, 0x56, 0xea,
+	0x2b, 0x59, 0x51, 0x6d, 0x6a, 0x14, 0xef, 0xe5, 0xe0, 0x66, 0x0d, 0x81, 0x0e, 0xd0, 0xef, 0x78,
+	0x20, 0x26, 0x39, 0xd2, 0xaf, 0x09, 0x50, 0xd0, 0xc1, 0x24, 0x47, 0xf0, 0x2e, 0x68, 0xd1, 0x92,
+	0xa0, 0x22, 0x14, 0xac, 0xd0, 0xa1, 0x67, 0x00, 0xec, 0x82, 0x76, 0x82, 0x28, 0x23, 0x98, 0x4a,
+	0x5e, 0x45, 0xbb, 0x0a, 0x0d, 0xfd, 0x93, 0x73, 0xdb, 0x3c, 0x3d, 0xb7, 0xcd, 0x9f, 0xe7, 0xb6,
+	0xf9, 0xf5, 0xc2, 0x36, 0x4e, 0x2f, 0x6c, 0xe3, 0xc7, 0x85, 0x6d, 0xbc, 0xdf, 0x49, 0xb1, 0x18,
+	0x95, 0x91, 0x1b, 0x33, 0xe2, 0xc5, 0x8c, 0x13, 0xc6, 0x3d, 0x1c, 0xc5, 0x9b, 0x29, 0xf3, 0xc6,
+	0xbb, 0x1e, 0x61, 0x49, 0x99, 0x21, 0xae, 0x7e, 0x22, 0x0f, 0x07, 0x9b, 0xfa, 0x3f, 0x52, 0x99,
+	0xe4, 0x51, 0x53, 0x7e, 0x11, 0x8f, 0x7e, 0x0f, 0x00, 0x43, 0x3d, 0x70, 0x30, 0x67, 0x04, 0x00,
+	0x00,
 }
 
 func (m *IdentifiedClientState) Marshal() (dAtA []byte, err error) {
@@ -519,6 +625,30 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.UpdateGasMultipliers) > 0 {
+		for iNdEx := len(m.UpdateGasMultipliers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UpdateGasMultipliers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintClient(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.MinUpdateTimeInterval != 0 {
+		i = encodeVarintClient(dAtA, i, uint64(m.MinUpdateTimeInterval))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MinUpdateBlockInterval != 0 {
+		i = encodeVarintClient(dAtA, i, uint64(m.MinUpdateBlockInterval))
+		i--
+		dAtA[i] = 0x10
+	}
 	if len(m.AllowedClients) > 0 {
 		for iNdEx := len(m.AllowedClients) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.AllowedClients[iNdEx])
@@ -531,6 +661,46 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *GasMultiplier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GasMultiplier) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GasMultiplier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Denominator != 0 {
+		i = encodeVarintClient(dAtA, i, uint64(m.Denominator))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Numerator != 0 {
+		i = encodeVarintClient(dAtA, i, uint64(m.Numerator))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ClientType) > 0 {
+		i -= len(m.ClientType)
+		copy(dAtA[i:], m.ClientType)
+		i = encodeVarintClient(dAtA, i, uint64(len(m.ClientType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintClient(dAtA []byte, offset int, v uint64) int {
 	offset -= sovClient(v)
 	base := offset
@@ -620,6 +790,37 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovClient(uint64(l))
 		}
 	}
+	if m.MinUpdateBlockInterval != 0 {
+		n += 1 + sovClient(uint64(m.MinUpdateBlockInterval))
+	}
+	if m.MinUpdateTimeInterval != 0 {
+		n += 1 + sovClient(uint64(m.MinUpdateTimeInterval))
+	}
+	if len(m.UpdateGasMultipliers) > 0 {
+		for _, e := range m.UpdateGasMultipliers {
+			l = e.Size()
+			n += 1 + l + sovClient(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GasMultiplier) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientType)
+	if l > 0 {
+		n += 1 + l + sovClient(uint64(l))
+	}
+	if m.Numerator != 0 {
+		n += 1 + sovClient(uint64(m.Numerator))
+	}
+	if m.Denominator != 0 {
+		n += 1 + sovClient(uint64(m.Denominator))
+	}
 	return n
 }
 
@@ -1131,6 +1332,198 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.AllowedClients = append(m.AllowedClients, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinUpdateBlockInterval", wireType)
+			}
+			m.MinUpdateBlockInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinUpdateBlockInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinUpdateTimeInterval", wireType)
+			}
+			m.MinUpdateTimeInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinUpdateTimeInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateGasMultipliers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClient
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdateGasMultipliers = append(m.UpdateGasMultipliers, GasMultiplier{})
+			if err := m.UpdateGasMultipliers[len(m.UpdateGasMultipliers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClient(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthClient
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GasMultiplier) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClient
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GasMultiplier: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GasMultiplier: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClient
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Numerator", wireType)
+			}
+			m.Numerator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Numerator |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denominator", wireType)
+			}
+			m.Denominator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Denominator |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipClient(dAtA[iNdEx:])
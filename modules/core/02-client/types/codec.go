@@ -43,6 +43,7 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgUpgradeClient{},
 		&MsgSubmitMisbehaviour{},
 		&MsgRecoverClient{},
+		&MsgMigrateClientType{},
 		&MsgIBCSoftwareUpgrade{},
 		&MsgUpdateParams{},
 	)
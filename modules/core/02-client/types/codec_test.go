@@ -196,6 +196,11 @@ func (suite *TypesTestSuite) TestCodecTypeRegistration() {
 			sdk.MsgTypeURL(&types.MsgRecoverClient{}),
 			true,
 		},
+		{
+			"success: MsgMigrateClientType",
+			sdk.MsgTypeURL(&types.MsgMigrateClientType{}),
+			true,
+		},
 		{
 			"success: MsgIBCSoftwareUpgrade",
 			sdk.MsgTypeURL(&types.MsgIBCSoftwareUpgrade{}),
@@ -8,14 +8,15 @@ import (
 
 // IBC client events
 const (
-	AttributeKeyClientID          = "client_id"
-	AttributeKeySubjectClientID   = "subject_client_id"
-	AttributeKeyClientType        = "client_type"
-	AttributeKeyConsensusHeight   = "consensus_height"
-	AttributeKeyConsensusHeights  = "consensus_heights"
-	AttributeKeyUpgradeStore      = "upgrade_store"
-	AttributeKeyUpgradePlanHeight = "upgrade_plan_height"
-	AttributeKeyUpgradePlanTitle  = "title"
+	AttributeKeyClientID           = "client_id"
+	AttributeKeySubjectClientID    = "subject_client_id"
+	AttributeKeySubstituteClientID = "substitute_client_id"
+	AttributeKeyClientType         = "client_type"
+	AttributeKeyConsensusHeight    = "consensus_height"
+	AttributeKeyConsensusHeights   = "consensus_heights"
+	AttributeKeyUpgradeStore       = "upgrade_store"
+	AttributeKeyUpgradePlanHeight  = "upgrade_plan_height"
+	AttributeKeyUpgradePlanTitle   = "title"
 )
 
 // IBC client events vars
@@ -25,6 +26,7 @@ var (
 	EventTypeUpgradeClient              = "upgrade_client"
 	EventTypeSubmitMisbehaviour         = "client_misbehaviour"
 	EventTypeRecoverClient              = "recover_client"
+	EventTypeMigrateClientType          = "migrate_client_type"
 	EventTypeScheduleIBCSoftwareUpgrade = "schedule_ibc_software_upgrade"
 	EventTypeUpgradeChain               = "upgrade_chain"
 
@@ -115,6 +115,19 @@ func (gs GenesisState) Validate() error {
 		return err
 	}
 
+	migratedClientTypes := make(map[string]string)
+	for _, migratedClientType := range gs.MigratedClientTypes {
+		if err := migratedClientType.Validate(); err != nil {
+			return err
+		}
+
+		if _, found := migratedClientTypes[migratedClientType.ClientId]; found {
+			return fmt.Errorf("duplicate migrated client type for client %s", migratedClientType.ClientId)
+		}
+
+		migratedClientTypes[migratedClientType.ClientId] = migratedClientType.ClientType
+	}
+
 	validClients := make(map[string]string)
 
 	for i, client := range gs.Clients {
@@ -139,6 +152,10 @@ func (gs GenesisState) Validate() error {
 			return err
 		}
 
+		if migratedClientType, found := migratedClientTypes[client.ClientId]; found {
+			clientType = migratedClientType
+		}
+
 		if clientType != clientState.ClientType() {
 			return fmt.Errorf("client state type %s does not equal client type in client identifier %s", clientState.ClientType(), clientType)
 		}
@@ -199,6 +216,13 @@ func (gs GenesisState) Validate() error {
 
 	}
 
+	for clientID := range migratedClientTypes {
+		// check that the migrated client type is for a client in the genesis clients list
+		if _, ok := validClients[clientID]; !ok {
+			return fmt.Errorf("migrated client type in genesis has a client id %s that does not map to a genesis client", clientID)
+		}
+	}
+
 	if maxSequence != 0 && maxSequence >= gs.NextClientSequence {
 		return fmt.Errorf("next client identifier sequence %d must be greater than the maximum sequence used in the provided client identifiers %d", gs.NextClientSequence, maxSequence)
 	}
@@ -235,6 +259,37 @@ func (gm GenesisMetadata) Validate() error {
 	return nil
 }
 
+// NewMigratedClientType creates a new MigratedClientType instance.
+func NewMigratedClientType(clientID, clientType string) MigratedClientType {
+	return MigratedClientType{
+		ClientId:   clientID,
+		ClientType: clientType,
+	}
+}
+
+// Validate performs basic validation of the client identifier and migrated client type. The migrated
+// client type must differ from the client type encoded in the client identifier.
+func (mct MigratedClientType) Validate() error {
+	if err := host.ClientIdentifierValidator(mct.ClientId); err != nil {
+		return err
+	}
+
+	if err := ValidateClientType(mct.ClientType); err != nil {
+		return err
+	}
+
+	clientType, _, err := ParseClientIdentifier(mct.ClientId)
+	if err != nil {
+		return err
+	}
+
+	if clientType == mct.ClientType {
+		return fmt.Errorf("migrated client type %s must differ from the client type in client identifier %s", mct.ClientType, mct.ClientId)
+	}
+
+	return nil
+}
+
 // NewIdentifiedGenesisMetadata takes in a client ID and list of genesis metadata for that client
 // and constructs a new IdentifiedGenesisMetadata.
 func NewIdentifiedGenesisMetadata(clientID string, gms []GenesisMetadata) IdentifiedGenesisMetadata {
@@ -37,6 +37,9 @@ type GenesisState struct {
 	CreateLocalhost bool `protobuf:"varint,5,opt,name=create_localhost,json=createLocalhost,proto3" json:"create_localhost,omitempty"` // Deprecated: Do not use.
 	// the sequence for the next generated client identifier
 	NextClientSequence uint64 `protobuf:"varint,6,opt,name=next_client_sequence,json=nextClientSequence,proto3" json:"next_client_sequence,omitempty"`
+	// client types of clients which have been migrated to a client type different
+	// from the client type encoded in their client identifier
+	MigratedClientTypes []MigratedClientType `protobuf:"bytes,7,rep,name=migrated_client_types,json=migratedClientTypes,proto3" json:"migrated_client_types"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -115,6 +118,69 @@ func (m *GenesisState) GetNextClientSequence() uint64 {
 	return 0
 }
 
+func (m *GenesisState) GetMigratedClientTypes() []MigratedClientType {
+	if m != nil {
+		return m.MigratedClientTypes
+	}
+	return nil
+}
+
+// MigratedClientType defines the client type of a client which has been migrated
+// to a client type different from the one encoded in its client identifier.
+type MigratedClientType struct {
+	// client identifier
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// client type the client has been migrated to
+	ClientType string `protobuf:"bytes,2,opt,name=client_type,json=clientType,proto3" json:"client_type,omitempty"`
+}
+
+func (m *MigratedClientType) Reset()         { *m = MigratedClientType{} }
+func (m *MigratedClientType) String() string { return proto.CompactTextString(m) }
+func (*MigratedClientType) ProtoMessage()    {}
+func (*MigratedClientType) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcd0c0f1f2e6a91a, []int{1}
+}
+func (m *MigratedClientType) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MigratedClientType) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MigratedClientType.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MigratedClientType) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MigratedClientType.Merge(m, src)
+}
+func (m *MigratedClientType) XXX_Size() int {
+	return m.Size()
+}
+func (m *MigratedClientType) XXX_DiscardUnknown() {
+	xxx_messageInfo_MigratedClientType.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MigratedClientType proto.InternalMessageInfo
+
+func (m *MigratedClientType) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+func (m *MigratedClientType) GetClientType() string {
+	if m != nil {
+		return m.ClientType
+	}
+	return ""
+}
+
 // GenesisMetadata defines the genesis type for metadata that will be used
 // to export all client store keys that are not client or consensus states.
 type GenesisMetadata struct {
@@ -128,7 +194,7 @@ func (m *GenesisMetadata) Reset()         { *m = GenesisMetadata{} }
 func (m *GenesisMetadata) String() string { return proto.CompactTextString(m) }
 func (*GenesisMetadata) ProtoMessage()    {}
 func (*GenesisMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bcd0c0f1f2e6a91a, []int{1}
+	return fileDescriptor_bcd0c0f1f2e6a91a, []int{2}
 }
 func (m *GenesisMetadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -168,7 +234,7 @@ func (m *IdentifiedGenesisMetadata) Reset()         { *m = IdentifiedGenesisMeta
 func (m *IdentifiedGenesisMetadata) String() string { return proto.CompactTextString(m) }
 func (*IdentifiedGenesisMetadata) ProtoMessage()    {}
 func (*IdentifiedGenesisMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bcd0c0f1f2e6a91a, []int{2}
+	return fileDescriptor_bcd0c0f1f2e6a91a, []int{3}
 }
 func (m *IdentifiedGenesisMetadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -213,6 +279,7 @@ func (m *IdentifiedGenesisMetadata) GetClientMetadata() []GenesisMetadata {
 
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "ibc.core.client.v1.GenesisState")
+	proto.RegisterType((*MigratedClientType)(nil), "ibc.core.client.v1.MigratedClientType")
 	proto.RegisterType((*GenesisMetadata)(nil), "ibc.core.client.v1.GenesisMetadata")
 	proto.RegisterType((*IdentifiedGenesisMetadata)(nil), "ibc.core.client.v1.IdentifiedGenesisMetadata")
 }
@@ -220,37 +287,41 @@ func init() {
 func init() { proto.RegisterFile("ibc/core/client/v1/genesis.proto", fileDescriptor_bcd0c0f1f2e6a91a) }
 
 var fileDescriptor_bcd0c0f1f2e6a91a = []byte{
-	// 479 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x53, 0x31, 0x6f, 0xd3, 0x40,
-	0x18, 0xf5, 0x25, 0x69, 0x68, 0xaf, 0x15, 0x09, 0xa7, 0x08, 0x99, 0x20, 0x39, 0x56, 0x58, 0xcc,
-	0x10, 0xbb, 0x0d, 0x4b, 0x61, 0x41, 0x4a, 0x07, 0x54, 0x09, 0x24, 0x64, 0x36, 0x06, 0x2c, 0xfb,
-	0xfc, 0xe1, 0x5a, 0xd8, 0xbe, 0x90, 0x3b, 0x5b, 0xf4, 0x1f, 0x30, 0x30, 0xf0, 0x13, 0x98, 0xf9,
-	0x25, 0x1d, 0x3b, 0x32, 0x01, 0x4a, 0x24, 0x7e, 0x07, 0xf2, 0xdd, 0x99, 0x4a, 0xc1, 0xe9, 0xf6,
-	0xe5, 0xbd, 0xf7, 0xbd, 0x97, 0x7b, 0xd6, 0x87, 0xed, 0x34, 0xa2, 0x1e, 0x65, 0x2b, 0xf0, 0x68,
-	0x96, 0x42, 0x21, 0xbc, 0xea, 0xc4, 0x4b, 0xa0, 0x00, 0x9e, 0x72, 0x77, 0xb9, 0x62, 0x82, 0x11,
-	0x92, 0x46, 0xd4, 0xad, 0x15, 0xae, 0x52, 0xb8, 0xd5, 0xc9, 0x78, 0xd2, 0xb2, 0xa5, 0x59, 0xb9,
-	0x34, 0x1e, 0x25, 0x2c, 0x61, 0x72, 0xf4, 0xea, 0x49, 0xa1, 0xd3, 0x3f, 0x5d, 0x7c, 0xf4, 0x42,
-	0x99, 0xbf, 0x11, 0xa1, 0x00, 0x42, 0xf1, 0x1d, 0xb5, 0xc6, 0x4d, 0x64, 0x77, 0x9d, 0xc3, 0xf9,
-	0x63, 0xf7, 0xff, 0x34, 0xf7, 0x3c, 0x86, 0x42, 0xa4, 0xef, 0x53, 0x88, 0xcf, 0x24, 0x26, 0x77,
-	0x17, 0xd6, 0xd5, 0xcf, 0x89, 0xf1, 0xfd, 0xd7, 0xe4, 0x7e, 0x2b, 0xcd, 0xfd, 0xc6, 0x99, 0x54,
-	0xf8, 0x9e, 0x1e, 0x03, 0xca, 0x0a, 0x0e, 0x05, 0x2f, 0xb9, 0xd9, 0xd9, 0x1d, 0xa7, 0x5c, 0xce,
-	0x1a, 0xa9, 0xb2, 0xbb, 0x89, 0x53, 0x34, 0xdf, 0xe2, 0xfd, 0x21, 0xdd, 0xc2, 0xc9, 0x3b, 0xdc,
-	0x60, 0x41, 0x0e, 0x22, 0x8c, 0x43, 0x11, 0x9a, 0x5d, 0x19, 0x3b, 0xbb, 0xfd, 0x95, 0xba, 0xa2,
-	0x57, 0x7a, 0x69, 0xd1, 0xab, 0xa3, 0xfd, 0x81, 0x36, 0x6b, 0x60, 0x72, 0x8a, 0xfb, 0xcb, 0x70,
-	0x15, 0xe6, 0xdc, 0xec, 0xd9, 0xc8, 0x39, 0x9c, 0x8f, 0xdb, 0x5c, 0x5f, 0x4b, 0x85, 0xb6, 0xd0,
-	0x7a, 0x32, 0xc3, 0x43, 0xba, 0x82, 0x50, 0x40, 0x90, 0x31, 0x1a, 0x66, 0x17, 0x8c, 0x0b, 0x73,
-	0xcf, 0x46, 0xce, 0xfe, 0xa2, 0x63, 0x22, 0x7f, 0xa0, 0xb8, 0x97, 0x0d, 0x45, 0x8e, 0xf1, 0xa8,
-	0x80, 0x4f, 0x22, 0x50, 0xae, 0x01, 0x87, 0x8f, 0x25, 0x14, 0x14, 0xcc, 0xbe, 0x8d, 0x9c, 0x9e,
-	0x4f, 0x6a, 0x4e, 0x37, 0xaf, 0x99, 0xe9, 0x73, 0x3c, 0xd8, 0x7a, 0x04, 0x19, 0xe2, 0xee, 0x07,
-	0xb8, 0x34, 0x91, 0x8d, 0x9c, 0x23, 0xbf, 0x1e, 0xc9, 0x08, 0xef, 0x55, 0x61, 0x56, 0x82, 0xd9,
-	0x91, 0x98, 0xfa, 0xf1, 0xac, 0xf7, 0xf9, 0xdb, 0xc4, 0x98, 0x7e, 0x41, 0xf8, 0xc1, 0xce, 0x42,
-	0xc8, 0x43, 0x7c, 0xa0, 0xff, 0x4b, 0x1a, 0x4b, 0xc7, 0x03, 0x7f, 0x5f, 0x01, 0xe7, 0x31, 0xf1,
-	0xb1, 0x6e, 0xea, 0xa6, 0x75, 0xf5, 0xb1, 0x1f, 0xb5, 0xf5, 0xd3, 0xde, 0xf5, 0x5d, 0x25, 0xf8,
-	0x87, 0xfa, 0x57, 0x6b, 0x0b, 0x5d, 0xaf, 0x2d, 0xf4, 0x7b, 0x6d, 0xa1, 0xaf, 0x1b, 0xcb, 0xb8,
-	0xde, 0x58, 0xc6, 0x8f, 0x8d, 0x65, 0xbc, 0x3d, 0x4d, 0x52, 0x71, 0x51, 0x46, 0x2e, 0x65, 0xb9,
-	0x47, 0x19, 0xcf, 0x19, 0xf7, 0xd2, 0x88, 0xce, 0x12, 0xe6, 0x55, 0x4f, 0xbd, 0x9c, 0xc5, 0x65,
-	0x06, 0x5c, 0x5d, 0xca, 0xf1, 0x7c, 0xa6, 0x8f, 0x45, 0x5c, 0x2e, 0x81, 0x47, 0x7d, 0x79, 0x13,
-	0x4f, 0xfe, 0x06, 0x00, 0x00, 0xff, 0xff, 0x27, 0x7e, 0x06, 0xb7, 0x82, 0x03, 0x00, 0x00,
+	// 532 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x53, 0x41, 0x6f, 0x12, 0x4d,
+	0x18, 0x66, 0x80, 0xd2, 0x76, 0x20, 0x1f, 0x7c, 0x23, 0x9a, 0x15, 0x93, 0x65, 0x83, 0x89, 0xc1,
+	0x03, 0xbb, 0x2d, 0x5e, 0xaa, 0x17, 0x13, 0x7a, 0x30, 0x4d, 0x6c, 0x62, 0x46, 0x4f, 0x1e, 0xc4,
+	0x65, 0xf6, 0x75, 0x3b, 0x91, 0xdd, 0x41, 0x66, 0x20, 0xf2, 0x0f, 0x3c, 0x78, 0xf0, 0x27, 0x78,
+	0xf6, 0x97, 0x70, 0xec, 0xd1, 0x93, 0x1a, 0xf8, 0x23, 0x86, 0x99, 0xd9, 0xd6, 0xc0, 0xb6, 0xb7,
+	0x77, 0x9f, 0xe7, 0x7d, 0x9e, 0xe7, 0xdd, 0x77, 0x66, 0xb0, 0xc7, 0x47, 0x2c, 0x60, 0x62, 0x0a,
+	0x01, 0x1b, 0x73, 0x48, 0x55, 0x30, 0x3f, 0x0e, 0x62, 0x48, 0x41, 0x72, 0xe9, 0x4f, 0xa6, 0x42,
+	0x09, 0x42, 0xf8, 0x88, 0xf9, 0x9b, 0x0e, 0xdf, 0x74, 0xf8, 0xf3, 0xe3, 0x56, 0x3b, 0x47, 0x65,
+	0x59, 0x2d, 0x6a, 0x35, 0x63, 0x11, 0x0b, 0x5d, 0x06, 0x9b, 0xca, 0xa0, 0x9d, 0x65, 0x19, 0xd7,
+	0x5e, 0x18, 0xf3, 0xd7, 0x2a, 0x54, 0x40, 0x18, 0xde, 0x37, 0x32, 0xe9, 0x20, 0xaf, 0xd4, 0xad,
+	0xf6, 0x1f, 0xfb, 0xbb, 0x69, 0xfe, 0x59, 0x04, 0xa9, 0xe2, 0x1f, 0x38, 0x44, 0xa7, 0x1a, 0xd3,
+	0xda, 0x81, 0xbb, 0xfc, 0xd5, 0x2e, 0xfc, 0xf8, 0xdd, 0xbe, 0x97, 0x4b, 0x4b, 0x9a, 0x39, 0x93,
+	0x39, 0xfe, 0xdf, 0x96, 0x43, 0x26, 0x52, 0x09, 0xa9, 0x9c, 0x49, 0xa7, 0x78, 0x73, 0x9c, 0x71,
+	0x39, 0xcd, 0x5a, 0x8d, 0xdd, 0x75, 0x9c, 0xa1, 0xe5, 0x16, 0x4f, 0x1b, 0x6c, 0x0b, 0x27, 0xef,
+	0x70, 0x86, 0x0d, 0x13, 0x50, 0x61, 0x14, 0xaa, 0xd0, 0x29, 0xe9, 0xd8, 0xde, 0xed, 0x7f, 0x69,
+	0x57, 0x74, 0x6e, 0x45, 0x83, 0xf2, 0x26, 0x9a, 0xd6, 0xad, 0x59, 0x06, 0x93, 0x13, 0x5c, 0x99,
+	0x84, 0xd3, 0x30, 0x91, 0x4e, 0xd9, 0x43, 0xdd, 0x6a, 0xbf, 0x95, 0xe7, 0xfa, 0x4a, 0x77, 0x58,
+	0x0b, 0xdb, 0x4f, 0x7a, 0xb8, 0xc1, 0xa6, 0x10, 0x2a, 0x18, 0x8e, 0x05, 0x0b, 0xc7, 0x17, 0x42,
+	0x2a, 0x67, 0xcf, 0x43, 0xdd, 0x83, 0x41, 0xd1, 0x41, 0xb4, 0x6e, 0xb8, 0x97, 0x19, 0x45, 0x8e,
+	0x70, 0x33, 0x85, 0xcf, 0x6a, 0x68, 0x5c, 0x87, 0x12, 0x3e, 0xcd, 0x20, 0x65, 0xe0, 0x54, 0x3c,
+	0xd4, 0x2d, 0x53, 0xb2, 0xe1, 0xec, 0xe6, 0x2d, 0x43, 0xde, 0xe3, 0xbb, 0x09, 0x8f, 0xa7, 0xa1,
+	0x82, 0x28, 0x53, 0xa9, 0xc5, 0x04, 0xa4, 0xb3, 0xaf, 0xff, 0xff, 0x51, 0xde, 0xa4, 0xe7, 0x56,
+	0x60, 0xac, 0xde, 0x2c, 0x26, 0x60, 0xa7, 0xbe, 0x93, 0xec, 0x30, 0xb2, 0x43, 0x31, 0xd9, 0x15,
+	0x90, 0x07, 0xf8, 0xd0, 0xc6, 0xf1, 0xc8, 0x41, 0x1e, 0xea, 0x1e, 0xd2, 0x03, 0x03, 0x9c, 0x45,
+	0xa4, 0x8d, 0xab, 0xff, 0xcc, 0xe2, 0x14, 0x35, 0x8d, 0xd9, 0x95, 0xba, 0xf3, 0x1c, 0xd7, 0xb7,
+	0x56, 0x4f, 0x1a, 0xb8, 0xf4, 0x11, 0x16, 0xda, 0xaa, 0x46, 0x37, 0x25, 0x69, 0xe2, 0xbd, 0x79,
+	0x38, 0x9e, 0x19, 0x7d, 0x8d, 0x9a, 0x8f, 0x67, 0xe5, 0x2f, 0xdf, 0xdb, 0x85, 0xce, 0x57, 0x84,
+	0xef, 0xdf, 0x78, 0x8c, 0xb7, 0x0f, 0x47, 0xb1, 0x3d, 0xdf, 0xeb, 0xbb, 0x62, 0xae, 0xe8, 0xc3,
+	0xbc, 0x5d, 0xe5, 0xdf, 0x90, 0xff, 0x4c, 0xc3, 0x15, 0x4a, 0x97, 0x2b, 0x17, 0x5d, 0xae, 0x5c,
+	0xf4, 0x67, 0xe5, 0xa2, 0x6f, 0x6b, 0xb7, 0x70, 0xb9, 0x76, 0x0b, 0x3f, 0xd7, 0x6e, 0xe1, 0xed,
+	0x49, 0xcc, 0xd5, 0xc5, 0x6c, 0xe4, 0x33, 0x91, 0x04, 0x4c, 0xc8, 0x44, 0xc8, 0x80, 0x8f, 0x58,
+	0x2f, 0x16, 0xc1, 0xfc, 0x69, 0x90, 0x88, 0x68, 0x36, 0x06, 0x69, 0xde, 0xf7, 0x51, 0xbf, 0x67,
+	0x9f, 0xb8, 0x3e, 0xc0, 0x51, 0x45, 0xbf, 0xe4, 0x27, 0x7f, 0x07, 0x00, 0xd8, 0xfe, 0x97, 0x10,
+	0x38, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -273,6 +344,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.MigratedClientTypes) > 0 {
+		for iNdEx := len(m.MigratedClientTypes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MigratedClientTypes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	if m.NextClientSequence != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.NextClientSequence))
 		i--
@@ -343,6 +428,43 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MigratedClientType) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MigratedClientType) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MigratedClientType) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClientType) > 0 {
+		i -= len(m.ClientType)
+		copy(dAtA[i:], m.ClientType)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ClientType)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *GenesisMetadata) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -467,6 +589,29 @@ func (m *GenesisState) Size() (n int) {
 	if m.NextClientSequence != 0 {
 		n += 1 + sovGenesis(uint64(m.NextClientSequence))
 	}
+	if len(m.MigratedClientTypes) > 0 {
+		for _, e := range m.MigratedClientTypes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MigratedClientType) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.ClientType)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -715,6 +860,154 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MigratedClientTypes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MigratedClientTypes = append(m.MigratedClientTypes, MigratedClientType{})
+			if err := m.MigratedClientTypes[len(m.MigratedClientTypes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MigratedClientType) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MigratedClientType: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MigratedClientType: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -472,6 +472,56 @@ func (suite *TypesTestSuite) TestValidateGenesis() {
 			),
 			expPass: false,
 		},
+		{
+			name: "valid genesis with migrated client type",
+			genState: types.GenesisState{
+				Clients: []types.IdentifiedClientState{
+					types.NewIdentifiedClientState(tmClientID0, solomachine.NewClientState(1, &solomachine.ConsensusState{PublicKey: suite.solomachine.ConsensusState().PublicKey, Diversifier: suite.solomachine.Diversifier, Timestamp: suite.solomachine.Time})),
+				},
+				Params:              types.NewParams(exported.Tendermint, exported.Solomachine),
+				NextClientSequence:  1,
+				MigratedClientTypes: []types.MigratedClientType{types.NewMigratedClientType(tmClientID0, exported.Solomachine)},
+			},
+			expPass: true,
+		},
+		{
+			name: "migrated client type does not map to a genesis client",
+			genState: types.GenesisState{
+				Params:              types.NewParams(exported.Tendermint, exported.Solomachine),
+				NextClientSequence:  1,
+				MigratedClientTypes: []types.MigratedClientType{types.NewMigratedClientType(tmClientID0, exported.Solomachine)},
+			},
+			expPass: false,
+		},
+		{
+			name: "migrated client type equals client type in client identifier",
+			genState: types.GenesisState{
+				Clients: []types.IdentifiedClientState{
+					types.NewIdentifiedClientState(
+						tmClientID0, ibctm.NewClientState(suite.chainA.ChainID, ibctm.DefaultTrustLevel, ibctesting.TrustingPeriod, ibctesting.UnbondingPeriod, ibctesting.MaxClockDrift, clientHeight, commitmenttypes.GetSDKSpecs(), ibctesting.UpgradePath),
+					),
+				},
+				Params:              types.NewParams(exported.Tendermint),
+				NextClientSequence:  1,
+				MigratedClientTypes: []types.MigratedClientType{types.NewMigratedClientType(tmClientID0, exported.Tendermint)},
+			},
+			expPass: false,
+		},
+		{
+			name: "duplicate migrated client types",
+			genState: types.GenesisState{
+				Clients: []types.IdentifiedClientState{
+					types.NewIdentifiedClientState(tmClientID0, solomachine.NewClientState(0, &solomachine.ConsensusState{PublicKey: suite.solomachine.ConsensusState().PublicKey, Diversifier: suite.solomachine.Diversifier, Timestamp: suite.solomachine.Time})),
+				},
+				Params:             types.NewParams(exported.Tendermint, exported.Solomachine),
+				NextClientSequence: 1,
+				MigratedClientTypes: []types.MigratedClientType{
+					types.NewMigratedClientType(tmClientID0, exported.Solomachine),
+					types.NewMigratedClientType(tmClientID0, exported.Solomachine),
+				},
+			},
+			expPass: false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -29,6 +29,14 @@ const (
 	// ParamsKey is the store key for the IBC client parameters
 	ParamsKey = "clientParams"
 
+	// KeyMigratedClientTypePrefix is the store key prefix for the client types of clients which
+	// have been migrated to a client type different from the one encoded in their client identifier.
+	KeyMigratedClientTypePrefix = "migratedClientTypes"
+
+	// KeyClientLastUpdatePrefix is the store key prefix for the block height and time of the last
+	// accepted update of a client, used to enforce the client update rate limit.
+	KeyClientLastUpdatePrefix = "clientLastUpdate"
+
 	// AllowAllClients is the value that if set in AllowedClients param
 	// would allow any wired up light client modules to be allowed
 	AllowAllClients = "*"
@@ -40,6 +48,21 @@ func FormatClientIdentifier(clientType string, sequence uint64) string {
 	return fmt.Sprintf("%s-%d", clientType, sequence)
 }
 
+// MigratedClientTypeKey returns the store key under which the client type of a migrated client is stored.
+func MigratedClientTypeKey(clientID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s", KeyMigratedClientTypePrefix, clientID))
+}
+
+// ClientLastUpdateHeightKey returns the store key under which the block height of the last accepted update of a client is stored.
+func ClientLastUpdateHeightKey(clientID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/height", KeyClientLastUpdatePrefix, clientID))
+}
+
+// ClientLastUpdateTimeKey returns the store key under which the block time of the last accepted update of a client is stored.
+func ClientLastUpdateTimeKey(clientID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/time", KeyClientLastUpdatePrefix, clientID))
+}
+
 // IsClientIDFormat checks if a clientID is in the format required on the SDK for
 // parsing client identifiers. The client identifier must be in the form: `{client-type}-{N}
 // which per the specification only permits ASCII for the {client-type} segment and
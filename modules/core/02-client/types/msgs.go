@@ -20,6 +20,7 @@ var (
 	_ sdk.Msg = (*MsgUpdateParams)(nil)
 	_ sdk.Msg = (*MsgIBCSoftwareUpgrade)(nil)
 	_ sdk.Msg = (*MsgRecoverClient)(nil)
+	_ sdk.Msg = (*MsgMigrateClientType)(nil)
 
 	_ sdk.HasValidateBasic = (*MsgCreateClient)(nil)
 	_ sdk.HasValidateBasic = (*MsgUpdateClient)(nil)
@@ -28,6 +29,7 @@ var (
 	_ sdk.HasValidateBasic = (*MsgUpdateParams)(nil)
 	_ sdk.HasValidateBasic = (*MsgIBCSoftwareUpgrade)(nil)
 	_ sdk.HasValidateBasic = (*MsgRecoverClient)(nil)
+	_ sdk.HasValidateBasic = (*MsgMigrateClientType)(nil)
 
 	_ codectypes.UnpackInterfacesMessage = (*MsgCreateClient)(nil)
 	_ codectypes.UnpackInterfacesMessage = (*MsgUpdateClient)(nil)
@@ -265,6 +267,36 @@ func (msg *MsgRecoverClient) ValidateBasic() error {
 	return nil
 }
 
+// NewMsgMigrateClientType creates a new MsgMigrateClientType instance
+func NewMsgMigrateClientType(signer, subjectClientID, substituteClientID string) *MsgMigrateClientType {
+	return &MsgMigrateClientType{
+		Signer:             signer,
+		SubjectClientId:    subjectClientID,
+		SubstituteClientId: substituteClientID,
+	}
+}
+
+// ValidateBasic performs basic checks on a MsgMigrateClientType.
+func (msg *MsgMigrateClientType) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	if err := host.ClientIdentifierValidator(msg.SubjectClientId); err != nil {
+		return err
+	}
+
+	if err := host.ClientIdentifierValidator(msg.SubstituteClientId); err != nil {
+		return err
+	}
+
+	if msg.SubjectClientId == msg.SubstituteClientId {
+		return errorsmod.Wrapf(ErrInvalidSubstitute, "subject and substitute clients must be different")
+	}
+
+	return nil
+}
+
 // NewMsgIBCSoftwareUpgrade creates a new MsgIBCSoftwareUpgrade instance
 func NewMsgIBCSoftwareUpgrade(signer string, plan upgradetypes.Plan, upgradedClientState exported.ClientState) (*MsgIBCSoftwareUpgrade, error) {
 	anyClient, err := PackClientState(upgradedClientState)
@@ -714,6 +714,96 @@ func TestMsgRecoverClientGetSigners(t *testing.T) {
 	}
 }
 
+func (suite *TypesTestSuite) TestMsgMigrateClientTypeValidateBasic() {
+	var msg *types.MsgMigrateClientType
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success: valid signer and client identifiers",
+			func() {},
+			nil,
+		},
+		{
+			"failure: invalid signer address",
+			func() {
+				msg.Signer = "invalid"
+			},
+			ibcerrors.ErrInvalidAddress,
+		},
+		{
+			"failure: invalid subject client ID",
+			func() {
+				msg.SubjectClientId = ""
+			},
+			host.ErrInvalidID,
+		},
+		{
+			"failure: invalid substitute client ID",
+			func() {
+				msg.SubstituteClientId = ""
+			},
+			host.ErrInvalidID,
+		},
+		{
+			"failure: subject and substitute client IDs are the same",
+			func() {
+				msg.SubstituteClientId = ibctesting.FirstClientID
+			},
+			types.ErrInvalidSubstitute,
+		},
+	}
+
+	for _, tc := range testCases {
+		msg = types.NewMsgMigrateClientType(
+			ibctesting.TestAccAddress,
+			ibctesting.FirstClientID,
+			"08-wasm-0",
+		)
+
+		tc.malleate()
+
+		err := msg.ValidateBasic()
+		expPass := tc.expError == nil
+		if expPass {
+			suite.Require().NoError(err, "valid case %s failed", tc.name)
+		} else {
+			suite.Require().Error(err, "invalid case %s passed", tc.name)
+			suite.Require().ErrorIs(err, tc.expError, "invalid case %s passed", tc.name)
+		}
+	}
+}
+
+// TestMsgMigrateClientTypeGetSigners tests GetSigners for MsgMigrateClientType
+func TestMsgMigrateClientTypeGetSigners(t *testing.T) {
+	testCases := []struct {
+		name    string
+		address sdk.AccAddress
+		expPass bool
+	}{
+		{"success: valid address", sdk.AccAddress(ibctesting.TestAccAddress), true},
+		{"failure: nil address", nil, false},
+	}
+
+	for _, tc := range testCases {
+		// Leave subject client ID and substitute client ID as empty strings
+		msg := types.MsgMigrateClientType{
+			Signer: tc.address.String(),
+		}
+		encodingCfg := moduletestutil.MakeTestEncodingConfig(ibc.AppModuleBasic{})
+		signers, _, err := encodingCfg.Codec.GetMsgV1Signers(&msg)
+		if tc.expPass {
+			require.NoError(t, err)
+			require.Equal(t, tc.address.Bytes(), signers[0])
+		} else {
+			require.Error(t, err)
+		}
+	}
+}
+
 // TestMsgIBCSoftwareUpgrade_NewMsgIBCSoftwareUpgrade tests NewMsgIBCSoftwareUpgrade
 func (suite *TypesTestSuite) TestMsgIBCSoftwareUpgrade_NewMsgIBCSoftwareUpgrade() {
 	testCases := []struct {
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"errors"
 	"fmt"
 	"slices"
 	"strings"
@@ -27,7 +28,11 @@ func DefaultParams() Params {
 
 // Validate all ibc-client module parameters
 func (p Params) Validate() error {
-	return validateClients(p.AllowedClients)
+	if err := validateClients(p.AllowedClients); err != nil {
+		return err
+	}
+
+	return validateGasMultipliers(p.UpdateGasMultipliers)
 }
 
 // IsAllowedClient checks if the given client type is registered on the allowlist.
@@ -46,6 +51,49 @@ func (p Params) IsAllowedClient(clientType string) bool {
 	return slices.Contains(p.AllowedClients, clientType)
 }
 
+// IsUpdateRateLimitEnabled returns true if a minimum block or time interval between client updates is configured.
+func (p Params) IsUpdateRateLimitEnabled() bool {
+	return p.MinUpdateBlockInterval > 0 || p.MinUpdateTimeInterval > 0
+}
+
+// GetUpdateGasMultiplier returns the gas multiplier configured for the given client type, if any.
+func (p Params) GetUpdateGasMultiplier(clientType string) (GasMultiplier, bool) {
+	for _, multiplier := range p.UpdateGasMultipliers {
+		if multiplier.ClientType == clientType {
+			return multiplier, true
+		}
+	}
+
+	return GasMultiplier{}, false
+}
+
+// NewGasMultiplier creates a new GasMultiplier instance.
+func NewGasMultiplier(clientType string, numerator, denominator uint64) GasMultiplier {
+	return GasMultiplier{
+		ClientType:  clientType,
+		Numerator:   numerator,
+		Denominator: denominator,
+	}
+}
+
+// Validate performs basic validation of the gas multiplier. Multipliers must not be lower than one,
+// as gas already consumed by the light client module cannot be refunded.
+func (m GasMultiplier) Validate() error {
+	if strings.TrimSpace(m.ClientType) == "" {
+		return errors.New("gas multiplier client type cannot be blank")
+	}
+
+	if m.Denominator == 0 {
+		return fmt.Errorf("gas multiplier denominator for client type %s cannot be zero", m.ClientType)
+	}
+
+	if m.Numerator < m.Denominator {
+		return fmt.Errorf("gas multiplier for client type %s must not be less than one: %d/%d", m.ClientType, m.Numerator, m.Denominator)
+	}
+
+	return nil
+}
+
 // validateClients checks that the given clients are not blank and there are no duplicates.
 // If AllowAllClients wildcard (*) is used, then there should no other client types in the allow list
 func validateClients(clients []string) error {
@@ -70,3 +118,23 @@ func validateClients(clients []string) error {
 
 	return nil
 }
+
+// validateGasMultipliers checks that the given gas multipliers are valid and there is at most one per client type.
+func validateGasMultipliers(multipliers []GasMultiplier) error {
+	if len(multipliers) > MaxAllowedClientsLength {
+		return fmt.Errorf("gas multipliers length must not exceed %d items", MaxAllowedClientsLength)
+	}
+
+	foundClients := make(map[string]bool, len(multipliers))
+	for _, multiplier := range multipliers {
+		if err := multiplier.Validate(); err != nil {
+			return err
+		}
+		if foundClients[multiplier.ClientType] {
+			return fmt.Errorf("duplicate gas multiplier for client type: %s", multiplier.ClientType)
+		}
+		foundClients[multiplier.ClientType] = true
+	}
+
+	return nil
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -41,6 +42,18 @@ func TestValidateParams(t *testing.T) {
 		{"duplicate clients", NewParams(exported.Tendermint, exported.Tendermint), false},
 		{"allow all clients plus valid client", NewParams(AllowAllClients, exported.Tendermint), false},
 		{"too many allowed clients", NewParams(make([]string, MaxAllowedClientsLength+1)...), false},
+		{"rate limit and gas multipliers", func() Params {
+			params := DefaultParams()
+			params.MinUpdateBlockInterval = 2
+			params.MinUpdateTimeInterval = uint64(time.Minute)
+			params.UpdateGasMultipliers = []GasMultiplier{NewGasMultiplier(exported.Tendermint, 3, 2), NewGasMultiplier(exported.Solomachine, 1, 1)}
+			return params
+		}(), true},
+		{"blank gas multiplier client type", withGasMultipliers(NewGasMultiplier(" ", 2, 1)), false},
+		{"zero gas multiplier denominator", withGasMultipliers(NewGasMultiplier(exported.Tendermint, 2, 0)), false},
+		{"gas multiplier less than one", withGasMultipliers(NewGasMultiplier(exported.Tendermint, 1, 2)), false},
+		{"duplicate gas multipliers", withGasMultipliers(NewGasMultiplier(exported.Tendermint, 2, 1), NewGasMultiplier(exported.Tendermint, 3, 1)), false},
+		{"too many gas multipliers", withGasMultipliers(make([]GasMultiplier, MaxAllowedClientsLength+1)...), false},
 	}
 
 	for _, tc := range testCases {
@@ -54,3 +67,20 @@ func TestValidateParams(t *testing.T) {
 		}
 	}
 }
+
+func TestGetUpdateGasMultiplier(t *testing.T) {
+	params := withGasMultipliers(NewGasMultiplier(exported.Tendermint, 2, 1))
+
+	multiplier, found := params.GetUpdateGasMultiplier(exported.Tendermint)
+	require.True(t, found)
+	require.Equal(t, NewGasMultiplier(exported.Tendermint, 2, 1), multiplier)
+
+	_, found = params.GetUpdateGasMultiplier(exported.Solomachine)
+	require.False(t, found)
+}
+
+func withGasMultipliers(multipliers ...GasMultiplier) Params {
+	params := DefaultParams()
+	params.UpdateGasMultipliers = multipliers
+	return params
+}
@@ -0,0 +1,40 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// updateRateLimitExemptionsKey is the context key under which the set of clients exempt from the
+// client update rate limit is stored.
+type updateRateLimitExemptionsKey struct{}
+
+// WithUpdateRateLimitExemptions returns a new context in which updates of the provided clients are exempt from the
+// client update rate limit, in addition to any clients already exempt in the given context.
+func WithUpdateRateLimitExemptions(ctx sdk.Context, clientIDs ...string) sdk.Context {
+	if len(clientIDs) == 0 {
+		return ctx
+	}
+
+	existing, _ := ctx.Value(updateRateLimitExemptionsKey{}).(map[string]struct{})
+	exemptions := make(map[string]struct{}, len(existing)+len(clientIDs))
+	for clientID := range existing {
+		exemptions[clientID] = struct{}{}
+	}
+	for _, clientID := range clientIDs {
+		exemptions[clientID] = struct{}{}
+	}
+
+	return ctx.WithValue(updateRateLimitExemptionsKey{}, exemptions)
+}
+
+// IsUpdateRateLimitExempt returns true if updates of the given client are exempt from the client update rate limit
+// in the provided context.
+func IsUpdateRateLimitExempt(ctx sdk.Context, clientID string) bool {
+	exemptions, ok := ctx.Value(updateRateLimitExemptionsKey{}).(map[string]struct{})
+	if !ok {
+		return false
+	}
+
+	_, found := exemptions[clientID]
+	return found
+}
@@ -448,6 +448,88 @@ func (m *MsgRecoverClientResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgRecoverClientResponse proto.InternalMessageInfo
 
+// MsgMigrateClientType defines the message used to replace a client with a substitute client
+// of a different client type, while preserving the client identifier of the subject client.
+type MsgMigrateClientType struct {
+	// the client identifier for the client to be migrated if the proposal passes
+	SubjectClientId string `protobuf:"bytes,1,opt,name=subject_client_id,json=subjectClientId,proto3" json:"subject_client_id,omitempty"`
+	// the substitute client identifier for the client of the new client type which will replace
+	// the subject client
+	SubstituteClientId string `protobuf:"bytes,2,opt,name=substitute_client_id,json=substituteClientId,proto3" json:"substitute_client_id,omitempty"`
+	// signer address
+	Signer string `protobuf:"bytes,3,opt,name=signer,proto3" json:"signer,omitempty"`
+}
+
+func (m *MsgMigrateClientType) Reset()         { *m = MsgMigrateClientType{} }
+func (m *MsgMigrateClientType) String() string { return proto.CompactTextString(m) }
+func (*MsgMigrateClientType) ProtoMessage()    {}
+func (*MsgMigrateClientType) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb5dc4651eb49a04, []int{10}
+}
+func (m *MsgMigrateClientType) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMigrateClientType) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMigrateClientType.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgMigrateClientType) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMigrateClientType.Merge(m, src)
+}
+func (m *MsgMigrateClientType) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMigrateClientType) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMigrateClientType.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgMigrateClientType proto.InternalMessageInfo
+
+// MsgMigrateClientTypeResponse defines the Msg/MigrateClientType response type.
+type MsgMigrateClientTypeResponse struct {
+}
+
+func (m *MsgMigrateClientTypeResponse) Reset()         { *m = MsgMigrateClientTypeResponse{} }
+func (m *MsgMigrateClientTypeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgMigrateClientTypeResponse) ProtoMessage()    {}
+func (*MsgMigrateClientTypeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb5dc4651eb49a04, []int{11}
+}
+func (m *MsgMigrateClientTypeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMigrateClientTypeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMigrateClientTypeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgMigrateClientTypeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMigrateClientTypeResponse.Merge(m, src)
+}
+func (m *MsgMigrateClientTypeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMigrateClientTypeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMigrateClientTypeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgMigrateClientTypeResponse proto.InternalMessageInfo
+
 // MsgIBCSoftwareUpgrade defines the message used to schedule an upgrade of an IBC client using a v1 governance proposal
 type MsgIBCSoftwareUpgrade struct {
 	Plan types1.Plan `protobuf:"bytes,1,opt,name=plan,proto3" json:"plan"`
@@ -468,7 +550,7 @@ func (m *MsgIBCSoftwareUpgrade) Reset()         { *m = MsgIBCSoftwareUpgrade{} }
 func (m *MsgIBCSoftwareUpgrade) String() string { return proto.CompactTextString(m) }
 func (*MsgIBCSoftwareUpgrade) ProtoMessage()    {}
 func (*MsgIBCSoftwareUpgrade) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cb5dc4651eb49a04, []int{10}
+	return fileDescriptor_cb5dc4651eb49a04, []int{12}
 }
 func (m *MsgIBCSoftwareUpgrade) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -526,7 +608,7 @@ func (m *MsgIBCSoftwareUpgradeResponse) Reset()         { *m = MsgIBCSoftwareUpg
 func (m *MsgIBCSoftwareUpgradeResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgIBCSoftwareUpgradeResponse) ProtoMessage()    {}
 func (*MsgIBCSoftwareUpgradeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cb5dc4651eb49a04, []int{11}
+	return fileDescriptor_cb5dc4651eb49a04, []int{13}
 }
 func (m *MsgIBCSoftwareUpgradeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -569,7 +651,7 @@ func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
 func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParams) ProtoMessage()    {}
 func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cb5dc4651eb49a04, []int{12}
+	return fileDescriptor_cb5dc4651eb49a04, []int{14}
 }
 func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -606,7 +688,7 @@ func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse
 func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParamsResponse) ProtoMessage()    {}
 func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cb5dc4651eb49a04, []int{13}
+	return fileDescriptor_cb5dc4651eb49a04, []int{15}
 }
 func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -646,6 +728,8 @@ func init() {
 	proto.RegisterType((*MsgSubmitMisbehaviourResponse)(nil), "ibc.core.client.v1.MsgSubmitMisbehaviourResponse")
 	proto.RegisterType((*MsgRecoverClient)(nil), "ibc.core.client.v1.MsgRecoverClient")
 	proto.RegisterType((*MsgRecoverClientResponse)(nil), "ibc.core.client.v1.MsgRecoverClientResponse")
+	proto.RegisterType((*MsgMigrateClientType)(nil), "ibc.core.client.v1.MsgMigrateClientType")
+	proto.RegisterType((*MsgMigrateClientTypeResponse)(nil), "ibc.core.client.v1.MsgMigrateClientTypeResponse")
 	proto.RegisterType((*MsgIBCSoftwareUpgrade)(nil), "ibc.core.client.v1.MsgIBCSoftwareUpgrade")
 	proto.RegisterType((*MsgIBCSoftwareUpgradeResponse)(nil), "ibc.core.client.v1.MsgIBCSoftwareUpgradeResponse")
 	proto.RegisterType((*MsgUpdateParams)(nil), "ibc.core.client.v1.MsgUpdateParams")
@@ -655,59 +739,61 @@ func init() {
 func init() { proto.RegisterFile("ibc/core/client/v1/tx.proto", fileDescriptor_cb5dc4651eb49a04) }
 
 var fileDescriptor_cb5dc4651eb49a04 = []byte{
-	// 819 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x31, 0x6f, 0xd3, 0x40,
-	0x14, 0x8e, 0xd3, 0x34, 0xa2, 0xd7, 0xb4, 0xa1, 0x47, 0x4a, 0x53, 0x97, 0x26, 0x55, 0xe8, 0x50,
-	0x0a, 0xb5, 0x9b, 0x22, 0x41, 0x29, 0x30, 0xb4, 0x59, 0xe8, 0x10, 0xa9, 0x72, 0xc5, 0xc2, 0x92,
-	0xda, 0xce, 0xc5, 0x35, 0x8a, 0x7d, 0x96, 0xef, 0x1c, 0xe8, 0x86, 0x98, 0x18, 0x19, 0x58, 0xd8,
-	0xf8, 0x09, 0x15, 0x3f, 0x80, 0x0d, 0xa9, 0x63, 0x47, 0x26, 0x84, 0xda, 0xa1, 0x12, 0xbf, 0x02,
-	0xd9, 0x77, 0x49, 0x6d, 0x27, 0x36, 0x41, 0x6c, 0xb1, 0xdf, 0xf7, 0xee, 0x7d, 0xdf, 0xbb, 0xf7,
-	0xbe, 0x18, 0x2c, 0x99, 0x9a, 0x2e, 0xeb, 0xd8, 0x45, 0xb2, 0xde, 0x35, 0x91, 0x4d, 0xe5, 0x5e,
-	0x5d, 0xa6, 0x6f, 0x25, 0xc7, 0xc5, 0x14, 0x43, 0x68, 0x6a, 0xba, 0xe4, 0x07, 0x25, 0x16, 0x94,
-	0x7a, 0x75, 0x71, 0x41, 0xc7, 0xc4, 0xc2, 0x44, 0xb6, 0x88, 0xe1, 0x63, 0x2d, 0x62, 0x30, 0xb0,
-	0xb8, 0xca, 0x03, 0x9e, 0x63, 0xb8, 0x6a, 0x1b, 0xc9, 0xbd, 0xba, 0x86, 0xa8, 0x5a, 0xef, 0x3f,
-	0x73, 0x54, 0xc9, 0xc0, 0x06, 0x0e, 0x7e, 0xca, 0xfe, 0x2f, 0xfe, 0x76, 0xd1, 0xc0, 0xd8, 0xe8,
-	0x22, 0x39, 0x78, 0xd2, 0xbc, 0x8e, 0xac, 0xda, 0x27, 0x3c, 0x54, 0x1d, 0x41, 0x90, 0xb3, 0x09,
-	0x00, 0xb5, 0xaf, 0x02, 0x28, 0x36, 0x89, 0xd1, 0x70, 0x91, 0x4a, 0x51, 0x23, 0x88, 0xc0, 0xc7,
-	0xa0, 0xc0, 0x30, 0x2d, 0x42, 0x55, 0x8a, 0xca, 0xc2, 0x8a, 0xb0, 0x36, 0xbd, 0x55, 0x92, 0x58,
-	0x19, 0xa9, 0x5f, 0x46, 0xda, 0xb5, 0x4f, 0x94, 0x69, 0x86, 0x3c, 0xf4, 0x81, 0xf0, 0x39, 0x28,
-	0xea, 0xd8, 0x26, 0xc8, 0x26, 0x1e, 0xe1, 0xb9, 0xd9, 0x94, 0xdc, 0xd9, 0x01, 0x98, 0xa5, 0xdf,
-	0x06, 0x79, 0x62, 0x1a, 0x36, 0x72, 0xcb, 0x13, 0x2b, 0xc2, 0xda, 0x94, 0xc2, 0x9f, 0x76, 0x8a,
-	0x1f, 0xbe, 0x54, 0x33, 0xef, 0xaf, 0x4e, 0xd7, 0xf9, 0x8b, 0xda, 0x33, 0xb0, 0x10, 0xe3, 0xac,
-	0x20, 0xe2, 0xf8, 0x87, 0xc1, 0x25, 0x30, 0xc5, 0xb9, 0x9b, 0xed, 0x80, 0xf8, 0x94, 0x72, 0x83,
-	0xbd, 0xd8, 0x6f, 0xef, 0xe4, 0xfc, 0x83, 0x6a, 0x9f, 0x98, 0xe4, 0x97, 0x4e, 0xfb, 0x5a, 0x72,
-	0x5a, 0x1a, 0x7c, 0x0a, 0x66, 0x79, 0xd0, 0x42, 0x84, 0xa8, 0x46, 0xba, 0xaa, 0x19, 0x86, 0x6d,
-	0x32, 0xe8, 0xf8, 0xa2, 0x16, 0x03, 0x51, 0x61, 0x56, 0x7d, 0x51, 0xb5, 0xef, 0x59, 0x70, 0x33,
-	0x88, 0x05, 0xb3, 0x30, 0x0e, 0xe5, 0xf8, 0x15, 0x66, 0xff, 0xe3, 0x0a, 0x27, 0xfe, 0xe1, 0x0a,
-	0x37, 0x41, 0xc9, 0x71, 0x31, 0xee, 0xb4, 0xf8, 0xdc, 0xb6, 0xd8, 0xd9, 0xe5, 0xdc, 0x8a, 0xb0,
-	0x56, 0x50, 0x60, 0x10, 0x8b, 0xca, 0xd8, 0x05, 0xcb, 0xb1, 0x8c, 0x58, 0xf9, 0xc9, 0x20, 0x55,
-	0x8c, 0xa4, 0x26, 0xcd, 0x4d, 0x3e, 0xbd, 0xc5, 0x22, 0x28, 0xc7, 0xdb, 0x38, 0xe8, 0xf1, 0x67,
-	0x01, 0xcc, 0x37, 0x89, 0x71, 0xe8, 0x69, 0x96, 0x49, 0x9b, 0x26, 0xd1, 0xd0, 0xb1, 0xda, 0x33,
-	0xb1, 0xe7, 0xa6, 0x37, 0x7a, 0x1b, 0x14, 0xac, 0x10, 0x38, 0xb5, 0xd1, 0x11, 0x64, 0xe2, 0x60,
-	0xcc, 0xc5, 0x58, 0x97, 0x85, 0x5a, 0x15, 0x2c, 0x8f, 0xa4, 0x16, 0x26, 0xef, 0x0f, 0x88, 0x82,
-	0x74, 0xdc, 0x43, 0x2e, 0xef, 0xec, 0x3a, 0x98, 0x23, 0x9e, 0xf6, 0x1a, 0xe9, 0xb4, 0x15, 0xe7,
-	0x5f, 0xe4, 0x81, 0x46, 0x5f, 0xc6, 0x26, 0x28, 0x11, 0x4f, 0x23, 0xd4, 0xa4, 0x1e, 0x45, 0x21,
-	0x78, 0x36, 0x80, 0xc3, 0xeb, 0xd8, 0x20, 0x63, 0xec, 0xb9, 0x66, 0x4d, 0x8f, 0x50, 0x1b, 0xf0,
-	0xfe, 0xc6, 0x9a, 0xbe, 0xbf, 0xd7, 0x38, 0xc4, 0x1d, 0xfa, 0x46, 0x75, 0x11, 0xbf, 0x1c, 0xf8,
-	0x08, 0xe4, 0x9c, 0xae, 0x6a, 0x73, 0xef, 0xb9, 0x23, 0x31, 0x7b, 0x94, 0xfa, 0x76, 0xc8, 0xed,
-	0x51, 0x3a, 0xe8, 0xaa, 0xf6, 0x5e, 0xee, 0xec, 0x67, 0x35, 0xa3, 0x04, 0x78, 0xf8, 0x02, 0xcc,
-	0x73, 0x4c, 0xbb, 0x35, 0xf6, 0x06, 0xdc, 0xea, 0xa7, 0x34, 0x42, 0x9b, 0x90, 0x24, 0x70, 0x3a,
-	0x2c, 0x8e, 0xdd, 0xcc, 0x30, 0xff, 0x81, 0x42, 0x1a, 0xf2, 0x9a, 0x03, 0xd5, 0x55, 0x2d, 0x12,
-	0x3a, 0x58, 0x08, 0x1f, 0x0c, 0xb7, 0x41, 0xde, 0x09, 0x10, 0x9c, 0xab, 0x28, 0x0d, 0xff, 0x81,
-	0x48, 0xec, 0x0c, 0x2e, 0x99, 0xe3, 0xd3, 0xbd, 0x84, 0x65, 0xf4, 0x09, 0x6d, 0xfd, 0x9e, 0x04,
-	0x13, 0x4d, 0x62, 0xc0, 0x23, 0x50, 0x88, 0x98, 0xfe, 0xdd, 0x51, 0xd5, 0x62, 0x2e, 0x2b, 0xde,
-	0x1f, 0x03, 0x34, 0xb0, 0xe2, 0x23, 0x50, 0x88, 0x78, 0x6c, 0x52, 0x85, 0x30, 0x28, 0xb1, 0xc2,
-	0x28, 0x5f, 0x84, 0x3a, 0x98, 0x89, 0x9a, 0xc9, 0x6a, 0x62, 0x76, 0x08, 0x25, 0x3e, 0x18, 0x07,
-	0x35, 0x28, 0xe2, 0x02, 0x38, 0xc2, 0x14, 0xee, 0x25, 0x9c, 0x31, 0x0c, 0x15, 0xeb, 0x63, 0x43,
-	0xc3, 0xc2, 0xa2, 0xbb, 0x9c, 0x24, 0x2c, 0x82, 0x4a, 0x14, 0x36, 0x72, 0xf9, 0x7c, 0x61, 0x23,
-	0x16, 0x2f, 0x49, 0xd8, 0x30, 0x34, 0x51, 0x58, 0xf2, 0x3a, 0xc0, 0x0e, 0x80, 0xe1, 0x9b, 0xe4,
-	0x1b, 0x91, 0x3e, 0x19, 0x0c, 0xf4, 0x97, 0xc9, 0x88, 0x4e, 0xb9, 0x38, 0xf9, 0xee, 0xea, 0x74,
-	0x5d, 0xd8, 0x53, 0xce, 0x2e, 0x2a, 0xc2, 0xf9, 0x45, 0x45, 0xf8, 0x75, 0x51, 0x11, 0x3e, 0x5e,
-	0x56, 0x32, 0xe7, 0x97, 0x95, 0xcc, 0x8f, 0xcb, 0x4a, 0xe6, 0xd5, 0xb6, 0x61, 0xd2, 0x63, 0x4f,
-	0x93, 0x74, 0x6c, 0xc9, 0xfc, 0xd3, 0xcb, 0xd4, 0xf4, 0x0d, 0x03, 0xcb, 0xbd, 0x27, 0xb2, 0x85,
-	0xdb, 0x5e, 0x17, 0x11, 0xf6, 0xe1, 0xb4, 0xb9, 0xb5, 0xc1, 0xbf, 0x9d, 0xe8, 0x89, 0x83, 0x88,
-	0x96, 0x0f, 0xac, 0xe3, 0xe1, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x4e, 0xa7, 0x07, 0xfb, 0xfc,
-	0x09, 0x00, 0x00,
+	// 860 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x96, 0xbf, 0x6e, 0xfb, 0x54,
+	0x14, 0xc7, 0xe3, 0x34, 0x8d, 0xe8, 0x6d, 0xda, 0x50, 0x93, 0xd2, 0xd4, 0x6d, 0x93, 0x2a, 0x74,
+	0x08, 0xfd, 0x63, 0x27, 0x45, 0x82, 0x52, 0x60, 0x68, 0xb3, 0xd0, 0x21, 0x52, 0xe5, 0xc2, 0xc2,
+	0x92, 0xda, 0xce, 0xcd, 0xad, 0x51, 0xec, 0x6b, 0xf9, 0x5e, 0x07, 0xb2, 0x21, 0x26, 0x46, 0x06,
+	0x16, 0x06, 0x24, 0x1e, 0xa1, 0xe2, 0x01, 0xd8, 0x90, 0x3a, 0x76, 0x44, 0x42, 0x42, 0xa8, 0x1d,
+	0xfa, 0x1a, 0x28, 0xbe, 0xd7, 0xae, 0xed, 0xc4, 0x96, 0x11, 0xcb, 0x6f, 0xb3, 0x7d, 0x3e, 0xe7,
+	0x9c, 0xef, 0x39, 0x3e, 0x3e, 0xd7, 0x60, 0xc7, 0xd4, 0x0d, 0xc5, 0xc0, 0x2e, 0x54, 0x8c, 0xb1,
+	0x09, 0x6d, 0xaa, 0x4c, 0xba, 0x0a, 0xfd, 0x56, 0x76, 0x5c, 0x4c, 0xb1, 0x28, 0x9a, 0xba, 0x21,
+	0xcf, 0x8c, 0x32, 0x33, 0xca, 0x93, 0xae, 0xb4, 0x65, 0x60, 0x62, 0x61, 0xa2, 0x58, 0x04, 0xcd,
+	0x58, 0x8b, 0x20, 0x06, 0x4b, 0x07, 0xdc, 0xe0, 0x39, 0xc8, 0xd5, 0x86, 0x50, 0x99, 0x74, 0x75,
+	0x48, 0xb5, 0x6e, 0x70, 0xcf, 0xa9, 0x1a, 0xc2, 0x08, 0xfb, 0x97, 0xca, 0xec, 0x8a, 0x3f, 0xdd,
+	0x46, 0x18, 0xa3, 0x31, 0x54, 0xfc, 0x3b, 0xdd, 0x1b, 0x29, 0x9a, 0x3d, 0xe5, 0xa6, 0xe6, 0x02,
+	0x81, 0x5c, 0x8d, 0x0f, 0xb4, 0x7e, 0x13, 0x40, 0xb5, 0x4f, 0x50, 0xcf, 0x85, 0x1a, 0x85, 0x3d,
+	0xdf, 0x22, 0x7e, 0x04, 0x2a, 0x8c, 0x19, 0x10, 0xaa, 0x51, 0x58, 0x17, 0xf6, 0x85, 0xf6, 0xea,
+	0x69, 0x4d, 0x66, 0x69, 0xe4, 0x20, 0x8d, 0x7c, 0x61, 0x4f, 0xd5, 0x55, 0x46, 0xde, 0xcc, 0x40,
+	0xf1, 0x33, 0x50, 0x35, 0xb0, 0x4d, 0xa0, 0x4d, 0x3c, 0xc2, 0x7d, 0x8b, 0x19, 0xbe, 0xeb, 0x21,
+	0xcc, 0xdc, 0xdf, 0x05, 0x65, 0x62, 0x22, 0x1b, 0xba, 0xf5, 0xa5, 0x7d, 0xa1, 0xbd, 0xa2, 0xf2,
+	0xbb, 0xf3, 0xea, 0x0f, 0xbf, 0x36, 0x0b, 0xdf, 0xbf, 0xdc, 0x1f, 0xf2, 0x07, 0xad, 0x4f, 0xc1,
+	0x56, 0x42, 0xb3, 0x0a, 0x89, 0x33, 0x0b, 0x26, 0xee, 0x80, 0x15, 0xae, 0xdd, 0x1c, 0xfa, 0xc2,
+	0x57, 0xd4, 0xb7, 0xd8, 0x83, 0xab, 0xe1, 0x79, 0x69, 0x16, 0xa8, 0xf5, 0x13, 0x2b, 0xf9, 0x4b,
+	0x67, 0xf8, 0x5a, 0x72, 0x96, 0x9b, 0xf8, 0x09, 0x58, 0xe7, 0x46, 0x0b, 0x12, 0xa2, 0xa1, 0xec,
+	0xaa, 0xd6, 0x18, 0xdb, 0x67, 0x68, 0xfe, 0xa2, 0xb6, 0xc1, 0x56, 0x42, 0x55, 0x50, 0x54, 0xeb,
+	0x8f, 0x22, 0x78, 0xdb, 0xb7, 0xf9, 0xb3, 0x90, 0x47, 0x72, 0xf2, 0x15, 0x16, 0xff, 0xc7, 0x2b,
+	0x5c, 0xfa, 0x0f, 0xaf, 0xb0, 0x03, 0x6a, 0x8e, 0x8b, 0xf1, 0x68, 0xc0, 0xe7, 0x76, 0xc0, 0x62,
+	0xd7, 0x4b, 0xfb, 0x42, 0xbb, 0xa2, 0x8a, 0xbe, 0x2d, 0x5e, 0xc6, 0x05, 0xd8, 0x4b, 0x78, 0x24,
+	0xd2, 0x2f, 0xfb, 0xae, 0x52, 0xcc, 0x35, 0x6d, 0x6e, 0xca, 0xd9, 0x2d, 0x96, 0x40, 0x3d, 0xd9,
+	0xc6, 0xb0, 0xc7, 0x3f, 0x0b, 0x60, 0xb3, 0x4f, 0xd0, 0x8d, 0xa7, 0x5b, 0x26, 0xed, 0x9b, 0x44,
+	0x87, 0x77, 0xda, 0xc4, 0xc4, 0x9e, 0x9b, 0xdd, 0xe8, 0x33, 0x50, 0xb1, 0x22, 0x70, 0x66, 0xa3,
+	0x63, 0x64, 0xea, 0x60, 0x6c, 0x24, 0x54, 0xd7, 0x85, 0x56, 0x13, 0xec, 0x2d, 0x94, 0x16, 0x15,
+	0x3f, 0x1b, 0x10, 0x15, 0x1a, 0x78, 0x02, 0x5d, 0xde, 0xd9, 0x43, 0xb0, 0x41, 0x3c, 0xfd, 0x6b,
+	0x68, 0xd0, 0x41, 0x52, 0x7f, 0x95, 0x1b, 0x7a, 0x41, 0x19, 0x1d, 0x50, 0x23, 0x9e, 0x4e, 0xa8,
+	0x49, 0x3d, 0x0a, 0x23, 0x78, 0xd1, 0xc7, 0xc5, 0x57, 0x5b, 0xe8, 0x91, 0x7b, 0xae, 0x59, 0xd3,
+	0x63, 0xd2, 0x42, 0xdd, 0xbf, 0x08, 0xa0, 0xd6, 0x27, 0xa8, 0x6f, 0x22, 0x37, 0x9c, 0xfa, 0x2f,
+	0xa6, 0x0e, 0x7c, 0x53, 0xb4, 0x37, 0xc0, 0xee, 0x22, 0x79, 0xa1, 0xfe, 0xdf, 0xd9, 0xd0, 0x5c,
+	0x5d, 0xf6, 0x6e, 0xf0, 0x88, 0x7e, 0xa3, 0xb9, 0x90, 0x0f, 0x97, 0xf8, 0x21, 0x28, 0x39, 0x63,
+	0xcd, 0xe6, 0xbb, 0x73, 0x57, 0x66, 0xeb, 0x5d, 0x0e, 0xd6, 0x39, 0x5f, 0xef, 0xf2, 0xf5, 0x58,
+	0xb3, 0x2f, 0x4b, 0x0f, 0x7f, 0x37, 0x0b, 0xaa, 0xcf, 0x8b, 0x9f, 0x83, 0x4d, 0xce, 0x0c, 0x07,
+	0xb9, 0xbf, 0xe0, 0x77, 0x02, 0x97, 0x5e, 0xe4, 0x4b, 0x4e, 0x2b, 0x72, 0x35, 0x5a, 0x20, 0x9b,
+	0xac, 0x79, 0xfd, 0x61, 0x85, 0x34, 0xb2, 0x2b, 0xaf, 0x35, 0x57, 0xb3, 0x48, 0x24, 0xb0, 0x10,
+	0x0d, 0x2c, 0x9e, 0x81, 0xb2, 0xe3, 0x13, 0x5c, 0xab, 0x24, 0xcf, 0x1f, 0x80, 0x32, 0x8b, 0xc1,
+	0x4b, 0xe6, 0x7c, 0xf6, 0x2e, 0x64, 0x1e, 0x81, 0xa0, 0xd3, 0xbf, 0xca, 0x60, 0xa9, 0x4f, 0x90,
+	0x78, 0x0b, 0x2a, 0xb1, 0x43, 0xeb, 0xbd, 0x45, 0xd9, 0x12, 0xa7, 0x84, 0x74, 0x94, 0x03, 0x0a,
+	0x8f, 0x92, 0x5b, 0x50, 0x89, 0x9d, 0x11, 0x69, 0x19, 0xa2, 0x90, 0x74, 0x94, 0x03, 0x0a, 0x33,
+	0x18, 0x60, 0x2d, 0xbe, 0x0c, 0x0f, 0x52, 0xbd, 0x23, 0x94, 0x74, 0x9c, 0x87, 0x0a, 0x93, 0xb8,
+	0x40, 0x5c, 0xb0, 0xd4, 0xde, 0x4f, 0x89, 0x31, 0x8f, 0x4a, 0xdd, 0xdc, 0x68, 0xb4, 0xb0, 0xf8,
+	0x2e, 0x4a, 0x2b, 0x2c, 0x46, 0x49, 0xc7, 0x79, 0xa8, 0x30, 0x09, 0x06, 0x1b, 0xf3, 0x8b, 0xa3,
+	0x9d, 0x12, 0x62, 0x8e, 0x94, 0x3a, 0x79, 0xc9, 0x68, 0x27, 0x17, 0x7c, 0xe9, 0x69, 0x9d, 0x9c,
+	0x47, 0xa5, 0x6e, 0x6e, 0x34, 0xcc, 0x39, 0x02, 0x62, 0x74, 0x74, 0xf8, 0x27, 0x98, 0x3d, 0x8a,
+	0x0c, 0x92, 0x8e, 0x72, 0x40, 0x41, 0x1e, 0x69, 0xf9, 0xbb, 0x97, 0xfb, 0x43, 0xe1, 0x52, 0x7d,
+	0x78, 0x6a, 0x08, 0x8f, 0x4f, 0x0d, 0xe1, 0x9f, 0xa7, 0x86, 0xf0, 0xe3, 0x73, 0xa3, 0xf0, 0xf8,
+	0xdc, 0x28, 0xfc, 0xf9, 0xdc, 0x28, 0x7c, 0x75, 0x86, 0x4c, 0x7a, 0xe7, 0xe9, 0xb2, 0x81, 0x2d,
+	0x85, 0xff, 0xab, 0x9a, 0xba, 0x71, 0x82, 0xb0, 0x32, 0xf9, 0x58, 0xb1, 0xf0, 0xd0, 0x1b, 0x43,
+	0xc2, 0xfe, 0x34, 0x3b, 0xa7, 0x27, 0xfc, 0x67, 0x93, 0x4e, 0x1d, 0x48, 0xf4, 0xb2, 0xbf, 0xab,
+	0x3e, 0xf8, 0x77, 0x00, 0xb9, 0xc3, 0xb9, 0xf8, 0x2d, 0x0b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -732,6 +818,8 @@ type MsgClient interface {
 	SubmitMisbehaviour(ctx context.Context, in *MsgSubmitMisbehaviour, opts ...grpc.CallOption) (*MsgSubmitMisbehaviourResponse, error)
 	// RecoverClient defines a rpc handler method for MsgRecoverClient.
 	RecoverClient(ctx context.Context, in *MsgRecoverClient, opts ...grpc.CallOption) (*MsgRecoverClientResponse, error)
+	// MigrateClientType defines a rpc handler method for MsgMigrateClientType.
+	MigrateClientType(ctx context.Context, in *MsgMigrateClientType, opts ...grpc.CallOption) (*MsgMigrateClientTypeResponse, error)
 	// IBCSoftwareUpgrade defines a rpc handler method for MsgIBCSoftwareUpgrade.
 	IBCSoftwareUpgrade(ctx context.Context, in *MsgIBCSoftwareUpgrade, opts ...grpc.CallOption) (*MsgIBCSoftwareUpgradeResponse, error)
 	// UpdateClientParams defines a rpc handler method for MsgUpdateParams.
@@ -791,6 +879,15 @@ func (c *msgClient) RecoverClient(ctx context.Context, in *MsgRecoverClient, opt
 	return out, nil
 }
 
+func (c *msgClient) MigrateClientType(ctx context.Context, in *MsgMigrateClientType, opts ...grpc.CallOption) (*MsgMigrateClientTypeResponse, error) {
+	out := new(MsgMigrateClientTypeResponse)
+	err := c.cc.Invoke(ctx, "/ibc.core.client.v1.Msg/MigrateClientType", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) IBCSoftwareUpgrade(ctx context.Context, in *MsgIBCSoftwareUpgrade, opts ...grpc.CallOption) (*MsgIBCSoftwareUpgradeResponse, error) {
 	out := new(MsgIBCSoftwareUpgradeResponse)
 	err := c.cc.Invoke(ctx, "/ibc.core.client.v1.Msg/IBCSoftwareUpgrade", in, out, opts...)
@@ -821,6 +918,8 @@ type MsgServer interface {
 	SubmitMisbehaviour(context.Context, *MsgSubmitMisbehaviour) (*MsgSubmitMisbehaviourResponse, error)
 	// RecoverClient defines a rpc handler method for MsgRecoverClient.
 	RecoverClient(context.Context, *MsgRecoverClient) (*MsgRecoverClientResponse, error)
+	// MigrateClientType defines a rpc handler method for MsgMigrateClientType.
+	MigrateClientType(context.Context, *MsgMigrateClientType) (*MsgMigrateClientTypeResponse, error)
 	// IBCSoftwareUpgrade defines a rpc handler method for MsgIBCSoftwareUpgrade.
 	IBCSoftwareUpgrade(context.Context, *MsgIBCSoftwareUpgrade) (*MsgIBCSoftwareUpgradeResponse, error)
 	// UpdateClientParams defines a rpc handler method for MsgUpdateParams.
@@ -846,6 +945,9 @@ func (*UnimplementedMsgServer) SubmitMisbehaviour(ctx context.Context, req *MsgS
 func (*UnimplementedMsgServer) RecoverClient(ctx context.Context, req *MsgRecoverClient) (*MsgRecoverClientResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RecoverClient not implemented")
 }
+func (*UnimplementedMsgServer) MigrateClientType(ctx context.Context, req *MsgMigrateClientType) (*MsgMigrateClientTypeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MigrateClientType not implemented")
+}
 func (*UnimplementedMsgServer) IBCSoftwareUpgrade(ctx context.Context, req *MsgIBCSoftwareUpgrade) (*MsgIBCSoftwareUpgradeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method IBCSoftwareUpgrade not implemented")
 }
@@ -947,6 +1049,24 @@ func _Msg_RecoverClient_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_MigrateClientType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMigrateClientType)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).MigrateClientType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.core.client.v1.Msg/MigrateClientType",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MigrateClientType(ctx, req.(*MsgMigrateClientType))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_IBCSoftwareUpgrade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgIBCSoftwareUpgrade)
 	if err := dec(in); err != nil {
@@ -983,6 +1103,7 @@ func _Msg_UpdateClientParams_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ibc.core.client.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -1007,6 +1128,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RecoverClient",
 			Handler:    _Msg_RecoverClient_Handler,
 		},
+		{
+			MethodName: "MigrateClientType",
+			Handler:    _Msg_MigrateClientType_Handler,
+		},
 		{
 			MethodName: "IBCSoftwareUpgrade",
 			Handler:    _Msg_IBCSoftwareUpgrade_Handler,
@@ -1413,6 +1538,73 @@ func (m *MsgRecoverClientResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgMigrateClientType) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMigrateClientType) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMigrateClientType) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SubstituteClientId) > 0 {
+		i -= len(m.SubstituteClientId)
+		copy(dAtA[i:], m.SubstituteClientId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SubstituteClientId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SubjectClientId) > 0 {
+		i -= len(m.SubjectClientId)
+		copy(dAtA[i:], m.SubjectClientId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SubjectClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMigrateClientTypeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMigrateClientTypeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMigrateClientTypeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgIBCSoftwareUpgrade) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1728,6 +1920,36 @@ func (m *MsgRecoverClientResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgMigrateClientType) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SubjectClientId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SubstituteClientId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgMigrateClientTypeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *MsgIBCSoftwareUpgrade) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2922,6 +3144,202 @@ func (m *MsgRecoverClientResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgMigrateClientType) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMigrateClientType: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMigrateClientType: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubjectClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubjectClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubstituteClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubstituteClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMigrateClientTypeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMigrateClientTypeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMigrateClientTypeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MsgIBCSoftwareUpgrade) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -52,6 +52,7 @@ func NewTxCmd() *cobra.Command {
 	txCmd.AddCommand(
 		newUpgradeChannelsTxCmd(),
 		newPruneAcknowledgementsTxCmd(),
+		newOpenLocalhostChannelTxCmd(),
 	)
 
 	return txCmd
@@ -25,6 +25,7 @@ const (
 	flagPortPattern = "port-pattern"
 	flagExpedited   = "expedited"
 	flagChannelIDs  = "channel-ids"
+	flagOrdered     = "ordered"
 )
 
 // newPruneAcknowledgementsTxCmd returns the command to create a new MsgPruneAcknowledgements transaction
@@ -59,6 +60,43 @@ func newPruneAcknowledgementsTxCmd() *cobra.Command {
 	return cmd
 }
 
+// newOpenLocalhostChannelTxCmd returns the command to create a new MsgOpenLocalhostChannel transaction
+func newOpenLocalhostChannelTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "open-localhost-channel [port] [counterparty-port] [version]",
+		Short: "Open a channel between two ports over the localhost connection",
+		Long: `Open a channel between two ports on this chain over the localhost connection. As both channel ends
+reside on the same chain, all four channel handshake steps are executed within a single message.`,
+		Example: fmt.Sprintf("%s tx %s %s open-localhost-channel transfer transfer ics20-1", version.AppName, ibcexported.ModuleName, types.SubModuleName),
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			ordered, err := cmd.Flags().GetBool(flagOrdered)
+			if err != nil {
+				return err
+			}
+
+			ordering := types.UNORDERED
+			if ordered {
+				ordering = types.ORDERED
+			}
+
+			signer := clientCtx.GetFromAddress().String()
+			msg := types.NewMsgOpenLocalhostChannel(args[0], args[1], args[2], ordering, signer)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Bool(flagOrdered, false, "Open an ordered channel")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 func newUpgradeChannelsTxCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "upgrade-channels [version]",
@@ -38,6 +38,7 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgChannelUpgradeCancel{},
 		&MsgPruneAcknowledgements{},
 		&MsgUpdateParams{},
+		&MsgOpenLocalhostChannel{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
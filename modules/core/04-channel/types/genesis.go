@@ -3,6 +3,9 @@ package types
 import (
 	"errors"
 	"fmt"
+	"slices"
+
+	errorsmod "cosmossdk.io/errors"
 
 	host "github.com/cosmos/ibc-go/v9/modules/core/24-host"
 )
@@ -41,6 +44,30 @@ func (ps PacketSequence) Validate() error {
 	return validateGenFields(ps.PortId, ps.ChannelId, ps.Sequence)
 }
 
+// NewLocalhostChannel creates a new LocalhostChannel instance.
+func NewLocalhostChannel(portID, counterpartyPortID, version string, ordering Order) LocalhostChannel {
+	return LocalhostChannel{
+		PortId:             portID,
+		CounterpartyPortId: counterpartyPortID,
+		Version:            version,
+		Ordering:           ordering,
+	}
+}
+
+// ValidateBasic performs basic validation of the localhost channel fields.
+func (lc LocalhostChannel) ValidateBasic() error {
+	if err := host.PortIdentifierValidator(lc.PortId); err != nil {
+		return errorsmod.Wrap(err, "invalid port ID")
+	}
+	if err := host.PortIdentifierValidator(lc.CounterpartyPortId); err != nil {
+		return errorsmod.Wrap(err, "invalid counterparty port ID")
+	}
+	if !slices.Contains([]Order{ORDERED, UNORDERED}, lc.Ordering) {
+		return errorsmod.Wrap(ErrInvalidChannelOrdering, lc.Ordering.String())
+	}
+	return nil
+}
+
 // NewGenesisState creates a GenesisState instance.
 func NewGenesisState(
 	channels []IdentifiedChannel, acks, receipts, commitments []PacketState,
@@ -142,6 +169,12 @@ func (gs GenesisState) Validate() error {
 		}
 	}
 
+	for i, lc := range gs.LocalhostChannels {
+		if err := lc.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid localhost channel %v index %d: %w", lc, i, err)
+		}
+	}
+
 	return nil
 }
 
@@ -35,6 +35,9 @@ type GenesisState struct {
 	// the sequence for the next generated channel identifier
 	NextChannelSequence uint64 `protobuf:"varint,8,opt,name=next_channel_sequence,json=nextChannelSequence,proto3" json:"next_channel_sequence,omitempty"`
 	Params              Params `protobuf:"bytes,9,opt,name=params,proto3" json:"params"`
+	// localhost channels to be opened in InitGenesis. These are not exported as the
+	// opened channels are included in the exported channels.
+	LocalhostChannels []LocalhostChannel `protobuf:"bytes,10,rep,name=localhost_channels,json=localhostChannels,proto3" json:"localhost_channels"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -133,6 +136,83 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetLocalhostChannels() []LocalhostChannel {
+	if m != nil {
+		return m.LocalhostChannels
+	}
+	return nil
+}
+
+// LocalhostChannel defines a channel to be opened over the localhost connection
+// between two ports on the same chain during InitGenesis.
+type LocalhostChannel struct {
+	PortId             string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	CounterpartyPortId string `protobuf:"bytes,2,opt,name=counterparty_port_id,json=counterpartyPortId,proto3" json:"counterparty_port_id,omitempty"`
+	Version            string `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+	Ordering           Order  `protobuf:"varint,4,opt,name=ordering,proto3,enum=ibc.core.channel.v1.Order" json:"ordering,omitempty"`
+}
+
+func (m *LocalhostChannel) Reset()         { *m = LocalhostChannel{} }
+func (m *LocalhostChannel) String() string { return proto.CompactTextString(m) }
+func (*LocalhostChannel) ProtoMessage()    {}
+func (*LocalhostChannel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb06ec201f452595, []int{1}
+}
+func (m *LocalhostChannel) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LocalhostChannel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LocalhostChannel.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LocalhostChannel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LocalhostChannel.Merge(m, src)
+}
+func (m *LocalhostChannel) XXX_Size() int {
+	return m.Size()
+}
+func (m *LocalhostChannel) XXX_DiscardUnknown() {
+	xxx_messageInfo_LocalhostChannel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LocalhostChannel proto.InternalMessageInfo
+
+func (m *LocalhostChannel) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *LocalhostChannel) GetCounterpartyPortId() string {
+	if m != nil {
+		return m.CounterpartyPortId
+	}
+	return ""
+}
+
+func (m *LocalhostChannel) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+func (m *LocalhostChannel) GetOrdering() Order {
+	if m != nil {
+		return m.Ordering
+	}
+	return NONE
+}
+
 // PacketSequence defines the genesis type necessary to retrieve and store
 // next send and receive sequences.
 type PacketSequence struct {
@@ -145,7 +225,7 @@ func (m *PacketSequence) Reset()         { *m = PacketSequence{} }
 func (m *PacketSequence) String() string { return proto.CompactTextString(m) }
 func (*PacketSequence) ProtoMessage()    {}
 func (*PacketSequence) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cb06ec201f452595, []int{1}
+	return fileDescriptor_cb06ec201f452595, []int{2}
 }
 func (m *PacketSequence) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -197,43 +277,50 @@ func (m *PacketSequence) GetSequence() uint64 {
 
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "ibc.core.channel.v1.GenesisState")
+	proto.RegisterType((*LocalhostChannel)(nil), "ibc.core.channel.v1.LocalhostChannel")
 	proto.RegisterType((*PacketSequence)(nil), "ibc.core.channel.v1.PacketSequence")
 }
 
 func init() { proto.RegisterFile("ibc/core/channel/v1/genesis.proto", fileDescriptor_cb06ec201f452595) }
 
 var fileDescriptor_cb06ec201f452595 = []byte{
-	// 470 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0x41, 0x6f, 0xd3, 0x30,
-	0x18, 0x86, 0x9b, 0xb5, 0xeb, 0x5a, 0x77, 0x9b, 0xc0, 0x03, 0x11, 0x8a, 0xc8, 0xca, 0x90, 0x50,
-	0x2f, 0x8b, 0x59, 0xe1, 0xd2, 0x6b, 0x39, 0x40, 0x2f, 0x68, 0xca, 0x6e, 0x48, 0xa8, 0x4a, 0xec,
-	0x8f, 0xcc, 0x6a, 0x63, 0x87, 0xd8, 0x2d, 0xf0, 0x2f, 0xf8, 0x2d, 0xfc, 0x8a, 0x1d, 0x77, 0xe4,
-	0x34, 0xa1, 0xf6, 0x5f, 0x70, 0x42, 0x71, 0x9c, 0xac, 0x68, 0x05, 0xa9, 0xb7, 0xf8, 0xfb, 0xde,
-	0xf7, 0x79, 0xdf, 0x43, 0x3e, 0xf4, 0x8c, 0x47, 0x94, 0x50, 0x99, 0x01, 0xa1, 0x97, 0xa1, 0x10,
-	0x30, 0x23, 0x8b, 0x33, 0x12, 0x83, 0x00, 0xc5, 0x95, 0x9f, 0x66, 0x52, 0x4b, 0x7c, 0xc4, 0x23,
-	0xea, 0xe7, 0x12, 0xdf, 0x4a, 0xfc, 0xc5, 0x59, 0xf7, 0x41, 0x2c, 0x63, 0x69, 0xf6, 0x24, 0xff,
-	0x2a, 0xa4, 0xdd, 0x8d, 0xb4, 0xd2, 0x65, 0x24, 0x27, 0x3f, 0x76, 0xd1, 0xfe, 0xdb, 0x82, 0x7f,
-	0xa1, 0x43, 0x0d, 0xf8, 0x23, 0x6a, 0x59, 0x85, 0x72, 0x9d, 0x5e, 0xbd, 0xdf, 0x19, 0xbc, 0xf0,
-	0x37, 0x24, 0xfa, 0x63, 0x06, 0x42, 0xf3, 0x4f, 0x1c, 0xd8, 0x9b, 0x62, 0x38, 0x7a, 0x7c, 0x75,
-	0x73, 0x5c, 0xfb, 0x7d, 0x73, 0x7c, 0xff, 0xce, 0x2a, 0xa8, 0x90, 0x38, 0x40, 0xf7, 0x42, 0x3a,
-	0x15, 0xf2, 0xcb, 0x0c, 0x58, 0x0c, 0x09, 0x08, 0xad, 0xdc, 0x1d, 0x13, 0xd3, 0xdb, 0x18, 0x73,
-	0x1e, 0xd2, 0x29, 0x68, 0x53, 0x6d, 0xd4, 0xc8, 0x03, 0x82, 0x3b, 0x7e, 0xfc, 0x0e, 0x75, 0xa8,
-	0x4c, 0x12, 0xae, 0x0b, 0x5c, 0x7d, 0x2b, 0xdc, 0xba, 0x15, 0x8f, 0x50, 0x2b, 0x03, 0x0a, 0x3c,
-	0xd5, 0xca, 0x6d, 0x6c, 0x85, 0xa9, 0x7c, 0xf8, 0x1c, 0x1d, 0x2a, 0x10, 0x6c, 0xa2, 0xe0, 0xf3,
-	0x1c, 0x04, 0x05, 0xe5, 0xee, 0x1a, 0xd2, 0xf3, 0xff, 0x91, 0xac, 0xd6, 0xc2, 0x0e, 0x72, 0x40,
-	0x39, 0x33, 0xc4, 0x0c, 0xe8, 0x62, 0x8d, 0xd8, 0xdc, 0x9a, 0x98, 0x03, 0x6e, 0x89, 0xef, 0xd1,
-	0x41, 0x48, 0xa7, 0x6b, 0xc0, 0xbd, 0x6d, 0x81, 0xfb, 0x21, 0x9d, 0xde, 0xf2, 0x06, 0xe8, 0xa1,
-	0x80, 0xaf, 0x7a, 0x62, 0x5d, 0x15, 0xd8, 0x6d, 0xf5, 0x9c, 0x7e, 0x23, 0x38, 0xca, 0x97, 0xf6,
-	0x5f, 0x28, 0x4d, 0x78, 0x88, 0x9a, 0x69, 0x98, 0x85, 0x89, 0x72, 0xdb, 0x3d, 0xa7, 0xdf, 0x19,
-	0x3c, 0xf9, 0x47, 0x78, 0x2e, 0xb1, 0xa1, 0xd6, 0x70, 0xc2, 0xd0, 0xe1, 0xdf, 0xa5, 0xf0, 0x23,
-	0xb4, 0x97, 0xca, 0x4c, 0x4f, 0x38, 0x73, 0x9d, 0x9e, 0xd3, 0x6f, 0x07, 0xcd, 0xfc, 0x39, 0x66,
-	0xf8, 0x29, 0x42, 0x65, 0x29, 0xce, 0xdc, 0x1d, 0xb3, 0x6b, 0xdb, 0xc9, 0x98, 0xe1, 0x2e, 0x6a,
-	0x55, 0x5d, 0xeb, 0xa6, 0x6b, 0xf5, 0x1e, 0x5d, 0x5c, 0x2d, 0x3d, 0xe7, 0x7a, 0xe9, 0x39, 0xbf,
-	0x96, 0x9e, 0xf3, 0x7d, 0xe5, 0xd5, 0xae, 0x57, 0x5e, 0xed, 0xe7, 0xca, 0xab, 0x7d, 0x18, 0xc6,
-	0x5c, 0x5f, 0xce, 0x23, 0x9f, 0xca, 0x84, 0x50, 0xa9, 0x12, 0xa9, 0x08, 0x8f, 0xe8, 0x69, 0x2c,
-	0xc9, 0x62, 0x48, 0x12, 0xc9, 0xe6, 0x33, 0x50, 0xc5, 0xdd, 0xbd, 0x7c, 0x7d, 0x5a, 0x9e, 0x9e,
-	0xfe, 0x96, 0x82, 0x8a, 0x9a, 0xe6, 0xec, 0x5e, 0xfd, 0x09, 0x00, 0x00, 0xff, 0xff, 0x06, 0xa2,
-	0xe9, 0xa6, 0xe9, 0x03, 0x00, 0x00,
+	// 565 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0x4f, 0x6f, 0xd3, 0x3e,
+	0x18, 0xc7, 0x9b, 0xb5, 0xbf, 0xae, 0x75, 0xb7, 0x6a, 0xf3, 0xf6, 0x13, 0xa1, 0x88, 0x2c, 0x14,
+	0x81, 0x7a, 0x59, 0xb2, 0x15, 0x84, 0xd4, 0x6b, 0x39, 0x40, 0x25, 0x04, 0x55, 0x76, 0x9b, 0x84,
+	0xaa, 0xd4, 0x79, 0x48, 0xad, 0x26, 0x76, 0x88, 0xdd, 0xc2, 0xde, 0x04, 0xe2, 0x9d, 0xf0, 0x36,
+	0x76, 0xdc, 0x91, 0xd3, 0x84, 0xda, 0x77, 0xc1, 0x09, 0xc5, 0xf9, 0xb3, 0xb2, 0x75, 0x48, 0xbd,
+	0xc5, 0x7e, 0x3e, 0xdf, 0xcf, 0xe3, 0xe8, 0xb1, 0x8c, 0x9e, 0xd0, 0x31, 0xb1, 0x09, 0x8f, 0xc1,
+	0x26, 0x13, 0x97, 0x31, 0x08, 0xec, 0xf9, 0xa9, 0xed, 0x03, 0x03, 0x41, 0x85, 0x15, 0xc5, 0x5c,
+	0x72, 0x7c, 0x40, 0xc7, 0xc4, 0x4a, 0x10, 0x2b, 0x43, 0xac, 0xf9, 0x69, 0xeb, 0xd0, 0xe7, 0x3e,
+	0x57, 0x75, 0x3b, 0xf9, 0x4a, 0xd1, 0xd6, 0x5a, 0x5b, 0x9e, 0x52, 0x48, 0xfb, 0x5b, 0x15, 0xed,
+	0xbc, 0x49, 0xfd, 0x67, 0xd2, 0x95, 0x80, 0x3f, 0xa2, 0x5a, 0x46, 0x08, 0x5d, 0x33, 0xcb, 0x9d,
+	0x46, 0xf7, 0xb9, 0xb5, 0xa6, 0xa3, 0x35, 0xf0, 0x80, 0x49, 0xfa, 0x89, 0x82, 0xf7, 0x3a, 0xdd,
+	0xec, 0x3f, 0xbc, 0xbc, 0x3e, 0x2a, 0xfd, 0xbe, 0x3e, 0xda, 0xbf, 0x53, 0x72, 0x0a, 0x25, 0x76,
+	0xd0, 0x9e, 0x4b, 0xa6, 0x8c, 0x7f, 0x09, 0xc0, 0xf3, 0x21, 0x04, 0x26, 0x85, 0xbe, 0xa5, 0xda,
+	0x98, 0x6b, 0xdb, 0x0c, 0x5d, 0x32, 0x05, 0xa9, 0x8e, 0xd6, 0xaf, 0x24, 0x0d, 0x9c, 0x3b, 0x79,
+	0xfc, 0x16, 0x35, 0x08, 0x0f, 0x43, 0x2a, 0x53, 0x5d, 0x79, 0x23, 0xdd, 0x6a, 0x14, 0xf7, 0x51,
+	0x2d, 0x06, 0x02, 0x34, 0x92, 0x42, 0xaf, 0x6c, 0xa4, 0x29, 0x72, 0x78, 0x88, 0x9a, 0x02, 0x98,
+	0x37, 0x12, 0xf0, 0x79, 0x06, 0x8c, 0x80, 0xd0, 0xff, 0x53, 0xa6, 0xa7, 0xff, 0x32, 0x65, 0x6c,
+	0x26, 0xdb, 0x4d, 0x04, 0xf9, 0x9e, 0x32, 0xc6, 0x40, 0xe6, 0x2b, 0xc6, 0xea, 0xc6, 0xc6, 0x44,
+	0x70, 0x63, 0x7c, 0x8f, 0x76, 0x5d, 0x32, 0x5d, 0x11, 0x6e, 0x6f, 0x2a, 0xdc, 0x71, 0xc9, 0xf4,
+	0xc6, 0xd7, 0x45, 0xff, 0x33, 0xf8, 0x2a, 0x47, 0x59, 0xaa, 0x10, 0xeb, 0x35, 0x53, 0xeb, 0x54,
+	0x9c, 0x83, 0xa4, 0x98, 0xdd, 0x85, 0x3c, 0x84, 0x7b, 0xa8, 0x1a, 0xb9, 0xb1, 0x1b, 0x0a, 0xbd,
+	0x6e, 0x6a, 0x9d, 0x46, 0xf7, 0xd1, 0x3d, 0xcd, 0x13, 0x24, 0x6b, 0x9a, 0x05, 0xf0, 0x39, 0xc2,
+	0x01, 0x27, 0x6e, 0x30, 0xe1, 0xa2, 0xe8, 0x29, 0x74, 0xa4, 0xfe, 0xe1, 0xd9, 0x5a, 0xcd, 0xbb,
+	0x1c, 0xcf, 0x2f, 0x6b, 0x2a, 0xdc, 0x0f, 0x6e, 0xed, 0x8b, 0xf6, 0x0f, 0x0d, 0xed, 0xdd, 0xa6,
+	0xf1, 0x03, 0xb4, 0x1d, 0xf1, 0x58, 0x8e, 0xa8, 0xa7, 0x6b, 0xa6, 0xd6, 0xa9, 0x3b, 0xd5, 0x64,
+	0x39, 0xf0, 0xf0, 0x09, 0x3a, 0x24, 0x7c, 0xc6, 0x24, 0xc4, 0x91, 0x1b, 0xcb, 0x8b, 0x51, 0x4e,
+	0x6d, 0x29, 0x0a, 0xaf, 0xd6, 0x86, 0x69, 0x42, 0x47, 0xdb, 0x73, 0x88, 0x05, 0xe5, 0x4c, 0x2f,
+	0x2b, 0x28, 0x5f, 0xe2, 0x57, 0xa8, 0xc6, 0x63, 0x0f, 0x62, 0xca, 0x7c, 0xbd, 0x62, 0x6a, 0x9d,
+	0x66, 0xb7, 0xb5, 0xf6, 0x5f, 0x3e, 0x24, 0x90, 0x53, 0xb0, 0x6d, 0x0f, 0x35, 0xff, 0x1e, 0xd1,
+	0xfd, 0xc7, 0x7d, 0x8c, 0x50, 0x3e, 0xa2, 0xe2, 0x90, 0xf5, 0x6c, 0x67, 0xe0, 0xe1, 0x16, 0xaa,
+	0x15, 0x93, 0x2b, 0xab, 0xc9, 0x15, 0xeb, 0xfe, 0xd9, 0xe5, 0xc2, 0xd0, 0xae, 0x16, 0x86, 0xf6,
+	0x6b, 0x61, 0x68, 0xdf, 0x97, 0x46, 0xe9, 0x6a, 0x69, 0x94, 0x7e, 0x2e, 0x8d, 0xd2, 0x79, 0xcf,
+	0xa7, 0x72, 0x32, 0x1b, 0x5b, 0x84, 0x87, 0x36, 0xe1, 0x22, 0xe4, 0xc2, 0xa6, 0x63, 0x72, 0xec,
+	0x73, 0x7b, 0xde, 0xb3, 0x43, 0xee, 0xcd, 0x02, 0x10, 0xe9, 0x2b, 0x74, 0xf2, 0xf2, 0x38, 0x7f,
+	0x88, 0xe4, 0x45, 0x04, 0x62, 0x5c, 0x55, 0x8f, 0xd0, 0x8b, 0x3f, 0x03, 0x00, 0x60, 0xde, 0x00,
+	0x17, 0xf7, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -256,6 +343,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.LocalhostChannels) > 0 {
+		for iNdEx := len(m.LocalhostChannels) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LocalhostChannels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -372,6 +473,55 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *LocalhostChannel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LocalhostChannel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LocalhostChannel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Ordering != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Ordering))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.CounterpartyPortId) > 0 {
+		i -= len(m.CounterpartyPortId)
+		copy(dAtA[i:], m.CounterpartyPortId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.CounterpartyPortId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *PacketSequence) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -478,6 +628,36 @@ func (m *GenesisState) Size() (n int) {
 	}
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.LocalhostChannels) > 0 {
+		for _, e := range m.LocalhostChannels {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *LocalhostChannel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.CounterpartyPortId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Ordering != 0 {
+		n += 1 + sovGenesis(uint64(m.Ordering))
+	}
 	return n
 }
 
@@ -826,6 +1006,205 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LocalhostChannels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LocalhostChannels = append(m.LocalhostChannels, LocalhostChannel{})
+			if err := m.LocalhostChannels[len(m.LocalhostChannels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LocalhostChannel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LocalhostChannel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LocalhostChannel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyPortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CounterpartyPortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ordering", wireType)
+			}
+			m.Ordering = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Ordering |= Order(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
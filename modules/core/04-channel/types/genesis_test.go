@@ -214,6 +214,40 @@ func TestValidateGenesis(t *testing.T) {
 			),
 			expPass: false,
 		},
+		{
+			name: "valid localhost channels",
+			genState: func() types.GenesisState {
+				genState := types.DefaultGenesisState()
+				genState.LocalhostChannels = []types.LocalhostChannel{
+					types.NewLocalhostChannel(testPort1, testPort2, testChannelVersion, types.UNORDERED),
+					types.NewLocalhostChannel(testPort1, testPort1, "", testChannelOrder),
+				}
+				return genState
+			}(),
+			expPass: true,
+		},
+		{
+			name: "invalid localhost channel port identifier",
+			genState: func() types.GenesisState {
+				genState := types.DefaultGenesisState()
+				genState.LocalhostChannels = []types.LocalhostChannel{
+					types.NewLocalhostChannel(testPort1, "(invalidport)", testChannelVersion, testChannelOrder),
+				}
+				return genState
+			}(),
+			expPass: false,
+		},
+		{
+			name: "invalid localhost channel ordering",
+			genState: func() types.GenesisState {
+				genState := types.DefaultGenesisState()
+				genState.LocalhostChannels = []types.LocalhostChannel{
+					types.NewLocalhostChannel(testPort1, testPort2, testChannelVersion, types.NONE),
+				}
+				return genState
+			}(),
+			expPass: false,
+		},
 	}
 
 	for _, tc := range testCases {
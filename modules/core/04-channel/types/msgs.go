@@ -32,6 +32,7 @@ var (
 	_ sdk.Msg = (*MsgChannelUpgradeTimeout)(nil)
 	_ sdk.Msg = (*MsgChannelUpgradeCancel)(nil)
 	_ sdk.Msg = (*MsgPruneAcknowledgements)(nil)
+	_ sdk.Msg = (*MsgOpenLocalhostChannel)(nil)
 
 	_ sdk.HasValidateBasic = (*MsgChannelOpenInit)(nil)
 	_ sdk.HasValidateBasic = (*MsgChannelOpenTry)(nil)
@@ -50,6 +51,7 @@ var (
 	_ sdk.HasValidateBasic = (*MsgChannelUpgradeTimeout)(nil)
 	_ sdk.HasValidateBasic = (*MsgChannelUpgradeCancel)(nil)
 	_ sdk.HasValidateBasic = (*MsgPruneAcknowledgements)(nil)
+	_ sdk.HasValidateBasic = (*MsgOpenLocalhostChannel)(nil)
 )
 
 // NewMsgChannelOpenInit creates a new MsgChannelOpenInit. It sets the counterparty channel
@@ -774,3 +776,29 @@ func (msg *MsgPruneAcknowledgements) ValidateBasic() error {
 
 	return nil
 }
+
+// NewMsgOpenLocalhostChannel creates a new instance of MsgOpenLocalhostChannel.
+func NewMsgOpenLocalhostChannel(portID, counterpartyPortID, version string, ordering Order, signer string) *MsgOpenLocalhostChannel {
+	return &MsgOpenLocalhostChannel{
+		PortId:             portID,
+		CounterpartyPortId: counterpartyPortID,
+		Version:            version,
+		Ordering:           ordering,
+		Signer:             signer,
+	}
+}
+
+// ValidateBasic performs basic checks on a MsgOpenLocalhostChannel.
+func (msg *MsgOpenLocalhostChannel) ValidateBasic() error {
+	localhostChannel := NewLocalhostChannel(msg.PortId, msg.CounterpartyPortId, msg.Version, msg.Ordering)
+	if err := localhostChannel.ValidateBasic(); err != nil {
+		return err
+	}
+
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	return nil
+}
@@ -1927,3 +1927,71 @@ func (suite *TypesTestSuite) TestMsgPruneAcknowledgementsGetSigners() {
 	suite.Require().NoError(err)
 	suite.Require().Equal(expSigner.Bytes(), signers[0])
 }
+
+func (suite *TypesTestSuite) TestMsgOpenLocalhostChannelValidateBasic() {
+	var msg *types.MsgOpenLocalhostChannel
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"success: empty version",
+			func() {
+				msg.Version = ""
+			},
+			nil,
+		},
+		{
+			"invalid port identifier",
+			func() {
+				msg.PortId = invalidPort
+			},
+			host.ErrInvalidID,
+		},
+		{
+			"invalid counterparty port identifier",
+			func() {
+				msg.CounterpartyPortId = invalidPort
+			},
+			host.ErrInvalidID,
+		},
+		{
+			"invalid channel ordering",
+			func() {
+				msg.Ordering = types.NONE
+			},
+			types.ErrInvalidChannelOrdering,
+		},
+		{
+			"empty signer address",
+			func() {
+				msg.Signer = emptyAddr
+			},
+			ibcerrors.ErrInvalidAddress,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			msg = types.NewMsgOpenLocalhostChannel(ibctesting.MockPort, ibctesting.MockPort, ibctesting.DefaultChannelVersion, types.UNORDERED, addr)
+
+			tc.malleate()
+			err := msg.ValidateBasic()
+
+			expPass := tc.expErr == nil
+			if expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
@@ -1630,6 +1630,93 @@ func (m *MsgPruneAcknowledgementsResponse) GetTotalRemainingSequences() uint64 {
 	return 0
 }
 
+// MsgOpenLocalhostChannel defines an sdk.Msg to open a channel over the localhost connection.
+// As both channel ends exist on the same chain, all four handshake steps are performed atomically.
+type MsgOpenLocalhostChannel struct {
+	// the port on which the handshake is initialised
+	PortId string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// the port of the counterparty channel end
+	CounterpartyPortId string `protobuf:"bytes,2,opt,name=counterparty_port_id,json=counterpartyPortId,proto3" json:"counterparty_port_id,omitempty"`
+	// the proposed channel version
+	Version string `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+	// the channel ordering
+	Ordering Order  `protobuf:"varint,4,opt,name=ordering,proto3,enum=ibc.core.channel.v1.Order" json:"ordering,omitempty"`
+	Signer   string `protobuf:"bytes,5,opt,name=signer,proto3" json:"signer,omitempty"`
+}
+
+func (m *MsgOpenLocalhostChannel) Reset()         { *m = MsgOpenLocalhostChannel{} }
+func (m *MsgOpenLocalhostChannel) String() string { return proto.CompactTextString(m) }
+func (*MsgOpenLocalhostChannel) ProtoMessage()    {}
+func (*MsgOpenLocalhostChannel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bc4637e0ac3fc7b7, []int{38}
+}
+func (m *MsgOpenLocalhostChannel) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgOpenLocalhostChannel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgOpenLocalhostChannel.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgOpenLocalhostChannel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgOpenLocalhostChannel.Merge(m, src)
+}
+func (m *MsgOpenLocalhostChannel) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgOpenLocalhostChannel) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgOpenLocalhostChannel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgOpenLocalhostChannel proto.InternalMessageInfo
+
+// MsgOpenLocalhostChannelResponse defines the Msg/OpenLocalhostChannel response type.
+type MsgOpenLocalhostChannelResponse struct {
+	ChannelId             string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	CounterpartyChannelId string `protobuf:"bytes,2,opt,name=counterparty_channel_id,json=counterpartyChannelId,proto3" json:"counterparty_channel_id,omitempty"`
+	Version               string `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (m *MsgOpenLocalhostChannelResponse) Reset()         { *m = MsgOpenLocalhostChannelResponse{} }
+func (m *MsgOpenLocalhostChannelResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgOpenLocalhostChannelResponse) ProtoMessage()    {}
+func (*MsgOpenLocalhostChannelResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bc4637e0ac3fc7b7, []int{39}
+}
+func (m *MsgOpenLocalhostChannelResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgOpenLocalhostChannelResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgOpenLocalhostChannelResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgOpenLocalhostChannelResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgOpenLocalhostChannelResponse.Merge(m, src)
+}
+func (m *MsgOpenLocalhostChannelResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgOpenLocalhostChannelResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgOpenLocalhostChannelResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgOpenLocalhostChannelResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterEnum("ibc.core.channel.v1.ResponseResultType", ResponseResultType_name, ResponseResultType_value)
 	proto.RegisterType((*MsgChannelOpenInit)(nil), "ibc.core.channel.v1.MsgChannelOpenInit")
@@ -1670,136 +1757,143 @@ func init() {
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "ibc.core.channel.v1.MsgUpdateParamsResponse")
 	proto.RegisterType((*MsgPruneAcknowledgements)(nil), "ibc.core.channel.v1.MsgPruneAcknowledgements")
 	proto.RegisterType((*MsgPruneAcknowledgementsResponse)(nil), "ibc.core.channel.v1.MsgPruneAcknowledgementsResponse")
+	proto.RegisterType((*MsgOpenLocalhostChannel)(nil), "ibc.core.channel.v1.MsgOpenLocalhostChannel")
+	proto.RegisterType((*MsgOpenLocalhostChannelResponse)(nil), "ibc.core.channel.v1.MsgOpenLocalhostChannelResponse")
 }
 
 func init() { proto.RegisterFile("ibc/core/channel/v1/tx.proto", fileDescriptor_bc4637e0ac3fc7b7) }
 
 var fileDescriptor_bc4637e0ac3fc7b7 = []byte{
-	// 1975 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0xcf, 0x6f, 0xdb, 0xc8,
-	0x15, 0x36, 0xf5, 0x33, 0x7e, 0x4e, 0xd6, 0x0a, 0xe5, 0xc4, 0x32, 0x6d, 0x4b, 0x8a, 0x5a, 0x6c,
-	0xbc, 0x6e, 0x22, 0xad, 0xbd, 0x49, 0x81, 0x04, 0x0b, 0xb4, 0x8e, 0xaa, 0x74, 0x0d, 0xc4, 0xb1,
-	0x41, 0x59, 0x45, 0xbb, 0x5b, 0x54, 0x90, 0xa9, 0x09, 0x45, 0x48, 0x22, 0xb9, 0x24, 0xa5, 0x5d,
-	0x17, 0x68, 0xb1, 0xe8, 0x29, 0xc8, 0x61, 0xd1, 0x02, 0x7b, 0x0d, 0xd0, 0xa2, 0xff, 0xc0, 0x9e,
-	0xfb, 0xe3, 0xd0, 0xdb, 0x9e, 0x8a, 0x3d, 0x2e, 0x0a, 0x74, 0x51, 0x24, 0x87, 0xbd, 0xf4, 0x2f,
-	0x28, 0x50, 0xa0, 0xe0, 0xcc, 0x90, 0xa2, 0xc8, 0xa1, 0x44, 0x59, 0xaa, 0xb1, 0x37, 0x71, 0xe6,
-	0x9b, 0xf7, 0xde, 0x7c, 0xdf, 0x9b, 0xc7, 0x99, 0xa1, 0x60, 0x4b, 0x39, 0x93, 0x2a, 0x92, 0x66,
-	0xa0, 0x8a, 0xd4, 0x69, 0xa9, 0x2a, 0xea, 0x55, 0x86, 0x7b, 0x15, 0xeb, 0xe3, 0xb2, 0x6e, 0x68,
-	0x96, 0xc6, 0x67, 0x95, 0x33, 0xa9, 0x6c, 0xf7, 0x96, 0x69, 0x6f, 0x79, 0xb8, 0x27, 0xac, 0xc9,
-	0x9a, 0xac, 0xe1, 0xfe, 0x8a, 0xfd, 0x8b, 0x40, 0x85, 0x75, 0x49, 0x33, 0xfb, 0x9a, 0x59, 0xe9,
-	0x9b, 0xb2, 0x6d, 0xa2, 0x6f, 0xca, 0xb4, 0xa3, 0x30, 0xf2, 0xd0, 0x53, 0x90, 0x6a, 0xd9, 0xbd,
-	0xe4, 0x17, 0x05, 0xdc, 0x62, 0x85, 0xe0, 0xf8, 0x9b, 0x00, 0x19, 0xe8, 0xb2, 0xd1, 0x6a, 0x23,
-	0x02, 0x29, 0x7d, 0xc6, 0x01, 0x7f, 0x64, 0xca, 0x55, 0xd2, 0x7f, 0xac, 0x23, 0xf5, 0x50, 0x55,
-	0x2c, 0x7e, 0x1d, 0xd2, 0xba, 0x66, 0x58, 0x4d, 0xa5, 0x9d, 0xe3, 0x8a, 0xdc, 0xce, 0xb2, 0x98,
-	0xb2, 0x1f, 0x0f, 0xdb, 0xfc, 0xbb, 0x90, 0xa6, 0xb6, 0x72, 0xb1, 0x22, 0xb7, 0xb3, 0xb2, 0xbf,
-	0x55, 0x66, 0x4c, 0xb6, 0x4c, 0xed, 0x3d, 0x4a, 0x7c, 0xf1, 0x75, 0x61, 0x49, 0x74, 0x86, 0xf0,
-	0x37, 0x21, 0x65, 0x2a, 0xb2, 0x8a, 0x8c, 0x5c, 0x9c, 0x58, 0x25, 0x4f, 0x0f, 0x57, 0x9f, 0xff,
-	0xbe, 0xb0, 0xf4, 0x9b, 0x6f, 0x3e, 0xdf, 0xa5, 0x0d, 0xa5, 0x0f, 0x40, 0x08, 0x46, 0x25, 0x22,
-	0x53, 0xd7, 0x54, 0x13, 0xf1, 0xdb, 0x00, 0xd4, 0xe2, 0x28, 0xc0, 0x65, 0xda, 0x72, 0xd8, 0xe6,
-	0x73, 0x90, 0x1e, 0x22, 0xc3, 0x54, 0x34, 0x15, 0xc7, 0xb8, 0x2c, 0x3a, 0x8f, 0x0f, 0x13, 0xb6,
-	0x9f, 0xd2, 0xd7, 0x31, 0xb8, 0x3e, 0x6e, 0xfd, 0xd4, 0x38, 0x0f, 0x9f, 0xf2, 0x3e, 0x64, 0x75,
-	0x03, 0x0d, 0x15, 0x6d, 0x60, 0x36, 0x3d, 0x6e, 0xb1, 0xe9, 0x47, 0xb1, 0x1c, 0x27, 0x5e, 0x77,
-	0xba, 0xab, 0x6e, 0x08, 0x1e, 0x9a, 0xe2, 0xb3, 0xd3, 0xb4, 0x07, 0x6b, 0x92, 0x36, 0x50, 0x2d,
-	0x64, 0xe8, 0x2d, 0xc3, 0x3a, 0x6f, 0x3a, 0xb3, 0x49, 0xe0, 0xb8, 0xb2, 0xde, 0xbe, 0x9f, 0x90,
-	0x2e, 0x9b, 0x12, 0xdd, 0xd0, 0xb4, 0x67, 0x4d, 0x45, 0x55, 0xac, 0x5c, 0xb2, 0xc8, 0xed, 0x5c,
-	0x15, 0x97, 0x71, 0x0b, 0xd6, 0xb3, 0x0a, 0x57, 0x49, 0x77, 0x07, 0x29, 0x72, 0xc7, 0xca, 0xa5,
-	0x70, 0x50, 0x82, 0x27, 0x28, 0x92, 0x5a, 0xc3, 0xbd, 0xf2, 0x7b, 0x18, 0x41, 0x43, 0x5a, 0xc1,
-	0xa3, 0x48, 0x93, 0x47, 0xbd, 0xf4, 0x64, 0xf5, 0xde, 0x87, 0x8d, 0x00, 0xbf, 0xae, 0x78, 0x1e,
-	0x75, 0xb8, 0x31, 0x75, 0x7c, 0xb2, 0xc6, 0x7c, 0xb2, 0x52, 0xf1, 0xfe, 0x16, 0x10, 0xef, 0x40,
-	0xea, 0x86, 0x8b, 0x37, 0xd9, 0x26, 0xff, 0x7d, 0x58, 0x1f, 0x63, 0xda, 0x83, 0x25, 0x19, 0x7a,
-	0xc3, 0xdb, 0x3d, 0xd2, 0xf7, 0x02, 0x0a, 0x6d, 0x02, 0xd1, 0xa3, 0x69, 0x19, 0xe7, 0x54, 0xa0,
-	0x2b, 0xb8, 0xc1, 0x4e, 0xbe, 0xcb, 0xd5, 0x67, 0xd3, 0xaf, 0xcf, 0x81, 0xd4, 0x75, 0xf4, 0x29,
-	0xfd, 0x83, 0x83, 0x1b, 0xe3, 0xbd, 0x55, 0x4d, 0x7d, 0xa6, 0x18, 0xfd, 0x0b, 0x93, 0xec, 0xce,
-	0xbc, 0x25, 0x75, 0x31, 0xad, 0xce, 0xcc, 0x6d, 0xe5, 0xfc, 0x33, 0x4f, 0xcc, 0x37, 0xf3, 0xe4,
-	0xe4, 0x99, 0x17, 0x60, 0x9b, 0x39, 0x37, 0x77, 0xf6, 0x43, 0xc8, 0x8e, 0x00, 0xd5, 0x9e, 0x66,
-	0xa2, 0xc9, 0xf5, 0x70, 0xca, 0xd4, 0x23, 0x17, 0xbc, 0x6d, 0xd8, 0x64, 0xf8, 0x75, 0xc3, 0xfa,
-	0x43, 0x0c, 0x6e, 0xfa, 0xfa, 0xe7, 0x55, 0x65, 0xbc, 0x62, 0xc4, 0xa7, 0x55, 0x8c, 0x45, 0xea,
-	0xc2, 0x3f, 0x82, 0xed, 0xb1, 0xe5, 0x43, 0xdf, 0x49, 0x4d, 0x13, 0x7d, 0x38, 0x40, 0xaa, 0x84,
-	0x70, 0xfe, 0x27, 0xc4, 0x4d, 0x2f, 0xa8, 0x41, 0x30, 0x75, 0x0a, 0x09, 0x52, 0x58, 0x84, 0x3c,
-	0x9b, 0x22, 0x97, 0xc5, 0xd7, 0x1c, 0x5c, 0x3b, 0x32, 0x65, 0x11, 0x49, 0xc3, 0x93, 0x96, 0xd4,
-	0x45, 0x16, 0xff, 0x00, 0x52, 0x3a, 0xfe, 0x85, 0xb9, 0x5b, 0xd9, 0xdf, 0x64, 0x96, 0x69, 0x02,
-	0xa6, 0x13, 0xa4, 0x03, 0xf8, 0xb7, 0x20, 0x43, 0x08, 0x92, 0xb4, 0x7e, 0x5f, 0xb1, 0xfa, 0x48,
-	0xb5, 0x30, 0xc9, 0x57, 0xc5, 0x55, 0xdc, 0x5e, 0x75, 0x9b, 0x03, 0x5c, 0xc6, 0xe7, 0xe3, 0x32,
-	0x31, 0x39, 0x95, 0x7e, 0x81, 0xd7, 0xef, 0x68, 0x92, 0x6e, 0xe5, 0xfd, 0x01, 0xa4, 0x0c, 0x64,
-	0x0e, 0x7a, 0x64, 0xb2, 0x6f, 0xec, 0xdf, 0x66, 0x4e, 0xd6, 0x81, 0x8b, 0x18, 0x7a, 0x7a, 0xae,
-	0x23, 0x91, 0x0e, 0xa3, 0x15, 0xf8, 0xd3, 0x18, 0xc0, 0x91, 0x29, 0x9f, 0x2a, 0x7d, 0xa4, 0x0d,
-	0x16, 0x43, 0xe1, 0x40, 0x35, 0x90, 0x84, 0x94, 0x21, 0x6a, 0x8f, 0x51, 0xd8, 0x70, 0x9b, 0x17,
-	0x43, 0xe1, 0x1d, 0xe0, 0x55, 0xf4, 0xb1, 0xe5, 0xa6, 0x59, 0xd3, 0x40, 0xd2, 0x10, 0xd3, 0x99,
-	0x10, 0x33, 0x76, 0x8f, 0x93, 0x5c, 0x36, 0x79, 0xd1, 0x8b, 0xca, 0x07, 0x78, 0x0b, 0x45, 0xf9,
-	0x58, 0x34, 0xdb, 0xff, 0x21, 0xef, 0x3b, 0x6a, 0xfd, 0x58, 0xc5, 0x89, 0x7d, 0x49, 0xa4, 0x17,
-	0x60, 0x85, 0xa6, 0xb8, 0xed, 0x94, 0xd6, 0x08, 0x52, 0x35, 0x48, 0x18, 0x0b, 0x29, 0x12, 0x6c,
-	0x55, 0x92, 0x53, 0x55, 0x49, 0xcd, 0x56, 0x52, 0xd2, 0x17, 0x28, 0x29, 0x67, 0xf8, 0x45, 0x39,
-	0xce, 0xfd, 0xa2, 0x05, 0x7e, 0x1e, 0xc3, 0xe9, 0x73, 0x20, 0x75, 0x55, 0xed, 0xa3, 0x1e, 0x6a,
-	0xcb, 0x08, 0xd7, 0x8c, 0x39, 0x14, 0xde, 0x81, 0xd5, 0xd6, 0xb8, 0x35, 0x47, 0x60, 0x5f, 0xf3,
-	0x48, 0x60, 0x7b, 0x60, 0x7b, 0x4c, 0xe0, 0x03, 0xbb, 0xe5, 0x92, 0xdf, 0xce, 0x12, 0xde, 0xf5,
-	0xfb, 0x98, 0x58, 0x34, 0xdf, 0x7f, 0x1a, 0xdb, 0xdf, 0xd0, 0x14, 0x98, 0xeb, 0x25, 0xff, 0x43,
-	0x48, 0x3d, 0x53, 0x50, 0xaf, 0x6d, 0xd2, 0xaa, 0x54, 0x62, 0x06, 0x46, 0x3d, 0x3d, 0xc6, 0x48,
-	0x47, 0x31, 0x32, 0x2e, 0x7a, 0x6d, 0xff, 0x94, 0xf3, 0x6e, 0x60, 0x3c, 0xc1, 0xbb, 0x2c, 0xbd,
-	0x0b, 0x69, 0x9a, 0xfa, 0x34, 0x71, 0xb6, 0x26, 0x45, 0xe3, 0x9c, 0x3c, 0xe8, 0x10, 0xbb, 0x38,
-	0x04, 0x16, 0x4e, 0x0c, 0x2f, 0x9c, 0xd5, 0x81, 0x6f, 0xb1, 0x10, 0x36, 0xff, 0x1b, 0x87, 0xb5,
-	0x40, 0x40, 0x13, 0x8f, 0x53, 0x53, 0xc8, 0xfc, 0x31, 0x14, 0x75, 0x43, 0xd3, 0x35, 0x13, 0xb5,
-	0xdd, 0x35, 0x2c, 0x69, 0xaa, 0x8a, 0x24, 0x4b, 0xd1, 0xd4, 0x66, 0x47, 0xd3, 0x6d, 0x9a, 0xe3,
-	0x3b, 0xcb, 0xe2, 0xb6, 0x83, 0xa3, 0x5e, 0xab, 0x2e, 0xea, 0x3d, 0x4d, 0x37, 0xf9, 0x0e, 0x6c,
-	0x32, 0x0b, 0x02, 0x95, 0x2a, 0x31, 0xa3, 0x54, 0x1b, 0x8c, 0xc2, 0x41, 0x00, 0xd3, 0x4b, 0x4f,
-	0x72, 0x6a, 0xe9, 0xe1, 0xbf, 0x03, 0xd7, 0x68, 0xa9, 0xa5, 0xc7, 0xc6, 0x14, 0x5e, 0x8b, 0x64,
-	0xf5, 0x51, 0x76, 0x47, 0x20, 0x47, 0xe1, 0xb4, 0x07, 0x44, 0x2d, 0x06, 0x96, 0xec, 0x95, 0xf9,
-	0x96, 0xec, 0xf2, 0xe4, 0x84, 0xfc, 0x3b, 0x07, 0x5b, 0x2c, 0xfd, 0x2f, 0x3d, 0x1f, 0x3d, 0xe5,
-	0x21, 0x3e, 0x4f, 0x79, 0xf8, 0x67, 0x8c, 0x91, 0xd0, 0xf3, 0x1c, 0x31, 0x1b, 0xbe, 0xa3, 0xa2,
-	0xc3, 0x46, 0x3c, 0x32, 0x1b, 0x59, 0x46, 0xe2, 0x04, 0x13, 0x26, 0x11, 0x25, 0x61, 0x92, 0x11,
-	0x12, 0xe6, 0xff, 0x7b, 0xf6, 0x44, 0x8c, 0x7c, 0xf1, 0x1c, 0x3f, 0x17, 0x55, 0xe5, 0xff, 0x1c,
-	0x87, 0x5c, 0xc0, 0xcf, 0xbc, 0x47, 0xa6, 0x9f, 0x82, 0xc0, 0xbc, 0x2d, 0x30, 0xad, 0x96, 0x85,
-	0x68, 0xda, 0x09, 0xcc, 0x78, 0xeb, 0x36, 0x42, 0xcc, 0x31, 0x2e, 0x13, 0x70, 0x4f, 0x68, 0x92,
-	0x24, 0x16, 0x9c, 0x24, 0xc9, 0x28, 0x49, 0x92, 0x8a, 0x90, 0x24, 0xe9, 0xf9, 0x92, 0xe4, 0xca,
-	0xe4, 0x24, 0x51, 0xa0, 0x18, 0x26, 0xde, 0xa2, 0x13, 0xe5, 0x93, 0x38, 0x63, 0x3b, 0x70, 0xac,
-	0x23, 0xf5, 0x5b, 0x98, 0x25, 0x53, 0x5f, 0x34, 0x89, 0x0b, 0xbc, 0x68, 0x58, 0x29, 0x71, 0xb9,
-	0x25, 0xa1, 0xc0, 0xd8, 0xd3, 0xd8, 0x0a, 0xb8, 0xe7, 0xf6, 0xbf, 0xc4, 0x18, 0x8b, 0xd9, 0x39,
-	0x7f, 0x2e, 0xaa, 0x2e, 0xcf, 0x7e, 0x5f, 0x9b, 0x65, 0x08, 0x15, 0xad, 0x2e, 0xfb, 0xf9, 0x4d,
-	0xce, 0xc7, 0x6f, 0x6a, 0x32, 0xbf, 0x25, 0xc6, 0x6a, 0xf2, 0x9d, 0x56, 0x4b, 0x7f, 0x8d, 0xc1,
-	0x7a, 0x70, 0xc9, 0xb5, 0x54, 0x09, 0xf5, 0x2e, 0xcc, 0xf0, 0x13, 0xb8, 0x86, 0x0c, 0x43, 0x33,
-	0x9a, 0xf8, 0x40, 0xa9, 0x3b, 0x87, 0xf6, 0x5b, 0x4c, 0x6a, 0x6b, 0x36, 0x52, 0x24, 0x40, 0x3a,
-	0xdb, 0xab, 0xc8, 0xd3, 0xc6, 0x97, 0x21, 0x4b, 0x38, 0x1b, 0xb7, 0x49, 0xe8, 0xbd, 0x8e, 0xbb,
-	0xbc, 0x36, 0x2e, 0x99, 0xe3, 0x5b, 0x50, 0x08, 0xa1, 0xcf, 0xa5, 0xf8, 0xd7, 0xb0, 0x7a, 0x64,
-	0xca, 0x0d, 0xbd, 0xdd, 0xb2, 0xd0, 0x49, 0xcb, 0x68, 0xf5, 0x4d, 0x7e, 0x0b, 0x96, 0x5b, 0x03,
-	0xab, 0xa3, 0x19, 0x8a, 0x75, 0xee, 0x7c, 0xc7, 0x70, 0x1b, 0xc8, 0x11, 0xd0, 0xc6, 0xd1, 0x4f,
-	0x2d, 0x61, 0x47, 0x40, 0x1b, 0x32, 0x3a, 0x02, 0xda, 0x4f, 0x0f, 0x79, 0x27, 0xbe, 0x91, 0xb9,
-	0xd2, 0x06, 0x56, 0xd8, 0xeb, 0xdf, 0x0d, 0xed, 0x77, 0x1c, 0x5e, 0x60, 0x27, 0xc6, 0x40, 0x45,
-	0xbe, 0xe3, 0x97, 0x79, 0x61, 0xf9, 0xd7, 0x20, 0xd9, 0x53, 0xfa, 0xf4, 0x6e, 0x31, 0x21, 0x92,
-	0x87, 0xe8, 0x47, 0x9d, 0xcf, 0x38, 0x9c, 0xb6, 0xcc, 0x98, 0xdc, 0x97, 0xc0, 0x3d, 0xb8, 0x69,
-	0x69, 0x56, 0xab, 0xd7, 0xd4, 0x6d, 0x58, 0xdb, 0xad, 0x84, 0x26, 0x0e, 0x35, 0x21, 0xae, 0xe1,
-	0x5e, 0x6c, 0xa3, 0xed, 0x94, 0x40, 0x93, 0x7f, 0x08, 0x1b, 0x64, 0x94, 0x81, 0xfa, 0x2d, 0x45,
-	0x55, 0x54, 0xd9, 0x33, 0x90, 0x6c, 0x2f, 0xd7, 0x31, 0x40, 0x74, 0xfa, 0xdd, 0xb1, 0xbb, 0x5f,
-	0x71, 0xc0, 0x07, 0x5f, 0x2a, 0xfc, 0x7d, 0x28, 0x8a, 0xb5, 0xfa, 0xc9, 0xf1, 0xd3, 0x7a, 0xad,
-	0x29, 0xd6, 0xea, 0x8d, 0x27, 0xa7, 0xcd, 0xd3, 0x9f, 0x9d, 0xd4, 0x9a, 0x8d, 0xa7, 0xf5, 0x93,
-	0x5a, 0xf5, 0xf0, 0xf1, 0x61, 0xed, 0x47, 0x99, 0x25, 0x61, 0xf5, 0xc5, 0xcb, 0xe2, 0x8a, 0xa7,
-	0x89, 0xbf, 0x0d, 0x1b, 0xcc, 0x61, 0x4f, 0x8f, 0x8f, 0x4f, 0x32, 0x9c, 0x70, 0xe5, 0xc5, 0xcb,
-	0x62, 0xc2, 0xfe, 0xcd, 0xdf, 0x85, 0x2d, 0x26, 0xb0, 0xde, 0xa8, 0x56, 0x6b, 0xf5, 0x7a, 0x26,
-	0x26, 0xac, 0xbc, 0x78, 0x59, 0x4c, 0xd3, 0xc7, 0x50, 0xf8, 0xe3, 0x83, 0xc3, 0x27, 0x0d, 0xb1,
-	0x96, 0x89, 0x13, 0x38, 0x7d, 0x14, 0x12, 0xcf, 0xff, 0x98, 0x5f, 0xda, 0xff, 0x77, 0x06, 0xe2,
-	0x47, 0xa6, 0xcc, 0x77, 0x61, 0xd5, 0xff, 0x3d, 0x90, 0xfd, 0x72, 0x0d, 0x7e, 0xa2, 0x13, 0x2a,
-	0x11, 0x81, 0xae, 0x82, 0x1d, 0x78, 0xc3, 0xf7, 0x21, 0xee, 0xcd, 0x08, 0x26, 0x4e, 0x8d, 0x73,
-	0xa1, 0x1c, 0x0d, 0x17, 0xe2, 0xc9, 0xde, 0xd2, 0x47, 0xf1, 0x74, 0x20, 0x75, 0x23, 0x79, 0xf2,
-	0xee, 0x61, 0x2d, 0xe0, 0x19, 0x9f, 0x4f, 0x76, 0x23, 0x58, 0xa1, 0x58, 0x61, 0x3f, 0x3a, 0xd6,
-	0xf5, 0xaa, 0x42, 0x26, 0xf0, 0xdd, 0x62, 0x67, 0x8a, 0x1d, 0x17, 0x29, 0xbc, 0x1d, 0x15, 0xe9,
-	0xfa, 0xfb, 0x08, 0xb2, 0xac, 0xef, 0x11, 0xdf, 0x8b, 0x62, 0xc8, 0x99, 0xe7, 0x3b, 0x33, 0x80,
-	0x5d, 0xc7, 0x3f, 0x07, 0xf0, 0x5c, 0xe1, 0x97, 0xc2, 0x4c, 0x8c, 0x30, 0xc2, 0xee, 0x74, 0x8c,
-	0x6b, 0xbd, 0x0e, 0x69, 0x67, 0x6b, 0x51, 0x08, 0x1b, 0x46, 0x01, 0xc2, 0xed, 0x29, 0x00, 0x6f,
-	0xee, 0xf9, 0x6e, 0x70, 0xdf, 0x9c, 0x32, 0x94, 0xe2, 0xc2, 0x73, 0x2f, 0xe4, 0x56, 0xb2, 0x0b,
-	0xab, 0xfe, 0xab, 0xc4, 0xd0, 0x28, 0x7d, 0xc0, 0xf0, 0xc5, 0x1b, 0x76, 0x25, 0x37, 0x4a, 0x74,
-	0xef, 0x3d, 0xda, 0xb4, 0x44, 0xf7, 0x60, 0xa7, 0x26, 0x3a, 0xeb, 0x8a, 0xeb, 0x43, 0xb8, 0x1e,
-	0xbc, 0x6f, 0x7a, 0x2b, 0x9a, 0x21, 0xbb, 0x70, 0xec, 0x45, 0x86, 0x86, 0xbb, 0xb4, 0xcb, 0x47,
-	0x44, 0x97, 0x76, 0x05, 0xd9, 0x8b, 0x0c, 0x75, 0x5d, 0xfe, 0x0a, 0x6e, 0xb0, 0x4f, 0xaf, 0x77,
-	0xa3, 0xd9, 0x72, 0x96, 0xd8, 0xfd, 0x99, 0xe0, 0xe1, 0xd2, 0xe2, 0x33, 0x51, 0x44, 0x69, 0x6d,
-	0x6c, 0x54, 0x69, 0xbd, 0x3b, 0xfd, 0xe0, 0xa4, 0x9d, 0xa5, 0x18, 0x71, 0xd2, 0xce, 0xc2, 0xbc,
-	0x3f, 0x13, 0xdc, 0x75, 0xff, 0x4b, 0x58, 0x63, 0xee, 0x80, 0xef, 0x44, 0xe4, 0x10, 0xa3, 0x85,
-	0x7b, 0xb3, 0xa0, 0x5d, 0xdf, 0x0a, 0x64, 0xc9, 0xde, 0x8c, 0xa2, 0xe8, 0x16, 0xf1, 0xbb, 0x61,
-	0xc6, 0xbc, 0x1b, 0x39, 0xe1, 0x4e, 0x14, 0x94, 0x97, 0x65, 0xf6, 0x56, 0x2f, 0x94, 0x65, 0x26,
-	0x3c, 0x9c, 0xe5, 0x89, 0x9b, 0x36, 0x21, 0xf9, 0xc9, 0x37, 0x9f, 0xef, 0x72, 0x8f, 0xea, 0x5f,
-	0xbc, 0xca, 0x73, 0x5f, 0xbe, 0xca, 0x73, 0xff, 0x7a, 0x95, 0xe7, 0x7e, 0xfb, 0x3a, 0xbf, 0xf4,
-	0xe5, 0xeb, 0xfc, 0xd2, 0x57, 0xaf, 0xf3, 0x4b, 0xef, 0x3f, 0x90, 0x15, 0xab, 0x33, 0x38, 0x2b,
-	0x4b, 0x5a, 0xbf, 0x42, 0xff, 0x1f, 0xa5, 0x9c, 0x49, 0x77, 0x65, 0xad, 0x32, 0x7c, 0x50, 0xe9,
-	0x6b, 0xed, 0x41, 0x0f, 0x99, 0xe4, 0x7f, 0x4d, 0x6f, 0xdf, 0xbb, 0xeb, 0xfc, 0xb5, 0xc9, 0x3a,
-	0xd7, 0x91, 0x79, 0x96, 0xc2, 0x7f, 0x6b, 0x7a, 0xe7, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x5b,
-	0x64, 0x1e, 0xc4, 0xa1, 0x25, 0x00, 0x00,
+	// 2056 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0xcd, 0x6f, 0x1b, 0xc7,
+	0x15, 0xd7, 0xf2, 0x53, 0x7a, 0xb2, 0x23, 0x69, 0x29, 0x5b, 0xd4, 0xea, 0x83, 0x34, 0x5b, 0xc4,
+	0x8a, 0x6a, 0x93, 0x96, 0x62, 0x07, 0xb0, 0x11, 0xa0, 0x95, 0x59, 0xba, 0x11, 0x60, 0x59, 0xc2,
+	0x52, 0x2a, 0xda, 0xa4, 0x28, 0x41, 0x2d, 0xc7, 0xe4, 0x42, 0xe4, 0xce, 0x66, 0x77, 0xc9, 0x44,
+	0x05, 0x5a, 0x04, 0x3d, 0x19, 0x3e, 0x04, 0x2d, 0x90, 0x43, 0x2f, 0x06, 0x5a, 0xf4, 0x1f, 0xc8,
+	0xb9, 0x1f, 0x87, 0xde, 0x72, 0x2a, 0x72, 0x0c, 0x0a, 0x34, 0x2d, 0xac, 0x43, 0xfa, 0x37, 0x14,
+	0x28, 0x50, 0xec, 0xcc, 0xec, 0x72, 0x49, 0xce, 0x92, 0x43, 0x91, 0x15, 0x7a, 0xe3, 0xce, 0xfc,
+	0xe6, 0x7d, 0xfc, 0xde, 0x9b, 0xb7, 0xf3, 0x76, 0x08, 0xeb, 0xfa, 0xa9, 0x56, 0xd0, 0xb0, 0x85,
+	0x0a, 0x5a, 0xa3, 0x6a, 0x18, 0xa8, 0x59, 0xe8, 0xec, 0x14, 0x9c, 0x8f, 0xf3, 0xa6, 0x85, 0x1d,
+	0x2c, 0xa7, 0xf4, 0x53, 0x2d, 0xef, 0xce, 0xe6, 0xd9, 0x6c, 0xbe, 0xb3, 0xa3, 0x2c, 0xd7, 0x71,
+	0x1d, 0x93, 0xf9, 0x82, 0xfb, 0x8b, 0x42, 0x95, 0x15, 0x0d, 0xdb, 0x2d, 0x6c, 0x17, 0x5a, 0x76,
+	0xdd, 0x15, 0xd1, 0xb2, 0xeb, 0x6c, 0x22, 0xd3, 0xd5, 0xd0, 0xd4, 0x91, 0xe1, 0xb8, 0xb3, 0xf4,
+	0x17, 0x03, 0xdc, 0xe2, 0x99, 0xe0, 0xe9, 0x1b, 0x02, 0x69, 0x9b, 0x75, 0xab, 0x5a, 0x43, 0x14,
+	0x92, 0xfb, 0x4c, 0x02, 0xf9, 0xc0, 0xae, 0x17, 0xe9, 0xfc, 0xa1, 0x89, 0x8c, 0x7d, 0x43, 0x77,
+	0xe4, 0x15, 0x48, 0x9a, 0xd8, 0x72, 0x2a, 0x7a, 0x2d, 0x2d, 0x65, 0xa5, 0xad, 0x39, 0x35, 0xe1,
+	0x3e, 0xee, 0xd7, 0xe4, 0x77, 0x21, 0xc9, 0x64, 0xa5, 0x23, 0x59, 0x69, 0x6b, 0x7e, 0x77, 0x3d,
+	0xcf, 0x71, 0x36, 0xcf, 0xe4, 0x3d, 0x8e, 0x7d, 0xf1, 0x75, 0x66, 0x46, 0xf5, 0x96, 0xc8, 0x37,
+	0x21, 0x61, 0xeb, 0x75, 0x03, 0x59, 0xe9, 0x28, 0x95, 0x4a, 0x9f, 0x1e, 0x2d, 0xbc, 0xf8, 0x6d,
+	0x66, 0xe6, 0x97, 0xdf, 0x7c, 0xbe, 0xcd, 0x06, 0x72, 0x1f, 0x80, 0x32, 0x68, 0x95, 0x8a, 0x6c,
+	0x13, 0x1b, 0x36, 0x92, 0x37, 0x00, 0x98, 0xc4, 0xae, 0x81, 0x73, 0x6c, 0x64, 0xbf, 0x26, 0xa7,
+	0x21, 0xd9, 0x41, 0x96, 0xad, 0x63, 0x83, 0xd8, 0x38, 0xa7, 0x7a, 0x8f, 0x8f, 0x62, 0xae, 0x9e,
+	0xdc, 0xd7, 0x11, 0x58, 0xea, 0x95, 0x7e, 0x6c, 0x9d, 0x87, 0xbb, 0xbc, 0x0b, 0x29, 0xd3, 0x42,
+	0x1d, 0x1d, 0xb7, 0xed, 0x4a, 0x40, 0x2d, 0x11, 0xfd, 0x38, 0x92, 0x96, 0xd4, 0x25, 0x6f, 0xba,
+	0xe8, 0x9b, 0x10, 0xa0, 0x29, 0x3a, 0x3e, 0x4d, 0x3b, 0xb0, 0xac, 0xe1, 0xb6, 0xe1, 0x20, 0xcb,
+	0xac, 0x5a, 0xce, 0x79, 0xc5, 0xf3, 0x26, 0x46, 0xec, 0x4a, 0x05, 0xe7, 0x7e, 0x48, 0xa7, 0x5c,
+	0x4a, 0x4c, 0x0b, 0xe3, 0xe7, 0x15, 0xdd, 0xd0, 0x9d, 0x74, 0x3c, 0x2b, 0x6d, 0x5d, 0x53, 0xe7,
+	0xc8, 0x08, 0x89, 0x67, 0x11, 0xae, 0xd1, 0xe9, 0x06, 0xd2, 0xeb, 0x0d, 0x27, 0x9d, 0x20, 0x46,
+	0x29, 0x01, 0xa3, 0x68, 0x6a, 0x75, 0x76, 0xf2, 0xef, 0x11, 0x04, 0x33, 0x69, 0x9e, 0xac, 0xa2,
+	0x43, 0x81, 0xe8, 0x25, 0x87, 0x47, 0xef, 0x7d, 0x58, 0x1d, 0xe0, 0xd7, 0x0f, 0x5e, 0x20, 0x3a,
+	0x52, 0x4f, 0x74, 0xfa, 0xc2, 0x1a, 0xe9, 0x0b, 0x2b, 0x0b, 0xde, 0x5f, 0x06, 0x82, 0xb7, 0xa7,
+	0x9d, 0x85, 0x07, 0x6f, 0xb8, 0x4c, 0xf9, 0x1d, 0x58, 0xe9, 0x61, 0x3a, 0x80, 0xa5, 0x19, 0x7a,
+	0x23, 0x38, 0xdd, 0x8d, 0xef, 0x25, 0x22, 0xb4, 0x06, 0x34, 0x1e, 0x15, 0xc7, 0x3a, 0x67, 0x01,
+	0x9a, 0x25, 0x03, 0x6e, 0xf2, 0x5d, 0x6d, 0x7c, 0xd6, 0xfa, 0xe3, 0xb3, 0xa7, 0x9d, 0x79, 0xf1,
+	0xc9, 0xfd, 0x4d, 0x82, 0x1b, 0xbd, 0xb3, 0x45, 0x6c, 0x3c, 0xd7, 0xad, 0xd6, 0xa5, 0x49, 0xf6,
+	0x3d, 0xaf, 0x6a, 0x67, 0xe9, 0x68, 0xc0, 0x73, 0x37, 0x72, 0xfd, 0x9e, 0xc7, 0x26, 0xf3, 0x3c,
+	0x3e, 0xdc, 0xf3, 0x0c, 0x6c, 0x70, 0x7d, 0xf3, 0xbd, 0xef, 0x40, 0xaa, 0x0b, 0x28, 0x36, 0xb1,
+	0x8d, 0x86, 0xd7, 0xc3, 0x11, 0xae, 0x0b, 0x17, 0xbc, 0x0d, 0x58, 0xe3, 0xe8, 0xf5, 0xcd, 0xfa,
+	0x5d, 0x04, 0x6e, 0xf6, 0xcd, 0x4f, 0x1a, 0x95, 0xde, 0x8a, 0x11, 0x1d, 0x55, 0x31, 0xa6, 0x19,
+	0x17, 0xf9, 0x31, 0x6c, 0xf4, 0x6c, 0x1f, 0xf6, 0x4e, 0xaa, 0xd8, 0xe8, 0xc3, 0x36, 0x32, 0x34,
+	0x44, 0xf2, 0x3f, 0xa6, 0xae, 0x05, 0x41, 0x27, 0x14, 0x53, 0x66, 0x90, 0x41, 0x0a, 0xb3, 0xb0,
+	0xc9, 0xa7, 0xc8, 0x67, 0xf1, 0x42, 0x82, 0xeb, 0x07, 0x76, 0x5d, 0x45, 0x5a, 0xe7, 0xa8, 0xaa,
+	0x9d, 0x21, 0x47, 0x7e, 0x08, 0x09, 0x93, 0xfc, 0x22, 0xdc, 0xcd, 0xef, 0xae, 0x71, 0xcb, 0x34,
+	0x05, 0x33, 0x07, 0xd9, 0x02, 0xf9, 0x2d, 0x58, 0xa4, 0x04, 0x69, 0xb8, 0xd5, 0xd2, 0x9d, 0x16,
+	0x32, 0x1c, 0x42, 0xf2, 0x35, 0x75, 0x81, 0x8c, 0x17, 0xfd, 0xe1, 0x01, 0x2e, 0xa3, 0x93, 0x71,
+	0x19, 0x1b, 0x9e, 0x4a, 0x3f, 0x85, 0x1b, 0x3d, 0x4e, 0xfa, 0x95, 0xf7, 0xbb, 0x90, 0xb0, 0x90,
+	0xdd, 0x6e, 0x52, 0x67, 0xdf, 0xd8, 0xbd, 0xcd, 0x75, 0xd6, 0x83, 0xab, 0x04, 0x7a, 0x7c, 0x6e,
+	0x22, 0x95, 0x2d, 0x63, 0x15, 0xf8, 0xd3, 0x08, 0xc0, 0x81, 0x5d, 0x3f, 0xd6, 0x5b, 0x08, 0xb7,
+	0xa7, 0x43, 0x61, 0xdb, 0xb0, 0x90, 0x86, 0xf4, 0x0e, 0xaa, 0xf5, 0x50, 0x78, 0xe2, 0x0f, 0x4f,
+	0x87, 0xc2, 0x3b, 0x20, 0x1b, 0xe8, 0x63, 0xc7, 0x4f, 0xb3, 0x8a, 0x85, 0xb4, 0x0e, 0xa1, 0x33,
+	0xa6, 0x2e, 0xba, 0x33, 0x5e, 0x72, 0xb9, 0xe4, 0x89, 0x17, 0x95, 0x0f, 0x40, 0xee, 0xf2, 0x31,
+	0x6d, 0xb6, 0xff, 0x4d, 0xdf, 0x77, 0x4c, 0xfa, 0xa1, 0x41, 0x12, 0xfb, 0x8a, 0x48, 0xcf, 0xc0,
+	0x3c, 0x4b, 0x71, 0x57, 0x29, 0xab, 0x11, 0xb4, 0x6a, 0x50, 0x33, 0xa6, 0x52, 0x24, 0xf8, 0x51,
+	0x89, 0x8f, 0x8c, 0x4a, 0x62, 0xbc, 0x92, 0x92, 0xbc, 0x44, 0x49, 0x39, 0x85, 0xd5, 0x01, 0xee,
+	0xa7, 0x1d, 0xe0, 0x17, 0x11, 0x92, 0x3e, 0x7b, 0xda, 0x99, 0x81, 0x3f, 0x6a, 0xa2, 0x5a, 0x1d,
+	0x91, 0x9a, 0x31, 0x41, 0x84, 0xb7, 0x60, 0xa1, 0xda, 0x2b, 0xcd, 0x0b, 0x70, 0xdf, 0x70, 0x37,
+	0xc0, 0xee, 0xc2, 0x5a, 0x4f, 0x80, 0xf7, 0xdc, 0x91, 0x2b, 0x7e, 0x3b, 0x6b, 0xa0, 0x0c, 0x32,
+	0x31, 0x6d, 0xbe, 0xff, 0xd0, 0x73, 0xbe, 0x61, 0x29, 0x30, 0xd1, 0x4b, 0xfe, 0x7b, 0x90, 0x78,
+	0xae, 0xa3, 0x66, 0xcd, 0x66, 0x55, 0x29, 0xc7, 0x35, 0x8c, 0x69, 0x7a, 0x42, 0x90, 0x5e, 0xc4,
+	0xe8, 0x3a, 0xf1, 0xda, 0xfe, 0xa9, 0x14, 0x3c, 0xc0, 0x04, 0x8c, 0xf7, 0x59, 0x7a, 0x17, 0x92,
+	0x2c, 0xf5, 0xd3, 0xd2, 0x90, 0xce, 0x83, 0x2d, 0xf5, 0x3a, 0x0f, 0xb6, 0xc4, 0x2d, 0x0e, 0x03,
+	0x1b, 0x27, 0x42, 0x36, 0xce, 0x42, 0xbb, 0x6f, 0xb3, 0x50, 0x36, 0xff, 0x13, 0x85, 0xe5, 0x01,
+	0x83, 0x86, 0xb6, 0x53, 0x23, 0xc8, 0xfc, 0x01, 0x64, 0x4d, 0x0b, 0x9b, 0xd8, 0x46, 0x35, 0x7f,
+	0x0f, 0x6b, 0xd8, 0x30, 0x90, 0xe6, 0xe8, 0xd8, 0xa8, 0x34, 0xb0, 0xe9, 0xd2, 0x1c, 0xdd, 0x9a,
+	0x53, 0x37, 0x3c, 0x1c, 0xd3, 0x5a, 0xf4, 0x51, 0xef, 0x61, 0xd3, 0x96, 0x1b, 0xb0, 0xc6, 0x2d,
+	0x08, 0x2c, 0x54, 0xb1, 0x31, 0x43, 0xb5, 0xca, 0x29, 0x1c, 0x14, 0x30, 0xba, 0xf4, 0xc4, 0x47,
+	0x96, 0x1e, 0xf9, 0x5b, 0x70, 0x9d, 0x95, 0x5a, 0xd6, 0x36, 0x26, 0xc8, 0x5e, 0xa4, 0xbb, 0x8f,
+	0xb1, 0xdb, 0x05, 0x79, 0x11, 0x4e, 0x06, 0x40, 0x4c, 0xe2, 0xc0, 0x96, 0x9d, 0x9d, 0x6c, 0xcb,
+	0xce, 0x0d, 0x4f, 0xc8, 0xbf, 0x4a, 0xb0, 0xce, 0x8b, 0xff, 0x95, 0xe7, 0x63, 0xa0, 0x3c, 0x44,
+	0x27, 0x29, 0x0f, 0x7f, 0x8f, 0x70, 0x12, 0x7a, 0x92, 0x16, 0xf3, 0xa4, 0xaf, 0x55, 0xf4, 0xd8,
+	0x88, 0x0a, 0xb3, 0x91, 0xe2, 0x24, 0xce, 0x60, 0xc2, 0xc4, 0x44, 0x12, 0x26, 0x2e, 0x90, 0x30,
+	0xff, 0xdb, 0xde, 0x13, 0x71, 0xf2, 0x25, 0xd0, 0x7e, 0x4e, 0xab, 0xca, 0xff, 0x31, 0x0a, 0xe9,
+	0x01, 0x3d, 0x93, 0xb6, 0x4c, 0x3f, 0x02, 0x85, 0xfb, 0xb5, 0xc0, 0x76, 0xaa, 0x0e, 0x62, 0x69,
+	0xa7, 0x70, 0xed, 0x2d, 0xbb, 0x08, 0x35, 0xcd, 0xf9, 0x98, 0x40, 0x66, 0x42, 0x93, 0x24, 0x36,
+	0xe5, 0x24, 0x89, 0x8b, 0x24, 0x49, 0x42, 0x20, 0x49, 0x92, 0x93, 0x25, 0xc9, 0xec, 0xf0, 0x24,
+	0xd1, 0x21, 0x1b, 0x16, 0xbc, 0x69, 0x27, 0xca, 0x27, 0x51, 0xce, 0x71, 0xc0, 0xfd, 0x32, 0xf0,
+	0x7f, 0x98, 0x25, 0x23, 0x5f, 0x34, 0xb1, 0x4b, 0xbc, 0x68, 0x78, 0x29, 0x71, 0xb5, 0x25, 0x21,
+	0x03, 0x1b, 0xdc, 0x08, 0xf8, 0x7d, 0xfb, 0x9f, 0x22, 0x9c, 0xcd, 0xec, 0xf5, 0x9f, 0xd3, 0xaa,
+	0xcb, 0xe3, 0x7f, 0xaf, 0x4d, 0x71, 0x02, 0x25, 0x56, 0x97, 0xfb, 0xf9, 0x8d, 0x4f, 0xc6, 0x6f,
+	0x62, 0x38, 0xbf, 0x39, 0xc8, 0x86, 0xb1, 0xe7, 0x53, 0xfc, 0xe7, 0x08, 0xac, 0x0c, 0x6e, 0xb9,
+	0xaa, 0xa1, 0xa1, 0xe6, 0xa5, 0x19, 0x7e, 0x0a, 0xd7, 0x91, 0x65, 0x61, 0xab, 0x42, 0x1a, 0x4a,
+	0xd3, 0x6b, 0xda, 0x6f, 0x71, 0xa9, 0x2d, 0xb9, 0x48, 0x95, 0x02, 0x99, 0xb7, 0xd7, 0x50, 0x60,
+	0x4c, 0xce, 0x43, 0x8a, 0x72, 0xd6, 0x2b, 0x93, 0xd2, 0xbb, 0x44, 0xa6, 0x82, 0x32, 0xae, 0x98,
+	0xe3, 0x5b, 0x90, 0x09, 0xa1, 0xcf, 0xa7, 0xf8, 0x17, 0xb0, 0x70, 0x60, 0xd7, 0x4f, 0xcc, 0x5a,
+	0xd5, 0x41, 0x47, 0x55, 0xab, 0xda, 0xb2, 0xe5, 0x75, 0x98, 0xab, 0xb6, 0x9d, 0x06, 0xb6, 0x74,
+	0xe7, 0xdc, 0xbb, 0xc7, 0xf0, 0x07, 0x68, 0x0b, 0xe8, 0xe2, 0xd2, 0x91, 0xa1, 0x2d, 0xa0, 0x0b,
+	0xe9, 0xb6, 0x80, 0xee, 0xd3, 0x23, 0xd9, 0xb3, 0xaf, 0x2b, 0x2e, 0xb7, 0x0a, 0x2b, 0x7d, 0xfa,
+	0x7d, 0xd3, 0x7e, 0x2d, 0x91, 0x0d, 0x76, 0x64, 0xb5, 0x0d, 0xd4, 0xd7, 0x7e, 0xd9, 0x97, 0x0e,
+	0xff, 0x32, 0xc4, 0x9b, 0x7a, 0x8b, 0x7d, 0x5b, 0x8c, 0xa9, 0xf4, 0x41, 0xbc, 0xd5, 0xf9, 0x4c,
+	0x82, 0x6c, 0x98, 0x4d, 0xfe, 0x4b, 0xe0, 0x3e, 0xdc, 0x74, 0xb0, 0x53, 0x6d, 0x56, 0x4c, 0x17,
+	0x56, 0xf3, 0x2b, 0xa1, 0x4d, 0x4c, 0x8d, 0xa9, 0xcb, 0x64, 0x96, 0xc8, 0xa8, 0x79, 0x25, 0xd0,
+	0x96, 0x1f, 0xc1, 0x2a, 0x5d, 0x65, 0xa1, 0x56, 0x55, 0x37, 0x74, 0xa3, 0x1e, 0x58, 0x48, 0x8f,
+	0x97, 0x2b, 0x04, 0xa0, 0x7a, 0xf3, 0xfe, 0xda, 0xdc, 0x3f, 0x24, 0x42, 0xa3, 0x5b, 0x9f, 0x9e,
+	0x62, 0xad, 0xda, 0x6c, 0x60, 0xdb, 0xf1, 0xb6, 0x75, 0x28, 0x53, 0xf7, 0xfa, 0x6a, 0x8d, 0x87,
+	0xa2, 0x9c, 0xc9, 0xc1, 0xb9, 0x23, 0xba, 0x22, 0x70, 0x4b, 0x12, 0xed, 0xbd, 0x25, 0x79, 0x07,
+	0x66, 0xb1, 0x55, 0x43, 0x96, 0x6e, 0xd4, 0xd3, 0xb1, 0x21, 0x2f, 0x93, 0x43, 0x17, 0xa4, 0xfa,
+	0x58, 0xf1, 0x2e, 0xfc, 0x37, 0x12, 0x64, 0x42, 0x3c, 0x14, 0xbd, 0x81, 0x1b, 0x72, 0xad, 0x12,
+	0x19, 0x76, 0xad, 0x12, 0xea, 0x35, 0x7d, 0x59, 0x6f, 0x7f, 0x25, 0x81, 0x3c, 0xf8, 0x46, 0x97,
+	0x1f, 0x40, 0x56, 0x2d, 0x95, 0x8f, 0x0e, 0x9f, 0x95, 0x4b, 0x15, 0xb5, 0x54, 0x3e, 0x79, 0x7a,
+	0x5c, 0x39, 0xfe, 0xf1, 0x51, 0xa9, 0x72, 0xf2, 0xac, 0x7c, 0x54, 0x2a, 0xee, 0x3f, 0xd9, 0x2f,
+	0x7d, 0x7f, 0x71, 0x46, 0x59, 0x78, 0xf9, 0x2a, 0x3b, 0x1f, 0x18, 0x92, 0x6f, 0xc3, 0x2a, 0x77,
+	0xd9, 0xb3, 0xc3, 0xc3, 0xa3, 0x45, 0x49, 0x99, 0x7d, 0xf9, 0x2a, 0x1b, 0x73, 0x7f, 0xcb, 0x77,
+	0x61, 0x9d, 0x0b, 0x2c, 0x9f, 0x14, 0x8b, 0xa5, 0x72, 0x79, 0x31, 0xa2, 0xcc, 0xbf, 0x7c, 0x95,
+	0x4d, 0xb2, 0xc7, 0x50, 0xf8, 0x93, 0xbd, 0xfd, 0xa7, 0x27, 0x6a, 0x69, 0x31, 0x4a, 0xe1, 0xec,
+	0x51, 0x89, 0xbd, 0xf8, 0xfd, 0xe6, 0xcc, 0xee, 0xbf, 0x96, 0x20, 0x7a, 0x60, 0xd7, 0xe5, 0x33,
+	0x58, 0xe8, 0xbf, 0x8c, 0xe5, 0x9f, 0x6c, 0x06, 0xef, 0x47, 0x95, 0x82, 0x20, 0xd0, 0x0f, 0x63,
+	0x03, 0xde, 0xe8, 0xbb, 0x05, 0x7d, 0x53, 0x40, 0xc4, 0xb1, 0x75, 0xae, 0xe4, 0xc5, 0x70, 0x21,
+	0x9a, 0xdc, 0x7e, 0x4a, 0x44, 0xd3, 0x9e, 0x76, 0x26, 0xa4, 0x29, 0xd8, 0x40, 0x38, 0x20, 0x73,
+	0xee, 0xae, 0xb6, 0x05, 0xa4, 0x30, 0xac, 0xb2, 0x2b, 0x8e, 0xf5, 0xb5, 0x1a, 0xb0, 0x38, 0x70,
+	0x69, 0xb4, 0x35, 0x42, 0x8e, 0x8f, 0x54, 0xee, 0x89, 0x22, 0x7d, 0x7d, 0x1f, 0x41, 0x8a, 0x77,
+	0x19, 0xf4, 0x1d, 0x11, 0x41, 0x9e, 0x9f, 0x6f, 0x8f, 0x01, 0xf6, 0x15, 0xff, 0x04, 0x20, 0x70,
+	0x7f, 0x92, 0x0b, 0x13, 0xd1, 0xc5, 0x28, 0xdb, 0xa3, 0x31, 0xbe, 0xf4, 0x32, 0x24, 0xbd, 0x73,
+	0x5d, 0x26, 0x6c, 0x19, 0x03, 0x28, 0xb7, 0x47, 0x00, 0x82, 0xb9, 0xd7, 0xf7, 0xf9, 0xfc, 0xcd,
+	0x11, 0x4b, 0x19, 0x4e, 0xc9, 0x8b, 0xe1, 0x7c, 0x4d, 0x67, 0xb0, 0xd0, 0xff, 0x1d, 0x37, 0xd4,
+	0xca, 0x3e, 0xa0, 0x52, 0x10, 0x04, 0x72, 0x12, 0x3d, 0xf8, 0x11, 0x73, 0x54, 0xa2, 0x07, 0xb0,
+	0xca, 0xae, 0x38, 0xd6, 0xd7, 0xfa, 0x21, 0x2c, 0x0d, 0x7e, 0xec, 0x7b, 0x4b, 0x4c, 0x90, 0x5b,
+	0x38, 0x76, 0x84, 0xa1, 0xe1, 0x2a, 0xdd, 0xf2, 0x21, 0xa8, 0xd2, 0xad, 0x20, 0x3b, 0xc2, 0x50,
+	0x5f, 0xe5, 0xcf, 0xe1, 0x06, 0xff, 0xd3, 0xc1, 0x5d, 0x31, 0x59, 0xde, 0x16, 0x7b, 0x30, 0x16,
+	0x3c, 0x3c, 0xb4, 0xa4, 0x21, 0x15, 0x0c, 0xad, 0x8b, 0x55, 0x76, 0xc5, 0xb1, 0xe1, 0x4e, 0x7b,
+	0x5b, 0x51, 0xd0, 0x69, 0x6f, 0x63, 0x3e, 0x18, 0x0b, 0xee, 0xab, 0xff, 0x19, 0x2c, 0x73, 0xdb,
+	0x8f, 0x3b, 0x82, 0x1c, 0x12, 0xb4, 0x72, 0x7f, 0x1c, 0xb4, 0xaf, 0x5b, 0x87, 0x14, 0x3d, 0x18,
+	0x33, 0x14, 0x3b, 0x9f, 0x7f, 0x3b, 0x4c, 0x58, 0xf0, 0x14, 0xad, 0xdc, 0x11, 0x41, 0x05, 0x59,
+	0xe6, 0x9f, 0xb3, 0x43, 0x59, 0xe6, 0xc2, 0x95, 0x07, 0x63, 0xc1, 0x83, 0x2c, 0x73, 0xcf, 0xae,
+	0xa1, 0x4e, 0xf0, 0xd0, 0xca, 0xfd, 0x71, 0xd0, 0x9e, 0x6e, 0x25, 0xfe, 0xc9, 0x37, 0x9f, 0x6f,
+	0x4b, 0x8f, 0xcb, 0x5f, 0xbc, 0xde, 0x94, 0xbe, 0x7c, 0xbd, 0x29, 0xfd, 0xf3, 0xf5, 0xa6, 0xf4,
+	0xab, 0x8b, 0xcd, 0x99, 0x2f, 0x2f, 0x36, 0x67, 0xbe, 0xba, 0xd8, 0x9c, 0x79, 0xff, 0x61, 0x5d,
+	0x77, 0x1a, 0xed, 0xd3, 0xbc, 0x86, 0x5b, 0x05, 0xf6, 0xc7, 0x38, 0xfd, 0x54, 0xbb, 0x5b, 0xc7,
+	0x85, 0xce, 0xc3, 0x42, 0x0b, 0xd7, 0xda, 0x4d, 0x64, 0xd3, 0x3f, 0xb4, 0xdd, 0xbb, 0x7f, 0xd7,
+	0xfb, 0x4f, 0x9b, 0x73, 0x6e, 0x22, 0xfb, 0x34, 0x41, 0xfe, 0xcf, 0xf6, 0xf6, 0x7f, 0x07, 0x00,
+	0x74, 0x41, 0x09, 0xcf, 0x9a, 0x27, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1853,6 +1947,8 @@ type MsgClient interface {
 	UpdateChannelParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 	// PruneAcknowledgements defines a rpc handler method for MsgPruneAcknowledgements.
 	PruneAcknowledgements(ctx context.Context, in *MsgPruneAcknowledgements, opts ...grpc.CallOption) (*MsgPruneAcknowledgementsResponse, error)
+	// OpenLocalhostChannel defines a rpc handler method for MsgOpenLocalhostChannel.
+	OpenLocalhostChannel(ctx context.Context, in *MsgOpenLocalhostChannel, opts ...grpc.CallOption) (*MsgOpenLocalhostChannelResponse, error)
 }
 
 type msgClient struct {
@@ -2034,6 +2130,15 @@ func (c *msgClient) PruneAcknowledgements(ctx context.Context, in *MsgPruneAckno
 	return out, nil
 }
 
+func (c *msgClient) OpenLocalhostChannel(ctx context.Context, in *MsgOpenLocalhostChannel, opts ...grpc.CallOption) (*MsgOpenLocalhostChannelResponse, error) {
+	out := new(MsgOpenLocalhostChannelResponse)
+	err := c.cc.Invoke(ctx, "/ibc.core.channel.v1.Msg/OpenLocalhostChannel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// ChannelOpenInit defines a rpc handler method for MsgChannelOpenInit.
@@ -2075,6 +2180,8 @@ type MsgServer interface {
 	UpdateChannelParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 	// PruneAcknowledgements defines a rpc handler method for MsgPruneAcknowledgements.
 	PruneAcknowledgements(context.Context, *MsgPruneAcknowledgements) (*MsgPruneAcknowledgementsResponse, error)
+	// OpenLocalhostChannel defines a rpc handler method for MsgOpenLocalhostChannel.
+	OpenLocalhostChannel(context.Context, *MsgOpenLocalhostChannel) (*MsgOpenLocalhostChannelResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -2138,6 +2245,9 @@ func (*UnimplementedMsgServer) UpdateChannelParams(ctx context.Context, req *Msg
 func (*UnimplementedMsgServer) PruneAcknowledgements(ctx context.Context, req *MsgPruneAcknowledgements) (*MsgPruneAcknowledgementsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PruneAcknowledgements not implemented")
 }
+func (*UnimplementedMsgServer) OpenLocalhostChannel(ctx context.Context, req *MsgOpenLocalhostChannel) (*MsgOpenLocalhostChannelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OpenLocalhostChannel not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -2485,6 +2595,25 @@ func _Msg_PruneAcknowledgements_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_OpenLocalhostChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgOpenLocalhostChannel)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).OpenLocalhostChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.core.channel.v1.Msg/OpenLocalhostChannel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).OpenLocalhostChannel(ctx, req.(*MsgOpenLocalhostChannel))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ibc.core.channel.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -2565,6 +2694,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "PruneAcknowledgements",
 			Handler:    _Msg_PruneAcknowledgements_Handler,
 		},
+		{
+			MethodName: "OpenLocalhostChannel",
+			Handler:    _Msg_OpenLocalhostChannel_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ibc/core/channel/v1/tx.proto",
@@ -4347,6 +4480,106 @@ func (m *MsgPruneAcknowledgementsResponse) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgOpenLocalhostChannel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgOpenLocalhostChannel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgOpenLocalhostChannel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Ordering != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Ordering))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.CounterpartyPortId) > 0 {
+		i -= len(m.CounterpartyPortId)
+		copy(dAtA[i:], m.CounterpartyPortId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CounterpartyPortId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgOpenLocalhostChannelResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgOpenLocalhostChannelResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgOpenLocalhostChannelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.CounterpartyChannelId) > 0 {
+		i -= len(m.CounterpartyChannelId)
+		copy(dAtA[i:], m.CounterpartyChannelId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CounterpartyChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -5112,6 +5345,55 @@ func (m *MsgPruneAcknowledgementsResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgOpenLocalhostChannel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.CounterpartyPortId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Ordering != 0 {
+		n += 1 + sovTx(uint64(m.Ordering))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgOpenLocalhostChannelResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.CounterpartyChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -10729,6 +11011,349 @@ func (m *MsgPruneAcknowledgementsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgOpenLocalhostChannel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgOpenLocalhostChannel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgOpenLocalhostChannel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyPortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CounterpartyPortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ordering", wireType)
+			}
+			m.Ordering = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Ordering |= Order(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgOpenLocalhostChannelResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgOpenLocalhostChannelResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgOpenLocalhostChannelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CounterpartyChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1,12 +1,16 @@
 package ante
 
 import (
+	"slices"
+
 	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	clienttypes "github.com/cosmos/ibc-go/v9/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
+	ibcerrors "github.com/cosmos/ibc-go/v9/modules/core/errors"
 	"github.com/cosmos/ibc-go/v9/modules/core/exported"
 	"github.com/cosmos/ibc-go/v9/modules/core/keeper"
 )
@@ -24,7 +28,12 @@ func NewRedundantRelayDecorator(k *keeper.Keeper) RedundantRelayDecorator {
 // contains some other message type, then the antedecorator returns no error and continues processing to ensure these transactions
 // are included. This will ensure that relayers do not waste fees on multiMsg transactions when another relayer has already submitted
 // all packets, by rejecting the tx at the mempool layer.
+//
+// Additionally, clients which must be updated to a new height in order to verify the proofs of packet messages contained
+// in the tx are marked as exempt from the client update rate limit for the remainder of the tx processing.
 func (rrd RedundantRelayDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	ctx = rrd.withUpdateRateLimitExemptions(ctx, tx)
+
 	// do not run redundancy check on DeliverTx or simulate
 	if (ctx.IsCheckTx() || ctx.IsReCheckTx()) && !simulate {
 		// keep track of total packet messages and number of redundancies across `RecvPacket`, `AcknowledgePacket`, and `TimeoutPacket/OnClose`
@@ -153,7 +162,8 @@ func (rrd RedundantRelayDecorator) recvPacketReCheckTx(ctx sdk.Context, msg *cha
 
 // updateClientCheckTx runs a subset of ibc client update logic to be used specifically within the RedundantRelayDecorator AnteHandler.
 // The following function performs ibc client message verification for CheckTx only and state updates in both CheckTx and ReCheckTx.
-// Note that misbehaviour checks are omitted.
+// Note that misbehaviour checks are omitted, except for exempting client messages submitting misbehaviour from the
+// client update rate limit.
 func (rrd RedundantRelayDecorator) updateClientCheckTx(ctx sdk.Context, msg *clienttypes.MsgUpdateClient) error {
 	clientMsg, err := clienttypes.UnpackClientMessage(msg.ClientMessage)
 	if err != nil {
@@ -169,15 +179,190 @@ func (rrd RedundantRelayDecorator) updateClientCheckTx(ctx sdk.Context, msg *cli
 		return err
 	}
 
+	gasConsumed := ctx.GasMeter().GasConsumed()
+
 	if !ctx.IsReCheckTx() {
 		if err := clientModule.VerifyClientMessage(ctx, msg.ClientId, clientMsg); err != nil {
 			return err
 		}
 	}
 
+	if err := rrd.k.ClientKeeper.ApplyUpdateRateLimit(ctx, msg.ClientId); err != nil {
+		// client messages submitting misbehaviour are never rate limited
+		if !clientModule.CheckForMisbehaviour(ctx, msg.ClientId, clientMsg) {
+			return err
+		}
+	}
+
 	heights := clientModule.UpdateState(ctx, msg.ClientId, clientMsg)
 
+	clientType, err := rrd.k.ClientKeeper.GetClientType(ctx, msg.ClientId)
+	if err != nil {
+		return err
+	}
+	rrd.k.ClientKeeper.ConsumeUpdateGas(ctx, clientType, ctx.GasMeter().GasConsumed()-gasConsumed)
+
 	ctx.Logger().With("module", "x/"+exported.ModuleName).Debug("ante ibc client update", "consensusHeights", heights)
 
 	return nil
 }
+
+// withUpdateRateLimitExemptions returns a context in which the clients that must be updated to a new height in order to
+// verify the proofs of the packet messages contained in the tx are exempt from the client update rate limit. The exemptions
+// are only computed if the tx contains an update of a client which would otherwise be rejected by the rate limit.
+//
+// The client updates and packet messages of the tx are applied on a cached context which is discarded afterwards, and a
+// client is only exempted if a packet message which is not a no-op verifies its proof at a height the client is updated
+// to within the tx. Thus a packet message with an arbitrary proof height cannot be used to bypass the rate limit.
+// The cached context uses a separate infinite gas meter, such that the verification is not charged to the tx in
+// addition to the verification performed by the message handlers.
+func (rrd RedundantRelayDecorator) withUpdateRateLimitExemptions(ctx sdk.Context, tx sdk.Tx) sdk.Context {
+	var (
+		msgs            = tx.GetMsgs()
+		updateClientIDs []string
+	)
+	for _, m := range msgs {
+		msg, ok := m.(*clienttypes.MsgUpdateClient)
+		if !ok || slices.Contains(updateClientIDs, msg.ClientId) {
+			continue
+		}
+
+		if err := rrd.k.ClientKeeper.CheckUpdateRateLimit(ctx, msg.ClientId); err != nil {
+			updateClientIDs = append(updateClientIDs, msg.ClientId)
+		}
+	}
+
+	if len(updateClientIDs) == 0 {
+		return ctx
+	}
+
+	latestHeights := make(map[string]exported.Height, len(updateClientIDs))
+	for _, clientID := range updateClientIDs {
+		latestHeights[clientID] = rrd.k.ClientKeeper.GetClientLatestHeight(ctx, clientID)
+	}
+
+	// the rate limit is lifted for all clients updated in the tx on the discarded cached context
+	cacheCtx, _ := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+	cacheCtx = clienttypes.WithUpdateRateLimitExemptions(cacheCtx, updateClientIDs...)
+
+	var clientIDs []string
+	for _, m := range msgs {
+		var (
+			portID, channelID string
+			proofHeight       clienttypes.Height
+		)
+
+		switch msg := m.(type) {
+		case *clienttypes.MsgUpdateClient:
+			clientMsg, err := clienttypes.UnpackClientMessage(msg.ClientMessage)
+			if err != nil {
+				continue
+			}
+
+			applyMsg(cacheCtx, func(ctx sdk.Context) error {
+				return rrd.updateClientState(ctx, msg.ClientId, clientMsg)
+			})
+			continue
+		case *channeltypes.MsgRecvPacket:
+			portID, channelID, proofHeight = msg.Packet.DestinationPort, msg.Packet.DestinationChannel, msg.ProofHeight
+		case *channeltypes.MsgAcknowledgement:
+			portID, channelID, proofHeight = msg.Packet.SourcePort, msg.Packet.SourceChannel, msg.ProofHeight
+		case *channeltypes.MsgTimeout:
+			portID, channelID, proofHeight = msg.Packet.SourcePort, msg.Packet.SourceChannel, msg.ProofHeight
+		case *channeltypes.MsgTimeoutOnClose:
+			portID, channelID, proofHeight = msg.Packet.SourcePort, msg.Packet.SourceChannel, msg.ProofHeight
+		default:
+			continue
+		}
+
+		clientID, _, err := rrd.k.ChannelKeeper.GetChannelClientState(ctx, portID, channelID)
+		if err != nil {
+			continue
+		}
+
+		latestHeight, ok := latestHeights[clientID]
+		if !ok || !proofHeight.GT(latestHeight) || slices.Contains(clientIDs, clientID) {
+			continue
+		}
+
+		if applyMsg(cacheCtx, func(ctx sdk.Context) error { return rrd.verifyPacketMsg(ctx, m) }) {
+			clientIDs = append(clientIDs, clientID)
+		}
+	}
+
+	return clienttypes.WithUpdateRateLimitExemptions(ctx, clientIDs...)
+}
+
+// updateClientState verifies the client message and updates the state of the client, skipping the client update rate
+// limit and the client update gas multiplier. Client messages submitting misbehaviour do not update the client state.
+func (rrd RedundantRelayDecorator) updateClientState(ctx sdk.Context, clientID string, clientMsg exported.ClientMessage) error {
+	if status := rrd.k.ClientKeeper.GetClientStatus(ctx, clientID); status != exported.Active {
+		return errorsmod.Wrapf(clienttypes.ErrClientNotActive, "cannot update client (%s) with status %s", clientID, status)
+	}
+
+	clientModule, err := rrd.k.ClientKeeper.Route(ctx, clientID)
+	if err != nil {
+		return err
+	}
+
+	if err := clientModule.VerifyClientMessage(ctx, clientID, clientMsg); err != nil {
+		return err
+	}
+
+	if clientModule.CheckForMisbehaviour(ctx, clientID, clientMsg) {
+		return errorsmod.Wrapf(clienttypes.ErrInvalidClient, "client message for client (%s) submits misbehaviour", clientID)
+	}
+
+	clientModule.UpdateState(ctx, clientID, clientMsg)
+
+	return nil
+}
+
+// verifyPacketMsg runs the core IBC packet verification logic of the provided packet message, skipping any application logic.
+// An error is returned if the proof of the packet message fails to verify or if the packet message is a no-op.
+func (rrd RedundantRelayDecorator) verifyPacketMsg(ctx sdk.Context, m sdk.Msg) error {
+	switch msg := m.(type) {
+	case *channeltypes.MsgRecvPacket:
+		_, capability, err := rrd.k.ChannelKeeper.LookupModuleByChannel(ctx, msg.Packet.DestinationPort, msg.Packet.DestinationChannel)
+		if err != nil {
+			return err
+		}
+
+		_, err = rrd.k.ChannelKeeper.RecvPacket(ctx, capability, msg.Packet, msg.ProofCommitment, msg.ProofHeight)
+		return err
+	case *channeltypes.MsgAcknowledgement:
+		_, capability, err := rrd.k.ChannelKeeper.LookupModuleByChannel(ctx, msg.Packet.SourcePort, msg.Packet.SourceChannel)
+		if err != nil {
+			return err
+		}
+
+		_, err = rrd.k.ChannelKeeper.AcknowledgePacket(ctx, capability, msg.Packet, msg.Acknowledgement, msg.ProofAcked, msg.ProofHeight)
+		return err
+	case *channeltypes.MsgTimeout:
+		_, err := rrd.k.ChannelKeeper.TimeoutPacket(ctx, msg.Packet, msg.ProofUnreceived, msg.ProofHeight, msg.NextSequenceRecv)
+		return err
+	case *channeltypes.MsgTimeoutOnClose:
+		_, capability, err := rrd.k.ChannelKeeper.LookupModuleByChannel(ctx, msg.Packet.SourcePort, msg.Packet.SourceChannel)
+		if err != nil {
+			return err
+		}
+
+		_, err = rrd.k.ChannelKeeper.TimeoutOnClose(ctx, capability, msg.Packet, msg.ProofUnreceived, msg.ProofClose, msg.ProofHeight, msg.NextSequenceRecv, msg.CounterpartyUpgradeSequence)
+		return err
+	default:
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidType, "expected packet message, got %T", msg)
+	}
+}
+
+// applyMsg executes the provided function on a cached context of ctx, whose state changes are only written
+// to ctx if the function succeeds. It returns true if the function succeeds.
+func applyMsg(ctx sdk.Context, fn func(ctx sdk.Context) error) bool {
+	cacheCtx, writeFn := ctx.CacheContext()
+	if err := fn(cacheCtx); err != nil {
+		return false
+	}
+
+	writeFn()
+	return true
+}
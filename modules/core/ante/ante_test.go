@@ -8,6 +8,8 @@ import (
 	"github.com/stretchr/testify/require"
 	testifysuite "github.com/stretchr/testify/suite"
 
+	storetypes "cosmossdk.io/store/types"
+
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -180,6 +182,27 @@ func (suite *AnteTestSuite) createUpdateClientMessage() sdk.Msg {
 	return msg
 }
 
+// createRecvPacketMessageWithUpdate creates a RecvPacket message for a packet sent from chain A to chain B, together with
+// the UpdateClient message required to verify the packet commitment proof.
+func (suite *AnteTestSuite) createRecvPacketMessageWithUpdate() []sdk.Msg {
+	sequence, err := suite.path.EndpointA.SendPacket(clienttypes.NewHeight(2, 0), 0, ibctesting.MockPacketData)
+	suite.Require().NoError(err)
+
+	packet := channeltypes.NewPacket(ibctesting.MockPacketData, sequence,
+		suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID,
+		suite.path.EndpointB.ChannelConfig.PortID, suite.path.EndpointB.ChannelID,
+		clienttypes.NewHeight(2, 0), 0)
+
+	updateMsg := suite.createUpdateClientMessage()
+
+	packetKey := host.PacketCommitmentKey(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	proof, proofHeight := suite.chainA.QueryProof(packetKey)
+
+	recvMsg := channeltypes.NewMsgRecvPacket(packet, proof, proofHeight, suite.path.EndpointA.Chain.SenderAccount.GetAddress().String())
+
+	return []sdk.Msg{updateMsg, recvMsg}
+}
+
 func (suite *AnteTestSuite) TestAnteDecoratorCheckTx() {
 	testCases := []struct {
 		name     string
@@ -642,3 +665,133 @@ func (suite *AnteTestSuite) TestAnteDecoratorReCheckTx() {
 		})
 	}
 }
+
+func (suite *AnteTestSuite) TestAnteDecoratorUpdateRateLimit() {
+	testCases := []struct {
+		name        string
+		malleate    func(suite *AnteTestSuite) []sdk.Msg
+		rateLimited bool
+		expExempt   bool
+		expError    error
+	}{
+		{
+			"success on update with no previous update",
+			func(suite *AnteTestSuite) []sdk.Msg {
+				return []sdk.Msg{suite.createUpdateClientMessage()}
+			},
+			false,
+			false,
+			nil,
+		},
+		{
+			"success on rate limited update required by RecvPacket message",
+			func(suite *AnteTestSuite) []sdk.Msg {
+				return suite.createRecvPacketMessageWithUpdate()
+			},
+			true,
+			true,
+			nil,
+		},
+		{
+			"no success on rate limited update with redundant RecvPacket message with inflated proof height",
+			func(suite *AnteTestSuite) []sdk.Msg {
+				recvMsg := suite.createRecvPacketMessage(true)
+				recvMsg.ProofHeight = clienttypes.NewHeight(recvMsg.ProofHeight.RevisionNumber, recvMsg.ProofHeight.RevisionHeight+100)
+				return []sdk.Msg{suite.createUpdateClientMessage(), recvMsg}
+			},
+			true,
+			false,
+			clienttypes.ErrClientUpdateRateLimited,
+		},
+		{
+			"no success on rate limited update with RecvPacket message which fails to verify at the updated height",
+			func(suite *AnteTestSuite) []sdk.Msg {
+				msgs := suite.createRecvPacketMessageWithUpdate()
+				recvMsg, ok := msgs[1].(*channeltypes.MsgRecvPacket)
+				suite.Require().True(ok)
+				recvMsg.ProofHeight = clienttypes.NewHeight(recvMsg.ProofHeight.RevisionNumber, recvMsg.ProofHeight.RevisionHeight+100)
+				return msgs
+			},
+			true,
+			false,
+			clienttypes.ErrClientUpdateRateLimited,
+		},
+		{
+			"no success on rate limited update",
+			func(suite *AnteTestSuite) []sdk.Msg {
+				return []sdk.Msg{suite.createUpdateClientMessage()}
+			},
+			true,
+			false,
+			clienttypes.ErrClientUpdateRateLimited,
+		},
+		{
+			"no success on rate limited update not required by RecvPacket message",
+			func(suite *AnteTestSuite) []sdk.Msg {
+				// the RecvPacket message proof is verifiable at the latest client height
+				recvMsg := suite.createRecvPacketMessage(false)
+				return []sdk.Msg{suite.createUpdateClientMessage(), recvMsg}
+			},
+			true,
+			false,
+			clienttypes.ErrClientUpdateRateLimited,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			// reset suite
+			suite.SetupTest()
+
+			k := suite.chainB.App.GetIBCKeeper()
+			decorator := ante.NewRedundantRelayDecorator(k)
+
+			msgs := tc.malleate(suite)
+
+			deliverCtx := suite.chainB.GetContext().WithIsCheckTx(false)
+			checkCtx := suite.chainB.GetContext().WithIsCheckTx(true)
+
+			params := clienttypes.DefaultParams()
+			params.MinUpdateBlockInterval = 2
+			k.ClientKeeper.SetParams(deliverCtx, params)
+
+			if tc.rateLimited {
+				k.ClientKeeper.SetClientLastUpdate(deliverCtx, suite.path.EndpointB.ClientID, uint64(deliverCtx.BlockHeight()), uint64(deliverCtx.BlockTime().UnixNano()))
+			}
+
+			// create multimsg tx
+			txBuilder := suite.chainB.TxConfig.NewTxBuilder()
+			err := txBuilder.SetMsgs(msgs...)
+			suite.Require().NoError(err)
+			tx := txBuilder.GetTx()
+
+			next := func(ctx sdk.Context, tx sdk.Tx, simulate bool) (newCtx sdk.Context, err error) { return ctx, nil }
+
+			gasConsumed := deliverCtx.GasMeter().GasConsumed()
+			newCtx, err := decorator.AnteHandle(deliverCtx, tx, false, next)
+			suite.Require().NoError(err, "antedecorator should not error on DeliverTx")
+			suite.Require().Equal(tc.expExempt, clienttypes.IsUpdateRateLimitExempt(newCtx, suite.path.EndpointB.ClientID))
+
+			// the client updates and packet messages applied to compute the exemptions are not charged to the tx
+			updateMsg, ok := msgs[0].(*clienttypes.MsgUpdateClient)
+			suite.Require().True(ok)
+			clientMsg, err := clienttypes.UnpackClientMessage(updateMsg.ClientMessage)
+			suite.Require().NoError(err)
+
+			updateCtx, _ := newCtx.CacheContext()
+			updateCtx = updateCtx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+			err = k.ClientKeeper.UpdateClient(updateCtx, updateMsg.ClientId, clientMsg)
+			suite.Require().Equal(tc.expError == nil, err == nil)
+			suite.Require().Less(deliverCtx.GasMeter().GasConsumed()-gasConsumed, updateCtx.GasMeter().GasConsumed())
+
+			_, err = decorator.AnteHandle(checkCtx, tx, false, next)
+			if tc.expError == nil {
+				suite.Require().NoError(err, "non-strict decorator did not pass as expected")
+			} else {
+				suite.Require().ErrorIs(err, tc.expError, "non-strict antehandler did not return error as expected")
+			}
+		})
+	}
+}
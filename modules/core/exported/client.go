@@ -122,6 +122,23 @@ type LightClientModule interface {
 	) error
 }
 
+// ChainIDLightClientModule is an optional interface which light client modules may implement in order to
+// expose the chain identifier of the counterparty chain tracked by a client. Only clients whose light client
+// module implements this interface may take part in a client type migration.
+type ChainIDLightClientModule interface {
+	// ChainID returns the chain identifier of the counterparty chain tracked by the client.
+	ChainID(ctx context.Context, clientID string) (string, error)
+}
+
+// ClientStoreReplacedLightClientModule is an optional interface which light client modules may implement in order to
+// be notified when the client store of a client is replaced by a client type migration. Light client modules which
+// keep state about a client outside of its client store may use it to update that state.
+type ClientStoreReplacedLightClientModule interface {
+	// OnClientStoreReplaced is called after the client store of the client has been replaced. It is called on the
+	// light client modules of both the client type migrated from and the client type migrated to.
+	OnClientStoreReplaced(ctx context.Context, clientID string) error
+}
+
 // ClientState defines the required common functions for light clients.
 type ClientState interface {
 	proto.Message
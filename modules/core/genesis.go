@@ -1,21 +1,50 @@
 package ibc
 
 import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	client "github.com/cosmos/ibc-go/v9/modules/core/02-client"
 	connection "github.com/cosmos/ibc-go/v9/modules/core/03-connection"
 	channel "github.com/cosmos/ibc-go/v9/modules/core/04-channel"
+	channeltypes "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v9/modules/core/05-port/types"
 	"github.com/cosmos/ibc-go/v9/modules/core/keeper"
 	"github.com/cosmos/ibc-go/v9/modules/core/types"
 )
 
 // InitGenesis initializes the ibc state from a provided genesis
-// state.
+// state. Any localhost channels provided in the channel genesis state are
+// opened once the channel state has been initialized. The ports used by these
+// channels must already be bound, thus the ibc module must be initialized after
+// the applications owning those ports.
 func InitGenesis(ctx sdk.Context, k keeper.Keeper, gs *types.GenesisState) {
 	client.InitGenesis(ctx, k.ClientKeeper, gs.ClientGenesis)
 	connection.InitGenesis(ctx, k.ConnectionKeeper, gs.ConnectionGenesis)
 	channel.InitGenesis(ctx, k.ChannelKeeper, gs.ChannelGenesis)
+
+	for _, lc := range gs.ChannelGenesis.LocalhostChannels {
+		if err := openLocalhostChannel(ctx, k, lc); err != nil {
+			panic(fmt.Errorf("failed to open localhost channel between ports %s and %s: %w", lc.PortId, lc.CounterpartyPortId, err))
+		}
+	}
+}
+
+// openLocalhostChannel opens the provided genesis localhost channel. An error is returned if either port of the
+// channel is not bound, which is the case if the ibc module is initialized before the application owning the port.
+func openLocalhostChannel(ctx sdk.Context, k keeper.Keeper, lc channeltypes.LocalhostChannel) error {
+	for _, portID := range []string{lc.PortId, lc.CounterpartyPortId} {
+		if !k.PortKeeper.IsBound(ctx, portID) {
+			return errorsmod.Wrapf(porttypes.ErrPortNotFound, "port %s is not bound, the ibc module must be initialized after the application owning the port", portID)
+		}
+	}
+
+	msg := channeltypes.NewMsgOpenLocalhostChannel(lc.PortId, lc.CounterpartyPortId, lc.Version, lc.Ordering, k.GetAuthority())
+	_, err := k.OpenLocalhostChannel(ctx, msg)
+	return err
 }
 
 // ExportGenesis returns the ibc exported genesis.
@@ -12,6 +12,7 @@ import (
 	clienttypes "github.com/cosmos/ibc-go/v9/modules/core/02-client/types"
 	connectiontypes "github.com/cosmos/ibc-go/v9/modules/core/03-connection/types"
 	channeltypes "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v9/modules/core/05-port/types"
 	commitmenttypes "github.com/cosmos/ibc-go/v9/modules/core/23-commitment/types"
 	"github.com/cosmos/ibc-go/v9/modules/core/exported"
 	"github.com/cosmos/ibc-go/v9/modules/core/types"
@@ -318,6 +319,103 @@ func (suite *IBCTestSuite) TestInitGenesis() {
 	}
 }
 
+func (suite *IBCTestSuite) TestInitGenesisLocalhostChannels() {
+	var genState *types.GenesisState
+
+	testCases := []struct {
+		name        string
+		malleate    func()
+		unboundPort string
+	}{
+		{
+			"success",
+			func() {},
+			"",
+		},
+		{
+			"failure: port is not bound",
+			func() {
+				genState.ChannelGenesis.LocalhostChannels = append(
+					genState.ChannelGenesis.LocalhostChannels,
+					channeltypes.NewLocalhostChannel(port1, port2, ibctesting.DefaultChannelVersion, channeltypes.UNORDERED),
+				)
+			},
+			port1,
+		},
+		{
+			"failure: counterparty port is not bound",
+			func() {
+				genState.ChannelGenesis.LocalhostChannels = append(
+					genState.ChannelGenesis.LocalhostChannels,
+					channeltypes.NewLocalhostChannel(ibctesting.MockPort, port2, ibctesting.DefaultChannelVersion, channeltypes.UNORDERED),
+				)
+			},
+			port2,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			genState = types.DefaultGenesisState()
+			genState.ChannelGenesis.LocalhostChannels = []channeltypes.LocalhostChannel{
+				channeltypes.NewLocalhostChannel(ibctesting.MockPort, ibctesting.MockPort, ibctesting.DefaultChannelVersion, channeltypes.UNORDERED),
+				channeltypes.NewLocalhostChannel(ibctesting.TransferPort, ibctesting.TransferPort, "", channeltypes.UNORDERED),
+			}
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			initGenesis := func() {
+				ibc.InitGenesis(ctx, *suite.chainA.App.GetIBCKeeper(), genState)
+			}
+
+			if tc.unboundPort != "" {
+				var err error
+				func() {
+					defer func() {
+						r := recover()
+						suite.Require().NotNil(r)
+						err, _ = r.(error)
+					}()
+					initGenesis()
+				}()
+
+				suite.Require().ErrorIs(err, porttypes.ErrPortNotFound)
+				suite.Require().ErrorContains(err, fmt.Sprintf("port %s is not bound", tc.unboundPort))
+				return
+			}
+
+			suite.Require().NotPanics(initGenesis)
+
+			expChannels := []struct {
+				portID, channelID, counterpartyChannelID string
+			}{
+				{ibctesting.MockPort, "channel-0", "channel-1"},
+				{ibctesting.MockPort, "channel-1", "channel-0"},
+				{ibctesting.TransferPort, "channel-2", "channel-3"},
+				{ibctesting.TransferPort, "channel-3", "channel-2"},
+			}
+
+			for _, expChannel := range expChannels {
+				channel, found := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetChannel(ctx, expChannel.portID, expChannel.channelID)
+				suite.Require().True(found)
+				suite.Require().Equal(channeltypes.OPEN, channel.State)
+				suite.Require().Equal([]string{exported.LocalhostConnectionID}, channel.ConnectionHops)
+				suite.Require().Equal(channeltypes.NewCounterparty(expChannel.portID, expChannel.counterpartyChannelID), channel.Counterparty)
+			}
+
+			// localhost channels are exported as regular channels
+			exportedGenState := ibc.ExportGenesis(ctx, *suite.chainA.App.GetIBCKeeper())
+			suite.Require().Empty(exportedGenState.ChannelGenesis.LocalhostChannels)
+			suite.Require().Len(exportedGenState.ChannelGenesis.Channels, len(expChannels))
+		})
+	}
+}
+
 func (suite *IBCTestSuite) TestExportGenesis() {
 	testCases := []struct {
 		msg      string
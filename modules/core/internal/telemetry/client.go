@@ -56,3 +56,14 @@ func ReportRecoverClient(clientType, subjectClientID string) {
 		},
 	)
 }
+
+func ReportMigrateClientType(clientType, subjectClientID string) {
+	telemetry.IncrCounterWithLabels(
+		[]string{"ibc", "client", "migrate"},
+		1,
+		[]metrics.Label{
+			telemetry.NewLabel(ibcmetrics.LabelClientType, clientType),
+			telemetry.NewLabel(ibcmetrics.LabelClientID, subjectClientID),
+		},
+	)
+}
@@ -14,8 +14,10 @@ import (
 	channeltypes "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
 	porttypes "github.com/cosmos/ibc-go/v9/modules/core/05-port/types"
 	ibcerrors "github.com/cosmos/ibc-go/v9/modules/core/errors"
+	"github.com/cosmos/ibc-go/v9/modules/core/exported"
 	"github.com/cosmos/ibc-go/v9/modules/core/internal/telemetry"
 	coretypes "github.com/cosmos/ibc-go/v9/modules/core/types"
+	localhost "github.com/cosmos/ibc-go/v9/modules/light-clients/09-localhost"
 )
 
 var (
@@ -116,6 +118,20 @@ func (k *Keeper) RecoverClient(goCtx context.Context, msg *clienttypes.MsgRecove
 	return &clienttypes.MsgRecoverClientResponse{}, nil
 }
 
+// MigrateClientType defines a rpc handler method for MsgMigrateClientType.
+func (k *Keeper) MigrateClientType(goCtx context.Context, msg *clienttypes.MsgMigrateClientType) (*clienttypes.MsgMigrateClientTypeResponse, error) {
+	if k.GetAuthority() != msg.Signer {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Signer)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.ClientKeeper.MigrateClientType(ctx, msg.SubjectClientId, msg.SubstituteClientId); err != nil {
+		return nil, errorsmod.Wrap(err, "client type migration failed")
+	}
+
+	return &clienttypes.MsgMigrateClientTypeResponse{}, nil
+}
+
 // IBCSoftwareUpgrade defines a rpc handler method for MsgIBCSoftwareUpgrade.
 func (k *Keeper) IBCSoftwareUpgrade(goCtx context.Context, msg *clienttypes.MsgIBCSoftwareUpgrade) (*clienttypes.MsgIBCSoftwareUpgradeResponse, error) {
 	if k.GetAuthority() != msg.Signer {
@@ -1054,6 +1070,52 @@ func (k *Keeper) PruneAcknowledgements(goCtx context.Context, msg *channeltypes.
 	}, nil
 }
 
+// OpenLocalhostChannel defines a rpc handler method for MsgOpenLocalhostChannel.
+// As both channel ends reside on the same chain, OpenLocalhostChannel performs the
+// ChannelOpenInit, ChannelOpenTry, ChannelOpenAck and ChannelOpenConfirm handshake steps
+// over the localhost connection atomically, using the localhost sentinel proof.
+func (k *Keeper) OpenLocalhostChannel(goCtx context.Context, msg *channeltypes.MsgOpenLocalhostChannel) (*channeltypes.MsgOpenLocalhostChannelResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	connectionHops := []string{exported.LocalhostConnectionID}
+	proofHeight := clienttypes.GetSelfHeight(ctx)
+
+	initRes, err := k.ChannelOpenInit(ctx, channeltypes.NewMsgChannelOpenInit(
+		msg.PortId, msg.Version, msg.Ordering, connectionHops, msg.CounterpartyPortId, msg.Signer,
+	))
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "localhost channel open init failed")
+	}
+
+	tryRes, err := k.ChannelOpenTry(ctx, channeltypes.NewMsgChannelOpenTry(
+		msg.CounterpartyPortId, "", msg.Ordering, connectionHops, msg.PortId, initRes.ChannelId,
+		initRes.Version, localhost.SentinelProof, proofHeight, msg.Signer,
+	))
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "localhost channel open try failed")
+	}
+
+	if _, err := k.ChannelOpenAck(ctx, channeltypes.NewMsgChannelOpenAck(
+		msg.PortId, initRes.ChannelId, tryRes.ChannelId, tryRes.Version, localhost.SentinelProof, proofHeight, msg.Signer,
+	)); err != nil {
+		return nil, errorsmod.Wrap(err, "localhost channel open ack failed")
+	}
+
+	if _, err := k.ChannelOpenConfirm(ctx, channeltypes.NewMsgChannelOpenConfirm(
+		msg.CounterpartyPortId, tryRes.ChannelId, localhost.SentinelProof, proofHeight, msg.Signer,
+	)); err != nil {
+		return nil, errorsmod.Wrap(err, "localhost channel open confirm failed")
+	}
+
+	ctx.Logger().Info("localhost channel open succeeded", "port-id", msg.PortId, "channel-id", initRes.ChannelId, "counterparty-port-id", msg.CounterpartyPortId, "counterparty-channel-id", tryRes.ChannelId, "version", tryRes.Version)
+
+	return &channeltypes.MsgOpenLocalhostChannelResponse{
+		ChannelId:             initRes.ChannelId,
+		CounterpartyChannelId: tryRes.ChannelId,
+		Version:               tryRes.Version,
+	}, nil
+}
+
 // UpdateClientParams defines a rpc handler method for MsgUpdateParams.
 func (k *Keeper) UpdateClientParams(goCtx context.Context, msg *clienttypes.MsgUpdateParams) (*clienttypes.MsgUpdateParamsResponse, error) {
 	if k.GetAuthority() != msg.Signer {
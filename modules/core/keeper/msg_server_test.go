@@ -300,6 +300,82 @@ func (suite *KeeperTestSuite) TestRecoverClient() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestMigrateClientType() {
+	// mockClientType is a client type routed to the 07-tendermint light client module
+	const mockClientType = "99-mock"
+
+	var msg *clienttypes.MsgMigrateClientType
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success: migrate client type",
+			func() {},
+			nil,
+		},
+		{
+			"signer doesn't match authority",
+			func() {
+				msg.Signer = ibctesting.InvalidID
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"invalid subject client",
+			func() {
+				msg.SubjectClientId = ibctesting.InvalidID
+			},
+			clienttypes.ErrRouteNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			clientKeeper := suite.chainA.App.GetIBCKeeper().ClientKeeper
+			clientKeeper.AddRoute(mockClientType, ibctm.NewLightClientModule(suite.chainA.Codec, clientKeeper.GetStoreProvider()))
+
+			subjectPath := ibctesting.NewPath(suite.chainA, suite.chainB)
+			subjectPath.SetupClients()
+			subject := subjectPath.EndpointA.ClientID
+
+			substitutePath := ibctesting.NewPath(suite.chainA, suite.chainB)
+			substitutePath.SetupClients()
+
+			// store the substitute tendermint client under a client identifier of the mock client type
+			substitute := clienttypes.FormatClientIdentifier(mockClientType, 0)
+			substituteClientState := suite.chainA.GetClientState(substitutePath.EndpointA.ClientID)
+			consensusState, found := clientKeeper.GetClientConsensusState(suite.chainA.GetContext(), substitutePath.EndpointA.ClientID, substituteClientState.(*ibctm.ClientState).LatestHeight)
+			suite.Require().True(found)
+			clientKeeper.SetClientState(suite.chainA.GetContext(), substitute, substituteClientState)
+			clientKeeper.SetClientConsensusState(suite.chainA.GetContext(), substitute, substituteClientState.(*ibctm.ClientState).LatestHeight, consensusState)
+
+			msg = clienttypes.NewMsgMigrateClientType(suite.chainA.App.GetIBCKeeper().GetAuthority(), subject, substitute)
+
+			tc.malleate()
+
+			_, err := suite.chainA.App.GetIBCKeeper().MigrateClientType(suite.chainA.GetContext(), msg)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+
+				clientType, err := clientKeeper.GetClientType(suite.chainA.GetContext(), subject)
+				suite.Require().NoError(err)
+				suite.Require().Equal(mockClientType, clientType)
+				suite.Require().Equal(exported.Active, clientKeeper.GetClientStatus(suite.chainA.GetContext(), subject))
+			} else {
+				suite.Require().Error(err)
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
 // tests the IBC handler acknowledgement of a packet on ordered and unordered
 // channels. It verifies that the deletion of packet commitments from state
 // occurs. It test high level properties like ordering and basic sanity
@@ -2588,6 +2664,114 @@ func (suite *KeeperTestSuite) TestIBCSoftwareUpgrade() {
 	}
 }
 
+// TestOpenLocalhostChannel tests the OpenLocalhostChannel rpc handler
+func (suite *KeeperTestSuite) TestOpenLocalhostChannel() {
+	var msg *channeltypes.MsgOpenLocalhostChannel
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"success: ordered channel",
+			func() {
+				msg.Ordering = channeltypes.ORDERED
+			},
+			nil,
+		},
+		{
+			"success: empty version is negotiated by application",
+			func() {
+				msg.Version = ""
+			},
+			nil,
+		},
+		{
+			"failure: port is not bound",
+			func() {
+				msg.PortId = "portidone"
+			},
+			capabilitytypes.ErrCapabilityNotFound,
+		},
+		{
+			"failure: counterparty port is not bound",
+			func() {
+				msg.CounterpartyPortId = "portidone"
+			},
+			capabilitytypes.ErrCapabilityNotFound,
+		},
+		{
+			"failure: localhost client is not allowed",
+			func() {
+				params := suite.chainA.App.GetIBCKeeper().ClientKeeper.GetParams(suite.chainA.GetContext())
+				params.AllowedClients = []string{exported.Tendermint}
+				suite.chainA.App.GetIBCKeeper().ClientKeeper.SetParams(suite.chainA.GetContext(), params)
+			},
+			clienttypes.ErrClientNotActive,
+		},
+		{
+			"failure: application callback fails on counterparty port",
+			func() {
+				suite.chainA.GetSimApp().IBCMockModule.IBCApp.OnChanOpenTry = func(
+					ctx context.Context, order channeltypes.Order, connectionHops []string, portID, channelID string,
+					chanCap *capabilitytypes.Capability, counterparty channeltypes.Counterparty, counterpartyVersion string,
+				) (string, error) {
+					return "", ibcmock.MockApplicationCallbackError
+				}
+			},
+			ibcmock.MockApplicationCallbackError,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			msg = channeltypes.NewMsgOpenLocalhostChannel(
+				ibctesting.MockPort, ibctesting.MockPort, ibctesting.DefaultChannelVersion,
+				channeltypes.UNORDERED, suite.chainA.SenderAccount.GetAddress().String(),
+			)
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			resp, err := suite.chainA.App.GetIBCKeeper().OpenLocalhostChannel(ctx, msg)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(resp)
+				suite.Require().Equal(ibctesting.DefaultChannelVersion, resp.Version)
+
+				channelKeeper := suite.chainA.App.GetIBCKeeper().ChannelKeeper
+
+				channel, found := channelKeeper.GetChannel(ctx, msg.PortId, resp.ChannelId)
+				suite.Require().True(found)
+				suite.Require().Equal(channeltypes.OPEN, channel.State)
+				suite.Require().Equal(msg.Ordering, channel.Ordering)
+				suite.Require().Equal([]string{exported.LocalhostConnectionID}, channel.ConnectionHops)
+				suite.Require().Equal(channeltypes.NewCounterparty(msg.CounterpartyPortId, resp.CounterpartyChannelId), channel.Counterparty)
+				suite.Require().Equal(resp.Version, channel.Version)
+
+				counterpartyChannel, found := channelKeeper.GetChannel(ctx, msg.CounterpartyPortId, resp.CounterpartyChannelId)
+				suite.Require().True(found)
+				suite.Require().Equal(channeltypes.OPEN, counterpartyChannel.State)
+				suite.Require().Equal(channeltypes.NewCounterparty(msg.PortId, resp.ChannelId), counterpartyChannel.Counterparty)
+				suite.Require().Equal(resp.Version, counterpartyChannel.Version)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().Nil(resp)
+			}
+		})
+	}
+}
+
 // TestUpdateClientParams tests the UpdateClientParams rpc handler
 func (suite *KeeperTestSuite) TestUpdateClientParams() {
 	signer := suite.chainA.App.GetIBCKeeper().GetAuthority()
@@ -1,6 +1,9 @@
 package solomachine
 
 import (
+	"math"
+	"time"
+
 	errorsmod "cosmossdk.io/errors"
 	storetypes "cosmossdk.io/store/types"
 
@@ -17,6 +20,10 @@ import (
 
 var _ exported.ClientState = (*ClientState)(nil)
 
+// MaxKeyRotationDelay is the maximum key rotation delay in seconds, ensuring that the
+// effective time of a key rotation in nanoseconds cannot overflow.
+const MaxKeyRotationDelay = uint64(math.MaxInt64 / int64(time.Second))
+
 // NewClientState creates a new ClientState instance.
 func NewClientState(latestSequence uint64, consensusState *ConsensusState) *ClientState {
 	return &ClientState{
@@ -39,6 +46,17 @@ func (cs ClientState) Validate() error {
 	if cs.ConsensusState == nil {
 		return errorsmod.Wrap(clienttypes.ErrInvalidConsensus, "consensus state cannot be nil")
 	}
+	if cs.KeyRotationDelay > MaxKeyRotationDelay {
+		return errorsmod.Wrapf(clienttypes.ErrInvalidClient, "key rotation delay cannot exceed %d seconds", MaxKeyRotationDelay)
+	}
+	if cs.PendingKeyRotation != nil {
+		if cs.KeyRotationDelay == 0 {
+			return errorsmod.Wrap(ErrInvalidKeyRotation, "pending key rotation requires a non-zero key rotation delay")
+		}
+		if err := cs.PendingKeyRotation.ValidateBasic(); err != nil {
+			return err
+		}
+	}
 	return cs.ConsensusState.ValidateBasic()
 }
 
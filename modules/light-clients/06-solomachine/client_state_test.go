@@ -53,6 +53,64 @@ func (suite *SoloMachineTestSuite) TestClientStateValidate() {
 				solomachine.NewClientState(1, &solomachine.ConsensusState{nil, sm.Diversifier, sm.Time}),
 				false,
 			},
+			{
+				"valid client state with pending key rotation",
+				func() *solomachine.ClientState {
+					clientState := sm.ClientState()
+					clientState.KeyRotationDelay = 60
+					clientState.PendingKeyRotation = solomachine.NewKeyRotation(sm.ConsensusState().PublicKey, sm.Diversifier, 1)
+					return clientState
+				}(),
+				true,
+			},
+			{
+				"key rotation delay exceeds maximum",
+				func() *solomachine.ClientState {
+					clientState := sm.ClientState()
+					clientState.KeyRotationDelay = solomachine.MaxKeyRotationDelay + 1
+					return clientState
+				}(),
+				false,
+			},
+			{
+				"pending key rotation without key rotation delay",
+				func() *solomachine.ClientState {
+					clientState := sm.ClientState()
+					clientState.PendingKeyRotation = solomachine.NewKeyRotation(sm.ConsensusState().PublicKey, sm.Diversifier, 1)
+					return clientState
+				}(),
+				false,
+			},
+			{
+				"pending key rotation effective time is zero",
+				func() *solomachine.ClientState {
+					clientState := sm.ClientState()
+					clientState.KeyRotationDelay = 60
+					clientState.PendingKeyRotation = solomachine.NewKeyRotation(sm.ConsensusState().PublicKey, sm.Diversifier, 0)
+					return clientState
+				}(),
+				false,
+			},
+			{
+				"pending key rotation diversifier is blank",
+				func() *solomachine.ClientState {
+					clientState := sm.ClientState()
+					clientState.KeyRotationDelay = 60
+					clientState.PendingKeyRotation = solomachine.NewKeyRotation(sm.ConsensusState().PublicKey, "  ", 1)
+					return clientState
+				}(),
+				false,
+			},
+			{
+				"pending key rotation pubkey is empty",
+				func() *solomachine.ClientState {
+					clientState := sm.ClientState()
+					clientState.KeyRotationDelay = 60
+					clientState.PendingKeyRotation = solomachine.NewKeyRotation(nil, sm.Diversifier, 1)
+					return clientState
+				}(),
+				false,
+			},
 		}
 
 		for _, tc := range testCases {
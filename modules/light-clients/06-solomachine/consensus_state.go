@@ -1,10 +1,13 @@
 package solomachine
 
 import (
+	"errors"
+	"fmt"
 	"strings"
 
 	errorsmod "cosmossdk.io/errors"
 
+	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 
 	clienttypes "github.com/cosmos/ibc-go/v9/modules/core/02-client/types"
@@ -49,9 +52,48 @@ func (cs ConsensusState) ValidateBasic() error {
 	}
 
 	publicKey, err := cs.GetPubKey()
-	if err != nil || publicKey == nil || len(publicKey.Bytes()) == 0 {
+	if err != nil {
 		return errorsmod.Wrap(clienttypes.ErrInvalidConsensus, "public key cannot be empty")
 	}
 
+	if err := validatePublicKey(publicKey); err != nil {
+		return errorsmod.Wrap(clienttypes.ErrInvalidConsensus, err.Error())
+	}
+
+	return nil
+}
+
+// validatePublicKey checks that the public key is not empty. If the public key is a
+// LegacyAminoPubKey threshold multisig public key, the threshold must be greater than
+// zero and must not exceed the number of public keys, each of which must be valid.
+func validatePublicKey(publicKey cryptotypes.PubKey) error {
+	if publicKey == nil || len(publicKey.Bytes()) == 0 {
+		return errors.New("public key cannot be empty")
+	}
+
+	multisigPublicKey, ok := publicKey.(*kmultisig.LegacyAminoPubKey)
+	if !ok {
+		return nil
+	}
+
+	if len(multisigPublicKey.PubKeys) == 0 {
+		return errors.New("multisig public key must contain at least one public key")
+	}
+
+	if multisigPublicKey.Threshold == 0 || int(multisigPublicKey.Threshold) > len(multisigPublicKey.PubKeys) {
+		return fmt.Errorf("multisig threshold must be greater than zero and not exceed the number of public keys (%d), got %d", len(multisigPublicKey.PubKeys), multisigPublicKey.Threshold)
+	}
+
+	for i, anyPublicKey := range multisigPublicKey.PubKeys {
+		subPublicKey, ok := anyPublicKey.GetCachedValue().(cryptotypes.PubKey)
+		if !ok {
+			return fmt.Errorf("multisig public key %d is not cryptotypes.PubKey", i)
+		}
+
+		if err := validatePublicKey(subPublicKey); err != nil {
+			return fmt.Errorf("invalid multisig public key %d: %w", i, err)
+		}
+	}
+
 	return nil
 }
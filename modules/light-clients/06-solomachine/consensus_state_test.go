@@ -1,6 +1,9 @@
 package solomachine_test
 
 import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+
 	"github.com/cosmos/ibc-go/v9/modules/core/exported"
 	solomachine "github.com/cosmos/ibc-go/v9/modules/light-clients/06-solomachine"
 	ibctesting "github.com/cosmos/ibc-go/v9/testing"
@@ -54,6 +57,24 @@ func (suite *SoloMachineTestSuite) TestConsensusStateValidateBasic() {
 				},
 				false,
 			},
+			{
+				"multisig threshold exceeds number of public keys",
+				&solomachine.ConsensusState{
+					Timestamp:   sm.Time,
+					Diversifier: sm.Diversifier,
+					PublicKey:   suite.newMultisigPublicKey(2, 3),
+				},
+				false,
+			},
+			{
+				"multisig threshold is zero",
+				&solomachine.ConsensusState{
+					Timestamp:   sm.Time,
+					Diversifier: sm.Diversifier,
+					PublicKey:   suite.newMultisigPublicKey(2, 0),
+				},
+				false,
+			},
 		}
 
 		for _, tc := range testCases {
@@ -71,3 +92,18 @@ func (suite *SoloMachineTestSuite) TestConsensusStateValidateBasic() {
 		}
 	}
 }
+
+// newMultisigPublicKey returns a LegacyAminoPubKey threshold multisig public key with the given
+// number of keys and threshold, bypassing the validation performed by its constructor.
+func (suite *SoloMachineTestSuite) newMultisigPublicKey(nKeys uint64, threshold uint32) *codectypes.Any {
+	_, _, publicKey := ibctesting.GenerateKeys(suite.T(), nKeys)
+
+	multisigPublicKey, ok := publicKey.(*kmultisig.LegacyAminoPubKey)
+	suite.Require().True(ok)
+	multisigPublicKey.Threshold = threshold
+
+	anyPublicKey, err := codectypes.NewAnyWithValue(multisigPublicKey)
+	suite.Require().NoError(err)
+
+	return anyPublicKey
+}
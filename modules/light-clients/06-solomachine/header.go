@@ -53,9 +53,13 @@ func (h Header) ValidateBasic() error {
 	}
 
 	newPublicKey, err := h.GetPubKey()
-	if err != nil || newPublicKey == nil || len(newPublicKey.Bytes()) == 0 {
+	if err != nil {
 		return errorsmod.Wrap(clienttypes.ErrInvalidHeader, "new public key cannot be empty")
 	}
 
+	if err := validatePublicKey(newPublicKey); err != nil {
+		return errorsmod.Wrapf(clienttypes.ErrInvalidHeader, "invalid new public key: %s", err)
+	}
+
 	return nil
 }
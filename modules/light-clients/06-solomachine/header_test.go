@@ -62,6 +62,16 @@ func (suite *SoloMachineTestSuite) TestHeaderValidateBasic() {
 				},
 				false,
 			},
+			{
+				"multisig threshold exceeds number of public keys",
+				&solomachine.Header{
+					Timestamp:      header.Timestamp,
+					Signature:      header.Signature,
+					NewPublicKey:   suite.newMultisigPublicKey(3, 4),
+					NewDiversifier: header.NewDiversifier,
+				},
+				false,
+			},
 		}
 
 		suite.Require().Equal(exported.Solomachine, header.ClientType())
@@ -0,0 +1,71 @@
+package solomachine
+
+import (
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+)
+
+// NewKeyRotation creates a new KeyRotation instance.
+func NewKeyRotation(newPublicKey *codectypes.Any, newDiversifier string, effectiveTime uint64) *KeyRotation {
+	return &KeyRotation{
+		NewPublicKey:   newPublicKey,
+		NewDiversifier: newDiversifier,
+		EffectiveTime:  effectiveTime,
+	}
+}
+
+// GetPubKey unmarshals the new public key into a cryptotypes.PubKey type.
+// An error is returned if the new public key is nil or the cached value
+// is not a PubKey.
+func (kr KeyRotation) GetPubKey() (cryptotypes.PubKey, error) {
+	if kr.NewPublicKey == nil {
+		return nil, errorsmod.Wrap(ErrInvalidKeyRotation, "key rotation NewPublicKey cannot be nil")
+	}
+
+	publicKey, ok := kr.NewPublicKey.GetCachedValue().(cryptotypes.PubKey)
+	if !ok {
+		return nil, errorsmod.Wrap(ErrInvalidKeyRotation, "key rotation NewPublicKey is not cryptotypes.PubKey")
+	}
+
+	return publicKey, nil
+}
+
+// ValidateBasic ensures that the effective time and the new public key have been
+// initialized and that the new diversifier is valid.
+func (kr KeyRotation) ValidateBasic() error {
+	if kr.EffectiveTime == 0 {
+		return errorsmod.Wrap(ErrInvalidKeyRotation, "effective time cannot be zero")
+	}
+
+	if kr.NewDiversifier != "" && strings.TrimSpace(kr.NewDiversifier) == "" {
+		return errorsmod.Wrap(ErrInvalidKeyRotation, "diversifier cannot contain only spaces")
+	}
+
+	newPublicKey, err := kr.GetPubKey()
+	if err != nil {
+		return err
+	}
+
+	if err := validatePublicKey(newPublicKey); err != nil {
+		return errorsmod.Wrap(ErrInvalidKeyRotation, err.Error())
+	}
+
+	return nil
+}
+
+// applyPendingKeyRotation replaces the public key and diversifier of the consensus state with
+// those of the pending key rotation if the rotation is effective at the provided block time
+// (in nanoseconds). The updated client state is not persisted.
+func (cs *ClientState) applyPendingKeyRotation(blockTime uint64) {
+	if cs.PendingKeyRotation == nil || blockTime < cs.PendingKeyRotation.EffectiveTime {
+		return
+	}
+
+	cs.ConsensusState.PublicKey = cs.PendingKeyRotation.NewPublicKey
+	cs.ConsensusState.Diversifier = cs.PendingKeyRotation.NewDiversifier
+	cs.PendingKeyRotation = nil
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 
 	clienttypes "github.com/cosmos/ibc-go/v9/modules/core/02-client/types"
+	host "github.com/cosmos/ibc-go/v9/modules/core/24-host"
 	"github.com/cosmos/ibc-go/v9/modules/core/exported"
 )
 
@@ -64,7 +65,7 @@ func (l LightClientModule) Initialize(ctx context.Context, clientID string, clie
 // VerifyClientMessage obtains the client state associated with the client identifier and calls into the clientState.VerifyClientMessage method.
 func (l LightClientModule) VerifyClientMessage(ctx context.Context, clientID string, clientMsg exported.ClientMessage) error {
 	clientStore := l.storeProvider.ClientStore(ctx, clientID)
-	clientState, found := getClientState(clientStore, l.cdc)
+	clientState, found := getActiveClientState(ctx, clientStore, l.cdc)
 	if !found {
 		return errorsmod.Wrap(clienttypes.ErrClientNotFound, clientID)
 	}
@@ -75,7 +76,7 @@ func (l LightClientModule) VerifyClientMessage(ctx context.Context, clientID str
 // CheckForMisbehaviour obtains the client state associated with the client identifier and calls into the clientState.CheckForMisbehaviour method.
 func (l LightClientModule) CheckForMisbehaviour(ctx context.Context, clientID string, clientMsg exported.ClientMessage) bool {
 	clientStore := l.storeProvider.ClientStore(ctx, clientID)
-	clientState, found := getClientState(clientStore, l.cdc)
+	clientState, found := getActiveClientState(ctx, clientStore, l.cdc)
 	if !found {
 		panic(errorsmod.Wrap(clienttypes.ErrClientNotFound, clientID))
 	}
@@ -100,7 +101,7 @@ func (l LightClientModule) UpdateStateOnMisbehaviour(ctx context.Context, client
 // UpdateState obtains the client state associated with the client identifier and calls into the clientState.UpdateState method.
 func (l LightClientModule) UpdateState(ctx context.Context, clientID string, clientMsg exported.ClientMessage) []exported.Height {
 	clientStore := l.storeProvider.ClientStore(ctx, clientID)
-	clientState, found := getClientState(clientStore, l.cdc)
+	clientState, found := getActiveClientState(ctx, clientStore, l.cdc)
 	if !found {
 		panic(errorsmod.Wrap(clienttypes.ErrClientNotFound, clientID))
 	}
@@ -120,7 +121,7 @@ func (l LightClientModule) VerifyMembership(
 	value []byte,
 ) error {
 	clientStore := l.storeProvider.ClientStore(ctx, clientID)
-	clientState, found := getClientState(clientStore, l.cdc)
+	clientState, found := getActiveClientState(ctx, clientStore, l.cdc)
 	if !found {
 		return errorsmod.Wrap(clienttypes.ErrClientNotFound, clientID)
 	}
@@ -139,7 +140,7 @@ func (l LightClientModule) VerifyNonMembership(
 	path exported.Path,
 ) error {
 	clientStore := l.storeProvider.ClientStore(ctx, clientID)
-	clientState, found := getClientState(clientStore, l.cdc)
+	clientState, found := getActiveClientState(ctx, clientStore, l.cdc)
 	if !found {
 		return errorsmod.Wrap(clienttypes.ErrClientNotFound, clientID)
 	}
@@ -193,16 +194,9 @@ func (l LightClientModule) TimestampAtHeight(ctx context.Context, clientID strin
 
 // RecoverClient asserts that the substitute client is a solo machine client. It obtains the client state associated with the
 // subject client and calls into the subjectClientState.CheckSubstituteAndUpdateState method.
+// The client type of the substitute is asserted from its stored client state rather than parsed from its identifier,
+// as the substitute client may have been migrated from a different client type.
 func (l LightClientModule) RecoverClient(ctx context.Context, clientID, substituteClientID string) error {
-	substituteClientType, _, err := clienttypes.ParseClientIdentifier(substituteClientID)
-	if err != nil {
-		return err
-	}
-
-	if substituteClientType != exported.Solomachine {
-		return errorsmod.Wrapf(clienttypes.ErrInvalidClientType, "expected: %s, got: %s", exported.Solomachine, substituteClientType)
-	}
-
 	clientStore := l.storeProvider.ClientStore(ctx, clientID)
 	clientState, found := getClientState(clientStore, l.cdc)
 	if !found {
@@ -210,11 +204,17 @@ func (l LightClientModule) RecoverClient(ctx context.Context, clientID, substitu
 	}
 
 	substituteClientStore := l.storeProvider.ClientStore(ctx, substituteClientID)
-	substituteClient, found := getClientState(substituteClientStore, l.cdc)
-	if !found {
+	bz := substituteClientStore.Get(host.ClientStateKey())
+	if len(bz) == 0 {
 		return errorsmod.Wrap(clienttypes.ErrClientNotFound, substituteClientID)
 	}
 
+	substituteClientState := clienttypes.MustUnmarshalClientState(l.cdc, bz)
+	substituteClient, ok := substituteClientState.(*ClientState)
+	if !ok {
+		return errorsmod.Wrapf(clienttypes.ErrInvalidClientType, "expected: %s, got: %s", exported.Solomachine, substituteClientState.ClientType())
+	}
+
 	return clientState.CheckSubstituteAndUpdateState(ctx, l.cdc, clientStore, substituteClientStore, substituteClient)
 }
 
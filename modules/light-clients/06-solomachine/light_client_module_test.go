@@ -2,6 +2,7 @@ package solomachine_test
 
 import (
 	"fmt"
+	"time"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -940,16 +941,27 @@ func (suite *SoloMachineTestSuite) TestRecoverClient() {
 			nil,
 		},
 		{
-			"failure: cannot parse malformed substitute client ID",
+			"success: substitute client ID does not contain 06-solomachine prefix",
+			func() {
+				// store the substitute solo machine client under a client identifier of a different client type,
+				// as is the case for a client that has been migrated to the 06-solomachine client type
+				substituteClientID = wasmClientID
+				suite.chainA.App.GetIBCKeeper().ClientKeeper.SetClientState(suite.chainA.GetContext(), substituteClientID, substituteClientState)
+			},
+			nil,
+		},
+		{
+			"failure: substitute client ID is malformed",
 			func() {
 				substituteClientID = ibctesting.InvalidID
 			},
-			host.ErrInvalidID,
+			clienttypes.ErrClientNotFound,
 		},
 		{
-			"failure: substitute client ID does not contain 06-solomachine prefix",
+			"failure: substitute client is not a solo machine client",
 			func() {
-				substituteClientID = wasmClientID
+				// the substitute client ID contains the 06-solomachine prefix, but its stored client state is of a different type
+				suite.chainA.App.GetIBCKeeper().ClientKeeper.SetClientState(suite.chainA.GetContext(), substituteClientID, &ibctm.ClientState{})
 			},
 			clienttypes.ErrInvalidClientType,
 		},
@@ -1113,6 +1125,98 @@ func (suite *SoloMachineTestSuite) TestUpdateState() {
 	}
 }
 
+func (suite *SoloMachineTestSuite) TestKeyRotationDelay() {
+	const keyRotationDelay = 60 // seconds
+
+	// test singlesig and multisig public keys
+	for _, sm := range []*ibctesting.Solomachine{suite.solomachine, suite.solomachineMulti} {
+		var (
+			ctx               sdk.Context
+			lightClientModule exported.LightClientModule
+		)
+
+		// setupClient stores a client state with a key rotation delay for the solo machine
+		setupClient := func() {
+			suite.SetupTest()
+			sm = ibctesting.NewSolomachine(suite.T(), suite.chainA.Codec, sm.ClientID, sm.Diversifier, uint64(len(sm.PrivateKeys)))
+
+			clientState := sm.ClientState()
+			clientState.KeyRotationDelay = keyRotationDelay
+
+			ctx = suite.chainA.GetContext()
+			suite.chainA.App.GetIBCKeeper().ClientKeeper.SetClientState(ctx, sm.ClientID, clientState)
+
+			var err error
+			lightClientModule, err = suite.chainA.App.GetIBCKeeper().ClientKeeper.Route(ctx, sm.ClientID)
+			suite.Require().NoError(err)
+		}
+
+		// update verifies and applies the header at the provided block time and returns the stored client state
+		update := func(header *solomachine.Header, blockTime time.Time) *solomachine.ClientState {
+			updateCtx := ctx.WithBlockTime(blockTime)
+			suite.Require().NoError(lightClientModule.VerifyClientMessage(updateCtx, sm.ClientID, header))
+			lightClientModule.UpdateState(updateCtx, sm.ClientID, header)
+
+			clientState, found := suite.chainA.App.GetIBCKeeper().ClientKeeper.GetClientState(ctx, sm.ClientID)
+			suite.Require().True(found)
+			return clientState.(*solomachine.ClientState)
+		}
+
+		suite.Run("key rotation becomes effective after delay", func() {
+			setupClient()
+			currentPublicKey := sm.ConsensusState().PublicKey
+			blockTime := ctx.BlockTime()
+
+			rotationHeader := sm.CreateHeader("rotated")
+			clientState := update(rotationHeader, blockTime)
+
+			suite.Require().Equal(uint64(2), clientState.Sequence)
+			suite.Require().Equal(currentPublicKey, clientState.ConsensusState.PublicKey)
+			suite.Require().Equal("testing", clientState.ConsensusState.Diversifier)
+			suite.Require().Equal(solomachine.NewKeyRotation(rotationHeader.NewPublicKey, "rotated", uint64(blockTime.Add(keyRotationDelay*time.Second).UnixNano())), clientState.PendingKeyRotation)
+
+			// the solo machine now signs with the new keys, which are not effective before the delay has elapsed
+			header := sm.CreateHeader("rotated")
+
+			err := lightClientModule.VerifyClientMessage(ctx.WithBlockTime(blockTime.Add(keyRotationDelay*time.Second-time.Nanosecond)), sm.ClientID, header)
+			suite.Require().Error(err)
+
+			effectiveTime := blockTime.Add(keyRotationDelay * time.Second)
+			clientState = update(header, effectiveTime)
+
+			suite.Require().Equal(uint64(3), clientState.Sequence)
+			suite.Require().Equal(rotationHeader.NewPublicKey, clientState.ConsensusState.PublicKey)
+			suite.Require().Equal("rotated", clientState.ConsensusState.Diversifier)
+
+			// the header signed by the new keys proposes another key rotation
+			suite.Require().Equal(solomachine.NewKeyRotation(header.NewPublicKey, "rotated", uint64(effectiveTime.Add(keyRotationDelay*time.Second).UnixNano())), clientState.PendingKeyRotation)
+		})
+
+		suite.Run("key rotation is cancelled by current keys", func() {
+			setupClient()
+			currentPrivateKeys, currentPublicKeys, currentPublicKey := sm.PrivateKeys, sm.PublicKeys, sm.PublicKey
+			currentAnyPublicKey := sm.ConsensusState().PublicKey
+			blockTime := ctx.BlockTime()
+
+			clientState := update(sm.CreateHeader(sm.Diversifier), blockTime)
+			suite.Require().NotNil(clientState.PendingKeyRotation)
+
+			// the current keys propose themselves to cancel the pending key rotation
+			sm.PrivateKeys, sm.PublicKeys, sm.PublicKey = currentPrivateKeys, currentPublicKeys, currentPublicKey
+			clientState = update(sm.CreateHeaderWithKeys(currentPrivateKeys, currentPublicKeys, currentPublicKey, "cancelled"), blockTime)
+
+			suite.Require().Equal(uint64(3), clientState.Sequence)
+			suite.Require().Equal(currentAnyPublicKey, clientState.ConsensusState.PublicKey)
+			suite.Require().Equal("cancelled", clientState.ConsensusState.Diversifier)
+			suite.Require().Nil(clientState.PendingKeyRotation)
+
+			// the current keys remain effective after the delay has elapsed
+			clientState = update(sm.CreateHeaderWithKeys(currentPrivateKeys, currentPublicKeys, currentPublicKey, "cancelled"), blockTime.Add(keyRotationDelay*time.Second))
+			suite.Require().Equal(currentAnyPublicKey, clientState.ConsensusState.PublicKey)
+		})
+	}
+}
+
 func (suite *SoloMachineTestSuite) TestCheckForMisbehaviour() {
 	var (
 		clientMsg exported.ClientMessage
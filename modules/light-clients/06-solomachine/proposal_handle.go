@@ -15,8 +15,9 @@ import (
 
 // CheckSubstituteAndUpdateState verifies that the subject is allowed to be updated by
 // a governance proposal and that the substitute client is a solo machine.
-// It will update the consensus state to the substitute's consensus state and
-// the sequence to the substitute's current sequence. An error is returned if
+// It will update the consensus state, the key rotation delay and the pending key
+// rotation to those of the substitute and the sequence to the substitute's current
+// sequence. An error is returned if
 // the client has been disallowed to be updated by a governance proposal,
 // the substitute is not a solo machine, or the current public key equals
 // the new public key.
@@ -43,9 +44,11 @@ func (cs ClientState) CheckSubstituteAndUpdateState(
 		return errorsmod.Wrapf(clienttypes.ErrInvalidHeader, "subject and substitute have the same public key")
 	}
 
-	// update to substitute parameters
+	// update to substitute parameters, any pending key rotation of the subject is discarded
 	cs.Sequence = substituteClientState.Sequence
 	cs.ConsensusState = substituteClientState.ConsensusState
+	cs.KeyRotationDelay = substituteClientState.KeyRotationDelay
+	cs.PendingKeyRotation = substituteClientState.PendingKeyRotation
 	cs.IsFrozen = false
 
 	setClientState(subjectClientStore, cdc, &cs)
@@ -6,13 +6,19 @@ import (
 )
 
 // Interface implementation checks.
-var _, _, _, _ codectypes.UnpackInterfacesMessage = (*ClientState)(nil), (*ConsensusState)(nil), (*Header)(nil), (*HeaderData)(nil)
+var _, _, _, _, _ codectypes.UnpackInterfacesMessage = (*ClientState)(nil), (*ConsensusState)(nil), (*Header)(nil), (*HeaderData)(nil), (*KeyRotation)(nil)
 
 // Data is an interface used for all the signature data bytes proto definitions.
 type Data interface{}
 
 // UnpackInterfaces implements the UnpackInterfaceMessages.UnpackInterfaces method
 func (cs ClientState) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	if cs.PendingKeyRotation != nil {
+		if err := cs.PendingKeyRotation.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+
 	return cs.ConsensusState.UnpackInterfaces(unpacker)
 }
 
@@ -30,3 +36,8 @@ func (h Header) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
 func (hd HeaderData) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
 	return unpacker.UnpackAny(hd.NewPubKey, new(cryptotypes.PubKey))
 }
+
+// UnpackInterfaces implements the UnpackInterfaceMessages.UnpackInterfaces method
+func (kr KeyRotation) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return unpacker.UnpackAny(kr.NewPublicKey, new(cryptotypes.PubKey))
+}
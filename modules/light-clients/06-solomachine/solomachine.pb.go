@@ -32,6 +32,11 @@ type ClientState struct {
 	// frozen sequence of the solo machine
 	IsFrozen       bool            `protobuf:"varint,2,opt,name=is_frozen,json=isFrozen,proto3" json:"is_frozen,omitempty"`
 	ConsensusState *ConsensusState `protobuf:"bytes,3,opt,name=consensus_state,json=consensusState,proto3" json:"consensus_state,omitempty"`
+	// delay in seconds, measured in host chain block time, after which a key rotation proposed by a header
+	// becomes effective. Key rotations are applied immediately if the delay is zero.
+	KeyRotationDelay uint64 `protobuf:"varint,4,opt,name=key_rotation_delay,json=keyRotationDelay,proto3" json:"key_rotation_delay,omitempty"`
+	// key rotation proposed by a header which has not become effective yet
+	PendingKeyRotation *KeyRotation `protobuf:"bytes,5,opt,name=pending_key_rotation,json=pendingKeyRotation,proto3" json:"pending_key_rotation,omitempty"`
 }
 
 func (m *ClientState) Reset()         { *m = ClientState{} }
@@ -67,6 +72,52 @@ func (m *ClientState) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ClientState proto.InternalMessageInfo
 
+// KeyRotation defines a pending rotation of the solo machine public key and
+// diversifier. Until the rotation becomes effective it may be cancelled by a
+// header signed by the current public key.
+type KeyRotation struct {
+	// public key which becomes effective once the rotation is applied
+	NewPublicKey *types.Any `protobuf:"bytes,1,opt,name=new_public_key,json=newPublicKey,proto3" json:"new_public_key,omitempty"`
+	// diversifier which becomes effective once the rotation is applied
+	NewDiversifier string `protobuf:"bytes,2,opt,name=new_diversifier,json=newDiversifier,proto3" json:"new_diversifier,omitempty"`
+	// unix timestamp in nanoseconds, measured in host chain block time, at
+	// which the rotation becomes effective
+	EffectiveTime uint64 `protobuf:"varint,3,opt,name=effective_time,json=effectiveTime,proto3" json:"effective_time,omitempty"`
+}
+
+func (m *KeyRotation) Reset()         { *m = KeyRotation{} }
+func (m *KeyRotation) String() string { return proto.CompactTextString(m) }
+func (*KeyRotation) ProtoMessage()    {}
+func (*KeyRotation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_264187157b9220a4, []int{1}
+}
+func (m *KeyRotation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *KeyRotation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_KeyRotation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *KeyRotation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KeyRotation.Merge(m, src)
+}
+func (m *KeyRotation) XXX_Size() int {
+	return m.Size()
+}
+func (m *KeyRotation) XXX_DiscardUnknown() {
+	xxx_messageInfo_KeyRotation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KeyRotation proto.InternalMessageInfo
+
 // ConsensusState defines a solo machine consensus state. The sequence of a
 // consensus state is contained in the "height" key used in storing the
 // consensus state.
@@ -84,7 +135,7 @@ func (m *ConsensusState) Reset()         { *m = ConsensusState{} }
 func (m *ConsensusState) String() string { return proto.CompactTextString(m) }
 func (*ConsensusState) ProtoMessage()    {}
 func (*ConsensusState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_264187157b9220a4, []int{1}
+	return fileDescriptor_264187157b9220a4, []int{2}
 }
 func (m *ConsensusState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -125,7 +176,7 @@ func (m *Header) Reset()         { *m = Header{} }
 func (m *Header) String() string { return proto.CompactTextString(m) }
 func (*Header) ProtoMessage()    {}
 func (*Header) Descriptor() ([]byte, []int) {
-	return fileDescriptor_264187157b9220a4, []int{2}
+	return fileDescriptor_264187157b9220a4, []int{3}
 }
 func (m *Header) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -166,7 +217,7 @@ func (m *Misbehaviour) Reset()         { *m = Misbehaviour{} }
 func (m *Misbehaviour) String() string { return proto.CompactTextString(m) }
 func (*Misbehaviour) ProtoMessage()    {}
 func (*Misbehaviour) Descriptor() ([]byte, []int) {
-	return fileDescriptor_264187157b9220a4, []int{3}
+	return fileDescriptor_264187157b9220a4, []int{4}
 }
 func (m *Misbehaviour) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -208,7 +259,7 @@ func (m *SignatureAndData) Reset()         { *m = SignatureAndData{} }
 func (m *SignatureAndData) String() string { return proto.CompactTextString(m) }
 func (*SignatureAndData) ProtoMessage()    {}
 func (*SignatureAndData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_264187157b9220a4, []int{4}
+	return fileDescriptor_264187157b9220a4, []int{5}
 }
 func (m *SignatureAndData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -248,7 +299,7 @@ func (m *TimestampedSignatureData) Reset()         { *m = TimestampedSignatureDa
 func (m *TimestampedSignatureData) String() string { return proto.CompactTextString(m) }
 func (*TimestampedSignatureData) ProtoMessage()    {}
 func (*TimestampedSignatureData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_264187157b9220a4, []int{5}
+	return fileDescriptor_264187157b9220a4, []int{6}
 }
 func (m *TimestampedSignatureData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -295,7 +346,7 @@ func (m *SignBytes) Reset()         { *m = SignBytes{} }
 func (m *SignBytes) String() string { return proto.CompactTextString(m) }
 func (*SignBytes) ProtoMessage()    {}
 func (*SignBytes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_264187157b9220a4, []int{6}
+	return fileDescriptor_264187157b9220a4, []int{7}
 }
 func (m *SignBytes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -336,7 +387,7 @@ func (m *HeaderData) Reset()         { *m = HeaderData{} }
 func (m *HeaderData) String() string { return proto.CompactTextString(m) }
 func (*HeaderData) ProtoMessage()    {}
 func (*HeaderData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_264187157b9220a4, []int{7}
+	return fileDescriptor_264187157b9220a4, []int{8}
 }
 func (m *HeaderData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -367,6 +418,7 @@ var xxx_messageInfo_HeaderData proto.InternalMessageInfo
 
 func init() {
 	proto.RegisterType((*ClientState)(nil), "ibc.lightclients.solomachine.v3.ClientState")
+	proto.RegisterType((*KeyRotation)(nil), "ibc.lightclients.solomachine.v3.KeyRotation")
 	proto.RegisterType((*ConsensusState)(nil), "ibc.lightclients.solomachine.v3.ConsensusState")
 	proto.RegisterType((*Header)(nil), "ibc.lightclients.solomachine.v3.Header")
 	proto.RegisterType((*Misbehaviour)(nil), "ibc.lightclients.solomachine.v3.Misbehaviour")
@@ -381,46 +433,52 @@ func init() {
 }
 
 var fileDescriptor_264187157b9220a4 = []byte{
-	// 619 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0xcf, 0x6e, 0xd3, 0x4e,
-	0x10, 0xce, 0xb6, 0xfe, 0x55, 0xcd, 0x3a, 0xbf, 0x14, 0x59, 0x3d, 0x84, 0x82, 0xdc, 0xa8, 0x12,
-	0xa2, 0x97, 0xd8, 0x34, 0x41, 0x48, 0x94, 0x53, 0xdb, 0x08, 0x21, 0x01, 0x02, 0xb9, 0x15, 0x42,
-	0x5c, 0xa2, 0xb5, 0xbd, 0x71, 0x56, 0xd8, 0xbb, 0xa9, 0x77, 0x9d, 0x28, 0x88, 0x07, 0x40, 0xe2,
-	0xc2, 0x85, 0x3b, 0x27, 0xae, 0xbc, 0x06, 0xc7, 0x1e, 0x39, 0x56, 0xc9, 0x8b, 0x20, 0xaf, 0xed,
-	0xd8, 0x71, 0xd3, 0xe4, 0xc0, 0x6d, 0x66, 0x3c, 0xf3, 0xed, 0xf7, 0xcd, 0x1f, 0xc3, 0x23, 0x62,
-	0x3b, 0xa6, 0x4f, 0xbc, 0x81, 0x70, 0x7c, 0x82, 0xa9, 0xe0, 0x26, 0x67, 0x3e, 0x0b, 0x90, 0x33,
-	0x20, 0x14, 0x9b, 0xa3, 0x4e, 0xd1, 0x35, 0x86, 0x21, 0x13, 0x4c, 0xdb, 0x27, 0xb6, 0x63, 0x14,
-	0x4b, 0x8c, 0x62, 0xce, 0xa8, 0xb3, 0xb7, 0xeb, 0x31, 0x8f, 0xc9, 0x5c, 0x33, 0xb6, 0x92, 0xb2,
-	0xbd, 0xbb, 0x1e, 0x63, 0x9e, 0x8f, 0x4d, 0xe9, 0xd9, 0x51, 0xdf, 0x44, 0x74, 0x92, 0x7c, 0x3a,
-	0xf8, 0x09, 0xa0, 0x7a, 0x26, 0xb1, 0xce, 0x05, 0x12, 0x58, 0xdb, 0x83, 0xdb, 0x1c, 0x5f, 0x46,
-	0x98, 0x3a, 0xb8, 0x01, 0x9a, 0xe0, 0x50, 0xb1, 0xe6, 0xbe, 0x76, 0x0f, 0x56, 0x09, 0xef, 0xf5,
-	0x43, 0xf6, 0x09, 0xd3, 0xc6, 0x46, 0x13, 0x1c, 0x6e, 0x5b, 0xdb, 0x84, 0x3f, 0x97, 0xbe, 0xf6,
-	0x1e, 0xee, 0x38, 0x8c, 0x72, 0x4c, 0x79, 0xc4, 0x7b, 0x3c, 0xc6, 0x6a, 0x6c, 0x36, 0xc1, 0xa1,
-	0xda, 0x36, 0x8d, 0x35, 0xa4, 0x8d, 0xb3, 0xac, 0x4e, 0x52, 0xb0, 0xea, 0xce, 0x82, 0x7f, 0xac,
-	0x7c, 0xf9, 0xb1, 0x5f, 0x39, 0xf8, 0x0a, 0x60, 0x7d, 0x31, 0x51, 0xeb, 0x40, 0x38, 0x8c, 0x6c,
-	0x9f, 0x38, 0xbd, 0x8f, 0x78, 0x22, 0xd9, 0xaa, 0xed, 0x5d, 0x23, 0xd1, 0x6a, 0x64, 0x5a, 0x8d,
-	0x13, 0x3a, 0xb1, 0xaa, 0x49, 0xde, 0x4b, 0x3c, 0xd1, 0x9a, 0x50, 0x75, 0xc9, 0x08, 0x87, 0x9c,
-	0xf4, 0x09, 0x0e, 0xa5, 0x8c, 0xaa, 0x55, 0x0c, 0x69, 0xf7, 0x61, 0x55, 0x90, 0x00, 0x73, 0x81,
-	0x82, 0xa1, 0xd4, 0xa0, 0x58, 0x79, 0x20, 0x65, 0xf3, 0x0b, 0xc0, 0xad, 0x17, 0x18, 0xb9, 0xe5,
-	0x74, 0x50, 0x4a, 0x8f, 0xbf, 0x72, 0xe2, 0x51, 0x24, 0xa2, 0x10, 0xcb, 0xc7, 0x6a, 0x56, 0x1e,
-	0xd0, 0x8e, 0x61, 0x9d, 0xe2, 0x71, 0xaf, 0xa0, 0x62, 0x73, 0x85, 0x8a, 0x1a, 0xc5, 0xe3, 0xb7,
-	0x73, 0x21, 0x0f, 0xe1, 0x4e, 0x5c, 0x5b, 0x14, 0xa3, 0x48, 0x31, 0x31, 0x64, 0x37, 0x8f, 0xa6,
-	0x8c, 0xaf, 0x01, 0xac, 0xbd, 0x26, 0xdc, 0xc6, 0x03, 0x34, 0x22, 0x2c, 0x0a, 0x57, 0x4e, 0xfa,
-	0x1d, 0xfc, 0x7f, 0x4e, 0xb2, 0xc7, 0x68, 0xc2, 0x5c, 0x6d, 0x1f, 0xad, 0x1d, 0xe5, 0x79, 0x56,
-	0x75, 0x42, 0xdd, 0x2e, 0x12, 0xc8, 0xaa, 0xcd, 0x71, 0xde, 0xd0, 0x12, 0xae, 0x18, 0xb3, 0x54,
-	0xee, 0x3f, 0xe1, 0x5e, 0x8c, 0x59, 0x2a, 0xf1, 0x33, 0xbc, 0x53, 0xce, 0x5b, 0xec, 0x3f, 0x28,
-	0xf7, 0x5f, 0x83, 0xca, 0x10, 0x89, 0x41, 0x3a, 0x18, 0x69, 0xc7, 0x31, 0x17, 0x09, 0x24, 0xa9,
-	0xd5, 0x2c, 0x69, 0x2f, 0xce, 0x58, 0x59, 0xbe, 0x12, 0x18, 0x36, 0x2e, 0xb2, 0x10, 0x76, 0xe7,
-	0x44, 0x24, 0x8b, 0x07, 0xb0, 0x9e, 0xeb, 0x96, 0xe8, 0x09, 0x95, 0xbc, 0x1b, 0xdd, 0x1b, 0xcf,
-	0x6c, 0x2c, 0x7f, 0xe6, 0x3b, 0x80, 0xd5, 0x18, 0xfc, 0x74, 0x22, 0x30, 0x5f, 0x39, 0xc4, 0x95,
-	0x68, 0xe5, 0x3b, 0xd8, 0xbc, 0x79, 0x07, 0x59, 0x73, 0x94, 0x25, 0xcd, 0xf9, 0x2f, 0x6f, 0x4e,
-	0xca, 0xeb, 0x12, 0xc2, 0xe4, 0x20, 0xa4, 0x92, 0xc7, 0x50, 0x4d, 0x17, 0x7b, 0xfd, 0x6d, 0x26,
-	0x5b, 0x7d, 0xcb, 0x4a, 0x6f, 0xdc, 0xbe, 0xd2, 0xa7, 0xfd, 0xdf, 0x53, 0x1d, 0x5c, 0x4d, 0x75,
-	0x70, 0x3d, 0xd5, 0xc1, 0xb7, 0x99, 0x5e, 0xb9, 0x9a, 0xe9, 0x95, 0x3f, 0x33, 0xbd, 0xf2, 0xe1,
-	0x95, 0x47, 0xc4, 0x20, 0xb2, 0x0d, 0x87, 0x05, 0xa6, 0xc3, 0x78, 0xc0, 0xb8, 0x49, 0x6c, 0xa7,
-	0xe5, 0x31, 0x73, 0xf4, 0xd4, 0x0c, 0x98, 0x1b, 0xf9, 0x98, 0x27, 0xbf, 0xde, 0x56, 0xf6, 0xef,
-	0x7d, 0xf4, 0xa4, 0x55, 0xd8, 0xb9, 0x67, 0x05, 0xdb, 0xde, 0x92, 0x7c, 0x3b, 0x7f, 0x03, 0x00,
-	0x00, 0xff, 0xff, 0x0a, 0x37, 0x9c, 0x84, 0xb1, 0x05, 0x00, 0x00,
+	// 707 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x95, 0xcf, 0x4e, 0xdb, 0x4a,
+	0x14, 0xc6, 0x33, 0xc4, 0x20, 0x32, 0x09, 0x01, 0x8d, 0x58, 0xe4, 0x72, 0xaf, 0x42, 0x84, 0x84,
+	0x2e, 0x0b, 0xb0, 0x0b, 0xa9, 0x2a, 0x95, 0xae, 0xf8, 0xa3, 0xaa, 0x12, 0xad, 0x5a, 0x19, 0x54,
+	0x55, 0x5d, 0xd4, 0x1a, 0xdb, 0x27, 0xce, 0x88, 0x64, 0x26, 0x64, 0xc6, 0x89, 0x52, 0xf5, 0x01,
+	0x2a, 0x75, 0xd3, 0x4d, 0xd7, 0xed, 0x1b, 0x74, 0xd3, 0x87, 0xe8, 0x92, 0x65, 0x97, 0x08, 0x5e,
+	0xa4, 0xf2, 0xd8, 0x89, 0x9d, 0x10, 0x91, 0x56, 0xec, 0x66, 0xce, 0x9c, 0xf3, 0xcd, 0x6f, 0xbe,
+	0x99, 0x63, 0xe3, 0x5d, 0xe6, 0x7a, 0x56, 0x8b, 0x05, 0x4d, 0xe5, 0xb5, 0x18, 0x70, 0x25, 0x2d,
+	0x29, 0x5a, 0xa2, 0x4d, 0xbd, 0x26, 0xe3, 0x60, 0xf5, 0xea, 0xd9, 0xa9, 0xd9, 0xe9, 0x0a, 0x25,
+	0xc8, 0x3a, 0x73, 0x3d, 0x33, 0x5b, 0x62, 0x66, 0x73, 0x7a, 0xf5, 0xb5, 0xd5, 0x40, 0x04, 0x42,
+	0xe7, 0x5a, 0xd1, 0x28, 0x2e, 0x5b, 0xfb, 0x27, 0x10, 0x22, 0x68, 0x81, 0xa5, 0x67, 0x6e, 0xd8,
+	0xb0, 0x28, 0x1f, 0xc4, 0x4b, 0x1b, 0x3f, 0xe6, 0x70, 0xf1, 0x48, 0x6b, 0x9d, 0x2a, 0xaa, 0x80,
+	0xac, 0xe1, 0x45, 0x09, 0x17, 0x21, 0x70, 0x0f, 0x2a, 0xa8, 0x86, 0xb6, 0x0c, 0x7b, 0x34, 0x27,
+	0xff, 0xe2, 0x02, 0x93, 0x4e, 0xa3, 0x2b, 0xde, 0x03, 0xaf, 0xcc, 0xd5, 0xd0, 0xd6, 0xa2, 0xbd,
+	0xc8, 0xe4, 0x53, 0x3d, 0x27, 0x6f, 0xf0, 0xb2, 0x27, 0xb8, 0x04, 0x2e, 0x43, 0xe9, 0xc8, 0x48,
+	0xab, 0x92, 0xaf, 0xa1, 0xad, 0xe2, 0x9e, 0x65, 0xce, 0x80, 0x36, 0x8f, 0x86, 0x75, 0x1a, 0xc1,
+	0x2e, 0x7b, 0x63, 0x73, 0xb2, 0x8d, 0xc9, 0x39, 0x0c, 0x9c, 0xae, 0x50, 0x54, 0x31, 0xc1, 0x1d,
+	0x1f, 0x5a, 0x74, 0x50, 0x31, 0x34, 0xdc, 0xca, 0x39, 0x0c, 0xec, 0x64, 0xe1, 0x38, 0x8a, 0x93,
+	0x77, 0x78, 0xb5, 0x03, 0xdc, 0x67, 0x3c, 0x70, 0xb2, 0x55, 0x95, 0x79, 0x0d, 0xb3, 0x3d, 0x13,
+	0xe6, 0x24, 0x15, 0xb4, 0x49, 0xa2, 0x94, 0x89, 0xed, 0x1b, 0x1f, 0xbf, 0xad, 0xe7, 0x36, 0xbe,
+	0x22, 0x5c, 0xcc, 0x44, 0xc9, 0x3e, 0x2e, 0x73, 0xe8, 0x3b, 0x9d, 0xd0, 0x6d, 0x31, 0x2f, 0xda,
+	0x58, 0x9b, 0x57, 0xdc, 0x5b, 0x35, 0x63, 0xeb, 0xcd, 0xa1, 0xf5, 0xe6, 0x01, 0x1f, 0xd8, 0x25,
+	0x0e, 0xfd, 0x57, 0x3a, 0xf5, 0x04, 0x06, 0xe4, 0x7f, 0xbc, 0x1c, 0xd5, 0xfa, 0xac, 0x07, 0x5d,
+	0xc9, 0x1a, 0x0c, 0xba, 0xda, 0xdc, 0x82, 0x1d, 0x49, 0x1e, 0xa7, 0x51, 0xb2, 0x89, 0xcb, 0xd0,
+	0x68, 0x80, 0xa7, 0x58, 0x0f, 0x1c, 0xc5, 0xda, 0xb1, 0xc3, 0x86, 0xbd, 0x34, 0x8a, 0x9e, 0xb1,
+	0x36, 0x24, 0x84, 0x9f, 0x10, 0x2e, 0x8f, 0x1b, 0x4b, 0xea, 0x18, 0xff, 0x21, 0x60, 0xa1, 0x33,
+	0xa2, 0xab, 0xe1, 0xe2, 0x6d, 0xb2, 0x6c, 0x88, 0xfc, 0x87, 0x0b, 0x11, 0x8c, 0x54, 0xb4, 0xdd,
+	0x49, 0x88, 0xd2, 0x40, 0x42, 0xf3, 0x1d, 0xe1, 0x85, 0x67, 0x40, 0xfd, 0xc9, 0x74, 0x34, 0x91,
+	0x1e, 0xad, 0x4a, 0x16, 0x70, 0xaa, 0xc2, 0x2e, 0xe8, 0xcd, 0x4a, 0x76, 0x1a, 0x98, 0x62, 0x73,
+	0xfe, 0x3e, 0x36, 0x1b, 0xd3, 0x6c, 0x4e, 0x88, 0xaf, 0x10, 0x2e, 0xbd, 0x60, 0xd2, 0x85, 0x26,
+	0xed, 0x31, 0x11, 0x76, 0xef, 0xec, 0x8c, 0xd7, 0x78, 0x69, 0x04, 0xe9, 0x08, 0x1e, 0x93, 0x17,
+	0xf7, 0x76, 0x67, 0xbe, 0xb6, 0xd3, 0x61, 0xd5, 0x01, 0xf7, 0x8f, 0xa9, 0xa2, 0x76, 0x69, 0xa4,
+	0xf3, 0x92, 0x4f, 0xe8, 0xaa, 0xbe, 0xa8, 0xe4, 0xef, 0xaf, 0x7b, 0xd6, 0x17, 0xc9, 0x11, 0x3f,
+	0xe0, 0x95, 0xc9, 0xbc, 0x71, 0xff, 0xd1, 0xa4, 0xff, 0x04, 0x1b, 0x1d, 0xaa, 0x9a, 0xc9, 0xc5,
+	0xe8, 0x71, 0x14, 0xf3, 0xa9, 0xa2, 0x1a, 0xad, 0x64, 0x1b, 0x7e, 0xa2, 0x92, 0xde, 0xb1, 0x31,
+	0xfd, 0x49, 0x00, 0xae, 0x9c, 0x0d, 0x43, 0xe0, 0x8f, 0x40, 0x34, 0xc5, 0x26, 0x2e, 0xa7, 0xe7,
+	0xd6, 0xea, 0x31, 0xca, 0x92, 0x1c, 0x4b, 0x1b, 0xdb, 0x66, 0x6e, 0xfa, 0x36, 0x5f, 0x10, 0x2e,
+	0x44, 0xe2, 0x87, 0x03, 0x05, 0xf2, 0xce, 0x4b, 0xbc, 0x53, 0x6d, 0xb2, 0x0f, 0xf2, 0xb7, 0xfb,
+	0x60, 0x68, 0x8e, 0x31, 0xc5, 0x9c, 0xf9, 0xd4, 0x9c, 0x84, 0xeb, 0x02, 0xe3, 0xb8, 0x21, 0xf4,
+	0x49, 0x1e, 0xe2, 0x62, 0xf2, 0xb0, 0x67, 0xf7, 0x66, 0xfc, 0xaa, 0xff, 0xe6, 0xcb, 0x11, 0x6f,
+	0x79, 0xd8, 0xf8, 0x79, 0x5d, 0x45, 0x97, 0xd7, 0x55, 0x74, 0x75, 0x5d, 0x45, 0x9f, 0x6f, 0xaa,
+	0xb9, 0xcb, 0x9b, 0x6a, 0xee, 0xd7, 0x4d, 0x35, 0xf7, 0xf6, 0x79, 0xc0, 0x54, 0x33, 0x74, 0x4d,
+	0x4f, 0xb4, 0x2d, 0x4f, 0xc8, 0xb6, 0x90, 0x16, 0x73, 0xbd, 0x9d, 0x40, 0x58, 0xbd, 0xc7, 0x56,
+	0x5b, 0xf8, 0x61, 0x0b, 0x64, 0xfc, 0xab, 0xda, 0x19, 0xfe, 0xab, 0x1e, 0x3c, 0xda, 0xc9, 0xbc,
+	0xb9, 0x27, 0x99, 0xb1, 0xbb, 0xa0, 0x79, 0xeb, 0xbf, 0x07, 0x00, 0xd7, 0x34, 0xc9, 0x6e, 0xe1,
+	0x06, 0x00, 0x00,
 }
 
 func (m *ClientState) Marshal() (dAtA []byte, err error) {
@@ -443,6 +501,23 @@ func (m *ClientState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.PendingKeyRotation != nil {
+		{
+			size, err := m.PendingKeyRotation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSolomachine(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.KeyRotationDelay != 0 {
+		i = encodeVarintSolomachine(dAtA, i, uint64(m.KeyRotationDelay))
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.ConsensusState != nil {
 		{
 			size, err := m.ConsensusState.MarshalToSizedBuffer(dAtA[:i])
@@ -473,6 +548,53 @@ func (m *ClientState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *KeyRotation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *KeyRotation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *KeyRotation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EffectiveTime != 0 {
+		i = encodeVarintSolomachine(dAtA, i, uint64(m.EffectiveTime))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.NewDiversifier) > 0 {
+		i -= len(m.NewDiversifier)
+		copy(dAtA[i:], m.NewDiversifier)
+		i = encodeVarintSolomachine(dAtA, i, uint64(len(m.NewDiversifier)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.NewPublicKey != nil {
+		{
+			size, err := m.NewPublicKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSolomachine(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ConsensusState) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -833,6 +955,33 @@ func (m *ClientState) Size() (n int) {
 		l = m.ConsensusState.Size()
 		n += 1 + l + sovSolomachine(uint64(l))
 	}
+	if m.KeyRotationDelay != 0 {
+		n += 1 + sovSolomachine(uint64(m.KeyRotationDelay))
+	}
+	if m.PendingKeyRotation != nil {
+		l = m.PendingKeyRotation.Size()
+		n += 1 + l + sovSolomachine(uint64(l))
+	}
+	return n
+}
+
+func (m *KeyRotation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NewPublicKey != nil {
+		l = m.NewPublicKey.Size()
+		n += 1 + l + sovSolomachine(uint64(l))
+	}
+	l = len(m.NewDiversifier)
+	if l > 0 {
+		n += 1 + l + sovSolomachine(uint64(l))
+	}
+	if m.EffectiveTime != 0 {
+		n += 1 + sovSolomachine(uint64(m.EffectiveTime))
+	}
 	return n
 }
 
@@ -1094,6 +1243,198 @@ func (m *ClientState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyRotationDelay", wireType)
+			}
+			m.KeyRotationDelay = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSolomachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeyRotationDelay |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingKeyRotation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSolomachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSolomachine
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSolomachine
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PendingKeyRotation == nil {
+				m.PendingKeyRotation = &KeyRotation{}
+			}
+			if err := m.PendingKeyRotation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSolomachine(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSolomachine
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KeyRotation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSolomachine
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KeyRotation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KeyRotation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewPublicKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSolomachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSolomachine
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSolomachine
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NewPublicKey == nil {
+				m.NewPublicKey = &types.Any{}
+			}
+			if err := m.NewPublicKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewDiversifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSolomachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSolomachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSolomachine
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewDiversifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveTime", wireType)
+			}
+			m.EffectiveTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSolomachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EffectiveTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSolomachine(dAtA[iNdEx:])
@@ -1,11 +1,13 @@
 package solomachine
 
 import (
+	"context"
 	"fmt"
 
 	storetypes "cosmossdk.io/store/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	clienttypes "github.com/cosmos/ibc-go/v9/modules/core/02-client/types"
 	host "github.com/cosmos/ibc-go/v9/modules/core/24-host"
@@ -28,3 +30,18 @@ func getClientState(store storetypes.KVStore, cdc codec.BinaryCodec) (*ClientSta
 
 	return clientState, true
 }
+
+// getActiveClientState retrieves the client state from the store using the provided KVStore and codec and
+// applies any pending key rotation which is effective at the current block time. The applied key rotation is
+// only persisted once the client state is next stored.
+func getActiveClientState(ctx context.Context, store storetypes.KVStore, cdc codec.BinaryCodec) (*ClientState, bool) {
+	clientState, found := getClientState(store, cdc)
+	if !found {
+		return nil, false
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx) // TODO: https://github.com/cosmos/ibc-go/issues/5917
+	clientState.applyPendingKeyRotation(uint64(sdkCtx.BlockTime().UnixNano()))
+
+	return clientState, true
+}
@@ -2,11 +2,13 @@ package solomachine
 
 import (
 	"context"
+	"time"
 
 	errorsmod "cosmossdk.io/errors"
 	storetypes "cosmossdk.io/store/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	clienttypes "github.com/cosmos/ibc-go/v9/modules/core/02-client/types"
 	"github.com/cosmos/ibc-go/v9/modules/core/exported"
@@ -77,6 +79,10 @@ func (cs ClientState) verifyHeader(cdc codec.BinaryCodec, header *Header) error
 }
 
 // UpdateState updates the consensus state to the new public key and an incremented sequence.
+// If a key rotation delay is set and the header proposes a public key different from the current
+// public key, the new public key and diversifier are stored as a pending key rotation which becomes
+// effective once the delay has elapsed. A header proposing the current public key updates the
+// diversifier and cancels any pending key rotation.
 // A list containing the updated consensus height is returned.
 // If the provided clientMsg is not of type Header, the handler will no-op and return an empty slice.
 func (cs ClientState) UpdateState(ctx context.Context, cdc codec.BinaryCodec, clientStore storetypes.KVStore, clientMsg exported.ClientMessage) []exported.Height {
@@ -86,17 +92,51 @@ func (cs ClientState) UpdateState(ctx context.Context, cdc codec.BinaryCodec, cl
 		return []exported.Height{}
 	}
 
-	// create new solomachine ConsensusState
-	consensusState := &ConsensusState{
-		PublicKey:   smHeader.NewPublicKey,
-		Diversifier: smHeader.NewDiversifier,
-		Timestamp:   smHeader.Timestamp,
+	if cs.KeyRotationDelay == 0 {
+		// create new solomachine ConsensusState
+		cs.ConsensusState = &ConsensusState{
+			PublicKey:   smHeader.NewPublicKey,
+			Diversifier: smHeader.NewDiversifier,
+			Timestamp:   smHeader.Timestamp,
+		}
+	} else {
+		cs.updateStateWithKeyRotationDelay(ctx, smHeader)
 	}
 
 	cs.Sequence++
-	cs.ConsensusState = consensusState
 
 	setClientState(clientStore, cdc, &cs)
 
 	return []exported.Height{clienttypes.NewHeight(0, cs.Sequence)}
 }
+
+// updateStateWithKeyRotationDelay updates the consensus state timestamp to the header timestamp and either
+// schedules the key rotation proposed by the header or, if the header proposes the current public key,
+// applies the new diversifier and cancels any pending key rotation.
+func (cs *ClientState) updateStateWithKeyRotationDelay(ctx context.Context, smHeader *Header) {
+	currentPublicKey, err := cs.ConsensusState.GetPubKey()
+	if err != nil {
+		panic(err)
+	}
+
+	newPublicKey, err := smHeader.GetPubKey()
+	if err != nil {
+		panic(err)
+	}
+
+	cs.ConsensusState = &ConsensusState{
+		PublicKey:   cs.ConsensusState.PublicKey,
+		Diversifier: cs.ConsensusState.Diversifier,
+		Timestamp:   smHeader.Timestamp,
+	}
+
+	if newPublicKey.Equals(currentPublicKey) {
+		cs.ConsensusState.Diversifier = smHeader.NewDiversifier
+		cs.PendingKeyRotation = nil
+		return
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx) // TODO: https://github.com/cosmos/ibc-go/issues/5917
+	effectiveTime := uint64(sdkCtx.BlockTime().Add(time.Duration(cs.KeyRotationDelay) * time.Second).UnixNano())
+	cs.PendingKeyRotation = NewKeyRotation(smHeader.NewPublicKey, smHeader.NewDiversifier, effectiveTime)
+}
@@ -9,11 +9,15 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 
 	clienttypes "github.com/cosmos/ibc-go/v9/modules/core/02-client/types"
+	host "github.com/cosmos/ibc-go/v9/modules/core/24-host"
 	ibcerrors "github.com/cosmos/ibc-go/v9/modules/core/errors"
 	"github.com/cosmos/ibc-go/v9/modules/core/exported"
 )
 
-var _ exported.LightClientModule = (*LightClientModule)(nil)
+var (
+	_ exported.LightClientModule        = (*LightClientModule)(nil)
+	_ exported.ChainIDLightClientModule = (*LightClientModule)(nil)
+)
 
 // LightClientModule implements the core IBC api.LightClientModule interface.
 type LightClientModule struct {
@@ -176,18 +180,22 @@ func (l LightClientModule) TimestampAtHeight(
 	return clientState.getTimestampAtHeight(clientStore, l.cdc, height)
 }
 
-// RecoverClient asserts that the substitute client is a tendermint client. It obtains the client state associated with the
-// subject client and calls into the subjectClientState.CheckSubstituteAndUpdateState method.
-func (l LightClientModule) RecoverClient(ctx context.Context, clientID, substituteClientID string) error {
-	substituteClientType, _, err := clienttypes.ParseClientIdentifier(substituteClientID)
-	if err != nil {
-		return err
+// ChainID returns the chain identifier stored in the client state for the given client identifier.
+func (l LightClientModule) ChainID(ctx context.Context, clientID string) (string, error) {
+	clientStore := l.storeProvider.ClientStore(ctx, clientID)
+	clientState, found := getClientState(clientStore, l.cdc)
+	if !found {
+		return "", errorsmod.Wrap(clienttypes.ErrClientNotFound, clientID)
 	}
 
-	if substituteClientType != exported.Tendermint {
-		return errorsmod.Wrapf(clienttypes.ErrInvalidClientType, "expected: %s, got: %s", exported.Tendermint, substituteClientType)
-	}
+	return clientState.ChainId, nil
+}
 
+// RecoverClient asserts that the substitute client is a tendermint client. It obtains the client state associated with the
+// subject client and calls into the subjectClientState.CheckSubstituteAndUpdateState method.
+// The client type of the substitute is asserted from its stored client state rather than parsed from its identifier,
+// as the substitute client may have been migrated from a different client type.
+func (l LightClientModule) RecoverClient(ctx context.Context, clientID, substituteClientID string) error {
 	clientStore := l.storeProvider.ClientStore(ctx, clientID)
 	clientState, found := getClientState(clientStore, l.cdc)
 	if !found {
@@ -195,11 +203,17 @@ func (l LightClientModule) RecoverClient(ctx context.Context, clientID, substitu
 	}
 
 	substituteClientStore := l.storeProvider.ClientStore(ctx, substituteClientID)
-	substituteClient, found := getClientState(substituteClientStore, l.cdc)
-	if !found {
+	bz := substituteClientStore.Get(host.ClientStateKey())
+	if len(bz) == 0 {
 		return errorsmod.Wrap(clienttypes.ErrClientNotFound, substituteClientID)
 	}
 
+	substituteClientState := clienttypes.MustUnmarshalClientState(l.cdc, bz)
+	substituteClient, ok := substituteClientState.(*ClientState)
+	if !ok {
+		return errorsmod.Wrapf(clienttypes.ErrInvalidClientType, "expected: %s, got: %s", exported.Tendermint, substituteClientState.ClientType())
+	}
+
 	return clientState.CheckSubstituteAndUpdateState(ctx, l.cdc, clientStore, substituteClientStore, substituteClient)
 }
 
@@ -871,6 +871,58 @@ func (suite *TendermintTestSuite) TestLatestHeight() {
 	}
 }
 
+func (suite *TendermintTestSuite) TestChainID() {
+	var path *ibctesting.Path
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"failure: client state not found",
+			func() {
+				store := suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(suite.chainA.GetContext(), path.EndpointA.ClientID)
+				store.Delete(host.ClientStateKey())
+			},
+			clienttypes.ErrClientNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.SetupClients()
+
+			lightClientModule, err := suite.chainA.App.GetIBCKeeper().ClientKeeper.Route(suite.chainA.GetContext(), path.EndpointA.ClientID)
+			suite.Require().NoError(err)
+
+			tc.malleate()
+
+			chainIDModule, ok := lightClientModule.(exported.ChainIDLightClientModule)
+			suite.Require().True(ok)
+
+			chainID, err := chainIDModule.ChainID(suite.chainA.GetContext(), path.EndpointA.ClientID)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().Equal(suite.chainB.ChainID, chainID)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().Empty(chainID)
+			}
+		})
+	}
+}
+
 func (suite *TendermintTestSuite) TestGetTimestampAtHeight() {
 	var (
 		path   *ibctesting.Path
@@ -965,16 +1017,38 @@ func (suite *TendermintTestSuite) TestRecoverClient() {
 			nil,
 		},
 		{
-			"cannot parse malformed substitute client ID",
+			"success, substitute client ID does not contain 07-tendermint prefix",
+			func() {
+				// store the substitute tendermint client under a client identifier of a different client type,
+				// as is the case for a client that has been migrated to the 07-tendermint client type
+				clientKeeper := suite.chainA.App.GetIBCKeeper().ClientKeeper
+				tmClientState, ok := suite.chainA.GetClientState(substituteClientID).(*ibctm.ClientState)
+				suite.Require().True(ok)
+				consensusState, found := clientKeeper.GetClientConsensusState(suite.chainA.GetContext(), substituteClientID, tmClientState.LatestHeight)
+				suite.Require().True(found)
+
+				substituteClientID = clienttypes.FormatClientIdentifier(exported.Solomachine, 0)
+				clientKeeper.SetClientState(suite.chainA.GetContext(), substituteClientID, tmClientState)
+				clientKeeper.SetClientConsensusState(suite.chainA.GetContext(), substituteClientID, tmClientState.LatestHeight, consensusState)
+				clientStore := clientKeeper.ClientStore(suite.chainA.GetContext(), substituteClientID)
+				ibctm.SetProcessedTime(clientStore, tmClientState.LatestHeight, 100)
+				ibctm.SetProcessedHeight(clientStore, tmClientState.LatestHeight, clienttypes.NewHeight(0, 1))
+			},
+			nil,
+		},
+		{
+			"substitute client ID is malformed",
 			func() {
 				substituteClientID = ibctesting.InvalidID
 			},
-			host.ErrInvalidID,
+			clienttypes.ErrClientNotFound,
 		},
 		{
-			"substitute client ID does not contain 07-tendermint prefix",
+			"substitute client is not a tendermint client",
 			func() {
-				substituteClientID = solomachineClientID
+				// the substitute client ID contains the 07-tendermint prefix, but its stored client state is of a different type
+				solomachineClientState := ibctesting.NewSolomachine(suite.T(), suite.chainA.Codec, "solomachine", "", 1).ClientState()
+				suite.chainA.App.GetIBCKeeper().ClientKeeper.SetClientState(suite.chainA.GetContext(), substituteClientID, solomachineClientState)
 			},
 			clienttypes.ErrInvalidClientType,
 		},
@@ -19,6 +19,11 @@ func GetQueryCmd() *cobra.Command {
 	queryCmd.AddCommand(
 		getCmdCode(),
 		getCmdChecksums(),
+		getCmdChecksumParams(),
+		getCmdClientStoreSize(),
+		getCmdApprovedChecksums(),
+		getCmdSimulateMigrateContract(),
+		getCmdPinnedCodes(),
 	)
 
 	return queryCmd
@@ -36,7 +41,12 @@ func NewTxCmd() *cobra.Command {
 
 	txCmd.AddCommand(
 		newSubmitStoreCodeProposalCmd(),
+		newSubmitApproveChecksumProposalCmd(),
+		newUploadCodeCmd(),
 		newMigrateContractCmd(),
+		newRollbackContractCmd(),
+		newSubmitPinCodesProposalCmd(),
+		newSubmitUnpinCodesProposalCmd(),
 	)
 
 	return txCmd
@@ -78,3 +78,182 @@ func getCmdChecksums() *cobra.Command {
 
 	return cmd
 }
+
+// getCmdChecksumParams defines the command to query the client store limits for given checksum.
+func getCmdChecksumParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "checksum-params [checksum]",
+		Short:   "Query checksum params",
+		Long:    "Query the client store limits applied to light client wasm contracts with a given checksum",
+		Example: fmt.Sprintf("%s query %s wasm checksum-params [checksum]", version.AppName, ibcexported.ModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := types.QueryChecksumParamsRequest{
+				Checksum: args[0],
+			}
+
+			res, err := queryClient.ChecksumParams(context.Background(), &req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// getCmdClientStoreSize defines the command to query the client store size for given client identifier.
+func getCmdClientStoreSize() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "client-store-size [client-id]",
+		Short:   "Query client store size",
+		Long:    "Query the size in bytes of the client store of a wasm light client with a given client identifier",
+		Example: fmt.Sprintf("%s query %s wasm client-store-size 08-wasm-0", version.AppName, ibcexported.ModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := types.QueryClientStoreSizeRequest{
+				ClientId: args[0],
+			}
+
+			res, err := queryClient.ClientStoreSize(context.Background(), &req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// getCmdApprovedChecksums defines the command to query the checksums approved for upload.
+func getCmdApprovedChecksums() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "approved-checksums",
+		Short:   "Query all approved checksums",
+		Long:    "Query all checksums approved for upload whose light client wasm contracts have not been stored yet",
+		Example: fmt.Sprintf("%s query %s wasm approved-checksums", version.AppName, ibcexported.ModuleName),
+		Args:    cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := types.QueryApprovedChecksumsRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.ApprovedChecksums(context.Background(), &req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "all approved checksums")
+
+	return cmd
+}
+
+// getCmdSimulateMigrateContract defines the command to simulate the migration of the contract of a light client.
+func getCmdSimulateMigrateContract() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "simulate-migrate-contract [client-id] [checksum] [migrate-msg]",
+		Short:   "Simulate a contract migration",
+		Long:    "Simulate the migration of the contract for the specified client ID to the byte code corresponding to checksum, and print the resulting client state and status",
+		Example: fmt.Sprintf("%s query %s wasm simulate-migrate-contract 08-wasm-0 b3a49b2914f5e6a673215e74325c1d153bb6776e079774e52c5b7e674d9ad3ab {}", version.AppName, ibcexported.ModuleName),
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := types.QuerySimulateMigrateContractRequest{
+				ClientId: args[0],
+				Checksum: args[1],
+				Msg:      []byte(args[2]),
+			}
+
+			res, err := queryClient.SimulateMigrateContract(context.Background(), &req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// getCmdPinnedCodes defines the command to query the codes pinned to the vm in-memory cache.
+func getCmdPinnedCodes() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "pinned-codes",
+		Short:   "Query all pinned codes",
+		Long:    "Query all codes pinned to the vm in-memory cache together with the cache metrics of the queried node",
+		Example: fmt.Sprintf("%s query %s wasm pinned-codes", version.AppName, ibcexported.ModuleName),
+		Args:    cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := types.QueryPinnedCodesRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.PinnedCodes(context.Background(), &req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "all pinned codes")
+
+	return cmd
+}
@@ -20,7 +20,10 @@ import (
 	ibcexported "github.com/cosmos/ibc-go/v9/modules/core/exported"
 )
 
-const FlagAuthority = "authority"
+const (
+	FlagAuthority = "authority"
+	FlagUploader  = "uploader"
+)
 
 // newSubmitStoreCodeProposalCmd returns the command to send a proposal to store new wasm bytecode.
 func newSubmitStoreCodeProposalCmd() *cobra.Command {
@@ -84,6 +87,99 @@ func newSubmitStoreCodeProposalCmd() *cobra.Command {
 	return cmd
 }
 
+// newSubmitApproveChecksumProposalCmd returns the command to send a proposal to approve the upload of wasm bytecode with a given checksum.
+func newSubmitApproveChecksumProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "approve-checksum [checksum]",
+		Short:   "Creates a proposal to approve the upload of the wasm code with the given checksum",
+		Long:    "Creates a proposal to approve the upload of the wasm code with the given checksum. Once approved, the wasm code can be stored with upload-code by the address set with --uploader, or by anyone if no uploader is set",
+		Example: fmt.Sprintf("%s tx %s-wasm approve-checksum b3a49b2914f5e6a673215e74325c1d153bb6776e079774e52c5b7e674d9ad3ab --uploader [address]", version.AppName, ibcexported.ModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposal, err := govcli.ReadGovPropFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			authority, _ := cmd.Flags().GetString(FlagAuthority)
+			if authority != "" {
+				if _, err = sdk.AccAddressFromBech32(authority); err != nil {
+					return fmt.Errorf("invalid authority address: %w", err)
+				}
+			} else {
+				authority = sdk.AccAddress(address.Module(govtypes.ModuleName)).String()
+			}
+
+			checksum, err := hex.DecodeString(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid checksum format: %w", err)
+			}
+
+			uploader, _ := cmd.Flags().GetString(FlagUploader)
+
+			msg := types.NewMsgApproveChecksum(authority, checksum, uploader)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			if err := proposal.SetMsgs([]sdk.Msg{msg}); err != nil {
+				return fmt.Errorf("failed to create an approve checksum proposal message: %w", err)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), proposal)
+		},
+	}
+
+	cmd.Flags().String(FlagAuthority, "", "The address of the wasm client module authority (defaults to gov)")
+	cmd.Flags().String(FlagUploader, "", "The only address allowed to upload the wasm code (defaults to anyone)")
+
+	flags.AddTxFlagsToCmd(cmd)
+	govcli.AddGovPropFlagsToCmd(cmd)
+	err := cmd.MarkFlagRequired(govcli.FlagTitle)
+	if err != nil {
+		panic(err)
+	}
+
+	return cmd
+}
+
+// newUploadCodeCmd returns the command to store wasm bytecode whose checksum has been approved by the authority.
+func newUploadCodeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "upload-code [path/to/wasm-file]",
+		Short:   "Reads wasm code from the file and stores it",
+		Long:    "Reads wasm code from the file and stores it. The checksum of the wasm code must have been approved with an approve-checksum proposal",
+		Example: fmt.Sprintf("%s tx %s-wasm upload-code [path/to/wasm_file]", version.AppName, ibcexported.ModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			code, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgStoreCode(clientCtx.GetFromAddress().String(), code)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 func newMigrateContractCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "migrate-contract [client-id] [checksum] [migrate-msg]",
@@ -126,3 +222,151 @@ func newMigrateContractCmd() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+func newRollbackContractCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "rollback-contract [client-id]",
+		Short:   "Rolls back the last migration of a contract",
+		Long:    "Restores the checksum and client store of the contract for the specified client ID to the snapshot taken at its last migration",
+		Example: fmt.Sprintf("%s tx %s-wasm rollback-contract 08-wasm-0", version.AppName, ibcexported.ModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRollbackContract(clientCtx.GetFromAddress().String(), args[0])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// newSubmitPinCodesProposalCmd returns the command to send a proposal to pin wasm codes to the vm in-memory cache.
+func newSubmitPinCodesProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "pin-codes [checksum]...",
+		Short:   "Creates a proposal to pin the wasm codes with the given checksums to the vm in-memory cache",
+		Long:    "Creates a proposal to pin the wasm codes with the given checksums to the vm in-memory cache",
+		Example: fmt.Sprintf("%s tx %s-wasm pin-codes b3a49b2914f5e6a673215e74325c1d153bb6776e079774e52c5b7e674d9ad3ab", version.AppName, ibcexported.ModuleName),
+		Args:    cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposal, err := govcli.ReadGovPropFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			authority, _ := cmd.Flags().GetString(FlagAuthority)
+			if authority != "" {
+				if _, err = sdk.AccAddressFromBech32(authority); err != nil {
+					return fmt.Errorf("invalid authority address: %w", err)
+				}
+			} else {
+				authority = sdk.AccAddress(address.Module(govtypes.ModuleName)).String()
+			}
+
+			checksums := make([][]byte, len(args))
+			for i, arg := range args {
+				checksums[i], err = hex.DecodeString(arg)
+				if err != nil {
+					return fmt.Errorf("invalid checksum format: %w", err)
+				}
+			}
+
+			msg := types.NewMsgPinCodes(authority, checksums)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			if err := proposal.SetMsgs([]sdk.Msg{msg}); err != nil {
+				return fmt.Errorf("failed to create a pin-codes proposal message: %w", err)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), proposal)
+		},
+	}
+
+	cmd.Flags().String(FlagAuthority, "", "The address of the wasm client module authority (defaults to gov)")
+
+	flags.AddTxFlagsToCmd(cmd)
+	govcli.AddGovPropFlagsToCmd(cmd)
+	err := cmd.MarkFlagRequired(govcli.FlagTitle)
+	if err != nil {
+		panic(err)
+	}
+
+	return cmd
+}
+
+// newSubmitUnpinCodesProposalCmd returns the command to send a proposal to unpin wasm codes from the vm in-memory cache.
+func newSubmitUnpinCodesProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "unpin-codes [checksum]...",
+		Short:   "Creates a proposal to unpin the wasm codes with the given checksums from the vm in-memory cache",
+		Long:    "Creates a proposal to unpin the wasm codes with the given checksums from the vm in-memory cache",
+		Example: fmt.Sprintf("%s tx %s-wasm unpin-codes b3a49b2914f5e6a673215e74325c1d153bb6776e079774e52c5b7e674d9ad3ab", version.AppName, ibcexported.ModuleName),
+		Args:    cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposal, err := govcli.ReadGovPropFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			authority, _ := cmd.Flags().GetString(FlagAuthority)
+			if authority != "" {
+				if _, err = sdk.AccAddressFromBech32(authority); err != nil {
+					return fmt.Errorf("invalid authority address: %w", err)
+				}
+			} else {
+				authority = sdk.AccAddress(address.Module(govtypes.ModuleName)).String()
+			}
+
+			checksums := make([][]byte, len(args))
+			for i, arg := range args {
+				checksums[i], err = hex.DecodeString(arg)
+				if err != nil {
+					return fmt.Errorf("invalid checksum format: %w", err)
+				}
+			}
+
+			msg := types.NewMsgUnpinCodes(authority, checksums)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			if err := proposal.SetMsgs([]sdk.Msg{msg}); err != nil {
+				return fmt.Errorf("failed to create a unpin-codes proposal message: %w", err)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), proposal)
+		},
+	}
+
+	cmd.Flags().String(FlagAuthority, "", "The address of the wasm client module authority (defaults to gov)")
+
+	flags.AddTxFlagsToCmd(cmd)
+	govcli.AddGovPropFlagsToCmd(cmd)
+	err := cmd.MarkFlagRequired(govcli.FlagTitle)
+	if err != nil {
+		panic(err)
+	}
+
+	return cmd
+}
@@ -0,0 +1,35 @@
+package telemetry
+
+import (
+	"encoding/hex"
+
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+	"github.com/hashicorp/go-metrics"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+
+	"github.com/cosmos/ibc-go/modules/light-clients/08-wasm/types"
+)
+
+const labelChecksum = "checksum"
+
+// ReportCacheMetrics sets the gauges for the cache metrics of the wasm VM and the per code metrics of the pinned codes.
+func ReportCacheMetrics(cacheMetrics *wasmvmtypes.Metrics, pinnedMetrics *wasmvmtypes.PinnedMetrics) {
+	keys := []string{"ibc", types.ModuleName, "cache"}
+
+	telemetry.SetGauge(float32(cacheMetrics.HitsPinnedMemoryCache), append(keys, "hits_pinned_memory_cache")...)
+	telemetry.SetGauge(float32(cacheMetrics.HitsMemoryCache), append(keys, "hits_memory_cache")...)
+	telemetry.SetGauge(float32(cacheMetrics.HitsFsCache), append(keys, "hits_fs_cache")...)
+	telemetry.SetGauge(float32(cacheMetrics.Misses), append(keys, "misses")...)
+	telemetry.SetGauge(float32(cacheMetrics.ElementsPinnedMemoryCache), append(keys, "elements_pinned_memory_cache")...)
+	telemetry.SetGauge(float32(cacheMetrics.ElementsMemoryCache), append(keys, "elements_memory_cache")...)
+	telemetry.SetGauge(float32(cacheMetrics.SizePinnedMemoryCache), append(keys, "size_pinned_memory_cache")...)
+	telemetry.SetGauge(float32(cacheMetrics.SizeMemoryCache), append(keys, "size_memory_cache")...)
+
+	for _, entry := range pinnedMetrics.PerModule {
+		labels := []metrics.Label{telemetry.NewLabel(labelChecksum, hex.EncodeToString(entry.Checksum))}
+
+		telemetry.SetGaugeWithLabels(append(keys, "pinned", "hits"), float32(entry.Metrics.Hits), labels)
+		telemetry.SetGaugeWithLabels(append(keys, "pinned", "size"), float32(entry.Metrics.Size), labels)
+	}
+}
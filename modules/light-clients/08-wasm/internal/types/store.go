@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"errors"
 	"io"
+	"math"
 
 	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
 
@@ -15,6 +16,7 @@ import (
 var (
 	_ wasmvmtypes.KVStore = &StoreAdapter{}
 	_ storetypes.KVStore  = &ClientRecoveryStore{}
+	_ storetypes.KVStore  = &SizeTrackingStore{}
 
 	SubjectPrefix    = []byte("subject/")
 	SubstitutePrefix = []byte("substitute/")
@@ -188,6 +190,77 @@ func SplitPrefix(key []byte) ([]byte, []byte) {
 	return nil, key
 }
 
+// SizeTrackingStore wraps a client store and tracks the change in size of the store caused by writes
+// and deletions, where the size of an entry is the sum of the lengths of its key and value.
+//
+// For every write, gas is consumed proportionally to the number of bytes written, in addition to
+// the gas consumed by the wrapped store.
+type SizeTrackingStore struct {
+	storetypes.KVStore
+
+	gasMeter   storetypes.GasMeter
+	gasPerByte uint64
+	sizeDelta  int64
+}
+
+// NewSizeTrackingStore returns a new instance of a SizeTrackingStore
+func NewSizeTrackingStore(store storetypes.KVStore, gasMeter storetypes.GasMeter, gasPerByte uint64) *SizeTrackingStore {
+	if store == nil {
+		panic(errors.New("store must not be nil"))
+	}
+	if gasMeter == nil {
+		panic(errors.New("gas meter must not be nil"))
+	}
+
+	return &SizeTrackingStore{
+		KVStore:    store,
+		gasMeter:   gasMeter,
+		gasPerByte: gasPerByte,
+	}
+}
+
+// Set implements the storetypes.KVStore interface. It consumes gas for the bytes written and
+// tracks the change in size of the entry stored under the given key.
+func (s *SizeTrackingStore) Set(key, value []byte) {
+	entrySize := len(key) + len(value)
+	s.consumeWriteGas(uint64(entrySize))
+
+	if prev := s.KVStore.Get(key); prev != nil {
+		s.sizeDelta -= int64(len(key) + len(prev))
+	}
+	s.sizeDelta += int64(entrySize)
+
+	s.KVStore.Set(key, value)
+}
+
+// Delete implements the storetypes.KVStore interface. It tracks the size of the deleted entry.
+func (s *SizeTrackingStore) Delete(key []byte) {
+	if prev := s.KVStore.Get(key); prev != nil {
+		s.sizeDelta -= int64(len(key) + len(prev))
+	}
+
+	s.KVStore.Delete(key)
+}
+
+// SizeDelta returns the change in size of the store caused by the writes and deletions made.
+func (s *SizeTrackingStore) SizeDelta() int64 {
+	return s.sizeDelta
+}
+
+// consumeWriteGas consumes the per byte write gas for the given number of bytes.
+func (s *SizeTrackingStore) consumeWriteGas(numBytes uint64) {
+	if s.gasPerByte == 0 || numBytes == 0 {
+		return
+	}
+
+	gas := uint64(math.MaxUint64)
+	if numBytes <= math.MaxUint64/s.gasPerByte {
+		gas = numBytes * s.gasPerByte
+	}
+
+	s.gasMeter.ConsumeGas(gas, "wasm client store write per byte")
+}
+
 // StoreAdapter bridges the SDK types implementation to wasmvm one. It implements the wasmvmtypes.KVStore interface.
 type StoreAdapter struct {
 	parent storetypes.KVStore
@@ -431,6 +431,92 @@ func (suite *TypesTestSuite) TestNewClientRecoveryStore() {
 	}
 }
 
+// TestSizeTrackingStore tests the size tracking and gas consumption of the SizeTrackingStore.
+func (suite *TypesTestSuite) TestSizeTrackingStore() {
+	const gasPerByte = 10
+
+	var (
+		key   = []byte("key")
+		value = []byte("value")
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func(store *internaltypes.SizeTrackingStore)
+		expDelta int64
+		expGas   uint64
+	}{
+		{
+			"set new entry",
+			func(store *internaltypes.SizeTrackingStore) {
+				store.Set(key, value)
+			},
+			int64(len(key) + len(value)),
+			uint64(len(key)+len(value)) * gasPerByte,
+		},
+		{
+			"overwrite existing entry with larger value",
+			func(store *internaltypes.SizeTrackingStore) {
+				store.Set(key, value)
+				store.Set(key, append(value, value...))
+			},
+			int64(len(key) + 2*len(value)),
+			uint64(2*len(key)+3*len(value)) * gasPerByte,
+		},
+		{
+			"overwrite existing entry with smaller value",
+			func(store *internaltypes.SizeTrackingStore) {
+				store.Set(key, value)
+				store.Set(key, value[:1])
+			},
+			int64(len(key) + 1),
+			uint64(2*len(key)+len(value)+1) * gasPerByte,
+		},
+		{
+			"delete existing entry",
+			func(store *internaltypes.SizeTrackingStore) {
+				store.Set(key, value)
+				store.Delete(key)
+			},
+			0,
+			uint64(len(key)+len(value)) * gasPerByte,
+		},
+		{
+			"delete missing entry",
+			func(store *internaltypes.SizeTrackingStore) {
+				store.Delete(key)
+			},
+			0,
+			0,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			subjectStore, _ := suite.GetSubjectAndSubstituteStore()
+			gasMeter := storetypes.NewInfiniteGasMeter()
+			store := internaltypes.NewSizeTrackingStore(subjectStore, gasMeter, gasPerByte)
+
+			tc.malleate(store)
+
+			suite.Require().Equal(tc.expDelta, store.SizeDelta())
+			suite.Require().Equal(tc.expGas, gasMeter.GasConsumed())
+		})
+	}
+}
+
+// TestSizeTrackingStoreExistingEntries tests that the size of entries written before wrapping the store is accounted for.
+func (suite *TypesTestSuite) TestSizeTrackingStoreExistingEntries() {
+	subjectStore, _ := suite.GetSubjectAndSubstituteStore()
+	subjectStore.Set([]byte("key"), []byte("value"))
+
+	store := internaltypes.NewSizeTrackingStore(subjectStore, storetypes.NewInfiniteGasMeter(), 0)
+	store.Delete([]byte("key"))
+
+	suite.Require().Equal(int64(-len("keyvalue")), store.SizeDelta())
+}
+
 // GetSubjectAndSubstituteStore returns two KVStores for testing the migrate client wrapping types.
 func (suite *TypesTestSuite) GetSubjectAndSubstituteStore() (storetypes.KVStore, storetypes.KVStore) {
 	suite.SetupTest()
@@ -104,7 +104,12 @@ func (k Keeper) WasmInstantiate(ctx sdk.Context, clientID string, clientStore st
 	}
 
 	checksum := cs.Checksum
-	res, err := k.instantiateContract(ctx, clientID, clientStore, checksum, encodedData)
+	trackedStore, err := k.trackClientStore(ctx, clientID, clientStore, checksum)
+	if err != nil {
+		return err
+	}
+
+	res, err := k.instantiateContract(ctx, clientID, trackedStore, checksum, encodedData)
 	if err != nil {
 		return errorsmod.Wrap(types.ErrVMError, err.Error())
 	}
@@ -116,6 +121,10 @@ func (k Keeper) WasmInstantiate(ctx sdk.Context, clientID string, clientStore st
 		return errorsmod.Wrapf(err, "checksum (%s)", hex.EncodeToString(cs.Checksum))
 	}
 
+	if err = k.updateClientStoreSize(ctx, clientID, trackedStore, checksum); err != nil {
+		return err
+	}
+
 	newClientState, err := validatePostExecutionClientState(clientStore, k.Codec())
 	if err != nil {
 		return err
@@ -143,7 +152,12 @@ func (k Keeper) WasmSudo(ctx sdk.Context, clientID string, clientStore storetype
 	}
 
 	checksum := cs.Checksum
-	res, err := k.callContract(ctx, clientID, clientStore, checksum, encodedData)
+	trackedStore, err := k.trackClientStore(ctx, clientID, clientStore, checksum)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := k.callContract(ctx, clientID, trackedStore, checksum, encodedData)
 	if err != nil {
 		return nil, errorsmod.Wrap(types.ErrVMError, err.Error())
 	}
@@ -155,6 +169,10 @@ func (k Keeper) WasmSudo(ctx sdk.Context, clientID string, clientStore storetype
 		return nil, errorsmod.Wrapf(err, "checksum (%s)", hex.EncodeToString(cs.Checksum))
 	}
 
+	if err = k.updateClientStoreSize(ctx, clientID, trackedStore, checksum); err != nil {
+		return nil, err
+	}
+
 	newClientState, err := validatePostExecutionClientState(clientStore, k.Codec())
 	if err != nil {
 		return nil, err
@@ -172,7 +190,12 @@ func (k Keeper) WasmSudo(ctx sdk.Context, clientID string, clientStore storetype
 // WasmMigrate returns an error if:
 // - the contract migration returns an error
 func (k Keeper) WasmMigrate(ctx sdk.Context, clientStore storetypes.KVStore, cs *types.ClientState, clientID string, payload []byte) error {
-	res, err := k.migrateContract(ctx, clientID, clientStore, cs.Checksum, payload)
+	trackedStore, err := k.trackClientStore(ctx, clientID, clientStore, cs.Checksum)
+	if err != nil {
+		return err
+	}
+
+	res, err := k.migrateContract(ctx, clientID, trackedStore, cs.Checksum, payload)
 	if err != nil {
 		return errorsmod.Wrap(types.ErrVMError, err.Error())
 	}
@@ -184,6 +207,10 @@ func (k Keeper) WasmMigrate(ctx sdk.Context, clientStore storetypes.KVStore, cs
 		return errorsmod.Wrapf(err, "checksum (%s)", hex.EncodeToString(cs.Checksum))
 	}
 
+	if err = k.updateClientStoreSize(ctx, clientID, trackedStore, cs.Checksum); err != nil {
+		return err
+	}
+
 	_, err = validatePostExecutionClientState(clientStore, k.cdc)
 	return err
 }
@@ -209,6 +236,74 @@ func (k Keeper) WasmQuery(ctx sdk.Context, clientID string, clientStore storetyp
 	return res.Ok, nil
 }
 
+// trackClientStore wraps the client store in a store which tracks the change in size caused by the contract
+// execution and consumes the per byte write gas set for the checksum. The size of the client store is computed
+// and stored if it has not been tracked yet. Client recovery stores are returned as is and are not tracked.
+func (k Keeper) trackClientStore(ctx sdk.Context, clientID string, clientStore storetypes.KVStore, checksum types.Checksum) (storetypes.KVStore, error) {
+	if _, ok := clientStore.(internaltypes.ClientRecoveryStore); ok {
+		return clientStore, nil
+	}
+
+	found, err := k.clientStoreSizes.Has(ctx, clientID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !found {
+		if err := k.clientStoreSizes.Set(ctx, clientID, computeStoreSize(clientStore)); err != nil {
+			return nil, err
+		}
+	}
+
+	params := k.GetChecksumParams(ctx, checksum)
+	return internaltypes.NewSizeTrackingStore(clientStore, ctx.GasMeter(), params.StoreWriteGasPerByte), nil
+}
+
+// updateClientStoreSize applies the change in client store size tracked during the contract execution.
+// An error is returned if the client store has grown beyond the maximum client store size set for the checksum.
+// Contract executions which shrink the client store are always allowed.
+func (k Keeper) updateClientStoreSize(ctx sdk.Context, clientID string, clientStore storetypes.KVStore, checksum types.Checksum) error {
+	trackedStore, ok := clientStore.(*internaltypes.SizeTrackingStore)
+	if !ok {
+		return nil
+	}
+
+	size, err := k.clientStoreSizes.Get(ctx, clientID)
+	if err != nil {
+		return err
+	}
+
+	delta := trackedStore.SizeDelta()
+	switch {
+	case delta > 0:
+		size += uint64(delta)
+	case uint64(-delta) > size:
+		size = 0
+	default:
+		size -= uint64(-delta)
+	}
+
+	params := k.GetChecksumParams(ctx, checksum)
+	if delta > 0 && params.IsClientStoreSizeLimited() && size > params.MaxClientStoreSize {
+		return errorsmod.Wrapf(types.ErrClientStoreSizeExceeded, "client (%s) store size %d exceeds maximum %d for checksum (%s)", clientID, size, params.MaxClientStoreSize, hex.EncodeToString(checksum))
+	}
+
+	return k.clientStoreSizes.Set(ctx, clientID, size)
+}
+
+// setClientState stores the wasm client state in the client store of the given client, tracking the change in
+// client store size and consuming the per byte write gas set for the checksum of the client state.
+func (k Keeper) setClientState(ctx sdk.Context, clientID string, clientState *types.ClientState) error {
+	trackedStore, err := k.trackClientStore(ctx, clientID, k.clientKeeper.ClientStore(ctx, clientID), clientState.Checksum)
+	if err != nil {
+		return err
+	}
+
+	trackedStore.Set(host.ClientStateKey(), clienttypes.MustMarshalClientState(k.cdc, clientState))
+
+	return k.updateClientStoreSize(ctx, clientID, trackedStore, clientState.Checksum)
+}
+
 // validatePostExecutionClientState validates that the contract has not many any invalid modifications
 // to the client state during execution. It ensures that
 // - the client state is still present
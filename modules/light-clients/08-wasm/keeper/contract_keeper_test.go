@@ -1,11 +1,14 @@
 package keeper_test
 
 import (
+	"bytes"
 	"encoding/json"
 
 	wasmvm "github.com/CosmWasm/wasmvm/v2"
 	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
 
+	storetypes "cosmossdk.io/store/types"
+
 	wasmtesting "github.com/cosmos/ibc-go/modules/light-clients/08-wasm/testing"
 	"github.com/cosmos/ibc-go/modules/light-clients/08-wasm/types"
 	clienttypes "github.com/cosmos/ibc-go/v9/modules/core/02-client/types"
@@ -562,3 +565,139 @@ func (suite *KeeperTestSuite) TestWasmSudo() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestWasmSudoClientStoreSize() {
+	var (
+		checksumParams types.ChecksumParams
+		sudoFn         func(store wasmvm.KVStore)
+		latestHeight   exported.Height
+	)
+
+	dataKey := []byte("data")
+	data := bytes.Repeat([]byte{0x1}, 100)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success: no limits set",
+			func() {},
+			nil,
+		},
+		{
+			"success: client store size is below maximum",
+			func() {
+				checksumParams.MaxClientStoreSize = 100_000
+			},
+			nil,
+		},
+		{
+			"success: per byte write gas is set",
+			func() {
+				checksumParams.StoreWriteGasPerByte = 1000
+			},
+			nil,
+		},
+		{
+			"success: client store size exceeds maximum but does not grow",
+			func() {
+				checksumParams.MaxClientStoreSize = 1
+
+				sudoFn = func(store wasmvm.KVStore) {
+					store.Set(dataKey, data)
+					store.Delete(dataKey)
+				}
+			},
+			nil,
+		},
+		{
+			"success: client store size exceeds maximum but shrinks",
+			func() {
+				checksumParams.MaxClientStoreSize = 1
+
+				sudoFn = func(store wasmvm.KVStore) {
+					store.Delete(host.ConsensusStateKey(latestHeight))
+				}
+			},
+			nil,
+		},
+		{
+			"failure: client store size exceeds maximum",
+			func() {
+				checksumParams.MaxClientStoreSize = 1
+			},
+			types.ErrClientStoreSizeExceeded,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupWasmWithMockVM()
+			checksum := suite.storeWasmCode(wasmtesting.Code)
+
+			endpoint := wasmtesting.NewWasmEndpoint(suite.chainA)
+			err := endpoint.CreateClient()
+			suite.Require().NoError(err)
+
+			wasmClientState, ok := endpoint.GetClientState().(*types.ClientState)
+			suite.Require().True(ok)
+			latestHeight = wasmClientState.LatestHeight
+
+			checksumParams = types.NewChecksumParams(checksum, 0, 0)
+			sudoFn = func(store wasmvm.KVStore) {
+				store.Set(dataKey, data)
+			}
+
+			tc.malleate()
+
+			wasmClientKeeper := GetSimApp(suite.chainA).WasmClientKeeper
+			err = wasmClientKeeper.SetChecksumParams(suite.chainA.GetContext(), checksumParams)
+			suite.Require().NoError(err)
+
+			suite.mockVM.RegisterSudoCallback(types.UpdateStateMsg{}, func(_ wasmvm.Checksum, _ wasmvmtypes.Env, _ []byte, store wasmvm.KVStore, _ wasmvm.GoAPI, _ wasmvm.Querier, _ wasmvm.GasMeter, _ uint64, _ wasmvmtypes.UFraction) (*wasmvmtypes.ContractResult, uint64, error) {
+				sudoFn(store)
+
+				resp, err := json.Marshal(types.UpdateStateResult{})
+				suite.Require().NoError(err)
+
+				return &wasmvmtypes.ContractResult{Ok: &wasmvmtypes.Response{Data: resp}}, wasmtesting.DefaultGasUsed, nil
+			})
+
+			ctx := suite.chainA.GetContext()
+			clientStore := suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(ctx, endpoint.ClientID)
+
+			gasBefore := ctx.GasMeter().GasConsumed()
+			_, err = wasmClientKeeper.WasmSudo(ctx, endpoint.ClientID, clientStore, wasmClientState, types.SudoMsg{UpdateState: &types.UpdateStateMsg{}})
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+
+				// the tracked client store size must equal the actual size of the client store
+				size, err := wasmClientKeeper.GetClientStoreSize(ctx, endpoint.ClientID)
+				suite.Require().NoError(err)
+				suite.Require().Equal(storeSize(clientStore), size)
+
+				minGas := checksumParams.StoreWriteGasPerByte * uint64(len(dataKey)+len(data))
+				suite.Require().GreaterOrEqual(ctx.GasMeter().GasConsumed()-gasBefore, minGas)
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+			}
+		})
+	}
+}
+
+// storeSize returns the sum of the lengths of all keys and values in the given store.
+func storeSize(store storetypes.KVStore) uint64 {
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var size uint64
+	for ; iterator.Valid(); iterator.Next() {
+		size += uint64(len(iterator.Key()) + len(iterator.Value()))
+	}
+
+	return size
+}
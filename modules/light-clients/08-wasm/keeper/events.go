@@ -37,3 +37,48 @@ func emitMigrateContractEvent(ctx sdk.Context, clientID string, checksum, newChe
 		),
 	})
 }
+
+// emitApproveChecksumEvent emits an approve checksum event
+func emitApproveChecksumEvent(ctx sdk.Context, checksum types.Checksum, uploader string) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeApproveChecksum,
+			sdk.NewAttribute(types.AttributeKeyWasmChecksum, hex.EncodeToString(checksum)),
+			sdk.NewAttribute(types.AttributeKeyUploader, uploader),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+}
+
+// emitRollbackContractEvent emits a rollback contract event
+func emitRollbackContractEvent(ctx sdk.Context, clientID string, checksum, restoredChecksum types.Checksum) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRollbackContract,
+			sdk.NewAttribute(types.AttributeKeyClientID, clientID),
+			sdk.NewAttribute(types.AttributeKeyWasmChecksum, hex.EncodeToString(checksum)),
+			sdk.NewAttribute(types.AttributeKeyNewChecksum, hex.EncodeToString(restoredChecksum)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+}
+
+// emitPinCodeEvent emits a pin or unpin code event, depending on the event type
+func emitPinCodeEvent(ctx sdk.Context, eventType string, checksum types.Checksum) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			eventType,
+			sdk.NewAttribute(types.AttributeKeyWasmChecksum, hex.EncodeToString(checksum)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+}
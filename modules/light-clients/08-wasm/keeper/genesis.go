@@ -1,8 +1,12 @@
 package keeper
 
 import (
+	"encoding/hex"
+
 	wasmvm "github.com/CosmWasm/wasmvm/v2"
 
+	errorsmod "cosmossdk.io/errors"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/cosmos/ibc-go/modules/light-clients/08-wasm/types"
@@ -17,16 +21,58 @@ func (k Keeper) InitGenesis(ctx sdk.Context, gs types.GenesisState) error {
 	}
 
 	for _, contract := range gs.Contracts {
-		_, err := k.storeWasmCode(ctx, contract.CodeBytes, storeFn)
+		_, err := k.storeWasmCode(ctx, k.GetAuthority(), contract.CodeBytes, storeFn)
 		if err != nil {
 			return err
 		}
 	}
+
+	for _, params := range gs.ChecksumParams {
+		if !k.HasChecksum(ctx, params.Checksum) {
+			return errorsmod.Wrapf(types.ErrWasmChecksumNotFound, "checksum params set for checksum %s", hex.EncodeToString(params.Checksum))
+		}
+
+		if err := k.SetChecksumParams(ctx, params); err != nil {
+			return err
+		}
+	}
+
+	for _, approvedChecksum := range gs.ApprovedChecksums {
+		if k.HasChecksum(ctx, approvedChecksum.Checksum) {
+			return errorsmod.Wrapf(types.ErrWasmCodeExists, "approved checksum %s", hex.EncodeToString(approvedChecksum.Checksum))
+		}
+
+		if err := k.SetApprovedChecksum(ctx, approvedChecksum); err != nil {
+			return err
+		}
+	}
+
+	for _, snapshot := range gs.ContractSnapshots {
+		if err := k.SetContractSnapshot(ctx, snapshot.ClientId, snapshot.Snapshot); err != nil {
+			return err
+		}
+	}
+
+	// only the codes which were pinned when the genesis was exported are pinned to the vm in-memory cache
+	for _, checksum := range gs.PinnedChecksums {
+		if !k.HasChecksum(ctx, checksum) {
+			return errorsmod.Wrapf(types.ErrWasmChecksumNotFound, "pinned checksum %s", hex.EncodeToString(checksum))
+		}
+
+		if err := k.pinCode(ctx, checksum); err != nil {
+			return err
+		}
+	}
+
+	k.ReportCacheMetrics(ctx)
+
 	return nil
 }
 
 // ExportGenesis returns the 08-wasm module's exported genesis. This includes the code
-// for all contracts previously stored.
+// for all contracts previously stored, the client store limits set for their checksums,
+// the checksums approved for upload, the client store snapshots taken at contract migrations and
+// the checksums of the codes pinned to the vm in-memory cache.
 func (k Keeper) ExportGenesis(ctx sdk.Context) types.GenesisState {
 	checksums, err := k.GetAllChecksums(ctx)
 	if err != nil {
@@ -45,5 +91,31 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) types.GenesisState {
 		})
 	}
 
+	checksumParams, err := k.GetAllChecksumParams(ctx)
+	if err != nil {
+		panic(err)
+	}
+	genesisState.ChecksumParams = checksumParams
+
+	approvedChecksums, err := k.GetAllApprovedChecksums(ctx)
+	if err != nil {
+		panic(err)
+	}
+	genesisState.ApprovedChecksums = approvedChecksums
+
+	contractSnapshots, err := k.GetAllContractSnapshots(ctx)
+	if err != nil {
+		panic(err)
+	}
+	genesisState.ContractSnapshots = contractSnapshots
+
+	pinnedChecksums, err := k.GetAllPinnedChecksums(ctx)
+	if err != nil {
+		panic(err)
+	}
+	for _, checksum := range pinnedChecksums {
+		genesisState.PinnedChecksums = append(genesisState.PinnedChecksums, checksum)
+	}
+
 	return genesisState
 }
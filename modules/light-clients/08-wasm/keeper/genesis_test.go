@@ -1,7 +1,9 @@
 package keeper_test
 
 import (
+	"bytes"
 	"encoding/hex"
+	"slices"
 
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
@@ -16,9 +18,13 @@ func (suite *KeeperTestSuite) TestInitGenesis() {
 		expChecksums []string
 	)
 
+	checksumBz, err := hex.DecodeString("b3a49b2914f5e6a673215e74325c1d153bb6776e079774e52c5b7e674d9ad3ab")
+	suite.Require().NoError(err)
+
 	testCases := []struct {
 		name     string
 		malleate func()
+		expError error
 	}{
 		{
 			"success",
@@ -35,6 +41,23 @@ func (suite *KeeperTestSuite) TestInitGenesis() {
 
 				expChecksums = []string{checksum}
 			},
+			nil,
+		},
+		{
+			"success with checksum params",
+			func() {
+				genesisState = *types.NewGenesisState(
+					[]types.Contract{
+						{
+							CodeBytes: wasmtesting.Code,
+						},
+					},
+				)
+				genesisState.ChecksumParams = []types.ChecksumParams{types.NewChecksumParams(checksumBz, 1024, 10)}
+
+				expChecksums = []string{hex.EncodeToString(checksumBz)}
+			},
+			nil,
 		},
 		{
 			"success with empty genesis contract",
@@ -42,6 +65,85 @@ func (suite *KeeperTestSuite) TestInitGenesis() {
 				genesisState = *types.NewGenesisState([]types.Contract{})
 				expChecksums = []string{}
 			},
+			nil,
+		},
+		{
+			"success with approved checksums",
+			func() {
+				genesisState = *types.NewGenesisState([]types.Contract{})
+				genesisState.ApprovedChecksums = []types.ApprovedChecksum{types.NewApprovedChecksum(checksumBz, suite.chainA.SenderAccount.GetAddress().String())}
+
+				expChecksums = []string{}
+			},
+			nil,
+		},
+		{
+			"success with contract snapshots",
+			func() {
+				genesisState = *types.NewGenesisState(
+					[]types.Contract{
+						{
+							CodeBytes: wasmtesting.Code,
+						},
+					},
+				)
+				genesisState.ContractSnapshots = []types.ClientContractSnapshot{
+					types.NewClientContractSnapshot(defaultWasmClientID, types.ContractSnapshot{
+						Checksum:    checksumBz,
+						Height:      10,
+						ClientStore: []types.ClientStoreEntry{{Key: []byte("key"), Value: []byte("value")}},
+					}),
+				}
+
+				expChecksums = []string{hex.EncodeToString(checksumBz)}
+			},
+			nil,
+		},
+		{
+			"success with pinned checksums",
+			func() {
+				genesisState = *types.NewGenesisState(
+					[]types.Contract{
+						{
+							CodeBytes: wasmtesting.Code,
+						},
+					},
+				)
+				genesisState.PinnedChecksums = [][]byte{checksumBz}
+
+				expChecksums = []string{hex.EncodeToString(checksumBz)}
+			},
+			nil,
+		},
+		{
+			"failure: pinned checksum not stored",
+			func() {
+				genesisState = *types.NewGenesisState([]types.Contract{})
+				genesisState.PinnedChecksums = [][]byte{checksumBz}
+			},
+			types.ErrWasmChecksumNotFound,
+		},
+		{
+			"failure: approved checksum already stored",
+			func() {
+				genesisState = *types.NewGenesisState(
+					[]types.Contract{
+						{
+							CodeBytes: wasmtesting.Code,
+						},
+					},
+				)
+				genesisState.ApprovedChecksums = []types.ApprovedChecksum{types.NewApprovedChecksum(checksumBz, "")}
+			},
+			types.ErrWasmCodeExists,
+		},
+		{
+			"failure: checksum params for missing checksum",
+			func() {
+				genesisState = *types.NewGenesisState([]types.Contract{})
+				genesisState.ChecksumParams = []types.ChecksumParams{types.NewChecksumParams(checksumBz, 1024, 10)}
+			},
+			types.ErrWasmChecksumNotFound,
 		},
 	}
 
@@ -53,6 +155,10 @@ func (suite *KeeperTestSuite) TestInitGenesis() {
 			tc.malleate()
 
 			err := GetSimApp(suite.chainA).WasmClientKeeper.InitGenesis(ctx, genesisState)
+			if tc.expError != nil {
+				suite.Require().ErrorIs(err, tc.expError)
+				return
+			}
 			suite.Require().NoError(err)
 
 			var storedHashes []string
@@ -65,6 +171,29 @@ func (suite *KeeperTestSuite) TestInitGenesis() {
 
 			suite.Require().Equal(len(expChecksums), len(storedHashes))
 			suite.Require().ElementsMatch(expChecksums, storedHashes)
+
+			for _, params := range genesisState.ChecksumParams {
+				suite.Require().Equal(params, GetSimApp(suite.chainA).WasmClientKeeper.GetChecksumParams(ctx, params.Checksum))
+			}
+
+			for _, approvedChecksum := range genesisState.ApprovedChecksums {
+				storedApproval, found := GetSimApp(suite.chainA).WasmClientKeeper.GetApprovedChecksum(ctx, approvedChecksum.Checksum)
+				suite.Require().True(found)
+				suite.Require().Equal(approvedChecksum, storedApproval)
+			}
+
+			// only the pinned checksums of the genesis state are pinned
+			for _, checksum := range checksums {
+				suite.Require().Equal(slices.ContainsFunc(genesisState.PinnedChecksums, func(pinnedChecksum []byte) bool {
+					return bytes.Equal(pinnedChecksum, checksum)
+				}), GetSimApp(suite.chainA).WasmClientKeeper.IsPinnedCode(ctx, checksum))
+			}
+
+			for _, snapshot := range genesisState.ContractSnapshots {
+				storedSnapshot, found := GetSimApp(suite.chainA).WasmClientKeeper.GetContractSnapshot(ctx, snapshot.ClientId)
+				suite.Require().True(found)
+				suite.Require().Equal(snapshot.Snapshot, storedSnapshot)
+			}
 		})
 	}
 }
@@ -83,7 +212,29 @@ func (suite *KeeperTestSuite) TestExportGenesis() {
 	suite.Require().NoError(err)
 	suite.Require().Equal(expChecksum, hex.EncodeToString(res.Checksum))
 
+	expParams := types.NewChecksumParams(res.Checksum, 1024, 10)
+	err = GetSimApp(suite.chainA).WasmClientKeeper.SetChecksumParams(ctx, expParams)
+	suite.Require().NoError(err)
+
+	expApprovedChecksum := types.NewApprovedChecksum(wasmtesting.CreateMockContract([]byte("approved")), "")
+	expApprovedChecksum.Checksum, err = types.CreateChecksum(expApprovedChecksum.Checksum)
+	suite.Require().NoError(err)
+	err = GetSimApp(suite.chainA).WasmClientKeeper.SetApprovedChecksum(ctx, expApprovedChecksum)
+	suite.Require().NoError(err)
+
+	expSnapshot := types.ContractSnapshot{
+		Checksum:    res.Checksum,
+		Height:      uint64(ctx.BlockHeight()),
+		ClientStore: []types.ClientStoreEntry{{Key: []byte("key"), Value: []byte("value")}},
+	}
+	err = GetSimApp(suite.chainA).WasmClientKeeper.SetContractSnapshot(ctx, defaultWasmClientID, expSnapshot)
+	suite.Require().NoError(err)
+
 	genesisState := GetSimApp(suite.chainA).WasmClientKeeper.ExportGenesis(ctx)
 	suite.Require().Len(genesisState.Contracts, 1)
 	suite.Require().NotEmpty(genesisState.Contracts[0].CodeBytes)
+	suite.Require().Equal([]types.ChecksumParams{expParams}, genesisState.ChecksumParams)
+	suite.Require().Equal([]types.ApprovedChecksum{expApprovedChecksum}, genesisState.ApprovedChecksums)
+	suite.Require().Equal([]types.ClientContractSnapshot{types.NewClientContractSnapshot(defaultWasmClientID, expSnapshot)}, genesisState.ContractSnapshots)
+	suite.Require().Equal([][]byte{res.Checksum}, genesisState.PinnedChecksums)
 }
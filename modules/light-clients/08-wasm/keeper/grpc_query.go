@@ -4,20 +4,27 @@ import (
 	"context"
 	"encoding/hex"
 
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	"cosmossdk.io/collections"
 	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkquery "github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/cosmos/ibc-go/modules/light-clients/08-wasm/types"
+	ibcerrors "github.com/cosmos/ibc-go/v9/modules/core/errors"
 )
 
 var _ types.QueryServer = (*Keeper)(nil)
 
+// SimulateMigrateContractGasLimit is the gas limit under which the contract migration simulated by the
+// Query/SimulateMigrateContract gRPC method is executed, regardless of the gas limit of the query context.
+const SimulateMigrateContractGasLimit uint64 = 10_000_000
+
 // Code implements the Query/Code gRPC method
 func (k Keeper) Code(goCtx context.Context, req *types.QueryCodeRequest) (*types.QueryCodeResponse, error) {
 	if req == nil {
@@ -62,3 +69,181 @@ func (k Keeper) Checksums(goCtx context.Context, req *types.QueryChecksumsReques
 		Pagination: pageRes,
 	}, nil
 }
+
+// ChecksumParams implements the Query/ChecksumParams gRPC method
+func (k Keeper) ChecksumParams(goCtx context.Context, req *types.QueryChecksumParamsRequest) (*types.QueryChecksumParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	checksum, err := hex.DecodeString(req.Checksum)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid checksum")
+	}
+
+	if !k.HasChecksum(goCtx, checksum) {
+		return nil, status.Error(codes.NotFound, errorsmod.Wrap(types.ErrWasmChecksumNotFound, req.Checksum).Error())
+	}
+
+	return &types.QueryChecksumParamsResponse{
+		Params: k.GetChecksumParams(goCtx, checksum),
+	}, nil
+}
+
+// ClientStoreSize implements the Query/ClientStoreSize gRPC method
+func (k Keeper) ClientStoreSize(goCtx context.Context, req *types.QueryClientStoreSizeRequest) (*types.QueryClientStoreSizeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := types.ValidateClientID(req.ClientId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	clientState, err := k.GetWasmClientState(ctx, req.ClientId)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	size, err := k.GetClientStoreSize(ctx, req.ClientId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryClientStoreSizeResponse{
+		Size_:   size,
+		MaxSize: k.GetChecksumParams(ctx, clientState.Checksum).MaxClientStoreSize,
+	}, nil
+}
+
+// ApprovedChecksums implements the Query/ApprovedChecksums gRPC method. It returns the checksums approved for upload.
+func (k Keeper) ApprovedChecksums(goCtx context.Context, req *types.QueryApprovedChecksumsRequest) (*types.QueryApprovedChecksumsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	approvedChecksums, pageRes, err := sdkquery.CollectionPaginate(
+		goCtx,
+		k.approvedChecksums,
+		req.Pagination,
+		func(_ []byte, value types.ApprovedChecksum) (types.ApprovedChecksum, error) {
+			return value, nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryApprovedChecksumsResponse{
+		ApprovedChecksums: approvedChecksums,
+		Pagination:        pageRes,
+	}, nil
+}
+
+// PinnedCodes implements the Query/PinnedCodes gRPC method. It returns the hex encoded checksums of the codes marked
+// as pinned together with their per code metrics and the cache metrics of the wasm VM. The metrics are node-specific.
+func (k Keeper) PinnedCodes(goCtx context.Context, req *types.QueryPinnedCodesRequest) (*types.QueryPinnedCodesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	pinnedMetrics, err := k.GetVM().GetPinnedMetrics()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	metricsByChecksum := make(map[string]wasmvmtypes.PerModuleMetrics, len(pinnedMetrics.PerModule))
+	for _, entry := range pinnedMetrics.PerModule {
+		metricsByChecksum[hex.EncodeToString(entry.Checksum)] = entry.Metrics
+	}
+
+	pinnedCodes, pageRes, err := sdkquery.CollectionPaginate(
+		goCtx,
+		k.pinnedChecksums,
+		req.Pagination,
+		func(key []byte, _ collections.NoValue) (types.PinnedCode, error) {
+			checksum := hex.EncodeToString(key)
+			metrics := metricsByChecksum[checksum]
+
+			return types.PinnedCode{
+				Checksum: checksum,
+				Hits:     metrics.Hits,
+				Size_:    metrics.Size,
+			}, nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	cacheMetrics, err := k.GetVM().GetMetrics()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPinnedCodesResponse{
+		PinnedCodes: pinnedCodes,
+		CacheMetrics: types.CacheMetrics{
+			HitsPinnedMemoryCache:     cacheMetrics.HitsPinnedMemoryCache,
+			HitsMemoryCache:           cacheMetrics.HitsMemoryCache,
+			HitsFsCache:               cacheMetrics.HitsFsCache,
+			Misses:                    cacheMetrics.Misses,
+			ElementsPinnedMemoryCache: cacheMetrics.ElementsPinnedMemoryCache,
+			ElementsMemoryCache:       cacheMetrics.ElementsMemoryCache,
+			SizePinnedMemoryCache:     cacheMetrics.SizePinnedMemoryCache,
+			SizeMemoryCache:           cacheMetrics.SizeMemoryCache,
+		},
+		Pagination: pageRes,
+	}, nil
+}
+
+// SimulateMigrateContract implements the Query/SimulateMigrateContract gRPC method. It runs the migration of the contract
+// of the given light client in a cached context that is discarded, and returns the resulting client state and status.
+// The migration is executed under a gas meter limited to SimulateMigrateContractGasLimit and an error is returned if
+// the migration runs out of gas.
+func (k Keeper) SimulateMigrateContract(goCtx context.Context, req *types.QuerySimulateMigrateContractRequest) (_ *types.QuerySimulateMigrateContractResponse, err error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := types.ValidateClientID(req.ClientId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	checksum, err := hex.DecodeString(req.Checksum)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid checksum")
+	}
+
+	if len(req.Msg) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "migrate message cannot be empty")
+	}
+
+	// discard all changes made by the migration by not committing the cached context
+	cacheCtx, _ := sdk.UnwrapSDKContext(goCtx).CacheContext()
+	cacheCtx = cacheCtx.WithGasMeter(storetypes.NewGasMeter(SimulateMigrateContractGasLimit))
+
+	defer func() {
+		if r := recover(); r != nil {
+			outOfGas, ok := r.(storetypes.ErrorOutOfGas)
+			if !ok {
+				panic(r)
+			}
+
+			err = status.Error(codes.ResourceExhausted, errorsmod.Wrapf(ibcerrors.ErrOutOfGas, "simulation exceeded gas limit of %d: %s", SimulateMigrateContractGasLimit, outOfGas.Descriptor).Error())
+		}
+	}()
+
+	if err := k.migrateContractCode(cacheCtx, req.ClientId, checksum, req.Msg); err != nil {
+		return nil, status.Error(codes.FailedPrecondition, errorsmod.Wrap(err, "failed to migrate contract").Error())
+	}
+
+	clientState, err := k.GetWasmClientState(cacheCtx, req.ClientId)
+	if err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	return &types.QuerySimulateMigrateContractResponse{
+		ClientState: clientState,
+		Status:      k.contractStatus(cacheCtx, req.ClientId, clientState).String(),
+	}, nil
+}
@@ -2,12 +2,21 @@ package keeper_test
 
 import (
 	"encoding/hex"
+	"encoding/json"
+
+	wasmvm "github.com/CosmWasm/wasmvm/v2"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
+	"github.com/cosmos/ibc-go/modules/light-clients/08-wasm/keeper"
 	wasmtesting "github.com/cosmos/ibc-go/modules/light-clients/08-wasm/testing"
 	"github.com/cosmos/ibc-go/modules/light-clients/08-wasm/types"
+	"github.com/cosmos/ibc-go/v9/modules/core/exported"
+	ibctesting "github.com/cosmos/ibc-go/v9/testing"
 )
 
 func (suite *KeeperTestSuite) TestQueryCode() {
@@ -116,3 +125,426 @@ func (suite *KeeperTestSuite) TestQueryChecksums() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestQueryApprovedChecksums() {
+	var expApprovedChecksums []types.ApprovedChecksum
+
+	testCases := []struct {
+		name     string
+		malleate func()
+	}{
+		{
+			"success with no approved checksums",
+			func() {
+				expApprovedChecksums = []types.ApprovedChecksum{}
+			},
+		},
+		{
+			"success with one approved checksum",
+			func() {
+				checksum, err := types.CreateChecksum(wasmtesting.Code)
+				suite.Require().NoError(err)
+
+				signer := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+				msg := types.NewMsgApproveChecksum(signer, checksum, "")
+
+				_, err = GetSimApp(suite.chainA).WasmClientKeeper.ApproveChecksum(suite.chainA.GetContext(), msg)
+				suite.Require().NoError(err)
+
+				expApprovedChecksums = []types.ApprovedChecksum{types.NewApprovedChecksum(checksum, "")}
+			},
+		},
+		{
+			"success with approved checksum consumed by upload",
+			func() {
+				suite.approveChecksum(wasmtesting.Code, "")
+
+				msg := types.NewMsgStoreCode(suite.chainA.SenderAccount.GetAddress().String(), wasmtesting.Code)
+				_, err := GetSimApp(suite.chainA).WasmClientKeeper.StoreCode(suite.chainA.GetContext(), msg)
+				suite.Require().NoError(err)
+
+				expApprovedChecksums = []types.ApprovedChecksum{}
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupWasmWithMockVM()
+
+			tc.malleate()
+
+			req := &types.QueryApprovedChecksumsRequest{}
+			res, err := GetSimApp(suite.chainA).WasmClientKeeper.ApprovedChecksums(suite.chainA.GetContext(), req)
+
+			suite.Require().NoError(err)
+			suite.Require().NotNil(res)
+			suite.Require().ElementsMatch(expApprovedChecksums, res.ApprovedChecksums)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryChecksumParams() {
+	var (
+		req       *types.QueryChecksumParamsRequest
+		expParams types.ChecksumParams
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {
+				checksum := suite.storeWasmCode(wasmtesting.Code)
+
+				expParams = types.NewChecksumParams(checksum, 1024, 10)
+				err := GetSimApp(suite.chainA).WasmClientKeeper.SetChecksumParams(suite.chainA.GetContext(), expParams)
+				suite.Require().NoError(err)
+
+				req = &types.QueryChecksumParamsRequest{Checksum: hex.EncodeToString(checksum)}
+			},
+			true,
+		},
+		{
+			"success: no params set",
+			func() {
+				checksum := suite.storeWasmCode(wasmtesting.Code)
+
+				expParams = types.NewChecksumParams(checksum, 0, 0)
+				req = &types.QueryChecksumParamsRequest{Checksum: hex.EncodeToString(checksum)}
+			},
+			true,
+		},
+		{
+			"fails with invalid checksum",
+			func() {
+				req = &types.QueryChecksumParamsRequest{Checksum: "test"}
+			},
+			false,
+		},
+		{
+			"fails with non-existent checksum",
+			func() {
+				req = &types.QueryChecksumParamsRequest{Checksum: hex.EncodeToString(make([]byte, 32))}
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupWasmWithMockVM()
+
+			tc.malleate()
+
+			res, err := GetSimApp(suite.chainA).WasmClientKeeper.ChecksumParams(suite.chainA.GetContext(), req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(expParams, res.Params)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryClientStoreSize() {
+	var (
+		req        *types.QueryClientStoreSizeRequest
+		expMaxSize uint64
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"success: max client store size set",
+			func() {
+				checksum, err := types.CreateChecksum(wasmtesting.Code)
+				suite.Require().NoError(err)
+
+				expMaxSize = 1024
+				err = GetSimApp(suite.chainA).WasmClientKeeper.SetChecksumParams(suite.chainA.GetContext(), types.NewChecksumParams(checksum, expMaxSize, 0))
+				suite.Require().NoError(err)
+			},
+			true,
+		},
+		{
+			"fails with invalid client identifier",
+			func() {
+				req.ClientId = "07-tendermint-0"
+			},
+			false,
+		},
+		{
+			"fails with non-existent client",
+			func() {
+				req.ClientId = "08-wasm-100"
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupWasmWithMockVM()
+			_ = suite.storeWasmCode(wasmtesting.Code)
+
+			endpoint := wasmtesting.NewWasmEndpoint(suite.chainA)
+			err := endpoint.CreateClient()
+			suite.Require().NoError(err)
+
+			expMaxSize = 0
+			req = &types.QueryClientStoreSizeRequest{ClientId: endpoint.ClientID}
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			res, err := GetSimApp(suite.chainA).WasmClientKeeper.ClientStoreSize(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+
+				clientStore := suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(ctx, endpoint.ClientID)
+				suite.Require().Equal(storeSize(clientStore), res.Size_)
+				suite.Require().Equal(expMaxSize, res.MaxSize)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQuerySimulateMigrateContract() {
+	var (
+		req         *types.QuerySimulateMigrateContractRequest
+		newChecksum []byte
+		expStatus   exported.Status
+	)
+
+	newByteCode := wasmtesting.CreateMockContract([]byte("MockByteCode-TestQuerySimulateMigrateContract"))
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success",
+			func() {
+				req = &types.QuerySimulateMigrateContractRequest{ClientId: defaultWasmClientID, Checksum: hex.EncodeToString(newChecksum), Msg: []byte("{}")}
+			},
+			nil,
+		},
+		{
+			"success: migrated contract reports frozen status",
+			func() {
+				req = &types.QuerySimulateMigrateContractRequest{ClientId: defaultWasmClientID, Checksum: hex.EncodeToString(newChecksum), Msg: []byte("{}")}
+
+				suite.mockVM.RegisterQueryCallback(types.StatusMsg{}, func(_ wasmvm.Checksum, _ wasmvmtypes.Env, _ []byte, _ wasmvm.KVStore, _ wasmvm.GoAPI, _ wasmvm.Querier, _ wasmvm.GasMeter, _ uint64, _ wasmvmtypes.UFraction) (*wasmvmtypes.QueryResult, uint64, error) {
+					resp, err := json.Marshal(types.StatusResult{Status: exported.Frozen.String()})
+					suite.Require().NoError(err)
+					return &wasmvmtypes.QueryResult{Ok: resp}, wasmtesting.DefaultGasUsed, nil
+				})
+
+				expStatus = exported.Frozen
+			},
+			nil,
+		},
+		{
+			"failure: contract returns error on migration",
+			func() {
+				req = &types.QuerySimulateMigrateContractRequest{ClientId: defaultWasmClientID, Checksum: hex.EncodeToString(newChecksum), Msg: []byte("{}")}
+
+				suite.mockVM.MigrateFn = func(_ wasmvm.Checksum, _ wasmvmtypes.Env, _ []byte, _ wasmvm.KVStore, _ wasmvm.GoAPI, _ wasmvm.Querier, _ wasmvm.GasMeter, _ uint64, _ wasmvmtypes.UFraction) (*wasmvmtypes.ContractResult, uint64, error) {
+					return &wasmvmtypes.ContractResult{Err: wasmtesting.ErrMockContract.Error()}, wasmtesting.DefaultGasUsed, nil
+				}
+			},
+			status.Error(codes.FailedPrecondition, ""),
+		},
+		{
+			"failure: migration runs out of gas",
+			func() {
+				req = &types.QuerySimulateMigrateContractRequest{ClientId: defaultWasmClientID, Checksum: hex.EncodeToString(newChecksum), Msg: []byte("{}")}
+
+				suite.mockVM.MigrateFn = func(_ wasmvm.Checksum, _ wasmvmtypes.Env, _ []byte, _ wasmvm.KVStore, _ wasmvm.GoAPI, _ wasmvm.Querier, _ wasmvm.GasMeter, _ uint64, _ wasmvmtypes.UFraction) (*wasmvmtypes.ContractResult, uint64, error) {
+					data, err := json.Marshal(types.EmptyResult{})
+					suite.Require().NoError(err)
+
+					gasUsed := types.VMGasRegister.ToWasmVMGas(keeper.SimulateMigrateContractGasLimit + 1)
+					return &wasmvmtypes.ContractResult{Ok: &wasmvmtypes.Response{Data: data}}, gasUsed, nil
+				}
+			},
+			status.Error(codes.ResourceExhausted, ""),
+		},
+		{
+			"failure: checksum not found",
+			func() {
+				req = &types.QuerySimulateMigrateContractRequest{ClientId: defaultWasmClientID, Checksum: hex.EncodeToString(make([]byte, 32)), Msg: []byte("{}")}
+			},
+			status.Error(codes.FailedPrecondition, ""),
+		},
+		{
+			"failure: invalid checksum",
+			func() {
+				req = &types.QuerySimulateMigrateContractRequest{ClientId: defaultWasmClientID, Checksum: "invalid", Msg: []byte("{}")}
+			},
+			status.Error(codes.InvalidArgument, ""),
+		},
+		{
+			"failure: empty migrate message",
+			func() {
+				req = &types.QuerySimulateMigrateContractRequest{ClientId: defaultWasmClientID, Checksum: hex.EncodeToString(newChecksum)}
+			},
+			status.Error(codes.InvalidArgument, ""),
+		},
+		{
+			"failure: invalid client identifier",
+			func() {
+				req = &types.QuerySimulateMigrateContractRequest{ClientId: ibctesting.FirstClientID, Checksum: hex.EncodeToString(newChecksum), Msg: []byte("{}")}
+			},
+			status.Error(codes.InvalidArgument, ""),
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupWasmWithMockVM()
+
+			oldChecksum := suite.storeWasmCode(wasmtesting.Code)
+			newChecksum = suite.storeWasmCode(newByteCode)
+			expStatus = exported.Active
+
+			endpoint := wasmtesting.NewWasmEndpoint(suite.chainA)
+			err := endpoint.CreateClient()
+			suite.Require().NoError(err)
+
+			suite.mockVM.MigrateFn = func(_ wasmvm.Checksum, _ wasmvmtypes.Env, _ []byte, _ wasmvm.KVStore, _ wasmvm.GoAPI, _ wasmvm.Querier, _ wasmvm.GasMeter, _ uint64, _ wasmvmtypes.UFraction) (*wasmvmtypes.ContractResult, uint64, error) {
+				data, err := json.Marshal(types.EmptyResult{})
+				suite.Require().NoError(err)
+
+				return &wasmvmtypes.ContractResult{Ok: &wasmvmtypes.Response{Data: data}}, wasmtesting.DefaultGasUsed, nil
+			}
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			res, err := GetSimApp(suite.chainA).WasmClientKeeper.SimulateMigrateContract(ctx, req)
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+				suite.Require().Equal(newChecksum, res.ClientState.Checksum)
+				suite.Require().Equal(expStatus.String(), res.Status)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Equal(status.Code(tc.expError), status.Code(err))
+				suite.Require().Nil(res)
+			}
+
+			// the simulation must not modify state
+			clientState, ok := endpoint.GetClientState().(*types.ClientState)
+			suite.Require().True(ok)
+			suite.Require().Equal(oldChecksum, clientState.Checksum)
+
+			_, found := GetSimApp(suite.chainA).WasmClientKeeper.GetContractSnapshot(ctx, defaultWasmClientID)
+			suite.Require().False(found)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryPinnedCodes() {
+	var (
+		req            *types.QueryPinnedCodesRequest
+		expPinnedCodes []types.PinnedCode
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success with no pinned codes",
+			func() {
+				expPinnedCodes = []types.PinnedCode{}
+			},
+			nil,
+		},
+		{
+			"success with pinned code and metrics",
+			func() {
+				checksum := suite.storeWasmCode(wasmtesting.Code)
+
+				suite.mockVM.GetPinnedMetricsFn = func() (*wasmvmtypes.PinnedMetrics, error) {
+					return &wasmvmtypes.PinnedMetrics{
+						PerModule: []wasmvmtypes.PerModuleEntry{
+							{Checksum: checksum, Metrics: wasmvmtypes.PerModuleMetrics{Hits: 3, Size: 1024}},
+						},
+					}, nil
+				}
+
+				expPinnedCodes = []types.PinnedCode{{Checksum: hex.EncodeToString(checksum), Hits: 3, Size_: 1024}}
+			},
+			nil,
+		},
+		{
+			"success with unpinned code",
+			func() {
+				checksum := suite.storeWasmCode(wasmtesting.Code)
+
+				signer := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+				_, err := GetSimApp(suite.chainA).WasmClientKeeper.UnpinCodes(suite.chainA.GetContext(), types.NewMsgUnpinCodes(signer, [][]byte{checksum}))
+				suite.Require().NoError(err)
+
+				expPinnedCodes = []types.PinnedCode{}
+			},
+			nil,
+		},
+		{
+			"failure: empty request",
+			func() {
+				req = nil
+			},
+			status.Error(codes.InvalidArgument, "empty request"),
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupWasmWithMockVM()
+
+			suite.mockVM.GetMetricsFn = func() (*wasmvmtypes.Metrics, error) {
+				return &wasmvmtypes.Metrics{HitsPinnedMemoryCache: 5, Misses: 1, ElementsPinnedMemoryCache: 1}, nil
+			}
+
+			req = &types.QueryPinnedCodesRequest{}
+
+			tc.malleate()
+
+			res, err := GetSimApp(suite.chainA).WasmClientKeeper.PinnedCodes(suite.chainA.GetContext(), req)
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().ElementsMatch(expPinnedCodes, res.PinnedCodes)
+				suite.Require().Equal(types.CacheMetrics{HitsPinnedMemoryCache: 5, Misses: 1, ElementsPinnedMemoryCache: 1}, res.CacheMetrics)
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+				suite.Require().Nil(res)
+			}
+		})
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"bytes"
 	"context"
 	"encoding/hex"
+	"encoding/json"
+	"errors"
 
 	wasmvm "github.com/CosmWasm/wasmvm/v2"
 
@@ -11,12 +13,16 @@ import (
 	"cosmossdk.io/core/store"
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/cosmos/ibc-go/modules/light-clients/08-wasm/internal/telemetry"
 	"github.com/cosmos/ibc-go/modules/light-clients/08-wasm/types"
 	clienttypes "github.com/cosmos/ibc-go/v9/modules/core/02-client/types"
+	host "github.com/cosmos/ibc-go/v9/modules/core/24-host"
+	ibcerrors "github.com/cosmos/ibc-go/v9/modules/core/errors"
 	"github.com/cosmos/ibc-go/v9/modules/core/exported"
 )
 
@@ -30,8 +36,13 @@ type Keeper struct {
 
 	vm types.WasmEngine
 
-	checksums    collections.KeySet[[]byte]
-	storeService store.KVStoreService
+	checksums         collections.KeySet[[]byte]
+	checksumParams    collections.Map[[]byte, types.ChecksumParams]
+	clientStoreSizes  collections.Map[string, uint64]
+	approvedChecksums collections.Map[[]byte, types.ApprovedChecksum]
+	contractSnapshots collections.Map[string, types.ContractSnapshot]
+	pinnedChecksums   collections.KeySet[[]byte]
+	storeService      store.KVStoreService
 
 	queryPlugins QueryPlugins
 
@@ -67,6 +78,99 @@ func (k Keeper) GetChecksums() collections.KeySet[[]byte] {
 	return k.checksums
 }
 
+// GetChecksumParams returns the client store limits set for the given checksum. If no limits
+// have been set, params without a maximum client store size or per byte write gas are returned.
+func (k Keeper) GetChecksumParams(ctx context.Context, checksum types.Checksum) types.ChecksumParams {
+	params, err := k.checksumParams.Get(ctx, checksum)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.NewChecksumParams(checksum, 0, 0)
+		}
+		panic(err)
+	}
+
+	return params
+}
+
+// SetChecksumParams sets the client store limits for the checksum referenced by the params.
+func (k Keeper) SetChecksumParams(ctx context.Context, params types.ChecksumParams) error {
+	return k.checksumParams.Set(ctx, params.Checksum, params)
+}
+
+// GetAllChecksumParams returns the client store limits set for all checksums.
+func (k Keeper) GetAllChecksumParams(ctx context.Context) ([]types.ChecksumParams, error) {
+	iterator, err := k.checksumParams.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return iterator.Values()
+}
+
+// GetApprovedChecksum returns the approval for uploading the wasm byte code with the given checksum, if any.
+func (k Keeper) GetApprovedChecksum(ctx context.Context, checksum types.Checksum) (types.ApprovedChecksum, bool) {
+	approvedChecksum, err := k.approvedChecksums.Get(ctx, checksum)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.ApprovedChecksum{}, false
+		}
+		panic(err)
+	}
+
+	return approvedChecksum, true
+}
+
+// SetApprovedChecksum stores the approval for uploading the wasm byte code with the checksum it references.
+func (k Keeper) SetApprovedChecksum(ctx context.Context, approvedChecksum types.ApprovedChecksum) error {
+	return k.approvedChecksums.Set(ctx, approvedChecksum.Checksum, approvedChecksum)
+}
+
+// GetAllApprovedChecksums returns all the checksums approved for upload.
+func (k Keeper) GetAllApprovedChecksums(ctx context.Context) ([]types.ApprovedChecksum, error) {
+	iterator, err := k.approvedChecksums.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return iterator.Values()
+}
+
+// GetClientStoreSize returns the size of the client store of the given client, computed as the sum of the
+// lengths of all keys and values. If the size has not been tracked yet, it is computed by iterating over the client store.
+func (k Keeper) GetClientStoreSize(ctx sdk.Context, clientID string) (uint64, error) {
+	size, err := k.clientStoreSizes.Get(ctx, clientID)
+	if err == nil {
+		return size, nil
+	}
+	if !errors.Is(err, collections.ErrNotFound) {
+		return 0, err
+	}
+
+	return computeStoreSize(k.clientKeeper.ClientStore(ctx, clientID)), nil
+}
+
+// ResetClientStoreSize recomputes the tracked size of the client store of the given client by iterating over the
+// client store. If the client store does not hold a wasm client state, the tracked size is removed. It must be called
+// whenever the client store has been written to without tracking the change in size, such as when it is replaced.
+func (k Keeper) ResetClientStoreSize(ctx sdk.Context, clientID string) error {
+	clientStore := k.clientKeeper.ClientStore(ctx, clientID)
+	bz := clientStore.Get(host.ClientStateKey())
+	if len(bz) == 0 {
+		return k.clientStoreSizes.Remove(ctx, clientID)
+	}
+
+	clientState, err := clienttypes.UnmarshalClientState(k.cdc, bz)
+	if err != nil {
+		return err
+	}
+
+	if _, ok := clientState.(*types.ClientState); !ok {
+		return k.clientStoreSizes.Remove(ctx, clientID)
+	}
+
+	return k.clientStoreSizes.Set(ctx, clientID, computeStoreSize(clientStore))
+}
+
 // getQueryPlugins returns the set query plugins.
 func (k Keeper) getQueryPlugins() QueryPlugins {
 	return k.queryPlugins
@@ -81,12 +185,14 @@ func (k Keeper) newQueryHandler(ctx sdk.Context, callerID string) *queryHandler
 	return newQueryHandler(ctx, k.getQueryPlugins(), callerID)
 }
 
-// storeWasmCode stores the contract to the VM, pins the checksum in the VM's in memory cache and stores the checksum
-// in the 08-wasm store. The checksum identifying it is returned if successful. The following checks are made to the
+// storeWasmCode stores the contract to the VM and stores the checksum in the 08-wasm store. The contract is not pinned
+// to the VM's in memory cache, which is left to the caller. The checksum identifying it is returned if successful. The following checks are made to the
 // contract code before storing:
 // - Size bounds are checked. Contract length must not be 0 or exceed a specific size (maxWasmSize).
 // - The contract must not have already been stored in store.
-func (k Keeper) storeWasmCode(ctx sdk.Context, code []byte, storeFn func(code wasmvm.WasmCode, gasLimit uint64) (wasmvm.Checksum, uint64, error)) ([]byte, error) {
+// - If the signer is not the authority, the checksum of the contract must have been approved with MsgApproveChecksum
+// and the signer must be allowed to upload it. The approval is consumed once the contract is stored.
+func (k Keeper) storeWasmCode(ctx sdk.Context, signer string, code []byte, storeFn func(code wasmvm.WasmCode, gasLimit uint64) (wasmvm.Checksum, uint64, error)) ([]byte, error) {
 	var err error
 	if types.IsGzip(code) {
 		ctx.GasMeter().ConsumeGas(types.VMGasRegister.UncompressCosts(len(code)), "Uncompress gzip bytecode")
@@ -111,6 +217,17 @@ func (k Keeper) storeWasmCode(ctx sdk.Context, code []byte, storeFn func(code wa
 		return nil, types.ErrWasmCodeExists
 	}
 
+	if signer != k.GetAuthority() {
+		approvedChecksum, found := k.GetApprovedChecksum(ctx, checksum)
+		if !found {
+			return nil, errorsmod.Wrapf(types.ErrWasmChecksumNotApproved, "checksum %s", hex.EncodeToString(checksum))
+		}
+
+		if !approvedChecksum.IsUploaderAllowed(signer) {
+			return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected uploader %s, got %s", approvedChecksum.Uploader, signer)
+		}
+	}
+
 	// create the code in the vm
 	gasLeft := types.VMGasRegister.RuntimeGasForContract(ctx)
 	vmChecksum, gasUsed, err := storeFn(code, gasLeft)
@@ -124,22 +241,23 @@ func (k Keeper) storeWasmCode(ctx sdk.Context, code []byte, storeFn func(code wa
 		return nil, errorsmod.Wrapf(types.ErrInvalidChecksum, "expected %s, got %s", hex.EncodeToString(checksum), hex.EncodeToString(vmChecksum))
 	}
 
-	// pin the code to the vm in-memory cache
-	if err := k.GetVM().Pin(vmChecksum); err != nil {
-		return nil, errorsmod.Wrapf(err, "failed to pin contract with checksum (%s) to vm cache", hex.EncodeToString(vmChecksum))
-	}
-
 	// store the checksum
 	err = k.GetChecksums().Set(ctx, checksum)
 	if err != nil {
 		return nil, errorsmod.Wrap(err, "failed to store checksum")
 	}
 
+	// the approval is no longer needed once the checksum is stored
+	if err := k.approvedChecksums.Remove(ctx, checksum); err != nil {
+		return nil, errorsmod.Wrap(err, "failed to remove approved checksum")
+	}
+
 	return checksum, nil
 }
 
 // migrateContractCode migrates the contract for a given light client to one denoted by the given new checksum. The checksum we
 // are migrating to must first be stored using storeWasmCode and must not match the checksum currently stored for this light client.
+// A snapshot of the client store taken before the migration is kept, so that the migration can be rolled back with rollbackContract.
 func (k Keeper) migrateContractCode(ctx sdk.Context, clientID string, newChecksum, migrateMsg []byte) error {
 	clientStore := k.clientKeeper.ClientStore(ctx, clientID)
 	wasmClientState, found := types.GetClientState(clientStore, k.cdc)
@@ -156,6 +274,12 @@ func (k Keeper) migrateContractCode(ctx sdk.Context, clientID string, newChecksu
 		return errorsmod.Wrapf(types.ErrWasmCodeExists, "new checksum (%s) is the same as current checksum (%s)", hex.EncodeToString(newChecksum), hex.EncodeToString(wasmClientState.Checksum))
 	}
 
+	snapshot := types.ContractSnapshot{
+		Checksum:    oldChecksum,
+		Height:      uint64(ctx.BlockHeight()),
+		ClientStore: snapshotClientStore(clientStore),
+	}
+
 	// update the checksum, this needs to be done before the contract migration
 	// so that wasmMigrate can call the right code. Note that this is not
 	// persisted to the client store.
@@ -177,13 +301,124 @@ func (k Keeper) migrateContractCode(ctx sdk.Context, clientID string, newChecksu
 	// update the client state checksum before persisting it
 	wasmClientState.Checksum = newChecksum
 
-	k.clientKeeper.SetClientState(ctx, clientID, wasmClientState)
+	if err := k.setClientState(ctx, clientID, wasmClientState); err != nil {
+		return err
+	}
+
+	// keep the client store as it was before the migration so that the migration can be rolled back
+	if err := k.SetContractSnapshot(ctx, clientID, snapshot); err != nil {
+		return errorsmod.Wrap(err, "failed to store contract snapshot")
+	}
 
 	emitMigrateContractEvent(ctx, clientID, oldChecksum, newChecksum)
 
 	return nil
 }
 
+// rollbackContract restores the client store of the given light client, and therefore the checksum of its contract,
+// to the snapshot taken at the last contract migration. The checksum being restored must still be stored.
+// The snapshot is consumed by the rollback. The restored checksum is returned if successful.
+func (k Keeper) rollbackContract(ctx sdk.Context, clientID string) (types.Checksum, error) {
+	snapshot, found := k.GetContractSnapshot(ctx, clientID)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrContractSnapshotNotFound, "client %s", clientID)
+	}
+
+	if !k.HasChecksum(ctx, snapshot.Checksum) {
+		return nil, errorsmod.Wrapf(types.ErrWasmChecksumNotFound, "checksum %s of contract snapshot", hex.EncodeToString(snapshot.Checksum))
+	}
+
+	wasmClientState, err := k.GetWasmClientState(ctx, clientID)
+	if err != nil {
+		return nil, err
+	}
+
+	clientStore := k.clientKeeper.ClientStore(ctx, clientID)
+
+	// collect the keys before deleting to avoid mutating the store while iterating over it
+	var keys [][]byte
+	iterator := clientStore.Iterator(nil, nil)
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		clientStore.Delete(key)
+	}
+
+	for _, entry := range snapshot.ClientStore {
+		clientStore.Set(entry.Key, entry.Value)
+	}
+
+	if err := k.ResetClientStoreSize(ctx, clientID); err != nil {
+		return nil, errorsmod.Wrap(err, "failed to reset client store size")
+	}
+
+	if err := k.contractSnapshots.Remove(ctx, clientID); err != nil {
+		return nil, errorsmod.Wrap(err, "failed to remove contract snapshot")
+	}
+
+	emitRollbackContractEvent(ctx, clientID, wasmClientState.Checksum, snapshot.Checksum)
+
+	return snapshot.Checksum, nil
+}
+
+// GetContractSnapshot returns the client store snapshot taken at the last contract migration of the given light client, if any.
+func (k Keeper) GetContractSnapshot(ctx context.Context, clientID string) (types.ContractSnapshot, bool) {
+	snapshot, err := k.contractSnapshots.Get(ctx, clientID)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.ContractSnapshot{}, false
+		}
+		panic(err)
+	}
+
+	return snapshot, true
+}
+
+// SetContractSnapshot stores the client store snapshot taken at the last contract migration of the given light client.
+func (k Keeper) SetContractSnapshot(ctx context.Context, clientID string, snapshot types.ContractSnapshot) error {
+	return k.contractSnapshots.Set(ctx, clientID, snapshot)
+}
+
+// GetAllContractSnapshots returns the client store snapshots taken at the last contract migration of all light clients.
+func (k Keeper) GetAllContractSnapshots(ctx context.Context) ([]types.ClientContractSnapshot, error) {
+	iterator, err := k.contractSnapshots.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	kvs, err := iterator.KeyValues()
+	if err != nil {
+		return nil, err
+	}
+
+	snapshots := make([]types.ClientContractSnapshot, 0, len(kvs))
+	for _, kv := range kvs {
+		snapshots = append(snapshots, types.NewClientContractSnapshot(kv.Key, kv.Value))
+	}
+
+	return snapshots, nil
+}
+
+// contractStatus queries the status of the given light client from its contract. Unknown is returned if the query fails.
+func (k Keeper) contractStatus(ctx sdk.Context, clientID string, clientState *types.ClientState) exported.Status {
+	clientStore := k.clientKeeper.ClientStore(ctx, clientID)
+
+	res, err := k.WasmQuery(ctx, clientID, clientStore, clientState, types.QueryMsg{Status: &types.StatusMsg{}})
+	if err != nil {
+		return exported.Unknown
+	}
+
+	var result types.StatusResult
+	if err := json.Unmarshal(res, &result); err != nil {
+		return exported.Unknown
+	}
+
+	return exported.Status(result.Status)
+}
+
 // GetWasmClientState returns the 08-wasm client state for the given client identifier.
 func (k Keeper) GetWasmClientState(ctx sdk.Context, clientID string) (*types.ClientState, error) {
 	clientState, found := k.clientKeeper.GetClientState(ctx, clientID)
@@ -231,9 +466,35 @@ func (k Keeper) HasChecksum(ctx context.Context, checksum types.Checksum) bool {
 	return found
 }
 
+// computeStoreSize returns the sum of the lengths of all keys and values in the given store.
+func computeStoreSize(store storetypes.KVStore) uint64 {
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var size uint64
+	for ; iterator.Valid(); iterator.Next() {
+		size += uint64(len(iterator.Key()) + len(iterator.Value()))
+	}
+
+	return size
+}
+
+// snapshotClientStore returns all the key value pairs in the given client store.
+func snapshotClientStore(clientStore storetypes.KVStore) []types.ClientStoreEntry {
+	iterator := clientStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var entries []types.ClientStoreEntry
+	for ; iterator.Valid(); iterator.Next() {
+		entries = append(entries, types.ClientStoreEntry{Key: iterator.Key(), Value: iterator.Value()})
+	}
+
+	return entries
+}
+
 // InitializePinnedCodes updates wasmvm to pin to cache all contracts marked as pinned
 func (k Keeper) InitializePinnedCodes(ctx sdk.Context) error {
-	checksums, err := k.GetAllChecksums(ctx)
+	checksums, err := k.GetAllPinnedChecksums(ctx)
 	if err != nil {
 		return err
 	}
@@ -243,5 +504,82 @@ func (k Keeper) InitializePinnedCodes(ctx sdk.Context) error {
 			return err
 		}
 	}
+
+	k.ReportCacheMetrics(ctx)
+
 	return nil
 }
+
+// IsPinnedCode returns true if the code with the given checksum is marked as pinned.
+func (k Keeper) IsPinnedCode(ctx context.Context, checksum types.Checksum) bool {
+	found, err := k.pinnedChecksums.Has(ctx, checksum)
+	if err != nil {
+		panic(err)
+	}
+
+	return found
+}
+
+// GetAllPinnedChecksums returns the checksums of all codes marked as pinned.
+func (k Keeper) GetAllPinnedChecksums(ctx context.Context) ([]types.Checksum, error) {
+	iterator, err := k.pinnedChecksums.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	keys, err := iterator.Keys()
+	if err != nil {
+		return nil, err
+	}
+
+	checksums := make([]types.Checksum, len(keys))
+	for i, key := range keys {
+		checksums[i] = key
+	}
+
+	return checksums, nil
+}
+
+// pinCode pins the code with the given checksum to the vm in-memory cache and marks it as pinned.
+func (k Keeper) pinCode(ctx sdk.Context, checksum types.Checksum) error {
+	if err := k.GetVM().Pin(checksum); err != nil {
+		return errorsmod.Wrapf(err, "failed to pin contract with checksum (%s) to vm cache", hex.EncodeToString(checksum))
+	}
+
+	if err := k.pinnedChecksums.Set(ctx, checksum); err != nil {
+		return errorsmod.Wrap(err, "failed to mark checksum as pinned")
+	}
+
+	return nil
+}
+
+// unpinCode unpins the code with the given checksum from the vm in-memory cache and removes its pinned mark.
+func (k Keeper) unpinCode(ctx sdk.Context, checksum types.Checksum) error {
+	if err := k.GetVM().Unpin(checksum); err != nil {
+		return errorsmod.Wrapf(err, "failed to unpin contract with checksum (%s) from vm cache", hex.EncodeToString(checksum))
+	}
+
+	if err := k.pinnedChecksums.Remove(ctx, checksum); err != nil {
+		return errorsmod.Wrap(err, "failed to remove pinned mark of checksum")
+	}
+
+	return nil
+}
+
+// ReportCacheMetrics sets the telemetry gauges for the cache metrics of the vm and of the pinned codes.
+// The metrics are node-specific, so errors retrieving them are logged and otherwise ignored.
+func (k Keeper) ReportCacheMetrics(ctx sdk.Context) {
+	metrics, err := k.GetVM().GetMetrics()
+	if err != nil {
+		k.Logger(ctx).Error("failed to retrieve wasm vm cache metrics", "error", err)
+		return
+	}
+
+	pinnedMetrics, err := k.GetVM().GetPinnedMetrics()
+	if err != nil {
+		k.Logger(ctx).Error("failed to retrieve wasm vm pinned cache metrics", "error", err)
+		return
+	}
+
+	telemetry.ReportCacheMetrics(metrics, pinnedMetrics)
+}
@@ -120,6 +120,15 @@ func (suite *KeeperTestSuite) setupWasmWithMockVM() (ibctesting.TestingApp, map[
 	return app, app.DefaultGenesis()
 }
 
+// approveChecksum approves the upload of the given wasm code by the given uploader.
+func (suite *KeeperTestSuite) approveChecksum(wasmCode []byte, uploader string) {
+	checksum, err := types.CreateChecksum(wasmCode)
+	suite.Require().NoError(err)
+
+	err = GetSimApp(suite.chainA).WasmClientKeeper.SetApprovedChecksum(suite.chainA.GetContext(), types.NewApprovedChecksum(checksum, uploader))
+	suite.Require().NoError(err)
+}
+
 // storeWasmCode stores the wasm code on chain and returns the checksum.
 func (suite *KeeperTestSuite) storeWasmCode(wasmCode []byte) []byte {
 	ctx := suite.chainA.GetContext().WithBlockGasMeter(storetypes.NewInfiniteGasMeter())
@@ -259,7 +268,10 @@ func (suite *KeeperTestSuite) TestNewKeeper() {
 }
 
 func (suite *KeeperTestSuite) TestInitializedPinnedCodes() {
-	var capturedChecksums []wasmvm.Checksum
+	var (
+		capturedChecksums []wasmvm.Checksum
+		checksumIDs       []types.Checksum
+	)
 
 	testCases := []struct {
 		name     string
@@ -276,6 +288,22 @@ func (suite *KeeperTestSuite) TestInitializedPinnedCodes() {
 			},
 			nil,
 		},
+		{
+			"success: unpinned codes are not pinned",
+			func() {
+				signer := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+				_, err := GetSimApp(suite.chainA).WasmClientKeeper.UnpinCodes(suite.chainA.GetContext(), types.NewMsgUnpinCodes(signer, [][]byte{checksumIDs[1]}))
+				suite.Require().NoError(err)
+
+				checksumIDs = checksumIDs[:1]
+
+				suite.mockVM.PinFn = func(checksum wasmvm.Checksum) error {
+					capturedChecksums = append(capturedChecksums, checksum)
+					return nil
+				}
+			},
+			nil,
+		},
 		{
 			"failure: pin error",
 			func() {
@@ -292,12 +320,13 @@ func (suite *KeeperTestSuite) TestInitializedPinnedCodes() {
 
 		suite.Run(tc.name, func() {
 			suite.SetupWasmWithMockVM()
+			capturedChecksums = nil
 
 			ctx := suite.chainA.GetContext()
 			wasmClientKeeper := GetSimApp(suite.chainA).WasmClientKeeper
 
 			contracts := [][]byte{wasmtesting.Code, wasmtesting.CreateMockContract([]byte("gzipped-contract"))}
-			checksumIDs := make([]types.Checksum, len(contracts))
+			checksumIDs = make([]types.Checksum, len(contracts))
 			signer := authtypes.NewModuleAddress(govtypes.ModuleName).String()
 
 			// store contract on chain
@@ -436,6 +465,12 @@ func (suite *KeeperTestSuite) TestMigrateContract() {
 			if expPass {
 				suite.Require().NoError(err)
 				suite.Require().Equal(expClientState, clientState)
+
+				// the tracked client store size must account for the client state stored by the keeper
+				clientStore := suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(suite.chainA.GetContext(), endpointA.ClientID)
+				size, err := wasmClientKeeper.GetClientStoreSize(suite.chainA.GetContext(), endpointA.ClientID)
+				suite.Require().NoError(err)
+				suite.Require().Equal(storeSize(clientStore), size)
 			} else {
 				suite.Require().ErrorIs(err, tc.expErr)
 			}
@@ -443,6 +478,69 @@ func (suite *KeeperTestSuite) TestMigrateContract() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestResetClientStoreSize() {
+	var clientID string
+
+	dataKey := []byte("data")
+	data := []byte("untracked")
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expFound bool
+	}{
+		{
+			"success: tracked size is recomputed after an untracked write",
+			func() {
+				clientStore := suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(suite.chainA.GetContext(), clientID)
+				clientStore.Set(dataKey, data)
+			},
+			true,
+		},
+		{
+			"success: tracked size is removed when the client store no longer holds a wasm client state",
+			func() {
+				suite.chainA.App.GetIBCKeeper().ClientKeeper.SetClientState(suite.chainA.GetContext(), clientID, &ibctm.ClientState{})
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupWasmWithMockVM()
+			suite.storeWasmCode(wasmtesting.Code)
+
+			endpoint := wasmtesting.NewWasmEndpoint(suite.chainA)
+			err := endpoint.CreateClient()
+			suite.Require().NoError(err)
+			clientID = endpoint.ClientID
+
+			ctx := suite.chainA.GetContext()
+			wasmClientKeeper := GetSimApp(suite.chainA).WasmClientKeeper
+			clientStore := suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(ctx, clientID)
+			sizeBefore := storeSize(clientStore)
+
+			err = wasmClientKeeper.ResetClientStoreSize(ctx, clientID)
+			suite.Require().NoError(err)
+
+			tc.malleate()
+
+			err = wasmClientKeeper.ResetClientStoreSize(ctx, clientID)
+			suite.Require().NoError(err)
+
+			size, err := wasmClientKeeper.GetClientStoreSize(ctx, clientID)
+			suite.Require().NoError(err)
+			suite.Require().Equal(storeSize(clientStore), size)
+
+			if tc.expFound {
+				suite.Require().Equal(sizeBefore+uint64(len(dataKey)+len(data)), size)
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestGetChecksums() {
 	testCases := []struct {
 		name      string
@@ -52,12 +52,17 @@ func NewKeeperWithVM(
 	sb := collections.NewSchemaBuilder(storeService)
 
 	keeper := &Keeper{
-		cdc:          cdc,
-		vm:           vm,
-		checksums:    collections.NewKeySet(sb, types.ChecksumsKey, "checksums", collections.BytesKey),
-		storeService: storeService,
-		clientKeeper: clientKeeper,
-		authority:    authority,
+		cdc:               cdc,
+		vm:                vm,
+		checksums:         collections.NewKeySet(sb, types.ChecksumsKey, "checksums", collections.BytesKey),
+		checksumParams:    collections.NewMap(sb, types.ChecksumParamsKey, "checksum_params", collections.BytesKey, codec.CollValue[types.ChecksumParams](cdc)),
+		clientStoreSizes:  collections.NewMap(sb, types.ClientStoreSizesKey, "client_store_sizes", collections.StringKey, collections.Uint64Value),
+		approvedChecksums: collections.NewMap(sb, types.ApprovedChecksumsKey, "approved_checksums", collections.BytesKey, codec.CollValue[types.ApprovedChecksum](cdc)),
+		contractSnapshots: collections.NewMap(sb, types.ContractSnapshotsKey, "contract_snapshots", collections.StringKey, codec.CollValue[types.ContractSnapshot](cdc)),
+		pinnedChecksums:   collections.NewKeySet(sb, types.PinnedChecksumsKey, "pinned_checksums", collections.BytesKey),
+		storeService:      storeService,
+		clientKeeper:      clientKeeper,
+		authority:         authority,
 	}
 
 	_, err := sb.Build()
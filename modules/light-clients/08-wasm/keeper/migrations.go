@@ -45,6 +45,27 @@ func (m Migrator) MigrateChecksums(ctx sdk.Context) error {
 	return nil
 }
 
+// MigratePinnedChecksums marks all stored checksums as pinned.
+//
+// Before pinned codes were tracked in state, every stored code was pinned
+// to the vm in-memory cache. Marking all of them as pinned preserves this
+// behaviour across restarts of the node.
+func (m Migrator) MigratePinnedChecksums(ctx sdk.Context) error {
+	checksums, err := m.keeper.GetAllChecksums(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, checksum := range checksums {
+		if err := m.keeper.pinnedChecksums.Set(ctx, checksum); err != nil {
+			return err
+		}
+	}
+
+	m.keeper.Logger(ctx).Info("successfully migrated pinned checksums")
+	return nil
+}
+
 // getStoredChecksums returns the checksums stored under the KeyChecksums key.
 func (m Migrator) getStoredChecksums(ctx sdk.Context) ([][]byte, error) {
 	store := m.keeper.storeService.OpenKVStore(ctx)
@@ -46,6 +46,50 @@ func (suite *KeeperTestSuite) TestMigrateWasmStore() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestMigratePinnedChecksums() {
+	testCases := []struct {
+		name      string
+		checksums [][]byte
+	}{
+		{
+			"success: empty checksums",
+			[][]byte{},
+		},
+		{
+			"success: multiple checksums",
+			[][]byte{[]byte("hash1"), []byte("hash2"), []byte("hash3")},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			wasmClientKeeper := GetSimApp(suite.chainA).WasmClientKeeper
+			for _, hash := range tc.checksums {
+				err := wasmClientKeeper.GetChecksums().Set(suite.chainA.GetContext(), hash)
+				suite.Require().NoError(err)
+			}
+
+			// run the migration
+			m := keeper.NewMigrator(wasmClientKeeper)
+
+			err := m.MigratePinnedChecksums(suite.chainA.GetContext())
+			suite.Require().NoError(err)
+
+			// check that all stored checksums are marked as pinned
+			pinnedChecksums, err := wasmClientKeeper.GetAllPinnedChecksums(suite.chainA.GetContext())
+			suite.Require().NoError(err)
+			suite.Require().Len(pinnedChecksums, len(tc.checksums))
+
+			for _, hash := range tc.checksums {
+				suite.Require().True(wasmClientKeeper.IsPinnedCode(suite.chainA.GetContext(), hash))
+			}
+		})
+	}
+}
+
 // storeChecksums stores the given checksums under the KeyChecksums key, it runs
 // each time on an empty store so we don't need to read the previous checksums.
 func (suite *KeeperTestSuite) storeChecksums(checksums [][]byte) {
@@ -14,18 +14,22 @@ import (
 
 var _ types.MsgServer = (*Keeper)(nil)
 
-// StoreCode defines a rpc handler method for MsgStoreCode
+// StoreCode defines a rpc handler method for MsgStoreCode. The authority may store any wasm byte code,
+// while other signers may only store wasm byte code whose checksum has been approved with MsgApproveChecksum.
 func (k Keeper) StoreCode(goCtx context.Context, msg *types.MsgStoreCode) (*types.MsgStoreCodeResponse, error) {
-	if k.GetAuthority() != msg.Signer {
-		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Signer)
-	}
-
 	ctx := sdk.UnwrapSDKContext(goCtx)
-	checksum, err := k.storeWasmCode(ctx, msg.WasmByteCode, k.GetVM().StoreCode)
+	checksum, err := k.storeWasmCode(ctx, msg.Signer, msg.WasmByteCode, k.GetVM().StoreCode)
 	if err != nil {
 		return nil, errorsmod.Wrap(err, "failed to store wasm bytecode")
 	}
 
+	// pin the code to the vm in-memory cache
+	if err := k.pinCode(ctx, checksum); err != nil {
+		return nil, err
+	}
+
+	k.ReportCacheMetrics(ctx)
+
 	emitStoreWasmCodeEvent(ctx, checksum)
 
 	return &types.MsgStoreCodeResponse{
@@ -48,11 +52,18 @@ func (k Keeper) RemoveChecksum(goCtx context.Context, msg *types.MsgRemoveChecks
 		return nil, errorsmod.Wrap(err, "failed to remove checksum")
 	}
 
+	if err := k.checksumParams.Remove(goCtx, msg.Checksum); err != nil {
+		return nil, errorsmod.Wrap(err, "failed to remove checksum params")
+	}
+
 	// unpin the code from the vm in-memory cache
-	if err := k.GetVM().Unpin(msg.Checksum); err != nil {
-		return nil, errorsmod.Wrapf(err, "failed to unpin contract with checksum (%s) from vm cache", hex.EncodeToString(msg.Checksum))
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.unpinCode(ctx, msg.Checksum); err != nil {
+		return nil, err
 	}
 
+	k.ReportCacheMetrics(ctx)
+
 	return &types.MsgRemoveChecksumResponse{}, nil
 }
 
@@ -69,7 +80,123 @@ func (k Keeper) MigrateContract(goCtx context.Context, msg *types.MsgMigrateCont
 		return nil, errorsmod.Wrap(err, "failed to migrate contract")
 	}
 
+	k.ReportCacheMetrics(ctx)
+
 	// event emission is handled in migrateContractCode
 
 	return &types.MsgMigrateContractResponse{}, nil
 }
+
+// UpdateChecksumParams defines a rpc handler method for MsgUpdateChecksumParams
+func (k Keeper) UpdateChecksumParams(goCtx context.Context, msg *types.MsgUpdateChecksumParams) (*types.MsgUpdateChecksumParamsResponse, error) {
+	if k.GetAuthority() != msg.Signer {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Signer)
+	}
+
+	if !k.HasChecksum(goCtx, msg.Params.Checksum) {
+		return nil, types.ErrWasmChecksumNotFound
+	}
+
+	if err := k.SetChecksumParams(goCtx, msg.Params); err != nil {
+		return nil, errorsmod.Wrap(err, "failed to set checksum params")
+	}
+
+	return &types.MsgUpdateChecksumParamsResponse{}, nil
+}
+
+// ApproveChecksum defines a rpc handler method for MsgApproveChecksum
+func (k Keeper) ApproveChecksum(goCtx context.Context, msg *types.MsgApproveChecksum) (*types.MsgApproveChecksumResponse, error) {
+	if k.GetAuthority() != msg.Signer {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Signer)
+	}
+
+	if k.HasChecksum(goCtx, msg.Checksum) {
+		return nil, types.ErrWasmCodeExists
+	}
+
+	if err := k.SetApprovedChecksum(goCtx, types.NewApprovedChecksum(msg.Checksum, msg.Uploader)); err != nil {
+		return nil, errorsmod.Wrap(err, "failed to approve checksum")
+	}
+
+	emitApproveChecksumEvent(sdk.UnwrapSDKContext(goCtx), msg.Checksum, msg.Uploader)
+
+	return &types.MsgApproveChecksumResponse{}, nil
+}
+
+// RollbackContract defines a rpc handler method for MsgRollbackContract
+func (k Keeper) RollbackContract(goCtx context.Context, msg *types.MsgRollbackContract) (*types.MsgRollbackContractResponse, error) {
+	if k.GetAuthority() != msg.Signer {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Signer)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	checksum, err := k.rollbackContract(ctx, msg.ClientId)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to rollback contract")
+	}
+
+	k.ReportCacheMetrics(ctx)
+
+	// event emission is handled in rollbackContract
+
+	return &types.MsgRollbackContractResponse{
+		Checksum: checksum,
+	}, nil
+}
+
+// PinCodes defines a rpc handler method for MsgPinCodes
+func (k Keeper) PinCodes(goCtx context.Context, msg *types.MsgPinCodes) (*types.MsgPinCodesResponse, error) {
+	if k.GetAuthority() != msg.Signer {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Signer)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// all checksums are validated before any of them is pinned in the vm, as the vm cache is not reverted on failure
+	for _, checksum := range msg.Checksums {
+		if !k.HasChecksum(ctx, checksum) {
+			return nil, errorsmod.Wrapf(types.ErrWasmChecksumNotFound, "checksum %s", hex.EncodeToString(checksum))
+		}
+	}
+
+	for _, checksum := range msg.Checksums {
+		if err := k.pinCode(ctx, checksum); err != nil {
+			return nil, err
+		}
+
+		emitPinCodeEvent(ctx, types.EventTypePinCode, checksum)
+	}
+
+	k.ReportCacheMetrics(ctx)
+
+	return &types.MsgPinCodesResponse{}, nil
+}
+
+// UnpinCodes defines a rpc handler method for MsgUnpinCodes
+func (k Keeper) UnpinCodes(goCtx context.Context, msg *types.MsgUnpinCodes) (*types.MsgUnpinCodesResponse, error) {
+	if k.GetAuthority() != msg.Signer {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Signer)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// all checksums are validated before any of them is unpinned in the vm, as the vm cache is not reverted on failure
+	for _, checksum := range msg.Checksums {
+		if !k.HasChecksum(ctx, checksum) {
+			return nil, errorsmod.Wrapf(types.ErrWasmChecksumNotFound, "checksum %s", hex.EncodeToString(checksum))
+		}
+	}
+
+	for _, checksum := range msg.Checksums {
+		if err := k.unpinCode(ctx, checksum); err != nil {
+			return nil, err
+		}
+
+		emitPinCodeEvent(ctx, types.EventTypeUnpinCode, checksum)
+	}
+
+	k.ReportCacheMetrics(ctx)
+
+	return &types.MsgUnpinCodesResponse{}, nil
+}
@@ -71,10 +71,40 @@ func (suite *KeeperTestSuite) TestMsgStoreCode() {
 			types.ErrWasmCodeTooLarge,
 		},
 		{
-			"fails with unauthorized signer",
+			"success: non-authority signer with checksum approved for anyone",
 			func() {
 				signer = suite.chainA.SenderAccount.GetAddress().String()
 				msg = types.NewMsgStoreCode(signer, data)
+
+				suite.approveChecksum(data, "")
+			},
+			nil,
+		},
+		{
+			"success: non-authority signer with checksum approved for signer",
+			func() {
+				signer = suite.chainA.SenderAccount.GetAddress().String()
+				msg = types.NewMsgStoreCode(signer, data)
+
+				suite.approveChecksum(data, signer)
+			},
+			nil,
+		},
+		{
+			"fails with non-authority signer and checksum not approved",
+			func() {
+				signer = suite.chainA.SenderAccount.GetAddress().String()
+				msg = types.NewMsgStoreCode(signer, data)
+			},
+			types.ErrWasmChecksumNotApproved,
+		},
+		{
+			"fails with non-authority signer and checksum approved for another uploader",
+			func() {
+				signer = suite.chainA.SenderAccount.GetAddress().String()
+				msg = types.NewMsgStoreCode(signer, data)
+
+				suite.approveChecksum(data, suite.chainA.SenderAccounts[1].SenderAccount.GetAddress().String())
 			},
 			ibcerrors.ErrUnauthorized,
 		},
@@ -109,6 +139,9 @@ func (suite *KeeperTestSuite) TestMsgStoreCode() {
 				suite.Require().NotNil(res)
 				suite.Require().NotEmpty(res.Checksum)
 
+				_, found := GetSimApp(suite.chainA).WasmClientKeeper.GetApprovedChecksum(ctx, res.Checksum)
+				suite.Require().False(found, "approval must be consumed once the code is stored")
+
 				// Verify events
 				expectedEvents := sdk.Events{
 					sdk.NewEvent(
@@ -294,6 +327,10 @@ func (suite *KeeperTestSuite) TestMsgMigrateContract() {
 
 				suite.Require().Equal(expClientState, clientState)
 
+				snapshot, found := GetSimApp(suite.chainA).WasmClientKeeper.GetContractSnapshot(ctx, defaultWasmClientID)
+				suite.Require().True(found)
+				suite.Require().Equal([]byte(oldChecksum), snapshot.Checksum)
+
 				// Verify events
 				expectedEvents := sdk.Events{
 					sdk.NewEvent(
@@ -402,6 +439,9 @@ func (suite *KeeperTestSuite) TestMsgRemoveChecksum() {
 			err := endpoint.CreateClient()
 			suite.Require().NoError(err)
 
+			err = GetSimApp(suite.chainA).WasmClientKeeper.SetChecksumParams(suite.chainA.GetContext(), types.NewChecksumParams(checksum, 1024, 10))
+			suite.Require().NoError(err)
+
 			tc.malleate()
 
 			ctx := suite.chainA.GetContext()
@@ -418,6 +458,10 @@ func (suite *KeeperTestSuite) TestMsgRemoveChecksum() {
 				// Check equality of checksums up to order
 				suite.Require().ElementsMatch(expChecksums, checksums)
 
+				// Check that checksum params have been removed
+				params := GetSimApp(suite.chainA).WasmClientKeeper.GetChecksumParams(suite.chainA.GetContext(), msg.Checksum)
+				suite.Require().Equal(types.NewChecksumParams(msg.Checksum, 0, 0), params)
+
 				// Verify events
 				suite.Require().Len(events, 0)
 			} else {
@@ -427,3 +471,463 @@ func (suite *KeeperTestSuite) TestMsgRemoveChecksum() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestMsgUpdateChecksumParams() {
+	checksum, err := types.CreateChecksum(wasmtesting.Code)
+	suite.Require().NoError(err)
+
+	govAcc := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	var msg *types.MsgUpdateChecksumParams
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success",
+			func() {
+				msg = types.NewMsgUpdateChecksumParams(govAcc, types.NewChecksumParams(checksum, 1024, 10))
+			},
+			nil,
+		},
+		{
+			"success: remove limits",
+			func() {
+				msg = types.NewMsgUpdateChecksumParams(govAcc, types.NewChecksumParams(checksum, 0, 0))
+			},
+			nil,
+		},
+		{
+			"failure: checksum is missing",
+			func() {
+				msg = types.NewMsgUpdateChecksumParams(govAcc, types.NewChecksumParams(make([]byte, 32), 1024, 10))
+			},
+			types.ErrWasmChecksumNotFound,
+		},
+		{
+			"failure: unauthorized signer",
+			func() {
+				msg = types.NewMsgUpdateChecksumParams(suite.chainA.SenderAccount.GetAddress().String(), types.NewChecksumParams(checksum, 1024, 10))
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupWasmWithMockVM()
+
+			_ = suite.storeWasmCode(wasmtesting.Code)
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			res, err := GetSimApp(suite.chainA).WasmClientKeeper.UpdateChecksumParams(ctx, msg)
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+
+				params := GetSimApp(suite.chainA).WasmClientKeeper.GetChecksumParams(ctx, checksum)
+				suite.Require().Equal(msg.Params, params)
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+				suite.Require().Nil(res)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgApproveChecksum() {
+	var (
+		msg      *types.MsgApproveChecksum
+		checksum types.Checksum
+	)
+
+	authority := GetSimApp(suite.chainA).WasmClientKeeper.GetAuthority()
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success: approved for anyone",
+			func() {
+				msg = types.NewMsgApproveChecksum(authority, checksum, "")
+			},
+			nil,
+		},
+		{
+			"success: approved for uploader",
+			func() {
+				msg = types.NewMsgApproveChecksum(authority, checksum, suite.chainA.SenderAccount.GetAddress().String())
+			},
+			nil,
+		},
+		{
+			"success: overwrite existing approval",
+			func() {
+				suite.approveChecksum(wasmtesting.Code, "")
+
+				msg = types.NewMsgApproveChecksum(authority, checksum, suite.chainA.SenderAccount.GetAddress().String())
+			},
+			nil,
+		},
+		{
+			"failure: unauthorized signer",
+			func() {
+				msg = types.NewMsgApproveChecksum(suite.chainA.SenderAccount.GetAddress().String(), checksum, "")
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"failure: checksum already stored",
+			func() {
+				suite.storeWasmCode(wasmtesting.Code)
+
+				msg = types.NewMsgApproveChecksum(authority, checksum, "")
+			},
+			types.ErrWasmCodeExists,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupWasmWithMockVM()
+
+			var err error
+			checksum, err = types.CreateChecksum(wasmtesting.Code)
+			suite.Require().NoError(err)
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			res, err := GetSimApp(suite.chainA).WasmClientKeeper.ApproveChecksum(ctx, msg)
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+
+				approvedChecksum, found := GetSimApp(suite.chainA).WasmClientKeeper.GetApprovedChecksum(ctx, checksum)
+				suite.Require().True(found)
+				suite.Require().Equal(types.NewApprovedChecksum(checksum, msg.Uploader), approvedChecksum)
+
+				expectedEvent := sdk.NewEvent(
+					types.EventTypeApproveChecksum,
+					sdk.NewAttribute(types.AttributeKeyWasmChecksum, hex.EncodeToString(checksum)),
+					sdk.NewAttribute(types.AttributeKeyUploader, msg.Uploader),
+				)
+				suite.Require().Contains(ctx.EventManager().Events(), expectedEvent)
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+				suite.Require().Nil(res)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgRollbackContract() {
+	var (
+		msg                 *types.MsgRollbackContract
+		oldChecksum         []byte
+		newChecksum         []byte
+		expClientStoreValue []byte
+	)
+
+	govAcc := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	newByteCode := wasmtesting.CreateMockContract([]byte("MockByteCode-TestMsgRollbackContract"))
+	customKey := []byte("custom-key")
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success",
+			func() {
+				msg = types.NewMsgRollbackContract(govAcc, defaultWasmClientID)
+			},
+			nil,
+		},
+		{
+			"failure: unauthorized signer",
+			func() {
+				msg = types.NewMsgRollbackContract(suite.chainA.SenderAccount.GetAddress().String(), defaultWasmClientID)
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"failure: no contract snapshot for client",
+			func() {
+				msg = types.NewMsgRollbackContract(govAcc, "08-wasm-100")
+			},
+			types.ErrContractSnapshotNotFound,
+		},
+		{
+			"failure: checksum of snapshot has been removed",
+			func() {
+				msg = types.NewMsgRollbackContract(govAcc, defaultWasmClientID)
+
+				err := GetSimApp(suite.chainA).WasmClientKeeper.GetChecksums().Remove(suite.chainA.GetContext(), oldChecksum)
+				suite.Require().NoError(err)
+			},
+			types.ErrWasmChecksumNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupWasmWithMockVM()
+
+			oldChecksum = suite.storeWasmCode(wasmtesting.Code)
+			newChecksum = suite.storeWasmCode(newByteCode)
+
+			endpoint := wasmtesting.NewWasmEndpoint(suite.chainA)
+			err := endpoint.CreateClient()
+			suite.Require().NoError(err)
+
+			expClientStoreValue = []byte("before migration")
+			clientStore := GetSimApp(suite.chainA).IBCKeeper.ClientKeeper.ClientStore(suite.chainA.GetContext(), defaultWasmClientID)
+			clientStore.Set(customKey, expClientStoreValue)
+
+			// the migration overwrites an existing entry and adds a new one
+			suite.mockVM.MigrateFn = func(_ wasmvm.Checksum, _ wasmvmtypes.Env, _ []byte, store wasmvm.KVStore, _ wasmvm.GoAPI, _ wasmvm.Querier, _ wasmvm.GasMeter, _ uint64, _ wasmvmtypes.UFraction) (*wasmvmtypes.ContractResult, uint64, error) {
+				store.Set(customKey, []byte("after migration"))
+				store.Set([]byte("new-key"), []byte("new value"))
+
+				data, err := json.Marshal(types.EmptyResult{})
+				suite.Require().NoError(err)
+
+				return &wasmvmtypes.ContractResult{Ok: &wasmvmtypes.Response{Data: data}}, wasmtesting.DefaultGasUsed, nil
+			}
+
+			_, err = GetSimApp(suite.chainA).WasmClientKeeper.MigrateContract(suite.chainA.GetContext(), types.NewMsgMigrateContract(govAcc, defaultWasmClientID, newChecksum, []byte("{}")))
+			suite.Require().NoError(err)
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			res, err := GetSimApp(suite.chainA).WasmClientKeeper.RollbackContract(ctx, msg)
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+				suite.Require().Equal(oldChecksum, res.Checksum)
+
+				clientState, ok := endpoint.GetClientState().(*types.ClientState)
+				suite.Require().True(ok)
+				suite.Require().Equal(oldChecksum, clientState.Checksum)
+
+				clientStore := GetSimApp(suite.chainA).IBCKeeper.ClientKeeper.ClientStore(ctx, defaultWasmClientID)
+				suite.Require().Equal(expClientStoreValue, clientStore.Get(customKey))
+				suite.Require().False(clientStore.Has([]byte("new-key")))
+
+				_, found := GetSimApp(suite.chainA).WasmClientKeeper.GetContractSnapshot(ctx, defaultWasmClientID)
+				suite.Require().False(found, "snapshot must be consumed by the rollback")
+
+				expectedEvent := sdk.NewEvent(
+					types.EventTypeRollbackContract,
+					sdk.NewAttribute(types.AttributeKeyClientID, defaultWasmClientID),
+					sdk.NewAttribute(types.AttributeKeyWasmChecksum, hex.EncodeToString(newChecksum)),
+					sdk.NewAttribute(types.AttributeKeyNewChecksum, hex.EncodeToString(oldChecksum)),
+				)
+				suite.Require().Contains(ctx.EventManager().Events(), expectedEvent)
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+				suite.Require().Nil(res)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgPinCodes() {
+	var (
+		msg      *types.MsgPinCodes
+		checksum []byte
+	)
+
+	govAcc := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success",
+			func() {
+				msg = types.NewMsgPinCodes(govAcc, [][]byte{checksum})
+			},
+			nil,
+		},
+		{
+			"failure: unauthorized signer",
+			func() {
+				msg = types.NewMsgPinCodes(suite.chainA.SenderAccount.GetAddress().String(), [][]byte{checksum})
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"failure: checksum is missing",
+			func() {
+				msg = types.NewMsgPinCodes(govAcc, [][]byte{{1}})
+			},
+			types.ErrWasmChecksumNotFound,
+		},
+		{
+			"failure: checksum is missing after a stored checksum",
+			func() {
+				msg = types.NewMsgPinCodes(govAcc, [][]byte{checksum, {1}})
+
+				// all checksums must be validated before any code is pinned in the vm
+				suite.mockVM.PinFn = func(_ wasmvm.Checksum) error {
+					suite.Require().FailNow("vm must not be called")
+					return nil
+				}
+			},
+			types.ErrWasmChecksumNotFound,
+		},
+		{
+			"failure: code could not be pinned",
+			func() {
+				msg = types.NewMsgPinCodes(govAcc, [][]byte{checksum})
+
+				suite.mockVM.PinFn = func(_ wasmvm.Checksum) error {
+					return wasmtesting.ErrMockVM
+				}
+			},
+			wasmtesting.ErrMockVM,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupWasmWithMockVM()
+
+			checksum = suite.storeWasmCode(wasmtesting.Code)
+
+			wasmClientKeeper := GetSimApp(suite.chainA).WasmClientKeeper
+			_, err := wasmClientKeeper.UnpinCodes(suite.chainA.GetContext(), types.NewMsgUnpinCodes(govAcc, [][]byte{checksum}))
+			suite.Require().NoError(err)
+			suite.Require().False(wasmClientKeeper.IsPinnedCode(suite.chainA.GetContext(), checksum))
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			res, err := wasmClientKeeper.PinCodes(ctx, msg)
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().True(wasmClientKeeper.IsPinnedCode(ctx, checksum))
+
+				expectedEvent := sdk.NewEvent(
+					types.EventTypePinCode,
+					sdk.NewAttribute(types.AttributeKeyWasmChecksum, hex.EncodeToString(checksum)),
+				)
+				suite.Require().Contains(ctx.EventManager().Events(), expectedEvent)
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+				suite.Require().Nil(res)
+				suite.Require().False(wasmClientKeeper.IsPinnedCode(ctx, checksum))
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgUnpinCodes() {
+	var (
+		msg      *types.MsgUnpinCodes
+		checksum []byte
+	)
+
+	govAcc := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success",
+			func() {
+				msg = types.NewMsgUnpinCodes(govAcc, [][]byte{checksum})
+			},
+			nil,
+		},
+		{
+			"failure: unauthorized signer",
+			func() {
+				msg = types.NewMsgUnpinCodes(suite.chainA.SenderAccount.GetAddress().String(), [][]byte{checksum})
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"failure: checksum is missing",
+			func() {
+				msg = types.NewMsgUnpinCodes(govAcc, [][]byte{{1}})
+			},
+			types.ErrWasmChecksumNotFound,
+		},
+		{
+			"failure: checksum is missing after a stored checksum",
+			func() {
+				msg = types.NewMsgUnpinCodes(govAcc, [][]byte{checksum, {1}})
+
+				// all checksums must be validated before any code is unpinned in the vm
+				suite.mockVM.UnpinFn = func(_ wasmvm.Checksum) error {
+					suite.Require().FailNow("vm must not be called")
+					return nil
+				}
+			},
+			types.ErrWasmChecksumNotFound,
+		},
+		{
+			"failure: code could not be unpinned",
+			func() {
+				msg = types.NewMsgUnpinCodes(govAcc, [][]byte{checksum})
+
+				suite.mockVM.UnpinFn = func(_ wasmvm.Checksum) error {
+					return wasmtesting.ErrMockVM
+				}
+			},
+			wasmtesting.ErrMockVM,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupWasmWithMockVM()
+
+			checksum = suite.storeWasmCode(wasmtesting.Code)
+
+			wasmClientKeeper := GetSimApp(suite.chainA).WasmClientKeeper
+			suite.Require().True(wasmClientKeeper.IsPinnedCode(suite.chainA.GetContext(), checksum))
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			res, err := wasmClientKeeper.UnpinCodes(ctx, msg)
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().False(wasmClientKeeper.IsPinnedCode(ctx, checksum))
+				suite.Require().True(wasmClientKeeper.HasChecksum(ctx, checksum), "unpinning must not remove the code")
+
+				expectedEvent := sdk.NewEvent(
+					types.EventTypeUnpinCode,
+					sdk.NewAttribute(types.AttributeKeyWasmChecksum, hex.EncodeToString(checksum)),
+				)
+				suite.Require().Contains(ctx.EventManager().Events(), expectedEvent)
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+				suite.Require().Nil(res)
+				suite.Require().True(wasmClientKeeper.IsPinnedCode(ctx, checksum))
+			}
+		})
+	}
+}
@@ -3,6 +3,7 @@ package keeper
 import (
 	"encoding/json"
 	"fmt"
+	"math"
 	"slices"
 
 	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
@@ -15,6 +16,9 @@ import (
 	abci "github.com/cometbft/cometbft/abci/types"
 
 	"github.com/cosmos/ibc-go/modules/light-clients/08-wasm/types"
+	clienttypes "github.com/cosmos/ibc-go/v9/modules/core/02-client/types"
+	connectiontypes "github.com/cosmos/ibc-go/v9/modules/core/03-connection/types"
+	host "github.com/cosmos/ibc-go/v9/modules/core/24-host"
 )
 
 /*
@@ -31,6 +35,9 @@ This design is based on wasmd's (v0.50.0) querier plugin design.
 
 var _ wasmvmtypes.Querier = (*queryHandler)(nil)
 
+// IBCCoreQueryGasCost is the flat amount of gas charged for every query handled by the IBC core custom querier.
+const IBCCoreQueryGasCost uint64 = 1000
+
 // defaultAcceptList defines a set of default allowed queries made available to the Querier.
 var defaultAcceptList = []string{
 	"/ibc.core.client.v1.Query/VerifyMembership",
@@ -168,6 +175,84 @@ func RejectCustomQuerier() func(sdk.Context, json.RawMessage) ([]byte, error) {
 	}
 }
 
+// IBCCoreCustomQuerier returns a custom querier that gives contracts read-only access to IBC core state of the local chain:
+// the status of other light clients, the counterparty of connection ends and the height and timestamp history of the chain.
+// A flat gas cost of IBCCoreQueryGasCost is charged for every query on top of the gas consumed reading from the store.
+// This function returns JSON encoded responses in bytes.
+func IBCCoreCustomQuerier(clientKeeper types.ClientStatusKeeper, connectionKeeper types.ConnectionKeeper, historicalInfoKeeper types.HistoricalInfoKeeper) func(sdk.Context, json.RawMessage) ([]byte, error) {
+	return func(ctx sdk.Context, request json.RawMessage) ([]byte, error) {
+		ctx.GasMeter().ConsumeGas(IBCCoreQueryGasCost, "08-wasm IBC core custom query")
+
+		var query types.IBCCoreQuery
+		if err := json.Unmarshal(request, &query); err != nil {
+			return nil, wasmvmtypes.InvalidRequest{Err: err.Error(), Request: request}
+		}
+
+		switch {
+		case query.ClientStatus != nil:
+			if err := host.ClientIdentifierValidator(query.ClientStatus.ClientID); err != nil {
+				return nil, err
+			}
+
+			status := clientKeeper.GetClientStatus(ctx, query.ClientStatus.ClientID)
+			return json.Marshal(types.StatusResult{Status: status.String()})
+		case query.ConnectionCounterpartyPrefix != nil:
+			if err := host.ConnectionIdentifierValidator(query.ConnectionCounterpartyPrefix.ConnectionID); err != nil {
+				return nil, err
+			}
+
+			connection, found := connectionKeeper.GetConnection(ctx, query.ConnectionCounterpartyPrefix.ConnectionID)
+			if !found {
+				return nil, errorsmod.Wrap(connectiontypes.ErrConnectionNotFound, query.ConnectionCounterpartyPrefix.ConnectionID)
+			}
+
+			return json.Marshal(types.ConnectionCounterpartyPrefixResult{
+				ClientID:     connection.Counterparty.ClientId,
+				ConnectionID: connection.Counterparty.ConnectionId,
+				KeyPrefix:    connection.Counterparty.Prefix.KeyPrefix,
+			})
+		case query.ChainHeight != nil:
+			return json.Marshal(types.ChainHeightResult{
+				Height:    clienttypes.GetSelfHeight(ctx),
+				Timestamp: uint64(ctx.BlockTime().UnixNano()),
+			})
+		case query.ChainTimestampAtHeight != nil:
+			timestamp, err := chainTimestampAtHeight(ctx, historicalInfoKeeper, query.ChainTimestampAtHeight.Height)
+			if err != nil {
+				return nil, err
+			}
+
+			return json.Marshal(types.TimestampAtHeightResult{Timestamp: timestamp})
+		default:
+			return nil, wasmvmtypes.UnsupportedRequest{Kind: "Unsupported IBC core custom query"}
+		}
+	}
+}
+
+// chainTimestampAtHeight returns the block timestamp of the local chain at the provided height. The timestamp of the
+// current height is read from the context, while the timestamps of past heights are read from the historical info.
+func chainTimestampAtHeight(ctx sdk.Context, historicalInfoKeeper types.HistoricalInfoKeeper, height clienttypes.Height) (uint64, error) {
+	selfHeight := clienttypes.GetSelfHeight(ctx)
+	if height.RevisionNumber != selfHeight.RevisionNumber {
+		return 0, errorsmod.Wrapf(clienttypes.ErrInvalidHeight, "revision number must match the revision number of the chain (%d), got %d", selfHeight.RevisionNumber, height.RevisionNumber)
+	}
+
+	if height.EQ(selfHeight) {
+		return uint64(ctx.BlockTime().UnixNano()), nil
+	}
+
+	if height.GT(selfHeight) || height.RevisionHeight > math.MaxInt64 {
+		return 0, errorsmod.Wrapf(clienttypes.ErrInvalidHeight, "height (%s) must not be greater than the current height of the chain (%s)", height, selfHeight)
+	}
+
+	historicalInfo, err := historicalInfoKeeper.GetHistoricalInfo(ctx, int64(height.RevisionHeight))
+	if err != nil {
+		return 0, errorsmod.Wrapf(clienttypes.ErrInvalidHeight, "historical info not found for height %s: %s", height, err)
+	}
+
+	return uint64(historicalInfo.Header.Time.UnixNano()), nil
+}
+
 // Wasmd Issue [#759](https://github.com/CosmWasm/wasmd/issues/759)
 // Don't return error string for worries of non-determinism
 func redactError(err error) error {
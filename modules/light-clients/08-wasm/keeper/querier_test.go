@@ -15,8 +15,11 @@ import (
 	wasmtesting "github.com/cosmos/ibc-go/modules/light-clients/08-wasm/testing"
 	"github.com/cosmos/ibc-go/modules/light-clients/08-wasm/types"
 	clienttypes "github.com/cosmos/ibc-go/v9/modules/core/02-client/types"
+	connectiontypes "github.com/cosmos/ibc-go/v9/modules/core/03-connection/types"
 	commitmenttypes "github.com/cosmos/ibc-go/v9/modules/core/23-commitment/types"
+	host "github.com/cosmos/ibc-go/v9/modules/core/24-host"
 	"github.com/cosmos/ibc-go/v9/modules/core/exported"
+	ibctesting "github.com/cosmos/ibc-go/v9/testing"
 )
 
 type CustomQuery struct {
@@ -352,3 +355,172 @@ func (suite *KeeperTestSuite) TestStargateQuery() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestIBCCoreCustomQuery() {
+	var query types.IBCCoreQuery
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expResp  func() any
+		expError error
+	}{
+		{
+			"success: client status",
+			func() {
+				query = types.IBCCoreQuery{ClientStatus: &types.ClientStatusQuery{ClientID: defaultWasmClientID}}
+			},
+			func() any {
+				return types.StatusResult{Status: exported.Active.String()}
+			},
+			nil,
+		},
+		{
+			"success: client status of unknown client",
+			func() {
+				query = types.IBCCoreQuery{ClientStatus: &types.ClientStatusQuery{ClientID: ibctesting.FirstClientID}}
+			},
+			func() any {
+				return types.StatusResult{Status: exported.Unknown.String()}
+			},
+			nil,
+		},
+		{
+			"success: connection counterparty prefix",
+			func() {
+				counterparty := connectiontypes.NewCounterparty(ibctesting.FirstClientID, ibctesting.FirstConnectionID, commitmenttypes.NewMerklePrefix([]byte(exported.StoreKey)))
+				connection := connectiontypes.NewConnectionEnd(connectiontypes.OPEN, defaultWasmClientID, counterparty, connectiontypes.GetCompatibleVersions(), 0)
+				GetSimApp(suite.chainA).IBCKeeper.ConnectionKeeper.SetConnection(suite.chainA.GetContext(), ibctesting.FirstConnectionID, connection)
+
+				query = types.IBCCoreQuery{ConnectionCounterpartyPrefix: &types.ConnectionCounterpartyPrefixQuery{ConnectionID: ibctesting.FirstConnectionID}}
+			},
+			func() any {
+				return types.ConnectionCounterpartyPrefixResult{
+					ClientID:     ibctesting.FirstClientID,
+					ConnectionID: ibctesting.FirstConnectionID,
+					KeyPrefix:    []byte(exported.StoreKey),
+				}
+			},
+			nil,
+		},
+		{
+			"success: chain height",
+			func() {
+				query = types.IBCCoreQuery{ChainHeight: &types.ChainHeightQuery{}}
+			},
+			func() any {
+				ctx := suite.chainA.GetContext()
+				return types.ChainHeightResult{
+					Height:    clienttypes.GetSelfHeight(ctx),
+					Timestamp: uint64(ctx.BlockTime().UnixNano()),
+				}
+			},
+			nil,
+		},
+		{
+			"success: chain timestamp at current height",
+			func() {
+				query = types.IBCCoreQuery{ChainTimestampAtHeight: &types.ChainTimestampAtHeightQuery{Height: clienttypes.GetSelfHeight(suite.chainA.GetContext())}}
+			},
+			func() any {
+				return types.TimestampAtHeightResult{Timestamp: uint64(suite.chainA.GetContext().BlockTime().UnixNano())}
+			},
+			nil,
+		},
+		{
+			"success: chain timestamp at past height",
+			func() {
+				suite.coordinator.CommitNBlocks(suite.chainA, 2)
+
+				height := clienttypes.GetSelfHeight(suite.chainA.GetContext())
+				height.RevisionHeight--
+				query = types.IBCCoreQuery{ChainTimestampAtHeight: &types.ChainTimestampAtHeightQuery{Height: height}}
+			},
+			func() any {
+				ctx := suite.chainA.GetContext()
+				historicalInfo, err := GetSimApp(suite.chainA).StakingKeeper.GetHistoricalInfo(ctx, ctx.BlockHeight()-1)
+				suite.Require().NoError(err)
+
+				return types.TimestampAtHeightResult{Timestamp: uint64(historicalInfo.Header.Time.UnixNano())}
+			},
+			nil,
+		},
+		{
+			"failure: chain timestamp at future height",
+			func() {
+				height := clienttypes.GetSelfHeight(suite.chainA.GetContext()).Increment().(clienttypes.Height)
+				query = types.IBCCoreQuery{ChainTimestampAtHeight: &types.ChainTimestampAtHeightQuery{Height: height}}
+			},
+			nil,
+			clienttypes.ErrInvalidHeight,
+		},
+		{
+			"failure: chain timestamp at height with different revision number",
+			func() {
+				query = types.IBCCoreQuery{ChainTimestampAtHeight: &types.ChainTimestampAtHeightQuery{Height: clienttypes.NewHeight(100, 1)}}
+			},
+			nil,
+			clienttypes.ErrInvalidHeight,
+		},
+		{
+			"failure: connection not found",
+			func() {
+				query = types.IBCCoreQuery{ConnectionCounterpartyPrefix: &types.ConnectionCounterpartyPrefixQuery{ConnectionID: ibctesting.FirstConnectionID}}
+			},
+			nil,
+			connectiontypes.ErrConnectionNotFound,
+		},
+		{
+			"failure: empty client identifier",
+			func() {
+				query = types.IBCCoreQuery{ClientStatus: &types.ClientStatusQuery{ClientID: ""}}
+			},
+			nil,
+			host.ErrInvalidID,
+		},
+		{
+			"failure: empty query",
+			func() {
+				query = types.IBCCoreQuery{}
+			},
+			nil,
+			wasmvmtypes.UnsupportedRequest{Kind: "Unsupported IBC core custom query"},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupWasmWithMockVM()
+			_ = suite.storeWasmCode(wasmtesting.Code)
+
+			endpoint := wasmtesting.NewWasmEndpoint(suite.chainA)
+			err := endpoint.CreateClient()
+			suite.Require().NoError(err)
+
+			tc.malleate()
+
+			simApp := GetSimApp(suite.chainA)
+			querier := keeper.IBCCoreCustomQuerier(simApp.IBCKeeper.ClientKeeper, simApp.IBCKeeper.ConnectionKeeper, simApp.StakingKeeper)
+
+			queryBz, err := json.Marshal(query)
+			suite.Require().NoError(err)
+
+			ctx := suite.chainA.GetContext()
+			gasBefore := ctx.GasMeter().GasConsumed()
+
+			res, err := querier(ctx, queryBz)
+			suite.Require().GreaterOrEqual(ctx.GasMeter().GasConsumed()-gasBefore, keeper.IBCCoreQueryGasCost)
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+
+				expRes, err := json.Marshal(tc.expResp())
+				suite.Require().NoError(err)
+				suite.Require().Equal(expRes, res)
+			} else {
+				suite.Require().Nil(res)
+				suite.Require().ErrorIs(err, tc.expError)
+			}
+		})
+	}
+}
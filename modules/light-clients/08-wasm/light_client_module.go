@@ -16,11 +16,16 @@ import (
 	"github.com/cosmos/ibc-go/modules/light-clients/08-wasm/types"
 	clienttypes "github.com/cosmos/ibc-go/v9/modules/core/02-client/types"
 	commitmenttypesv2 "github.com/cosmos/ibc-go/v9/modules/core/23-commitment/types/v2"
+	host "github.com/cosmos/ibc-go/v9/modules/core/24-host"
 	ibcerrors "github.com/cosmos/ibc-go/v9/modules/core/errors"
 	"github.com/cosmos/ibc-go/v9/modules/core/exported"
 )
 
-var _ exported.LightClientModule = (*LightClientModule)(nil)
+var (
+	_ exported.LightClientModule                    = (*LightClientModule)(nil)
+	_ exported.ChainIDLightClientModule             = (*LightClientModule)(nil)
+	_ exported.ClientStoreReplacedLightClientModule = (*LightClientModule)(nil)
+)
 
 // LightClientModule implements the core IBC api.LightClientModule interface.
 type LightClientModule struct {
@@ -400,20 +405,40 @@ func (l LightClientModule) TimestampAtHeight(ctx context.Context, clientID strin
 	return result.Timestamp, nil
 }
 
-// RecoverClient asserts that the substitute client is a wasm client. It obtains the client state associated with the
-// subject client and calls into the appropriate contract endpoint.
-// It will verify that a substitute client state is valid and update the subject client state.
-// Note that this method is used only for recovery and will not allow changes to the checksum.
-func (l LightClientModule) RecoverClient(ctx context.Context, clientID, substituteClientID string) error {
-	substituteClientType, _, err := clienttypes.ParseClientIdentifier(substituteClientID)
+// ChainID obtains the client state associated with the client identifier and calls into the appropriate contract endpoint.
+// It returns the chain identifier of the counterparty chain tracked by the wasm client.
+func (l LightClientModule) ChainID(ctx context.Context, clientID string) (string, error) {
+	clientStore := l.storeProvider.ClientStore(ctx, clientID)
+	cdc := l.keeper.Codec()
+
+	clientState, found := types.GetClientState(clientStore, cdc)
+	if !found {
+		return "", errorsmod.Wrap(clienttypes.ErrClientNotFound, clientID)
+	}
+
+	payload := types.QueryMsg{ChainID: &types.ChainIDMsg{}}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx) // TODO: https://github.com/cosmos/ibc-go/issues/5917
+	res, err := l.keeper.WasmQuery(sdkCtx, clientID, clientStore, clientState, payload)
 	if err != nil {
-		return err
+		return "", err
 	}
 
-	if substituteClientType != types.Wasm {
-		return errorsmod.Wrapf(clienttypes.ErrInvalidClientType, "expected: %s, got: %s", types.Wasm, substituteClientType)
+	var result types.ChainIDResult
+	if err := json.Unmarshal(res, &result); err != nil {
+		return "", errorsmod.Wrapf(types.ErrWasmInvalidResponseData, "failed to unmarshal result of wasm query: %v", err)
 	}
 
+	return result.ChainID, nil
+}
+
+// RecoverClient asserts that the substitute client is a wasm client. It obtains the client state associated with the
+// subject client and calls into the appropriate contract endpoint.
+// It will verify that a substitute client state is valid and update the subject client state.
+// Note that this method is used only for recovery and will not allow changes to the checksum.
+// The client type of the substitute is asserted from its stored client state rather than parsed from its identifier,
+// as the substitute client may have been migrated from a different client type.
+func (l LightClientModule) RecoverClient(ctx context.Context, clientID, substituteClientID string) error {
 	cdc := l.keeper.Codec()
 
 	subjectClientStore := l.storeProvider.ClientStore(ctx, clientID)
@@ -423,11 +448,17 @@ func (l LightClientModule) RecoverClient(ctx context.Context, clientID, substitu
 	}
 
 	substituteClientStore := l.storeProvider.ClientStore(ctx, substituteClientID)
-	substituteClientState, found := types.GetClientState(substituteClientStore, cdc)
-	if !found {
+	bz := substituteClientStore.Get(host.ClientStateKey())
+	if len(bz) == 0 {
 		return errorsmod.Wrap(clienttypes.ErrClientNotFound, substituteClientID)
 	}
 
+	substituteClient := clienttypes.MustUnmarshalClientState(cdc, bz)
+	substituteClientState, ok := substituteClient.(*types.ClientState)
+	if !ok {
+		return errorsmod.Wrapf(clienttypes.ErrInvalidClientType, "expected: %s, got: %s", types.Wasm, substituteClient.ClientType())
+	}
+
 	// check that checksums of subject client state and substitute client state match
 	// changing the checksum is only allowed through the migrate contract RPC endpoint
 	if !bytes.Equal(subjectClientState.Checksum, substituteClientState.Checksum) {
@@ -441,8 +472,19 @@ func (l LightClientModule) RecoverClient(ctx context.Context, clientID, substitu
 	}
 
 	sdkCtx := sdk.UnwrapSDKContext(ctx) // TODO: https://github.com/cosmos/ibc-go/issues/5917
-	_, err = l.keeper.WasmSudo(sdkCtx, clientID, store, subjectClientState, payload)
-	return err
+	if _, err := l.keeper.WasmSudo(sdkCtx, clientID, store, subjectClientState, payload); err != nil {
+		return err
+	}
+
+	// the recovery store does not track writes to the subject client store, so its size is recomputed
+	return l.keeper.ResetClientStoreSize(sdkCtx, clientID)
+}
+
+// OnClientStoreReplaced recomputes the tracked size of the client store of the given client after its
+// contents have been replaced by core IBC, such as when a client is migrated to or from a wasm client.
+func (l LightClientModule) OnClientStoreReplaced(ctx context.Context, clientID string) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx) // TODO: https://github.com/cosmos/ibc-go/issues/5917
+	return l.keeper.ResetClientStoreSize(sdkCtx, clientID)
 }
 
 // VerifyUpgradeAndUpdateState obtains the client state associated with the client identifier and calls into the appropriate contract endpoint.
@@ -235,6 +235,93 @@ func (suite *WasmTestSuite) TestTimestampAtHeight() {
 	}
 }
 
+func (suite *WasmTestSuite) TestChainID() {
+	var clientID string
+	expectedChainID := "testchain-1"
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {
+				suite.mockVM.RegisterQueryCallback(types.ChainIDMsg{}, func(_ wasmvm.Checksum, _ wasmvmtypes.Env, queryMsg []byte, _ wasmvm.KVStore, _ wasmvm.GoAPI, _ wasmvm.Querier, _ wasmvm.GasMeter, _ uint64, _ wasmvmtypes.UFraction) (*wasmvmtypes.QueryResult, uint64, error) {
+					var payload types.QueryMsg
+					err := json.Unmarshal(queryMsg, &payload)
+					suite.Require().NoError(err)
+
+					suite.Require().NotNil(payload.ChainID)
+					suite.Require().Nil(payload.Status)
+					suite.Require().Nil(payload.TimestampAtHeight)
+
+					resp, err := json.Marshal(types.ChainIDResult{ChainID: expectedChainID})
+					suite.Require().NoError(err)
+
+					return &wasmvmtypes.QueryResult{Ok: resp}, wasmtesting.DefaultGasUsed, nil
+				})
+			},
+			nil,
+		},
+		{
+			"failure: contract returns error",
+			func() {
+				suite.mockVM.RegisterQueryCallback(types.ChainIDMsg{}, func(_ wasmvm.Checksum, _ wasmvmtypes.Env, _ []byte, _ wasmvm.KVStore, _ wasmvm.GoAPI, _ wasmvm.Querier, _ wasmvm.GasMeter, _ uint64, _ wasmvmtypes.UFraction) (*wasmvmtypes.QueryResult, uint64, error) {
+					return &wasmvmtypes.QueryResult{Err: wasmtesting.ErrMockContract.Error()}, 0, nil
+				})
+			},
+			types.ErrWasmContractCallFailed,
+		},
+		{
+			"failure: cannot find client state",
+			func() {
+				clientID = unusedWasmClientID
+			},
+			clienttypes.ErrClientNotFound,
+		},
+		{
+			"failure: response fails to unmarshal",
+			func() {
+				suite.mockVM.RegisterQueryCallback(types.ChainIDMsg{}, func(_ wasmvm.Checksum, _ wasmvmtypes.Env, _ []byte, _ wasmvm.KVStore, _ wasmvm.GoAPI, _ wasmvm.Querier, _ wasmvm.GasMeter, _ uint64, _ wasmvmtypes.UFraction) (*wasmvmtypes.QueryResult, uint64, error) {
+					return &wasmvmtypes.QueryResult{Ok: []byte("invalid json")}, wasmtesting.DefaultGasUsed, nil
+				})
+			},
+			types.ErrWasmInvalidResponseData,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupWasmWithMockVM()
+
+			endpoint := wasmtesting.NewWasmEndpoint(suite.chainA)
+			err := endpoint.CreateClient()
+			suite.Require().NoError(err)
+			clientID = endpoint.ClientID
+
+			lightClientModule, err := suite.chainA.App.GetIBCKeeper().ClientKeeper.Route(suite.chainA.GetContext(), clientID)
+			suite.Require().NoError(err)
+
+			tc.malleate()
+
+			chainIDModule, ok := lightClientModule.(exported.ChainIDLightClientModule)
+			suite.Require().True(ok)
+
+			chainID, err := chainIDModule.ChainID(suite.chainA.GetContext(), clientID)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expectedChainID, chainID)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().Empty(chainID)
+			}
+		})
+	}
+}
+
 func (suite *WasmTestSuite) TestInitialize() {
 	var (
 		consensusState exported.ConsensusState
@@ -1439,16 +1526,17 @@ func (suite *WasmTestSuite) TestRecoverClient() {
 			nil,
 		},
 		{
-			"failure: cannot parse malformed substitute client ID",
+			"failure: substitute client ID is malformed",
 			func() {
 				substituteClientID = ibctesting.InvalidID
 			},
-			host.ErrInvalidID,
+			clienttypes.ErrClientNotFound,
 		},
 		{
-			"failure: substitute client ID does not contain 08-wasm prefix",
+			"failure: substitute client is not a wasm client",
 			func() {
-				substituteClientID = tmClientID
+				// the substitute client ID contains the 08-wasm prefix, but its stored client state is of a different type
+				GetSimApp(suite.chainA).IBCKeeper.ClientKeeper.SetClientState(suite.chainA.GetContext(), substituteClientID, &ibctm.ClientState{})
 			},
 			clienttypes.ErrInvalidClientType,
 		},
@@ -1536,6 +1624,18 @@ func (suite *WasmTestSuite) TestRecoverClient() {
 
 				subjectClientStore := suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(suite.chainA.GetContext(), subjectClientID)
 				suite.Require().Equal(expectedClientStateBz, subjectClientStore.Get(host.ClientStateKey()))
+
+				// the tracked client store size must account for the writes made through the recovery store
+				var expSize uint64
+				iterator := subjectClientStore.Iterator(nil, nil)
+				for ; iterator.Valid(); iterator.Next() {
+					expSize += uint64(len(iterator.Key()) + len(iterator.Value()))
+				}
+				suite.Require().NoError(iterator.Close())
+
+				size, err := GetSimApp(suite.chainA).WasmClientKeeper.GetClientStoreSize(suite.chainA.GetContext(), subjectClientID)
+				suite.Require().NoError(err)
+				suite.Require().Equal(expSize, size)
 			} else {
 				suite.Require().ErrorIs(err, tc.expErr)
 			}
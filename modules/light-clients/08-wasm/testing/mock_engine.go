@@ -19,7 +19,7 @@ var (
 	_ types.WasmEngine = (*MockWasmEngine)(nil)
 
 	// queryTypes contains all the possible query message types.
-	queryTypes = [...]any{types.StatusMsg{}, types.TimestampAtHeightMsg{}, types.VerifyClientMessageMsg{}, types.CheckForMisbehaviourMsg{}}
+	queryTypes = [...]any{types.StatusMsg{}, types.TimestampAtHeightMsg{}, types.VerifyClientMessageMsg{}, types.CheckForMisbehaviourMsg{}, types.ChainIDMsg{}}
 
 	// sudoTypes contains all the possible sudo message types.
 	sudoTypes = [...]any{types.UpdateStateMsg{}, types.UpdateStateOnMisbehaviourMsg{}, types.VerifyUpgradeAndUpdateStateMsg{}, types.VerifyMembershipMsg{}, types.VerifyNonMembershipMsg{}, types.MigrateClientStoreMsg{}}
@@ -44,6 +44,8 @@ type MockWasmEngine struct {
 	GetCodeFn            func(checksum wasmvm.Checksum) (wasmvm.WasmCode, error)
 	PinFn                func(checksum wasmvm.Checksum) error
 	UnpinFn              func(checksum wasmvm.Checksum) error
+	GetMetricsFn         func() (*wasmvmtypes.Metrics, error)
+	GetPinnedMetricsFn   func() (*wasmvmtypes.PinnedMetrics, error)
 
 	// queryCallbacks contains a mapping of queryMsg field type name to callback function.
 	queryCallbacks map[string]queryFn
@@ -55,7 +57,8 @@ type MockWasmEngine struct {
 
 // NewMockWasmEngine creates and returns a new instance of the mock wasmvm for testing purposes.
 // Each callback method of the mock wasmvm can be overridden to assign specific functionality.
-// Default functionality is assigned for StoreCode, StoreCodeUnchecked and GetCode. Both Pin and Unpin are implemented as no-op methods.
+// Default functionality is assigned for StoreCode, StoreCodeUnchecked and GetCode. Both Pin and Unpin are implemented as no-op methods,
+// and GetMetrics and GetPinnedMetrics return empty metrics.
 // All other callbacks stored in the query and sudo callback maps panic. Use RegisterQueryCallback and RegisterSudoCallback methods
 // to assign expected behaviour for test cases.
 func NewMockWasmEngine() *MockWasmEngine {
@@ -102,6 +105,14 @@ func NewMockWasmEngine() *MockWasmEngine {
 		return nil
 	}
 
+	m.GetMetricsFn = func() (*wasmvmtypes.Metrics, error) {
+		return &wasmvmtypes.Metrics{}, nil
+	}
+
+	m.GetPinnedMetricsFn = func() (*wasmvmtypes.PinnedMetrics, error) {
+		return &wasmvmtypes.PinnedMetrics{}, nil
+	}
+
 	m.GetCodeFn = func(checksum wasmvm.Checksum) (wasmvm.WasmCode, error) {
 		code, ok := m.storedContracts[binary.LittleEndian.Uint32(checksum)]
 		if !ok {
@@ -211,6 +222,22 @@ func (m *MockWasmEngine) Unpin(checksum wasmvm.Checksum) error {
 	return m.UnpinFn(checksum)
 }
 
+// GetMetrics implements the WasmEngine interface.
+func (m *MockWasmEngine) GetMetrics() (*wasmvmtypes.Metrics, error) {
+	if m.GetMetricsFn == nil {
+		panic(errors.New("mock engine is not properly initialized: GetMetricsFn is nil"))
+	}
+	return m.GetMetricsFn()
+}
+
+// GetPinnedMetrics implements the WasmEngine interface.
+func (m *MockWasmEngine) GetPinnedMetrics() (*wasmvmtypes.PinnedMetrics, error) {
+	if m.GetPinnedMetricsFn == nil {
+		panic(errors.New("mock engine is not properly initialized: GetPinnedMetricsFn is nil"))
+	}
+	return m.GetPinnedMetricsFn()
+}
+
 // getQueryMsgPayloadTypeName extracts the name of the struct that is populated.
 // this value is used as a key to map to a callback function to handle that message type.
 func getQueryMsgPayloadTypeName(queryMsgBz []byte) string {
@@ -236,6 +263,10 @@ func getQueryMsgPayloadTypeName(queryMsgBz []byte) string {
 		payloadField = *payload.VerifyClientMessage
 	}
 
+	if payload.ChainID != nil {
+		payloadField = *payload.ChainID
+	}
+
 	if payloadField == nil {
 		panic(fmt.Errorf("failed to extract valid query message from bytes: %s", string(queryMsgBz)))
 	}
@@ -0,0 +1,37 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	ibcerrors "github.com/cosmos/ibc-go/v9/modules/core/errors"
+)
+
+// NewApprovedChecksum creates a new ApprovedChecksum instance.
+func NewApprovedChecksum(checksum Checksum, uploader string) ApprovedChecksum {
+	return ApprovedChecksum{
+		Checksum: checksum,
+		Uploader: uploader,
+	}
+}
+
+// Validate performs basic validation of the approved checksum.
+func (a ApprovedChecksum) Validate() error {
+	if err := ValidateWasmChecksum(a.Checksum); err != nil {
+		return err
+	}
+
+	if a.Uploader != "" {
+		if _, err := sdk.AccAddressFromBech32(a.Uploader); err != nil {
+			return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "uploader could not be parsed as address: %v", err)
+		}
+	}
+
+	return nil
+}
+
+// IsUploaderAllowed returns true if the given address is allowed to upload the wasm byte code of the approved checksum.
+func (a ApprovedChecksum) IsUploaderAllowed(uploader string) bool {
+	return a.Uploader == "" || a.Uploader == uploader
+}
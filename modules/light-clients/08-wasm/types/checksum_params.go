@@ -0,0 +1,20 @@
+package types
+
+// NewChecksumParams creates a new ChecksumParams instance.
+func NewChecksumParams(checksum Checksum, maxClientStoreSize, storeWriteGasPerByte uint64) ChecksumParams {
+	return ChecksumParams{
+		Checksum:             checksum,
+		MaxClientStoreSize:   maxClientStoreSize,
+		StoreWriteGasPerByte: storeWriteGasPerByte,
+	}
+}
+
+// Validate performs basic validation of the checksum params.
+func (p ChecksumParams) Validate() error {
+	return ValidateWasmChecksum(p.Checksum)
+}
+
+// IsClientStoreSizeLimited returns true if a maximum client store size is set.
+func (p ChecksumParams) IsClientStoreSizeLimited() bool {
+	return p.MaxClientStoreSize != 0
+}
@@ -28,6 +28,11 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgStoreCode{},
 		&MsgMigrateContract{},
 		&MsgRemoveChecksum{},
+		&MsgUpdateChecksumParams{},
+		&MsgApproveChecksum{},
+		&MsgRollbackContract{},
+		&MsgPinCodes{},
+		&MsgUnpinCodes{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -21,6 +21,7 @@ type QueryMsg struct {
 	TimestampAtHeight    *TimestampAtHeightMsg    `json:"timestamp_at_height,omitempty"`
 	VerifyClientMessage  *VerifyClientMessageMsg  `json:"verify_client_message,omitempty"`
 	CheckForMisbehaviour *CheckForMisbehaviourMsg `json:"check_for_misbehaviour,omitempty"`
+	ChainID              *ChainIDMsg              `json:"chain_id,omitempty"`
 }
 
 // StatusMsg is a queryMsg sent to the contract to query the status of the wasm client.
@@ -41,6 +42,9 @@ type CheckForMisbehaviourMsg struct {
 	ClientMessage []byte `json:"client_message"`
 }
 
+// ChainIDMsg is a queryMsg sent to the contract to query the chain identifier of the counterparty chain tracked by the wasm client.
+type ChainIDMsg struct{}
+
 // SudoMsg is used to encode messages that are sent to the contract's sudo entry point.
 // The json omitempty tag is mandatory since it omits any empty (default initialized) fields from the encoded JSON,
 // this is required in order to be compatible with Rust's enum matching as used in the contract.
@@ -96,7 +100,7 @@ type MigrateClientStoreMsg struct{}
 
 // ContractResult is a type constraint that defines the expected results that can be returned by a contract call/query.
 type ContractResult interface {
-	EmptyResult | StatusResult | TimestampAtHeightResult | CheckForMisbehaviourResult | UpdateStateResult
+	EmptyResult | StatusResult | TimestampAtHeightResult | CheckForMisbehaviourResult | UpdateStateResult | ChainIDResult
 }
 
 // EmptyResult is the default return type of any contract call that does not require a custom return type.
@@ -113,6 +117,12 @@ type TimestampAtHeightResult struct {
 	Timestamp uint64 `json:"timestamp"`
 }
 
+// ChainIDResult is the expected return type of the chainIDMsg query. It returns the chain identifier of the counterparty
+// chain tracked by the wasm client.
+type ChainIDResult struct {
+	ChainID string `json:"chain_id"`
+}
+
 // CheckForMisbehaviourResult is the expected return type of the checkForMisbehaviourMsg query. It returns a boolean indicating
 // if misbehaviour was detected.
 type CheckForMisbehaviourResult struct {
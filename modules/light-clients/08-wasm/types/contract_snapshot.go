@@ -0,0 +1,26 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// NewClientContractSnapshot creates a new ClientContractSnapshot instance.
+func NewClientContractSnapshot(clientID string, snapshot ContractSnapshot) ClientContractSnapshot {
+	return ClientContractSnapshot{
+		ClientId: clientID,
+		Snapshot: snapshot,
+	}
+}
+
+// Validate performs basic validation of the client contract snapshot.
+func (s ClientContractSnapshot) Validate() error {
+	if err := ValidateClientID(s.ClientId); err != nil {
+		return err
+	}
+
+	if err := ValidateWasmChecksum(s.Snapshot.Checksum); err != nil {
+		return errorsmod.Wrapf(err, "invalid checksum of contract snapshot of client %s", s.ClientId)
+	}
+
+	return nil
+}
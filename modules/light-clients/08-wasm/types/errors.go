@@ -20,4 +20,7 @@ var (
 	ErrWasmInvalidResponseData         = errorsmod.Register(ModuleName, 15, "wasm contract returned invalid response data")
 	ErrWasmInvalidContractModification = errorsmod.Register(ModuleName, 16, "wasm contract made invalid state modifications")
 	ErrVMError                         = errorsmod.Register(ModuleName, 17, "wasm VM error")
+	ErrClientStoreSizeExceeded         = errorsmod.Register(ModuleName, 18, "client store size exceeds the maximum allowed")
+	ErrWasmChecksumNotApproved         = errorsmod.Register(ModuleName, 19, "wasm checksum not approved")
+	ErrContractSnapshotNotFound        = errorsmod.Register(ModuleName, 20, "contract snapshot not found")
 )
@@ -6,6 +6,14 @@ const (
 	EventTypeStoreWasmCode = "store_wasm_code"
 	// EventTypeMigrateContract defines the event type for a contract migration
 	EventTypeMigrateContract = "migrate_contract"
+	// EventTypeApproveChecksum defines the event type for a checksum approval
+	EventTypeApproveChecksum = "approve_checksum"
+	// EventTypeRollbackContract defines the event type for a contract migration rollback
+	EventTypeRollbackContract = "rollback_contract"
+	// EventTypePinCode defines the event type for pinning a code to the wasm VM in-memory cache
+	EventTypePinCode = "pin_code"
+	// EventTypeUnpinCode defines the event type for unpinning a code from the wasm VM in-memory cache
+	EventTypeUnpinCode = "unpin_code"
 
 	// AttributeKeyWasmChecksum denotes the checksum of the wasm code that was stored or migrated
 	AttributeKeyWasmChecksum = "wasm_checksum"
@@ -13,6 +21,8 @@ const (
 	AttributeKeyClientID = "client_id"
 	// AttributeKeyNewChecksum denotes the checksum of the new wasm code.
 	AttributeKeyNewChecksum = "new_checksum"
+	// AttributeKeyUploader denotes the address allowed to upload the wasm code of an approved checksum
+	AttributeKeyUploader = "uploader"
 
 	AttributeValueCategory = ModuleName
 )
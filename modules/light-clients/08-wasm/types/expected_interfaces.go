@@ -115,6 +115,15 @@ type WasmEngine interface {
 	// the implementor's choice.
 	// Unpin is idempotent.
 	Unpin(checksum wasmvm.Checksum) error
+
+	// GetMetrics returns the cache metrics of the VM for monitoring purposes.
+	// The values are node-specific and must not be used in consensus-critical contexts.
+	GetMetrics() (*wasmvmtypes.Metrics, error)
+
+	// GetPinnedMetrics returns the cache metrics of the pinned codes for monitoring purposes.
+	// The order of entries is non-deterministic and the values are node-specific.
+	// They must not be used in consensus-critical contexts.
+	GetPinnedMetrics() (*wasmvmtypes.PinnedMetrics, error)
 }
 
 type QueryRouter interface {
@@ -5,6 +5,9 @@ import (
 
 	storetypes "cosmossdk.io/store/types"
 
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	connectiontypes "github.com/cosmos/ibc-go/v9/modules/core/03-connection/types"
 	"github.com/cosmos/ibc-go/v9/modules/core/exported"
 )
 
@@ -14,3 +17,18 @@ type ClientKeeper interface {
 	GetClientState(ctx context.Context, clientID string) (exported.ClientState, bool)
 	SetClientState(ctx context.Context, clientID string, clientState exported.ClientState)
 }
+
+// ClientStatusKeeper defines the expected client keeper used by the IBC core custom querier
+type ClientStatusKeeper interface {
+	GetClientStatus(ctx context.Context, clientID string) exported.Status
+}
+
+// ConnectionKeeper defines the expected connection keeper used by the IBC core custom querier
+type ConnectionKeeper interface {
+	GetConnection(ctx context.Context, connectionID string) (connectiontypes.ConnectionEnd, bool)
+}
+
+// HistoricalInfoKeeper defines the expected staking keeper used by the IBC core custom querier
+type HistoricalInfoKeeper interface {
+	GetHistoricalInfo(ctx context.Context, height int64) (stakingtypes.HistoricalInfo, error)
+}
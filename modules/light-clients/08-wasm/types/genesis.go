@@ -1,6 +1,8 @@
 package types
 
 import (
+	"encoding/hex"
+
 	errorsmod "cosmossdk.io/errors"
 )
 
@@ -18,5 +20,56 @@ func (gs GenesisState) Validate() error {
 		}
 	}
 
+	seenChecksums := make(map[string]bool)
+	for _, params := range gs.ChecksumParams {
+		if err := params.Validate(); err != nil {
+			return errorsmod.Wrap(err, "checksum params validation failed")
+		}
+
+		checksum := hex.EncodeToString(params.Checksum)
+		if seenChecksums[checksum] {
+			return errorsmod.Wrapf(ErrInvalidChecksum, "duplicate checksum params for checksum %s", checksum)
+		}
+		seenChecksums[checksum] = true
+	}
+
+	seenApprovedChecksums := make(map[string]bool)
+	for _, approvedChecksum := range gs.ApprovedChecksums {
+		if err := approvedChecksum.Validate(); err != nil {
+			return errorsmod.Wrap(err, "approved checksum validation failed")
+		}
+
+		checksum := hex.EncodeToString(approvedChecksum.Checksum)
+		if seenApprovedChecksums[checksum] {
+			return errorsmod.Wrapf(ErrInvalidChecksum, "duplicate approved checksum %s", checksum)
+		}
+		seenApprovedChecksums[checksum] = true
+	}
+
+	seenSnapshotClientIDs := make(map[string]bool)
+	for _, snapshot := range gs.ContractSnapshots {
+		if err := snapshot.Validate(); err != nil {
+			return errorsmod.Wrap(err, "contract snapshot validation failed")
+		}
+
+		if seenSnapshotClientIDs[snapshot.ClientId] {
+			return errorsmod.Wrapf(ErrInvalid, "duplicate contract snapshot for client %s", snapshot.ClientId)
+		}
+		seenSnapshotClientIDs[snapshot.ClientId] = true
+	}
+
+	seenPinnedChecksums := make(map[string]bool)
+	for _, pinnedChecksum := range gs.PinnedChecksums {
+		if err := ValidateWasmChecksum(pinnedChecksum); err != nil {
+			return errorsmod.Wrap(err, "pinned checksum validation failed")
+		}
+
+		checksum := hex.EncodeToString(pinnedChecksum)
+		if seenPinnedChecksums[checksum] {
+			return errorsmod.Wrapf(ErrInvalidChecksum, "duplicate pinned checksum %s", checksum)
+		}
+		seenPinnedChecksums[checksum] = true
+	}
+
 	return nil
 }
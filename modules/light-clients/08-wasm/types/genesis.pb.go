@@ -27,6 +27,14 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type GenesisState struct {
 	// uploaded light client wasm contracts
 	Contracts []Contract `protobuf:"bytes,1,rep,name=contracts,proto3" json:"contracts"`
+	// client store limits set for stored checksums
+	ChecksumParams []ChecksumParams `protobuf:"bytes,2,rep,name=checksum_params,json=checksumParams,proto3" json:"checksum_params"`
+	// checksums approved for upload but not yet stored
+	ApprovedChecksums []ApprovedChecksum `protobuf:"bytes,3,rep,name=approved_checksums,json=approvedChecksums,proto3" json:"approved_checksums"`
+	// client store snapshots taken at the last contract migration of light clients
+	ContractSnapshots []ClientContractSnapshot `protobuf:"bytes,4,rep,name=contract_snapshots,json=contractSnapshots,proto3" json:"contract_snapshots"`
+	// checksums of the stored contracts pinned to the vm in-memory cache
+	PinnedChecksums [][]byte `protobuf:"bytes,5,rep,name=pinned_checksums,json=pinnedChecksums,proto3" json:"pinned_checksums,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -69,6 +77,89 @@ func (m *GenesisState) GetContracts() []Contract {
 	return nil
 }
 
+func (m *GenesisState) GetChecksumParams() []ChecksumParams {
+	if m != nil {
+		return m.ChecksumParams
+	}
+	return nil
+}
+
+func (m *GenesisState) GetApprovedChecksums() []ApprovedChecksum {
+	if m != nil {
+		return m.ApprovedChecksums
+	}
+	return nil
+}
+
+func (m *GenesisState) GetContractSnapshots() []ClientContractSnapshot {
+	if m != nil {
+		return m.ContractSnapshots
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPinnedChecksums() [][]byte {
+	if m != nil {
+		return m.PinnedChecksums
+	}
+	return nil
+}
+
+// ClientContractSnapshot defines the contract snapshot taken at the last contract migration of a light client
+type ClientContractSnapshot struct {
+	// the light client identifier
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// the client store snapshot taken at the last contract migration
+	Snapshot ContractSnapshot `protobuf:"bytes,2,opt,name=snapshot,proto3" json:"snapshot"`
+}
+
+func (m *ClientContractSnapshot) Reset()         { *m = ClientContractSnapshot{} }
+func (m *ClientContractSnapshot) String() string { return proto.CompactTextString(m) }
+func (*ClientContractSnapshot) ProtoMessage()    {}
+func (*ClientContractSnapshot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05e250654f164e20, []int{1}
+}
+func (m *ClientContractSnapshot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClientContractSnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClientContractSnapshot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClientContractSnapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClientContractSnapshot.Merge(m, src)
+}
+func (m *ClientContractSnapshot) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClientContractSnapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClientContractSnapshot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClientContractSnapshot proto.InternalMessageInfo
+
+func (m *ClientContractSnapshot) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+func (m *ClientContractSnapshot) GetSnapshot() ContractSnapshot {
+	if m != nil {
+		return m.Snapshot
+	}
+	return ContractSnapshot{}
+}
+
 // Contract stores contract code
 type Contract struct {
 	// contract byte code
@@ -79,7 +170,7 @@ func (m *Contract) Reset()         { *m = Contract{} }
 func (m *Contract) String() string { return proto.CompactTextString(m) }
 func (*Contract) ProtoMessage()    {}
 func (*Contract) Descriptor() ([]byte, []int) {
-	return fileDescriptor_05e250654f164e20, []int{1}
+	return fileDescriptor_05e250654f164e20, []int{2}
 }
 func (m *Contract) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -110,6 +201,7 @@ var xxx_messageInfo_Contract proto.InternalMessageInfo
 
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "ibc.lightclients.wasm.v1.GenesisState")
+	proto.RegisterType((*ClientContractSnapshot)(nil), "ibc.lightclients.wasm.v1.ClientContractSnapshot")
 	proto.RegisterType((*Contract)(nil), "ibc.lightclients.wasm.v1.Contract")
 }
 
@@ -118,24 +210,34 @@ func init() {
 }
 
 var fileDescriptor_05e250654f164e20 = []byte{
-	// 261 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xcb, 0x4c, 0x4a, 0xd6,
-	0xcf, 0xc9, 0x4c, 0xcf, 0x28, 0x49, 0xce, 0xc9, 0x4c, 0xcd, 0x2b, 0x29, 0xd6, 0x2f, 0x4f, 0x2c,
-	0xce, 0xd5, 0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca,
-	0x2f, 0xc9, 0x17, 0x92, 0xc8, 0x4c, 0x4a, 0xd6, 0x43, 0x56, 0xa7, 0x07, 0x52, 0xa7, 0x57, 0x66,
-	0x28, 0x25, 0x92, 0x9e, 0x9f, 0x9e, 0x0f, 0x56, 0xa4, 0x0f, 0x62, 0x41, 0xd4, 0x2b, 0x85, 0x71,
-	0xf1, 0xb8, 0x43, 0x0c, 0x08, 0x2e, 0x49, 0x2c, 0x49, 0x15, 0x72, 0xe3, 0xe2, 0x4c, 0xce, 0xcf,
-	0x2b, 0x29, 0x4a, 0x4c, 0x2e, 0x29, 0x96, 0x60, 0x54, 0x60, 0xd6, 0xe0, 0x36, 0x52, 0xd2, 0xc3,
-	0x65, 0xa6, 0x9e, 0x33, 0x54, 0xa9, 0x13, 0xcb, 0x89, 0x7b, 0xf2, 0x0c, 0x41, 0x08, 0xad, 0x4a,
-	0xfa, 0x5c, 0x1c, 0x30, 0x49, 0x21, 0x59, 0x2e, 0xae, 0xe4, 0xfc, 0x94, 0xd4, 0xf8, 0xa4, 0xca,
-	0x92, 0x54, 0x90, 0xa1, 0x8c, 0x1a, 0x3c, 0x20, 0xa5, 0x29, 0xa9, 0x4e, 0x20, 0x01, 0x2b, 0x96,
-	0x8e, 0x05, 0xf2, 0x0c, 0x4e, 0x61, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0,
-	0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10,
-	0x65, 0x93, 0x9e, 0x59, 0x92, 0x51, 0x9a, 0xa4, 0x97, 0x9c, 0x9f, 0xab, 0x9f, 0x9c, 0x5f, 0x9c,
-	0x9b, 0x5f, 0xac, 0x9f, 0x99, 0x94, 0xac, 0x9b, 0x9e, 0xaf, 0x9f, 0x9b, 0x9f, 0x52, 0x9a, 0x93,
-	0x5a, 0x0c, 0x09, 0x17, 0x5d, 0x58, 0xc0, 0x18, 0x58, 0xe8, 0x82, 0xc3, 0xa6, 0xa4, 0xb2, 0x20,
-	0xb5, 0x38, 0x89, 0x0d, 0xec, 0x4f, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0x06, 0xb3, 0x17,
-	0x15, 0x41, 0x01, 0x00, 0x00,
+	// 431 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0x41, 0x8b, 0xd3, 0x40,
+	0x1c, 0xc5, 0x33, 0xdb, 0x2a, 0xed, 0x58, 0x5c, 0x1d, 0x44, 0xc2, 0x8a, 0xd9, 0x10, 0x41, 0xa2,
+	0xd0, 0xcc, 0xae, 0x5e, 0x44, 0xbc, 0x58, 0x41, 0x11, 0x3c, 0x48, 0x17, 0x14, 0xbc, 0x84, 0xc9,
+	0x64, 0x48, 0x06, 0x9b, 0x4c, 0xc8, 0x7f, 0x5a, 0xd9, 0xb3, 0x17, 0x8f, 0x7e, 0x04, 0xf1, 0xd3,
+	0xec, 0x71, 0x8f, 0x9e, 0x44, 0xda, 0x2f, 0x22, 0x99, 0x64, 0x56, 0xb7, 0x6c, 0x7a, 0x4b, 0x5e,
+	0xde, 0xfb, 0xbd, 0xff, 0x4c, 0xfe, 0xf8, 0xa1, 0x4c, 0x38, 0x5d, 0xc8, 0x2c, 0xd7, 0x7c, 0x21,
+	0x45, 0xa9, 0x81, 0x7e, 0x61, 0x50, 0xd0, 0xd5, 0x31, 0xcd, 0x44, 0x29, 0x40, 0x42, 0x54, 0xd5,
+	0x4a, 0x2b, 0xe2, 0xca, 0x84, 0x47, 0xff, 0xfb, 0xa2, 0xc6, 0x17, 0xad, 0x8e, 0x0f, 0xee, 0x64,
+	0x2a, 0x53, 0xc6, 0x44, 0x9b, 0xa7, 0xd6, 0x7f, 0xf0, 0xa0, 0x97, 0x6b, 0x72, 0xc6, 0x14, 0xfc,
+	0x1c, 0xe0, 0xc9, 0x9b, 0xb6, 0xe6, 0x44, 0x33, 0x2d, 0xc8, 0x6b, 0x3c, 0xe6, 0xaa, 0xd4, 0x35,
+	0xe3, 0x1a, 0x5c, 0xe4, 0x0f, 0xc2, 0x1b, 0x4f, 0x82, 0xa8, 0xaf, 0x39, 0x7a, 0xd5, 0x59, 0x67,
+	0xc3, 0xb3, 0xdf, 0x87, 0xce, 0xfc, 0x5f, 0x94, 0x7c, 0xc4, 0xfb, 0x3c, 0x17, 0xfc, 0x33, 0x2c,
+	0x8b, 0xb8, 0x62, 0x35, 0x2b, 0xc0, 0xdd, 0x33, 0xb4, 0x70, 0x07, 0xad, 0x0b, 0xbc, 0x37, 0xfe,
+	0x8e, 0x79, 0x93, 0x5f, 0x52, 0x49, 0x8c, 0x09, 0xab, 0xaa, 0x5a, 0xad, 0x44, 0x1a, 0xdb, 0x4f,
+	0xe0, 0x0e, 0x0c, 0xfb, 0x71, 0x3f, 0xfb, 0x65, 0x97, 0xb1, 0x1d, 0x1d, 0xfd, 0x36, 0xdb, 0xd2,
+	0x81, 0x08, 0x4c, 0xec, 0x31, 0x62, 0x28, 0x59, 0x05, 0xb9, 0xd2, 0xe0, 0x0e, 0x4d, 0xc1, 0xd1,
+	0x8e, 0xe1, 0xcd, 0xbb, 0xbd, 0x90, 0x93, 0x2e, 0x68, 0x6b, 0xf8, 0x96, 0x0e, 0xe4, 0x11, 0xbe,
+	0x55, 0xc9, 0xb2, 0xbc, 0x74, 0x8a, 0x6b, 0xfe, 0x20, 0x9c, 0xcc, 0xf7, 0x5b, 0xfd, 0x62, 0xa2,
+	0xe0, 0x2b, 0xc2, 0x77, 0xaf, 0xc6, 0x93, 0x7b, 0x78, 0xdc, 0x0e, 0x12, 0xcb, 0xd4, 0x45, 0x3e,
+	0x0a, 0xc7, 0xf3, 0x51, 0x2b, 0xbc, 0x4d, 0xc9, 0x3b, 0x3c, 0xb2, 0x07, 0x70, 0xf7, 0x7c, 0xb4,
+	0xfb, 0x82, 0x7a, 0x26, 0xbf, 0x20, 0x04, 0x14, 0x8f, 0xac, 0x87, 0xdc, 0xc7, 0x98, 0xab, 0x54,
+	0xc4, 0xc9, 0xa9, 0x16, 0x60, 0x7a, 0x27, 0xcd, 0xcf, 0x4f, 0xc5, 0xac, 0x11, 0x9e, 0x0f, 0xbf,
+	0xfd, 0x38, 0x74, 0x66, 0x1f, 0xce, 0xd6, 0x1e, 0x3a, 0x5f, 0x7b, 0xe8, 0xcf, 0xda, 0x43, 0xdf,
+	0x37, 0x9e, 0x73, 0xbe, 0xf1, 0x9c, 0x5f, 0x1b, 0xcf, 0xf9, 0xf4, 0x22, 0x93, 0x3a, 0x5f, 0x26,
+	0x11, 0x57, 0x05, 0xe5, 0x0a, 0x0a, 0x05, 0x54, 0x26, 0x7c, 0x9a, 0x29, 0x5a, 0xa8, 0x74, 0xb9,
+	0x10, 0xd0, 0xee, 0xed, 0xd4, 0x2e, 0xee, 0xd1, 0xb3, 0xa9, 0xd9, 0x5d, 0x7d, 0x5a, 0x09, 0x48,
+	0xae, 0x9b, 0xd5, 0x7d, 0xfa, 0x77, 0x00, 0x51, 0x31, 0x47, 0x93, 0x39, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -158,6 +260,57 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PinnedChecksums) > 0 {
+		for iNdEx := len(m.PinnedChecksums) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PinnedChecksums[iNdEx])
+			copy(dAtA[i:], m.PinnedChecksums[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.PinnedChecksums[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.ContractSnapshots) > 0 {
+		for iNdEx := len(m.ContractSnapshots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ContractSnapshots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.ApprovedChecksums) > 0 {
+		for iNdEx := len(m.ApprovedChecksums) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ApprovedChecksums[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ChecksumParams) > 0 {
+		for iNdEx := len(m.ChecksumParams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChecksumParams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.Contracts) > 0 {
 		for iNdEx := len(m.Contracts) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -175,6 +328,46 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ClientContractSnapshot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClientContractSnapshot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClientContractSnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Snapshot.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Contract) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -228,6 +421,45 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.ChecksumParams) > 0 {
+		for _, e := range m.ChecksumParams {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ApprovedChecksums) > 0 {
+		for _, e := range m.ApprovedChecksums {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ContractSnapshots) > 0 {
+		for _, e := range m.ContractSnapshots {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PinnedChecksums) > 0 {
+		for _, b := range m.PinnedChecksums {
+			l = len(b)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ClientContractSnapshot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Snapshot.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -313,6 +545,255 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChecksumParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChecksumParams = append(m.ChecksumParams, ChecksumParams{})
+			if err := m.ChecksumParams[len(m.ChecksumParams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApprovedChecksums", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApprovedChecksums = append(m.ApprovedChecksums, ApprovedChecksum{})
+			if err := m.ApprovedChecksums[len(m.ApprovedChecksums)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractSnapshots", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractSnapshots = append(m.ContractSnapshots, ClientContractSnapshot{})
+			if err := m.ContractSnapshots[len(m.ContractSnapshots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PinnedChecksums", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PinnedChecksums = append(m.PinnedChecksums, make([]byte, postIndex-iNdEx))
+			copy(m.PinnedChecksums[len(m.PinnedChecksums)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClientContractSnapshot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClientContractSnapshot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClientContractSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Snapshot", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Snapshot.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1,10 +1,14 @@
 package types_test
 
 import (
+	wasmtesting "github.com/cosmos/ibc-go/modules/light-clients/08-wasm/testing"
 	"github.com/cosmos/ibc-go/modules/light-clients/08-wasm/types"
 )
 
 func (suite *TypesTestSuite) TestValidateGenesis() {
+	checksum, err := types.CreateChecksum(wasmtesting.Code)
+	suite.Require().NoError(err)
+
 	testCases := []struct {
 		name     string
 		genState *types.GenesisState
@@ -17,6 +21,115 @@ func (suite *TypesTestSuite) TestValidateGenesis() {
 			},
 			true,
 		},
+		{
+			"valid genesis with checksum params",
+			&types.GenesisState{
+				Contracts:      []types.Contract{{CodeBytes: []byte{1}}},
+				ChecksumParams: []types.ChecksumParams{types.NewChecksumParams(checksum, 1024, 10)},
+			},
+			true,
+		},
+		{
+			"invalid genesis: invalid checksum params",
+			&types.GenesisState{
+				Contracts:      []types.Contract{{CodeBytes: []byte{1}}},
+				ChecksumParams: []types.ChecksumParams{types.NewChecksumParams([]byte{1}, 1024, 10)},
+			},
+			false,
+		},
+		{
+			"invalid genesis: duplicate checksum params",
+			&types.GenesisState{
+				Contracts: []types.Contract{{CodeBytes: []byte{1}}},
+				ChecksumParams: []types.ChecksumParams{
+					types.NewChecksumParams(checksum, 1024, 10),
+					types.NewChecksumParams(checksum, 0, 0),
+				},
+			},
+			false,
+		},
+		{
+			"valid genesis with approved checksums",
+			&types.GenesisState{
+				ApprovedChecksums: []types.ApprovedChecksum{types.NewApprovedChecksum(checksum, "")},
+			},
+			true,
+		},
+		{
+			"invalid genesis: invalid approved checksum uploader",
+			&types.GenesisState{
+				ApprovedChecksums: []types.ApprovedChecksum{types.NewApprovedChecksum(checksum, "invalid")},
+			},
+			false,
+		},
+		{
+			"invalid genesis: duplicate approved checksums",
+			&types.GenesisState{
+				ApprovedChecksums: []types.ApprovedChecksum{
+					types.NewApprovedChecksum(checksum, ""),
+					types.NewApprovedChecksum(checksum, ""),
+				},
+			},
+			false,
+		},
+		{
+			"valid genesis with contract snapshots",
+			&types.GenesisState{
+				ContractSnapshots: []types.ClientContractSnapshot{
+					types.NewClientContractSnapshot("08-wasm-0", types.ContractSnapshot{Checksum: checksum, Height: 1}),
+				},
+			},
+			true,
+		},
+		{
+			"invalid genesis: contract snapshot with invalid client identifier",
+			&types.GenesisState{
+				ContractSnapshots: []types.ClientContractSnapshot{
+					types.NewClientContractSnapshot("07-tendermint-0", types.ContractSnapshot{Checksum: checksum, Height: 1}),
+				},
+			},
+			false,
+		},
+		{
+			"invalid genesis: contract snapshot with invalid checksum",
+			&types.GenesisState{
+				ContractSnapshots: []types.ClientContractSnapshot{
+					types.NewClientContractSnapshot("08-wasm-0", types.ContractSnapshot{Checksum: []byte{1}, Height: 1}),
+				},
+			},
+			false,
+		},
+		{
+			"invalid genesis: duplicate contract snapshots",
+			&types.GenesisState{
+				ContractSnapshots: []types.ClientContractSnapshot{
+					types.NewClientContractSnapshot("08-wasm-0", types.ContractSnapshot{Checksum: checksum, Height: 1}),
+					types.NewClientContractSnapshot("08-wasm-0", types.ContractSnapshot{Checksum: checksum, Height: 2}),
+				},
+			},
+			false,
+		},
+		{
+			"valid genesis with pinned checksums",
+			&types.GenesisState{
+				PinnedChecksums: [][]byte{checksum},
+			},
+			true,
+		},
+		{
+			"invalid genesis: invalid pinned checksum",
+			&types.GenesisState{
+				PinnedChecksums: [][]byte{{1}},
+			},
+			false,
+		},
+		{
+			"invalid genesis: duplicate pinned checksums",
+			&types.GenesisState{
+				PinnedChecksums: [][]byte{checksum, checksum},
+			},
+			false,
+		},
 		{
 			"invalid genesis",
 			&types.GenesisState{
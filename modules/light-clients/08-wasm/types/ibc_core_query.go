@@ -0,0 +1,51 @@
+package types
+
+import (
+	clienttypes "github.com/cosmos/ibc-go/v9/modules/core/02-client/types"
+)
+
+// IBCCoreQuery is used to decode the custom queries sent by contracts to the IBC core custom querier.
+// The json omitempty tag is mandatory since it omits any empty (default initialized) fields from the encoded JSON,
+// this is required in order to be compatible with Rust's enum matching as used in the contract.
+// Only one field should be set at a time.
+type IBCCoreQuery struct {
+	ClientStatus                 *ClientStatusQuery                 `json:"client_status,omitempty"`
+	ConnectionCounterpartyPrefix *ConnectionCounterpartyPrefixQuery `json:"connection_counterparty_prefix,omitempty"`
+	ChainHeight                  *ChainHeightQuery                  `json:"chain_height,omitempty"`
+	ChainTimestampAtHeight       *ChainTimestampAtHeightQuery       `json:"chain_timestamp_at_height,omitempty"`
+}
+
+// ClientStatusQuery is a custom query sent by the contract to query the status of a light client on the local chain.
+type ClientStatusQuery struct {
+	ClientID string `json:"client_id"`
+}
+
+// ConnectionCounterpartyPrefixQuery is a custom query sent by the contract to query the counterparty
+// of a connection end on the local chain.
+type ConnectionCounterpartyPrefixQuery struct {
+	ConnectionID string `json:"connection_id"`
+}
+
+// ChainHeightQuery is a custom query sent by the contract to query the current height and timestamp of the local chain.
+type ChainHeightQuery struct{}
+
+// ChainTimestampAtHeightQuery is a custom query sent by the contract to query the block timestamp of the local chain
+// at a given height. Only heights still present in the historical info of the local chain can be queried.
+type ChainTimestampAtHeightQuery struct {
+	Height clienttypes.Height `json:"height"`
+}
+
+// ConnectionCounterpartyPrefixResult is the return type of the connectionCounterpartyPrefix custom query. It returns the
+// counterparty client identifier, connection identifier and commitment key prefix of the connection end.
+type ConnectionCounterpartyPrefixResult struct {
+	ClientID     string `json:"client_id"`
+	ConnectionID string `json:"connection_id"`
+	KeyPrefix    []byte `json:"key_prefix"`
+}
+
+// ChainHeightResult is the return type of the chainHeight custom query. It returns the current height and
+// block timestamp (in nanoseconds) of the local chain.
+type ChainHeightResult struct {
+	Height    clienttypes.Height `json:"height"`
+	Timestamp uint64             `json:"timestamp"`
+}
@@ -17,5 +17,17 @@ const (
 	KeyChecksums = "checksums"
 )
 
-// ChecksumsKey is the key under which all checksums are stored
-var ChecksumsKey = collections.NewPrefix(0)
+var (
+	// ChecksumsKey is the key under which all checksums are stored
+	ChecksumsKey = collections.NewPrefix(0)
+	// ChecksumParamsKey is the key under which the client store limits of each checksum are stored
+	ChecksumParamsKey = collections.NewPrefix(1)
+	// ClientStoreSizesKey is the key under which the client store size of each client is stored
+	ClientStoreSizesKey = collections.NewPrefix(2)
+	// ApprovedChecksumsKey is the key under which the checksums approved for upload are stored
+	ApprovedChecksumsKey = collections.NewPrefix(3)
+	// ContractSnapshotsKey is the key under which the client store snapshot taken at the last contract migration of each client is stored
+	ContractSnapshotsKey = collections.NewPrefix(4)
+	// PinnedChecksumsKey is the key under which the checksums of the codes pinned to the wasm VM in-memory cache are stored
+	PinnedChecksumsKey = collections.NewPrefix(5)
+)
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"encoding/hex"
+
 	errorsmod "cosmossdk.io/errors"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -12,9 +14,19 @@ var (
 	_ sdk.Msg              = (*MsgStoreCode)(nil)
 	_ sdk.Msg              = (*MsgMigrateContract)(nil)
 	_ sdk.Msg              = (*MsgRemoveChecksum)(nil)
+	_ sdk.Msg              = (*MsgUpdateChecksumParams)(nil)
+	_ sdk.Msg              = (*MsgApproveChecksum)(nil)
+	_ sdk.Msg              = (*MsgRollbackContract)(nil)
+	_ sdk.Msg              = (*MsgPinCodes)(nil)
+	_ sdk.Msg              = (*MsgUnpinCodes)(nil)
 	_ sdk.HasValidateBasic = (*MsgStoreCode)(nil)
 	_ sdk.HasValidateBasic = (*MsgMigrateContract)(nil)
 	_ sdk.HasValidateBasic = (*MsgRemoveChecksum)(nil)
+	_ sdk.HasValidateBasic = (*MsgUpdateChecksumParams)(nil)
+	_ sdk.HasValidateBasic = (*MsgApproveChecksum)(nil)
+	_ sdk.HasValidateBasic = (*MsgRollbackContract)(nil)
+	_ sdk.HasValidateBasic = (*MsgPinCodes)(nil)
+	_ sdk.HasValidateBasic = (*MsgUnpinCodes)(nil)
 )
 
 // NewMsgStoreCode creates a new MsgStoreCode instance
@@ -92,3 +104,116 @@ func (m MsgMigrateContract) ValidateBasic() error {
 
 	return nil
 }
+
+// NewMsgUpdateChecksumParams creates a new MsgUpdateChecksumParams instance
+func NewMsgUpdateChecksumParams(signer string, params ChecksumParams) *MsgUpdateChecksumParams {
+	return &MsgUpdateChecksumParams{
+		Signer: signer,
+		Params: params,
+	}
+}
+
+// ValidateBasic implements sdk.HasValidateBasic
+func (m MsgUpdateChecksumParams) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	return m.Params.Validate()
+}
+
+// NewMsgApproveChecksum creates a new MsgApproveChecksum instance
+func NewMsgApproveChecksum(signer string, checksum []byte, uploader string) *MsgApproveChecksum {
+	return &MsgApproveChecksum{
+		Signer:   signer,
+		Checksum: checksum,
+		Uploader: uploader,
+	}
+}
+
+// ValidateBasic implements sdk.HasValidateBasic
+func (m MsgApproveChecksum) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	return NewApprovedChecksum(m.Checksum, m.Uploader).Validate()
+}
+
+// NewMsgRollbackContract creates a new MsgRollbackContract instance
+func NewMsgRollbackContract(signer, clientID string) *MsgRollbackContract {
+	return &MsgRollbackContract{
+		Signer:   signer,
+		ClientId: clientID,
+	}
+}
+
+// ValidateBasic implements sdk.HasValidateBasic
+func (m MsgRollbackContract) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	return ValidateClientID(m.ClientId)
+}
+
+// NewMsgPinCodes creates a new MsgPinCodes instance
+func NewMsgPinCodes(signer string, checksums [][]byte) *MsgPinCodes {
+	return &MsgPinCodes{
+		Signer:    signer,
+		Checksums: checksums,
+	}
+}
+
+// ValidateBasic implements sdk.HasValidateBasic
+func (m MsgPinCodes) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	return validateChecksumList(m.Checksums)
+}
+
+// NewMsgUnpinCodes creates a new MsgUnpinCodes instance
+func NewMsgUnpinCodes(signer string, checksums [][]byte) *MsgUnpinCodes {
+	return &MsgUnpinCodes{
+		Signer:    signer,
+		Checksums: checksums,
+	}
+}
+
+// ValidateBasic implements sdk.HasValidateBasic
+func (m MsgUnpinCodes) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	return validateChecksumList(m.Checksums)
+}
+
+// validateChecksumList validates that the list of checksums is not empty, and that
+// every checksum is valid and appears only once.
+func validateChecksumList(checksums [][]byte) error {
+	if len(checksums) == 0 {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidRequest, "checksums cannot be empty")
+	}
+
+	seen := make(map[string]bool, len(checksums))
+	for _, checksum := range checksums {
+		if err := ValidateWasmChecksum(checksum); err != nil {
+			return err
+		}
+
+		if seen[string(checksum)] {
+			return errorsmod.Wrapf(ErrInvalidChecksum, "duplicate checksum %s", hex.EncodeToString(checksum))
+		}
+		seen[string(checksum)] = true
+	}
+
+	return nil
+}
@@ -266,3 +266,240 @@ func (suite *TypesTestSuite) TestMsgRemoveChecksumGetSigners() {
 		})
 	}
 }
+
+func TestMsgUpdateChecksumParamsValidateBasic(t *testing.T) {
+	signer := sdk.AccAddress(ibctesting.TestAccAddress).String()
+	checksum, err := types.CreateChecksum(wasmtesting.Code)
+	require.NoError(t, err, t.Name())
+
+	testCases := []struct {
+		name   string
+		msg    *types.MsgUpdateChecksumParams
+		expErr error
+	}{
+		{
+			"success: valid signer address, valid params",
+			types.NewMsgUpdateChecksumParams(signer, types.NewChecksumParams(checksum, 1024, 10)),
+			nil,
+		},
+		{
+			"success: no limits",
+			types.NewMsgUpdateChecksumParams(signer, types.NewChecksumParams(checksum, 0, 0)),
+			nil,
+		},
+		{
+			"failure: checksum is empty",
+			types.NewMsgUpdateChecksumParams(signer, types.NewChecksumParams([]byte(""), 1024, 10)),
+			types.ErrInvalidChecksum,
+		},
+		{
+			"failure: signer is invalid",
+			types.NewMsgUpdateChecksumParams(ibctesting.InvalidID, types.NewChecksumParams(checksum, 1024, 10)),
+			ibcerrors.ErrInvalidAddress,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		err := tc.msg.ValidateBasic()
+
+		if tc.expErr == nil {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.ErrorIs(t, err, tc.expErr, tc.name)
+		}
+	}
+}
+
+func TestMsgApproveChecksumValidateBasic(t *testing.T) {
+	signer := sdk.AccAddress(ibctesting.TestAccAddress).String()
+	checksum, err := types.CreateChecksum(wasmtesting.Code)
+	require.NoError(t, err, t.Name())
+
+	testCases := []struct {
+		name   string
+		msg    *types.MsgApproveChecksum
+		expErr error
+	}{
+		{
+			"success: approved for anyone",
+			types.NewMsgApproveChecksum(signer, checksum, ""),
+			nil,
+		},
+		{
+			"success: approved for uploader",
+			types.NewMsgApproveChecksum(signer, checksum, signer),
+			nil,
+		},
+		{
+			"failure: checksum is empty",
+			types.NewMsgApproveChecksum(signer, []byte(""), ""),
+			types.ErrInvalidChecksum,
+		},
+		{
+			"failure: uploader is invalid",
+			types.NewMsgApproveChecksum(signer, checksum, ibctesting.InvalidID),
+			ibcerrors.ErrInvalidAddress,
+		},
+		{
+			"failure: signer is invalid",
+			types.NewMsgApproveChecksum(ibctesting.InvalidID, checksum, ""),
+			ibcerrors.ErrInvalidAddress,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		err := tc.msg.ValidateBasic()
+
+		if tc.expErr == nil {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.ErrorIs(t, err, tc.expErr, tc.name)
+		}
+	}
+}
+
+func TestMsgRollbackContractValidateBasic(t *testing.T) {
+	signer := sdk.AccAddress(ibctesting.TestAccAddress).String()
+
+	testCases := []struct {
+		name   string
+		msg    *types.MsgRollbackContract
+		expErr error
+	}{
+		{
+			"success: valid signer address, valid client id",
+			types.NewMsgRollbackContract(signer, defaultWasmClientID),
+			nil,
+		},
+		{
+			"failure: invalid client id",
+			types.NewMsgRollbackContract(signer, ibctesting.InvalidID),
+			host.ErrInvalidID,
+		},
+		{
+			"failure: signer is invalid",
+			types.NewMsgRollbackContract(ibctesting.InvalidID, defaultWasmClientID),
+			ibcerrors.ErrInvalidAddress,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		err := tc.msg.ValidateBasic()
+
+		if tc.expErr == nil {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.ErrorIs(t, err, tc.expErr, tc.name)
+		}
+	}
+}
+
+func TestMsgPinCodesValidateBasic(t *testing.T) {
+	signer := sdk.AccAddress(ibctesting.TestAccAddress).String()
+	checksum, err := types.CreateChecksum(wasmtesting.Code)
+	require.NoError(t, err, t.Name())
+	otherChecksum, err := types.CreateChecksum(wasmtesting.CreateMockContract([]byte("TestMsgPinCodesValidateBasic")))
+	require.NoError(t, err, t.Name())
+
+	testCases := []struct {
+		name   string
+		msg    *types.MsgPinCodes
+		expErr error
+	}{
+		{
+			"success: valid signer address, valid checksums",
+			types.NewMsgPinCodes(signer, [][]byte{checksum, otherChecksum}),
+			nil,
+		},
+		{
+			"failure: checksums are empty",
+			types.NewMsgPinCodes(signer, nil),
+			ibcerrors.ErrInvalidRequest,
+		},
+		{
+			"failure: checksum is invalid",
+			types.NewMsgPinCodes(signer, [][]byte{checksum, []byte("")}),
+			types.ErrInvalidChecksum,
+		},
+		{
+			"failure: duplicate checksum",
+			types.NewMsgPinCodes(signer, [][]byte{checksum, otherChecksum, checksum}),
+			types.ErrInvalidChecksum,
+		},
+		{
+			"failure: signer is invalid",
+			types.NewMsgPinCodes(ibctesting.InvalidID, [][]byte{checksum}),
+			ibcerrors.ErrInvalidAddress,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		err := tc.msg.ValidateBasic()
+
+		if tc.expErr == nil {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.ErrorIs(t, err, tc.expErr, tc.name)
+		}
+	}
+}
+
+func TestMsgUnpinCodesValidateBasic(t *testing.T) {
+	signer := sdk.AccAddress(ibctesting.TestAccAddress).String()
+	checksum, err := types.CreateChecksum(wasmtesting.Code)
+	require.NoError(t, err, t.Name())
+	otherChecksum, err := types.CreateChecksum(wasmtesting.CreateMockContract([]byte("TestMsgUnpinCodesValidateBasic")))
+	require.NoError(t, err, t.Name())
+
+	testCases := []struct {
+		name   string
+		msg    *types.MsgUnpinCodes
+		expErr error
+	}{
+		{
+			"success: valid signer address, valid checksums",
+			types.NewMsgUnpinCodes(signer, [][]byte{checksum, otherChecksum}),
+			nil,
+		},
+		{
+			"failure: checksums are empty",
+			types.NewMsgUnpinCodes(signer, nil),
+			ibcerrors.ErrInvalidRequest,
+		},
+		{
+			"failure: checksum is invalid",
+			types.NewMsgUnpinCodes(signer, [][]byte{checksum, []byte("")}),
+			types.ErrInvalidChecksum,
+		},
+		{
+			"failure: duplicate checksum",
+			types.NewMsgUnpinCodes(signer, [][]byte{checksum, otherChecksum, checksum}),
+			types.ErrInvalidChecksum,
+		},
+		{
+			"failure: signer is invalid",
+			types.NewMsgUnpinCodes(ibctesting.InvalidID, [][]byte{checksum}),
+			ibcerrors.ErrInvalidAddress,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		err := tc.msg.ValidateBasic()
+
+		if tc.expErr == nil {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.ErrorIs(t, err, tc.expErr, tc.name)
+		}
+	}
+}
@@ -7,6 +7,7 @@ import (
 	context "context"
 	fmt "fmt"
 	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
@@ -221,383 +222,3194 @@ func (m *QueryCodeResponse) GetData() []byte {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryChecksumsRequest)(nil), "ibc.lightclients.wasm.v1.QueryChecksumsRequest")
-	proto.RegisterType((*QueryChecksumsResponse)(nil), "ibc.lightclients.wasm.v1.QueryChecksumsResponse")
-	proto.RegisterType((*QueryCodeRequest)(nil), "ibc.lightclients.wasm.v1.QueryCodeRequest")
-	proto.RegisterType((*QueryCodeResponse)(nil), "ibc.lightclients.wasm.v1.QueryCodeResponse")
+// QueryChecksumParamsRequest is the request type for the Query/ChecksumParams RPC method.
+type QueryChecksumParamsRequest struct {
+	// checksum is a hex encoded string of the code stored.
+	Checksum string `protobuf:"bytes,1,opt,name=checksum,proto3" json:"checksum,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("ibc/lightclients/wasm/v1/query.proto", fileDescriptor_9e3718a8cb915777)
+func (m *QueryChecksumParamsRequest) Reset()         { *m = QueryChecksumParamsRequest{} }
+func (m *QueryChecksumParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryChecksumParamsRequest) ProtoMessage()    {}
+func (*QueryChecksumParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e3718a8cb915777, []int{4}
 }
-
-var fileDescriptor_9e3718a8cb915777 = []byte{
-	// 432 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xcf, 0xaa, 0xd3, 0x40,
-	0x14, 0xc6, 0x3b, 0xf5, 0x2a, 0x66, 0x74, 0xa1, 0x03, 0x4a, 0x09, 0x97, 0x70, 0x89, 0x7f, 0xee,
-	0xe5, 0x5e, 0x3a, 0xd3, 0xb4, 0x08, 0x82, 0xae, 0x14, 0x74, 0xab, 0x59, 0xb8, 0x70, 0x23, 0x93,
-	0xc9, 0x90, 0x0e, 0x26, 0x99, 0xb4, 0x33, 0xa9, 0x14, 0x11, 0xc1, 0x27, 0x10, 0x5c, 0xea, 0xe3,
-	0xb8, 0x70, 0x59, 0x70, 0xe3, 0x52, 0x5a, 0x1f, 0x44, 0x32, 0x93, 0x34, 0xad, 0x58, 0xda, 0xdd,
-	0xe4, 0xf0, 0x3b, 0xdf, 0xf7, 0x9d, 0x93, 0x03, 0xef, 0x8a, 0x88, 0x91, 0x54, 0x24, 0x63, 0xcd,
-	0x52, 0xc1, 0x73, 0xad, 0xc8, 0x3b, 0xaa, 0x32, 0x32, 0x0b, 0xc8, 0xa4, 0xe4, 0xd3, 0x39, 0x2e,
-	0xa6, 0x52, 0x4b, 0xd4, 0x13, 0x11, 0xc3, 0x9b, 0x14, 0xae, 0x28, 0x3c, 0x0b, 0xdc, 0xe3, 0x44,
-	0xca, 0x24, 0xe5, 0x84, 0x16, 0x82, 0xd0, 0x3c, 0x97, 0x9a, 0x6a, 0x21, 0x73, 0x65, 0xfb, 0xdc,
-	0x73, 0x26, 0x55, 0x26, 0x15, 0x89, 0xa8, 0xe2, 0x56, 0x90, 0xcc, 0x82, 0x88, 0x6b, 0x1a, 0x90,
-	0x82, 0x26, 0x22, 0x37, 0xb0, 0x65, 0xfd, 0x37, 0xf0, 0xd6, 0xcb, 0x8a, 0x78, 0x3a, 0xe6, 0xec,
-	0xad, 0x2a, 0x33, 0x15, 0xf2, 0x49, 0xc9, 0x95, 0x46, 0xcf, 0x20, 0x6c, 0xe1, 0x1e, 0x38, 0x01,
-	0x67, 0xd7, 0x86, 0xf7, 0xb1, 0x55, 0xc6, 0x95, 0x32, 0xb6, 0x51, 0x6b, 0x65, 0xfc, 0x82, 0x26,
-	0xbc, 0xee, 0x0d, 0x37, 0x3a, 0xfd, 0x8f, 0xf0, 0xf6, 0xbf, 0x06, 0xaa, 0x90, 0xb9, 0xe2, 0xe8,
-	0x18, 0x3a, 0xac, 0x29, 0xf6, 0xc0, 0xc9, 0xa5, 0x33, 0x27, 0x6c, 0x0b, 0xe8, 0xf9, 0x96, 0x7f,
-	0xd7, 0xf8, 0x9f, 0xee, 0xf5, 0xb7, 0xd2, 0x5b, 0x01, 0x30, 0xbc, 0x61, 0x03, 0xc8, 0xb8, 0x09,
-	0x88, 0x5c, 0x78, 0xb5, 0x71, 0x32, 0xa3, 0x39, 0xe1, 0xfa, 0xdb, 0x3f, 0x85, 0x37, 0x37, 0xf8,
-	0x3a, 0x2b, 0x82, 0x47, 0x31, 0xd5, 0xd4, 0xc0, 0xd7, 0x43, 0xf3, 0x1e, 0x7e, 0xef, 0xc2, 0xcb,
-	0x86, 0x44, 0x5f, 0x01, 0x74, 0xd6, 0xf3, 0x21, 0x82, 0x77, 0xfd, 0x37, 0xfc, 0xdf, 0x55, 0xbb,
-	0x83, 0xc3, 0x1b, 0x6c, 0x1c, 0xff, 0xe2, 0xd3, 0xcf, 0x3f, 0x5f, 0xba, 0xf7, 0xd0, 0x1d, 0xb2,
-	0xf3, 0x90, 0xda, 0x4d, 0x7e, 0x03, 0xf0, 0xa8, 0x1a, 0x06, 0x9d, 0xef, 0xf3, 0x69, 0x37, 0xe4,
-	0x5e, 0x1c, 0xc4, 0xd6, 0x71, 0x1e, 0x99, 0x38, 0x0f, 0xd0, 0xe8, 0x80, 0x38, 0xe4, 0x7d, 0xf3,
-	0xfc, 0x40, 0x98, 0x8c, 0xf9, 0x93, 0x57, 0x3f, 0x96, 0x1e, 0x58, 0x2c, 0x3d, 0xf0, 0x7b, 0xe9,
-	0x81, 0xcf, 0x2b, 0xaf, 0xb3, 0x58, 0x79, 0x9d, 0x5f, 0x2b, 0xaf, 0xf3, 0xfa, 0x71, 0x22, 0xf4,
-	0xb8, 0x8c, 0x30, 0x93, 0x19, 0xa9, 0x4f, 0x5a, 0x44, 0xac, 0x9f, 0x48, 0x92, 0xc9, 0xb8, 0x4c,
-	0xb9, 0xb2, 0x56, 0xfd, 0xc6, 0x6b, 0xf0, 0xb0, 0x6f, 0xec, 0xf4, 0xbc, 0xe0, 0x2a, 0xba, 0x62,
-	0x0e, 0x7c, 0xf4, 0x37, 0x00, 0x00, 0xff, 0xff, 0xbe, 0x82, 0x4a, 0xb3, 0x6c, 0x03, 0x00, 0x00,
+func (m *QueryChecksumParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryChecksumParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryChecksumParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryChecksumParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryChecksumParamsRequest.Merge(m, src)
+}
+func (m *QueryChecksumParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryChecksumParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryChecksumParamsRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryChecksumParamsRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Get all Wasm checksums
-	Checksums(ctx context.Context, in *QueryChecksumsRequest, opts ...grpc.CallOption) (*QueryChecksumsResponse, error)
-	// Get Wasm code for given checksum
-	Code(ctx context.Context, in *QueryCodeRequest, opts ...grpc.CallOption) (*QueryCodeResponse, error)
+func (m *QueryChecksumParamsRequest) GetChecksum() string {
+	if m != nil {
+		return m.Checksum
+	}
+	return ""
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+// QueryChecksumParamsResponse is the response type for the Query/ChecksumParams RPC method.
+type QueryChecksumParamsResponse struct {
+	Params ChecksumParams `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryChecksumParamsResponse) Reset()         { *m = QueryChecksumParamsResponse{} }
+func (m *QueryChecksumParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryChecksumParamsResponse) ProtoMessage()    {}
+func (*QueryChecksumParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e3718a8cb915777, []int{5}
 }
-
-func (c *queryClient) Checksums(ctx context.Context, in *QueryChecksumsRequest, opts ...grpc.CallOption) (*QueryChecksumsResponse, error) {
-	out := new(QueryChecksumsResponse)
-	err := c.cc.Invoke(ctx, "/ibc.lightclients.wasm.v1.Query/Checksums", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryChecksumParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryChecksumParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryChecksumParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryChecksumParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryChecksumParamsResponse.Merge(m, src)
+}
+func (m *QueryChecksumParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryChecksumParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryChecksumParamsResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) Code(ctx context.Context, in *QueryCodeRequest, opts ...grpc.CallOption) (*QueryCodeResponse, error) {
-	out := new(QueryCodeResponse)
-	err := c.cc.Invoke(ctx, "/ibc.lightclients.wasm.v1.Query/Code", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryChecksumParamsResponse proto.InternalMessageInfo
+
+func (m *QueryChecksumParamsResponse) GetParams() ChecksumParams {
+	if m != nil {
+		return m.Params
 	}
-	return out, nil
+	return ChecksumParams{}
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Get all Wasm checksums
-	Checksums(context.Context, *QueryChecksumsRequest) (*QueryChecksumsResponse, error)
-	// Get Wasm code for given checksum
-	Code(context.Context, *QueryCodeRequest) (*QueryCodeResponse, error)
+// QueryClientStoreSizeRequest is the request type for the Query/ClientStoreSize RPC method.
+type QueryClientStoreSizeRequest struct {
+	// client_id is the identifier of the 08-wasm light client.
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryClientStoreSizeRequest) Reset()         { *m = QueryClientStoreSizeRequest{} }
+func (m *QueryClientStoreSizeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryClientStoreSizeRequest) ProtoMessage()    {}
+func (*QueryClientStoreSizeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e3718a8cb915777, []int{6}
 }
-
-func (*UnimplementedQueryServer) Checksums(ctx context.Context, req *QueryChecksumsRequest) (*QueryChecksumsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Checksums not implemented")
+func (m *QueryClientStoreSizeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) Code(ctx context.Context, req *QueryCodeRequest) (*QueryCodeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Code not implemented")
+func (m *QueryClientStoreSizeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClientStoreSizeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryClientStoreSizeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClientStoreSizeRequest.Merge(m, src)
+}
+func (m *QueryClientStoreSizeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClientStoreSizeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClientStoreSizeRequest.DiscardUnknown(m)
 }
 
-func _Query_Checksums_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryChecksumsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Checksums(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ibc.lightclients.wasm.v1.Query/Checksums",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Checksums(ctx, req.(*QueryChecksumsRequest))
+var xxx_messageInfo_QueryClientStoreSizeRequest proto.InternalMessageInfo
+
+func (m *QueryClientStoreSizeRequest) GetClientId() string {
+	if m != nil {
+		return m.ClientId
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_Code_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryCodeRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Code(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ibc.lightclients.wasm.v1.Query/Code",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Code(ctx, req.(*QueryCodeRequest))
+// QueryClientStoreSizeResponse is the response type for the Query/ClientStoreSize RPC method.
+type QueryClientStoreSizeResponse struct {
+	// size is the sum of the lengths of all keys and values in the client store.
+	Size_ uint64 `protobuf:"varint,1,opt,name=size,proto3" json:"size,omitempty"`
+	// max_size is the maximum client store size allowed for the client's checksum, zero if unlimited.
+	MaxSize uint64 `protobuf:"varint,2,opt,name=max_size,json=maxSize,proto3" json:"max_size,omitempty"`
+}
+
+func (m *QueryClientStoreSizeResponse) Reset()         { *m = QueryClientStoreSizeResponse{} }
+func (m *QueryClientStoreSizeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryClientStoreSizeResponse) ProtoMessage()    {}
+func (*QueryClientStoreSizeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e3718a8cb915777, []int{7}
+}
+func (m *QueryClientStoreSizeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryClientStoreSizeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClientStoreSizeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryClientStoreSizeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClientStoreSizeResponse.Merge(m, src)
+}
+func (m *QueryClientStoreSizeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClientStoreSizeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClientStoreSizeResponse.DiscardUnknown(m)
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "ibc.lightclients.wasm.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Checksums",
-			Handler:    _Query_Checksums_Handler,
-		},
-		{
-			MethodName: "Code",
-			Handler:    _Query_Code_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "ibc/lightclients/wasm/v1/query.proto",
+var xxx_messageInfo_QueryClientStoreSizeResponse proto.InternalMessageInfo
+
+func (m *QueryClientStoreSizeResponse) GetSize_() uint64 {
+	if m != nil {
+		return m.Size_
+	}
+	return 0
 }
 
-func (m *QueryChecksumsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryClientStoreSizeResponse) GetMaxSize() uint64 {
+	if m != nil {
+		return m.MaxSize
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryChecksumsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryApprovedChecksumsRequest is the request type for the Query/ApprovedChecksums RPC method.
+type QueryApprovedChecksumsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryChecksumsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryApprovedChecksumsRequest) Reset()         { *m = QueryApprovedChecksumsRequest{} }
+func (m *QueryApprovedChecksumsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryApprovedChecksumsRequest) ProtoMessage()    {}
+func (*QueryApprovedChecksumsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e3718a8cb915777, []int{8}
+}
+func (m *QueryApprovedChecksumsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryApprovedChecksumsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryApprovedChecksumsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryApprovedChecksumsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryApprovedChecksumsRequest.Merge(m, src)
+}
+func (m *QueryApprovedChecksumsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryApprovedChecksumsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryApprovedChecksumsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryChecksumsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryApprovedChecksumsRequest proto.InternalMessageInfo
+
+func (m *QueryApprovedChecksumsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryChecksumsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryApprovedChecksumsResponse is the response type for the Query/ApprovedChecksums RPC method.
+type QueryApprovedChecksumsResponse struct {
+	// approved_checksums is a list of the checksums approved for upload but not yet stored.
+	ApprovedChecksums []ApprovedChecksum `protobuf:"bytes,1,rep,name=approved_checksums,json=approvedChecksums,proto3" json:"approved_checksums"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryChecksumsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryApprovedChecksumsResponse) Reset()         { *m = QueryApprovedChecksumsResponse{} }
+func (m *QueryApprovedChecksumsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryApprovedChecksumsResponse) ProtoMessage()    {}
+func (*QueryApprovedChecksumsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e3718a8cb915777, []int{9}
+}
+func (m *QueryApprovedChecksumsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryApprovedChecksumsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryApprovedChecksumsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.Checksums) > 0 {
-		for iNdEx := len(m.Checksums) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Checksums[iNdEx])
-			copy(dAtA[i:], m.Checksums[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.Checksums[iNdEx])))
-			i--
-			dAtA[i] = 0xa
+}
+func (m *QueryApprovedChecksumsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryApprovedChecksumsResponse.Merge(m, src)
+}
+func (m *QueryApprovedChecksumsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryApprovedChecksumsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryApprovedChecksumsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryApprovedChecksumsResponse proto.InternalMessageInfo
+
+func (m *QueryApprovedChecksumsResponse) GetApprovedChecksums() []ApprovedChecksum {
+	if m != nil {
+		return m.ApprovedChecksums
+	}
+	return nil
+}
+
+func (m *QueryApprovedChecksumsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QuerySimulateMigrateContractRequest is the request type for the Query/SimulateMigrateContract RPC method.
+type QuerySimulateMigrateContractRequest struct {
+	// the client id of the contract
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// checksum is a hex encoded string of the code to migrate to.
+	Checksum string `protobuf:"bytes,2,opt,name=checksum,proto3" json:"checksum,omitempty"`
+	// the json encoded message to be passed to the contract on migration
+	Msg []byte `protobuf:"bytes,3,opt,name=msg,proto3" json:"msg,omitempty"`
+}
+
+func (m *QuerySimulateMigrateContractRequest) Reset()         { *m = QuerySimulateMigrateContractRequest{} }
+func (m *QuerySimulateMigrateContractRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateMigrateContractRequest) ProtoMessage()    {}
+func (*QuerySimulateMigrateContractRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e3718a8cb915777, []int{10}
+}
+func (m *QuerySimulateMigrateContractRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulateMigrateContractRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulateMigrateContractRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QuerySimulateMigrateContractRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateMigrateContractRequest.Merge(m, src)
+}
+func (m *QuerySimulateMigrateContractRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulateMigrateContractRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateMigrateContractRequest.DiscardUnknown(m)
 }
 
-func (m *QueryCodeRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QuerySimulateMigrateContractRequest proto.InternalMessageInfo
+
+func (m *QuerySimulateMigrateContractRequest) GetClientId() string {
+	if m != nil {
+		return m.ClientId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryCodeRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QuerySimulateMigrateContractRequest) GetChecksum() string {
+	if m != nil {
+		return m.Checksum
+	}
+	return ""
 }
 
-func (m *QueryCodeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Checksum) > 0 {
-		i -= len(m.Checksum)
-		copy(dAtA[i:], m.Checksum)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Checksum)))
-		i--
-		dAtA[i] = 0xa
+func (m *QuerySimulateMigrateContractRequest) GetMsg() []byte {
+	if m != nil {
+		return m.Msg
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryCodeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+// QuerySimulateMigrateContractResponse is the response type for the Query/SimulateMigrateContract RPC method.
+type QuerySimulateMigrateContractResponse struct {
+	// the client state of the light client after the migration
+	ClientState *ClientState `protobuf:"bytes,1,opt,name=client_state,json=clientState,proto3" json:"client_state,omitempty"`
+	// the status of the light client after the migration
+	Status string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (m *QuerySimulateMigrateContractResponse) Reset()         { *m = QuerySimulateMigrateContractResponse{} }
+func (m *QuerySimulateMigrateContractResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateMigrateContractResponse) ProtoMessage()    {}
+func (*QuerySimulateMigrateContractResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e3718a8cb915777, []int{11}
+}
+func (m *QuerySimulateMigrateContractResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulateMigrateContractResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulateMigrateContractResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QuerySimulateMigrateContractResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateMigrateContractResponse.Merge(m, src)
+}
+func (m *QuerySimulateMigrateContractResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulateMigrateContractResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateMigrateContractResponse.DiscardUnknown(m)
 }
 
-func (m *QueryCodeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QuerySimulateMigrateContractResponse proto.InternalMessageInfo
+
+func (m *QuerySimulateMigrateContractResponse) GetClientState() *ClientState {
+	if m != nil {
+		return m.ClientState
+	}
+	return nil
 }
 
-func (m *QueryCodeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Data) > 0 {
-		i -= len(m.Data)
-		copy(dAtA[i:], m.Data)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Data)))
-		i--
-		dAtA[i] = 0xa
+func (m *QuerySimulateMigrateContractResponse) GetStatus() string {
+	if m != nil {
+		return m.Status
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+// QueryPinnedCodesRequest is the request type for the Query/PinnedCodes RPC method.
+type QueryPinnedCodesRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPinnedCodesRequest) Reset()         { *m = QueryPinnedCodesRequest{} }
+func (m *QueryPinnedCodesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPinnedCodesRequest) ProtoMessage()    {}
+func (*QueryPinnedCodesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e3718a8cb915777, []int{12}
+}
+func (m *QueryPinnedCodesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPinnedCodesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPinnedCodesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	dAtA[offset] = uint8(v)
-	return base
 }
-func (m *QueryChecksumsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryPinnedCodesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPinnedCodesRequest.Merge(m, src)
+}
+func (m *QueryPinnedCodesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPinnedCodesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPinnedCodesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPinnedCodesRequest proto.InternalMessageInfo
+
+func (m *QueryPinnedCodesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+
+// QueryPinnedCodesResponse is the response type for the Query/PinnedCodes RPC method.
+// The metrics are node-specific.
+type QueryPinnedCodesResponse struct {
+	// pinned_codes is the list of pinned codes together with their cache metrics.
+	PinnedCodes []PinnedCode `protobuf:"bytes,1,rep,name=pinned_codes,json=pinnedCodes,proto3" json:"pinned_codes"`
+	// cache_metrics are the cache metrics of the Wasm VM.
+	CacheMetrics CacheMetrics `protobuf:"bytes,2,opt,name=cache_metrics,json=cacheMetrics,proto3" json:"cache_metrics"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPinnedCodesResponse) Reset()         { *m = QueryPinnedCodesResponse{} }
+func (m *QueryPinnedCodesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPinnedCodesResponse) ProtoMessage()    {}
+func (*QueryPinnedCodesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e3718a8cb915777, []int{13}
+}
+func (m *QueryPinnedCodesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPinnedCodesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPinnedCodesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryPinnedCodesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPinnedCodesResponse.Merge(m, src)
+}
+func (m *QueryPinnedCodesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPinnedCodesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPinnedCodesResponse.DiscardUnknown(m)
 }
 
-func (m *QueryChecksumsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryPinnedCodesResponse proto.InternalMessageInfo
+
+func (m *QueryPinnedCodesResponse) GetPinnedCodes() []PinnedCode {
+	if m != nil {
+		return m.PinnedCodes
 	}
-	var l int
-	_ = l
-	if len(m.Checksums) > 0 {
-		for _, s := range m.Checksums {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+
+func (m *QueryPinnedCodesResponse) GetCacheMetrics() CacheMetrics {
+	if m != nil {
+		return m.CacheMetrics
+	}
+	return CacheMetrics{}
+}
+
+func (m *QueryPinnedCodesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// PinnedCode defines a code pinned to the Wasm VM in-memory cache and its cache metrics.
+type PinnedCode struct {
+	// checksum is a hex encoded string of the pinned code.
+	Checksum string `protobuf:"bytes,1,opt,name=checksum,proto3" json:"checksum,omitempty"`
+	// the number of cache hits of the code since the node started
+	Hits uint32 `protobuf:"varint,2,opt,name=hits,proto3" json:"hits,omitempty"`
+	// the size in bytes of the code in the cache
+	Size_ uint64 `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+}
+
+func (m *PinnedCode) Reset()         { *m = PinnedCode{} }
+func (m *PinnedCode) String() string { return proto.CompactTextString(m) }
+func (*PinnedCode) ProtoMessage()    {}
+func (*PinnedCode) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e3718a8cb915777, []int{14}
+}
+func (m *PinnedCode) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PinnedCode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PinnedCode.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PinnedCode) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PinnedCode.Merge(m, src)
+}
+func (m *PinnedCode) XXX_Size() int {
+	return m.Size()
+}
+func (m *PinnedCode) XXX_DiscardUnknown() {
+	xxx_messageInfo_PinnedCode.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PinnedCode proto.InternalMessageInfo
+
+func (m *PinnedCode) GetChecksum() string {
+	if m != nil {
+		return m.Checksum
+	}
+	return ""
+}
+
+func (m *PinnedCode) GetHits() uint32 {
+	if m != nil {
+		return m.Hits
+	}
+	return 0
+}
+
+func (m *PinnedCode) GetSize_() uint64 {
+	if m != nil {
+		return m.Size_
+	}
+	return 0
+}
+
+// CacheMetrics defines the cache metrics of the Wasm VM.
+type CacheMetrics struct {
+	HitsPinnedMemoryCache     uint32 `protobuf:"varint,1,opt,name=hits_pinned_memory_cache,json=hitsPinnedMemoryCache,proto3" json:"hits_pinned_memory_cache,omitempty"`
+	HitsMemoryCache           uint32 `protobuf:"varint,2,opt,name=hits_memory_cache,json=hitsMemoryCache,proto3" json:"hits_memory_cache,omitempty"`
+	HitsFsCache               uint32 `protobuf:"varint,3,opt,name=hits_fs_cache,json=hitsFsCache,proto3" json:"hits_fs_cache,omitempty"`
+	Misses                    uint32 `protobuf:"varint,4,opt,name=misses,proto3" json:"misses,omitempty"`
+	ElementsPinnedMemoryCache uint64 `protobuf:"varint,5,opt,name=elements_pinned_memory_cache,json=elementsPinnedMemoryCache,proto3" json:"elements_pinned_memory_cache,omitempty"`
+	ElementsMemoryCache       uint64 `protobuf:"varint,6,opt,name=elements_memory_cache,json=elementsMemoryCache,proto3" json:"elements_memory_cache,omitempty"`
+	SizePinnedMemoryCache     uint64 `protobuf:"varint,7,opt,name=size_pinned_memory_cache,json=sizePinnedMemoryCache,proto3" json:"size_pinned_memory_cache,omitempty"`
+	SizeMemoryCache           uint64 `protobuf:"varint,8,opt,name=size_memory_cache,json=sizeMemoryCache,proto3" json:"size_memory_cache,omitempty"`
+}
+
+func (m *CacheMetrics) Reset()         { *m = CacheMetrics{} }
+func (m *CacheMetrics) String() string { return proto.CompactTextString(m) }
+func (*CacheMetrics) ProtoMessage()    {}
+func (*CacheMetrics) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e3718a8cb915777, []int{15}
+}
+func (m *CacheMetrics) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CacheMetrics) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CacheMetrics.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CacheMetrics) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CacheMetrics.Merge(m, src)
+}
+func (m *CacheMetrics) XXX_Size() int {
+	return m.Size()
+}
+func (m *CacheMetrics) XXX_DiscardUnknown() {
+	xxx_messageInfo_CacheMetrics.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CacheMetrics proto.InternalMessageInfo
+
+func (m *CacheMetrics) GetHitsPinnedMemoryCache() uint32 {
+	if m != nil {
+		return m.HitsPinnedMemoryCache
+	}
+	return 0
+}
+
+func (m *CacheMetrics) GetHitsMemoryCache() uint32 {
+	if m != nil {
+		return m.HitsMemoryCache
+	}
+	return 0
+}
+
+func (m *CacheMetrics) GetHitsFsCache() uint32 {
+	if m != nil {
+		return m.HitsFsCache
+	}
+	return 0
+}
+
+func (m *CacheMetrics) GetMisses() uint32 {
+	if m != nil {
+		return m.Misses
+	}
+	return 0
+}
+
+func (m *CacheMetrics) GetElementsPinnedMemoryCache() uint64 {
+	if m != nil {
+		return m.ElementsPinnedMemoryCache
+	}
+	return 0
+}
+
+func (m *CacheMetrics) GetElementsMemoryCache() uint64 {
+	if m != nil {
+		return m.ElementsMemoryCache
+	}
+	return 0
+}
+
+func (m *CacheMetrics) GetSizePinnedMemoryCache() uint64 {
+	if m != nil {
+		return m.SizePinnedMemoryCache
+	}
+	return 0
+}
+
+func (m *CacheMetrics) GetSizeMemoryCache() uint64 {
+	if m != nil {
+		return m.SizeMemoryCache
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*QueryChecksumsRequest)(nil), "ibc.lightclients.wasm.v1.QueryChecksumsRequest")
+	proto.RegisterType((*QueryChecksumsResponse)(nil), "ibc.lightclients.wasm.v1.QueryChecksumsResponse")
+	proto.RegisterType((*QueryCodeRequest)(nil), "ibc.lightclients.wasm.v1.QueryCodeRequest")
+	proto.RegisterType((*QueryCodeResponse)(nil), "ibc.lightclients.wasm.v1.QueryCodeResponse")
+	proto.RegisterType((*QueryChecksumParamsRequest)(nil), "ibc.lightclients.wasm.v1.QueryChecksumParamsRequest")
+	proto.RegisterType((*QueryChecksumParamsResponse)(nil), "ibc.lightclients.wasm.v1.QueryChecksumParamsResponse")
+	proto.RegisterType((*QueryClientStoreSizeRequest)(nil), "ibc.lightclients.wasm.v1.QueryClientStoreSizeRequest")
+	proto.RegisterType((*QueryClientStoreSizeResponse)(nil), "ibc.lightclients.wasm.v1.QueryClientStoreSizeResponse")
+	proto.RegisterType((*QueryApprovedChecksumsRequest)(nil), "ibc.lightclients.wasm.v1.QueryApprovedChecksumsRequest")
+	proto.RegisterType((*QueryApprovedChecksumsResponse)(nil), "ibc.lightclients.wasm.v1.QueryApprovedChecksumsResponse")
+	proto.RegisterType((*QuerySimulateMigrateContractRequest)(nil), "ibc.lightclients.wasm.v1.QuerySimulateMigrateContractRequest")
+	proto.RegisterType((*QuerySimulateMigrateContractResponse)(nil), "ibc.lightclients.wasm.v1.QuerySimulateMigrateContractResponse")
+	proto.RegisterType((*QueryPinnedCodesRequest)(nil), "ibc.lightclients.wasm.v1.QueryPinnedCodesRequest")
+	proto.RegisterType((*QueryPinnedCodesResponse)(nil), "ibc.lightclients.wasm.v1.QueryPinnedCodesResponse")
+	proto.RegisterType((*PinnedCode)(nil), "ibc.lightclients.wasm.v1.PinnedCode")
+	proto.RegisterType((*CacheMetrics)(nil), "ibc.lightclients.wasm.v1.CacheMetrics")
+}
+
+func init() {
+	proto.RegisterFile("ibc/lightclients/wasm/v1/query.proto", fileDescriptor_9e3718a8cb915777)
+}
+
+var fileDescriptor_9e3718a8cb915777 = []byte{
+	// 1102 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcf, 0x6f, 0xdc, 0xc4,
+	0x17, 0x8f, 0xb3, 0x69, 0x9a, 0xbc, 0x4d, 0xbe, 0x69, 0xe6, 0x4b, 0xd2, 0xad, 0x1b, 0x96, 0xca,
+	0x49, 0xd3, 0x28, 0x25, 0x76, 0x93, 0x36, 0x24, 0x0a, 0x94, 0x1f, 0xad, 0x94, 0x82, 0x44, 0xa4,
+	0xd4, 0x91, 0x38, 0x70, 0xb1, 0x66, 0xbd, 0x83, 0x63, 0xb1, 0xfe, 0xd1, 0x9d, 0xd9, 0xd0, 0xb4,
+	0xaa, 0x90, 0x90, 0x90, 0xe0, 0x86, 0xc4, 0x11, 0xb8, 0xf1, 0x67, 0x70, 0xe2, 0x54, 0x71, 0xaa,
+	0xc4, 0x85, 0x13, 0x42, 0x09, 0x47, 0xfe, 0x08, 0x34, 0xcf, 0xb3, 0x6b, 0x3b, 0x59, 0xef, 0x8f,
+	0xaa, 0xdc, 0xc6, 0x6f, 0xde, 0xe7, 0x7d, 0x3e, 0x9f, 0xe7, 0xb7, 0x9e, 0x59, 0x58, 0xf2, 0x6b,
+	0xae, 0xd5, 0xf0, 0xbd, 0x43, 0xe1, 0x36, 0x7c, 0x16, 0x0a, 0x6e, 0x7d, 0x41, 0x79, 0x60, 0x1d,
+	0xad, 0x5b, 0x8f, 0x5a, 0xac, 0x79, 0x6c, 0xc6, 0xcd, 0x48, 0x44, 0xa4, 0xe2, 0xd7, 0x5c, 0x33,
+	0x9b, 0x65, 0xca, 0x2c, 0xf3, 0x68, 0x5d, 0x5f, 0xf0, 0xa2, 0xc8, 0x6b, 0x30, 0x8b, 0xc6, 0xbe,
+	0x45, 0xc3, 0x30, 0x12, 0x54, 0xf8, 0x51, 0xc8, 0x13, 0x9c, 0xfe, 0x9a, 0x17, 0x79, 0x11, 0x2e,
+	0x2d, 0xb9, 0x52, 0xd1, 0x55, 0x37, 0xe2, 0x41, 0xc4, 0xad, 0x1a, 0xe5, 0x2c, 0xa1, 0xb1, 0x8e,
+	0xd6, 0x6b, 0x4c, 0xd0, 0x75, 0x2b, 0xa6, 0x9e, 0x1f, 0x62, 0x09, 0x95, 0xbb, 0x58, 0xa8, 0x0f,
+	0x15, 0x60, 0x92, 0xe1, 0xc0, 0xdc, 0x43, 0x59, 0xe6, 0xfe, 0x21, 0x73, 0x3f, 0xe7, 0xad, 0x80,
+	0xdb, 0xec, 0x51, 0x8b, 0x71, 0x41, 0x76, 0x01, 0xd2, 0x8a, 0x15, 0xed, 0x9a, 0xb6, 0x52, 0xde,
+	0x58, 0x36, 0x13, 0x7a, 0x53, 0xd2, 0x9b, 0x89, 0x4b, 0x45, 0x6f, 0xee, 0x53, 0x8f, 0x29, 0xac,
+	0x9d, 0x41, 0x1a, 0x5f, 0xc2, 0xfc, 0x59, 0x02, 0x1e, 0x47, 0x21, 0x67, 0x64, 0x01, 0x26, 0xdd,
+	0x76, 0xb0, 0xa2, 0x5d, 0x2b, 0xad, 0x4c, 0xda, 0x69, 0x80, 0x3c, 0xc8, 0xf1, 0x8f, 0x22, 0xff,
+	0x8d, 0xbe, 0xfc, 0x49, 0xe9, 0x9c, 0x00, 0x13, 0x2e, 0x25, 0x02, 0xa2, 0x7a, 0x5b, 0x20, 0xd1,
+	0x61, 0xa2, 0xcd, 0x84, 0xd6, 0x26, 0xed, 0xce, 0xb3, 0x71, 0x03, 0x66, 0x33, 0xf9, 0x4a, 0x2b,
+	0x81, 0xb1, 0x3a, 0x15, 0x14, 0x93, 0xa7, 0x6c, 0x5c, 0x1b, 0xdb, 0xa0, 0xe7, 0x9c, 0xed, 0xd3,
+	0x26, 0x0d, 0xf8, 0x20, 0x14, 0x0c, 0xae, 0x76, 0x45, 0x2a, 0xb2, 0x5d, 0x18, 0x8f, 0x31, 0xa2,
+	0xda, 0xbe, 0x62, 0x16, 0xcd, 0x90, 0x99, 0xaf, 0x70, 0x6f, 0xec, 0xf9, 0x9f, 0x6f, 0x8c, 0xd8,
+	0x0a, 0x6d, 0xec, 0xb4, 0x69, 0x10, 0x74, 0x20, 0xa2, 0x26, 0x3b, 0xf0, 0x9f, 0x74, 0x9a, 0x70,
+	0x15, 0x26, 0x93, 0x72, 0x8e, 0x5f, 0xef, 0x48, 0xc4, 0xc0, 0x47, 0x75, 0x63, 0x0f, 0x16, 0xba,
+	0x63, 0xd3, 0x86, 0x70, 0xff, 0x09, 0x43, 0xdc, 0x98, 0x8d, 0x6b, 0x72, 0x05, 0x26, 0x02, 0xfa,
+	0xd8, 0xc1, 0xf8, 0x28, 0xc6, 0x2f, 0x06, 0xf4, 0xb1, 0x84, 0x19, 0x1e, 0xbc, 0x8e, 0xe5, 0x3e,
+	0x88, 0xe3, 0x66, 0x74, 0xc4, 0xea, 0xff, 0xd9, 0xb8, 0xfd, 0xa6, 0x41, 0xb5, 0x88, 0x49, 0x49,
+	0x77, 0x80, 0x50, 0xb5, 0xe9, 0xe4, 0x07, 0xb0, 0xbc, 0xb1, 0x5a, 0xdc, 0xea, 0xb3, 0x05, 0x55,
+	0xb3, 0x67, 0xe9, 0x59, 0xa2, 0x57, 0x37, 0xba, 0x31, 0x2c, 0xa2, 0x97, 0x03, 0x3f, 0x68, 0x35,
+	0xa8, 0x60, 0x7b, 0xbe, 0xd7, 0xa4, 0x82, 0xdd, 0x8f, 0x42, 0xd1, 0xa4, 0xae, 0x18, 0xe4, 0x45,
+	0xe6, 0xe6, 0x70, 0x34, 0x3f, 0x87, 0xe4, 0x12, 0x94, 0x02, 0xee, 0x55, 0x4a, 0x38, 0xd4, 0x72,
+	0x69, 0x7c, 0xa3, 0xc1, 0x52, 0x6f, 0x4a, 0xd5, 0xc4, 0x0f, 0x61, 0x4a, 0x71, 0x72, 0x41, 0x05,
+	0x53, 0x6f, 0xec, 0x7a, 0x8f, 0x49, 0x55, 0x83, 0x44, 0x05, 0xb3, 0xcb, 0x6e, 0xfa, 0x40, 0xe6,
+	0x61, 0x5c, 0x96, 0x68, 0x71, 0x25, 0x4f, 0x3d, 0x19, 0x14, 0x2e, 0xa3, 0x92, 0x7d, 0x3f, 0x0c,
+	0x59, 0x5d, 0xfe, 0x1a, 0x5f, 0xf9, 0xb0, 0x7c, 0x3d, 0x0a, 0x95, 0xf3, 0x1c, 0xca, 0xe1, 0x1e,
+	0x4c, 0xc5, 0x18, 0x76, 0x5c, 0x19, 0x57, 0x03, 0xb2, 0x54, 0xec, 0x30, 0x2d, 0xa2, 0x46, 0xa3,
+	0x1c, 0xa7, 0x65, 0xc9, 0x43, 0x98, 0x76, 0xa9, 0x7b, 0xc8, 0x9c, 0x80, 0x89, 0xa6, 0xef, 0x72,
+	0x35, 0x17, 0xcb, 0x3d, 0x3a, 0x26, 0xd3, 0xf7, 0x92, 0x6c, 0x55, 0x71, 0xca, 0xcd, 0xc4, 0xce,
+	0xcc, 0x59, 0xe9, 0xe5, 0xe7, 0x6c, 0x1f, 0x20, 0x15, 0xdf, 0xeb, 0xcb, 0x25, 0x7f, 0xf6, 0x87,
+	0xbe, 0x48, 0xc4, 0x4f, 0xdb, 0xb8, 0xee, 0x7c, 0x0a, 0x4a, 0xe9, 0xa7, 0xc0, 0xf8, 0xb6, 0x04,
+	0x53, 0x59, 0xfd, 0x64, 0x0b, 0x2a, 0x32, 0xd9, 0x51, 0x2d, 0x0d, 0x58, 0x10, 0x35, 0x8f, 0x1d,
+	0xb4, 0x83, 0x24, 0xd3, 0xf6, 0x9c, 0xdc, 0x4f, 0x64, 0xec, 0xe1, 0x2e, 0xe2, 0xc9, 0x2a, 0xcc,
+	0x22, 0x30, 0x87, 0x48, 0xe8, 0x67, 0xe4, 0x46, 0x36, 0xd7, 0x80, 0x69, 0xcc, 0xfd, 0x8c, 0xab,
+	0xbc, 0x12, 0xe6, 0x95, 0x65, 0x70, 0x97, 0x27, 0x39, 0xf3, 0x30, 0x1e, 0xf8, 0x9c, 0x33, 0x5e,
+	0x19, 0xc3, 0x4d, 0xf5, 0x44, 0xde, 0x83, 0x05, 0xd6, 0x60, 0x01, 0x0b, 0x0b, 0x44, 0x5e, 0x40,
+	0x77, 0x57, 0xda, 0x39, 0xe7, 0x85, 0x6e, 0xc0, 0x5c, 0xa7, 0x40, 0x0e, 0x39, 0x8e, 0xc8, 0xff,
+	0xb7, 0x37, 0xb3, 0x98, 0x2d, 0xa8, 0xc8, 0x76, 0x75, 0x25, 0xbc, 0x88, 0xb0, 0x39, 0xb9, 0xdf,
+	0xb5, 0x2b, 0x08, 0xcc, 0x21, 0x26, 0x10, 0x31, 0x23, 0x37, 0x32, 0xb9, 0x1b, 0x3f, 0x01, 0x5c,
+	0xc0, 0x29, 0x27, 0x3f, 0x68, 0x30, 0x99, 0x7e, 0xa6, 0xac, 0xe2, 0xd1, 0xeb, 0x7a, 0x25, 0xd0,
+	0x6f, 0x0d, 0x0e, 0x48, 0x86, 0xcc, 0xb8, 0xf9, 0xd5, 0xef, 0x7f, 0x7f, 0x3f, 0x7a, 0x9d, 0x2c,
+	0x5a, 0x85, 0x77, 0x91, 0xf4, 0xc4, 0xff, 0x51, 0x83, 0x31, 0x1c, 0xc0, 0xd5, 0x7e, 0x3c, 0xe9,
+	0x49, 0xae, 0xdf, 0x1c, 0x28, 0x57, 0xc9, 0x79, 0x1b, 0xe5, 0x6c, 0x92, 0xdb, 0x03, 0xc8, 0xb1,
+	0x9e, 0xb6, 0x97, 0xcf, 0x2c, 0xf9, 0x01, 0x20, 0xbf, 0x68, 0xf0, 0xbf, 0xfc, 0x71, 0x4b, 0xee,
+	0x0c, 0xd8, 0x90, 0xdc, 0xcd, 0x40, 0xdf, 0x1c, 0x12, 0xa5, 0xc4, 0xdf, 0x45, 0xf1, 0x5b, 0x64,
+	0x73, 0x48, 0xf1, 0xc9, 0x65, 0x80, 0xfc, 0xaa, 0xc1, 0xcc, 0x99, 0xc3, 0x9c, 0xf4, 0x55, 0xd2,
+	0xf5, 0xe2, 0xa0, 0xbf, 0x35, 0x2c, 0x4c, 0x39, 0x78, 0x1f, 0x1d, 0xec, 0x90, 0xed, 0x1e, 0x0e,
+	0xd4, 0xf3, 0xd3, 0xce, 0x81, 0xf6, 0xcc, 0xe2, 0xb2, 0x10, 0xde, 0x2a, 0xe4, 0x3b, 0x98, 0x3d,
+	0x77, 0xb0, 0x93, 0xad, 0x3e, 0x7a, 0x8a, 0x2e, 0x1d, 0xfa, 0xf6, 0xf0, 0x40, 0x65, 0xe5, 0x0e,
+	0x5a, 0x31, 0xc9, 0x9b, 0xc5, 0x56, 0xce, 0xdf, 0x31, 0xc8, 0x3f, 0x1a, 0x5c, 0x2e, 0x38, 0x58,
+	0xc9, 0xdd, 0x3e, 0x5a, 0x7a, 0xdf, 0x01, 0xf4, 0x77, 0x5f, 0x16, 0xae, 0x0c, 0xd9, 0x68, 0xe8,
+	0xe3, 0x1d, 0x6d, 0xd5, 0x78, 0x30, 0xe4, 0xeb, 0x51, 0x95, 0x9d, 0x20, 0x29, 0xed, 0xb8, 0x6d,
+	0x4b, 0x3f, 0x6b, 0x50, 0xce, 0x9c, 0xac, 0x64, 0xbd, 0x8f, 0xc6, 0xf3, 0x27, 0xbd, 0xbe, 0x31,
+	0x0c, 0x44, 0x59, 0x31, 0xd1, 0xca, 0x0a, 0x59, 0x2e, 0xf6, 0x91, 0x3d, 0xd8, 0xef, 0x7d, 0xf2,
+	0xfc, 0xa4, 0xaa, 0xbd, 0x38, 0xa9, 0x6a, 0x7f, 0x9d, 0x54, 0xb5, 0xef, 0x4e, 0xab, 0x23, 0x2f,
+	0x4e, 0xab, 0x23, 0x7f, 0x9c, 0x56, 0x47, 0x3e, 0x7d, 0xc7, 0xf3, 0xc5, 0x61, 0xab, 0x66, 0xba,
+	0x51, 0x60, 0xa9, 0x3f, 0x5e, 0x7e, 0xcd, 0x5d, 0xf3, 0x22, 0x2b, 0x88, 0xea, 0xad, 0x06, 0xe3,
+	0x49, 0xf5, 0xb5, 0x76, 0xf9, 0x5b, 0xdb, 0x6b, 0xc8, 0x20, 0x8e, 0x63, 0xc6, 0x6b, 0xe3, 0xf8,
+	0x0f, 0xeb, 0xf6, 0xbf, 0x03, 0x00, 0xe9, 0x47, 0x7f, 0x7c, 0x28, 0x0e, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Get all Wasm checksums
+	Checksums(ctx context.Context, in *QueryChecksumsRequest, opts ...grpc.CallOption) (*QueryChecksumsResponse, error)
+	// Get Wasm code for given checksum
+	Code(ctx context.Context, in *QueryCodeRequest, opts ...grpc.CallOption) (*QueryCodeResponse, error)
+	// Get the client store limits for given checksum
+	ChecksumParams(ctx context.Context, in *QueryChecksumParamsRequest, opts ...grpc.CallOption) (*QueryChecksumParamsResponse, error)
+	// Get the client store size for given client identifier
+	ClientStoreSize(ctx context.Context, in *QueryClientStoreSizeRequest, opts ...grpc.CallOption) (*QueryClientStoreSizeResponse, error)
+	// Get all checksums approved for upload
+	ApprovedChecksums(ctx context.Context, in *QueryApprovedChecksumsRequest, opts ...grpc.CallOption) (*QueryApprovedChecksumsResponse, error)
+	// Simulate the migration of the contract of a light client to the given checksum
+	SimulateMigrateContract(ctx context.Context, in *QuerySimulateMigrateContractRequest, opts ...grpc.CallOption) (*QuerySimulateMigrateContractResponse, error)
+	// Get all pinned Wasm checksums and the cache metrics of the Wasm VM
+	PinnedCodes(ctx context.Context, in *QueryPinnedCodesRequest, opts ...grpc.CallOption) (*QueryPinnedCodesResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Checksums(ctx context.Context, in *QueryChecksumsRequest, opts ...grpc.CallOption) (*QueryChecksumsResponse, error) {
+	out := new(QueryChecksumsResponse)
+	err := c.cc.Invoke(ctx, "/ibc.lightclients.wasm.v1.Query/Checksums", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Code(ctx context.Context, in *QueryCodeRequest, opts ...grpc.CallOption) (*QueryCodeResponse, error) {
+	out := new(QueryCodeResponse)
+	err := c.cc.Invoke(ctx, "/ibc.lightclients.wasm.v1.Query/Code", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ChecksumParams(ctx context.Context, in *QueryChecksumParamsRequest, opts ...grpc.CallOption) (*QueryChecksumParamsResponse, error) {
+	out := new(QueryChecksumParamsResponse)
+	err := c.cc.Invoke(ctx, "/ibc.lightclients.wasm.v1.Query/ChecksumParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ClientStoreSize(ctx context.Context, in *QueryClientStoreSizeRequest, opts ...grpc.CallOption) (*QueryClientStoreSizeResponse, error) {
+	out := new(QueryClientStoreSizeResponse)
+	err := c.cc.Invoke(ctx, "/ibc.lightclients.wasm.v1.Query/ClientStoreSize", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ApprovedChecksums(ctx context.Context, in *QueryApprovedChecksumsRequest, opts ...grpc.CallOption) (*QueryApprovedChecksumsResponse, error) {
+	out := new(QueryApprovedChecksumsResponse)
+	err := c.cc.Invoke(ctx, "/ibc.lightclients.wasm.v1.Query/ApprovedChecksums", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SimulateMigrateContract(ctx context.Context, in *QuerySimulateMigrateContractRequest, opts ...grpc.CallOption) (*QuerySimulateMigrateContractResponse, error) {
+	out := new(QuerySimulateMigrateContractResponse)
+	err := c.cc.Invoke(ctx, "/ibc.lightclients.wasm.v1.Query/SimulateMigrateContract", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PinnedCodes(ctx context.Context, in *QueryPinnedCodesRequest, opts ...grpc.CallOption) (*QueryPinnedCodesResponse, error) {
+	out := new(QueryPinnedCodesResponse)
+	err := c.cc.Invoke(ctx, "/ibc.lightclients.wasm.v1.Query/PinnedCodes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Get all Wasm checksums
+	Checksums(context.Context, *QueryChecksumsRequest) (*QueryChecksumsResponse, error)
+	// Get Wasm code for given checksum
+	Code(context.Context, *QueryCodeRequest) (*QueryCodeResponse, error)
+	// Get the client store limits for given checksum
+	ChecksumParams(context.Context, *QueryChecksumParamsRequest) (*QueryChecksumParamsResponse, error)
+	// Get the client store size for given client identifier
+	ClientStoreSize(context.Context, *QueryClientStoreSizeRequest) (*QueryClientStoreSizeResponse, error)
+	// Get all checksums approved for upload
+	ApprovedChecksums(context.Context, *QueryApprovedChecksumsRequest) (*QueryApprovedChecksumsResponse, error)
+	// Simulate the migration of the contract of a light client to the given checksum
+	SimulateMigrateContract(context.Context, *QuerySimulateMigrateContractRequest) (*QuerySimulateMigrateContractResponse, error)
+	// Get all pinned Wasm checksums and the cache metrics of the Wasm VM
+	PinnedCodes(context.Context, *QueryPinnedCodesRequest) (*QueryPinnedCodesResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Checksums(ctx context.Context, req *QueryChecksumsRequest) (*QueryChecksumsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Checksums not implemented")
+}
+func (*UnimplementedQueryServer) Code(ctx context.Context, req *QueryCodeRequest) (*QueryCodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Code not implemented")
+}
+func (*UnimplementedQueryServer) ChecksumParams(ctx context.Context, req *QueryChecksumParamsRequest) (*QueryChecksumParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChecksumParams not implemented")
+}
+func (*UnimplementedQueryServer) ClientStoreSize(ctx context.Context, req *QueryClientStoreSizeRequest) (*QueryClientStoreSizeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClientStoreSize not implemented")
+}
+func (*UnimplementedQueryServer) ApprovedChecksums(ctx context.Context, req *QueryApprovedChecksumsRequest) (*QueryApprovedChecksumsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApprovedChecksums not implemented")
+}
+func (*UnimplementedQueryServer) SimulateMigrateContract(ctx context.Context, req *QuerySimulateMigrateContractRequest) (*QuerySimulateMigrateContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateMigrateContract not implemented")
+}
+func (*UnimplementedQueryServer) PinnedCodes(ctx context.Context, req *QueryPinnedCodesRequest) (*QueryPinnedCodesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PinnedCodes not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Checksums_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryChecksumsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Checksums(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.lightclients.wasm.v1.Query/Checksums",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Checksums(ctx, req.(*QueryChecksumsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Code_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Code(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.lightclients.wasm.v1.Query/Code",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Code(ctx, req.(*QueryCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ChecksumParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryChecksumParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ChecksumParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.lightclients.wasm.v1.Query/ChecksumParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ChecksumParams(ctx, req.(*QueryChecksumParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ClientStoreSize_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClientStoreSizeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ClientStoreSize(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.lightclients.wasm.v1.Query/ClientStoreSize",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClientStoreSize(ctx, req.(*QueryClientStoreSizeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ApprovedChecksums_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryApprovedChecksumsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ApprovedChecksums(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.lightclients.wasm.v1.Query/ApprovedChecksums",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ApprovedChecksums(ctx, req.(*QueryApprovedChecksumsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SimulateMigrateContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySimulateMigrateContractRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SimulateMigrateContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.lightclients.wasm.v1.Query/SimulateMigrateContract",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulateMigrateContract(ctx, req.(*QuerySimulateMigrateContractRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PinnedCodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPinnedCodesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PinnedCodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.lightclients.wasm.v1.Query/PinnedCodes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PinnedCodes(ctx, req.(*QueryPinnedCodesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ibc.lightclients.wasm.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Checksums",
+			Handler:    _Query_Checksums_Handler,
+		},
+		{
+			MethodName: "Code",
+			Handler:    _Query_Code_Handler,
+		},
+		{
+			MethodName: "ChecksumParams",
+			Handler:    _Query_ChecksumParams_Handler,
+		},
+		{
+			MethodName: "ClientStoreSize",
+			Handler:    _Query_ClientStoreSize_Handler,
+		},
+		{
+			MethodName: "ApprovedChecksums",
+			Handler:    _Query_ApprovedChecksums_Handler,
+		},
+		{
+			MethodName: "SimulateMigrateContract",
+			Handler:    _Query_SimulateMigrateContract_Handler,
+		},
+		{
+			MethodName: "PinnedCodes",
+			Handler:    _Query_PinnedCodes_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "ibc/lightclients/wasm/v1/query.proto",
+}
+
+func (m *QueryChecksumsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryChecksumsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryChecksumsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryChecksumsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryChecksumsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryChecksumsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Checksums) > 0 {
+		for iNdEx := len(m.Checksums) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Checksums[iNdEx])
+			copy(dAtA[i:], m.Checksums[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Checksums[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCodeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCodeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCodeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Checksum) > 0 {
+		i -= len(m.Checksum)
+		copy(dAtA[i:], m.Checksum)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Checksum)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCodeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCodeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCodeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryChecksumParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryChecksumParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryChecksumParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Checksum) > 0 {
+		i -= len(m.Checksum)
+		copy(dAtA[i:], m.Checksum)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Checksum)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryChecksumParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryChecksumParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryChecksumParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClientStoreSizeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClientStoreSizeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClientStoreSizeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClientStoreSizeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClientStoreSizeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClientStoreSizeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxSize != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxSize))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Size_ != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Size_))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryApprovedChecksumsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryApprovedChecksumsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryApprovedChecksumsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryApprovedChecksumsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryApprovedChecksumsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryApprovedChecksumsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ApprovedChecksums) > 0 {
+		for iNdEx := len(m.ApprovedChecksums) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ApprovedChecksums[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateMigrateContractRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateMigrateContractRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateMigrateContractRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Checksum) > 0 {
+		i -= len(m.Checksum)
+		copy(dAtA[i:], m.Checksum)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Checksum)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateMigrateContractResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateMigrateContractResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateMigrateContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ClientState != nil {
+		{
+			size, err := m.ClientState.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPinnedCodesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPinnedCodesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPinnedCodesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPinnedCodesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPinnedCodesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPinnedCodesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.CacheMetrics.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.PinnedCodes) > 0 {
+		for iNdEx := len(m.PinnedCodes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PinnedCodes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PinnedCode) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PinnedCode) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PinnedCode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Size_ != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Size_))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Hits != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Hits))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Checksum) > 0 {
+		i -= len(m.Checksum)
+		copy(dAtA[i:], m.Checksum)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Checksum)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CacheMetrics) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CacheMetrics) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CacheMetrics) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SizeMemoryCache != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SizeMemoryCache))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.SizePinnedMemoryCache != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SizePinnedMemoryCache))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.ElementsMemoryCache != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ElementsMemoryCache))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ElementsPinnedMemoryCache != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ElementsPinnedMemoryCache))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Misses != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Misses))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.HitsFsCache != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.HitsFsCache))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.HitsMemoryCache != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.HitsMemoryCache))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.HitsPinnedMemoryCache != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.HitsPinnedMemoryCache))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryChecksumsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryChecksumsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Checksums) > 0 {
+		for _, s := range m.Checksums {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCodeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Checksum)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCodeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryChecksumParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Checksum)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryChecksumParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryClientStoreSizeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryClientStoreSizeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Size_ != 0 {
+		n += 1 + sovQuery(uint64(m.Size_))
+	}
+	if m.MaxSize != 0 {
+		n += 1 + sovQuery(uint64(m.MaxSize))
+	}
+	return n
+}
+
+func (m *QueryApprovedChecksumsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryApprovedChecksumsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ApprovedChecksums) > 0 {
+		for _, e := range m.ApprovedChecksums {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySimulateMigrateContractRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Checksum)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySimulateMigrateContractResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ClientState != nil {
+		l = m.ClientState.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPinnedCodesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPinnedCodesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PinnedCodes) > 0 {
+		for _, e := range m.PinnedCodes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.CacheMetrics.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *PinnedCode) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Checksum)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Hits != 0 {
+		n += 1 + sovQuery(uint64(m.Hits))
+	}
+	if m.Size_ != 0 {
+		n += 1 + sovQuery(uint64(m.Size_))
+	}
+	return n
+}
+
+func (m *CacheMetrics) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.HitsPinnedMemoryCache != 0 {
+		n += 1 + sovQuery(uint64(m.HitsPinnedMemoryCache))
+	}
+	if m.HitsMemoryCache != 0 {
+		n += 1 + sovQuery(uint64(m.HitsMemoryCache))
+	}
+	if m.HitsFsCache != 0 {
+		n += 1 + sovQuery(uint64(m.HitsFsCache))
+	}
+	if m.Misses != 0 {
+		n += 1 + sovQuery(uint64(m.Misses))
+	}
+	if m.ElementsPinnedMemoryCache != 0 {
+		n += 1 + sovQuery(uint64(m.ElementsPinnedMemoryCache))
+	}
+	if m.ElementsMemoryCache != 0 {
+		n += 1 + sovQuery(uint64(m.ElementsMemoryCache))
+	}
+	if m.SizePinnedMemoryCache != 0 {
+		n += 1 + sovQuery(uint64(m.SizePinnedMemoryCache))
+	}
+	if m.SizeMemoryCache != 0 {
+		n += 1 + sovQuery(uint64(m.SizeMemoryCache))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryChecksumsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryChecksumsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryChecksumsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryChecksumsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryChecksumsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryChecksumsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checksums", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Checksums = append(m.Checksums, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCodeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCodeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCodeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checksum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Checksum = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCodeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCodeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCodeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryChecksumParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryChecksumParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryChecksumParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checksum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Checksum = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryChecksumParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryChecksumParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryChecksumParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryClientStoreSizeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClientStoreSizeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClientStoreSizeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryClientStoreSizeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClientStoreSizeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClientStoreSizeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
+			}
+			m.Size_ = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Size_ |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSize", wireType)
+			}
+			m.MaxSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryApprovedChecksumsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryApprovedChecksumsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryApprovedChecksumsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryApprovedChecksumsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryApprovedChecksumsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryApprovedChecksumsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApprovedChecksums", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApprovedChecksums = append(m.ApprovedChecksums, ApprovedChecksum{})
+			if err := m.ApprovedChecksums[len(m.ApprovedChecksums)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySimulateMigrateContractRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySimulateMigrateContractRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySimulateMigrateContractRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checksum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Checksum = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msg = append(m.Msg[:0], dAtA[iNdEx:postIndex]...)
+			if m.Msg == nil {
+				m.Msg = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySimulateMigrateContractResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySimulateMigrateContractResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySimulateMigrateContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ClientState == nil {
+				m.ClientState = &ClientState{}
+			}
+			if err := m.ClientState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func (m *QueryCodeRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Checksum)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
 
-func (m *QueryCodeResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryChecksumsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPinnedCodesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -620,10 +3432,10 @@ func (m *QueryChecksumsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryChecksumsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPinnedCodesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryChecksumsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPinnedCodesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -683,7 +3495,7 @@ func (m *QueryChecksumsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryChecksumsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPinnedCodesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -706,17 +3518,17 @@ func (m *QueryChecksumsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryChecksumsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPinnedCodesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryChecksumsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPinnedCodesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Checksums", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PinnedCodes", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -726,25 +3538,60 @@ func (m *QueryChecksumsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Checksums = append(m.Checksums, string(dAtA[iNdEx:postIndex]))
+			m.PinnedCodes = append(m.PinnedCodes, PinnedCode{})
+			if err := m.PinnedCodes[len(m.PinnedCodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CacheMetrics", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CacheMetrics.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -801,7 +3648,7 @@ func (m *QueryChecksumsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCodeRequest) Unmarshal(dAtA []byte) error {
+func (m *PinnedCode) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -824,10 +3671,10 @@ func (m *QueryCodeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCodeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PinnedCode: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCodeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PinnedCode: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -862,6 +3709,44 @@ func (m *QueryCodeRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Checksum = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hits", wireType)
+			}
+			m.Hits = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Hits |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
+			}
+			m.Size_ = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Size_ |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -883,7 +3768,7 @@ func (m *QueryCodeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCodeResponse) Unmarshal(dAtA []byte) error {
+func (m *CacheMetrics) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -906,17 +3791,17 @@ func (m *QueryCodeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCodeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: CacheMetrics: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCodeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CacheMetrics: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HitsPinnedMemoryCache", wireType)
 			}
-			var byteLen int
+			m.HitsPinnedMemoryCache = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -926,26 +3811,144 @@ func (m *QueryCodeResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.HitsPinnedMemoryCache |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthQuery
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HitsMemoryCache", wireType)
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			m.HitsMemoryCache = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HitsMemoryCache |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HitsFsCache", wireType)
 			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
+			m.HitsFsCache = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HitsFsCache |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Misses", wireType)
+			}
+			m.Misses = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Misses |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ElementsPinnedMemoryCache", wireType)
+			}
+			m.ElementsPinnedMemoryCache = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ElementsPinnedMemoryCache |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ElementsMemoryCache", wireType)
+			}
+			m.ElementsMemoryCache = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ElementsMemoryCache |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SizePinnedMemoryCache", wireType)
+			}
+			m.SizePinnedMemoryCache = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SizePinnedMemoryCache |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SizeMemoryCache", wireType)
+			}
+			m.SizeMemoryCache = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SizeMemoryCache |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
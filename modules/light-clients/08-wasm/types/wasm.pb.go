@@ -194,11 +194,256 @@ func (m *Checksums) GetChecksums() [][]byte {
 	return nil
 }
 
+// ChecksumParams defines the limits applied to the client stores of light clients
+// using the contract with the given checksum.
+type ChecksumParams struct {
+	// checksum is the sha256 hash of the contract the params apply to
+	Checksum []byte `protobuf:"bytes,1,opt,name=checksum,proto3" json:"checksum,omitempty"`
+	// the maximum size in bytes of a light client's client store, computed as the sum of the
+	// lengths of all keys and values. A value of zero disables the limit.
+	MaxClientStoreSize uint64 `protobuf:"varint,2,opt,name=max_client_store_size,json=maxClientStoreSize,proto3" json:"max_client_store_size,omitempty"`
+	// the gas consumed per byte of key and value written by the contract to the client store,
+	// in addition to the regular store write costs.
+	StoreWriteGasPerByte uint64 `protobuf:"varint,3,opt,name=store_write_gas_per_byte,json=storeWriteGasPerByte,proto3" json:"store_write_gas_per_byte,omitempty"`
+}
+
+func (m *ChecksumParams) Reset()         { *m = ChecksumParams{} }
+func (m *ChecksumParams) String() string { return proto.CompactTextString(m) }
+func (*ChecksumParams) ProtoMessage()    {}
+func (*ChecksumParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_678928ebbdee1807, []int{4}
+}
+func (m *ChecksumParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChecksumParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChecksumParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChecksumParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChecksumParams.Merge(m, src)
+}
+func (m *ChecksumParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChecksumParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChecksumParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChecksumParams proto.InternalMessageInfo
+
+func (m *ChecksumParams) GetChecksum() []byte {
+	if m != nil {
+		return m.Checksum
+	}
+	return nil
+}
+
+func (m *ChecksumParams) GetMaxClientStoreSize() uint64 {
+	if m != nil {
+		return m.MaxClientStoreSize
+	}
+	return 0
+}
+
+func (m *ChecksumParams) GetStoreWriteGasPerByte() uint64 {
+	if m != nil {
+		return m.StoreWriteGasPerByte
+	}
+	return 0
+}
+
+// ApprovedChecksum defines a checksum pre-approved by governance, whose matching wasm byte code
+// may be uploaded with MsgStoreCode by signers other than the authority.
+type ApprovedChecksum struct {
+	// checksum is the sha256 hash of the approved wasm byte code
+	Checksum []byte `protobuf:"bytes,1,opt,name=checksum,proto3" json:"checksum,omitempty"`
+	// the only address allowed to upload the wasm byte code. If empty, anyone may upload it.
+	Uploader string `protobuf:"bytes,2,opt,name=uploader,proto3" json:"uploader,omitempty"`
+}
+
+func (m *ApprovedChecksum) Reset()         { *m = ApprovedChecksum{} }
+func (m *ApprovedChecksum) String() string { return proto.CompactTextString(m) }
+func (*ApprovedChecksum) ProtoMessage()    {}
+func (*ApprovedChecksum) Descriptor() ([]byte, []int) {
+	return fileDescriptor_678928ebbdee1807, []int{5}
+}
+func (m *ApprovedChecksum) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApprovedChecksum) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApprovedChecksum.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ApprovedChecksum) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApprovedChecksum.Merge(m, src)
+}
+func (m *ApprovedChecksum) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApprovedChecksum) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApprovedChecksum.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApprovedChecksum proto.InternalMessageInfo
+
+func (m *ApprovedChecksum) GetChecksum() []byte {
+	if m != nil {
+		return m.Checksum
+	}
+	return nil
+}
+
+func (m *ApprovedChecksum) GetUploader() string {
+	if m != nil {
+		return m.Uploader
+	}
+	return ""
+}
+
+// ContractSnapshot defines a snapshot of a light client's client store taken when its contract was
+// migrated, which allows the migration to be rolled back.
+type ContractSnapshot struct {
+	// checksum is the sha256 hash of the contract before the migration
+	Checksum []byte `protobuf:"bytes,1,opt,name=checksum,proto3" json:"checksum,omitempty"`
+	// the block height at which the migration was executed
+	Height uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// the client store entries before the migration
+	ClientStore []ClientStoreEntry `protobuf:"bytes,3,rep,name=client_store,json=clientStore,proto3" json:"client_store"`
+}
+
+func (m *ContractSnapshot) Reset()         { *m = ContractSnapshot{} }
+func (m *ContractSnapshot) String() string { return proto.CompactTextString(m) }
+func (*ContractSnapshot) ProtoMessage()    {}
+func (*ContractSnapshot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_678928ebbdee1807, []int{6}
+}
+func (m *ContractSnapshot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContractSnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractSnapshot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ContractSnapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractSnapshot.Merge(m, src)
+}
+func (m *ContractSnapshot) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContractSnapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractSnapshot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractSnapshot proto.InternalMessageInfo
+
+func (m *ContractSnapshot) GetChecksum() []byte {
+	if m != nil {
+		return m.Checksum
+	}
+	return nil
+}
+
+func (m *ContractSnapshot) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ContractSnapshot) GetClientStore() []ClientStoreEntry {
+	if m != nil {
+		return m.ClientStore
+	}
+	return nil
+}
+
+// ClientStoreEntry defines a key value pair of a light client's client store.
+type ClientStoreEntry struct {
+	Key   []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value []byte `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (m *ClientStoreEntry) Reset()         { *m = ClientStoreEntry{} }
+func (m *ClientStoreEntry) String() string { return proto.CompactTextString(m) }
+func (*ClientStoreEntry) ProtoMessage()    {}
+func (*ClientStoreEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_678928ebbdee1807, []int{7}
+}
+func (m *ClientStoreEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClientStoreEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClientStoreEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClientStoreEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClientStoreEntry.Merge(m, src)
+}
+func (m *ClientStoreEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClientStoreEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClientStoreEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClientStoreEntry proto.InternalMessageInfo
+
+func (m *ClientStoreEntry) GetKey() []byte {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+func (m *ClientStoreEntry) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ClientState)(nil), "ibc.lightclients.wasm.v1.ClientState")
 	proto.RegisterType((*ConsensusState)(nil), "ibc.lightclients.wasm.v1.ConsensusState")
 	proto.RegisterType((*ClientMessage)(nil), "ibc.lightclients.wasm.v1.ClientMessage")
 	proto.RegisterType((*Checksums)(nil), "ibc.lightclients.wasm.v1.Checksums")
+	proto.RegisterType((*ChecksumParams)(nil), "ibc.lightclients.wasm.v1.ChecksumParams")
+	proto.RegisterType((*ApprovedChecksum)(nil), "ibc.lightclients.wasm.v1.ApprovedChecksum")
+	proto.RegisterType((*ContractSnapshot)(nil), "ibc.lightclients.wasm.v1.ContractSnapshot")
+	proto.RegisterType((*ClientStoreEntry)(nil), "ibc.lightclients.wasm.v1.ClientStoreEntry")
 }
 
 func init() {
@@ -206,29 +451,41 @@ func init() {
 }
 
 var fileDescriptor_678928ebbdee1807 = []byte{
-	// 339 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0xb1, 0x4e, 0xc3, 0x30,
-	0x10, 0x86, 0xe3, 0xb6, 0x42, 0xd4, 0x6d, 0x19, 0x22, 0x86, 0x28, 0x42, 0x69, 0x55, 0x96, 0x82,
-	0x14, 0x9b, 0xc2, 0x82, 0x2a, 0xa6, 0x56, 0x48, 0x2c, 0x2c, 0x45, 0x62, 0x60, 0x41, 0x89, 0x6b,
-	0x25, 0x16, 0x49, 0x5d, 0xf5, 0x9c, 0x22, 0xde, 0x00, 0x31, 0xf1, 0x08, 0x3c, 0x4e, 0xc7, 0x8e,
-	0x4c, 0x08, 0xb5, 0x2f, 0x82, 0x6c, 0xa7, 0xc0, 0x02, 0x53, 0x2e, 0xbf, 0x3f, 0xdf, 0x7d, 0xf2,
-	0xe1, 0x43, 0x11, 0x33, 0x9a, 0x89, 0x24, 0x55, 0x2c, 0x13, 0x7c, 0xaa, 0x80, 0x3e, 0x46, 0x90,
-	0xd3, 0x45, 0xdf, 0x7c, 0xc9, 0x6c, 0x2e, 0x95, 0x74, 0x3d, 0x11, 0x33, 0xf2, 0x1b, 0x22, 0xe6,
-	0x70, 0xd1, 0xf7, 0xf7, 0x13, 0x99, 0x48, 0x03, 0x51, 0x5d, 0x59, 0xde, 0x6f, 0xeb, 0xa6, 0x4c,
-	0xce, 0x39, 0xb5, 0xbc, 0x6e, 0x67, 0x2b, 0x0b, 0x74, 0x5f, 0x10, 0x6e, 0x8c, 0x4c, 0x70, 0xa3,
-	0x22, 0xc5, 0x5d, 0x17, 0xd7, 0x26, 0x91, 0x8a, 0x3c, 0xd4, 0x41, 0xbd, 0xe6, 0xd8, 0xd4, 0xae,
-	0x8f, 0x77, 0x59, 0xca, 0xd9, 0x03, 0x14, 0xb9, 0x57, 0x31, 0xf9, 0xf7, 0xbf, 0x7b, 0x89, 0x5b,
-	0x59, 0xa4, 0x38, 0xa8, 0xfb, 0x94, 0x6b, 0x2d, 0xaf, 0xda, 0x41, 0xbd, 0xc6, 0xa9, 0x4f, 0xb4,
-	0xa8, 0x1e, 0x4c, 0xca, 0x71, 0x8b, 0x3e, 0xb9, 0x32, 0xc4, 0xb0, 0xb6, 0xfc, 0x68, 0x3b, 0xe3,
-	0xa6, 0xbd, 0x66, 0xb3, 0x41, 0xed, 0xf9, 0xad, 0xed, 0x74, 0x8f, 0xf1, 0xde, 0x48, 0x4e, 0x81,
-	0x4f, 0xa1, 0x80, 0x3f, 0x75, 0x4a, 0xf6, 0x08, 0xb7, 0xac, 0xf7, 0x35, 0x07, 0x88, 0x92, 0xff,
-	0xd0, 0x10, 0xd7, 0x47, 0xa5, 0x2f, 0xb8, 0x07, 0xb8, 0xbe, 0x95, 0x07, 0x0f, 0x75, 0xaa, 0xbd,
-	0xe6, 0xf8, 0x27, 0x18, 0x54, 0x3c, 0x34, 0xbc, 0x5d, 0xae, 0x03, 0xb4, 0x5a, 0x07, 0xe8, 0x73,
-	0x1d, 0xa0, 0xd7, 0x4d, 0xe0, 0xac, 0x36, 0x81, 0xf3, 0xbe, 0x09, 0x9c, 0xbb, 0x8b, 0x44, 0xa8,
-	0xb4, 0x88, 0x09, 0x93, 0x39, 0x65, 0x12, 0x72, 0x09, 0x54, 0xc4, 0x2c, 0x4c, 0x24, 0xcd, 0xe5,
-	0xa4, 0xc8, 0x38, 0xd8, 0xfd, 0x85, 0xdb, 0x05, 0x9e, 0x9c, 0x87, 0x66, 0x87, 0xea, 0x69, 0xc6,
-	0x21, 0xde, 0x31, 0x2f, 0x7e, 0xf6, 0x15, 0x00, 0x00, 0xff, 0xff, 0xdb, 0x78, 0x4d, 0x63, 0xe9,
-	0x01, 0x00, 0x00,
+	// 538 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x93, 0x41, 0x6b, 0xdb, 0x30,
+	0x14, 0xc7, 0xa3, 0x26, 0x2b, 0x8d, 0x92, 0x96, 0x60, 0xb2, 0x61, 0xc2, 0x70, 0x82, 0x77, 0xc9,
+	0x0a, 0xb1, 0x97, 0x0e, 0xc6, 0x08, 0xbb, 0x2c, 0xa1, 0x6c, 0x0c, 0x06, 0xc5, 0x81, 0x0d, 0x76,
+	0x31, 0xb2, 0xf2, 0x70, 0x4c, 0x6d, 0xcb, 0x48, 0x72, 0xda, 0xf4, 0x13, 0x8c, 0x9d, 0x76, 0xdb,
+	0x61, 0x97, 0x7d, 0x9c, 0x1e, 0x7b, 0xdc, 0x69, 0x8c, 0xe4, 0x8b, 0x0c, 0x4b, 0x4e, 0x9b, 0x15,
+	0x9a, 0x53, 0xde, 0x7b, 0xfa, 0x45, 0xfa, 0xbf, 0xbf, 0xdf, 0xc3, 0xcf, 0xa2, 0x80, 0xba, 0x71,
+	0x14, 0xce, 0x25, 0x8d, 0x23, 0x48, 0xa5, 0x70, 0x2f, 0x88, 0x48, 0xdc, 0xc5, 0x50, 0xfd, 0x3a,
+	0x19, 0x67, 0x92, 0x19, 0x66, 0x14, 0x50, 0x67, 0x1b, 0x72, 0xd4, 0xe1, 0x62, 0xd8, 0x69, 0x87,
+	0x2c, 0x64, 0x0a, 0x72, 0x8b, 0x48, 0xf3, 0x9d, 0x6e, 0x71, 0x29, 0x65, 0x1c, 0x5c, 0xcd, 0x17,
+	0xd7, 0xe9, 0x48, 0x03, 0xf6, 0x37, 0x84, 0x1b, 0x13, 0x55, 0x98, 0x4a, 0x22, 0xc1, 0x30, 0x70,
+	0x6d, 0x46, 0x24, 0x31, 0x51, 0x0f, 0xf5, 0x9b, 0x9e, 0x8a, 0x8d, 0x0e, 0x3e, 0xa0, 0x73, 0xa0,
+	0xe7, 0x22, 0x4f, 0xcc, 0x3d, 0x55, 0xbf, 0xcd, 0x8d, 0x53, 0x7c, 0x18, 0x13, 0x09, 0x42, 0xfa,
+	0x73, 0x28, 0x64, 0x99, 0xd5, 0x1e, 0xea, 0x37, 0x4e, 0x3a, 0x4e, 0x21, 0xb4, 0x78, 0xd8, 0x29,
+	0x9f, 0x5b, 0x0c, 0x9d, 0xf7, 0x8a, 0x18, 0xd7, 0xae, 0xff, 0x74, 0x2b, 0x5e, 0x53, 0xff, 0x4d,
+	0xd7, 0x46, 0xb5, 0xaf, 0xbf, 0xba, 0x15, 0xfb, 0x18, 0x1f, 0x4d, 0x58, 0x2a, 0x20, 0x15, 0xb9,
+	0x78, 0x50, 0x4e, 0xc9, 0x3e, 0xc7, 0x87, 0x5a, 0xf7, 0x47, 0x10, 0x82, 0x84, 0xbb, 0xd0, 0x01,
+	0xae, 0x4f, 0x4a, 0xbd, 0xc2, 0x78, 0x8a, 0xeb, 0x1b, 0xf1, 0xc2, 0x44, 0xbd, 0x6a, 0xbf, 0xe9,
+	0xdd, 0x15, 0x46, 0x7b, 0x26, 0xb2, 0x7f, 0x20, 0x7c, 0xb4, 0xe1, 0xcf, 0x08, 0x27, 0x89, 0xf8,
+	0xcf, 0x01, 0x74, 0xcf, 0x81, 0x21, 0x7e, 0x9c, 0x90, 0x4b, 0x5f, 0xb7, 0xe9, 0x0b, 0xc9, 0x38,
+	0xf8, 0x22, 0xba, 0x02, 0x65, 0x55, 0xcd, 0x33, 0x12, 0x72, 0xb9, 0x31, 0x98, 0x71, 0x98, 0x46,
+	0x57, 0x60, 0xbc, 0xc2, 0xa6, 0xe6, 0x2e, 0x78, 0x24, 0xc1, 0x0f, 0x89, 0xf0, 0x33, 0xe0, 0x7e,
+	0xb0, 0x94, 0xa0, 0xfc, 0xab, 0x79, 0x6d, 0x75, 0xfe, 0xb9, 0x38, 0x7e, 0x47, 0xc4, 0x19, 0xf0,
+	0xf1, 0x52, 0x82, 0xfd, 0x01, 0xb7, 0xde, 0x66, 0x19, 0x67, 0x0b, 0x98, 0x6d, 0x04, 0xee, 0x94,
+	0xd6, 0xc1, 0x07, 0x79, 0x16, 0x33, 0x32, 0x03, 0xae, 0xd4, 0xd4, 0xbd, 0xdb, 0xdc, 0xfe, 0x89,
+	0x70, 0x6b, 0xc2, 0x52, 0xc9, 0x09, 0x95, 0xd3, 0x94, 0x64, 0x62, 0xce, 0xe4, 0xce, 0xcb, 0x9e,
+	0xe0, 0xfd, 0xf2, 0x13, 0xeb, 0xc6, 0xca, 0xcc, 0x98, 0xe2, 0xe6, 0x76, 0xef, 0x66, 0xb5, 0x57,
+	0xed, 0x37, 0x4e, 0x8e, 0x9d, 0x87, 0x26, 0xd5, 0xd9, 0x72, 0xe3, 0x34, 0x95, 0x7c, 0x59, 0x0e,
+	0x44, 0x83, 0xde, 0xd5, 0xed, 0x11, 0x6e, 0xdd, 0xc7, 0x8c, 0x16, 0xae, 0x9e, 0xc3, 0xb2, 0xd4,
+	0x55, 0x84, 0x46, 0x1b, 0x3f, 0x5a, 0x90, 0x38, 0x87, 0x72, 0x2a, 0x75, 0x32, 0xfe, 0x74, 0xbd,
+	0xb2, 0xd0, 0xcd, 0xca, 0x42, 0x7f, 0x57, 0x16, 0xfa, 0xbe, 0xb6, 0x2a, 0x37, 0x6b, 0xab, 0xf2,
+	0x7b, 0x6d, 0x55, 0xbe, 0xbc, 0x09, 0x23, 0x39, 0xcf, 0x03, 0x87, 0xb2, 0xc4, 0xa5, 0x4c, 0x24,
+	0x4c, 0xb8, 0x51, 0x40, 0x07, 0x21, 0x73, 0x13, 0x36, 0xcb, 0x63, 0x10, 0x7a, 0xff, 0x06, 0x9b,
+	0x05, 0x7c, 0xf1, 0x7a, 0xa0, 0x76, 0x50, 0x2e, 0x33, 0x10, 0xc1, 0xbe, 0xda, 0x98, 0x97, 0xff,
+	0x06, 0x00, 0x77, 0xba, 0x6f, 0x04, 0xa9, 0x03, 0x00, 0x00,
 }
 
 func (m *ClientState) Marshal() (dAtA []byte, err error) {
@@ -370,6 +627,169 @@ func (m *Checksums) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ChecksumParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChecksumParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChecksumParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.StoreWriteGasPerByte != 0 {
+		i = encodeVarintWasm(dAtA, i, uint64(m.StoreWriteGasPerByte))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxClientStoreSize != 0 {
+		i = encodeVarintWasm(dAtA, i, uint64(m.MaxClientStoreSize))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Checksum) > 0 {
+		i -= len(m.Checksum)
+		copy(dAtA[i:], m.Checksum)
+		i = encodeVarintWasm(dAtA, i, uint64(len(m.Checksum)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ApprovedChecksum) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApprovedChecksum) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApprovedChecksum) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Uploader) > 0 {
+		i -= len(m.Uploader)
+		copy(dAtA[i:], m.Uploader)
+		i = encodeVarintWasm(dAtA, i, uint64(len(m.Uploader)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Checksum) > 0 {
+		i -= len(m.Checksum)
+		copy(dAtA[i:], m.Checksum)
+		i = encodeVarintWasm(dAtA, i, uint64(len(m.Checksum)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractSnapshot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractSnapshot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractSnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClientStore) > 0 {
+		for iNdEx := len(m.ClientStore) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClientStore[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintWasm(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Height != 0 {
+		i = encodeVarintWasm(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Checksum) > 0 {
+		i -= len(m.Checksum)
+		copy(dAtA[i:], m.Checksum)
+		i = encodeVarintWasm(dAtA, i, uint64(len(m.Checksum)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClientStoreEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClientStoreEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClientStoreEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintWasm(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintWasm(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintWasm(dAtA []byte, offset int, v uint64) int {
 	offset -= sovWasm(v)
 	base := offset
@@ -438,16 +858,410 @@ func (m *Checksums) Size() (n int) {
 			n += 1 + l + sovWasm(uint64(l))
 		}
 	}
-	return n
-}
+	return n
+}
+
+func (m *ChecksumParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Checksum)
+	if l > 0 {
+		n += 1 + l + sovWasm(uint64(l))
+	}
+	if m.MaxClientStoreSize != 0 {
+		n += 1 + sovWasm(uint64(m.MaxClientStoreSize))
+	}
+	if m.StoreWriteGasPerByte != 0 {
+		n += 1 + sovWasm(uint64(m.StoreWriteGasPerByte))
+	}
+	return n
+}
+
+func (m *ApprovedChecksum) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Checksum)
+	if l > 0 {
+		n += 1 + l + sovWasm(uint64(l))
+	}
+	l = len(m.Uploader)
+	if l > 0 {
+		n += 1 + l + sovWasm(uint64(l))
+	}
+	return n
+}
+
+func (m *ContractSnapshot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Checksum)
+	if l > 0 {
+		n += 1 + l + sovWasm(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovWasm(uint64(m.Height))
+	}
+	if len(m.ClientStore) > 0 {
+		for _, e := range m.ClientStore {
+			l = e.Size()
+			n += 1 + l + sovWasm(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ClientStoreEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovWasm(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovWasm(uint64(l))
+	}
+	return n
+}
+
+func sovWasm(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozWasm(x uint64) (n int) {
+	return sovWasm(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ClientState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWasm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClientState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClientState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthWasm
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWasm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checksum", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthWasm
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWasm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Checksum = append(m.Checksum[:0], dAtA[iNdEx:postIndex]...)
+			if m.Checksum == nil {
+				m.Checksum = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWasm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWasm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LatestHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWasm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWasm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConsensusState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWasm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsensusState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsensusState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthWasm
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWasm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWasm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWasm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClientMessage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWasm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClientMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClientMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthWasm
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWasm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWasm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWasm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovWasm(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozWasm(x uint64) (n int) {
-	return sovWasm(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *ClientState) Unmarshal(dAtA []byte) error {
+func (m *Checksums) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -470,15 +1284,15 @@ func (m *ClientState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClientState: wiretype end group for non-group")
+			return fmt.Errorf("proto: Checksums: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClientState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Checksums: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Checksums", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -505,12 +1319,60 @@ func (m *ClientState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
-			}
+			m.Checksums = append(m.Checksums, make([]byte, postIndex-iNdEx))
+			copy(m.Checksums[len(m.Checksums)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWasm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWasm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChecksumParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWasm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChecksumParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChecksumParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Checksum", wireType)
 			}
@@ -544,11 +1406,11 @@ func (m *ClientState) Unmarshal(dAtA []byte) error {
 				m.Checksum = []byte{}
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LatestHeight", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxClientStoreSize", wireType)
 			}
-			var msglen int
+			m.MaxClientStoreSize = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowWasm
@@ -558,25 +1420,30 @@ func (m *ClientState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.MaxClientStoreSize |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthWasm
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthWasm
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StoreWriteGasPerByte", wireType)
 			}
-			if err := m.LatestHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.StoreWriteGasPerByte = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StoreWriteGasPerByte |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipWasm(dAtA[iNdEx:])
@@ -598,7 +1465,7 @@ func (m *ClientState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ConsensusState) Unmarshal(dAtA []byte) error {
+func (m *ApprovedChecksum) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -621,15 +1488,15 @@ func (m *ConsensusState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ConsensusState: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApprovedChecksum: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConsensusState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApprovedChecksum: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Checksum", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -656,10 +1523,42 @@ func (m *ConsensusState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
+			m.Checksum = append(m.Checksum[:0], dAtA[iNdEx:postIndex]...)
+			if m.Checksum == nil {
+				m.Checksum = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uploader", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWasm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWasm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Uploader = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -682,7 +1581,7 @@ func (m *ConsensusState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClientMessage) Unmarshal(dAtA []byte) error {
+func (m *ContractSnapshot) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -705,15 +1604,15 @@ func (m *ClientMessage) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClientMessage: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContractSnapshot: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClientMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContractSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Checksum", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -740,9 +1639,62 @@ func (m *ClientMessage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
+			m.Checksum = append(m.Checksum[:0], dAtA[iNdEx:postIndex]...)
+			if m.Checksum == nil {
+				m.Checksum = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientStore", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWasm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWasm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientStore = append(m.ClientStore, ClientStoreEntry{})
+			if err := m.ClientStore[len(m.ClientStore)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -766,7 +1718,7 @@ func (m *ClientMessage) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Checksums) Unmarshal(dAtA []byte) error {
+func (m *ClientStoreEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -789,15 +1741,15 @@ func (m *Checksums) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Checksums: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClientStoreEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Checksums: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClientStoreEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Checksums", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -824,8 +1776,44 @@ func (m *Checksums) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Checksums = append(m.Checksums, make([]byte, postIndex-iNdEx))
-			copy(m.Checksums[len(m.Checksums)-1], dAtA[iNdEx:postIndex])
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthWasm
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWasm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
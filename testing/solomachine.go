@@ -155,6 +155,13 @@ func (solo *Solomachine) CreateHeader(newDiversifier string) *solomachine.Header
 	// generate new private keys and signature for header
 	newPrivKeys, newPubKeys, newPubKey := GenerateKeys(solo.t, uint64(len(solo.PrivateKeys)))
 
+	return solo.CreateHeaderWithKeys(newPrivKeys, newPubKeys, newPubKey, newDiversifier)
+}
+
+// CreateHeaderWithKeys creates the necessary signature to construct a valid solo machine
+// header which proposes the provided keys and diversifier. The solo machine is updated to
+// use the provided keys and diversifier.
+func (solo *Solomachine) CreateHeaderWithKeys(newPrivKeys []cryptotypes.PrivKey, newPubKeys []cryptotypes.PubKey, newPubKey cryptotypes.PubKey, newDiversifier string) *solomachine.Header {
 	publicKey, err := codectypes.NewAnyWithValue(newPubKey)
 	require.NoError(solo.t, err)
 